@@ -0,0 +1,193 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/grpc/v1alpha1"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/streams"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// execService implements the ExecService of grpc apis.
+type execService struct {
+	containerMgr mgr.ContainerMgr
+}
+
+// Exec creates and starts a process in container, the stdio of process is
+// streamed in the bidirectional stream.
+func (s *execService) Exec(stream v1alpha1.ExecService_ExecServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	cfg := req.GetConfig()
+	if cfg == nil {
+		return status.Error(codes.InvalidArgument, "the first request should carry the config of exec process")
+	}
+	if len(cfg.Cmd) == 0 {
+		return status.Error(codes.InvalidArgument, "the command of exec process should not be empty")
+	}
+
+	execID, err := s.containerMgr.CreateExec(ctx, cfg.Container, &types.ExecCreateConfig{
+		Cmd:          cfg.Cmd,
+		Env:          cfg.Env,
+		User:         cfg.User,
+		WorkingDir:   cfg.WorkingDir,
+		Tty:          cfg.Tty,
+		Privileged:   cfg.Privileged,
+		AttachStdin:  cfg.Stdin,
+		AttachStdout: true,
+		AttachStderr: !cfg.Tty,
+	})
+	if err != nil {
+		return toGRPCError(err)
+	}
+
+	w := &execStreamWriter{stream: stream}
+	if err := w.send(&v1alpha1.ExecResponse{ExecId: execID}); err != nil {
+		return err
+	}
+
+	attach := &streams.AttachConfig{
+		Terminal:  cfg.Tty,
+		UseStdout: true,
+		Stdout:    &execOutput{w: w},
+	}
+	if !cfg.Tty {
+		attach.UseStderr, attach.Stderr = true, &execOutput{w: w, stderr: true}
+	}
+
+	var stdinW *io.PipeWriter
+	if cfg.Stdin {
+		var stdinR *io.PipeReader
+		stdinR, stdinW = io.Pipe()
+		attach.UseStdin, attach.Stdin = true, stdinR
+
+		// NOTE: the input is not consumed after the process exits, close
+		// the reader so that the writing of stdin is not blocked.
+		defer stdinR.Close()
+	}
+
+	go s.recvLoop(ctx, cancel, stream, execID, stdinW)
+
+	if err := s.containerMgr.StartExec(ctx, execID, attach, 0); err != nil {
+		return toGRPCError(err)
+	}
+
+	execInfo, err := s.containerMgr.InspectExec(ctx, execID)
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return w.send(&v1alpha1.ExecResponse{Exited: true, ExitCode: int32(execInfo.ExitCode)})
+}
+
+// recvLoop handles the input of stdin and the resizing of tty from client.
+// The stdin is closed if the client closes the sending direction, and the
+// exec is cancelled if the stream is broken.
+func (s *execService) recvLoop(ctx context.Context, cancel context.CancelFunc, stream v1alpha1.ExecService_ExecServer, execID string, stdinW *io.PipeWriter) {
+	closeStdin := func() {
+		if stdinW != nil {
+			stdinW.Close()
+			stdinW = nil
+		}
+	}
+	defer closeStdin()
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				cancel()
+			}
+			return
+		}
+
+		if size := req.GetResize(); size != nil {
+			if err := s.containerMgr.ResizeExec(ctx, execID, types.ResizeOptions{
+				Height: int64(size.Height),
+				Width:  int64(size.Width),
+			}); err != nil {
+				log.With(ctx).Warnf("failed to resize tty of exec %s: %v", execID, err)
+			}
+		}
+
+		if len(req.Stdin) > 0 && stdinW != nil {
+			if _, err := stdinW.Write(req.Stdin); err != nil {
+				log.With(ctx).Warnf("failed to write stdin of exec %s: %v", execID, err)
+				stdinW = nil
+			}
+		}
+
+		if req.CloseStdin {
+			closeStdin()
+		}
+	}
+}
+
+// execStreamWriter serializes the sending of responses, since the stream
+// can't be written by multiple goroutines.
+type execStreamWriter struct {
+	sync.Mutex
+	stream v1alpha1.ExecService_ExecServer
+}
+
+func (w *execStreamWriter) send(resp *v1alpha1.ExecResponse) error {
+	w.Lock()
+	defer w.Unlock()
+	return w.stream.Send(resp)
+}
+
+// execOutput writes the stdout or stderr of process to the stream.
+type execOutput struct {
+	w      *execStreamWriter
+	stderr bool
+}
+
+// Write implements the io.Writer interface.
+func (o *execOutput) Write(p []byte) (int, error) {
+	resp := &v1alpha1.ExecResponse{}
+	if o.stderr {
+		resp.Stderr = p
+	} else {
+		resp.Stdout = p
+	}
+
+	if err := o.w.send(resp); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// toGRPCError converts the error of managers into grpc status error.
+func toGRPCError(err error) error {
+	if httpErr, ok := err.(httputils.HTTPError); ok && httpErr.Code() == http.StatusForbidden {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	code := codes.Unknown
+	switch {
+	case errtypes.IsNotfound(err):
+		code = codes.NotFound
+	case errtypes.IsInvalidParam(err):
+		code = codes.InvalidArgument
+	case errtypes.IsAlreadyExisted(err):
+		code = codes.AlreadyExists
+	case errtypes.IsConflict(err), errtypes.IsInUse(err):
+		code = codes.FailedPrecondition
+	}
+	return status.Error(code, err.Error())
+}
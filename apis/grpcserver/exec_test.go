@@ -0,0 +1,151 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/grpc/v1alpha1"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/streams"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockContainerMgr runs the exec process by echoing the stdin to stdout.
+type mockContainerMgr struct {
+	mgr.ContainerMgr
+
+	config   *types.ExecCreateConfig
+	resizeCh chan types.ResizeOptions
+}
+
+func (m *mockContainerMgr) CreateExec(ctx context.Context, name string, config *types.ExecCreateConfig) (string, error) {
+	if name != "c1" {
+		return "", errors.Wrapf(errtypes.ErrNotfound, "container %s", name)
+	}
+	m.config = config
+	return "exec1", nil
+}
+
+func (m *mockContainerMgr) StartExec(ctx context.Context, execid string, cfg *streams.AttachConfig, timeout int) error {
+	cfg.Stderr.Write([]byte("started"))
+	if cfg.UseStdin {
+		io.Copy(cfg.Stdout, cfg.Stdin)
+	}
+	return nil
+}
+
+func (m *mockContainerMgr) InspectExec(ctx context.Context, execid string) (*types.ContainerExecInspect, error) {
+	return &types.ContainerExecInspect{ID: execid, ExitCode: 3}, nil
+}
+
+func (m *mockContainerMgr) ResizeExec(ctx context.Context, execid string, opts types.ResizeOptions) error {
+	m.resizeCh <- opts
+	return nil
+}
+
+func newTestClient(t *testing.T, s *Server) (v1alpha1.ExecServiceClient, func()) {
+	dir, err := ioutil.TempDir("", "grpcserver")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+
+	socket := filepath.Join(dir, "pouchd-grpc.sock")
+	s.Config = &config.Config{GRPCListen: []string{"unix://" + socket}}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unexpected error during start grpc server: %v", err)
+	}
+
+	conn, err := grpc.Dial(socket, grpc.WithInsecure(), grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+		return net.DialTimeout("unix", addr, timeout)
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error during dial grpc server: %v", err)
+	}
+
+	return v1alpha1.NewExecServiceClient(conn), func() {
+		conn.Close()
+		s.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestExec(t *testing.T) {
+	m := &mockContainerMgr{resizeCh: make(chan types.ResizeOptions, 1)}
+	client, cleanup := newTestClient(t, &Server{ContainerMgr: m})
+	defer cleanup()
+
+	stream, err := client.Exec(context.Background())
+	assert.NoError(t, err)
+
+	assert.NoError(t, stream.Send(&v1alpha1.ExecRequest{
+		Config: &v1alpha1.ExecConfig{Container: "c1", Cmd: []string{"cat"}, Stdin: true},
+	}))
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "exec1", resp.ExecId)
+	assert.Equal(t, []string{"cat"}, m.config.Cmd)
+	assert.True(t, m.config.AttachStdin && m.config.AttachStdout && m.config.AttachStderr)
+
+	assert.NoError(t, stream.Send(&v1alpha1.ExecRequest{Resize: &v1alpha1.TtySize{Height: 24, Width: 80}}))
+	select {
+	case opts := <-m.resizeCh:
+		assert.Equal(t, types.ResizeOptions{Height: 24, Width: 80}, opts)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected the tty of exec to be resized")
+	}
+
+	assert.NoError(t, stream.Send(&v1alpha1.ExecRequest{Stdin: []byte("hello"), CloseStdin: true}))
+
+	var stdout, stderr []byte
+	for {
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		if resp.Exited {
+			assert.Equal(t, int32(3), resp.ExitCode)
+			break
+		}
+		stdout = append(stdout, resp.Stdout...)
+		stderr = append(stderr, resp.Stderr...)
+	}
+	assert.Equal(t, "hello", string(stdout))
+	assert.Equal(t, "started", string(stderr))
+
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestExecWithInvalidRequest(t *testing.T) {
+	client, cleanup := newTestClient(t, &Server{ContainerMgr: &mockContainerMgr{}})
+	defer cleanup()
+
+	for _, tc := range []struct {
+		req  *v1alpha1.ExecRequest
+		code codes.Code
+	}{
+		{req: &v1alpha1.ExecRequest{Stdin: []byte("hello")}, code: codes.InvalidArgument},
+		{req: &v1alpha1.ExecRequest{Config: &v1alpha1.ExecConfig{Container: "c1"}}, code: codes.InvalidArgument},
+		{req: &v1alpha1.ExecRequest{Config: &v1alpha1.ExecConfig{Container: "c2", Cmd: []string{"sh"}}}, code: codes.NotFound},
+	} {
+		stream, err := client.Exec(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(tc.req))
+
+		_, err = stream.Recv()
+		assert.Equal(t, tc.code, status.Code(err), "unexpected error %v for request %v", err, tc.req)
+	}
+}
@@ -0,0 +1,87 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/randomid"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// streamInterceptor authorizes the stream calls by the same plugins as the
+// http server, and records them in the audit log. All the grpc calls are
+// state-changing, so they are always audited.
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	ctx := ss.Context()
+	user, authNMethod := requestUser(ctx)
+
+	if s.AuditLogger.Enabled() {
+		defer func(start time.Time) {
+			s.AuditLogger.Log(newAuditEntry(ctx, info.FullMethod, user, start, err))
+		}(time.Now())
+	}
+
+	// the handler is not run if the call is denied by any authorization
+	// plugin.
+	if err = s.Authorizer.Authorize(&authz.Request{
+		User:            user,
+		UserAuthNMethod: authNMethod,
+		RequestMethod:   http.MethodPost,
+		RequestURI:      info.FullMethod,
+		RequestPath:     info.FullMethod,
+	}); err != nil {
+		log.With(ctx).Warnf("grpc call %s of user %q is not authorized: %v", info.FullMethod, user, err)
+		err = toGRPCError(err)
+		return err
+	}
+
+	err = handler(srv, ss)
+	return err
+}
+
+// requestUser returns the user of call and how it is identified. The common
+// name of client certificate takes precedence over the metadata of UserHeader,
+// which is the same as the http server.
+func requestUser(ctx context.Context) (user, authNMethod string) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			return tlsInfo.State.PeerCertificates[0].Subject.CommonName, authz.AuthNMethodTLS
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if users := md.Get(authz.UserHeader); len(users) > 0 && users[0] != "" {
+			return users[0], authz.AuthNMethodHeader
+		}
+	}
+	return "", ""
+}
+
+// newAuditEntry records the grpc call and its result in the audit entry, the
+// full method name of call is recorded as the path.
+func newAuditEntry(ctx context.Context, fullMethod, user string, start time.Time, err error) *audit.Entry {
+	entry := &audit.Entry{
+		Time:      start,
+		RequestID: randomid.Generate()[:10],
+		User:      user,
+		Method:    http.MethodPost,
+		Path:      fullMethod,
+		Result:    audit.ResultSuccess,
+		Duration:  time.Since(start).Seconds(),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.Client = p.Addr.String()
+	}
+	if err != nil {
+		entry.Result = audit.ResultFailure
+		entry.Error = err.Error()
+	}
+	return entry
+}
@@ -0,0 +1,101 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/grpc/v1alpha1"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAuthzPlugin records the requests and allows them or not.
+type fakeAuthzPlugin struct {
+	allow    bool
+	requests []*authz.Request
+}
+
+func (p *fakeAuthzPlugin) Name() string {
+	return "fake"
+}
+
+func (p *fakeAuthzPlugin) AuthZRequest(req *authz.Request) (*authz.Response, error) {
+	p.requests = append(p.requests, req)
+	return &authz.Response{Allow: p.allow, Msg: "exec is not allowed"}, nil
+}
+
+func TestExecAuthorizedAndAudited(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpcserver-audit")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	auditFile := filepath.Join(dir, "audit.log")
+	auditLogger, err := audit.New([]string{"file://" + auditFile})
+	assert.NoError(t, err)
+	defer auditLogger.Close()
+
+	for _, allow := range []bool{false, true} {
+		m := &mockContainerMgr{}
+		plugin := &fakeAuthzPlugin{allow: allow}
+		client, cleanup := newTestClient(t, &Server{
+			ContainerMgr: m,
+			Authorizer:   authz.NewAuthorizer(plugin),
+			AuditLogger:  auditLogger,
+		})
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), authz.UserHeader, "alice")
+		stream, err := client.Exec(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&v1alpha1.ExecRequest{
+			Config: &v1alpha1.ExecConfig{Container: "c1", Cmd: []string{"sh"}, Privileged: true},
+		}))
+
+		resp, err := stream.Recv()
+		if allow {
+			assert.NoError(t, err)
+			assert.Equal(t, "exec1", resp.ExecId)
+			assert.NotNil(t, m.config)
+		} else {
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Nil(t, m.config, "exec should not be created if it is denied")
+		}
+
+		// drain the stream so that the call is finished and audited.
+		for err == nil {
+			_, err = stream.Recv()
+		}
+		cleanup()
+
+		assert.Equal(t, 1, len(plugin.requests))
+		assert.Equal(t, &authz.Request{
+			User:            "alice",
+			UserAuthNMethod: authz.AuthNMethodHeader,
+			RequestMethod:   "POST",
+			RequestURI:      "/pouch.v1alpha1.ExecService/Exec",
+			RequestPath:     "/pouch.v1alpha1.ExecService/Exec",
+		}, plugin.requests[0])
+	}
+
+	data, err := ioutil.ReadFile(auditFile)
+	assert.NoError(t, err)
+
+	var results []string
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		var entry audit.Entry
+		assert.NoError(t, json.Unmarshal(line, &entry))
+		assert.Equal(t, "alice", entry.User)
+		assert.Equal(t, "/pouch.v1alpha1.ExecService/Exec", entry.Path)
+		results = append(results, entry.Result)
+	}
+	assert.Equal(t, []string{audit.ResultFailure, audit.ResultSuccess}, results)
+}
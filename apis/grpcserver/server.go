@@ -0,0 +1,78 @@
+package grpcserver
+
+import (
+	"net"
+
+	"github.com/alibaba/pouch/apis/grpc/v1alpha1"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/netutils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Server is a grpc server which serves the streaming apis alongside the http
+// server, for example, the interactive exec for programmatic clients.
+type Server struct {
+	Config       *config.Config
+	ContainerMgr mgr.ContainerMgr
+
+	// Authorizer and AuditLogger are shared with the http server, so that
+	// the grpc calls are authorized and audited as the http requests.
+	Authorizer  *authz.Authorizer
+	AuditLogger *audit.Logger
+
+	server *grpc.Server
+}
+
+// Start listens to the grpc addresses and serves in background. The error is
+// returned if it fails to listen to any address.
+func (s *Server) Start() error {
+	opts := []grpc.ServerOption{grpc.StreamInterceptor(s.streamInterceptor)}
+	if s.Config.TLS.Key != "" && s.Config.TLS.Cert != "" {
+		tlsConfig, err := httputils.NewServerTLSConfig(httputils.ServerTLSOptions{
+			Key:          s.Config.TLS.Key,
+			Cert:         s.Config.TLS.Cert,
+			CA:           s.Config.TLS.CA,
+			VerifyRemote: s.Config.TLS.VerifyRemote,
+			MinVersion:   s.Config.TLS.MinVersion,
+			CipherSuites: s.Config.TLS.CipherSuites,
+		})
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s.server = grpc.NewServer(opts...)
+	v1alpha1.RegisterExecServiceServer(s.server, &execService{containerMgr: s.ContainerMgr})
+
+	for _, one := range s.Config.GRPCListen {
+		l, err := netutils.GetListener(one, nil)
+		if err != nil {
+			// close the listeners which have been served.
+			s.server.Stop()
+			return err
+		}
+		log.With(nil).Infof("start to listen to %s for grpc server", one)
+
+		go func(l net.Listener) {
+			if err := s.server.Serve(l); err != nil {
+				log.With(nil).Errorf("failed to serve grpc server on %s: %v", l.Addr(), err)
+			}
+		}(l)
+	}
+	return nil
+}
+
+// Stop closes all the listeners and the connections of grpc server.
+func (s *Server) Stop() {
+	if s.server != nil {
+		s.server.Stop()
+	}
+}
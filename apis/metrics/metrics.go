@@ -37,6 +37,18 @@ var (
 	// ImageActionsTimer records the time cost of each image action.
 	ImageActionsTimer = metrics.NewLabelTimer(subsystemPouch, "image_actions", "The number of seconds it takes to process each image action", "action")
 
+	// ExecActionsCounter records the number of exec operations.
+	ExecActionsCounter = metrics.NewLabelCounter(subsystemPouch, "exec_actions_counter", "The number of exec operations", "action")
+
+	// ExecSuccessActionsCounter records the number of exec success operations.
+	ExecSuccessActionsCounter = metrics.NewLabelCounter(subsystemPouch, "exec_success_actions_counter", "The number of exec success operations", "action")
+
+	// ContainerStatesGauge records the number of containers in each state.
+	ContainerStatesGauge = metrics.NewLabelGaugeWithUnit(subsystemPouch, "container_states", "The number of containers in each state", metrics.Unit("containers"), "state")
+
+	// APIRequestTimer records the latency of each api route.
+	APIRequestTimer = metrics.NewLabelTimer(subsystemPouch, "api_request", "The number of seconds it takes to process each api request", "method", "route")
+
 	// EngineVersion records the version and commit information of the engine process.
 	EngineVersion = metrics.NewLabelGauge(subsystemPouch, "engine", "The version and commit information of the engine process", "commit", "version", "kernel")
 )
@@ -57,5 +69,9 @@ func Register() {
 		registry.MustRegister(ImageSuccessActionsCounter)
 		registry.MustRegister(ContainerActionsTimer)
 		registry.MustRegister(ImageActionsTimer)
+		registry.MustRegister(ExecActionsCounter)
+		registry.MustRegister(ExecSuccessActionsCounter)
+		registry.MustRegister(ContainerStatesGauge)
+		registry.MustRegister(APIRequestTimer)
 	})
 }
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ClusterStoreOpts defines the options of cluster store.
+type ClusterStoreOpts struct {
+	values *map[string]string
+}
+
+// NewClusterStoreOpts initials a ClusterStoreOpts struct
+func NewClusterStoreOpts(opts *map[string]string) *ClusterStoreOpts {
+	if opts == nil {
+		opts = &map[string]string{}
+	}
+
+	if *opts == nil {
+		*opts = map[string]string{}
+	}
+
+	return &ClusterStoreOpts{values: opts}
+}
+
+// Set implement ClusterStoreOpts as pflag.Value interface
+func (o *ClusterStoreOpts) Set(val string) error {
+	splits := strings.SplitN(val, "=", 2)
+	if len(splits) != 2 || splits[0] == "" {
+		return fmt.Errorf("invalid cluster store option %s, correct format must be key=value", val)
+	}
+
+	(*o.values)[splits[0]] = splits[1]
+	return nil
+}
+
+// String implement ClusterStoreOpts as pflag.Value interface
+func (o *ClusterStoreOpts) String() string {
+	var str []string
+	for k, v := range *o.values {
+		str = append(str, k+"="+v)
+	}
+	sort.Strings(str)
+
+	return fmt.Sprintf("%v", str)
+}
+
+// Type implement ClusterStoreOpts as pflag.Value interface
+func (o *ClusterStoreOpts) Type() string {
+	return "map"
+}
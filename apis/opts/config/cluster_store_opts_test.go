@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterStoreOpts(t *testing.T) {
+	var values map[string]string
+	opts := NewClusterStoreOpts(&values)
+
+	assert.NoError(t, opts.Set("kv.path=pouch/nodes"))
+	assert.NoError(t, opts.Set("discovery.ttl=30"))
+	assert.NoError(t, opts.Set("kv.keyfile="))
+	assert.Equal(t, map[string]string{"kv.path": "pouch/nodes", "discovery.ttl": "30", "kv.keyfile": ""}, values)
+	assert.Equal(t, "[discovery.ttl=30 kv.keyfile= kv.path=pouch/nodes]", opts.String())
+
+	for _, val := range []string{"", "kv.path", "=pouch"} {
+		assert.Error(t, opts.Set(val), val)
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	units "github.com/docker/go-units"
+)
+
+// DefaultUlimitOpts defines the default ulimits of containers keyed by
+// their names.
+type DefaultUlimitOpts struct {
+	values *map[string]*units.Ulimit
+}
+
+// NewDefaultUlimitOpts initials a DefaultUlimitOpts struct
+func NewDefaultUlimitOpts(opts *map[string]*units.Ulimit) *DefaultUlimitOpts {
+	if opts == nil {
+		opts = &map[string]*units.Ulimit{}
+	}
+
+	if *opts == nil {
+		*opts = map[string]*units.Ulimit{}
+	}
+
+	return &DefaultUlimitOpts{values: opts}
+}
+
+// Set implement DefaultUlimitOpts as pflag.Value interface
+func (o *DefaultUlimitOpts) Set(val string) error {
+	ul, err := units.ParseUlimit(val)
+	if err != nil {
+		return err
+	}
+
+	(*o.values)[ul.Name] = ul
+	return nil
+}
+
+// String implement DefaultUlimitOpts as pflag.Value interface
+func (o *DefaultUlimitOpts) String() string {
+	var str []string
+	for _, ul := range *o.values {
+		str = append(str, ul.String())
+	}
+	sort.Strings(str)
+
+	return fmt.Sprintf("%v", str)
+}
+
+// Type implement DefaultUlimitOpts as pflag.Value interface
+func (o *DefaultUlimitOpts) Type() string {
+	return "ulimit"
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+
+	units "github.com/docker/go-units"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultUlimitOpts(t *testing.T) {
+	var values map[string]*units.Ulimit
+	opts := NewDefaultUlimitOpts(&values)
+
+	assert.NoError(t, opts.Set("nofile=1024:65536"))
+	assert.NoError(t, opts.Set("nproc=4096"))
+	assert.NoError(t, opts.Set("nofile=65536:65536"))
+	assert.Equal(t, map[string]*units.Ulimit{
+		"nofile": {Name: "nofile", Soft: 65536, Hard: 65536},
+		"nproc":  {Name: "nproc", Soft: 4096, Hard: 4096},
+	}, values)
+	assert.Equal(t, "[nofile=65536:65536 nproc=4096:4096]", opts.String())
+
+	for _, val := range []string{"", "nofile", "foo=1024", "nofile=2048:1024", "nofile=a:b"} {
+		assert.Error(t, opts.Set(val), val)
+	}
+}
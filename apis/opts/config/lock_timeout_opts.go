@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LockTimeoutOpts defines the timeouts in seconds to acquire the container
+// lock keyed by the operations.
+type LockTimeoutOpts struct {
+	values *map[string]int
+}
+
+// NewLockTimeoutOpts initials a LockTimeoutOpts struct
+func NewLockTimeoutOpts(opts *map[string]int) *LockTimeoutOpts {
+	if opts == nil {
+		opts = &map[string]int{}
+	}
+
+	if *opts == nil {
+		*opts = map[string]int{}
+	}
+
+	return &LockTimeoutOpts{values: opts}
+}
+
+// Set implement LockTimeoutOpts as pflag.Value interface
+func (o *LockTimeoutOpts) Set(val string) error {
+	splits := strings.SplitN(val, "=", 2)
+	if len(splits) != 2 || splits[0] == "" {
+		return fmt.Errorf("invalid container lock timeout %s, correct format must be operation=timeout", val)
+	}
+
+	timeout, err := strconv.Atoi(splits[1])
+	if err != nil || timeout < 0 {
+		return fmt.Errorf("invalid container lock timeout %s, timeout must be a non-negative integer", val)
+	}
+
+	(*o.values)[splits[0]] = timeout
+	return nil
+}
+
+// String implement LockTimeoutOpts as pflag.Value interface
+func (o *LockTimeoutOpts) String() string {
+	var str []string
+	for k, v := range *o.values {
+		str = append(str, k+"="+strconv.Itoa(v))
+	}
+	sort.Strings(str)
+
+	return fmt.Sprintf("%v", str)
+}
+
+// Type implement LockTimeoutOpts as pflag.Value interface
+func (o *LockTimeoutOpts) Type() string {
+	return "map"
+}
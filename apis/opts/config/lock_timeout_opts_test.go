@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockTimeoutOpts(t *testing.T) {
+	var values map[string]int
+	opts := NewLockTimeoutOpts(&values)
+
+	assert.NoError(t, opts.Set("default=30"))
+	assert.NoError(t, opts.Set("stats=5"))
+	assert.NoError(t, opts.Set("destroy=0"))
+	assert.Equal(t, map[string]int{"default": 30, "stats": 5, "destroy": 0}, values)
+	assert.Equal(t, "[default=30 destroy=0 stats=5]", opts.String())
+
+	for _, val := range []string{"", "stats", "=5", "stats=fast", "stats=-1"} {
+		assert.Error(t, opts.Set(val), val)
+	}
+}
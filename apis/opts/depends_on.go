@@ -0,0 +1,32 @@
+package opts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ParseDependsOn parses the dependencies of container, the format of
+// dependency is <container>[:<condition>].
+func ParseDependsOn(dependsOn []string) ([]*types.ContainerDependency, error) {
+	var deps []*types.ContainerDependency
+	for _, d := range dependsOn {
+		fields := strings.SplitN(d, ":", 2)
+		if fields[0] == "" {
+			return nil, fmt.Errorf("invalid dependency %q, format should be <container>[:<condition>]", d)
+		}
+
+		dep := &types.ContainerDependency{Name: fields[0]}
+		if len(fields) == 2 {
+			switch fields[1] {
+			case "started", "healthy":
+				dep.Condition = fields[1]
+			default:
+				return nil, fmt.Errorf("invalid condition %q of dependency %s, should be started or healthy", fields[1], fields[0])
+			}
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
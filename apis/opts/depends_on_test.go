@@ -0,0 +1,28 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDependsOn(t *testing.T) {
+	deps, err := ParseDependsOn(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, deps)
+
+	deps, err = ParseDependsOn([]string{"db", "cache:healthy", "queue:started"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.ContainerDependency{
+		{Name: "db"},
+		{Name: "cache", Condition: "healthy"},
+		{Name: "queue", Condition: "started"},
+	}, deps)
+
+	for _, input := range []string{"", ":healthy", "db:running"} {
+		_, err := ParseDependsOn([]string{input})
+		assert.Error(t, err, input)
+	}
+}
@@ -45,6 +45,18 @@ func TestParseDeviceMapping(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name: "deviceMappingCDI",
+			args: args{
+				device: []string{"vendor.com/gpu=gpu0:0"},
+			},
+			want: []*types.DeviceMapping{{
+				PathOnHost:        "vendor.com/gpu=gpu0:0",
+				PathInContainer:   "vendor.com/gpu=gpu0:0",
+				CgroupPermissions: "rwm",
+			}},
+			wantErr: false,
+		},
 		{
 			name: "deviceMappingWrong1",
 			args: args{
@@ -0,0 +1,37 @@
+package opts
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// HostGateway is the special address of extra host resolved to the address
+// of host, which is the gateway of the default bridge network.
+const HostGateway = "host-gateway"
+
+// ParseExtraHost parses the extra host of container in format of
+// <host>:<ip>, the ip could be IPv6 or host-gateway.
+func ParseExtraHost(extraHost string) (string, string, error) {
+	// allow IPv6 addresses in extra hosts; only split on first ":".
+	fields := strings.SplitN(extraHost, ":", 2)
+	if len(fields) != 2 || fields[0] == "" || strings.ContainsAny(fields[0], " \t") {
+		return "", "", fmt.Errorf("invalid extra host %s: extra host must be in format of host:ip", extraHost)
+	}
+
+	host, ip := fields[0], fields[1]
+	if ip != HostGateway && net.ParseIP(ip) == nil {
+		return "", "", fmt.Errorf("invalid extra host %s: %s is not an IP address or %s", extraHost, ip, HostGateway)
+	}
+	return host, ip, nil
+}
+
+// ValidateExtraHosts validates the extra hosts of container.
+func ValidateExtraHosts(extraHosts []string) error {
+	for _, extraHost := range extraHosts {
+		if _, _, err := ParseExtraHost(extraHost); err != nil {
+			return err
+		}
+	}
+	return nil
+}
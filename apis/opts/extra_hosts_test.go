@@ -0,0 +1,34 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExtraHost(t *testing.T) {
+	for _, tc := range []struct {
+		extraHost string
+		host, ip  string
+	}{
+		{"foo:10.0.0.1", "foo", "10.0.0.1"},
+		{"foo:2001:db8::1", "foo", "2001:db8::1"},
+		{"host.docker.internal:host-gateway", "host.docker.internal", HostGateway},
+	} {
+		host, ip, err := ParseExtraHost(tc.extraHost)
+		assert.NoError(t, err, tc.extraHost)
+		assert.Equal(t, tc.host, host)
+		assert.Equal(t, tc.ip, ip)
+	}
+
+	for _, extraHost := range []string{"", "foo", ":10.0.0.1", "foo:bar", "foo bar:10.0.0.1", "foo:"} {
+		_, _, err := ParseExtraHost(extraHost)
+		assert.Error(t, err, extraHost)
+	}
+}
+
+func TestValidateExtraHosts(t *testing.T) {
+	assert.NoError(t, ValidateExtraHosts(nil))
+	assert.NoError(t, ValidateExtraHosts([]string{"foo:10.0.0.1", "bar:host-gateway"}))
+	assert.Error(t, ValidateExtraHosts([]string{"foo:10.0.0.1", "bar"}))
+}
@@ -0,0 +1,80 @@
+package opts
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ParseGPUs parses the GPU request of container, the format of value is
+// all, the number of GPUs, or the comma-separated options like
+// "count=2,capabilities=compute;utility" and "\"device=0,1\"".
+func ParseGPUs(value string) (*types.DeviceRequest, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	req := &types.DeviceRequest{}
+	switch {
+	case value == "all":
+		req.Count = -1
+	case isGPUCount(value):
+		count, _ := strconv.ParseInt(value, 10, 64)
+		req.Count = count
+	default:
+		r := csv.NewReader(strings.NewReader(value))
+		fields, err := r.Read()
+		if err != nil {
+			return nil, fmt.Errorf("invalid gpus %q: %v", value, err)
+		}
+
+		var capabilities []string
+		for _, field := range fields {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 || parts[1] == "" {
+				return nil, fmt.Errorf("invalid gpus option %q, should be key=value", field)
+			}
+
+			switch key, val := parts[0], parts[1]; key {
+			case "count":
+				if val == "all" {
+					req.Count = -1
+					continue
+				}
+				if !isGPUCount(val) {
+					return nil, fmt.Errorf("invalid gpus count %q, should be all or a positive number", val)
+				}
+				req.Count, _ = strconv.ParseInt(val, 10, 64)
+			case "device":
+				req.DeviceIDs = strings.Split(val, ",")
+			case "driver":
+				req.Driver = val
+			case "capabilities":
+				capabilities = strings.Split(val, ";")
+			default:
+				return nil, fmt.Errorf("unknown gpus option %q", key)
+			}
+		}
+
+		if req.Count != 0 && len(req.DeviceIDs) != 0 {
+			return nil, fmt.Errorf("invalid gpus %q, count and device cannot be used together", value)
+		}
+		if req.Count == 0 && len(req.DeviceIDs) == 0 {
+			req.Count = -1
+		}
+		req.Capabilities = [][]string{append([]string{"gpu"}, capabilities...)}
+		return req, nil
+	}
+
+	req.Capabilities = [][]string{{"gpu"}}
+	return req, nil
+}
+
+// isGPUCount returns whether the value is a positive number.
+func isGPUCount(value string) bool {
+	count, err := strconv.ParseInt(value, 10, 64)
+	return err == nil && count > 0
+}
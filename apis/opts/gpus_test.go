@@ -0,0 +1,39 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGPUs(t *testing.T) {
+	req, err := ParseGPUs("")
+	assert.NoError(t, err)
+	assert.Nil(t, req)
+
+	for value, expected := range map[string]*types.DeviceRequest{
+		"all": {Count: -1, Capabilities: [][]string{{"gpu"}}},
+		"2":   {Count: 2, Capabilities: [][]string{{"gpu"}}},
+		"count=1,driver=nvidia": {
+			Count:        1,
+			Driver:       "nvidia",
+			Capabilities: [][]string{{"gpu"}},
+		},
+		`"device=0,GPU-fef8089b",capabilities=compute;utility`: {
+			DeviceIDs:    []string{"0", "GPU-fef8089b"},
+			Capabilities: [][]string{{"gpu", "compute", "utility"}},
+		},
+		"driver=nvidia": {Count: -1, Driver: "nvidia", Capabilities: [][]string{{"gpu"}}},
+	} {
+		req, err := ParseGPUs(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, req, value)
+	}
+
+	for _, value := range []string{"0", "-1", "count=0", "count=a", "foo=bar", "driver", `count=1,"device=0"`} {
+		_, err := ParseGPUs(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,47 @@
+package opts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ParseHealthcheck parses the healthcheck of container, nil means the
+// healthcheck of image is inherited.
+func ParseHealthcheck(cmd string, interval, timeout, startPeriod time.Duration, retries int64, disable bool) (*types.HealthConfig, error) {
+	if disable {
+		if cmd != "" || interval != 0 || timeout != 0 || startPeriod != 0 || retries != 0 {
+			return nil, fmt.Errorf("--no-healthcheck conflicts with --health-* options")
+		}
+		return &types.HealthConfig{Test: []string{"NONE"}}, nil
+	}
+
+	if interval < 0 {
+		return nil, fmt.Errorf("--health-interval cannot be negative")
+	}
+	if timeout < 0 {
+		return nil, fmt.Errorf("--health-timeout cannot be negative")
+	}
+	if startPeriod < 0 {
+		return nil, fmt.Errorf("--health-start-period cannot be negative")
+	}
+	if retries < 0 {
+		return nil, fmt.Errorf("--health-retries cannot be negative")
+	}
+
+	if cmd == "" && interval == 0 && timeout == 0 && startPeriod == 0 && retries == 0 {
+		return nil, nil
+	}
+
+	hc := &types.HealthConfig{
+		Interval:    int64(interval),
+		Timeout:     int64(timeout),
+		StartPeriod: int64(startPeriod),
+		Retries:     retries,
+	}
+	if cmd != "" {
+		hc.Test = []string{"CMD-SHELL", cmd}
+	}
+	return hc, nil
+}
@@ -0,0 +1,70 @@
+package opts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHealthcheck(t *testing.T) {
+	type TestCase struct {
+		cmd         string
+		interval    time.Duration
+		timeout     time.Duration
+		startPeriod time.Duration
+		retries     int64
+		disable     bool
+		expected    *types.HealthConfig
+		hasError    bool
+	}
+
+	for _, testCase := range []TestCase{
+		{
+			expected: nil,
+		},
+		{
+			cmd:      "curl -f http://localhost/",
+			interval: 5 * time.Second,
+			retries:  2,
+			expected: &types.HealthConfig{
+				Test:     []string{"CMD-SHELL", "curl -f http://localhost/"},
+				Interval: int64(5 * time.Second),
+				Retries:  2,
+			},
+		},
+		{
+			timeout: time.Second,
+			expected: &types.HealthConfig{
+				Timeout: int64(time.Second),
+			},
+		},
+		{
+			disable:  true,
+			expected: &types.HealthConfig{Test: []string{"NONE"}},
+		},
+		{
+			cmd:      "true",
+			disable:  true,
+			hasError: true,
+		},
+		{
+			interval: -time.Second,
+			hasError: true,
+		},
+		{
+			retries:  -1,
+			hasError: true,
+		},
+	} {
+		hc, err := ParseHealthcheck(testCase.cmd, testCase.interval, testCase.timeout, testCase.startPeriod, testCase.retries, testCase.disable)
+		if testCase.hasError {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, hc)
+	}
+}
@@ -0,0 +1,44 @@
+package opts
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ParseHooks parses the OCI hooks of container in format of
+// <stage>:<path> [args...], such as "prestart:/usr/bin/setup-net --debug",
+// the path is taken as the first argument of hook.
+func ParseHooks(hooks []string) ([]*types.OCIHook, error) {
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+
+	results := make([]*types.OCIHook, 0, len(hooks))
+	for _, h := range hooks {
+		fields := strings.SplitN(h, ":", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid hook %s: it should be <stage>:<path> [args...]", h)
+		}
+
+		switch fields[0] {
+		case types.OCIHookStagePrestart, types.OCIHookStagePoststart, types.OCIHookStagePoststop:
+		default:
+			return nil, fmt.Errorf("invalid hook %s: unknown stage %s", h, fields[0])
+		}
+
+		args := strings.Fields(fields[1])
+		if len(args) == 0 || !filepath.IsAbs(args[0]) {
+			return nil, fmt.Errorf("invalid hook %s: path must be an absolute path", h)
+		}
+
+		results = append(results, &types.OCIHook{
+			Stage: fields[0],
+			Path:  args[0],
+			Args:  args,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,32 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHooks(t *testing.T) {
+	hooks, err := ParseHooks(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, hooks)
+
+	hooks, err = ParseHooks([]string{"prestart:/usr/bin/setup-net --debug", "poststop:/usr/bin/cleanup"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.OCIHook{
+		{Stage: "prestart", Path: "/usr/bin/setup-net", Args: []string{"/usr/bin/setup-net", "--debug"}},
+		{Stage: "poststop", Path: "/usr/bin/cleanup", Args: []string{"/usr/bin/cleanup"}},
+	}, hooks)
+
+	for _, input := range []string{
+		"/usr/bin/setup-net",
+		"createRuntime:/usr/bin/setup-net",
+		"prestart:",
+		"prestart:setup-net",
+	} {
+		_, err := ParseHooks([]string{input})
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,68 @@
+package opts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	units "github.com/docker/go-units"
+)
+
+// ParseHugepageLimits parses the hugepage limits of container, the format
+// is <page size>=<limit>, such as 2MB=1g.
+func ParseHugepageLimits(limits []string) ([]*types.HugepageLimit, error) {
+	var results []*types.HugepageLimit
+	seen := map[string]bool{}
+	for _, l := range limits {
+		fields := strings.SplitN(l, "=", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid hugepage limit %s: hugepage limit must be in format of <page size>=<limit>", l)
+		}
+
+		pageSize, err := parseHugepageSize(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hugepage limit %s: %v", l, err)
+		}
+		if seen[pageSize] {
+			return nil, fmt.Errorf("invalid hugepage limit %s: duplicated page size %s", l, pageSize)
+		}
+		seen[pageSize] = true
+
+		limit, err := units.RAMInBytes(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hugepage limit %s: %v", l, err)
+		}
+		if limit < 0 {
+			return nil, fmt.Errorf("invalid hugepage limit %s: limit cannot be negative", l)
+		}
+
+		results = append(results, &types.HugepageLimit{
+			PageSize: pageSize,
+			Limit:    uint64(limit),
+		})
+	}
+	return results, nil
+}
+
+// parseHugepageSize converts the page size into the format used by the
+// hugetlb cgroup, such as 64KB, 2MB and 1GB.
+func parseHugepageSize(size string) (string, error) {
+	bytes, err := units.RAMInBytes(size)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case bytes <= 0:
+		return "", fmt.Errorf("page size should be positive")
+	case bytes%units.GiB == 0:
+		return fmt.Sprintf("%dGB", bytes/units.GiB), nil
+	case bytes%units.MiB == 0:
+		return fmt.Sprintf("%dMB", bytes/units.MiB), nil
+	case bytes%units.KiB == 0:
+		return fmt.Sprintf("%dKB", bytes/units.KiB), nil
+	default:
+		return "", fmt.Errorf("page size should be a multiple of 1KB")
+	}
+}
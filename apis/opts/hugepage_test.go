@@ -0,0 +1,36 @@
+package opts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHugepageLimits(t *testing.T) {
+	limits, err := ParseHugepageLimits([]string{"2MB=1g", "1g=0", "64k=64m"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.HugepageLimit{
+		{PageSize: "2MB", Limit: 1 << 30},
+		{PageSize: "1GB", Limit: 0},
+		{PageSize: "64KB", Limit: 64 << 20},
+	}, limits)
+
+	limits, err = ParseHugepageLimits(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, limits)
+
+	for _, l := range []string{
+		"2MB",
+		"foo=1g",
+		"1000=1g",
+		"2MB=foo",
+		"2MB=-1",
+		"2MB=1g,2m=2g",
+	} {
+		_, err := ParseHugepageLimits(strings.Split(l, ","))
+		assert.Error(t, err, l)
+	}
+}
@@ -0,0 +1,48 @@
+package opts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ParseMemoryPressurePolicy parses the memory pressure policy of container,
+// the format of policy is none, restart or signal[:<signal>], and the level
+// is medium or critical.
+func ParseMemoryPressurePolicy(policy, level string) (*types.MemoryPressurePolicy, error) {
+	if policy == "" {
+		if level != "" {
+			return nil, fmt.Errorf("memory pressure level %s should be used with memory pressure policy", level)
+		}
+		return nil, nil
+	}
+
+	p := &types.MemoryPressurePolicy{}
+	fields := strings.SplitN(policy, ":", 2)
+	switch fields[0] {
+	case types.MemoryPressurePolicyActionNone, types.MemoryPressurePolicyActionRestart:
+		if len(fields) == 2 {
+			return nil, fmt.Errorf("invalid memory pressure policy %q, only action signal accepts a signal", policy)
+		}
+	case types.MemoryPressurePolicyActionSignal:
+		if len(fields) == 2 {
+			if fields[1] == "" {
+				return nil, fmt.Errorf("invalid memory pressure policy %q, signal cannot be empty", policy)
+			}
+			p.Signal = fields[1]
+		}
+	default:
+		return nil, fmt.Errorf("invalid memory pressure policy %q, should be none, restart or signal[:<signal>]", policy)
+	}
+	p.Action = fields[0]
+
+	switch level {
+	case "", types.MemoryPressurePolicyLevelMedium, types.MemoryPressurePolicyLevelCritical:
+		p.Level = level
+	default:
+		return nil, fmt.Errorf("invalid memory pressure level %q, should be medium or critical", level)
+	}
+
+	return p, nil
+}
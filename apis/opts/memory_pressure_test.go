@@ -0,0 +1,41 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMemoryPressurePolicy(t *testing.T) {
+	p, err := ParseMemoryPressurePolicy("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	for _, tc := range []struct {
+		policy   string
+		level    string
+		expected *types.MemoryPressurePolicy
+	}{
+		{"none", "", &types.MemoryPressurePolicy{Action: "none"}},
+		{"restart", "medium", &types.MemoryPressurePolicy{Action: "restart", Level: "medium"}},
+		{"signal", "", &types.MemoryPressurePolicy{Action: "signal"}},
+		{"signal:SIGUSR1", "critical", &types.MemoryPressurePolicy{Action: "signal", Signal: "SIGUSR1", Level: "critical"}},
+	} {
+		p, err := ParseMemoryPressurePolicy(tc.policy, tc.level)
+		assert.NoError(t, err, tc.policy)
+		assert.Equal(t, tc.expected, p, tc.policy)
+	}
+
+	for _, tc := range [][2]string{
+		{"", "medium"},
+		{"stop", ""},
+		{"restart:SIGKILL", ""},
+		{"signal:", ""},
+		{"restart", "low"},
+	} {
+		_, err := ParseMemoryPressurePolicy(tc[0], tc[1])
+		assert.Error(t, err, tc)
+	}
+}
@@ -92,7 +92,7 @@ func ParseBindMode(mp *types.MountPoint, mode string) error {
 		}
 	}
 
-	if defaultMode > 1 || rwMode > 1 || replaceMode > 1 || copyMode > 1 || propagationMode > 1 {
+	if defaultMode > 1 || rwMode > 1 || labelMode > 1 || replaceMode > 1 || copyMode > 1 || propagationMode > 1 {
 		return fmt.Errorf("invalid bind mode: %s", mode)
 	}
 
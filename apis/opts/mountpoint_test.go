@@ -170,15 +170,20 @@ func TestParseBindMode(t *testing.T) {
 			expectErr: nil,
 		},
 		{
-			mode: "z,Z",
+			mode: "ro,Z",
 			expectMountPoint: &types.MountPoint{
-				Mode:     "z,Z",
-				RW:       true,
+				Mode:     "ro,Z",
+				RW:       false,
 				CopyData: true,
 			},
 			err:       false,
 			expectErr: nil,
 		},
+		{
+			mode:      "z,Z",
+			err:       true,
+			expectErr: fmt.Errorf("invalid bind mode: z,Z"),
+		},
 	}
 
 	for _, p := range parseds {
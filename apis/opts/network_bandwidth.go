@@ -0,0 +1,57 @@
+package opts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	units "github.com/docker/go-units"
+)
+
+// ParseNetworkBandwidth parses the bandwidth limits of container network, the
+// format of egress and ingress limit is <rate>[:<burst>], the rate is in bytes
+// per second and the burst is in bytes, such as 10mb:1mb. The rate 0 means no
+// limit.
+func ParseNetworkBandwidth(egress, ingress string) (*types.NetworkBandwidth, error) {
+	if egress == "" && ingress == "" {
+		return nil, nil
+	}
+
+	b := &types.NetworkBandwidth{}
+	var err error
+	if b.EgressRate, b.EgressBurst, err = parseRateBurst(egress); err != nil {
+		return nil, fmt.Errorf("invalid egress bandwidth %q: %v", egress, err)
+	}
+	if b.IngressRate, b.IngressBurst, err = parseRateBurst(ingress); err != nil {
+		return nil, fmt.Errorf("invalid ingress bandwidth %q: %v", ingress, err)
+	}
+	return b, nil
+}
+
+func parseRateBurst(limit string) (rate int64, burst int64, err error) {
+	if limit == "" {
+		return 0, 0, nil
+	}
+
+	fields := strings.SplitN(limit, ":", 2)
+	if rate, err = units.RAMInBytes(fields[0]); err != nil {
+		return 0, 0, err
+	}
+	if rate < 0 {
+		return 0, 0, fmt.Errorf("rate cannot be negative")
+	}
+
+	if len(fields) == 2 {
+		if rate == 0 {
+			return 0, 0, fmt.Errorf("burst should be used with rate")
+		}
+		if burst, err = units.RAMInBytes(fields[1]); err != nil {
+			return 0, 0, err
+		}
+		if burst <= 0 {
+			return 0, 0, fmt.Errorf("burst should be larger than 0")
+		}
+	}
+	return rate, burst, nil
+}
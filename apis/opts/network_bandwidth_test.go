@@ -0,0 +1,41 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNetworkBandwidth(t *testing.T) {
+	b, err := ParseNetworkBandwidth("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	for _, tc := range []struct {
+		egress   string
+		ingress  string
+		expected *types.NetworkBandwidth
+	}{
+		{"1mb", "", &types.NetworkBandwidth{EgressRate: 1048576}},
+		{"", "512k:64k", &types.NetworkBandwidth{IngressRate: 524288, IngressBurst: 65536}},
+		{"10m:1m", "1g", &types.NetworkBandwidth{EgressRate: 10485760, EgressBurst: 1048576, IngressRate: 1073741824}},
+		{"0", "", &types.NetworkBandwidth{}},
+	} {
+		b, err := ParseNetworkBandwidth(tc.egress, tc.ingress)
+		assert.NoError(t, err, tc.egress, tc.ingress)
+		assert.Equal(t, tc.expected, b, tc.egress, tc.ingress)
+	}
+
+	for _, tc := range [][2]string{
+		{"fast", ""},
+		{"0:1m", ""},
+		{"", "1mb:"},
+		{"", "1mb:0"},
+		{"-1mb", ""},
+	} {
+		_, err := ParseNetworkBandwidth(tc[0], tc[1])
+		assert.Error(t, err, tc)
+	}
+}
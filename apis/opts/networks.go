@@ -30,6 +30,11 @@ func ParseNetworks(networks []string) (*types.NetworkingConfig, string, error) {
 
 		if name == "container" {
 			networkMode = fmt.Sprintf("%s:%s", name, parameter)
+		} else if name == "cni" {
+			networkMode = name
+			if parameter != "" {
+				networkMode = fmt.Sprintf("%s:%s", name, parameter)
+			}
 		} else if ipaddr := net.ParseIP(parameter); ipaddr != nil {
 			networkingConfig.EndpointsConfig[name] = &types.EndpointSettings{
 				IPAddress: parameter,
@@ -46,6 +51,7 @@ func ParseNetworks(networks []string) (*types.NetworkingConfig, string, error) {
 // network format as below:
 // [network]:[ip_address], such as: mynetwork:172.17.0.2 or mynetwork(ip alloc by ipam) or 172.17.0.2(default network is bridge)
 // [network_mode]:[parameter], such as: host(use host network) or container:containerID(use exist container network)
+// or cni:net1,net2(use the CNI networks, cni only for the default CNI network)
 // [network_mode]:[parameter]:mode, such as: mynetwork:172.17.0.2:mode(if the container has multi-networks, the network is the default network mode)
 func parseNetwork(network string) (string, string, string, error) {
 	var (
@@ -66,7 +72,7 @@ func parseNetwork(network string) (string, string, string, error) {
 		}
 	case 2:
 		name = arr[0]
-		if name == "container" {
+		if name == "container" || name == "cni" {
 			parameter = arr[1]
 		} else if ipaddr := net.ParseIP(arr[1]); ipaddr != nil {
 			parameter = arr[1]
@@ -124,7 +130,7 @@ func SetEndpointIPAddress(nwConfig *types.NetworkingConfig, mode, ipv4, ipv6 str
 		return nil
 	}
 
-	if nwConfig.EndpointsConfig != nil {
+	if nwConfig.EndpointsConfig == nil {
 		nwConfig.EndpointsConfig = make(map[string]*types.EndpointSettings)
 	}
 
@@ -137,10 +143,31 @@ func SetEndpointIPAddress(nwConfig *types.NetworkingConfig, mode, ipv4, ipv6 str
 		epConfig.IPAMConfig = &types.EndpointIPAMConfig{}
 	}
 
-	epConfig.IPAMConfig.IPV4Address = ipv4
-	epConfig.IPAMConfig.IPV6Address = ipv6
+	// the address specified by "--net network:ip" is kept.
+	if ipv4 != "" {
+		epConfig.IPAMConfig.IPV4Address = ipv4
+	}
+	if ipv6 != "" {
+		epConfig.IPAMConfig.IPV6Address = ipv6
+	}
 
 	nwConfig.EndpointsConfig[mode] = epConfig
 
 	return nil
 }
+
+// SetEndpointAliases sets the network-scoped aliases of the endpoint in the
+// network of network mode, by which the container is resolved in the
+// user-defined network.
+func SetEndpointAliases(nwConfig *types.NetworkingConfig, mode string, aliases []string) error {
+	if nwConfig == nil || len(aliases) == 0 {
+		return nil
+	}
+
+	epConfig := nwConfig.EndpointsConfig[mode]
+	if epConfig == nil {
+		return fmt.Errorf("network-scoped alias is not supported in network mode %s", mode)
+	}
+	epConfig.Aliases = append(epConfig.Aliases, aliases...)
+	return nil
+}
@@ -62,6 +62,28 @@ func TestParseNetworks(t *testing.T) {
 			want1:   "container:e8e153651a0d",
 			wantErr: false,
 		},
+		{
+			name: "if name is 'cni' then return name and CNI networks as mode",
+			args: args{
+				networks: []string{"cni:net1,net2"},
+			},
+			want: &types.NetworkingConfig{
+				EndpointsConfig: map[string]*types.EndpointSettings{},
+			},
+			want1:   "cni:net1,net2",
+			wantErr: false,
+		},
+		{
+			name: "if name is 'cni' without networks then return cni mode",
+			args: args{
+				networks: []string{"cni"},
+			},
+			want: &types.NetworkingConfig{
+				EndpointsConfig: map[string]*types.EndpointSettings{},
+			},
+			want1:   "cni",
+			wantErr: false,
+		},
 		{
 			name: "name is not 'container'",
 			args: args{
@@ -262,6 +284,13 @@ func Test_parseNetwork(t *testing.T) {
 				network: net{name: "container", parameter: "9ca6ac", mode: ""},
 			},
 		},
+		{
+			input: "cni:net1,net2",
+			expect: result{
+				err:     nil,
+				network: net{name: "cni", parameter: "net1,net2", mode: ""},
+			},
+		},
 		{
 			input: "bridge:121.0.0.1:mode",
 			expect: result{
@@ -286,3 +315,29 @@ func Test_parseNetwork(t *testing.T) {
 		assert.Equal(t, testCase.expect.network.mode, mode)
 	}
 }
+
+func TestSetEndpointIPAddressAndAliases(t *testing.T) {
+	nwConfig, mode, err := ParseNetworks([]string{"mynet:192.168.1.5"})
+	assert.NoError(t, err)
+
+	// the address of "--net mynet:192.168.1.5" is kept.
+	assert.NoError(t, SetEndpointIPAddress(nwConfig, mode, "", "fd00::5"))
+	assert.NoError(t, SetEndpointAliases(nwConfig, mode, []string{"db", "mysql"}))
+	assert.Equal(t, &types.EndpointSettings{
+		IPAddress: "192.168.1.5",
+		IPAMConfig: &types.EndpointIPAMConfig{
+			IPV4Address: "192.168.1.5",
+			IPV6Address: "fd00::5",
+		},
+		Aliases: []string{"db", "mysql"},
+	}, nwConfig.EndpointsConfig["mynet"])
+
+	nwConfig, mode, err = ParseNetworks([]string{"mynet"})
+	assert.NoError(t, err)
+	assert.NoError(t, SetEndpointIPAddress(nwConfig, mode, "192.168.1.6", ""))
+	assert.Equal(t, "192.168.1.6", nwConfig.EndpointsConfig["mynet"].IPAMConfig.IPV4Address)
+
+	nwConfig, mode, err = ParseNetworks([]string{"container:abc"})
+	assert.NoError(t, err)
+	assert.Error(t, SetEndpointAliases(nwConfig, mode, []string{"db"}))
+}
@@ -0,0 +1,40 @@
+package opts
+
+import (
+	"fmt"
+	"strings"
+
+	units "github.com/docker/go-units"
+)
+
+// ParseStorageOpt parses the storage driver options of container in format
+// of <key>=<value>, only "size" which limits the writable layer is supported.
+func ParseStorageOpt(storageOpt []string) (map[string]string, error) {
+	if len(storageOpt) == 0 {
+		return nil, nil
+	}
+
+	results := make(map[string]string)
+	for _, opt := range storageOpt {
+		parts := strings.SplitN(opt, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid storage option %s: must be in format of <key>=<value>", opt)
+		}
+
+		key := strings.ToLower(strings.TrimSpace(parts[0]))
+		switch key {
+		case "size":
+			size, err := units.RAMInBytes(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid storage option %s: %v", opt, err)
+			}
+			if size <= 0 {
+				return nil, fmt.Errorf("invalid storage option %s: size must be positive", opt)
+			}
+		default:
+			return nil, fmt.Errorf("invalid storage option %s: unknown option %s", opt, key)
+		}
+		results[key] = parts[1]
+	}
+	return results, nil
+}
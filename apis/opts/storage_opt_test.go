@@ -0,0 +1,28 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStorageOpt(t *testing.T) {
+	storageOpt, err := ParseStorageOpt(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, storageOpt)
+
+	storageOpt, err = ParseStorageOpt([]string{"size=10G"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"size": "10G"}, storageOpt)
+
+	for _, input := range [][]string{
+		{"size"},
+		{"size="},
+		{"size=foo"},
+		{"size=0"},
+		{"foo=bar"},
+	} {
+		_, err := ParseStorageOpt(input)
+		assert.Error(t, err, "%v", input)
+	}
+}
@@ -0,0 +1,33 @@
+package opts
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ParseTmpfs parses the tmpfs mounts of container in format of
+// <destination>[:<options>], such as "/run:rw,size=64m".
+func ParseTmpfs(tmpfs []string) (map[string]string, error) {
+	if len(tmpfs) == 0 {
+		return nil, nil
+	}
+
+	results := make(map[string]string)
+	for _, t := range tmpfs {
+		fields := strings.SplitN(t, ":", 2)
+		dest := fields[0]
+		if !filepath.IsAbs(dest) {
+			return nil, fmt.Errorf("invalid tmpfs %s: destination must be an absolute path", t)
+		}
+		if _, exist := results[dest]; exist {
+			return nil, fmt.Errorf("invalid tmpfs %s: duplicate destination %s", t, dest)
+		}
+
+		results[dest] = ""
+		if len(fields) == 2 {
+			results[dest] = fields[1]
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,26 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTmpfs(t *testing.T) {
+	tmpfs, err := ParseTmpfs(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, tmpfs)
+
+	tmpfs, err = ParseTmpfs([]string{"/run:rw,size=64m", "/tmp"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"/run": "rw,size=64m", "/tmp": ""}, tmpfs)
+
+	for _, input := range [][]string{
+		{"run"},
+		{":rw"},
+		{"/run", "/run:size=64m"},
+	} {
+		_, err := ParseTmpfs(input)
+		assert.Error(t, err, "%v", input)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/alibaba/pouch/pkg/utils/filters"
 	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
 
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/go-openapi/strfmt"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
@@ -120,9 +121,23 @@ func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *
 		Driver:          c.Driver,
 		MountLabel:      c.MountLabel,
 		ProcessLabel:    c.ProcessLabel,
+		AppArmorProfile: c.AppArmorProfile,
+		SeccompProfile:  c.SeccompProfile,
+		NoNewPrivileges: c.NoNewPrivileges,
+		Capabilities:    c.Capabilities,
 		ExecIds:         c.ExecIds,
 	}
 
+	if httputils.BoolValue(req, "size") {
+		sizeRw, sizeRootFs, err := s.ContainerMgr.Size(ctx, c.ID)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get size of container %s: %v", c.ID, err)
+		} else {
+			container.SizeRw = &sizeRw
+			container.SizeRootFs = &sizeRootFs
+		}
+	}
+
 	return EncodeResponse(rw, http.StatusOK, container)
 }
 
@@ -143,6 +158,7 @@ func (s *Server) getContainers(ctx context.Context, rw http.ResponseWriter, req
 	}
 
 	containerList := make([]types.Container, 0, len(cons))
+	withSize := httputils.BoolValue(req, "size")
 
 	for _, c := range cons {
 		status, err := c.FormatStatus()
@@ -181,6 +197,13 @@ func (s *Server) getContainers(ctx context.Context, rw http.ResponseWriter, req
 			Mounts:          mounts,
 		}
 
+		if withSize {
+			singleCon.SizeRw, singleCon.SizeRootFs, err = s.ContainerMgr.Size(ctx, c.ID)
+			if err != nil {
+				log.With(ctx).Warnf("failed to get size of container %s: %v", c.ID, err)
+			}
+		}
+
 		containerList = append(containerList, singleCon)
 	}
 	return EncodeResponse(rw, http.StatusOK, containerList)
@@ -196,9 +219,16 @@ func (s *Server) startContainer(ctx context.Context, rw http.ResponseWriter, req
 	name := mux.Vars(req)["name"]
 
 	options := &types.ContainerStartOptions{
-		DetachKeys:    req.FormValue("detachKeys"),
-		CheckpointID:  req.FormValue("checkpoint"),
-		CheckpointDir: req.FormValue("checkpoint-dir"),
+		DetachKeys:     req.FormValue("detachKeys"),
+		CheckpointID:   req.FormValue("checkpoint"),
+		CheckpointDir:  req.FormValue("checkpoint-dir"),
+		TCPEstablished: httputils.BoolValue(req, "tcp-established"),
+		LazyPages:      httputils.BoolValue(req, "lazy-pages"),
+		PageServer:     req.FormValue("page-server"),
+	}
+
+	if options.LazyPages && options.PageServer == "" {
+		return httputils.NewHTTPError(fmt.Errorf("page server should be specified to restore memory pages lazily"), http.StatusBadRequest)
 	}
 
 	if err := s.ContainerMgr.Start(ctx, name, options); err != nil {
@@ -292,6 +322,46 @@ func (s *Server) unpauseContainer(ctx context.Context, rw http.ResponseWriter, r
 	return nil
 }
 
+func (s *Server) pauseContainers(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	names, filter, err := containerGroupParams(req)
+	if err != nil {
+		return err
+	}
+
+	ids, err := s.ContainerMgr.PauseContainers(ctx, names, filter)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, ids)
+}
+
+func (s *Server) unpauseContainers(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	names, filter, err := containerGroupParams(req)
+	if err != nil {
+		return err
+	}
+
+	ids, err := s.ContainerMgr.UnpauseContainers(ctx, names, filter)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, ids)
+}
+
+// containerGroupParams gets the containers specified by repeated id and the
+// filters from query.
+func containerGroupParams(req *http.Request) ([]string, map[string][]string, error) {
+	if err := req.ParseForm(); err != nil {
+		return nil, nil, httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	filter, err := filters.FromURLParam(req.Form.Get("filters"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return req.Form["id"], filter, nil
+}
+
 func (s *Server) renameContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	label := util_metrics.ActionRenameLabel
 	defer func(start time.Time) {
@@ -316,14 +386,24 @@ func (s *Server) attachContainer(ctx context.Context, rw http.ResponseWriter, re
 	name := mux.Vars(req)["name"]
 	_, upgrade := req.Header["Upgrade"]
 
+	c, err := s.ContainerMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
 	var (
-		err     error
 		closeFn func() error
 		attach  = new(streams.AttachConfig)
 		stdin   io.ReadCloser
 		stdout  io.Writer
 	)
 
+	if keys := req.FormValue("detachKeys"); keys != "" {
+		if attach.DetachKeys, err = streams.ParseDetachKeys(keys); err != nil {
+			return httputils.NewHTTPError(err, http.StatusBadRequest)
+		}
+	}
+
 	stdin, stdout, closeFn, err = openHijackConnection(rw)
 	if err != nil {
 		return err
@@ -338,15 +418,28 @@ func (s *Server) attachContainer(ctx context.Context, rw http.ResponseWriter, re
 		fmt.Fprintf(stdout, "HTTP/1.1 200 OK\r\nContent-Type: application/vnd.docker.raw-stream\r\n\r\n")
 	}
 
+	// NOTE: attach both stdout and stderr if neither is specified, so that
+	// the old clients still work.
+	useStdout, useStderr := httputils.BoolValue(req, "stdout"), httputils.BoolValue(req, "stderr")
+	if req.FormValue("stdout") == "" && req.FormValue("stderr") == "" {
+		useStdout, useStderr = true, true
+	}
+
 	attach.UseStdin = httputils.BoolValue(req, "stdin")
 	attach.Stdin = stdin
-	attach.UseStdout = true
-	attach.Stdout = stdout
-	attach.UseStderr = true
-	attach.Stderr = stdout
+	attach.UseStdout, attach.UseStderr = useStdout, useStderr
+
+	// NOTE: compatible with docker API, the stdout and stderr are
+	// multiplexed in one stream if the container has no tty.
+	if c.Config.Tty {
+		attach.Stdout, attach.Stderr = stdout, stdout
+	} else {
+		attach.Stdout = stdcopy.NewStdWriter(stdout, stdcopy.Stdout)
+		attach.Stderr = stdcopy.NewStdWriter(stdout, stdcopy.Stderr)
+	}
 
-	if err := s.ContainerMgr.AttachContainerIO(ctx, name, attach); err != nil {
-		stdout.Write([]byte(err.Error() + "\r\n"))
+	if err := s.ContainerMgr.AttachContainerIO(ctx, name, attach); err != nil && err != streams.ErrDetached {
+		attach.Stdout.Write([]byte(err.Error() + "\r\n"))
 	}
 	return nil
 }
@@ -391,6 +484,27 @@ func (s *Server) updateContainer(ctx context.Context, rw http.ResponseWriter, re
 	return nil
 }
 
+func (s *Server) updateContainerNetwork(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	bandwidth := &types.NetworkBandwidth{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(bandwidth); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	// validate request body
+	if err := bandwidth.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	name := mux.Vars(req)["name"]
+
+	if err := s.ContainerMgr.UpdateNetwork(ctx, name, bandwidth); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
 func (s *Server) upgradeContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	label := util_metrics.ActionUpgradeLabel
 	defer func(start time.Time) {
@@ -432,6 +546,17 @@ func (s *Server) topContainer(ctx context.Context, rw http.ResponseWriter, req *
 	return EncodeResponse(rw, http.StatusOK, procList)
 }
 
+func (s *Server) getContainerChanges(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	changes, err := s.ContainerMgr.Changes(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, changes)
+}
+
 func (s *Server) logsContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	opts := &types.ContainerLogsOptions{
 		ShowStdout: httputils.BoolValue(req, "stdout"),
@@ -525,9 +650,9 @@ func (s *Server) removeContainers(ctx context.Context, rw http.ResponseWriter, r
 
 func (s *Server) waitContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	name := mux.Vars(req)["name"]
+	condition := req.FormValue("condition")
 
-	waitStatus, err := s.ContainerMgr.Wait(ctx, name)
-
+	waitStatus, err := s.ContainerMgr.Wait(ctx, name, condition)
 	if err != nil {
 		return err
 	}
@@ -548,6 +673,10 @@ func (s *Server) createContainerCheckpoint(ctx context.Context, rw http.Response
 		return httputils.NewHTTPError(fmt.Errorf("checkpoint id should not be empty"), http.StatusBadRequest)
 	}
 
+	if options.LazyPages && options.PageServer == "" {
+		return httputils.NewHTTPError(fmt.Errorf("page server should be specified for lazy checkpoint"), http.StatusBadRequest)
+	}
+
 	if err := s.ContainerMgr.CreateCheckpoint(ctx, name, options); err != nil {
 		return err
 	}
@@ -592,6 +721,33 @@ func (s *Server) deleteContainerCheckpoint(ctx context.Context, rw http.Response
 	return nil
 }
 
+func (s *Server) exportContainerCheckpoint(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	r, err := s.ContainerMgr.ExportCheckpoint(ctx, name, mux.Vars(req)["id"], req.FormValue("dir"))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	rw.Header().Set("Content-Type", "application/x-tar")
+
+	output := newWriteFlusher(rw)
+	_, err = io.Copy(output, r)
+	return err
+}
+
+func (s *Server) importContainerCheckpoint(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.ContainerMgr.ImportCheckpoint(ctx, name, mux.Vars(req)["id"], req.FormValue("dir"), req.Body); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusCreated)
+	return nil
+}
+
 func (s *Server) commitContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	options := &types.ContainerCommitOptions{
 		Repository: req.FormValue("repo"),
@@ -599,6 +755,8 @@ func (s *Server) commitContainer(ctx context.Context, rw http.ResponseWriter, re
 		Author:     req.FormValue("author"),
 		Comment:    req.FormValue("comment"),
 	}
+	// the form has been parsed by FormValue.
+	options.Changes = req.Form["changes"]
 
 	id, err := s.ContainerMgr.Commit(ctx, req.FormValue("container"), options)
 	if err != nil {
@@ -89,3 +89,19 @@ func (s *Server) getContainersArchive(ctx context.Context, rw http.ResponseWrite
 
 	return err
 }
+
+// exportContainer returns the tar stream of container rootfs.
+func (s *Server) exportContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	tarArchive, err := s.ContainerMgr.Export(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer tarArchive.Close()
+
+	rw.Header().Set("Content-Type", "application/x-tar")
+	_, err = io.Copy(rw, tarArchive)
+
+	return err
+}
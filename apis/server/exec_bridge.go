@@ -8,10 +8,12 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/alibaba/pouch/apis/metrics"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/streams"
+	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
 
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/go-openapi/strfmt"
@@ -19,6 +21,9 @@ import (
 )
 
 func (s *Server) createContainerExec(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	label := util_metrics.ActionCreateLabel
+	metrics.ExecActionsCounter.WithLabelValues(label).Inc()
+
 	config := &types.ExecCreateConfig{}
 	// decode request body
 	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
@@ -42,10 +47,15 @@ func (s *Server) createContainerExec(ctx context.Context, rw http.ResponseWriter
 		ID: id,
 	}
 
+	metrics.ExecSuccessActionsCounter.WithLabelValues(label).Inc()
+
 	return EncodeResponse(rw, http.StatusCreated, execCreateResp)
 }
 
 func (s *Server) startContainerExec(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	label := util_metrics.ActionStartLabel
+	metrics.ExecActionsCounter.WithLabelValues(label).Inc()
+
 	config := &types.ExecStartConfig{}
 	// decode request body
 	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
@@ -106,7 +116,10 @@ func (s *Server) startContainerExec(ctx context.Context, rw http.ResponseWriter,
 			return err
 		}
 		attach.Stdout.Write([]byte(err.Error() + "\r\n"))
+		return nil
 	}
+
+	metrics.ExecSuccessActionsCounter.WithLabelValues(label).Inc()
 	return nil
 }
 
@@ -119,6 +132,14 @@ func (s *Server) getExecInfo(ctx context.Context, rw http.ResponseWriter, req *h
 	return EncodeResponse(rw, http.StatusOK, execInfo)
 }
 
+func (s *Server) pruneExecs(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	deleted, err := s.ContainerMgr.PruneExecs(ctx, req.FormValue("container"))
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, &types.ExecPruneResp{ExecsDeleted: deleted})
+}
+
 func (s *Server) resizeExec(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	height, err := strconv.Atoi(req.FormValue("h"))
 	if err != nil {
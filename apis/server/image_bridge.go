@@ -56,6 +56,9 @@ func (s *Server) pullImage(ctx context.Context, rw http.ResponseWriter, req *htt
 			return err
 		}
 	}
+	// the progress of pull is streamed in json objects
+	rw.Header().Set("Content-Type", "application/json")
+
 	// Error information has be sent to client, so no need call resp.Write
 	if err := s.ImageMgr.PullImage(ctx, image, &authConfig, newWriteFlusher(rw)); err != nil {
 		log.With(ctx).Errorf("failed to pull image %s: %v", image, err)
@@ -94,6 +97,21 @@ func (s *Server) listImages(ctx context.Context, rw http.ResponseWriter, req *ht
 	return EncodeResponse(rw, http.StatusOK, imageList)
 }
 
+// pruneImages removes the images which are not used by any container.
+func (s *Server) pruneImages(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	filter, err := filters.FromParam(req.FormValue("filters"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.ImageMgr.PruneImages(ctx, filter, s.ContainerMgr.IsImageUsed)
+	if err != nil {
+		log.With(ctx).Errorf("failed to prune images: %v", err)
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, resp)
+}
+
 func (s *Server) searchImages(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	searchPattern := req.FormValue("term")
 	registry := req.FormValue("registry")
@@ -191,6 +209,27 @@ func (s *Server) postImageTag(ctx context.Context, rw http.ResponseWriter, req *
 	return nil
 }
 
+// importImage creates an image from the http tar stream of rootfs.
+func (s *Server) importImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	ref := req.FormValue("repo")
+	if ref == "" {
+		return httputils.NewHTTPError(fmt.Errorf("repo can't be empty"), http.StatusBadRequest)
+	}
+	if tag := req.FormValue("tag"); tag != "" {
+		ref = fmt.Sprintf("%s:%s", ref, tag)
+	}
+
+	if err := s.ImageMgr.ImportImage(ctx, ref, req.FormValue("message"), req.Body); err != nil {
+		return err
+	}
+
+	image, err := s.ImageMgr.GetImage(ctx, ref)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusCreated, image)
+}
+
 // loadImage loads an image by http tar stream.
 func (s *Server) loadImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	imageName := req.FormValue("name")
@@ -206,15 +245,16 @@ func (s *Server) loadImage(ctx context.Context, rw http.ResponseWriter, req *htt
 // saveImage saves an image by http tar stream.
 func (s *Server) saveImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	imageName := req.FormValue("name")
+	format := req.FormValue("format")
 
-	rw.Header().Set("Content-Type", "application/x-tar")
-
-	r, err := s.ImageMgr.SaveImage(ctx, imageName)
+	r, err := s.ImageMgr.SaveImage(ctx, imageName, format)
 	if err != nil {
 		return err
 	}
 	defer r.Close()
 
+	rw.Header().Set("Content-Type", "application/x-tar")
+
 	output := newWriteFlusher(rw)
 	_, err = io.Copy(output, r)
 	return err
@@ -254,3 +294,24 @@ func (s *Server) pushImage(ctx context.Context, rw http.ResponseWriter, req *htt
 
 	return nil
 }
+
+// createManifestList creates a manifest list from local images.
+func (s *Server) createManifestList(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.ManifestCreateConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	if err := s.ImageMgr.CreateManifestList(ctx, config.Name, config.Images); err != nil {
+		log.With(ctx).Errorf("failed to create manifest list %s: %v", config.Name, err)
+		return err
+	}
+
+	rw.WriteHeader(http.StatusCreated)
+	return nil
+}
@@ -40,7 +40,9 @@ func Test_pullImage_without_tag(t *testing.T) {
 		Form:   map[string][]string{"fromImage": {"reg.abc.com/base/os:7.2"}},
 		Header: map[string][]string{},
 	}
-	s.pullImage(context.Background(), nil, req)
+	rw := httptest.NewRecorder()
+	s.pullImage(context.Background(), rw, req)
+	assert.Equal(t, "application/json", rw.Header().Get("Content-Type"))
 }
 
 func Test_pullImage_counter(t *testing.T) {
@@ -65,7 +67,7 @@ func Test_pullImage_counter(t *testing.T) {
 			Form:   map[string][]string{"fromImage": {"reg.abc.com/base/os:7.2"}},
 			Header: map[string][]string{},
 		}
-		s.pullImage(ctx, nil, req)
+		s.pullImage(ctx, httptest.NewRecorder(), req)
 
 		ch <- 1
 	}()
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
 	networktypes "github.com/alibaba/pouch/network/types"
 	"github.com/alibaba/pouch/pkg/httputils"
 
@@ -114,17 +115,21 @@ func (s *Server) disconnectNetwork(ctx context.Context, rw http.ResponseWriter,
 
 func buildNetworkInspectResp(n *networktypes.Network) *types.NetworkInspectResp {
 	info := n.Network.Info()
+	options := info.DriverOptions()
 	network := &types.NetworkInspectResp{
 		Name:       n.Name,
 		ID:         n.Network.ID(),
 		Driver:     n.Type,
 		EnableIPV6: info.IPv6Enabled(),
 		Internal:   info.Internal(),
-		Options:    info.DriverOptions(),
+		Options:    options,
 		Labels:     info.Labels(),
 		IPAM:       buildIpamResources(info),
 		Scope:      info.Scope(),
 	}
+	if n.Type == "bridge" {
+		network.Policy = mgr.NetworkPolicy(options)
+	}
 	return network
 }
 
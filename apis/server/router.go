@@ -6,17 +6,22 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"regexp"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/alibaba/pouch/apis/metrics"
 	serverTypes "github.com/alibaba/pouch/apis/server/types"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/version"
 
 	"github.com/gorilla/mux"
 )
@@ -34,32 +39,43 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodGet, Path: "/version", HandlerFunc: s.version},
 		{Method: http.MethodPost, Path: "/auth", HandlerFunc: s.auth},
 		{Method: http.MethodGet, Path: "/events", HandlerFunc: withCancelHandler(s.events)},
+		{Method: http.MethodGet, Path: "/system/df", HandlerFunc: s.diskUsage},
 
 		// daemon, we still list this API into system manager.
 		{Method: http.MethodPost, Path: "/daemon/update", HandlerFunc: s.updateDaemon},
+		{Method: http.MethodPost, Path: "/daemon/reload", HandlerFunc: s.reloadDaemon},
 
 		// container
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/checkpoints", HandlerFunc: withCancelHandler(s.createContainerCheckpoint)},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/checkpoints", HandlerFunc: withCancelHandler(s.listContainerCheckpoint)},
 		{Method: http.MethodDelete, Path: "/containers/{name}/checkpoints/{id}", HandlerFunc: withCancelHandler(s.deleteContainerCheckpoint)},
+		{Method: http.MethodGet, Path: "/containers/{name}/checkpoints/{id}/archive", HandlerFunc: withCancelHandler(s.exportContainerCheckpoint)},
+		{Method: http.MethodPut, Path: "/containers/{name}/checkpoints/{id}/archive", HandlerFunc: withCancelHandler(s.importContainerCheckpoint)},
 		{Method: http.MethodPost, Path: "/containers/create", HandlerFunc: s.createContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/start", HandlerFunc: s.startContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/stop", HandlerFunc: s.stopContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/attach", HandlerFunc: s.attachContainer},
+		{Method: http.MethodGet, Path: "/containers/{name:.*}/attach/ws", HandlerFunc: s.attachContainerWebsocket},
 		{Method: http.MethodGet, Path: "/containers/json", HandlerFunc: s.getContainers},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/json", HandlerFunc: s.getContainer},
 		{Method: http.MethodDelete, Path: "/containers/{name:.*}", HandlerFunc: s.removeContainers},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/exec", HandlerFunc: s.createContainerExec},
+		{Method: http.MethodPost, Path: "/exec/prune", HandlerFunc: s.pruneExecs},
 		{Method: http.MethodGet, Path: "/exec/{name:.*}/json", HandlerFunc: s.getExecInfo},
 		{Method: http.MethodPost, Path: "/exec/{name:.*}/start", HandlerFunc: s.startContainerExec},
+		{Method: http.MethodGet, Path: "/exec/{name:.*}/start/ws", HandlerFunc: s.startContainerExecWebsocket},
 		{Method: http.MethodPost, Path: "/exec/{name:.*}/resize", HandlerFunc: s.resizeExec},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/rename", HandlerFunc: s.renameContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/restart", HandlerFunc: s.restartContainer},
+		{Method: http.MethodPost, Path: "/containers/pause", HandlerFunc: s.pauseContainers},
+		{Method: http.MethodPost, Path: "/containers/unpause", HandlerFunc: s.unpauseContainers},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/pause", HandlerFunc: s.pauseContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/unpause", HandlerFunc: s.unpauseContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/update", HandlerFunc: s.updateContainer},
+		{Method: http.MethodPost, Path: "/containers/{name:.*}/update-network", HandlerFunc: s.updateContainerNetwork},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/upgrade", HandlerFunc: s.upgradeContainer},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/top", HandlerFunc: s.topContainer},
+		{Method: http.MethodGet, Path: "/containers/{name:.*}/changes", HandlerFunc: s.getContainerChanges},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/logs", HandlerFunc: withCancelHandler(s.logsContainer)},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/stats", HandlerFunc: withCancelHandler(s.statsContainer)},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/resize", HandlerFunc: s.resizeContainer},
@@ -71,17 +87,26 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodPost, Path: "/images/create", HandlerFunc: withCancelHandler(s.pullImage)},
 		{Method: http.MethodPost, Path: "/images/search", HandlerFunc: s.searchImages},
 		{Method: http.MethodGet, Path: "/images/json", HandlerFunc: s.listImages},
+		{Method: http.MethodPost, Path: "/images/prune", HandlerFunc: s.pruneImages},
 		{Method: http.MethodDelete, Path: "/images/{name:.*}", HandlerFunc: s.removeImage},
 		{Method: http.MethodGet, Path: "/images/{name:.*}/json", HandlerFunc: s.getImage},
 		{Method: http.MethodPost, Path: "/images/{name:.*}/tag", HandlerFunc: s.postImageTag},
 		{Method: http.MethodPost, Path: "/images/load", HandlerFunc: withCancelHandler(s.loadImage)},
+		{Method: http.MethodPost, Path: "/images/import", HandlerFunc: withCancelHandler(s.importImage)},
 		{Method: http.MethodGet, Path: "/images/save", HandlerFunc: withCancelHandler(s.saveImage)},
 		{Method: http.MethodGet, Path: "/images/{name:.*}/history", HandlerFunc: s.getImageHistory},
 		{Method: http.MethodPost, Path: "/images/{name:.*}/push", HandlerFunc: s.pushImage},
+		{Method: http.MethodPost, Path: "/images/manifests/create", HandlerFunc: s.createManifestList},
 
 		// volume
 		{Method: http.MethodGet, Path: "/volumes", HandlerFunc: s.listVolume},
 		{Method: http.MethodPost, Path: "/volumes/create", HandlerFunc: s.createVolume},
+		// the stats and snapshot routes must be ahead of the volume routes, which match any path.
+		{Method: http.MethodGet, Path: "/volumes/{name:.*}/stats", HandlerFunc: s.getVolumeStats},
+		{Method: http.MethodPost, Path: "/volumes/{name:.*}/snapshot", HandlerFunc: s.createVolumeSnapshot},
+		{Method: http.MethodGet, Path: "/volumes/{name:.*}/snapshots", HandlerFunc: s.listVolumeSnapshots},
+		{Method: http.MethodDelete, Path: "/volumes/{name:.*}/snapshots/{snapshot}", HandlerFunc: s.removeVolumeSnapshot},
+		{Method: http.MethodPost, Path: "/volumes/{name:.*}/clone", HandlerFunc: s.cloneVolume},
 		{Method: http.MethodGet, Path: "/volumes/{name:.*}", HandlerFunc: s.getVolume},
 		{Method: http.MethodDelete, Path: "/volumes/{name:.*}", HandlerFunc: s.removeVolume},
 
@@ -108,6 +133,7 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodPut, Path: "/containers/{name:.*}/archive", HandlerFunc: s.putContainersArchive},
 		{Method: http.MethodHead, Path: "/containers/{name:.*}/archive", HandlerFunc: s.headContainersArchive},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/archive", HandlerFunc: s.getContainersArchive},
+		{Method: http.MethodGet, Path: "/containers/{name:.*}/export", HandlerFunc: withCancelHandler(s.exportContainer)},
 	}
 
 	if s.APIPlugin != nil {
@@ -117,8 +143,10 @@ func initRoute(s *Server) *mux.Router {
 	// register API
 	for _, h := range handlers {
 		if h != nil {
-			r.Path(versionMatcher + h.Path).Methods(h.Method).Handler(filter(h.HandlerFunc, s))
-			r.Path(h.Path).Methods(h.Method).Handler(filter(h.HandlerFunc, s))
+			handler := withVersionAdapters(h.Method, h.Path, versionAdapters, h.HandlerFunc)
+			handler = withMetricsHandler(h.Method, h.Path, handler)
+			r.Path(versionMatcher + h.Path).Methods(h.Method).Handler(filter(handler, s))
+			r.Path(h.Path).Methods(h.Method).Handler(filter(handler, s))
 		}
 	}
 
@@ -173,6 +201,17 @@ func withCancelHandler(h serverTypes.Handler) serverTypes.Handler {
 	}
 }
 
+// withMetricsHandler records the latency of the handler by the route template,
+// so that the requests of different containers share the same series.
+func withMetricsHandler(method, route string, h serverTypes.Handler) serverTypes.Handler {
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		defer func(start time.Time) {
+			metrics.APIRequestTimer.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		}(time.Now())
+		return h(ctx, rw, req)
+	}
+}
+
 func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 	pctx := context.Background()
 
@@ -180,9 +219,40 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 		ctx, cancel := context.WithCancel(pctx)
 		defer cancel()
 
+		// the request id is passed to managers and ctrd in the log context
+		// of ctx, so the logs and the audit entry of request are correlated.
+		requestID := getRequestID(req)
 		ctx = log.NewContext(ctx, map[string]interface{}{
-			"RequestID": randomid.Generate()[:10],
+			"RequestID": requestID,
 		})
+		w.Header().Set(requestIDHeader, requestID)
+
+		var err error
+		if s.AuditLogger.Enabled() && auditReqDecider(req) {
+			defer func(start time.Time) {
+				s.AuditLogger.Log(newAuditEntry(req, requestID, start, err))
+			}(time.Now())
+		}
+
+		// the headers of api version are set even if the negotiation fails,
+		// so that the client can choose the version supported.
+		w.Header().Set(httputils.APIVersionHeader, version.APIVersion)
+		w.Header().Set(httputils.MinAPIVersionHeader, version.MinAPIVersion)
+		apiVersion, err := negotiateAPIVersion(req)
+		if err != nil {
+			HandleErrorResponse(w, err)
+			return
+		}
+		if isDeprecatedAPIVersion(apiVersion) {
+			w.Header().Set("Warning", fmt.Sprintf(`299 pouchd "api version %s is deprecated, please upgrade the client to api version %s or later"`, apiVersion, version.DeprecatedAPIVersion))
+		}
+		ctx = setAPIVersion(ctx, apiVersion)
+
+		if atomic.LoadInt32(&s.draining) == 1 && drainReqDecider(req) {
+			err = httputils.NewHTTPError(fmt.Errorf("pouchd is shutting down"), http.StatusServiceUnavailable)
+			HandleErrorResponse(w, err)
+			return
+		}
 
 		if flyingReqDecider(req) {
 			atomic.AddInt32(&s.FlyingReq, 1)
@@ -193,6 +263,7 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 		if len(s.ManagerWhiteList) > 0 && req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
 			if _, isManager := s.ManagerWhiteList[req.TLS.PeerCertificates[0].Subject.CommonName]; !isManager {
 				s.lock.RUnlock()
+				err = httputils.NewHTTPError(fmt.Errorf("tls verified error"), http.StatusForbidden)
 				w.WriteHeader(http.StatusForbidden)
 				w.Write([]byte("tls verified error."))
 				return
@@ -224,8 +295,16 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 			log.With(ctx).Debugf("Calling %s %s, client %s", req.Method, req.URL.RequestURI(), clientInfo)
 		}
 
+		// the handler is not run if the request is denied by any
+		// authorization plugin.
+		if err = s.Authorizer.AuthZRequest(req); err != nil {
+			log.With(ctx).Warnf("Authorization of %s %s, client %s fails: %s", req.Method, req.URL.RequestURI(), clientInfo, err)
+			HandleErrorResponse(w, err)
+			return
+		}
+
 		// Start to handle request.
-		err := handler(ctx, w, req)
+		err = handler(ctx, w, req)
 		if err == nil {
 			return
 		}
@@ -246,6 +325,75 @@ func flyingReqDecider(req *http.Request) bool {
 	return false
 }
 
+// routeToRejectInDrain are suffixes of the routes which create or start
+// containers, they are rejected when server is draining.
+var routeToRejectInDrain = []string{"/containers/create", "/start", "/restart", "/unpause", "/exec"}
+
+// auditReqDecider decides whether the request is recorded in the audit log,
+// only the state-changing ones are recorded.
+func auditReqDecider(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// newAuditEntry records the request and its result in the audit entry.
+func newAuditEntry(req *http.Request, requestID string, start time.Time, err error) *audit.Entry {
+	user, _ := authz.RequestUser(req)
+	entry := &audit.Entry{
+		Time:      start,
+		RequestID: requestID,
+		User:      user,
+		Client:    req.RemoteAddr,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Vars:      mux.Vars(req),
+		Query:     req.URL.Query(),
+		Result:    audit.ResultSuccess,
+		Duration:  time.Since(start).Seconds(),
+	}
+	if len(entry.Query) == 0 {
+		entry.Query = nil
+	}
+	if err != nil {
+		entry.Result = audit.ResultFailure
+		entry.Error = err.Error()
+		entry.StatusCode = errorStatusCode(err)
+	}
+	return entry
+}
+
+// requestIDHeader is the header of request id, the client can specify it to
+// correlate its own logs with the ones of pouchd.
+const requestIDHeader = "X-Request-ID"
+
+// requestIDMatcher restricts the request id specified by client, so that it
+// can't break the format of logs.
+var requestIDMatcher = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+// getRequestID returns the request id specified by client if it is valid,
+// otherwise a random one is generated.
+func getRequestID(req *http.Request) string {
+	if id := req.Header.Get(requestIDHeader); requestIDMatcher.MatchString(id) {
+		return id
+	}
+	return randomid.Generate()[:10]
+}
+
+func drainReqDecider(req *http.Request) bool {
+	if req.Method != http.MethodPost {
+		return false
+	}
+	for _, r := range routeToRejectInDrain {
+		if strings.HasSuffix(req.URL.Path, r) {
+			return true
+		}
+	}
+	return false
+}
+
 // EncodeResponse encodes response in json.
 func EncodeResponse(rw http.ResponseWriter, statusCode int, data interface{}) error {
 	rw.Header().Set("Content-Type", "application/json")
@@ -255,29 +403,8 @@ func EncodeResponse(rw http.ResponseWriter, statusCode int, data interface{}) er
 
 // HandleErrorResponse handles err from daemon side and constructs response for client side.
 func HandleErrorResponse(w http.ResponseWriter, err error) {
-	var (
-		code   int
-		errMsg string
-	)
-
-	// By default, daemon side returns code 500 if error happens.
-	code = http.StatusInternalServerError
-	errMsg = err.Error()
-
-	httpErr, ok := err.(httputils.HTTPError)
-	if ok {
-		code = httpErr.Code()
-	} else if errtypes.IsNotfound(err) {
-		code = http.StatusNotFound
-	} else if errtypes.IsInvalidParam(err) {
-		code = http.StatusBadRequest
-	} else if errtypes.IsAlreadyExisted(err) {
-		code = http.StatusConflict
-	} else if errtypes.IsNotModified(err) {
-		code = http.StatusNotModified
-	} else if errtypes.IsInvalidAuthorization(err) {
-		code = http.StatusForbidden
-	}
+	code := errorStatusCode(err)
+	errMsg := err.Error()
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
@@ -289,3 +416,26 @@ func HandleErrorResponse(w http.ResponseWriter, err error) {
 	}
 	enc.Encode(resp)
 }
+
+// errorStatusCode returns the status code of response for the error.
+func errorStatusCode(err error) int {
+	if httpErr, ok := err.(httputils.HTTPError); ok {
+		return httpErr.Code()
+	}
+
+	switch {
+	case errtypes.IsNotfound(err):
+		return http.StatusNotFound
+	case errtypes.IsInvalidParam(err):
+		return http.StatusBadRequest
+	case errtypes.IsAlreadyExisted(err), errtypes.IsConflict(err), errtypes.IsInUse(err):
+		return http.StatusConflict
+	case errtypes.IsNotModified(err):
+		return http.StatusNotModified
+	case errtypes.IsInvalidAuthorization(err):
+		return http.StatusForbidden
+	}
+
+	// By default, daemon side returns code 500 if error happens.
+	return http.StatusInternalServerError
+}
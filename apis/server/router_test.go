@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRequestID(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "/containers/create", nil)
+	assert.Len(t, getRequestID(req), 10)
+
+	req.Header.Set(requestIDHeader, "job-42.step_1")
+	assert.Equal(t, "job-42.step_1", getRequestID(req))
+
+	// the invalid one is replaced by a random one.
+	req.Header.Set(requestIDHeader, "id with\nnewline")
+	assert.Len(t, getRequestID(req), 10)
+}
+
+func TestNewAuditEntry(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodDelete, "/v1.24/containers/c1?force=true", nil)
+	req.RemoteAddr = "10.0.0.1:12345"
+	req.Header.Set(authz.UserHeader, "alice")
+
+	// the variables of route are set by router.
+	start := time.Now()
+	var entry *audit.Entry
+	r := mux.NewRouter()
+	r.Path("/v{version}/containers/{name}").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		entry = newAuditEntry(req, "r1", start, errors.Wrap(errtypes.ErrNotfound, "container c1"))
+	})
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if !assert.NotNil(t, entry) {
+		return
+	}
+	assert.Equal(t, start, entry.Time)
+	assert.Equal(t, "r1", entry.RequestID)
+	assert.Equal(t, "alice", entry.User)
+	assert.Equal(t, "10.0.0.1:12345", entry.Client)
+	assert.Equal(t, "/v1.24/containers/c1", entry.Path)
+	assert.Equal(t, map[string]string{"version": "1.24", "name": "c1"}, entry.Vars)
+	assert.Equal(t, map[string][]string{"force": {"true"}}, entry.Query)
+	assert.Equal(t, audit.ResultFailure, entry.Result)
+	assert.Equal(t, http.StatusNotFound, entry.StatusCode)
+
+	entry = newAuditEntry(req, "r2", start, nil)
+	assert.Equal(t, audit.ResultSuccess, entry.Result)
+	assert.Equal(t, "", entry.Error)
+	assert.Equal(t, 0, entry.StatusCode)
+}
+
+func TestAuditReqDecider(t *testing.T) {
+	for method, expected := range map[string]bool{
+		http.MethodGet:    false,
+		http.MethodHead:   false,
+		http.MethodPost:   true,
+		http.MethodPut:    true,
+		http.MethodDelete: true,
+	} {
+		req, _ := http.NewRequest(method, "/containers/c1", nil)
+		assert.Equal(t, expected, auditReqDecider(req), "unexpected decision for %s", method)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	stdlog "log"
 	"net"
@@ -14,6 +15,8 @@ import (
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/hookplugins"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/netutils"
@@ -32,8 +35,11 @@ type Server struct {
 	ContainerPlugin  hookplugins.ContainerPlugin
 	APIPlugin        hookplugins.APIPlugin
 	ManagerWhiteList map[string]struct{}
+	Authorizer       *authz.Authorizer
+	AuditLogger      *audit.Logger
 	lock             sync.RWMutex
 	FlyingReq        int32
+	draining         int32
 }
 
 // Start setup route table and listen to specified address which currently only supports unix socket and tcp address.
@@ -51,14 +57,18 @@ func (s *Server) Start(readyCh chan bool) (err error) {
 
 	var tlsConfig *tls.Config
 	if s.Config.TLS.Key != "" && s.Config.TLS.Cert != "" {
-		tlsConfig, err = httputils.GenTLSConfig(s.Config.TLS.Key, s.Config.TLS.Cert, s.Config.TLS.CA)
+		tlsConfig, err = httputils.NewServerTLSConfig(httputils.ServerTLSOptions{
+			Key:          s.Config.TLS.Key,
+			Cert:         s.Config.TLS.Cert,
+			CA:           s.Config.TLS.CA,
+			VerifyRemote: s.Config.TLS.VerifyRemote,
+			MinVersion:   s.Config.TLS.MinVersion,
+			CipherSuites: s.Config.TLS.CipherSuites,
+		})
 		if err != nil {
 			readyCh <- false
 			return err
 		}
-		if s.Config.TLS.VerifyRemote {
-			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
-		}
 		SetupManagerWhitelist(s)
 	}
 
@@ -103,13 +113,20 @@ func SetupManagerWhitelist(server *Server) {
 	}
 }
 
-// Stop will shutdown http server by closing all listeners.
-func (s *Server) Stop() error {
+// Drain makes server reject the requests which create or start containers,
+// other requests are still served until server is stopped.
+func (s *Server) Drain() {
+	atomic.StoreInt32(&s.draining, 1)
+}
+
+// Stop will shutdown http server by closing all listeners, it waits for the
+// on going requests until ctx is done.
+func (s *Server) Stop(ctx context.Context) error {
 	for _, one := range s.listeners {
 		one.Close()
 	}
 
-	// drain all requests on going or timeout after one minute
+	// drain all requests on going or timeout when ctx is done
 	drain := make(chan struct{})
 	go func() {
 		for {
@@ -123,8 +140,8 @@ func (s *Server) Stop() error {
 
 	select {
 	case <-drain:
-	case <-time.After(60 * time.Second):
-		log.With(nil).Errorf("stop pouch server after waited 60 seconds, on going request %d", atomic.LoadInt32(&s.FlyingReq))
+	case <-ctx.Done():
+		log.With(nil).Errorf("stop pouch server after shutdown timeout %d seconds, on going request %d", s.Config.ShutdownTimeout, atomic.LoadInt32(&s.FlyingReq))
 	}
 
 	return nil
@@ -8,11 +8,14 @@ import (
 	"time"
 
 	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/metrics"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/events"
+	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
-	"github.com/alibaba/pouch/pkg/utils/metrics"
+	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
 
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/go-openapi/strfmt"
@@ -41,6 +44,14 @@ func (s *Server) version(ctx context.Context, rw http.ResponseWriter, req *http.
 	return EncodeResponse(rw, http.StatusOK, version)
 }
 
+func (s *Server) diskUsage(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	du, err := s.SystemMgr.DiskUsage(ctx)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, du)
+}
+
 func (s *Server) updateDaemon(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
 	cfg := &types.DaemonUpdateConfig{}
 
@@ -56,6 +67,10 @@ func (s *Server) updateDaemon(ctx context.Context, rw http.ResponseWriter, req *
 	return s.SystemMgr.UpdateDaemon(cfg)
 }
 
+func (s *Server) reloadDaemon(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	return s.SystemMgr.ReloadDaemon(ctx)
+}
+
 func (s *Server) auth(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
 	auth := types.AuthConfig{}
 
@@ -119,6 +134,9 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 	if err != nil {
 		return err
 	}
+	if err := events.ValidateFilter(ef); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
 
 	// send past events
 	buffered, eventq, errq := s.SystemMgr.SubscribeToEvents(ctx, since, until, ef)
@@ -156,7 +174,41 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 }
 
 func (s *Server) metrics(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
-	metrics.GetPrometheusHandler().ServeHTTP(rw, req)
+	if s.ContainerMgr != nil {
+		if err := s.updateContainerStatesMetrics(ctx); err != nil {
+			log.With(ctx).Warnf("failed to update container states metrics: %v", err)
+		}
+	}
+
+	util_metrics.GetPrometheusHandler().ServeHTTP(rw, req)
+	return nil
+}
+
+// updateContainerStatesMetrics counts the containers in each state, it is
+// refreshed on every scrape so that removed containers are not reported.
+func (s *Server) updateContainerStatesMetrics(ctx context.Context) error {
+	containers, err := s.ContainerMgr.List(ctx, &mgr.ContainerListOption{All: true})
+	if err != nil {
+		return err
+	}
+
+	states := map[types.Status]int{
+		types.StatusCreated:    0,
+		types.StatusRunning:    0,
+		types.StatusStopped:    0,
+		types.StatusPaused:     0,
+		types.StatusRestarting: 0,
+		types.StatusRemoving:   0,
+		types.StatusExited:     0,
+		types.StatusDead:       0,
+	}
+	for _, c := range containers {
+		states[c.State.Status]++
+	}
+
+	for state, count := range states {
+		metrics.ContainerStatesGauge.WithLabelValues(string(state)).Set(float64(count))
+	}
 	return nil
 }
 
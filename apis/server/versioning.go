@@ -0,0 +1,245 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	serverTypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/version"
+
+	"github.com/gorilla/mux"
+)
+
+type apiVersionKey struct{}
+
+// versionAdapter converts the api of an old version to the current one, so
+// that the clients of old version still work after the breaking changes of
+// types. For example, the adapter of version 1.24 converts the request of
+// 1.24 to the one of 1.25, and converts the response of 1.25 back to the one
+// of 1.24. The request of 1.23 is converted by the adapters of 1.23 and 1.24
+// in order.
+type versionAdapter struct {
+	// Version is the last api version before the breaking change.
+	Version string
+
+	// Method and Path are the route of api, like POST /containers/create.
+	Method string
+	Path   string
+
+	// AdaptRequest converts the decoded JSON body of request, nil if the
+	// request is not changed.
+	AdaptRequest func(body interface{}) (interface{}, error)
+
+	// AdaptResponse converts the decoded JSON body of response, nil if the
+	// response is not changed.
+	AdaptResponse func(body interface{}) (interface{}, error)
+}
+
+// versionAdapters are the adapters of the breaking changes of api. Add the
+// adapter here when the request or response of api is changed in the way
+// which breaks the existing clients, and bump version.APIVersion.
+var versionAdapters []versionAdapter
+
+// negotiateAPIVersion returns the api version of request, which is specified
+// by the version prefix of path, or by the header if no prefix. The current
+// api version is used if neither is specified.
+func negotiateAPIVersion(req *http.Request) (string, error) {
+	v := mux.Vars(req)["version"]
+	if v == "" {
+		v = req.Header.Get(httputils.APIVersionHeader)
+	}
+	if v == "" {
+		return version.APIVersion, nil
+	}
+
+	if _, _, err := httputils.ParseAPIVersion(v); err != nil {
+		return "", httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	if httputils.CompareAPIVersion(v, version.APIVersion) > 0 {
+		return "", httputils.NewHTTPError(fmt.Errorf("client api version %s is too new, the max supported api version is %s", v, version.APIVersion), http.StatusBadRequest)
+	}
+	if httputils.CompareAPIVersion(v, version.MinAPIVersion) < 0 {
+		return "", httputils.NewHTTPError(fmt.Errorf("client api version %s is too old, the min supported api version is %s", v, version.MinAPIVersion), http.StatusBadRequest)
+	}
+	return v, nil
+}
+
+// isDeprecatedAPIVersion returns whether the api version is deprecated, and
+// will not be supported in the future.
+func isDeprecatedAPIVersion(v string) bool {
+	return httputils.CompareAPIVersion(v, version.DeprecatedAPIVersion) < 0
+}
+
+// setAPIVersion sets the negotiated api version of request to context.
+func setAPIVersion(ctx context.Context, v string) context.Context {
+	return context.WithValue(ctx, apiVersionKey{}, v)
+}
+
+// getAPIVersion returns the negotiated api version of request from context.
+func getAPIVersion(ctx context.Context) string {
+	if v, ok := ctx.Value(apiVersionKey{}).(string); ok {
+		return v
+	}
+	return version.APIVersion
+}
+
+// withVersionAdapters converts the request and response of old api versions
+// by the adapters of route. The handler is returned as it is if the route
+// has no adapter.
+func withVersionAdapters(method, route string, adapters []versionAdapter, h serverTypes.Handler) serverTypes.Handler {
+	var routeAdapters []versionAdapter
+	for _, a := range adapters {
+		if a.Method == method && a.Path == route {
+			routeAdapters = append(routeAdapters, a)
+		}
+	}
+	if len(routeAdapters) == 0 {
+		return h
+	}
+
+	// the request is converted from the oldest version to the newest.
+	sort.Slice(routeAdapters, func(i, j int) bool {
+		return httputils.CompareAPIVersion(routeAdapters[i].Version, routeAdapters[j].Version) < 0
+	})
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		v := getAPIVersion(ctx)
+
+		var active []versionAdapter
+		for _, a := range routeAdapters {
+			if httputils.CompareAPIVersion(v, a.Version) <= 0 {
+				active = append(active, a)
+			}
+		}
+		if len(active) == 0 {
+			return h(ctx, rw, req)
+		}
+
+		if err := adaptRequest(req, active); err != nil {
+			return httputils.NewHTTPError(err, http.StatusBadRequest)
+		}
+
+		var adaptResponse bool
+		for _, a := range active {
+			adaptResponse = adaptResponse || a.AdaptResponse != nil
+		}
+		if !adaptResponse {
+			return h(ctx, rw, req)
+		}
+
+		buf := &responseBuffer{header: rw.Header(), code: http.StatusOK}
+		if err := h(ctx, buf, req); err != nil {
+			return err
+		}
+		return writeAdaptedResponse(rw, buf, active)
+	}
+}
+
+// adaptRequest converts the JSON body of request by the adapters in order.
+func adaptRequest(req *http.Request, adapters []versionAdapter) error {
+	var adaptBody bool
+	for _, a := range adapters {
+		adaptBody = adaptBody || a.AdaptRequest != nil
+	}
+	if !adaptBody || req.Body == nil {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+		return nil
+	}
+
+	var body interface{}
+	if err := decodeJSON(data, &body); err != nil {
+		return fmt.Errorf("failed to decode request body: %v", err)
+	}
+	for _, a := range adapters {
+		if a.AdaptRequest == nil {
+			continue
+		}
+		if body, err = a.AdaptRequest(body); err != nil {
+			return fmt.Errorf("failed to convert request of api version %s: %v", a.Version, err)
+		}
+	}
+
+	if data, err = json.Marshal(body); err != nil {
+		return err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	req.ContentLength = int64(len(data))
+	return nil
+}
+
+// writeAdaptedResponse converts the JSON body of response by the adapters
+// from the newest version to the oldest, and writes it.
+func writeAdaptedResponse(rw http.ResponseWriter, buf *responseBuffer, adapters []versionAdapter) error {
+	data := buf.body.Bytes()
+	if buf.body.Len() > 0 {
+		var body interface{}
+		if err := decodeJSON(data, &body); err != nil {
+			return fmt.Errorf("failed to decode response body: %v", err)
+		}
+
+		var err error
+		for i := len(adapters) - 1; i >= 0; i-- {
+			if adapters[i].AdaptResponse == nil {
+				continue
+			}
+			if body, err = adapters[i].AdaptResponse(body); err != nil {
+				return fmt.Errorf("failed to convert response of api version %s: %v", adapters[i].Version, err)
+			}
+		}
+
+		if data, err = json.Marshal(body); err != nil {
+			return err
+		}
+		data = append(data, '\n')
+	}
+
+	rw.Header().Del("Content-Length")
+	rw.WriteHeader(buf.code)
+	_, err := rw.Write(data)
+	return err
+}
+
+// decodeJSON decodes the numbers as json.Number, so that the large integers
+// like the size of memory are not changed by the float conversion.
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// responseBuffer buffers the response of handler, so that it can be
+// converted before written to client.
+type responseBuffer struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+// Header implements http.ResponseWriter.
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+// Write implements http.ResponseWriter.
+func (b *responseBuffer) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (b *responseBuffer) WriteHeader(code int) {
+	b.code = code
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/version"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		header   string
+		expected string
+		err      bool
+	}{
+		{path: "/containers/json", expected: version.APIVersion},
+		{path: "/v1.20/containers/json", expected: "1.20"},
+		{path: "/containers/json", header: "1.20", expected: "1.20"},
+		{path: "/v1.20/containers/json", header: "1.22", expected: "1.20"},
+		{path: "/v99.0/containers/json", err: true},
+		{path: "/v1.2/containers/json", err: true},
+		{path: "/v1.2.3/containers/json", err: true},
+		{path: "/containers/json", header: "latest", err: true},
+	} {
+		var (
+			v   string
+			err error
+		)
+		r := mux.NewRouter()
+		handler := func(w http.ResponseWriter, req *http.Request) {
+			v, err = negotiateAPIVersion(req)
+		}
+		r.Path(versionMatcher + "/containers/json").HandlerFunc(handler)
+		r.Path("/containers/json").HandlerFunc(handler)
+
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.header != "" {
+			req.Header.Set(httputils.APIVersionHeader, tc.header)
+		}
+		r.ServeHTTP(httptest.NewRecorder(), req)
+
+		if tc.err {
+			assert.Error(t, err, "expected error for %s with header %q", tc.path, tc.header)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, v, "unexpected version for %s with header %q", tc.path, tc.header)
+	}
+}
+
+func TestWithVersionAdapters(t *testing.T) {
+	// the field Memory is renamed to MemoryLimit in 1.24, and Count is
+	// changed from number to string in 1.22.
+	adapters := []versionAdapter{
+		{
+			Version: "1.23",
+			Method:  http.MethodPost,
+			Path:    "/test",
+			AdaptRequest: func(body interface{}) (interface{}, error) {
+				m := body.(map[string]interface{})
+				m["MemoryLimit"] = m["Memory"]
+				delete(m, "Memory")
+				return m, nil
+			},
+			AdaptResponse: func(body interface{}) (interface{}, error) {
+				m := body.(map[string]interface{})
+				m["Memory"] = m["MemoryLimit"]
+				delete(m, "MemoryLimit")
+				return m, nil
+			},
+		},
+		{
+			Version: "1.21",
+			Method:  http.MethodPost,
+			Path:    "/test",
+			AdaptResponse: func(body interface{}) (interface{}, error) {
+				m := body.(map[string]interface{})
+				m["Count"] = m["Count"].(json.Number).String()
+				return m, nil
+			},
+		},
+		{
+			Version: "1.21",
+			Method:  http.MethodGet,
+			Path:    "/other",
+		},
+	}
+
+	var received string
+	h := withVersionAdapters(http.MethodPost, "/test", adapters, func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		body, _ := ioutil.ReadAll(req.Body)
+		received = string(body)
+		return EncodeResponse(rw, http.StatusCreated, map[string]interface{}{"MemoryLimit": 9007199254740993, "Count": 3})
+	})
+
+	for _, tc := range []struct {
+		version  string
+		request  string
+		received string
+		response string
+	}{
+		{version: "1.24", request: `{"MemoryLimit":1}`, received: `{"MemoryLimit":1}`, response: `{"Count":3,"MemoryLimit":9007199254740993}`},
+		{version: "1.22", request: `{"Memory":1}`, received: `{"MemoryLimit":1}`, response: `{"Count":3,"Memory":9007199254740993}`},
+		{version: "1.20", request: `{"Memory":1}`, received: `{"MemoryLimit":1}`, response: `{"Count":"3","Memory":9007199254740993}`},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(tc.request))
+		rw := httptest.NewRecorder()
+		assert.NoError(t, h(setAPIVersion(context.Background(), tc.version), rw, req))
+
+		assert.Equal(t, tc.received, received, "unexpected request of version %s", tc.version)
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		assert.Equal(t, tc.response, strings.TrimSpace(rw.Body.String()), "unexpected response of version %s", tc.version)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
 	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
@@ -67,13 +68,8 @@ func (s *Server) createVolume(ctx context.Context, rw http.ResponseWriter, req *
 	return EncodeResponse(rw, http.StatusCreated, respVolume)
 }
 
-func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
-	name := mux.Vars(req)["name"]
-	volume, err := s.VolumeMgr.Get(ctx, name)
-	if err != nil {
-		return err
-	}
-
+// toVolumeInfo converts the volume into the volume info of api.
+func toVolumeInfo(volume *volumetypes.Volume) *types.VolumeInfo {
 	status := map[string]interface{}{}
 	for k, v := range volume.Options() {
 		if k != "" && v != "" {
@@ -82,7 +78,7 @@ func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *htt
 	}
 	status["size"] = volume.Size()
 
-	respVolume := types.VolumeInfo{
+	return &types.VolumeInfo{
 		Name:       volume.Name,
 		Driver:     volume.Driver(),
 		Mountpoint: volume.Path(),
@@ -90,8 +86,51 @@ func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *htt
 		Labels:     volume.Labels,
 		Status:     status,
 	}
+}
+
+func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	name := mux.Vars(req)["name"]
+	volume, err := s.VolumeMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	info := toVolumeInfo(volume)
+
+	// the usage of volume may walk through all the files, so it's only
+	// computed if the size is required.
+	if httputils.BoolValue(req, "size") {
+		stats, err := s.VolumeMgr.Stats(ctx, name)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get stats of volume %s: %v", name, err)
+		} else {
+			info.Stats = toVolumeStats(stats)
+		}
+	}
+
+	return EncodeResponse(rw, http.StatusOK, info)
+}
+
+// toVolumeStats converts the volume stats into the volume stats of api.
+func toVolumeStats(stats *volumetypes.VolumeStats) *types.VolumeStats {
+	return &types.VolumeStats{
+		Used:       stats.Used,
+		Available:  stats.Available,
+		Capacity:   stats.Capacity,
+		InodesUsed: stats.InodesUsed,
+		InodesFree: stats.InodesFree,
+		Inodes:     stats.Inodes,
+	}
+}
 
-	return EncodeResponse(rw, http.StatusOK, respVolume)
+func (s *Server) getVolumeStats(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	stats, err := s.VolumeMgr.Stats(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, toVolumeStats(stats))
 }
 
 func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
@@ -107,23 +146,7 @@ func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *ht
 
 	respVolumes := types.VolumeListResp{Volumes: []*types.VolumeInfo{}, Warnings: nil}
 	for _, volume := range volumes {
-		status := map[string]interface{}{}
-		for k, v := range volume.Options() {
-			if k != "" && v != "" {
-				status[k] = v
-			}
-		}
-		status["size"] = volume.Size()
-
-		respVolume := &types.VolumeInfo{
-			Name:       volume.Name,
-			Driver:     volume.Driver(),
-			Mountpoint: volume.Path(),
-			CreatedAt:  volume.CreateTime(),
-			Labels:     volume.Labels,
-			Status:     status,
-		}
-		respVolumes.Volumes = append(respVolumes.Volumes, respVolume)
+		respVolumes.Volumes = append(respVolumes.Volumes, toVolumeInfo(volume))
 	}
 	return EncodeResponse(rw, http.StatusOK, respVolumes)
 }
@@ -137,3 +160,87 @@ func (s *Server) removeVolume(ctx context.Context, rw http.ResponseWriter, req *
 	rw.WriteHeader(http.StatusNoContent)
 	return nil
 }
+
+// toVolumeSnapshot converts the snapshot into the volume snapshot of api.
+func toVolumeSnapshot(snapshot *volumetypes.VolumeSnapshot) *types.VolumeSnapshot {
+	return &types.VolumeSnapshot{
+		Name:      snapshot.Name,
+		Volume:    snapshot.Volume,
+		Driver:    snapshot.Driver,
+		Labels:    snapshot.Labels,
+		CreatedAt: snapshot.CreateTime(),
+	}
+}
+
+func (s *Server) createVolumeSnapshot(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.VolumeSnapshotCreateConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	name := mux.Vars(req)["name"]
+	snapshot, err := s.VolumeMgr.CreateSnapshot(ctx, name, config.Name, config.Labels)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusCreated, toVolumeSnapshot(snapshot))
+}
+
+func (s *Server) listVolumeSnapshots(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	snapshots, err := s.VolumeMgr.ListSnapshots(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	respSnapshots := make([]*types.VolumeSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		respSnapshots = append(respSnapshots, toVolumeSnapshot(snapshot))
+	}
+	return EncodeResponse(rw, http.StatusOK, respSnapshots)
+}
+
+func (s *Server) removeVolumeSnapshot(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	vars := mux.Vars(req)
+
+	if err := s.VolumeMgr.RemoveSnapshot(ctx, vars["name"], vars["snapshot"]); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) cloneVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.VolumeCloneConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	logCreateOptions(ctx, "volume", config)
+
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	name := mux.Vars(req)["name"]
+	newName := config.Name
+	if newName == "" {
+		newName = randomid.Generate()
+	}
+
+	volume, err := s.VolumeMgr.Clone(ctx, name, config.Snapshot, newName, config.DriverOpts, config.Labels)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusCreated, toVolumeInfo(volume))
+}
@@ -0,0 +1,311 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/metrics"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/streams"
+	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// wsPingInterval is the interval of sending ping frames to the client,
+	// which keeps the idle connection alive through proxies.
+	wsPingInterval = 30 * time.Second
+
+	// wsWriteTimeout is the timeout of writing one frame to the client.
+	wsWriteTimeout = 10 * time.Second
+
+	// wsResizeMessage is the type of control message to resize the tty.
+	wsResizeMessage = "resize"
+)
+
+// wsControlMessage is the control message sent by the client in text frame,
+// for example, {"type": "resize", "height": 24, "width": 80}.
+type wsControlMessage struct {
+	Type   string `json:"type"`
+	Height int64  `json:"height"`
+	Width  int64  `json:"width"`
+}
+
+// wsFrame is one frame of websocket with its payload type.
+type wsFrame struct {
+	payloadType byte
+	data        []byte
+}
+
+// wsFrameCodec sends and receives the whole frame, so that the binary frames
+// and text frames can be told apart.
+var wsFrameCodec = websocket.Codec{
+	Marshal: func(v interface{}) ([]byte, byte, error) {
+		f := v.(*wsFrame)
+		return f.data, f.payloadType, nil
+	},
+	Unmarshal: func(data []byte, payloadType byte, v interface{}) error {
+		f := v.(*wsFrame)
+		f.payloadType, f.data = payloadType, data
+		return nil
+	},
+}
+
+// wsStream adapts the websocket connection to the stdio of attaching. The
+// binary frames from client are the input of stdin, and the text frames are
+// the control messages. The output is written to client in binary frames.
+type wsStream struct {
+	conn   *websocket.Conn
+	resize func(opts types.ResizeOptions) error
+
+	// cancel is called when the client is gone.
+	cancel context.CancelFunc
+
+	stdinR *io.PipeReader
+	stdinW *io.PipeWriter
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func newWSStream(conn *websocket.Conn, useStdin bool, resize func(opts types.ResizeOptions) error, cancel context.CancelFunc) *wsStream {
+	conn.PayloadType = websocket.BinaryFrame
+
+	ws := &wsStream{
+		conn:   conn,
+		resize: resize,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	// NOTE: the frames are always read, so that the control messages are
+	// handled even if the stdin is not attached.
+	if useStdin {
+		ws.stdinR, ws.stdinW = io.Pipe()
+	}
+
+	go ws.readLoop()
+	go ws.pingLoop()
+	return ws
+}
+
+// Stdin returns the stdin of attaching.
+func (ws *wsStream) Stdin() io.ReadCloser {
+	return ws.stdinR
+}
+
+// Write writes the output to client in binary frame.
+func (ws *wsStream) Write(p []byte) (int, error) {
+	ws.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return ws.conn.Write(p)
+}
+
+// Close closes the stdin and the websocket connection.
+func (ws *wsStream) Close() error {
+	var err error
+	ws.closeOnce.Do(func() {
+		close(ws.done)
+		if ws.stdinR != nil {
+			ws.stdinR.Close()
+		}
+		err = ws.conn.Close()
+	})
+	return err
+}
+
+func (ws *wsStream) readLoop() {
+	defer ws.cancel()
+	if ws.stdinW != nil {
+		defer ws.stdinW.Close()
+	}
+
+	for {
+		var f wsFrame
+		if err := wsFrameCodec.Receive(ws.conn, &f); err != nil {
+			if err != io.EOF {
+				log.With(nil).Warnf("failed to read from websocket: %v", err)
+			}
+			return
+		}
+
+		switch f.payloadType {
+		case websocket.TextFrame:
+			ws.handleControlMessage(f.data)
+		case websocket.BinaryFrame:
+			if ws.stdinW == nil {
+				continue
+			}
+			if _, err := ws.stdinW.Write(f.data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (ws *wsStream) handleControlMessage(data []byte) {
+	var msg wsControlMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		log.With(nil).Warnf("failed to decode websocket control message %q: %v", data, err)
+		return
+	}
+
+	if msg.Type != wsResizeMessage {
+		log.With(nil).Warnf("unknown websocket control message type %q", msg.Type)
+		return
+	}
+
+	if err := ws.resize(types.ResizeOptions{Height: msg.Height, Width: msg.Width}); err != nil {
+		log.With(nil).Warnf("failed to resize tty by websocket control message: %v", err)
+	}
+}
+
+// pingLoop sends ping frames periodically, the client is treated as gone if
+// the ping frame can't be sent.
+func (ws *wsStream) pingLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ws.done:
+			return
+		case <-ticker.C:
+			ws.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := wsFrameCodec.Send(ws.conn, &wsFrame{payloadType: websocket.PingFrame}); err != nil {
+				log.With(nil).Warnf("failed to send ping to websocket: %v", err)
+				ws.cancel()
+				return
+			}
+		}
+	}
+}
+
+// serveWebsocket upgrades the request to websocket and calls the handler.
+func serveWebsocket(rw http.ResponseWriter, req *http.Request, handler func(conn *websocket.Conn)) {
+	websocket.Server{
+		Handshake: checkWebsocketOrigin,
+		Handler:   handler,
+	}.ServeHTTP(rw, req)
+}
+
+// checkWebsocketOrigin accepts the clients without Origin header, like the
+// clients of raw hijack protocol, and the browsers only if the page is served
+// by the same host. Otherwise any web page visited by the user could attach
+// to the containers through the browser, which is cross-site websocket
+// hijacking.
+func checkWebsocketOrigin(config *websocket.Config, req *http.Request) error {
+	origin, err := websocket.Origin(config, req)
+	if err != nil {
+		return err
+	}
+	config.Origin = origin
+	if origin == nil {
+		return nil
+	}
+
+	if !strings.EqualFold(origin.Host, req.Host) {
+		return fmt.Errorf("origin %s is not allowed to connect host %s", origin, req.Host)
+	}
+	return nil
+}
+
+func (s *Server) attachContainerWebsocket(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if _, err := s.ContainerMgr.Get(ctx, name); err != nil {
+		return err
+	}
+
+	var (
+		err    error
+		attach = new(streams.AttachConfig)
+	)
+
+	if keys := req.FormValue("detachKeys"); keys != "" {
+		if attach.DetachKeys, err = streams.ParseDetachKeys(keys); err != nil {
+			return httputils.NewHTTPError(err, http.StatusBadRequest)
+		}
+	}
+
+	// NOTE: attach both stdout and stderr if neither is specified.
+	useStdout, useStderr := httputils.BoolValue(req, "stdout"), httputils.BoolValue(req, "stderr")
+	if req.FormValue("stdout") == "" && req.FormValue("stderr") == "" {
+		useStdout, useStderr = true, true
+	}
+	attach.UseStdin = httputils.BoolValue(req, "stdin")
+	attach.UseStdout, attach.UseStderr = useStdout, useStderr
+
+	serveWebsocket(rw, req, func(conn *websocket.Conn) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ws := newWSStream(conn, attach.UseStdin, func(opts types.ResizeOptions) error {
+			return s.ContainerMgr.Resize(ctx, name, opts)
+		}, cancel)
+		defer ws.Close()
+
+		if attach.UseStdin {
+			attach.Stdin = ws.Stdin()
+		}
+		attach.Stdout, attach.Stderr = ws, ws
+
+		if err := s.ContainerMgr.AttachContainerIO(ctx, name, attach); err != nil && err != streams.ErrDetached {
+			ws.Write([]byte(err.Error() + "\r\n"))
+		}
+	})
+	return nil
+}
+
+func (s *Server) startContainerExecWebsocket(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	label := util_metrics.ActionStartLabel
+	metrics.ExecActionsCounter.WithLabelValues(label).Inc()
+
+	name := mux.Vars(req)["name"]
+
+	execInfo, err := s.ContainerMgr.InspectExec(ctx, name)
+	if err != nil {
+		return err
+	}
+	tty := execInfo.ProcessConfig != nil && execInfo.ProcessConfig.Tty
+
+	log.With(ctx).Infof("start exec %s over websocket, tty: %v", name, tty)
+
+	serveWebsocket(rw, req, func(conn *websocket.Conn) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ws := newWSStream(conn, execInfo.OpenStdin, func(opts types.ResizeOptions) error {
+			return s.ContainerMgr.ResizeExec(ctx, name, opts)
+		}, cancel)
+		defer ws.Close()
+
+		attach := &streams.AttachConfig{
+			Terminal:  tty,
+			UseStdout: true,
+			Stdout:    ws,
+		}
+		if execInfo.OpenStdin {
+			attach.UseStdin, attach.Stdin = true, ws.Stdin()
+		}
+		if !tty {
+			attach.UseStderr, attach.Stderr = true, ws
+		}
+
+		if err := s.ContainerMgr.StartExec(ctx, name, attach, 0); err != nil {
+			ws.Write([]byte(err.Error() + "\r\n"))
+			return
+		}
+		metrics.ExecSuccessActionsCounter.WithLabelValues(label).Inc()
+	})
+	return nil
+}
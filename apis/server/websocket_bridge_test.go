@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+func TestWebsocketStream(t *testing.T) {
+	var (
+		resizeCh = make(chan types.ResizeOptions, 1)
+		stdinCh  = make(chan string, 1)
+		doneCh   = make(chan struct{})
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		serveWebsocket(rw, req, func(conn *websocket.Conn) {
+			ctx, cancel := context.WithCancel(context.Background())
+			ws := newWSStream(conn, true, func(opts types.ResizeOptions) error {
+				resizeCh <- opts
+				return nil
+			}, cancel)
+			defer ws.Close()
+
+			ws.Write([]byte("hello"))
+
+			// the stdin is closed after the client is gone.
+			data, _ := ioutil.ReadAll(ws.Stdin())
+			stdinCh <- string(data)
+
+			<-ctx.Done()
+			close(doneCh)
+		})
+	}))
+	defer srv.Close()
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), "", srv.URL)
+	assert.NoError(t, err)
+
+	var f wsFrame
+	assert.NoError(t, wsFrameCodec.Receive(conn, &f))
+	assert.Equal(t, byte(websocket.BinaryFrame), f.payloadType)
+	assert.Equal(t, "hello", string(f.data))
+
+	assert.NoError(t, wsFrameCodec.Send(conn, &wsFrame{payloadType: websocket.TextFrame, data: []byte(`{"type":"resize","height":24,"width":80}`)}))
+	select {
+	case opts := <-resizeCh:
+		assert.Equal(t, types.ResizeOptions{Height: 24, Width: 80}, opts)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected the tty to be resized by control message")
+	}
+
+	// the invalid control message is ignored.
+	assert.NoError(t, wsFrameCodec.Send(conn, &wsFrame{payloadType: websocket.TextFrame, data: []byte(`{"type":"unknown"}`)}))
+	assert.NoError(t, wsFrameCodec.Send(conn, &wsFrame{payloadType: websocket.BinaryFrame, data: []byte("world")}))
+	assert.NoError(t, conn.Close())
+
+	select {
+	case data := <-stdinCh:
+		assert.Equal(t, "world", data)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected the stdin to be closed after the client is gone")
+	}
+
+	select {
+	case <-doneCh:
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected the context to be cancelled after the client is gone")
+	}
+}
+
+func TestWebsocketStreamWithoutStdin(t *testing.T) {
+	resizeCh := make(chan types.ResizeOptions, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		serveWebsocket(rw, req, func(conn *websocket.Conn) {
+			ctx, cancel := context.WithCancel(context.Background())
+			ws := newWSStream(conn, false, func(opts types.ResizeOptions) error {
+				resizeCh <- opts
+				return nil
+			}, cancel)
+			defer ws.Close()
+			<-ctx.Done()
+		})
+	}))
+	defer srv.Close()
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), "", srv.URL)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	// the input is dropped, and the control messages are still handled.
+	assert.NoError(t, wsFrameCodec.Send(conn, &wsFrame{payloadType: websocket.BinaryFrame, data: []byte("dropped")}))
+	assert.NoError(t, wsFrameCodec.Send(conn, &wsFrame{payloadType: websocket.TextFrame, data: []byte(`{"type":"resize","height":40,"width":120}`)}))
+
+	select {
+	case opts := <-resizeCh:
+		assert.Equal(t, types.ResizeOptions{Height: 40, Width: 120}, opts)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected the tty to be resized by control message")
+	}
+}
+
+func TestWebsocketOrigin(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		serveWebsocket(rw, req, func(conn *websocket.Conn) {
+			conn.Close()
+		})
+	}))
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	// the page served by other host is rejected.
+	_, err := websocket.Dial(url, "", "http://evil.example.com")
+	assert.Error(t, err)
+
+	// the client without Origin header is accepted.
+	req := httptest.NewRequest(http.MethodGet, "/containers/foo/attach/ws", nil)
+	assert.NoError(t, checkWebsocketOrigin(&websocket.Config{Version: websocket.ProtocolVersionHybi13}, req))
+
+	req.Header.Set("Origin", "http://"+req.Host)
+	assert.NoError(t, checkWebsocketOrigin(&websocket.Config{Version: websocket.ProtocolVersionHybi13}, req))
+
+	req.Header.Set("Origin", "http://evil.example.com")
+	assert.Error(t, checkWebsocketOrigin(&websocket.Config{Version: websocket.ProtocolVersionHybi13}, req))
+}
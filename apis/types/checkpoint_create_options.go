@@ -22,6 +22,24 @@ type CheckpointCreateOptions struct {
 
 	// exit
 	Exit bool `json:"Exit,omitempty"`
+
+	// lazy pages
+	LazyPages bool `json:"LazyPages,omitempty"`
+
+	// page server
+	PageServer string `json:"PageServer,omitempty"`
+
+	// ID of the parent checkpoint in the same checkpoint directory, only the memory pages changed since the parent are dumped.
+	ParentCheckpoint string `json:"ParentCheckpoint,omitempty"`
+
+	// Dump only the memory pages and keep the container running, the pre-dump can only be the parent of other checkpoints.
+	PreDump bool `json:"PreDump,omitempty"`
+
+	// Store the changes of container's rootfs in the checkpoint, which are applied to the rootfs of container which the checkpoint is imported into. It requires Exit.
+	RootfsDiff bool `json:"RootfsDiff,omitempty"`
+
+	// TCP established
+	TCPEstablished bool `json:"TCPEstablished,omitempty"`
 }
 
 // Validate validates this checkpoint create options
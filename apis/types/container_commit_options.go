@@ -17,6 +17,9 @@ type ContainerCommitOptions struct {
 	// author is the one build the image
 	Author string `json:"Author,omitempty"`
 
+	// changes are the Dockerfile instructions applied to the image config, CMD, ENV and EXPOSE are supported
+	Changes []string `json:"Changes"`
+
 	// comment is external information add for the image
 	Comment string `json:"Comment,omitempty"`
 
@@ -61,14 +61,16 @@ type ContainerConfig struct {
 	// An object mapping ports to an empty object in the form:`{<port>/<tcp|udp>: {}}`
 	ExposedPorts map[string]interface{} `json:"ExposedPorts,omitempty"`
 
+	// healthcheck
+	Healthcheck *HealthConfig `json:"Healthcheck,omitempty"`
+
 	// The hostname to use for the container, as a valid RFC 1123 hostname.
 	// Min Length: 1
 	// Format: hostname
 	Hostname strfmt.Hostname `json:"Hostname,omitempty"`
 
-	// The name of the image to use when creating the container
-	// Required: true
-	Image string `json:"Image"`
+	// The name of the image to use when creating the container, it is required unless `HostConfig.Rootfs` is specified.
+	Image string `json:"Image,omitempty"`
 
 	// Initial script executed in container. The script will be executed before entrypoint or command
 	InitScript string `json:"InitScript,omitempty"`
@@ -108,7 +110,10 @@ type ContainerConfig struct {
 	Shell []string `json:"Shell"`
 
 	// The snapshotter container choose, can be different with
-	// default snapshotter. The Field only set through hook plugin.
+	// default snapshotter. The field is set by user, or set to the
+	// remote snapshotter if the image is lazily pulled, or set by the
+	// image label `io.alibaba.pouch.snapshotter`, and it can be
+	// changed through hook plugin.
 	//
 	Snapshotter string `json:"Snapshotter,omitempty"`
 
@@ -154,11 +159,11 @@ func (m *ContainerConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateHostname(formats); err != nil {
+	if err := m.validateHealthcheck(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := m.validateImage(formats); err != nil {
+	if err := m.validateHostname(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -217,6 +222,24 @@ func (m *ContainerConfig) validateExposedPorts(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ContainerConfig) validateHealthcheck(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Healthcheck) { // not required
+		return nil
+	}
+
+	if m.Healthcheck != nil {
+		if err := m.Healthcheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Healthcheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ContainerConfig) validateHostname(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Hostname) { // not required
@@ -234,15 +257,6 @@ func (m *ContainerConfig) validateHostname(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *ContainerConfig) validateImage(formats strfmt.Registry) error {
-
-	if err := validate.RequiredString("Image", "body", string(m.Image)); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 var containerConfigTypeRichModePropEnum []interface{}
 
 func init() {
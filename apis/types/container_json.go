@@ -25,6 +25,9 @@ type ContainerJSON struct {
 	// The arguments to the command being run
 	Args []string `json:"Args"`
 
+	// The capabilities of container resolved when it is created.
+	Capabilities []string `json:"Capabilities"`
+
 	// config
 	Config *ContainerConfig `json:"Config,omitempty"`
 
@@ -73,6 +76,9 @@ type ContainerJSON struct {
 	// NetworkSettings exposes the network settings in the API.
 	NetworkSettings *NetworkSettings `json:"NetworkSettings,omitempty"`
 
+	// Whether the processes of container are prevented from gaining new privileges.
+	NoNewPrivileges bool `json:"NoNewPrivileges,omitempty"`
+
 	// The path to the command being run
 	Path string `json:"Path,omitempty"`
 
@@ -85,6 +91,9 @@ type ContainerJSON struct {
 	// the container's restart time
 	RestartCount int64 `json:"RestartCount,omitempty"`
 
+	// The seccomp profile of container, it is `unconfined`, `pouch/default` for the built-in default profile, or the path of profile.
+	SeccompProfile string `json:"SeccompProfile,omitempty"`
+
 	// The total size of all the files in this container.
 	SizeRootFs *int64 `json:"SizeRootFs,omitempty"`
 
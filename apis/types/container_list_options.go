@@ -36,6 +36,9 @@ type ContainerListOptions struct {
 
 	// since
 	Since string `json:"Since,omitempty"`
+
+	// size
+	Size bool `json:"Size,omitempty"`
 }
 
 // Validate validates this container list options
@@ -22,6 +22,15 @@ type ContainerStartOptions struct {
 
 	// detach keys
 	DetachKeys string `json:"DetachKeys,omitempty"`
+
+	// lazy pages
+	LazyPages bool `json:"LazyPages,omitempty"`
+
+	// page server
+	PageServer string `json:"PageServer,omitempty"`
+
+	// TCP established
+	TCPEstablished bool `json:"TCPEstablished,omitempty"`
 }
 
 // Validate validates this container start options
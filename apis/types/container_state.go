@@ -45,6 +45,9 @@ type ContainerState struct {
 	// Required: true
 	FinishedAt string `json:"FinishedAt"`
 
+	// health
+	Health *Health `json:"Health,omitempty"`
+
 	// Whether this container has been killed because it ran out of memory.
 	// Required: true
 	OOMKilled bool `json:"OOMKilled"`
@@ -104,6 +107,10 @@ func (m *ContainerState) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOOMKilled(formats); err != nil {
 		res = append(res, err)
 	}
@@ -174,6 +181,24 @@ func (m *ContainerState) validateFinishedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ContainerState) validateHealth(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Health) { // not required
+		return nil
+	}
+
+	if m.Health != nil {
+		if err := m.Health.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Health")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ContainerState) validateOOMKilled(formats strfmt.Registry) error {
 
 	if err := validate.Required("OOMKilled", "body", bool(m.OOMKilled)); err != nil {
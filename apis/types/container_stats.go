@@ -6,6 +6,8 @@ package types
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -22,6 +24,9 @@ type ContainerStats struct {
 	// cpu stats
 	CPUStats *CPUStats `json:"cpu_stats,omitempty"`
 
+	// the utilization of GPUs used by container.
+	GPUStats []*GPUStats `json:"gpu_stats,omitempty"`
+
 	// container id
 	ID string `json:"id,omitempty"`
 
@@ -57,6 +62,10 @@ func (m *ContainerStats) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateGPUStats(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMemoryStats(formats); err != nil {
 		res = append(res, err)
 	}
@@ -119,6 +128,31 @@ func (m *ContainerStats) validateCPUStats(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ContainerStats) validateGPUStats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.GPUStats) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.GPUStats); i++ {
+		if swag.IsZero(m.GPUStats[i]) { // not required
+			continue
+		}
+
+		if m.GPUStats[i] != nil {
+			if err := m.GPUStats[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("gpu_stats" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ContainerStats) validateMemoryStats(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.MemoryStats) { // not required
@@ -39,14 +39,23 @@ type ExecCreateConfig struct {
 	// envs for exec command in container
 	Env []string `json:"Env"`
 
+	// Additional groups that the exec process will run as, they are appended to the additional groups of container
+	GroupAdd []string `json:"GroupAdd"`
+
 	// Is the container in privileged mode
 	Privileged bool `json:"Privileged,omitempty"`
 
+	// Resources of the exec process, the process is started in a dedicated sub cgroup of container if specified, and the container is frozen while it starts. Only cgroup v1 and runc older than 1.1 are supported
+	Resources *ExecResources `json:"Resources,omitempty"`
+
 	// Attach standard streams to a tty
 	Tty bool `json:"Tty,omitempty"`
 
 	// User that will run the command
 	User string `json:"User,omitempty"`
+
+	// Working directory of exec command in container, default is the working directory of container
+	WorkingDir string `json:"WorkingDir,omitempty"`
 }
 
 // Validate validates this exec create config
@@ -57,6 +66,10 @@ func (m *ExecCreateConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -78,6 +91,24 @@ func (m *ExecCreateConfig) validateCmd(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ExecCreateConfig) validateResources(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Resources) { // not required
+		return nil
+	}
+
+	if m.Resources != nil {
+		if err := m.Resources.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Resources")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ExecCreateConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
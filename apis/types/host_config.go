@@ -19,7 +19,7 @@ import (
 // swagger:model HostConfig
 type HostConfig struct {
 
-	// Automatically remove the container when the container's process exits. This has no effect if `RestartPolicy` is set.
+	// Automatically remove the container and its anonymous volumes when the container's process exits or the container is stopped. It conflicts with `RestartPolicy`.
 	AutoRemove bool `json:"AutoRemove,omitempty"`
 
 	// A list of volume bindings for this container. Each volume binding is a string in one of these forms:
@@ -63,16 +63,25 @@ type HostConfig struct {
 	// A list of DNS search domains.
 	DNSSearch []string `json:"DnsSearch"`
 
+	// A list of containers which should be started before the container.
+	DependsOn []*ContainerDependency `json:"DependsOn"`
+
 	// Whether to enable lxcfs.
 	EnableLxcfs bool `json:"EnableLxcfs,omitempty"`
 
-	// A list of hostnames/IP mappings to add to the container's `/etc/hosts` file. Specified in the form `["hostname:IP"]`.
+	// A list of hostnames/IP mappings to add to the container's `/etc/hosts` file. Specified in the form `["hostname:IP"]`, the IP `host-gateway` is resolved to the gateway of default bridge network or `--host-gateway-ip` of daemon.
 	//
 	ExtraHosts []string `json:"ExtraHosts"`
 
 	// A list of additional groups that the container process will run as.
 	GroupAdd []string `json:"GroupAdd"`
 
+	// The OCI hooks of container, which are executed after the hooks of daemon at the same stage.
+	Hooks []*OCIHook `json:"Hooks"`
+
+	// Run an init as the first process of container, which forwards signals to the entrypoint and reaps the zombie processes. The default of daemon is used if it is omitted.
+	Init *bool `json:"Init,omitempty"`
+
 	// Initial script executed in container. The script will be executed before entrypoint or command
 	InitScript string `json:"InitScript,omitempty"`
 
@@ -97,12 +106,28 @@ type HostConfig struct {
 	// The logging configuration for this container
 	LogConfig *LogConfig `json:"LogConfig,omitempty"`
 
-	// Masks over the provided paths inside the container.
+	// Masks over the provided paths inside the container, the default kernel paths are masked if not set, an empty list masks nothing.
 	MaskedPaths []string `json:"MaskedPaths"`
 
-	// Network mode to use for this container. Supported standard values are: `netns:<path>`, `bridge`, `host`, `none`, and `container:<name|id>`. Any other value is taken as a custom network's name to which this container should connect to.
+	// The action to take when the memory of container is under pressure.
+	MemoryPressurePolicy *MemoryPressurePolicy `json:"MemoryPressurePolicy,omitempty"`
+
+	// The bandwidth limits of container network.
+	NetworkBandwidth *NetworkBandwidth `json:"NetworkBandwidth,omitempty"`
+
+	// Network mode to use for this container. Supported standard values are: `netns:<path>`, `bridge`, `host`, `none`, `container:<name|id>` and `cni[:<network>,...]`, which sets up the network by the CNI plugins. Any other value is taken as a custom network's name to which this container should connect to.
 	NetworkMode string `json:"NetworkMode,omitempty"`
 
+	// The NUMA placement policy of the container, it is resolved to `CpusetCpus` and `CpusetMems` when creating the container.
+	//
+	// - `pack` places the CPUs into as few NUMA nodes as possible
+	// - `spread` spreads the CPUs across the NUMA nodes
+	//
+	// The number of CPUs is computed by `CpuQuota` and `CpuPeriod`, or `NanoCpus`. The whole NUMA nodes are used if it is not set. The NUMA nodes can be limited by `CpusetMems`.
+	//
+	// Enum: [spread pack]
+	NumaPolicy string `json:"NumaPolicy,omitempty"`
+
 	// An integer value containing the score given to the container in order to tune OOM killer preferences.
 	// The range is in [-1000, 1000].
 	//
@@ -113,6 +138,7 @@ type HostConfig struct {
 	// Set the PID (Process) Namespace mode for the container. It can be either:
 	// - `"container:<name|id>"`: joins another container's PID namespace
 	// - `"host"`: use the host's PID namespace inside the container
+	// - `"private"` or empty: own private PID namespace
 	//
 	PidMode string `json:"PidMode,omitempty"`
 
@@ -125,10 +151,10 @@ type HostConfig struct {
 	// Allocates a random host port for all of a container's exposed ports.
 	PublishAllPorts bool `json:"PublishAllPorts,omitempty"`
 
-	// Set the provided paths as RO inside the container.
+	// Set the provided paths as RO inside the container, the default kernel paths are read only if not set, an empty list sets nothing.
 	ReadonlyPaths []string `json:"ReadonlyPaths"`
 
-	// Mount the container's root filesystem as read only.
+	// Mount the container's root filesystem as read only, `/tmp` and `/run` are mounted as tmpfs if they are not mounted by the container.
 	ReadonlyRootfs bool `json:"ReadonlyRootfs,omitempty"`
 
 	// Restart policy to be used to manage the container
@@ -141,6 +167,9 @@ type HostConfig struct {
 	// Enum: [dumb-init sbin-init systemd]
 	RichMode string `json:"RichMode,omitempty"`
 
+	// The existing root filesystem on host to create the container from, instead of an image.
+	Rootfs *RootfsConfig `json:"Rootfs,omitempty"`
+
 	// Runtime to use with this container.
 	Runtime string `json:"Runtime,omitempty"`
 
@@ -158,7 +187,7 @@ type HostConfig struct {
 	//
 	StorageOpt map[string]string `json:"StorageOpt,omitempty"`
 
-	// A list of kernel parameters (sysctls) to set in the container. For example: `{"net.ipv4.ip_forward": "1"}`
+	// A list of kernel parameters (sysctls) to set in the container. For example: `{"net.ipv4.ip_forward": "1"}` Only the namespaced ones are allowed, net.* is rejected with host network, and kernel.msg*, kernel.sem, kernel.shm*, fs.mqueue.* with host ipc.
 	//
 	Sysctls map[string]string `json:"Sysctls,omitempty"`
 
@@ -166,7 +195,11 @@ type HostConfig struct {
 	//
 	Tmpfs map[string]string `json:"Tmpfs,omitempty"`
 
-	// UTS namespace to use for the container.
+	// UTS namespace to use for the container. It can be either:
+	// - `"container:<name|id>"`: joins another container's UTS namespace, whose hostname and domainname are used, so they can't be set
+	// - `"host"`: use the host's UTS namespace inside the container
+	// - `"private"` or empty: own private UTS namespace
+	//
 	UTSMode string `json:"UTSMode,omitempty"`
 
 	// Sets the usernamespace mode for the container when usernamespace remapping option is enabled.
@@ -207,12 +240,18 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		DNSSearch []string `json:"DnsSearch"`
 
+		DependsOn []*ContainerDependency `json:"DependsOn"`
+
 		EnableLxcfs bool `json:"EnableLxcfs,omitempty"`
 
 		ExtraHosts []string `json:"ExtraHosts"`
 
 		GroupAdd []string `json:"GroupAdd"`
 
+		Hooks []*OCIHook `json:"Hooks"`
+
+		Init *bool `json:"Init,omitempty"`
+
 		InitScript string `json:"InitScript,omitempty"`
 
 		IpcMode string `json:"IpcMode,omitempty"`
@@ -225,8 +264,14 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		MaskedPaths []string `json:"MaskedPaths"`
 
+		MemoryPressurePolicy *MemoryPressurePolicy `json:"MemoryPressurePolicy,omitempty"`
+
+		NetworkBandwidth *NetworkBandwidth `json:"NetworkBandwidth,omitempty"`
+
 		NetworkMode string `json:"NetworkMode,omitempty"`
 
+		NumaPolicy string `json:"NumaPolicy,omitempty"`
+
 		OomScoreAdj int64 `json:"OomScoreAdj,omitempty"`
 
 		PidMode string `json:"PidMode,omitempty"`
@@ -247,6 +292,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		RichMode string `json:"RichMode,omitempty"`
 
+		Rootfs *RootfsConfig `json:"Rootfs,omitempty"`
+
 		Runtime string `json:"Runtime,omitempty"`
 
 		RuntimeType string `json:"RuntimeType,omitempty"`
@@ -295,12 +342,18 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.DNSSearch = dataAO0.DNSSearch
 
+	m.DependsOn = dataAO0.DependsOn
+
 	m.EnableLxcfs = dataAO0.EnableLxcfs
 
 	m.ExtraHosts = dataAO0.ExtraHosts
 
 	m.GroupAdd = dataAO0.GroupAdd
 
+	m.Hooks = dataAO0.Hooks
+
+	m.Init = dataAO0.Init
+
 	m.InitScript = dataAO0.InitScript
 
 	m.IpcMode = dataAO0.IpcMode
@@ -313,8 +366,14 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.MaskedPaths = dataAO0.MaskedPaths
 
+	m.MemoryPressurePolicy = dataAO0.MemoryPressurePolicy
+
+	m.NetworkBandwidth = dataAO0.NetworkBandwidth
+
 	m.NetworkMode = dataAO0.NetworkMode
 
+	m.NumaPolicy = dataAO0.NumaPolicy
+
 	m.OomScoreAdj = dataAO0.OomScoreAdj
 
 	m.PidMode = dataAO0.PidMode
@@ -335,6 +394,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.RichMode = dataAO0.RichMode
 
+	m.Rootfs = dataAO0.Rootfs
+
 	m.Runtime = dataAO0.Runtime
 
 	m.RuntimeType = dataAO0.RuntimeType
@@ -394,12 +455,18 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		DNSSearch []string `json:"DnsSearch"`
 
+		DependsOn []*ContainerDependency `json:"DependsOn"`
+
 		EnableLxcfs bool `json:"EnableLxcfs,omitempty"`
 
 		ExtraHosts []string `json:"ExtraHosts"`
 
 		GroupAdd []string `json:"GroupAdd"`
 
+		Hooks []*OCIHook `json:"Hooks"`
+
+		Init *bool `json:"Init,omitempty"`
+
 		InitScript string `json:"InitScript,omitempty"`
 
 		IpcMode string `json:"IpcMode,omitempty"`
@@ -412,8 +479,14 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		MaskedPaths []string `json:"MaskedPaths"`
 
+		MemoryPressurePolicy *MemoryPressurePolicy `json:"MemoryPressurePolicy,omitempty"`
+
+		NetworkBandwidth *NetworkBandwidth `json:"NetworkBandwidth,omitempty"`
+
 		NetworkMode string `json:"NetworkMode,omitempty"`
 
+		NumaPolicy string `json:"NumaPolicy,omitempty"`
+
 		OomScoreAdj int64 `json:"OomScoreAdj,omitempty"`
 
 		PidMode string `json:"PidMode,omitempty"`
@@ -434,6 +507,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		RichMode string `json:"RichMode,omitempty"`
 
+		Rootfs *RootfsConfig `json:"Rootfs,omitempty"`
+
 		Runtime string `json:"Runtime,omitempty"`
 
 		RuntimeType string `json:"RuntimeType,omitempty"`
@@ -479,12 +554,18 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.DNSSearch = m.DNSSearch
 
+	dataAO0.DependsOn = m.DependsOn
+
 	dataAO0.EnableLxcfs = m.EnableLxcfs
 
 	dataAO0.ExtraHosts = m.ExtraHosts
 
 	dataAO0.GroupAdd = m.GroupAdd
 
+	dataAO0.Hooks = m.Hooks
+
+	dataAO0.Init = m.Init
+
 	dataAO0.InitScript = m.InitScript
 
 	dataAO0.IpcMode = m.IpcMode
@@ -497,8 +578,14 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.MaskedPaths = m.MaskedPaths
 
+	dataAO0.MemoryPressurePolicy = m.MemoryPressurePolicy
+
+	dataAO0.NetworkBandwidth = m.NetworkBandwidth
+
 	dataAO0.NetworkMode = m.NetworkMode
 
+	dataAO0.NumaPolicy = m.NumaPolicy
+
 	dataAO0.OomScoreAdj = m.OomScoreAdj
 
 	dataAO0.PidMode = m.PidMode
@@ -519,6 +606,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.RichMode = m.RichMode
 
+	dataAO0.Rootfs = m.Rootfs
+
 	dataAO0.Runtime = m.Runtime
 
 	dataAO0.RuntimeType = m.RuntimeType
@@ -564,6 +653,14 @@ func (m *HostConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDependsOn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHooks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIsolation(formats); err != nil {
 		res = append(res, err)
 	}
@@ -572,6 +669,18 @@ func (m *HostConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMemoryPressurePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNetworkBandwidth(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNumaPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOomScoreAdj(formats); err != nil {
 		res = append(res, err)
 	}
@@ -588,6 +697,10 @@ func (m *HostConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootfs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateShmSize(formats); err != nil {
 		res = append(res, err)
 	}
@@ -633,6 +746,56 @@ func (m *HostConfig) validateConsoleSize(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostConfig) validateDependsOn(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DependsOn) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DependsOn); i++ {
+		if swag.IsZero(m.DependsOn[i]) { // not required
+			continue
+		}
+
+		if m.DependsOn[i] != nil {
+			if err := m.DependsOn[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("DependsOn" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostConfig) validateHooks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hooks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hooks); i++ {
+		if swag.IsZero(m.Hooks[i]) { // not required
+			continue
+		}
+
+		if m.Hooks[i] != nil {
+			if err := m.Hooks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Hooks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var hostConfigTypeIsolationPropEnum []interface{}
 
 func init() {
@@ -685,6 +848,76 @@ func (m *HostConfig) validateLogConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostConfig) validateMemoryPressurePolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MemoryPressurePolicy) { // not required
+		return nil
+	}
+
+	if m.MemoryPressurePolicy != nil {
+		if err := m.MemoryPressurePolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("MemoryPressurePolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var hostConfigTypeNumaPolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["spread","pack"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostConfigTypeNumaPolicyPropEnum = append(hostConfigTypeNumaPolicyPropEnum, v)
+	}
+}
+
+// property enum
+func (m *HostConfig) validateNumaPolicyEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, hostConfigTypeNumaPolicyPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostConfig) validateNumaPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NumaPolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateNumaPolicyEnum("NumaPolicy", "body", m.NumaPolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostConfig) validateNetworkBandwidth(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NetworkBandwidth) { // not required
+		return nil
+	}
+
+	if m.NetworkBandwidth != nil {
+		if err := m.NetworkBandwidth.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("NetworkBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *HostConfig) validateOomScoreAdj(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.OomScoreAdj) { // not required
@@ -770,6 +1003,24 @@ func (m *HostConfig) validateRichMode(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostConfig) validateRootfs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Rootfs) { // not required
+		return nil
+	}
+
+	if m.Rootfs != nil {
+		if err := m.Rootfs.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Rootfs")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *HostConfig) validateShmSize(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ShmSize) { // not required
@@ -42,6 +42,9 @@ type ImageInfo struct {
 
 	// size of image's taking disk space.
 	Size int64 `json:"Size,omitempty"`
+
+	// trust
+	Trust *ImageTrust `json:"Trust,omitempty"`
 }
 
 // Validate validates this image info
@@ -56,6 +59,10 @@ func (m *ImageInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTrust(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -98,6 +105,24 @@ func (m *ImageInfo) validateRootFS(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ImageInfo) validateTrust(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Trust) { // not required
+		return nil
+	}
+
+	if m.Trust != nil {
+		if err := m.Trust.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Trust")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ImageInfo) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -14,6 +14,11 @@ import (
 // swagger:model IndexInfo
 type IndexInfo struct {
 
+	// Suffix of the credential helper `docker-credential-<suffix>` which
+	// provides the credentials of the registry.
+	//
+	CredentialHelper string `json:"CredentialHelper,omitempty"`
+
 	// List of mirrors, expressed as URIs.
 	//
 	Mirrors []string `json:"Mirrors"`
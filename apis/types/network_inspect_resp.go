@@ -39,6 +39,9 @@ type NetworkInspectResp struct {
 	// Options holds the network specific options to use for when creating the network.
 	Options map[string]string `json:"Options,omitempty"`
 
+	// Policy is the traffic policy between containers on the network.
+	Policy *NetworkPolicy `json:"Policy,omitempty"`
+
 	// Scope describes the level at which the network exists.
 	Scope string `json:"Scope,omitempty"`
 }
@@ -51,6 +54,10 @@ func (m *NetworkInspectResp) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,6 +82,24 @@ func (m *NetworkInspectResp) validateIPAM(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NetworkInspectResp) validatePolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	if m.Policy != nil {
+		if err := m.Policy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NetworkInspectResp) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -86,9 +86,15 @@ type Resources struct {
 	// a list of cgroup rules to apply to the container
 	DeviceCgroupRules []string `json:"DeviceCgroupRules"`
 
-	// A list of devices to add to the container.
+	// A list of requests for devices to be sent to device drivers, such as GPUs.
+	DeviceRequests []*DeviceRequest `json:"DeviceRequests"`
+
+	// A list of devices to add to the container. The CDI device is requested by the qualified name `<vendor>/<class>=<name>` as `PathOnHost`, such as `vendor.com/gpu=gpu0`, the edits of its CDI spec loaded from `/etc/cdi` and `/var/run/cdi` are merged into the container.
 	Devices []*DeviceMapping `json:"Devices"`
 
+	// Hugepage limits of the container, one limit for each page size.
+	HugepageLimits []*HugepageLimit `json:"HugepageLimits"`
+
 	// Maximum IO in bytes per second for the container system drive (Windows only)
 	IOMaximumBandwidth uint64 `json:"IOMaximumBandwidth"`
 
@@ -180,10 +186,18 @@ func (m *Resources) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDeviceRequests(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDevices(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHugepageLimits(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMemorySwappiness(formats); err != nil {
 		res = append(res, err)
 	}
@@ -361,6 +375,31 @@ func (m *Resources) validateCPUPeriod(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Resources) validateDeviceRequests(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DeviceRequests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DeviceRequests); i++ {
+		if swag.IsZero(m.DeviceRequests[i]) { // not required
+			continue
+		}
+
+		if m.DeviceRequests[i] != nil {
+			if err := m.DeviceRequests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("DeviceRequests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Resources) validateDevices(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Devices) { // not required
@@ -386,6 +425,31 @@ func (m *Resources) validateDevices(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Resources) validateHugepageLimits(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HugepageLimits) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HugepageLimits); i++ {
+		if swag.IsZero(m.HugepageLimits[i]) { // not required
+			continue
+		}
+
+		if m.HugepageLimits[i] != nil {
+			if err := m.HugepageLimits[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("HugepageLimits" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Resources) validateMemorySwappiness(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.MemorySwappiness) { // not required
@@ -20,6 +20,15 @@ import (
 // swagger:model Runtime
 type Runtime struct {
 
+	// Default annotations of OCI spec of the containers using the runtime, which are overridden by the spec annotations of container.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Absolute path of the OCI spec template in json of the containers using the runtime, which is read and merged into the default spec of daemon every time the container starts, so the changes of template take effect at the next start.
+	BaseSpec string `json:"baseSpec,omitempty"`
+
+	// Default bind mounts of the containers using the runtime, in the form of `source:destination[:options]`, which are skipped if the destination is mounted by the container.
+	Mounts []string `json:"mounts,omitempty"`
+
 	// Options are config options for specific runtime.
 	Options interface{} `json:"options,omitempty"`
 
@@ -30,6 +30,11 @@ type SystemInfo struct {
 	// Enum: [cgroupfs systemd]
 	CgroupDriver string `json:"CgroupDriver,omitempty"`
 
+	// The version of cgroup hierarchy, `2` means the unified hierarchy.
+	//
+	// Enum: [1 2]
+	CgroupVersion string `json:"CgroupVersion,omitempty"`
+
 	// containerd commit
 	ContainerdCommit *Commit `json:"ContainerdCommit,omitempty"`
 
@@ -185,6 +190,12 @@ type SystemInfo struct {
 	// runc commit
 	RuncCommit *Commit `json:"RuncCommit,omitempty"`
 
+	// The status and capabilities of the runtimes configured on the daemon,
+	// which are probed when the daemon starts. Keys hold the "name" used to
+	// reference the runtime.
+	//
+	RuntimeStatus map[string]RuntimeStatus `json:"RuntimeStatus,omitempty"`
+
 	// List of [OCI compliant](https://github.com/opencontainers/runtime-spec)
 	// runtimes configured on the daemon. Keys hold the "name" used to
 	// reference the runtime.
@@ -224,6 +235,10 @@ func (m *SystemInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCgroupVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateContainerdCommit(formats); err != nil {
 		res = append(res, err)
 	}
@@ -236,6 +251,10 @@ func (m *SystemInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRuntimeStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRuntimes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -289,6 +308,49 @@ func (m *SystemInfo) validateCgroupDriver(formats strfmt.Registry) error {
 	return nil
 }
 
+var systemInfoTypeCgroupVersionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["1","2"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		systemInfoTypeCgroupVersionPropEnum = append(systemInfoTypeCgroupVersionPropEnum, v)
+	}
+}
+
+const (
+
+	// SystemInfoCgroupVersionNr1 captures enum value "1"
+	SystemInfoCgroupVersionNr1 string = "1"
+
+	// SystemInfoCgroupVersionNr2 captures enum value "2"
+	SystemInfoCgroupVersionNr2 string = "2"
+)
+
+// prop value enum
+func (m *SystemInfo) validateCgroupVersionEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, systemInfoTypeCgroupVersionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SystemInfo) validateCgroupVersion(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CgroupVersion) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCgroupVersionEnum("CgroupVersion", "body", m.CgroupVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *SystemInfo) validateContainerdCommit(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ContainerdCommit) { // not required
@@ -343,6 +405,28 @@ func (m *SystemInfo) validateRuncCommit(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *SystemInfo) validateRuntimeStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RuntimeStatus) { // not required
+		return nil
+	}
+
+	for k := range m.RuntimeStatus {
+
+		if err := validate.Required("RuntimeStatus"+"."+k, "body", m.RuntimeStatus[k]); err != nil {
+			return err
+		}
+		if val, ok := m.RuntimeStatus[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *SystemInfo) validateRuntimes(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Runtimes) { // not required
@@ -28,6 +28,12 @@ type UpdateConfig struct {
 	// List of labels set to container.
 	Label []string `json:"Label"`
 
+	// List of label keys removed from container.
+	RemoveLabel []string `json:"RemoveLabel"`
+
+	// List of specAnnotation keys removed from container.
+	RemoveSpecAnnotation []string `json:"RemoveSpecAnnotation"`
+
 	// restart policy
 	RestartPolicy *RestartPolicy `json:"RestartPolicy,omitempty"`
 
@@ -52,6 +58,10 @@ func (m *UpdateConfig) UnmarshalJSON(raw []byte) error {
 
 		Label []string `json:"Label"`
 
+		RemoveLabel []string `json:"RemoveLabel"`
+
+		RemoveSpecAnnotation []string `json:"RemoveSpecAnnotation"`
+
 		RestartPolicy *RestartPolicy `json:"RestartPolicy,omitempty"`
 
 		SpecAnnotation map[string]string `json:"SpecAnnotation,omitempty"`
@@ -66,6 +76,10 @@ func (m *UpdateConfig) UnmarshalJSON(raw []byte) error {
 
 	m.Label = dataAO1.Label
 
+	m.RemoveLabel = dataAO1.RemoveLabel
+
+	m.RemoveSpecAnnotation = dataAO1.RemoveSpecAnnotation
+
 	m.RestartPolicy = dataAO1.RestartPolicy
 
 	m.SpecAnnotation = dataAO1.SpecAnnotation
@@ -90,6 +104,10 @@ func (m UpdateConfig) MarshalJSON() ([]byte, error) {
 
 		Label []string `json:"Label"`
 
+		RemoveLabel []string `json:"RemoveLabel"`
+
+		RemoveSpecAnnotation []string `json:"RemoveSpecAnnotation"`
+
 		RestartPolicy *RestartPolicy `json:"RestartPolicy,omitempty"`
 
 		SpecAnnotation map[string]string `json:"SpecAnnotation,omitempty"`
@@ -101,6 +119,10 @@ func (m UpdateConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO1.Label = m.Label
 
+	dataAO1.RemoveLabel = m.RemoveLabel
+
+	dataAO1.RemoveSpecAnnotation = m.RemoveSpecAnnotation
+
 	dataAO1.RestartPolicy = m.RestartPolicy
 
 	dataAO1.SpecAnnotation = m.SpecAnnotation
@@ -38,6 +38,9 @@ type VolumeInfo struct {
 	//
 	Scope string `json:"Scope,omitempty"`
 
+	// Usage and capacity of the volume, only returned if the size is required.
+	Stats *VolumeStats `json:"Stats,omitempty"`
+
 	// Status provides low-level status information about the volume.
 	Status map[string]interface{} `json:"Status,omitempty"`
 }
@@ -46,6 +49,10 @@ type VolumeInfo struct {
 func (m *VolumeInfo) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateStats(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -56,6 +63,24 @@ func (m *VolumeInfo) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *VolumeInfo) validateStats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Stats) { // not required
+		return nil
+	}
+
+	if m.Stats != nil {
+		if err := m.Stats.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Stats")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // additional properties value enum
 var volumeInfoStatusValueEnum []interface{}
 
@@ -43,8 +43,14 @@ var checkpointCreateDescription = "Create a checkpoint from a running container
 type CheckpointCreateCommand struct {
 	CheckpointCommand
 
-	leaveRunning bool
-	cpDir        string
+	leaveRunning   bool
+	cpDir          string
+	tcpEstablished bool
+	lazyPages      bool
+	pageServer     string
+	preDump        bool
+	parent         string
+	rootfsDiff     bool
 }
 
 // Init initialize checkpoint create command.
@@ -69,9 +75,15 @@ func (cc *CheckpointCreateCommand) runCheckpointCreate(args []string) error {
 	apiClient := cc.cli.Client()
 
 	if err := apiClient.ContainerCheckpointCreate(ctx, args[0], types.CheckpointCreateOptions{
-		CheckpointID:  args[1],
-		CheckpointDir: cc.cpDir,
-		Exit:          !cc.leaveRunning,
+		CheckpointID:     args[1],
+		CheckpointDir:    cc.cpDir,
+		Exit:             !cc.leaveRunning,
+		TCPEstablished:   cc.tcpEstablished,
+		LazyPages:        cc.lazyPages,
+		PageServer:       cc.pageServer,
+		PreDump:          cc.preDump,
+		ParentCheckpoint: cc.parent,
+		RootfsDiff:       cc.rootfsDiff,
 	}); err != nil {
 		return err
 	}
@@ -85,6 +97,12 @@ func (cc *CheckpointCreateCommand) addFlags() {
 	flagSet := cc.cmd.Flags()
 	flagSet.BoolVar(&cc.leaveRunning, "leave-running", false, "keep container running after creating checkpoint")
 	flagSet.StringVar(&cc.cpDir, "checkpoint-dir", "", "directory to store checkpoints images")
+	flagSet.BoolVar(&cc.tcpEstablished, "tcp-established", false, "checkpoint established TCP connections")
+	flagSet.BoolVar(&cc.lazyPages, "lazy-pages", false, "leave memory pages in place and serve them by page server until they are restored")
+	flagSet.StringVar(&cc.pageServer, "page-server", "", "address the page server listens on for lazy checkpoint, format is host:port")
+	flagSet.BoolVar(&cc.preDump, "pre-dump", false, "dump only the memory pages and keep container running, the pre-dump can only be the parent of other checkpoints")
+	flagSet.StringVar(&cc.parent, "parent", "", "parent checkpoint in the same checkpoint directory, only the memory pages changed since it are dumped")
+	flagSet.BoolVar(&cc.rootfsDiff, "rootfs-diff", false, "store the changes of container rootfs in checkpoint, which are applied to the container it is imported into")
 }
 
 // checkpointCreateExample shows examples in checkpoint create command, and is used in auto-generated cli docs.
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -67,6 +68,15 @@ func (c *Cli) NewAPIClient() {
 		log.With(nil).Fatal(err)
 	}
 
+	// downgrade the api version to the one supported by an older pouchd,
+	// unless the version is specified. The commands not requesting pouchd
+	// still work if it is unreachable.
+	if os.Getenv("POUCH_API_VERSION") == "" {
+		if err := client.NegotiateAPIVersion(context.Background()); err != nil {
+			log.With(nil).Debugf("failed to negotiate api version with pouchd: %v", err)
+		}
+	}
+
 	c.APIClient = client
 }
 
@@ -19,6 +19,7 @@ type CommitCommand struct {
 	baseCommand
 	author  string
 	message string
+	changes []string
 }
 
 // Init initializes CommitCommand command.
@@ -43,6 +44,7 @@ func (cc *CommitCommand) addFlags() {
 
 	flagSet.StringVarP(&cc.author, "author", "a", "", "Image author, eg.(name <email@email.com>)")
 	flagSet.StringVarP(&cc.message, "message", "m", "", "Commit message")
+	flagSet.StringArrayVarP(&cc.changes, "change", "c", nil, "Apply Dockerfile instruction to the created image, CMD, ENV and EXPOSE are supported")
 }
 
 // runCommit is the entry of CommitCommand command.
@@ -73,6 +75,7 @@ func (cc *CommitCommand) runCommit(args []string) error {
 		Tag:        tag,
 		Comment:    cc.message,
 		Author:     cc.author,
+		Changes:    cc.changes,
 	}
 
 	respCommit, err := apiClient.ContainerCommit(ctx, id, commitConfig)
@@ -88,5 +91,7 @@ func (cc *CommitCommand) runCommit(args []string) error {
 func commitExample() string {
 	return `$ pouch commit 25bf50 test:image
 1c7e415csa333
+$ pouch commit -c 'CMD ["nginx", "-g", "daemon off;"]' -c "ENV PORT=8080" -c "EXPOSE 8080" 25bf50 test:nginx
+5f3d2c9a1b7e
 `
 }
@@ -27,23 +27,34 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.Int64Var(&c.cpushare, "cpu-shares", 0, "CPU shares (relative weight)")
 	flagSet.StringVar(&c.cpusetcpus, "cpuset-cpus", "", "CPUs in which to allow execution (0-3, 0,1)")
 	flagSet.StringVar(&c.cpusetmems, "cpuset-mems", "", "MEMs in which to allow execution (0-3, 0,1)")
+	flagSet.StringVar(&c.numaPolicy, "numa-policy", "", "NUMA placement policy resolved to cpuset when creating container, can be \"spread\" or \"pack\"")
 	flagSet.Int64Var(&c.cpuperiod, "cpu-period", 0, "Limit CPU CFS (Completely Fair Scheduler) period, range is in [1000(1ms),1000000(1s)]")
 	flagSet.Int64Var(&c.cpuquota, "cpu-quota", 0, "Limit CPU CFS (Completely Fair Scheduler) quota, range is in [1000,∞)")
 
+	flagSet.StringSliceVar(&c.dependsOn, "depends-on", nil, "Set containers to start before the container, format is <container>[:<condition>], condition can be \"started\" or \"healthy\"")
+
 	// device related options
-	flagSet.StringSliceVarP(&c.devices, "device", "", nil, "Add a host device to the container")
+	flagSet.StringSliceVarP(&c.devices, "device", "", nil, "Add a host device or a CDI device like vendor.com/gpu=gpu0 to the container")
 
 	flagSet.BoolVar(&c.enableLxcfs, "enableLxcfs", false, "Enable lxcfs for the container, only effective when enable-lxcfs switched on in Pouchd")
 	flagSet.StringVar(&c.entrypoint, "entrypoint", "", "Overwrite the default ENTRYPOINT of the image")
 	flagSet.StringArrayVarP(&c.env, "env", "e", nil, "Set environment variables for container('--env A=' means setting env A to empty, '--env B' means removing env B from container env inherited from image)")
 	flagSet.StringArrayVar(&c.envfile, "env-file", nil, "Read in a file of environment variables")
 	flagSet.StringVar(&c.hostname, "hostname", "", "Set container's hostname")
+
+	// healthcheck
+	flagSet.StringVar(&c.healthCmd, "health-cmd", "", "Command to run to check health")
+	flagSet.DurationVar(&c.healthInterval, "health-interval", 0, "Time between running the check (ms|s|m|h) (default 0s)")
+	flagSet.Int64Var(&c.healthRetries, "health-retries", 0, "Consecutive failures needed to report unhealthy")
+	flagSet.DurationVar(&c.healthStartPeriod, "health-start-period", 0, "Start period for the container to initialize before starting health-retries countdown (ms|s|m|h) (default 0s)")
+	flagSet.DurationVar(&c.healthTimeout, "health-timeout", 0, "Maximum time to allow one check to run (ms|s|m|h) (default 0s)")
+	flagSet.BoolVar(&c.noHealthcheck, "no-healthcheck", false, "Disable any container-specified HEALTHCHECK")
 	flagSet.BoolVar(&c.disableNetworkFiles, "disable-network-files", false, "Disable the generation of network files(/etc/hostname, /etc/hosts and /etc/resolv.conf) for container. If true, no network files will be generated. Default false")
 
 	// Intel RDT
 	flagSet.StringVar(&c.IntelRdtL3Cbm, "intel-rdt-l3-cbm", "", "Limit container resource for Intel RDT/CAT which introduced in Linux 4.10 kernel")
 
-	flagSet.StringVar(&c.ipcMode, "ipc", "", "IPC namespace to use")
+	flagSet.StringVar(&c.ipcMode, "ipc", "", "IPC namespace to use, host, container:<name|id>, private, shareable (default) or none")
 	flagSet.StringArrayVarP(&c.labels, "label", "l", nil, "Set labels for a container")
 
 	// log driver and log options
@@ -55,29 +66,36 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.StringVar(&c.memoryReservation, "memory-reservation", "", "Memory soft limit")
 	flagSet.StringVar(&c.memorySwap, "memory-swap", "", "Swap limit equal to memory + swap, '-1' to enable unlimited swap")
 	flagSet.Int64Var(&c.memorySwappiness, "memory-swappiness", 0, "Container memory swappiness [0, 100]")
+	flagSet.StringVar(&c.memoryPressurePolicy, "memory-pressure-policy", "", "Action to take when memory of container is under pressure, can be \"none\", \"restart\" or \"signal[:<signal>]\"")
+	flagSet.StringVar(&c.memoryPressureLevel, "memory-pressure-level", "", "Memory pressure level to take the action of memory pressure policy, can be \"medium\" or \"critical\"(default)")
 	flagSet.StringVar(&c.kernelMemory, "kernel-memory", "", "Kernel memory limit (in bytes)")
 	// for alikernel isolation options
 	flagSet.BoolVar(&c.oomKillDisable, "oom-kill-disable", false, "Disable OOM Killer")
 	flagSet.Int64Var(&c.oomScoreAdj, "oom-score-adj", -500, "Tune host's OOM preferences (-1000 to 1000)")
 
 	flagSet.StringVar(&c.name, "name", "", "Specify name of container")
+	flagSet.StringVar(&c.snapshotter, "snapshotter", "", "Snapshotter of container, such as the remote snapshotter to run lazily pulled image, devmapper or btrfs, the one labeled on image by io.alibaba.pouch.snapshotter or the default snapshotter is used if not set")
 	flagSet.StringVar(&c.specificID, "specific-id", "", "Specify id of container, length of id should be 64, characters of id should be in '0123456789abcdef'")
 
 	// network
 	flagSet.StringSliceVar(&c.networks, "net", nil, "Set networks to container")
+	flagSet.StringSliceVar(&c.netAliases, "network-alias", nil, "Add network-scoped alias for the container in user-defined network")
 	flagSet.StringSliceVarP(&c.ports, "publish", "p", nil, "Set container ports mapping")
 	flagSet.StringSliceVar(&c.expose, "expose", nil, "Set expose container's ports")
 	flagSet.BoolVarP(&c.publishAll, "publish-all", "P", false, "Publish all exposed ports to random ports")
 	flagSet.StringVar(&c.macAddress, "mac-address", "", "Set mac address of container endpoint")
-	flagSet.StringVar(&c.ip, "ip", "", "Set IPv4 address of container endpoint")
-	flagSet.StringVar(&c.ipv6, "ip6", "", "Set IPv6 address of container endpoint")
+	flagSet.StringVar(&c.ip, "ip", "", "Set static IPv4 address of container endpoint, which is reserved until the container is removed")
+	flagSet.StringVar(&c.ipv6, "ip6", "", "Set static IPv6 address of container endpoint, which is reserved until the container is removed")
 	flagSet.Int64Var(&c.netPriority, "net-priority", 0, "net priority")
+	flagSet.StringVar(&c.egressBandwidth, "egress-bandwidth", "", "Limit egress bandwidth of container network, format is <rate>[:<burst>] in bytes, such as 10mb:1mb")
+	flagSet.StringVar(&c.ingressBandwidth, "ingress-bandwidth", "", "Limit ingress bandwidth of container network, format is <rate>[:<burst>] in bytes, such as 10mb:1mb")
 	// dns
 	flagSet.StringArrayVar(&c.dns, "dns", nil, "Set DNS servers")
 	flagSet.StringSliceVar(&c.dnsOptions, "dns-option", nil, "Set DNS options")
 	flagSet.StringArrayVar(&c.dnsSearch, "dns-search", nil, "Set DNS search domains")
+	flagSet.StringArrayVar(&c.extraHosts, "add-host", nil, "Add a custom host-to-IP mapping (host:ip), ip host-gateway is resolved to the gateway of default bridge")
 
-	flagSet.StringVar(&c.pidMode, "pid", "", "PID namespace to use")
+	flagSet.StringVar(&c.pidMode, "pid", "", "PID namespace to use, host, container:<name|id> or private (default)")
 	flagSet.BoolVar(&c.privileged, "privileged", false, "Give extended privileges to the container")
 
 	flagSet.StringVar(&c.restartPolicy, "restart", "", "Restart policy to apply when container exits")
@@ -85,7 +103,7 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 
 	flagSet.StringSliceVar(&c.securityOpt, "security-opt", nil, "Security Options")
 
-	flagSet.StringSliceVar(&c.sysctls, "sysctl", nil, "Sysctl options")
+	flagSet.StringSliceVar(&c.sysctls, "sysctl", nil, "Set namespaced kernel parameters of container, such as net.ipv4.ip_forward=1, the ones of the host namespaces are rejected")
 	flagSet.BoolVarP(&c.tty, "tty", "t", false, "Allocate a pseudo-TTY")
 
 	// user
@@ -93,7 +111,8 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 
 	flagSet.StringSliceVar(&c.groupAdd, "group-add", nil, "Add additional groups to join")
 
-	flagSet.StringVar(&c.utsMode, "uts", "", "UTS namespace to use")
+	flagSet.StringVar(&c.utsMode, "uts", "", "UTS namespace to use, host, container:<name|id> or private (default)")
+	flagSet.StringVar(&c.usernsMode, "userns", "", "User namespace to use, \"host\" opts out of the user namespace remapping of pouchd")
 
 	flagSet.VarP(config.NewVolumes(&c.volume), "volume", "v", "Bind mount volumes to container, format is: [source:]<destination>[:mode], [source] can be volume or host's path, <destination> is container's path, [mode] can be \"ro/rw/dr/rr/z/Z/nocopy/private/rprivate/slave/rslave/shared/rshared\"")
 	flagSet.StringSliceVar(&c.volumesFrom, "volumes-from", nil, "set volumes from other containers, format is <container>[:mode]")
@@ -102,11 +121,23 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.StringVarP(&c.workdir, "workdir", "w", "", "Set the working directory in a container")
 	flagSet.Var(&c.ulimit, "ulimit", "Set container ulimit")
 	flagSet.Int64Var(&c.pidsLimit, "pids-limit", 0, "Set container pids limit")
+	flagSet.StringSliceVar(&c.hugepageLimits, "hugepage-limit", nil, "Set hugepage limit of container, format is <page size>=<limit>, such as 2MB=1g")
 
 	flagSet.BoolVar(&c.rich, "rich", false, "Start container in rich container mode. (default false)")
 	flagSet.StringVar(&c.richMode, "rich-mode", "", "Choose one rich container mode. dumb-init(default), systemd, sbin-init")
 	flagSet.StringVar(&c.initScript, "initscript", "", "Initial script executed in container")
-	flagSet.StringVar(&c.shmSize, "shm-size", "", "Size of /dev/shm, default value is 64MB")
+	flagSet.StringVar(&c.shmSize, "shm-size", "", "Size of /dev/shm, default value is 64MB, which is shared with the containers joining its ipc namespace")
+
+	// read-only rootfs and masked paths
+	flagSet.BoolVar(&c.readOnly, "read-only", false, "Mount the container's root filesystem as read only, /tmp and /run are mounted as tmpfs")
+	flagSet.StringArrayVar(&c.tmpfs, "tmpfs", nil, "Mount a tmpfs directory to container, format is <destination>[:<options>], such as /run:size=64m,mode=755")
+	flagSet.StringSliceVar(&c.maskedPaths, "masked-path", nil, "Mask the paths in container instead of the default kernel paths, '--masked-path \"\"' masks nothing")
+	flagSet.StringSliceVar(&c.readonlyPaths, "readonly-path", nil, "Set the paths read only in container instead of the default kernel paths, '--readonly-path \"\"' sets nothing")
+
+	// rootfs on host
+	flagSet.StringVar(&c.rootfs, "rootfs", "", "Create the container from the rootfs directory or OCI bundle on host instead of an image, which must be in the rootfs dirs allowed by pouchd, all the args are taken as the command")
+	flagSet.StringVar(&c.rootfsPropagation, "rootfs-propagation", "", "Set the mount propagation of rootfs on host, such as rprivate, rshared and rslave")
+	flagSet.StringVar(&c.rootfsOwner, "rootfs-owner", "", "Change the owner of all the files in rootfs on host to <uid>:<gid> when the container is created")
 
 	// cgroup
 	flagSet.StringVarP(&c.cgroupParent, "cgroup-parent", "", "", "Optional parent cgroup for the container")
@@ -114,13 +145,21 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	// disk quota
 	flagSet.StringSliceVar(&c.diskQuota, "disk-quota", nil, "Set disk quota for container")
 	flagSet.StringVar(&c.quotaID, "quota-id", "", "Specified quota id, if id < 0, it means pouchd alloc a unique quota id")
+	flagSet.StringArrayVar(&c.storageOpt, "storage-opt", nil, "Storage driver options for container, such as size=10G to limit the writable layer, which requires overlayfs snapshotter on xfs or ext4 with project quota")
 
 	// additional runtime spec annotations
 	flagSet.StringArrayVar(&c.specAnnotation, "annotation", nil, "Additional annotation for runtime")
 
+	// oci hooks
+	flagSet.StringArrayVar(&c.hooks, "hook", nil, "Add an OCI hook executed by runtime, format is <stage>:<path> [args...], stage is prestart, poststart or poststop")
+
+	// init process
+	flagSet.BoolVar(&c.init, "init", false, "Run an init in container to forward signals and reap processes, the default of daemon is used if not set")
+
 	// nvidia container
 	flagSet.StringVar(&c.nvidiaDriverCapabilities, "nvidia-capabilities", "", "NvidiaDriverCapabilities controls which driver libraries/binaries will be mounted inside the container")
 	flagSet.StringVar(&c.nvidiaVisibleDevices, "nvidia-visible-devs", "", "NvidiaVisibleDevices controls which GPUs will be made accessible inside the container")
+	flagSet.StringVar(&c.gpus, "gpus", "", "GPU devices to add to the container, can be \"all\", the number of GPUs or options like \"device=0,1\"")
 
 	return c
 }
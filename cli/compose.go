@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alibaba/pouch/cli/compose"
+
+	"github.com/spf13/cobra"
+)
+
+// composeDescription is used to describe compose command in detail and auto generate command doc.
+var composeDescription = "Define and run multi-container applications with the compose file. " +
+	"A subset of the compose file version 3 is supported, the services are created from images, " +
+	"and the unsupported keys like 'build' and 'deploy' are rejected. " +
+	"The networks, volumes and containers of the application are created by pouchd in the order of dependency, " +
+	"and labeled with the project name, which is the directory name of compose file by default. " +
+	"The variables like ${VAR} and ${VAR:-default} in compose file are substituted by the environment."
+
+// ComposeCommand is used to implement 'compose' command.
+type ComposeCommand struct {
+	baseCommand
+
+	file        string
+	projectName string
+}
+
+// Init initializes ComposeCommand command.
+func (cc *ComposeCommand) Init(c *Cli) {
+	cc.cli = c
+
+	cc.cmd = &cobra.Command{
+		Use:   "compose [command]",
+		Short: "Define and run multi-container applications",
+		Long:  composeDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch compose %s' does not exist.\nPlease execute `pouch compose --help` for more help", args[0])
+		},
+	}
+
+	flagSet := cc.cmd.PersistentFlags()
+	flagSet.StringVarP(&cc.file, "file", "f", "", fmt.Sprintf("Specify the compose file (default: %s in current directory)", strings.Join(compose.DefaultFiles, ", ")))
+	flagSet.StringVarP(&cc.projectName, "project-name", "p", "", "Specify the project name (default: directory name of compose file)")
+
+	c.AddCommand(cc, &ComposeUpCommand{compose: cc})
+	c.AddCommand(cc, &ComposeDownCommand{compose: cc})
+	c.AddCommand(cc, &ComposePsCommand{compose: cc})
+}
+
+// runner loads the project from compose file, and creates its runner.
+func (cc *ComposeCommand) runner() (*compose.Runner, error) {
+	file := cc.file
+	if file == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		if file, err = compose.FindFile(wd); err != nil {
+			return nil, err
+		}
+	}
+
+	project, err := compose.Load(file, cc.projectName, os.LookupEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient := cc.cli.Client()
+	r := compose.NewRunner(project, apiClient, os.Stdout, os.LookupEnv)
+	r.PullImage = func(ctx context.Context, image string) error {
+		return pullMissingImage(ctx, apiClient, image, false)
+	}
+	return r, nil
+}
+
+// composeUpDescription is used to describe compose up command in detail and auto generate command doc.
+var composeUpDescription = "Create and start the containers of services. " +
+	"The networks and volumes of project are created if they don't exist, and the missing images are pulled. " +
+	"The containers are started in the order of 'depends_on', the dependency with condition 'service_healthy' " +
+	"should be healthy before the containers depending on it start. " +
+	"The containers whose configurations are changed are recreated with the ones depending on them, " +
+	"and the others are kept running. The containers always run in the background."
+
+// ComposeUpCommand is used to implement 'compose up' command.
+type ComposeUpCommand struct {
+	baseCommand
+	compose *ComposeCommand
+
+	forceRecreate bool
+	noStart       bool
+	timeout       int
+}
+
+// Init initializes ComposeUpCommand command.
+func (u *ComposeUpCommand) Init(c *Cli) {
+	u.cli = c
+
+	u.cmd = &cobra.Command{
+		Use:   "up [OPTIONS] [SERVICE...]",
+		Short: "Create and start the containers of services",
+		Long:  composeUpDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return u.runComposeUp(args)
+		},
+		Example: composeUpExample(),
+	}
+
+	flagSet := u.cmd.Flags()
+	flagSet.BoolVar(&u.forceRecreate, "force-recreate", false, "Recreate containers even if their configurations are not changed")
+	flagSet.BoolVar(&u.noStart, "no-start", false, "Create containers without starting them")
+	flagSet.IntVarP(&u.timeout, "timeout", "t", 10, "Seconds to wait for the recreated containers to stop before killing them")
+}
+
+// runComposeUp is the entry of ComposeUpCommand command.
+func (u *ComposeUpCommand) runComposeUp(args []string) error {
+	r, err := u.compose.runner()
+	if err != nil {
+		return err
+	}
+
+	return r.Up(context.Background(), compose.UpOptions{
+		Services:      args,
+		ForceRecreate: u.forceRecreate,
+		NoStart:       u.noStart,
+		Timeout:       u.timeout,
+	})
+}
+
+// composeUpExample shows examples in compose up command, and is used in auto-generated cli docs.
+func composeUpExample() string {
+	return `$ cat docker-compose.yml
+version: "3"
+services:
+  web:
+    image: nginx:alpine
+    ports:
+      - "8080:80"
+    depends_on:
+      - db
+  db:
+    image: redis:alpine
+    volumes:
+      - data:/data
+volumes:
+  data:
+$ pouch compose up
+Creating network app_default
+Creating volume app_data
+Creating container app_db_1
+Starting container app_db_1
+Creating container app_web_1
+Starting container app_web_1`
+}
+
+// composeDownDescription is used to describe compose down command in detail and auto generate command doc.
+var composeDownDescription = "Stop and remove the containers and networks of project, " +
+	"including the containers of services which are removed from the compose file. " +
+	"The containers are removed in the reverse order of dependency. " +
+	"The named volumes of project are kept unless '--volumes' is specified, and the external networks and volumes are never removed."
+
+// ComposeDownCommand is used to implement 'compose down' command.
+type ComposeDownCommand struct {
+	baseCommand
+	compose *ComposeCommand
+
+	volumes bool
+	timeout int
+}
+
+// Init initializes ComposeDownCommand command.
+func (d *ComposeDownCommand) Init(c *Cli) {
+	d.cli = c
+
+	d.cmd = &cobra.Command{
+		Use:   "down [OPTIONS]",
+		Short: "Stop and remove the containers and networks of project",
+		Long:  composeDownDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return d.runComposeDown()
+		},
+		Example: composeDownExample(),
+	}
+
+	flagSet := d.cmd.Flags()
+	flagSet.BoolVarP(&d.volumes, "volumes", "v", false, "Remove the named volumes of project")
+	flagSet.IntVarP(&d.timeout, "timeout", "t", 10, "Seconds to wait for containers to stop before killing them")
+}
+
+// runComposeDown is the entry of ComposeDownCommand command.
+func (d *ComposeDownCommand) runComposeDown() error {
+	r, err := d.compose.runner()
+	if err != nil {
+		return err
+	}
+
+	return r.Down(context.Background(), compose.DownOptions{
+		RemoveVolumes: d.volumes,
+		Timeout:       d.timeout,
+	})
+}
+
+// composeDownExample shows examples in compose down command, and is used in auto-generated cli docs.
+func composeDownExample() string {
+	return `$ pouch compose down -v
+Stopping container app_web_1
+Removing container app_web_1
+Stopping container app_db_1
+Removing container app_db_1
+Removing network app_default
+Removing volume app_data`
+}
+
+// composePsDescription is used to describe compose ps command in detail and auto generate command doc.
+var composePsDescription = "List the containers of project, including the stopped ones."
+
+// ComposePsCommand is used to implement 'compose ps' command.
+type ComposePsCommand struct {
+	baseCommand
+	compose *ComposeCommand
+}
+
+// Init initializes ComposePsCommand command.
+func (p *ComposePsCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:   "ps",
+		Short: "List the containers of project",
+		Long:  composePsDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runComposePs()
+		},
+		Example: composePsExample(),
+	}
+}
+
+// runComposePs is the entry of ComposePsCommand command.
+func (p *ComposePsCommand) runComposePs() error {
+	r, err := p.compose.runner()
+	if err != nil {
+		return err
+	}
+
+	containers, err := r.Ps(context.Background())
+	if err != nil {
+		return err
+	}
+
+	display := p.cli.NewTableDisplay()
+	display.AddRow([]string{"NAME", "SERVICE", "IMAGE", "STATUS"})
+	for _, c := range containers {
+		display.AddRow([]string{
+			strings.Join(c.Names, ","),
+			c.Labels[compose.LabelService],
+			c.Image,
+			c.Status,
+		})
+	}
+	display.Flush()
+	return nil
+}
+
+// composePsExample shows examples in compose ps command, and is used in auto-generated cli docs.
+func composePsExample() string {
+	return `$ pouch compose ps
+NAME        SERVICE   IMAGE                            STATUS
+app_web_1   web       docker.io/library/nginx:alpine   Up 2 minutes
+app_db_1    db        docker.io/library/redis:alpine   Up 2 minutes`
+}
@@ -0,0 +1,329 @@
+package compose
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/opts"
+	"github.com/alibaba/pouch/apis/types"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+const (
+	// LabelProject is the label of project on the containers, networks and
+	// volumes created by compose.
+	LabelProject = "io.pouch.compose.project"
+
+	// LabelService is the label of service on the containers.
+	LabelService = "io.pouch.compose.service"
+
+	// LabelNetwork is the label of network key in compose file.
+	LabelNetwork = "io.pouch.compose.network"
+
+	// LabelVolume is the label of volume key in compose file.
+	LabelVolume = "io.pouch.compose.volume"
+
+	// LabelConfigHash is the label of the hash of container configuration,
+	// the container is recreated if the hash is changed.
+	LabelConfigHash = "io.pouch.compose.config-hash"
+)
+
+// containerSpec is the configuration to create container of service.
+type containerSpec struct {
+	Config           types.ContainerConfig
+	HostConfig       *types.HostConfig
+	NetworkingConfig *types.NetworkingConfig
+}
+
+// networkName returns the name of network in pouchd.
+func (p *Project) networkName(key string) string {
+	if n := p.Networks[key]; n != nil && n.External {
+		if n.Name != "" {
+			return n.Name
+		}
+		return key
+	}
+	return p.Name + "_" + key
+}
+
+// volumeName returns the name of volume in pouchd.
+func (p *Project) volumeName(key string) string {
+	if v := p.Volumes[key]; v != nil && v.External {
+		if v.Name != "" {
+			return v.Name
+		}
+		return key
+	}
+	return p.Name + "_" + key
+}
+
+// containerName returns the name of container of service.
+func (p *Project) containerName(s *ServiceConfig) string {
+	if s.ContainerName != "" {
+		return s.ContainerName
+	}
+	return fmt.Sprintf("%s_%s_1", p.Name, s.Name)
+}
+
+// splitVolume splits the short syntax of volume, [SOURCE:]TARGET[:MODE].
+func splitVolume(v string) (source, target, mode string, err error) {
+	parts := strings.Split(v, ":")
+	switch len(parts) {
+	case 1:
+		target = parts[0]
+	case 2:
+		source, target = parts[0], parts[1]
+	case 3:
+		source, target, mode = parts[0], parts[1], parts[2]
+	default:
+		return "", "", "", fmt.Errorf("invalid volume %q, format should be [SOURCE:]TARGET[:MODE]", v)
+	}
+	if !filepath.IsAbs(target) {
+		return "", "", "", fmt.Errorf("invalid volume %q, the target should be an absolute path", v)
+	}
+	return source, target, mode, nil
+}
+
+// isNamedVolume returns whether the source of volume is a named volume
+// rather than a path of host.
+func isNamedVolume(source string) bool {
+	return source != "" && !filepath.IsAbs(source) && !strings.HasPrefix(source, ".") && !strings.HasPrefix(source, "~")
+}
+
+// bind converts the volume of service to the bind of container.
+func (p *Project) bind(v string) (string, error) {
+	source, target, mode, err := splitVolume(v)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case source == "":
+		return target, nil
+	case isNamedVolume(source):
+		source = p.volumeName(source)
+	case strings.HasPrefix(source, "~"):
+		home := os.Getenv("HOME")
+		if home == "" {
+			return "", fmt.Errorf("cannot expand %s, $HOME is not set", source)
+		}
+		source = filepath.Join(home, source[1:])
+	case !filepath.IsAbs(source):
+		source = filepath.Join(p.WorkingDir, source)
+	}
+
+	if mode == "" {
+		return source + ":" + target, nil
+	}
+	return source + ":" + target + ":" + mode, nil
+}
+
+// healthcheck converts the healthcheck of service.
+func healthcheck(hc *HealthCheckConfig) (*types.HealthConfig, error) {
+	if hc == nil {
+		return nil, nil
+	}
+	if hc.Disable {
+		return &types.HealthConfig{Test: []string{"NONE"}}, nil
+	}
+
+	var durations [3]time.Duration
+	for i, d := range []string{hc.Interval, hc.Timeout, hc.StartPeriod} {
+		if d == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(d)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q of healthcheck: %v", d, err)
+		}
+		durations[i] = duration
+	}
+
+	config, err := opts.ParseHealthcheck("", durations[0], durations[1], durations[2], hc.Retries, false)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = &types.HealthConfig{}
+	}
+
+	// the test is in the form of [CMD, args...], [CMD-SHELL, command] or
+	// a string run by shell.
+	switch {
+	case len(hc.Test) == 0:
+	case hc.Test[0] == "CMD" || hc.Test[0] == "CMD-SHELL" || hc.Test[0] == "NONE":
+		config.Test = hc.Test
+	default:
+		config.Test = []string{"CMD-SHELL", strings.Join(hc.Test, " ")}
+	}
+	return config, nil
+}
+
+// environment resolves the variables without value from lookup, they are
+// dropped if unset.
+func environment(env []string, lookup LookupEnv) []string {
+	var resolved []string
+	for _, e := range env {
+		if strings.Contains(e, "=") {
+			resolved = append(resolved, e)
+			continue
+		}
+		if v, ok := lookup(e); ok {
+			resolved = append(resolved, e+"="+v)
+		}
+	}
+	return resolved
+}
+
+// containerSpec converts the service to the configuration of container.
+func (p *Project) containerSpec(s *ServiceConfig, lookup LookupEnv) (*containerSpec, error) {
+	labels := map[string]string{}
+	for k, v := range s.Labels {
+		labels[k] = v
+	}
+	labels[LabelProject] = p.Name
+	labels[LabelService] = s.Name
+
+	exposedPorts, err := opts.ParseExposedPorts(s.Ports, s.Expose)
+	if err != nil {
+		return nil, err
+	}
+	portBindings, err := opts.ParsePortBinding(s.Ports)
+	if err != nil {
+		return nil, err
+	}
+
+	var restartPolicy *types.RestartPolicy
+	if s.Restart != "" {
+		if restartPolicy, err = opts.ParseRestartPolicy(s.Restart); err != nil {
+			return nil, err
+		}
+	}
+
+	hc, err := healthcheck(s.Healthcheck)
+	if err != nil {
+		return nil, err
+	}
+
+	var binds []string
+	for _, v := range s.Volumes {
+		b, err := p.bind(v)
+		if err != nil {
+			return nil, err
+		}
+		binds = append(binds, b)
+	}
+
+	var dependsOn []*types.ContainerDependency
+	for _, name := range s.dependencies() {
+		dep, err := p.Service(name)
+		if err != nil {
+			return nil, err
+		}
+		condition := "started"
+		if s.DependsOn[name].Condition == ConditionServiceHealthy {
+			condition = "healthy"
+		}
+		dependsOn = append(dependsOn, &types.ContainerDependency{Name: p.containerName(dep), Condition: condition})
+	}
+
+	networkMode, networkingConfig, err := p.networking(s)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &containerSpec{
+		Config: types.ContainerConfig{
+			Image:        s.Image,
+			Cmd:          s.Command,
+			Entrypoint:   s.Entrypoint,
+			Env:          environment(s.Environment, lookup),
+			Labels:       labels,
+			ExposedPorts: exposedPorts,
+			Healthcheck:  hc,
+			WorkingDir:   s.WorkingDir,
+			User:         s.User,
+			Hostname:     strfmt.Hostname(s.Hostname),
+			Tty:          s.Tty,
+			OpenStdin:    s.StdinOpen,
+			StopSignal:   s.StopSignal,
+		},
+		HostConfig: &types.HostConfig{
+			Binds:         binds,
+			PortBindings:  portBindings,
+			RestartPolicy: restartPolicy,
+			Privileged:    s.Privileged,
+			CapAdd:        s.CapAdd,
+			CapDrop:       s.CapDrop,
+			DNS:           s.DNS,
+			ExtraHosts:    s.ExtraHosts,
+			NetworkMode:   networkMode,
+			DependsOn:     dependsOn,
+		},
+		NetworkingConfig: networkingConfig,
+	}
+
+	hash, err := spec.hash()
+	if err != nil {
+		return nil, err
+	}
+	spec.Config.Labels[LabelConfigHash] = hash
+	return spec, nil
+}
+
+// networking returns the network mode and endpoints of service. The network
+// mode is the first network sorted by name, and the service is reachable by
+// its name on all the networks.
+func (p *Project) networking(s *ServiceConfig) (string, *types.NetworkingConfig, error) {
+	if s.NetworkMode != "" {
+		if strings.HasPrefix(s.NetworkMode, "service:") {
+			dep, err := p.Service(strings.TrimPrefix(s.NetworkMode, "service:"))
+			if err != nil {
+				return "", nil, err
+			}
+			return "container:" + p.containerName(dep), nil, nil
+		}
+		return s.NetworkMode, nil, nil
+	}
+
+	keys := make([]string, 0, len(s.Networks))
+	for k := range s.Networks {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	config := &types.NetworkingConfig{EndpointsConfig: map[string]*types.EndpointSettings{}}
+	for _, k := range keys {
+		endpoint := &types.EndpointSettings{Aliases: []string{s.Name}}
+		if n := s.Networks[k]; n != nil {
+			endpoint.Aliases = append(endpoint.Aliases, n.Aliases...)
+			if n.IPv4Address != "" || n.IPv6Address != "" {
+				endpoint.IPAMConfig = &types.EndpointIPAMConfig{
+					IPV4Address: n.IPv4Address,
+					IPV6Address: n.IPv6Address,
+				}
+			}
+		}
+		config.EndpointsConfig[p.networkName(k)] = endpoint
+	}
+	return p.networkName(keys[0]), config, nil
+}
+
+// hash returns the digest of configuration, which is the same as long as
+// the service is not changed.
+func (spec *containerSpec) hash() (string, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
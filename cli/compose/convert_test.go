@@ -0,0 +1,134 @@
+package compose
+
+import (
+	"os"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	p := &Project{
+		Name:       "app",
+		WorkingDir: "/srv/app",
+		Volumes: map[string]*VolumeConfig{
+			"data":   {},
+			"shared": {External: true, Name: "team-shared"},
+		},
+	}
+
+	home := os.Getenv("HOME")
+	os.Setenv("HOME", "/home/alice")
+	defer os.Setenv("HOME", home)
+
+	for v, expected := range map[string]string{
+		"/cache":                 "/cache",
+		"data:/data":             "app_data:/data",
+		"shared:/shared:ro":      "team-shared:/shared:ro",
+		"./html:/usr/share/html": "/srv/app/html:/usr/share/html",
+		"../conf:/etc/conf:ro":   "/srv/conf:/etc/conf:ro",
+		"~/.ssh:/root/.ssh":      "/home/alice/.ssh:/root/.ssh",
+		"/var/run:/var/run":      "/var/run:/var/run",
+	} {
+		got, err := p.bind(v)
+		assert.NoError(t, err, "unexpected error of %s", v)
+		assert.Equal(t, expected, got, "unexpected bind of %s", v)
+	}
+}
+
+func TestContainerSpec(t *testing.T) {
+	p, err := loadProject(t, `
+services:
+  web:
+    image: nginx
+    container_name: frontend
+    ports: ["8080:80"]
+    restart: on-failure:3
+    environment: [MODE=prod, SECRET, UNSET]
+    depends_on:
+      api:
+        condition: service_healthy
+  api:
+    image: api
+    networks:
+      back:
+        ipv4_address: 172.30.0.10
+      front:
+        aliases: [backend]
+    healthcheck:
+      test: curl -f http://localhost/
+      interval: 5s
+      retries: 3
+  sidecar:
+    image: envoy
+    network_mode: service:api
+networks:
+  front:
+  back:
+    external: true
+    name: shared-back
+`, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	lookup := lookupMap(map[string]string{"SECRET": "s3cr3t"})
+
+	web, _ := p.Service("web")
+	spec, err := p.containerSpec(web, lookup)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"MODE=prod", "SECRET=s3cr3t"}, spec.Config.Env)
+	assert.Equal(t, "myappv2", spec.Config.Labels[LabelProject])
+	assert.Equal(t, "web", spec.Config.Labels[LabelService])
+	assert.Len(t, spec.Config.Labels[LabelConfigHash], 64)
+	assert.Contains(t, spec.Config.ExposedPorts, "80/tcp")
+	assert.Equal(t, []types.PortBinding{{HostPort: "8080"}}, spec.HostConfig.PortBindings["80/tcp"])
+	assert.Equal(t, &types.RestartPolicy{Name: "on-failure", MaximumRetryCount: 3}, spec.HostConfig.RestartPolicy)
+	assert.Equal(t, []*types.ContainerDependency{{Name: "myappv2_api_1", Condition: "healthy"}}, spec.HostConfig.DependsOn)
+	assert.Equal(t, "myappv2_default", spec.HostConfig.NetworkMode)
+
+	api, _ := p.Service("api")
+	spec, err = p.containerSpec(api, lookup)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"CMD-SHELL", "curl -f http://localhost/"}, spec.Config.Healthcheck.Test)
+	assert.Equal(t, int64(5e9), spec.Config.Healthcheck.Interval)
+	assert.Equal(t, int64(3), spec.Config.Healthcheck.Retries)
+	assert.Equal(t, "shared-back", spec.HostConfig.NetworkMode)
+	assert.Equal(t, map[string]*types.EndpointSettings{
+		"shared-back": {
+			Aliases:    []string{"api"},
+			IPAMConfig: &types.EndpointIPAMConfig{IPV4Address: "172.30.0.10"},
+		},
+		"myappv2_front": {Aliases: []string{"api", "backend"}},
+	}, spec.NetworkingConfig.EndpointsConfig)
+
+	// the container sharing network of service depends on it.
+	sidecar, _ := p.Service("sidecar")
+	spec, err = p.containerSpec(sidecar, lookup)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "container:myappv2_api_1", spec.HostConfig.NetworkMode)
+	assert.Nil(t, spec.NetworkingConfig)
+	assert.Equal(t, []*types.ContainerDependency{{Name: "myappv2_api_1", Condition: "started"}}, spec.HostConfig.DependsOn)
+}
+
+func TestContainerSpecHash(t *testing.T) {
+	hash := func(tag string) string {
+		p, err := loadProject(t, "services:\n  web:\n    image: nginx:"+tag+"\n", nil)
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		spec, err := p.containerSpec(p.Services[0], lookupMap(nil))
+		assert.NoError(t, err)
+		return spec.Config.Labels[LabelConfigHash]
+	}
+
+	assert.Equal(t, hash("1.17"), hash("1.17"))
+	assert.NotEqual(t, hash("1.17"), hash("1.19"))
+}
@@ -0,0 +1,140 @@
+package compose
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LookupEnv returns the value of variable, and whether it's set.
+type LookupEnv func(name string) (string, bool)
+
+// interpolate substitutes the variables in all the string values of the
+// decoded compose file.
+func interpolate(v interface{}, lookup LookupEnv) (interface{}, error) {
+	switch value := v.(type) {
+	case string:
+		return substitute(value, lookup)
+	case []interface{}:
+		for i := range value {
+			s, err := interpolate(value[i], lookup)
+			if err != nil {
+				return nil, err
+			}
+			value[i] = s
+		}
+		return value, nil
+	case map[interface{}]interface{}:
+		for k := range value {
+			s, err := interpolate(value[k], lookup)
+			if err != nil {
+				return nil, err
+			}
+			value[k] = s
+		}
+		return value, nil
+	default:
+		return v, nil
+	}
+}
+
+// substitute substitutes the variables in the string, the supported forms
+// are:
+//
+//	$VAR or ${VAR}        the value of VAR, empty if it's unset
+//	${VAR:-default}       default if VAR is unset or empty
+//	${VAR-default}        default if VAR is unset
+//	${VAR:?message}       error if VAR is unset or empty
+//	${VAR?message}        error if VAR is unset
+//	$$                    a literal $
+func substitute(s string, lookup LookupEnv) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' {
+			buf.WriteByte(s[i])
+			continue
+		}
+		if i+1 == len(s) {
+			return "", fmt.Errorf("invalid interpolation format %q: trailing $", s)
+		}
+
+		switch next := s[i+1]; {
+		case next == '$':
+			buf.WriteByte('$')
+			i++
+		case next == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("invalid interpolation format %q: missing }", s)
+			}
+			value, err := substituteBraced(s[i+2:i+2+end], lookup)
+			if err != nil {
+				return "", fmt.Errorf("invalid interpolation format %q: %v", s, err)
+			}
+			buf.WriteString(value)
+			i += 2 + end
+		case isNameStart(next):
+			j := i + 2
+			for j < len(s) && isNameChar(s[j]) {
+				j++
+			}
+			value, _ := lookup(s[i+1 : j])
+			buf.WriteString(value)
+			i = j - 1
+		default:
+			return "", fmt.Errorf("invalid interpolation format %q: invalid variable after $", s)
+		}
+	}
+	return buf.String(), nil
+}
+
+// substituteBraced substitutes the expression in braces.
+func substituteBraced(expr string, lookup LookupEnv) (string, error) {
+	j := 0
+	for j < len(expr) && isNameChar(expr[j]) {
+		j++
+	}
+	name, op := expr[:j], expr[j:]
+	if name == "" || !isNameStart(name[0]) {
+		return "", fmt.Errorf("invalid variable name %q", name)
+	}
+
+	value, ok := lookup(name)
+	switch {
+	case op == "":
+		return value, nil
+	case strings.HasPrefix(op, ":-"):
+		if value == "" {
+			return op[2:], nil
+		}
+		return value, nil
+	case strings.HasPrefix(op, "-"):
+		if !ok {
+			return op[1:], nil
+		}
+		return value, nil
+	case strings.HasPrefix(op, ":?"):
+		if value == "" {
+			return "", fmt.Errorf("required variable %s is missing or empty: %s", name, op[2:])
+		}
+		return value, nil
+	case strings.HasPrefix(op, "?"):
+		if !ok {
+			return "", fmt.Errorf("required variable %s is missing: %s", name, op[1:])
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("unsupported substitution %q", op)
+	}
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,70 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func lookupMap(env map[string]string) LookupEnv {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
+
+func TestSubstitute(t *testing.T) {
+	lookup := lookupMap(map[string]string{"TAG": "1.0", "EMPTY": ""})
+
+	for input, expected := range map[string]string{
+		"nginx":                    "nginx",
+		"nginx:$TAG":               "nginx:1.0",
+		"nginx:${TAG}-alpine":      "nginx:1.0-alpine",
+		"nginx:${UNSET}":           "nginx:",
+		"${UNSET:-latest}":         "latest",
+		"${EMPTY:-latest}":         "latest",
+		"${EMPTY-latest}":          "",
+		"${UNSET-latest}":          "latest",
+		"${TAG:?tag is required}":  "1.0",
+		"$$HOME and $${TAG}":       "$HOME and ${TAG}",
+		"${UNSET:-http://a:8080/}": "http://a:8080/",
+	} {
+		got, err := substitute(input, lookup)
+		assert.NoError(t, err, "unexpected error of %s", input)
+		assert.Equal(t, expected, got, "unexpected result of %s", input)
+	}
+
+	for _, input := range []string{
+		"price: 5$",
+		"${TAG",
+		"${1TAG}",
+		"$-",
+		"${TAG:+alt}",
+		"${UNSET:?tag is required}",
+		"${EMPTY:?tag is required}",
+		"${UNSET?tag is required}",
+	} {
+		_, err := substitute(input, lookup)
+		assert.Error(t, err, "expected error of %s", input)
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	raw := map[interface{}]interface{}{
+		"image": "redis:${TAG}",
+		"ports": []interface{}{"${PORT}:6379", 6380},
+		"labels": map[interface{}]interface{}{
+			"tag": "$TAG",
+		},
+	}
+
+	_, err := interpolate(raw, lookupMap(map[string]string{"TAG": "5", "PORT": "16379"}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[interface{}]interface{}{
+		"image": "redis:5",
+		"ports": []interface{}{"16379:6379", 6380},
+		"labels": map[interface{}]interface{}{
+			"tag": "5",
+		},
+	}, raw)
+}
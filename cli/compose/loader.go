@@ -0,0 +1,328 @@
+package compose
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultFiles are the compose files looked up in the working directory if
+// no file is specified.
+var DefaultFiles = []string{"compose.yaml", "compose.yml", "docker-compose.yml", "docker-compose.yaml"}
+
+// defaultNetwork is the network which the services without networks join.
+const defaultNetwork = "default"
+
+// Project is the loaded compose file.
+type Project struct {
+	// Name is the name of project, which prefixes the names of networks,
+	// volumes and containers of project.
+	Name string
+
+	// WorkingDir is the directory of compose file, the relative paths of
+	// bind mounts are relative to it.
+	WorkingDir string
+
+	// Services are sorted in the order of dependency, the dependencies
+	// come before the services depending on them.
+	Services []*ServiceConfig
+
+	Networks map[string]*NetworkConfig
+	Volumes  map[string]*VolumeConfig
+}
+
+// FindFile returns the first default compose file in the directory.
+func FindFile(dir string) (string, error) {
+	for _, name := range DefaultFiles {
+		file := filepath.Join(dir, name)
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		}
+	}
+	return "", fmt.Errorf("no compose file found in %s, supported filenames: %s", dir, strings.Join(DefaultFiles, ", "))
+}
+
+// Load loads the project from compose file. The name of project is the
+// name of directory of file if it's empty, and the variables in file are
+// substituted by lookup.
+func Load(file, name string, lookup LookupEnv) (*Project, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(file))
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = filepath.Base(dir)
+	}
+
+	config, err := parse(data, lookup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse compose file %s: %v", file, err)
+	}
+	return newProject(name, dir, config)
+}
+
+// parse decodes the compose file, and substitutes the variables in it.
+func parse(data []byte, lookup LookupEnv) (*Config, error) {
+	var raw map[interface{}]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	// the top-level extension fields are only used by the YAML anchors.
+	for k := range raw {
+		if key, ok := k.(string); ok && strings.HasPrefix(key, "x-") {
+			delete(raw, k)
+		}
+	}
+
+	if _, err := interpolate(raw, lookup); err != nil {
+		return nil, err
+	}
+
+	data, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, err
+	}
+
+	if config.Version != "" && !strings.HasPrefix(config.Version, "3") {
+		return nil, fmt.Errorf("unsupported version %s, only version 3 is supported", config.Version)
+	}
+	return config, nil
+}
+
+var invalidProjectChars = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// normalizeProjectName converts the name to the one accepted as the prefix
+// of container names.
+func normalizeProjectName(name string) string {
+	return invalidProjectChars.ReplaceAllString(strings.ToLower(name), "")
+}
+
+// newProject validates the config, and sorts the services in the order of
+// dependency.
+func newProject(name, dir string, config *Config) (*Project, error) {
+	p := &Project{
+		Name:       normalizeProjectName(name),
+		WorkingDir: dir,
+		Networks:   map[string]*NetworkConfig{},
+		Volumes:    map[string]*VolumeConfig{},
+	}
+	if p.Name == "" {
+		return nil, fmt.Errorf("invalid project name %q, it should contain lowercase letters, digits, '_' or '-'", name)
+	}
+	if len(config.Services) == 0 {
+		return nil, fmt.Errorf("no service is defined")
+	}
+
+	for n, network := range config.Networks {
+		if network == nil {
+			network = &NetworkConfig{}
+		}
+		p.Networks[n] = network
+	}
+	for n, volume := range config.Volumes {
+		if volume == nil {
+			volume = &VolumeConfig{}
+		}
+		p.Volumes[n] = volume
+	}
+
+	for n, s := range config.Services {
+		if s == nil {
+			return nil, fmt.Errorf("service %s is empty", n)
+		}
+		s.Name = n
+		if err := p.validateService(s); err != nil {
+			return nil, fmt.Errorf("invalid service %s: %v", n, err)
+		}
+
+		// the services without networks join the default network of project.
+		if s.NetworkMode == "" && len(s.Networks) == 0 {
+			s.Networks = ServiceNetworks{defaultNetwork: nil}
+		}
+		if _, ok := s.Networks[defaultNetwork]; ok {
+			if _, ok := p.Networks[defaultNetwork]; !ok {
+				p.Networks[defaultNetwork] = &NetworkConfig{}
+			}
+		}
+	}
+
+	services, err := sortServices(config.Services)
+	if err != nil {
+		return nil, err
+	}
+	p.Services = services
+	return p, nil
+}
+
+// validateService validates the references of service to the networks,
+// volumes and other services.
+func (p *Project) validateService(s *ServiceConfig) error {
+	if s.Image == "" {
+		return fmt.Errorf("image is required, build is not supported")
+	}
+
+	if s.NetworkMode != "" && len(s.Networks) > 0 {
+		return fmt.Errorf("network_mode and networks cannot be combined")
+	}
+	for n := range s.Networks {
+		if _, ok := p.Networks[n]; !ok && n != defaultNetwork {
+			return fmt.Errorf("network %s is not defined", n)
+		}
+	}
+
+	for _, v := range s.Volumes {
+		source, _, _, err := splitVolume(v)
+		if err != nil {
+			return err
+		}
+		if isNamedVolume(source) {
+			if _, ok := p.Volumes[source]; !ok {
+				return fmt.Errorf("named volume %s is not defined", source)
+			}
+		}
+	}
+
+	for dep, c := range s.DependsOn {
+		if dep == s.Name {
+			return fmt.Errorf("service cannot depend on itself")
+		}
+		switch c.Condition {
+		case ConditionServiceStarted, ConditionServiceHealthy:
+		default:
+			return fmt.Errorf("unsupported condition %s of dependency %s, should be %s or %s", c.Condition, dep, ConditionServiceStarted, ConditionServiceHealthy)
+		}
+	}
+	return nil
+}
+
+// dependencies returns the services which the service depends on, including
+// the one sharing network namespace by network_mode.
+func (s *ServiceConfig) dependencies() []string {
+	var deps []string
+	for dep := range s.DependsOn {
+		deps = append(deps, dep)
+	}
+	if strings.HasPrefix(s.NetworkMode, "service:") {
+		deps = append(deps, strings.TrimPrefix(s.NetworkMode, "service:"))
+	}
+	sort.Strings(deps)
+	return deps
+}
+
+// sortServices sorts the services in topological order of dependencies, and
+// the services without dependency between them are sorted by name.
+func sortServices(services map[string]*ServiceConfig) ([]*ServiceConfig, error) {
+	names := make([]string, 0, len(services))
+	for n := range services {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		state  = map[string]int{}
+		sorted []*ServiceConfig
+		visit  func(name string, chain []string) error
+	)
+	visit = func(name string, chain []string) error {
+		s, ok := services[name]
+		if !ok {
+			return fmt.Errorf("service %s depends on undefined service %s", chain[len(chain)-1], name)
+		}
+
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency between services: %s -> %s", strings.Join(chain, " -> "), name)
+		}
+
+		state[name] = visiting
+		for _, dep := range s.dependencies() {
+			if err := visit(dep, append(chain, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		sorted = append(sorted, s)
+		return nil
+	}
+
+	for _, n := range names {
+		if err := visit(n, nil); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// Service returns the service by name.
+func (p *Project) Service(name string) (*ServiceConfig, error) {
+	for _, s := range p.Services {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("no such service: %s", name)
+}
+
+// withDependencies returns the services and their dependencies recursively,
+// sorted in the order of dependency. All the services are returned if no
+// service is specified.
+func (p *Project) withDependencies(names []string) ([]*ServiceConfig, error) {
+	if len(names) == 0 {
+		return p.Services, nil
+	}
+
+	selected := map[string]bool{}
+	var selectService func(name string) error
+	selectService = func(name string) error {
+		if selected[name] {
+			return nil
+		}
+		s, err := p.Service(name)
+		if err != nil {
+			return err
+		}
+		selected[name] = true
+		for _, dep := range s.dependencies() {
+			if err := selectService(dep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, n := range names {
+		if err := selectService(n); err != nil {
+			return nil, err
+		}
+	}
+
+	var services []*ServiceConfig
+	for _, s := range p.Services {
+		if selected[s.Name] {
+			services = append(services, s)
+		}
+	}
+	return services, nil
+}
@@ -0,0 +1,178 @@
+package compose
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadProject(t *testing.T, data string, env map[string]string) (*Project, error) {
+	config, err := parse([]byte(data), lookupMap(env))
+	if err != nil {
+		return nil, err
+	}
+	return newProject("My App.v2", "/srv/app", config)
+}
+
+func serviceNames(services []*ServiceConfig) []string {
+	var names []string
+	for _, s := range services {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compose")
+	if err != nil {
+		t.Fatalf("failed to create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = FindFile(dir)
+	assert.Error(t, err)
+
+	file := filepath.Join(dir, "docker-compose.yml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("services:\n  web:\n    image: nginx\n"), 0644))
+
+	found, err := FindFile(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, file, found)
+
+	p, err := Load(file, "", lookupMap(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, normalizeProjectName(filepath.Base(dir)), p.Name)
+	assert.Equal(t, dir, p.WorkingDir)
+
+	p, err = Load(file, "demo", lookupMap(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "demo", p.Name)
+}
+
+func TestParseServices(t *testing.T) {
+	p, err := loadProject(t, `
+version: "3.7"
+x-common: &common
+  restart: always
+services:
+  web:
+    <<: *common
+    image: "nginx:${TAG:-latest}"
+    command: nginx -g "daemon off;"
+    environment:
+      MODE: prod
+      DEBUG:
+    labels:
+      - tier=frontend
+    extra_hosts:
+      db.local: 10.0.0.2
+    dns: 8.8.8.8
+    networks:
+      front:
+        aliases: [www]
+    depends_on:
+      api:
+        condition: service_healthy
+  api:
+    image: api
+    entrypoint: ["/bin/api", "--port", "8080"]
+    environment:
+      - MODE=prod
+    networks: [front, back]
+    depends_on: [db]
+  db:
+    image: postgres
+networks:
+  front:
+  back:
+    driver: bridge
+    labels:
+      owner: dba
+`, map[string]string{"TAG": "1.17"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "myappv2", p.Name)
+	assert.Equal(t, []string{"db", "api", "web"}, serviceNames(p.Services))
+
+	web, _ := p.Service("web")
+	assert.Equal(t, "nginx:1.17", web.Image)
+	assert.Equal(t, "always", web.Restart)
+	assert.Equal(t, ShellCommand{"nginx", "-g", "daemon off;"}, web.Command)
+	assert.Equal(t, Environment{"DEBUG", "MODE=prod"}, web.Environment)
+	assert.Equal(t, Labels{"tier": "frontend"}, web.Labels)
+	assert.Equal(t, ExtraHosts{"db.local:10.0.0.2"}, web.ExtraHosts)
+	assert.Equal(t, StringOrList{"8.8.8.8"}, web.DNS)
+	assert.Equal(t, []string{"www"}, web.Networks["front"].Aliases)
+	assert.Equal(t, DependsOn{"api": {Condition: ConditionServiceHealthy}}, web.DependsOn)
+
+	api, _ := p.Service("api")
+	assert.Equal(t, ShellCommand{"/bin/api", "--port", "8080"}, api.Entrypoint)
+	assert.Equal(t, ServiceNetworks{"front": nil, "back": nil}, api.Networks)
+	assert.Equal(t, DependsOn{"db": {Condition: ConditionServiceStarted}}, api.DependsOn)
+
+	// the service without networks joins the default network.
+	db, _ := p.Service("db")
+	assert.Equal(t, ServiceNetworks{defaultNetwork: nil}, db.Networks)
+	assert.Contains(t, p.Networks, defaultNetwork)
+	assert.Equal(t, Labels{"owner": "dba"}, p.Networks["back"].Labels)
+}
+
+func TestParseInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"unsupported version":   "version: '2'\nservices:\n  web:\n    image: nginx\n",
+		"unsupported key":       "services:\n  web:\n    build: .\n",
+		"no service":            "version: '3'\n",
+		"no image":              "services:\n  web:\n    command: ls\n",
+		"undefined network":     "services:\n  web:\n    image: nginx\n    networks: [front]\n",
+		"undefined volume":      "services:\n  web:\n    image: nginx\n    volumes: ['data:/data']\n",
+		"relative target":       "services:\n  web:\n    image: nginx\n    volumes: ['./html:html']\n",
+		"undefined dependency":  "services:\n  web:\n    image: nginx\n    depends_on: [db]\n",
+		"depend on itself":      "services:\n  web:\n    image: nginx\n    depends_on: [web]\n",
+		"unsupported condition": "services:\n  web:\n    image: nginx\n    depends_on:\n      db:\n        condition: service_completed_successfully\n  db:\n    image: db\n",
+		"network mode conflict": "services:\n  web:\n    image: nginx\n    network_mode: host\n    networks: [default]\n",
+		"missing variable":      "services:\n  web:\n    image: ${IMAGE:?image is required}\n",
+		"circular dependency":   "services:\n  a:\n    image: a\n    depends_on: [b]\n  b:\n    image: b\n    depends_on: [c]\n  c:\n    image: c\n    network_mode: service:a\n",
+	} {
+		_, err := loadProject(t, data, nil)
+		assert.Error(t, err, "expected error of %s", name)
+	}
+}
+
+func TestWithDependencies(t *testing.T) {
+	p, err := loadProject(t, `
+services:
+  web:
+    image: nginx
+    depends_on: [api]
+  api:
+    image: api
+    depends_on: [db, cache]
+  db:
+    image: postgres
+  cache:
+    image: redis
+  worker:
+    image: worker
+    depends_on: [cache]
+`, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"cache", "db", "api", "web", "worker"}, serviceNames(p.Services))
+
+	services, err := p.withDependencies([]string{"api"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cache", "db", "api"}, serviceNames(services))
+
+	services, err = p.withDependencies([]string{"worker", "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cache", "db", "worker"}, serviceNames(services))
+
+	_, err = p.withDependencies([]string{"proxy"})
+	assert.Error(t, err)
+}
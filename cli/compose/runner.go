@@ -0,0 +1,364 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/client"
+)
+
+// UpOptions are the options of Up.
+type UpOptions struct {
+	// Services are the services to create and start with their
+	// dependencies, all the services if it's empty.
+	Services []string
+
+	// ForceRecreate recreates the containers even if the configurations
+	// are not changed.
+	ForceRecreate bool
+
+	// NoStart creates the containers without starting them.
+	NoStart bool
+
+	// Timeout is the seconds to wait for the recreated containers to stop
+	// before killing.
+	Timeout int
+}
+
+// DownOptions are the options of Down.
+type DownOptions struct {
+	// RemoveVolumes removes the named volumes of project.
+	RemoveVolumes bool
+
+	// Timeout is the seconds to wait for containers to stop before killing.
+	Timeout int
+}
+
+// Runner runs the project by the pouch api.
+type Runner struct {
+	project *Project
+	client  client.CommonAPIClient
+	out     io.Writer
+	lookup  LookupEnv
+
+	// PullImage pulls the image of service if it's missing, the image
+	// should be pulled beforehand if it's nil.
+	PullImage func(ctx context.Context, image string) error
+}
+
+// NewRunner creates the runner of project, the progress is written to out,
+// and the environment variables without value in services are resolved by
+// lookup.
+func NewRunner(p *Project, apiClient client.CommonAPIClient, out io.Writer, lookup LookupEnv) *Runner {
+	return &Runner{
+		project: p,
+		client:  apiClient,
+		out:     out,
+		lookup:  lookup,
+	}
+}
+
+// Up creates the networks, volumes and containers of project, and starts
+// the containers in the order of dependency. The containers whose
+// configurations are changed are recreated, and so are the ones depending
+// on them, since the dependencies of container are bound to the IDs.
+func (r *Runner) Up(ctx context.Context, options UpOptions) error {
+	services, err := r.project.withDependencies(options.Services)
+	if err != nil {
+		return err
+	}
+
+	specs := map[string]*containerSpec{}
+	for _, s := range services {
+		spec, err := r.project.containerSpec(s, r.lookup)
+		if err != nil {
+			return fmt.Errorf("invalid service %s: %v", s.Name, err)
+		}
+		specs[s.Name] = spec
+	}
+
+	if err := r.createNetworks(ctx, services); err != nil {
+		return err
+	}
+	if err := r.createVolumes(ctx); err != nil {
+		return err
+	}
+
+	existing, err := r.containers(ctx)
+	if err != nil {
+		return err
+	}
+
+	recreated := map[string]bool{}
+	for _, s := range services {
+		spec := specs[s.Name]
+		name := r.project.containerName(s)
+		c := existing[s.Name]
+
+		recreate := options.ForceRecreate || c == nil || c.Labels[LabelConfigHash] != spec.Config.Labels[LabelConfigHash]
+		for _, dep := range s.dependencies() {
+			recreate = recreate || recreated[dep]
+		}
+
+		if recreate {
+			if c != nil {
+				fmt.Fprintf(r.out, "Recreating container %s\n", name)
+				if err := r.removeContainer(ctx, c, options.Timeout); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprintf(r.out, "Creating container %s\n", name)
+			}
+			if err := r.createContainer(ctx, name, spec); err != nil {
+				return fmt.Errorf("failed to create container of service %s: %v", s.Name, err)
+			}
+			recreated[s.Name] = true
+		} else if c.State == "running" {
+			fmt.Fprintf(r.out, "Container %s is up-to-date\n", name)
+			continue
+		}
+
+		if options.NoStart {
+			continue
+		}
+		fmt.Fprintf(r.out, "Starting container %s\n", name)
+		if err := r.client.ContainerStart(ctx, name, types.ContainerStartOptions{}); err != nil {
+			return fmt.Errorf("failed to start container of service %s: %v", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// Down stops and removes the containers of project in the reverse order of
+// dependency, then removes the networks, and the named volumes if
+// specified. The containers of services removed from the compose file are
+// also removed.
+func (r *Runner) Down(ctx context.Context, options DownOptions) error {
+	existing, err := r.containers(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the containers of services in the compose file are removed in order,
+	// and the rest are removed at last.
+	for i := len(r.project.Services) - 1; i >= 0; i-- {
+		s := r.project.Services[i]
+		if c, ok := existing[s.Name]; ok {
+			if err := r.removeContainer(ctx, c, options.Timeout); err != nil {
+				return err
+			}
+			delete(existing, s.Name)
+		}
+	}
+	for _, c := range existing {
+		if err := r.removeContainer(ctx, c, options.Timeout); err != nil {
+			return err
+		}
+	}
+
+	networks, err := r.client.NetworkList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range networks {
+		if n.Labels[LabelProject] != r.project.Name {
+			continue
+		}
+		fmt.Fprintf(r.out, "Removing network %s\n", n.Name)
+		if err := r.client.NetworkRemove(ctx, n.Name); err != nil {
+			return fmt.Errorf("failed to remove network %s: %v", n.Name, err)
+		}
+	}
+
+	if !options.RemoveVolumes {
+		return nil
+	}
+	volumes, err := r.client.VolumeList(ctx, filters.NewArgs())
+	if err != nil {
+		return err
+	}
+	for _, v := range volumes.Volumes {
+		if v.Labels[LabelProject] != r.project.Name {
+			continue
+		}
+		fmt.Fprintf(r.out, "Removing volume %s\n", v.Name)
+		if err := r.client.VolumeRemove(ctx, v.Name); err != nil {
+			return fmt.Errorf("failed to remove volume %s: %v", v.Name, err)
+		}
+	}
+	return nil
+}
+
+// Ps lists the containers of project, including the stopped ones.
+func (r *Runner) Ps(ctx context.Context) ([]*types.Container, error) {
+	return r.client.ContainerList(ctx, types.ContainerListOptions{
+		All:    true,
+		Filter: map[string][]string{"label": {LabelProject + "=" + r.project.Name}},
+	})
+}
+
+// containers returns the containers of project by service.
+func (r *Runner) containers(ctx context.Context) (map[string]*types.Container, error) {
+	containers, err := r.Ps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byService := map[string]*types.Container{}
+	for _, c := range containers {
+		byService[c.Labels[LabelService]] = c
+	}
+	return byService, nil
+}
+
+// createNetworks creates the networks used by the services, the external
+// ones should exist.
+func (r *Runner) createNetworks(ctx context.Context, services []*ServiceConfig) error {
+	used := map[string]bool{}
+	for _, s := range services {
+		for k := range s.Networks {
+			used[k] = true
+		}
+	}
+
+	networks, err := r.client.NetworkList(ctx)
+	if err != nil {
+		return err
+	}
+	exists := map[string]bool{}
+	for _, n := range networks {
+		exists[n.Name] = true
+	}
+
+	keys := make([]string, 0, len(used))
+	for k := range used {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		n, name := r.project.Networks[k], r.project.networkName(k)
+		if exists[name] {
+			continue
+		}
+		if n.External {
+			return fmt.Errorf("external network %s not found", name)
+		}
+
+		labels := map[string]string{}
+		for lk, lv := range n.Labels {
+			labels[lk] = lv
+		}
+		labels[LabelProject] = r.project.Name
+		labels[LabelNetwork] = k
+
+		driver := n.Driver
+		if driver == "" {
+			driver = "bridge"
+		}
+
+		fmt.Fprintf(r.out, "Creating network %s\n", name)
+		if _, err := r.client.NetworkCreate(ctx, &types.NetworkCreateConfig{
+			Name: name,
+			NetworkCreate: types.NetworkCreate{
+				Driver:         driver,
+				Options:        n.DriverOpts,
+				Labels:         labels,
+				Internal:       n.Internal,
+				CheckDuplicate: true,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to create network %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// createVolumes creates the named volumes of project, the external ones
+// should exist.
+func (r *Runner) createVolumes(ctx context.Context) error {
+	if len(r.project.Volumes) == 0 {
+		return nil
+	}
+
+	volumes, err := r.client.VolumeList(ctx, filters.NewArgs())
+	if err != nil {
+		return err
+	}
+	exists := map[string]bool{}
+	for _, v := range volumes.Volumes {
+		exists[v.Name] = true
+	}
+
+	keys := make([]string, 0, len(r.project.Volumes))
+	for k := range r.project.Volumes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, name := r.project.Volumes[k], r.project.volumeName(k)
+		if exists[name] {
+			continue
+		}
+		if v.External {
+			return fmt.Errorf("external volume %s not found", name)
+		}
+
+		labels := map[string]string{}
+		for lk, lv := range v.Labels {
+			labels[lk] = lv
+		}
+		labels[LabelProject] = r.project.Name
+		labels[LabelVolume] = k
+
+		fmt.Fprintf(r.out, "Creating volume %s\n", name)
+		if _, err := r.client.VolumeCreate(ctx, &types.VolumeCreateConfig{
+			Name:       name,
+			Driver:     v.Driver,
+			DriverOpts: v.DriverOpts,
+			Labels:     labels,
+		}); err != nil {
+			return fmt.Errorf("failed to create volume %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// createContainer creates the container, the image is pulled if it's
+// missing.
+func (r *Runner) createContainer(ctx context.Context, name string, spec *containerSpec) error {
+	if r.PullImage != nil {
+		if err := r.PullImage(ctx, spec.Config.Image); err != nil {
+			return err
+		}
+	}
+	_, err := r.client.ContainerCreate(ctx, spec.Config, spec.HostConfig, spec.NetworkingConfig, name)
+	return err
+}
+
+// removeContainer stops the container gracefully before removing it.
+func (r *Runner) removeContainer(ctx context.Context, c *types.Container, timeout int) error {
+	name := c.ID
+	if len(c.Names) > 0 {
+		name = c.Names[0]
+	}
+
+	if c.State == "running" || c.State == "paused" {
+		fmt.Fprintf(r.out, "Stopping container %s\n", name)
+		if err := r.client.ContainerStop(ctx, c.ID, strconv.Itoa(timeout)); err != nil {
+			return fmt.Errorf("failed to stop container %s: %v", name, err)
+		}
+	}
+
+	fmt.Fprintf(r.out, "Removing container %s\n", name)
+	if err := r.client.ContainerRemove(ctx, c.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+		return fmt.Errorf("failed to remove container %s: %v", name, err)
+	}
+	return nil
+}
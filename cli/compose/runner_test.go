@@ -0,0 +1,210 @@
+package compose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/client"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClient records the calls of runner, only the apis used by runner are
+// implemented.
+type fakeClient struct {
+	client.CommonAPIClient
+
+	containers map[string]*types.Container
+	networks   map[string]types.NetworkResource
+	volumes    map[string]*types.VolumeInfo
+	calls      []string
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		containers: map[string]*types.Container{},
+		networks:   map[string]types.NetworkResource{},
+		volumes:    map[string]*types.VolumeInfo{},
+	}
+}
+
+func (f *fakeClient) ContainerCreate(ctx context.Context, config types.ContainerConfig, hostConfig *types.HostConfig, networkConfig *types.NetworkingConfig, name string) (*types.ContainerCreateResp, error) {
+	f.calls = append(f.calls, "create "+name)
+	f.containers[name] = &types.Container{ID: name, Names: []string{name}, Labels: config.Labels, State: "created"}
+	return &types.ContainerCreateResp{ID: name}, nil
+}
+
+func (f *fakeClient) ContainerStart(ctx context.Context, name string, options types.ContainerStartOptions) error {
+	f.calls = append(f.calls, "start "+name)
+	f.containers[name].State = "running"
+	return nil
+}
+
+func (f *fakeClient) ContainerStop(ctx context.Context, name, timeout string) error {
+	f.calls = append(f.calls, "stop "+name)
+	f.containers[name].State = "exited"
+	return nil
+}
+
+func (f *fakeClient) ContainerRemove(ctx context.Context, name string, options *types.ContainerRemoveOptions) error {
+	f.calls = append(f.calls, "remove "+name)
+	delete(f.containers, name)
+	return nil
+}
+
+func (f *fakeClient) ContainerList(ctx context.Context, options types.ContainerListOptions) ([]*types.Container, error) {
+	var containers []*types.Container
+	for _, c := range f.containers {
+		if fmt.Sprintf("%s=%s", LabelProject, c.Labels[LabelProject]) == options.Filter["label"][0] {
+			containers = append(containers, c)
+		}
+	}
+	return containers, nil
+}
+
+func (f *fakeClient) NetworkCreate(ctx context.Context, req *types.NetworkCreateConfig) (*types.NetworkCreateResp, error) {
+	f.calls = append(f.calls, "create network "+req.Name)
+	f.networks[req.Name] = types.NetworkResource{Name: req.Name, Labels: req.Labels}
+	return &types.NetworkCreateResp{ID: req.Name}, nil
+}
+
+func (f *fakeClient) NetworkList(ctx context.Context) ([]types.NetworkResource, error) {
+	var networks []types.NetworkResource
+	for _, n := range f.networks {
+		networks = append(networks, n)
+	}
+	return networks, nil
+}
+
+func (f *fakeClient) NetworkRemove(ctx context.Context, name string) error {
+	f.calls = append(f.calls, "remove network "+name)
+	delete(f.networks, name)
+	return nil
+}
+
+func (f *fakeClient) VolumeCreate(ctx context.Context, config *types.VolumeCreateConfig) (*types.VolumeInfo, error) {
+	f.calls = append(f.calls, "create volume "+config.Name)
+	f.volumes[config.Name] = &types.VolumeInfo{Name: config.Name, Labels: config.Labels}
+	return f.volumes[config.Name], nil
+}
+
+func (f *fakeClient) VolumeList(ctx context.Context, filter filters.Args) (*types.VolumeListResp, error) {
+	resp := &types.VolumeListResp{}
+	for _, v := range f.volumes {
+		resp.Volumes = append(resp.Volumes, v)
+	}
+	return resp, nil
+}
+
+func (f *fakeClient) VolumeRemove(ctx context.Context, name string) error {
+	f.calls = append(f.calls, "remove volume "+name)
+	delete(f.volumes, name)
+	return nil
+}
+
+func TestRunner(t *testing.T) {
+	data := `
+services:
+  web:
+    image: nginx:%s
+    ports: ["8080:80"]
+    depends_on: [api]
+  api:
+    image: api
+    depends_on: [db]
+  db:
+    image: postgres
+    volumes: ["data:/var/lib/postgresql/data"]
+volumes:
+  data:
+  shared:
+    external: true
+`
+	load := func(tag string) *Project {
+		p, err := loadProject(t, fmt.Sprintf(data, tag), nil)
+		if err != nil {
+			t.Fatalf("failed to load project: %v", err)
+		}
+		return p
+	}
+
+	ctx := context.Background()
+	cli := newFakeClient()
+	cli.volumes["shared"] = &types.VolumeInfo{Name: "shared"}
+
+	var pulled []string
+	r := NewRunner(load("1.17"), cli, &bytes.Buffer{}, lookupMap(nil))
+	r.PullImage = func(ctx context.Context, image string) error {
+		pulled = append(pulled, image)
+		return nil
+	}
+
+	assert.NoError(t, r.Up(ctx, UpOptions{}))
+	assert.Equal(t, []string{
+		"create network myappv2_default",
+		"create volume myappv2_data",
+		"create myappv2_db_1",
+		"start myappv2_db_1",
+		"create myappv2_api_1",
+		"start myappv2_api_1",
+		"create myappv2_web_1",
+		"start myappv2_web_1",
+	}, cli.calls)
+	assert.Equal(t, []string{"postgres", "api", "nginx:1.17"}, pulled)
+
+	// nothing is changed if the project is up-to-date.
+	cli.calls = nil
+	assert.NoError(t, r.Up(ctx, UpOptions{}))
+	assert.Empty(t, cli.calls)
+
+	// the stopped container is started again.
+	cli.containers["myappv2_api_1"].State = "exited"
+	assert.NoError(t, r.Up(ctx, UpOptions{}))
+	assert.Equal(t, []string{"start myappv2_api_1"}, cli.calls)
+
+	// only the changed service is recreated.
+	cli.calls = nil
+	r = NewRunner(load("1.19"), cli, &bytes.Buffer{}, lookupMap(nil))
+	assert.NoError(t, r.Up(ctx, UpOptions{}))
+	assert.Equal(t, []string{
+		"stop myappv2_web_1",
+		"remove myappv2_web_1",
+		"create myappv2_web_1",
+		"start myappv2_web_1",
+	}, cli.calls)
+
+	// the services depending on the recreated one are recreated.
+	cli.calls = nil
+	assert.NoError(t, r.Up(ctx, UpOptions{Services: []string{"api"}, ForceRecreate: true, NoStart: true}))
+	assert.Equal(t, []string{
+		"stop myappv2_db_1",
+		"remove myappv2_db_1",
+		"create myappv2_db_1",
+		"stop myappv2_api_1",
+		"remove myappv2_api_1",
+		"create myappv2_api_1",
+	}, cli.calls)
+
+	ps, err := r.Ps(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, ps, 3)
+
+	// the containers are removed in the reverse order of dependency, and
+	// the external volume is kept.
+	cli.calls = nil
+	assert.NoError(t, r.Down(ctx, DownOptions{RemoveVolumes: true, Timeout: 10}))
+	assert.Equal(t, []string{
+		"stop myappv2_web_1",
+		"remove myappv2_web_1",
+		"remove myappv2_api_1",
+		"remove myappv2_db_1",
+		"remove network myappv2_default",
+		"remove volume myappv2_data",
+	}, cli.calls)
+	assert.Empty(t, cli.containers)
+	assert.Contains(t, cli.volumes, "shared")
+}
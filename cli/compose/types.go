@@ -0,0 +1,286 @@
+package compose
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	shellwords "github.com/mattn/go-shellwords"
+)
+
+// Config is the compose file, only a subset of the version 3 format is
+// supported, the unsupported keys are rejected rather than ignored.
+type Config struct {
+	Version  string                    `yaml:"version,omitempty"`
+	Services map[string]*ServiceConfig `yaml:"services"`
+	Networks map[string]*NetworkConfig `yaml:"networks,omitempty"`
+	Volumes  map[string]*VolumeConfig  `yaml:"volumes,omitempty"`
+}
+
+// ServiceConfig is the configuration of service, one container is created
+// for each service.
+type ServiceConfig struct {
+	// Name is the key of service in the compose file.
+	Name string `yaml:"-"`
+
+	Image         string             `yaml:"image"`
+	ContainerName string             `yaml:"container_name,omitempty"`
+	Command       ShellCommand       `yaml:"command,omitempty"`
+	Entrypoint    ShellCommand       `yaml:"entrypoint,omitempty"`
+	Environment   Environment        `yaml:"environment,omitempty"`
+	Labels        Labels             `yaml:"labels,omitempty"`
+	Ports         []string           `yaml:"ports,omitempty"`
+	Expose        []string           `yaml:"expose,omitempty"`
+	Volumes       []string           `yaml:"volumes,omitempty"`
+	Networks      ServiceNetworks    `yaml:"networks,omitempty"`
+	NetworkMode   string             `yaml:"network_mode,omitempty"`
+	DependsOn     DependsOn          `yaml:"depends_on,omitempty"`
+	Healthcheck   *HealthCheckConfig `yaml:"healthcheck,omitempty"`
+	Restart       string             `yaml:"restart,omitempty"`
+	WorkingDir    string             `yaml:"working_dir,omitempty"`
+	User          string             `yaml:"user,omitempty"`
+	Hostname      string             `yaml:"hostname,omitempty"`
+	Tty           bool               `yaml:"tty,omitempty"`
+	StdinOpen     bool               `yaml:"stdin_open,omitempty"`
+	Privileged    bool               `yaml:"privileged,omitempty"`
+	CapAdd        []string           `yaml:"cap_add,omitempty"`
+	CapDrop       []string           `yaml:"cap_drop,omitempty"`
+	DNS           StringOrList       `yaml:"dns,omitempty"`
+	ExtraHosts    ExtraHosts         `yaml:"extra_hosts,omitempty"`
+	StopSignal    string             `yaml:"stop_signal,omitempty"`
+}
+
+// HealthCheckConfig is the healthcheck of service, the durations are in the
+// format of time.ParseDuration, like 30s.
+type HealthCheckConfig struct {
+	Test        ShellCommand `yaml:"test,omitempty"`
+	Interval    string       `yaml:"interval,omitempty"`
+	Timeout     string       `yaml:"timeout,omitempty"`
+	StartPeriod string       `yaml:"start_period,omitempty"`
+	Retries     int64        `yaml:"retries,omitempty"`
+	Disable     bool         `yaml:"disable,omitempty"`
+}
+
+// NetworkConfig is the network of project.
+type NetworkConfig struct {
+	Driver     string            `yaml:"driver,omitempty"`
+	DriverOpts map[string]string `yaml:"driver_opts,omitempty"`
+	Labels     Labels            `yaml:"labels,omitempty"`
+	Internal   bool              `yaml:"internal,omitempty"`
+
+	// External means the network is created out of the project, and Name
+	// is its name, which is the key of network if it's empty.
+	External bool   `yaml:"external,omitempty"`
+	Name     string `yaml:"name,omitempty"`
+}
+
+// VolumeConfig is the named volume of project.
+type VolumeConfig struct {
+	Driver     string            `yaml:"driver,omitempty"`
+	DriverOpts map[string]string `yaml:"driver_opts,omitempty"`
+	Labels     Labels            `yaml:"labels,omitempty"`
+
+	// External means the volume is created out of the project, and Name is
+	// its name, which is the key of volume if it's empty.
+	External bool   `yaml:"external,omitempty"`
+	Name     string `yaml:"name,omitempty"`
+}
+
+// ServiceNetworkConfig is the endpoint of service on the network.
+type ServiceNetworkConfig struct {
+	Aliases     []string `yaml:"aliases,omitempty"`
+	IPv4Address string   `yaml:"ipv4_address,omitempty"`
+	IPv6Address string   `yaml:"ipv6_address,omitempty"`
+}
+
+// DependsOnConfig is the condition of dependency.
+type DependsOnConfig struct {
+	Condition string `yaml:"condition,omitempty"`
+}
+
+const (
+	// ConditionServiceStarted means the dependency should be running.
+	ConditionServiceStarted = "service_started"
+
+	// ConditionServiceHealthy means the dependency should be healthy.
+	ConditionServiceHealthy = "service_healthy"
+)
+
+// ShellCommand is the command in the form of list or string, the string is
+// split like shell.
+type ShellCommand []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (c *ShellCommand) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*c = list
+		return nil
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("command should be a string or a list of strings")
+	}
+	words, err := shellwords.Parse(s)
+	if err != nil {
+		return fmt.Errorf("invalid command %q: %v", s, err)
+	}
+	*c = words
+	return nil
+}
+
+// StringOrList is a list which could be a single string.
+type StringOrList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *StringOrList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*l = list
+		return nil
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("value should be a string or a list of strings")
+	}
+	*l = []string{s}
+	return nil
+}
+
+// Environment is the environment variables in the form of KEY=VALUE, the
+// variable without value is taken from the environment of compose.
+type Environment []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (e *Environment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*e = list
+		return nil
+	}
+
+	var m map[string]*string
+	if err := unmarshal(&m); err != nil {
+		return fmt.Errorf("environment should be a map or a list of KEY=VALUE")
+	}
+	env := make([]string, 0, len(m))
+	for k, v := range m {
+		if v == nil {
+			env = append(env, k)
+		} else {
+			env = append(env, k+"="+*v)
+		}
+	}
+	sort.Strings(env)
+	*e = env
+	return nil
+}
+
+// Labels is the labels in the form of map or list of key=value.
+type Labels map[string]string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *Labels) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var m map[string]string
+	if err := unmarshal(&m); err == nil {
+		*l = m
+		return nil
+	}
+
+	var list []string
+	if err := unmarshal(&list); err != nil {
+		return fmt.Errorf("labels should be a map or a list of key=value")
+	}
+	labels := make(map[string]string, len(list))
+	for _, kv := range list {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			labels[parts[0]] = parts[1]
+		} else {
+			labels[parts[0]] = ""
+		}
+	}
+	*l = labels
+	return nil
+}
+
+// ExtraHosts is the extra hosts in the form of host:ip, which could be a
+// map of host to ip.
+type ExtraHosts []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (h *ExtraHosts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*h = list
+		return nil
+	}
+
+	var m map[string]string
+	if err := unmarshal(&m); err != nil {
+		return fmt.Errorf("extra_hosts should be a map or a list of host:ip")
+	}
+	hosts := make([]string, 0, len(m))
+	for host, ip := range m {
+		hosts = append(hosts, host+":"+ip)
+	}
+	sort.Strings(hosts)
+	*h = hosts
+	return nil
+}
+
+// ServiceNetworks is the networks of service in the form of list, or map
+// with the configuration of endpoint.
+type ServiceNetworks map[string]*ServiceNetworkConfig
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *ServiceNetworks) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		networks := make(map[string]*ServiceNetworkConfig, len(list))
+		for _, name := range list {
+			networks[name] = nil
+		}
+		*n = networks
+		return nil
+	}
+
+	var m map[string]*ServiceNetworkConfig
+	if err := unmarshal(&m); err != nil {
+		return err
+	}
+	*n = m
+	return nil
+}
+
+// DependsOn is the dependencies of service in the form of list, or map
+// with the condition of dependency.
+type DependsOn map[string]DependsOnConfig
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (d *DependsOn) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		deps := make(map[string]DependsOnConfig, len(list))
+		for _, name := range list {
+			deps[name] = DependsOnConfig{Condition: ConditionServiceStarted}
+		}
+		*d = deps
+		return nil
+	}
+
+	var m map[string]DependsOnConfig
+	if err := unmarshal(&m); err != nil {
+		return err
+	}
+	for name, dep := range m {
+		if dep.Condition == "" {
+			dep.Condition = ConditionServiceStarted
+			m[name] = dep
+		}
+	}
+	*d = m
+	return nil
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/opts/config"
@@ -24,10 +26,12 @@ type container struct {
 	workdir             string
 	user                string
 	groupAdd            []string
+	dependsOn           []string
 	hostname            string
 	rm                  bool
 	disableNetworkFiles bool
 	specificID          string
+	snapshotter         string
 
 	blkioWeight          uint16
 	blkioWeightDevice    config.WeightDevice
@@ -41,6 +45,7 @@ type container struct {
 	cpusetmems string
 	cpuperiod  int64
 	cpuquota   int64
+	numaPolicy string
 
 	memory            string
 	memoryReservation string
@@ -61,10 +66,12 @@ type container struct {
 	ipcMode       string
 	pidMode       string
 	utsMode       string
+	usernsMode    string
 	sysctls       []string
 
 	// set network options
 	networks    []string
+	netAliases  []string
 	ports       []string
 	expose      []string
 	publishAll  bool
@@ -75,6 +82,7 @@ type container struct {
 	dns         []string
 	dnsOptions  []string
 	dnsSearch   []string
+	extraHosts  []string
 
 	securityOpt    []string
 	capAdd         []string
@@ -87,8 +95,29 @@ type container struct {
 	cgroupParent   string
 	ulimit         config.Ulimit
 	pidsLimit      int64
+	hugepageLimits []string
 	shmSize        string
 
+	readOnly      bool
+	tmpfs         []string
+	maskedPaths   []string
+	readonlyPaths []string
+	storageOpt    []string
+
+	rootfs            string
+	rootfsPropagation string
+	rootfsOwner       string
+
+	hooks []string
+
+	init bool
+
+	memoryPressurePolicy string
+	memoryPressureLevel  string
+
+	egressBandwidth  string
+	ingressBandwidth string
+
 	// log driver and log option
 	logDriver string
 	logOpts   []string
@@ -101,6 +130,15 @@ type container struct {
 	// nvidia container
 	nvidiaVisibleDevices     string
 	nvidiaDriverCapabilities string
+	gpus                     string
+
+	// healthcheck
+	healthCmd         string
+	healthInterval    time.Duration
+	healthTimeout     time.Duration
+	healthStartPeriod time.Duration
+	healthRetries     int64
+	noHealthcheck     bool
 }
 
 func (c *container) config() (*types.ContainerCreateConfig, error) {
@@ -145,11 +183,35 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	gpuRequest, err := opts.ParseGPUs(c.gpus)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryPressurePolicy, err := opts.ParseMemoryPressurePolicy(c.memoryPressurePolicy, c.memoryPressureLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	networkBandwidth, err := opts.ParseNetworkBandwidth(c.egressBandwidth, c.ingressBandwidth)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := opts.ValidateExtraHosts(c.extraHosts); err != nil {
+		return nil, err
+	}
+
 	sysctls, err := opts.ParseSysctls(c.sysctls)
 	if err != nil {
 		return nil, err
 	}
 
+	hugepageLimits, err := opts.ParseHugepageLimits(c.hugepageLimits)
+	if err != nil {
+		return nil, err
+	}
+
 	diskQuota, err := opts.ParseDiskQuota(c.diskQuota)
 	if err != nil {
 		return nil, err
@@ -178,6 +240,10 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	if err := opts.SetEndpointAliases(networkingConfig, networkMode, c.netAliases); err != nil {
+		return nil, err
+	}
+
 	if err := opts.ValidateNetworks(networkingConfig); err != nil {
 		return nil, err
 	}
@@ -206,6 +272,31 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	dependsOn, err := opts.ParseDependsOn(c.dependsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpfs, err := opts.ParseTmpfs(c.tmpfs)
+	if err != nil {
+		return nil, err
+	}
+
+	storageOpt, err := opts.ParseStorageOpt(c.storageOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := opts.ParseHooks(c.hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	healthcheck, err := opts.ParseHealthcheck(c.healthCmd, c.healthInterval, c.healthTimeout, c.healthStartPeriod, c.healthRetries, c.noHealthcheck)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &types.ContainerCreateConfig{
 		ContainerConfig: types.ContainerConfig{
 			Tty:                 c.tty,
@@ -220,18 +311,21 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 			RichMode:            c.richMode,
 			InitScript:          c.initScript,
 			ExposedPorts:        ports,
+			Healthcheck:         healthcheck,
 			DiskQuota:           diskQuota,
 			QuotaID:             quotaID,
 			SpecAnnotation:      specAnnotation,
 			NetPriority:         c.netPriority,
 			SpecificID:          c.specificID,
 			MacAddress:          c.macAddress,
+			Snapshotter:         c.snapshotter,
 		},
 
 		HostConfig: &types.HostConfig{
 			Binds:        c.volume.Value(),
 			VolumesFrom:  c.volumesFrom,
 			VolumeDriver: c.volumeDriver,
+			DependsOn:    dependsOn,
 			Runtime:      c.runtime,
 			Resources: types.Resources{
 				// cpu
@@ -267,16 +361,20 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 				CgroupParent:  c.cgroupParent,
 				Ulimits:       c.ulimit.Value(),
 				PidsLimit:     c.pidsLimit,
+
+				HugepageLimits: hugepageLimits,
 			},
 			DNS:             c.dns,
 			DNSOptions:      c.dnsOptions,
 			DNSSearch:       c.dnsSearch,
+			ExtraHosts:      c.extraHosts,
 			EnableLxcfs:     c.enableLxcfs,
 			Privileged:      c.privileged,
 			RestartPolicy:   restartPolicy,
 			IpcMode:         c.ipcMode,
 			PidMode:         c.pidMode,
 			UTSMode:         c.utsMode,
+			UsernsMode:      c.usernsMode,
 			GroupAdd:        c.groupAdd,
 			Sysctls:         sysctls,
 			SecurityOpt:     c.securityOpt,
@@ -286,11 +384,20 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 			CapDrop:         c.capDrop,
 			PortBindings:    portBindings,
 			OomScoreAdj:     c.oomScoreAdj,
+			NumaPolicy:      c.numaPolicy,
 			LogConfig: &types.LogConfig{
 				LogDriver: c.logDriver,
 				LogOpts:   logOpts,
 			},
-			ShmSize: &shmSize,
+			ShmSize:              &shmSize,
+			MemoryPressurePolicy: memoryPressurePolicy,
+			NetworkBandwidth:     networkBandwidth,
+			ReadonlyRootfs:       c.readOnly,
+			Tmpfs:                tmpfs,
+			MaskedPaths:          c.maskedPaths,
+			ReadonlyPaths:        c.readonlyPaths,
+			StorageOpt:           storageOpt,
+			Hooks:                hooks,
 		},
 
 		NetworkingConfig: networkingConfig,
@@ -303,5 +410,33 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		}
 	}
 
+	if gpuRequest != nil {
+		config.HostConfig.Resources.DeviceRequests = []*types.DeviceRequest{gpuRequest}
+	}
+
+	if c.rootfs != "" {
+		config.HostConfig.Rootfs = &types.RootfsConfig{
+			Path:        c.rootfs,
+			Propagation: c.rootfsPropagation,
+			Owner:       c.rootfsOwner,
+		}
+	} else if c.rootfsPropagation != "" || c.rootfsOwner != "" {
+		return nil, fmt.Errorf("--rootfs-propagation and --rootfs-owner require --rootfs")
+	}
+
 	return config, nil
 }
+
+// imageAndCmd splits the args into the image and command of container, the
+// args are all taken as the command if the container is created from the
+// rootfs on host.
+func (c *container) imageAndCmd(args []string) (string, []string, error) {
+	if c.rootfs != "" {
+		return "", args, nil
+	}
+
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("requires an image unless --rootfs is specified")
+	}
+	return args[0], args[1:], nil
+}
@@ -29,7 +29,7 @@ func (cc *CreateCommand) Init(c *Cli) {
 		Use:   "create [OPTIONS] IMAGE [ARG...]",
 		Short: "Create a new container with specified image",
 		Long:  createDescription,
-		Args:  cobra.MinimumNArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cc.runCreate(args)
 		},
@@ -62,17 +62,27 @@ func (cc *CreateCommand) runCreate(args []string) error {
 		return nil
 	}
 	config.ContainerConfig.OpenStdin = cc.openstdin
+	// the default of daemon is used unless init is set explicitly.
+	if cc.cmd.Flags().Changed("init") {
+		config.HostConfig.Init = &cc.init
+	}
 
-	config.Image = args[0]
-	if len(args) > 1 {
-		config.Cmd = args[1:]
+	image, cmd, err := cc.imageAndCmd(args)
+	if err != nil {
+		return err
+	}
+	config.Image = image
+	if len(cmd) > 0 {
+		config.Cmd = cmd
 	}
 	containerName := cc.name
 
 	ctx := context.Background()
 	apiClient := cc.cli.Client()
-	if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
-		return err
+	if config.Image != "" {
+		if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
+			return err
+		}
 	}
 
 	result, err := apiClient.ContainerCreate(ctx, config.ContainerConfig, config.HostConfig, config.NetworkingConfig, containerName)
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// diffDescription is used to describe diff command in detail and auto generate command doc.
+var diffDescription = "Inspect the changes of files or directories on a container's filesystem against its image. " +
+	"A is added, C is changed and D is deleted."
+
+// changeKinds is the symbol of the kind of change.
+var changeKinds = []string{"C", "A", "D"}
+
+// DiffCommand is used to implement 'diff' command.
+type DiffCommand struct {
+	baseCommand
+}
+
+// Init initializes DiffCommand command.
+func (d *DiffCommand) Init(c *Cli) {
+	d.cli = c
+	d.cmd = &cobra.Command{
+		Use:   "diff CONTAINER",
+		Short: "Inspect changes on a container's filesystem",
+		Long:  diffDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return d.runDiff(args)
+		},
+		Example: diffExample(),
+	}
+}
+
+// runDiff is the entry of DiffCommand command.
+func (d *DiffCommand) runDiff(args []string) error {
+	ctx := context.Background()
+	apiClient := d.cli.Client()
+
+	changes, err := apiClient.ContainerChanges(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get changes of container %s: %v", args[0], err)
+	}
+
+	for _, change := range changes {
+		kind := "?"
+		if int(change.Kind) < len(changeKinds) {
+			kind = changeKinds[change.Kind]
+		}
+		fmt.Printf("%s %s\n", kind, change.Path)
+	}
+	return nil
+}
+
+// diffExample shows examples in diff command, and is used in auto-generated cli docs.
+func diffExample() string {
+	return `$ pouch run -d --name foo busybox sh -c 'touch /tmp/foo && rm -rf /home && sleep 1000'
+$ pouch diff foo
+D /home
+C /tmp
+A /tmp/foo`
+}
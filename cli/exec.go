@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/client"
 	"github.com/alibaba/pouch/pkg/ioutils"
@@ -32,6 +33,12 @@ type ExecCommand struct {
 	User        string
 	Envs        []string
 	Privileged  bool
+	Workdir     string
+	GroupAdd    []string
+	Memory      string
+	CPUShares   int64
+	CPUPeriod   int64
+	CPUQuota    int64
 }
 
 // Init initializes ExecCommand command.
@@ -60,6 +67,12 @@ func (e *ExecCommand) addFlags() {
 	flagSet.StringVarP(&e.User, "user", "u", "", "Username or UID (format: <name|uid>[:<group|gid>])")
 	flagSet.StringArrayVarP(&e.Envs, "env", "e", []string{}, "Set environment variables")
 	flagSet.BoolVar(&e.Privileged, "privileged", false, "Give extended privileges to the exec process")
+	flagSet.StringVarP(&e.Workdir, "workdir", "w", "", "Working directory inside the container")
+	flagSet.StringSliceVar(&e.GroupAdd, "group-add", nil, "Add additional groups to the exec process")
+	flagSet.StringVarP(&e.Memory, "memory", "m", "", "Memory limit of the exec process")
+	flagSet.Int64Var(&e.CPUShares, "cpu-shares", 0, "CPU shares (relative weight) of the exec process")
+	flagSet.Int64Var(&e.CPUPeriod, "cpu-period", 0, "Limit CPU CFS (Completely Fair Scheduler) period of the exec process")
+	flagSet.Int64Var(&e.CPUQuota, "cpu-quota", 0, "Limit CPU CFS (Completely Fair Scheduler) quota of the exec process")
 }
 
 // runExec is the entry of ExecCommand command.
@@ -71,6 +84,11 @@ func (e *ExecCommand) runExec(args []string) error {
 	id := args[0]
 	command := args[1:]
 
+	memory, err := opts.ParseMemory(e.Memory)
+	if err != nil {
+		return err
+	}
+
 	createExecConfig := &types.ExecCreateConfig{
 		Cmd:          command,
 		Tty:          e.Terminal,
@@ -81,6 +99,18 @@ func (e *ExecCommand) runExec(args []string) error {
 		Privileged:   e.Privileged,
 		User:         e.User,
 		Env:          e.Envs,
+		WorkingDir:   e.Workdir,
+		GroupAdd:     e.GroupAdd,
+	}
+
+	// run the exec process in a dedicated cgroup only if limits are specified.
+	if memory != 0 || e.CPUShares != 0 || e.CPUPeriod != 0 || e.CPUQuota != 0 {
+		createExecConfig.Resources = &types.ExecResources{
+			Memory:    memory,
+			CPUShares: e.CPUShares,
+			CPUPeriod: e.CPUPeriod,
+			CPUQuota:  e.CPUQuota,
+		}
 	}
 
 	if err := checkTty(createExecConfig.AttachStdin, createExecConfig.Tty, os.Stdin.Fd()); err != nil {
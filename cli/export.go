@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// exportDescription is used to describe export command in detail and auto generate command doc.
+var exportDescription = "Export the filesystem of a container as a tar archive, " +
+	"the volumes of the container are not included."
+
+// ExportCommand use to implement 'export' command.
+type ExportCommand struct {
+	baseCommand
+	output string
+}
+
+// Init initialize export command.
+func (e *ExportCommand) Init(c *Cli) {
+	e.cli = c
+	e.cmd = &cobra.Command{
+		Use:   "export [OPTIONS] CONTAINER",
+		Short: "Export a container's filesystem as a tar archive",
+		Long:  exportDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return e.runExport(args)
+		},
+		Example: exportExample(),
+	}
+	e.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (e *ExportCommand) addFlags() {
+	flagSet := e.cmd.Flags()
+	flagSet.StringVarP(&e.output, "output", "o", "", "Write to a file, instead of STDOUT")
+}
+
+// runExport is the entry of export command.
+func (e *ExportCommand) runExport(args []string) error {
+	ctx := context.Background()
+	apiClient := e.cli.Client()
+
+	r, err := apiClient.ContainerExport(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out := os.Stdout
+	if e.output != "" {
+		out, err = os.Create(e.output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	_, err = io.Copy(out, r)
+	return err
+}
+
+// exportExample shows examples in export command, and is used in auto-generated cli docs.
+func exportExample() string {
+	return `$ pouch export -o rootfs.tar 25bf50
+$ pouch import rootfs.tar foo:rootfs
+sha256:1c7e415c4ea5b3e3a5c13e0a3e38f5e4a0e0f1b1ad7e7d5ac6aa3d2d5a8b0c9e`
+}
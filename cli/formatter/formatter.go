@@ -0,0 +1,137 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alibaba/pouch/pkg/utils/templates"
+)
+
+const (
+	// TableFormatKey is the prefix of table format, like
+	// "table {{.ID}}\t{{.Name}}", the default table of list is used if
+	// nothing follows it.
+	TableFormatKey = "table"
+
+	// JSONFormatKey writes each element as a line of JSON.
+	JSONFormatKey = "json"
+
+	// tablePadding is the same as the padding of default table of cli.
+	tablePadding = 3
+)
+
+// Format is the format of list, which is "json", "table", a go template
+// prefixed by "table", or a go template.
+type Format string
+
+// IsTable returns whether the elements are written as a table with header.
+func (f Format) IsTable() bool {
+	return strings.HasPrefix(string(f), TableFormatKey)
+}
+
+// Context writes the list of elements in the format.
+type Context struct {
+	// Output is where the list is written.
+	Output io.Writer
+
+	// Format is the format of list.
+	Format Format
+
+	// DefaultTable is the template of rows if the format is "table".
+	DefaultTable string
+
+	// Header is the headers of fields in the table.
+	Header map[string]string
+}
+
+// template returns the template of element, the escaped tab and newline
+// are unescaped, so that they can be typed in shell easily.
+func (c *Context) template() string {
+	tmpl := string(c.Format)
+	switch {
+	case tmpl == JSONFormatKey:
+		return "{{json .}}"
+	case tmpl == TableFormatKey:
+		tmpl = c.DefaultTable
+	case c.Format.IsTable():
+		tmpl = strings.TrimPrefix(tmpl, TableFormatKey)
+		tmpl = strings.TrimLeft(tmpl, " ")
+	}
+	return strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(tmpl)
+}
+
+// Write writes the elements in the format. The exported methods without
+// arguments of element are the fields used in the template, like {{.ID}}.
+func (c *Context) Write(elements []interface{}) error {
+	tmplStr := c.template()
+	tmpl, err := templates.Parse(tmplStr)
+	if err != nil {
+		return fmt.Errorf("invalid format %q: %v", c.Format, err)
+	}
+
+	out := c.Output
+	var tw *tabwriter.Writer
+	if c.Format.IsTable() {
+		tw = tabwriter.NewWriter(c.Output, 0, 0, tablePadding, ' ', 0)
+		out = tw
+
+		if header := c.header(tmplStr); header != "" {
+			fmt.Fprintln(out, header)
+		}
+	}
+
+	for _, e := range elements {
+		buf := &bytes.Buffer{}
+		if err := tmpl.Execute(buf, e); err != nil {
+			return fmt.Errorf("failed to execute template %q: %v", tmplStr, err)
+		}
+		fmt.Fprintln(out, strings.TrimRight(buf.String(), "\n"))
+	}
+
+	if tw != nil {
+		return tw.Flush()
+	}
+	return nil
+}
+
+// header renders the template with the headers of fields, the header is
+// omitted if the template cannot be rendered by them, for example, it
+// calls a method with arguments.
+func (c *Context) header(tmplStr string) string {
+	tmpl, err := templates.Parse(tmplStr)
+	if err != nil {
+		return ""
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Option("missingkey=zero").Execute(buf, c.Header); err != nil {
+		return ""
+	}
+	return strings.TrimRight(buf.String(), "\n")
+}
+
+// MarshalJSON marshals the element as a JSON object of its fields, it's
+// used to implement json.Marshaler of element, so that {{json .}} shows
+// all the fields.
+func MarshalJSON(e interface{}) ([]byte, error) {
+	fields := map[string]interface{}{}
+
+	v := reflect.ValueOf(e)
+	t := v.Type()
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if m.Name == "MarshalJSON" {
+			continue
+		}
+		// the receiver is the only argument.
+		if m.Type.NumIn() != 1 || m.Type.NumOut() != 1 {
+			continue
+		}
+		fields[m.Name] = v.Method(i).Call(nil)[0].Interface()
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,90 @@
+package formatter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeContext struct {
+	id   string
+	name string
+}
+
+func (c fakeContext) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(c)
+}
+
+func (c fakeContext) ID() string {
+	return c.id
+}
+
+func (c fakeContext) Name() string {
+	return c.name
+}
+
+func (c fakeContext) Label(name string) string {
+	return name
+}
+
+func TestWrite(t *testing.T) {
+	elements := []interface{}{
+		fakeContext{id: "e42c68", name: "web"},
+		fakeContext{id: "a8c2ea", name: "database"},
+	}
+	header := map[string]string{"ID": "ID", "Name": "NAME"}
+
+	for _, tc := range []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   "{{.ID}}: {{.Name}}",
+			expected: "e42c68: web\na8c2ea: database\n",
+		},
+		{
+			format:   `{{.Name}}\t{{.Label "app"}}`,
+			expected: "web\tapp\ndatabase\tapp\n",
+		},
+		{
+			format:   "json",
+			expected: "{\"ID\":\"e42c68\",\"Name\":\"web\"}\n{\"ID\":\"a8c2ea\",\"Name\":\"database\"}\n",
+		},
+		{
+			format:   "table",
+			expected: "NAME       ID\nweb        e42c68\ndatabase   a8c2ea\n",
+		},
+		{
+			format:   `table {{.ID}}\t{{.Name | upper}}`,
+			expected: "ID       NAME\ne42c68   WEB\na8c2ea   DATABASE\n",
+		},
+		{
+			// the header is omitted if it cannot be rendered.
+			format:   `table {{.ID}}\t{{.Label "app"}}`,
+			expected: "e42c68   app\na8c2ea   app\n",
+		},
+	} {
+		out := &bytes.Buffer{}
+		ctx := &Context{
+			Output:       out,
+			Format:       Format(tc.format),
+			DefaultTable: `{{.Name}}\t{{.ID}}`,
+			Header:       header,
+		}
+		assert.NoError(t, ctx.Write(elements), "unexpected error of format %s", tc.format)
+		assert.Equal(t, tc.expected, out.String(), "unexpected output of format %s", tc.format)
+	}
+
+	// the header of table is written even if the list is empty.
+	out := &bytes.Buffer{}
+	ctx := &Context{Output: out, Format: "table {{.ID}}", Header: header}
+	assert.NoError(t, ctx.Write(nil))
+	assert.Equal(t, "ID\n", out.String())
+
+	ctx = &Context{Output: out, Format: "{{.ID"}
+	assert.Error(t, ctx.Write(elements))
+
+	ctx = &Context{Output: out, Format: "{{.Unknown}}"}
+	assert.Error(t, ctx.Write(elements))
+}
@@ -36,7 +36,7 @@ func (i *ImageInspectCommand) Init(c *Cli) {
 
 // addFlags adds flags for specific command.
 func (i *ImageInspectCommand) addFlags() {
-	i.cmd.Flags().StringVarP(&i.format, "format", "f", "", "Format the output using the given go template")
+	i.cmd.Flags().StringVarP(&i.format, "format", "f", "", "Format the output using the given go template, or json for the indented JSON")
 }
 
 // runInpsect is used to inspect image.
@@ -3,9 +3,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/cli/formatter"
 	"github.com/alibaba/pouch/pkg/reference"
 	"github.com/alibaba/pouch/pkg/utils"
 
@@ -25,10 +27,13 @@ func (i imageSize) String() string {
 }
 
 type displayImage struct {
-	id     string
-	name   string
-	size   imageSize
-	digest string
+	id         string
+	name       string
+	repository string
+	tag        string
+	size       imageSize
+	digest     string
+	createdAt  string
 }
 
 // ImagesCommand use to implement 'images' command.
@@ -40,6 +45,7 @@ type ImagesCommand struct {
 	flagDigest  bool
 	flagNoTrunc bool
 	flagFilter  []string
+	flagFormat  string
 }
 
 // Init initialize images command.
@@ -66,6 +72,7 @@ func (i *ImagesCommand) addFlags() {
 	flagSet.BoolVar(&i.flagDigest, "digest", false, "Show images with digest")
 	flagSet.BoolVar(&i.flagNoTrunc, "no-trunc", false, "Do not truncate output")
 	flagSet.StringSliceVarP(&i.flagFilter, "filter", "f", []string{}, "Filter output based on conditions provided, filter support reference, since, before")
+	flagSet.StringVar(&i.flagFormat, "format", "", "Format the output using the given go template, json, or table with the template")
 }
 
 // runImages is the entry of images container command.
@@ -94,6 +101,15 @@ func (i *ImagesCommand) runImages(args []string) error {
 		return nil
 	}
 
+	dimgs := make([]displayImage, 0, len(imageList))
+	for _, img := range imageList {
+		dimgs = append(dimgs, imageInfoToDisplayImages(img, i.flagNoTrunc)...)
+	}
+
+	if i.flagFormat != "" {
+		return i.formatImages(dimgs)
+	}
+
 	display := i.cli.NewTableDisplay()
 	if i.flagDigest {
 		display.AddRow([]string{"IMAGE ID", "IMAGE NAME", "DIGEST", "SIZE"})
@@ -101,11 +117,6 @@ func (i *ImagesCommand) runImages(args []string) error {
 		display.AddRow([]string{"IMAGE ID", "IMAGE NAME", "SIZE"})
 	}
 
-	dimgs := make([]displayImage, 0, len(imageList))
-	for _, img := range imageList {
-		dimgs = append(dimgs, imageInfoToDisplayImages(img, i.flagNoTrunc)...)
-	}
-
 	for _, dimg := range dimgs {
 		if i.flagDigest {
 			display.AddRow([]string{dimg.id, dimg.name, dimg.digest, dimg.size.String()})
@@ -118,6 +129,84 @@ func (i *ImagesCommand) runImages(args []string) error {
 	return nil
 }
 
+// formatImages writes the images in the format of --format.
+func (i *ImagesCommand) formatImages(dimgs []displayImage) error {
+	defaultTable := "{{.ID}}\t{{.Name}}\t{{.Size}}"
+	if i.flagDigest {
+		defaultTable = "{{.ID}}\t{{.Name}}\t{{.Digest}}\t{{.Size}}"
+	}
+
+	elements := make([]interface{}, 0, len(dimgs))
+	for _, dimg := range dimgs {
+		elements = append(elements, imageContext{dimg})
+	}
+
+	ctx := &formatter.Context{
+		Output:       os.Stdout,
+		Format:       formatter.Format(i.flagFormat),
+		DefaultTable: defaultTable,
+		Header:       imageFormatHeader,
+	}
+	return ctx.Write(elements)
+}
+
+// imageFormatHeader is the headers of fields of imageContext.
+var imageFormatHeader = map[string]string{
+	"ID":         "IMAGE ID",
+	"Name":       "IMAGE NAME",
+	"Repository": "REPOSITORY",
+	"Tag":        "TAG",
+	"Digest":     "DIGEST",
+	"Size":       "SIZE",
+	"CreatedAt":  "CREATED AT",
+}
+
+// imageContext is the image rendered by the template of --format.
+type imageContext struct {
+	img displayImage
+}
+
+// MarshalJSON implements json.Marshaler, so that {{json .}} shows all the
+// fields.
+func (c imageContext) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(c)
+}
+
+// ID returns the ID of image, it's truncated unless --no-trunc.
+func (c imageContext) ID() string {
+	return c.img.id
+}
+
+// Name returns the reference of image, like docker.io/library/busybox:latest.
+func (c imageContext) Name() string {
+	return c.img.name
+}
+
+// Repository returns the repository of image.
+func (c imageContext) Repository() string {
+	return c.img.repository
+}
+
+// Tag returns the tag of image, <none> if it's untagged.
+func (c imageContext) Tag() string {
+	return c.img.tag
+}
+
+// Digest returns the digest of image, <none> if it's not pulled by digest.
+func (c imageContext) Digest() string {
+	return c.img.digest
+}
+
+// Size returns the size of image.
+func (c imageContext) Size() string {
+	return c.img.size.String()
+}
+
+// CreatedAt returns the time when image is created.
+func (c imageContext) CreatedAt() string {
+	return c.img.createdAt
+}
+
 func imageInfoToDisplayImages(img types.ImageInfo, noTrunc bool) []displayImage {
 	dimgs := make([]displayImage, 0)
 
@@ -158,9 +247,12 @@ func imageInfoToDisplayImages(img types.ImageInfo, noTrunc bool) []displayImage
 	for name, tags := range nameTags {
 		for _, tag := range tags {
 			dimg := displayImage{
-				id:   imageDisplayID,
-				name: name + ":" + tag,
-				size: imageSize(img.Size),
+				id:         imageDisplayID,
+				name:       name + ":" + tag,
+				repository: name,
+				tag:        tag,
+				size:       imageSize(img.Size),
+				createdAt:  img.CreatedAt,
 			}
 
 			if dig, ok := digestIndexByName[name]; ok {
@@ -176,20 +268,26 @@ func imageInfoToDisplayImages(img types.ImageInfo, noTrunc bool) []displayImage
 	if len(dimgs) == 0 {
 		for name, dig := range digestIndexByName {
 			dimgs = append(dimgs, displayImage{
-				id:     imageDisplayID,
-				name:   name + "@" + dig.String(),
-				digest: dig.String(),
-				size:   imageSize(img.Size),
+				id:         imageDisplayID,
+				name:       name + "@" + dig.String(),
+				repository: name,
+				tag:        "<none>",
+				digest:     dig.String(),
+				size:       imageSize(img.Size),
+				createdAt:  img.CreatedAt,
 			})
 		}
 
 		// if there is no repo digests
 		if len(dimgs) == 0 {
 			dimgs = append(dimgs, displayImage{
-				id:     imageDisplayID,
-				name:   "<none>",
-				digest: "<none>",
-				size:   imageSize(img.Size),
+				id:         imageDisplayID,
+				name:       "<none>",
+				repository: "<none>",
+				tag:        "<none>",
+				digest:     "<none>",
+				size:       imageSize(img.Size),
+				createdAt:  img.CreatedAt,
 			})
 		}
 	}
@@ -208,6 +306,14 @@ IMAGE ID       IMAGE NAME                                           DIGEST
 2cb0d9787c4d   registry.hub.docker.com/library/hello-world:latest   sha256:4b8ff392a12ed9ea17784bd3c9a8b1fa3299cac44aca35a85c90c5e3c7afacdc   6.30 KB
 4ab4c602aa5e   registry.hub.docker.com/library/hello-world:linux    sha256:d5c7d767f5ba807f9b363aa4db87d75ab030404a670880e16aedff16f605484b   5.25 KB
 
+$ pouch images --format "table {{.Repository}}\t{{.Tag}}\t{{.Size}}"
+REPOSITORY                                    TAG      SIZE
+registry.hub.docker.com/library/hello-world   latest   6.30 KB
+registry.hub.docker.com/library/hello-world   linux    5.25 KB
+
+$ pouch images --format json
+{"CreatedAt":"2019-01-01T01:29:27.650294696Z","Digest":"<none>","ID":"2cb0d9787c4d","Name":"registry.hub.docker.com/library/hello-world:latest","Repository":"registry.hub.docker.com/library/hello-world","Size":"6.30 KB","Tag":"latest"}
+
 $ pouch images --no-trunc
 IMAGE ID                                                                  IMAGE NAME                                           SIZE
 sha256:2cb0d9787c4dd17ef9eb03e512923bc4db10add190d3f84af63b744e353a9b34   registry.hub.docker.com/library/hello-world:latest   6.30 KB
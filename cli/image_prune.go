@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// imagePruneDescription is used to describe image prune command in detail and auto generate command doc.
+var imagePruneDescription = "Remove the images which are not used by any container. " +
+	"Only the dangling images, which have no tag, are removed by default, " +
+	"and all the unused images are removed with -a."
+
+// ImagePruneCommand use to implement 'image prune' command.
+type ImagePruneCommand struct {
+	baseCommand
+
+	// flags for image prune command
+	flagAll    bool
+	flagFilter []string
+}
+
+// Init initialize image prune command.
+func (p *ImagePruneCommand) Init(c *Cli) {
+	p.cli = c
+	p.cmd = &cobra.Command{
+		Use:   "prune [OPTIONS]",
+		Short: "Remove unused images",
+		Long:  imagePruneDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runImagePrune(args)
+		},
+		Example: imagePruneExample(),
+	}
+
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *ImagePruneCommand) addFlags() {
+	flagSet := p.cmd.Flags()
+	flagSet.BoolVarP(&p.flagAll, "all", "a", false, "Remove all unused images, not just dangling ones")
+	flagSet.StringSliceVarP(&p.flagFilter, "filter", "f", []string{}, "Filter images based on conditions provided, filter support dangling, unused, until")
+}
+
+// runImagePrune is the entry of image prune command.
+func (p *ImagePruneCommand) runImagePrune(args []string) error {
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	filter, err := filters.FromFilterOpts(p.flagFilter)
+	if err != nil {
+		return err
+	}
+	if p.flagAll {
+		filter.Add("unused", "true")
+	}
+
+	resp, err := apiClient.ImagePrune(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to prune images: %v", err)
+	}
+
+	for _, id := range resp.ImagesDeleted {
+		fmt.Printf("deleted: %s\n", id)
+	}
+	fmt.Printf("Total reclaimed space: %s\n", utils.FormatSize(resp.SpaceReclaimed))
+	return nil
+}
+
+// imagePruneExample shows examples in image prune command, and is used in auto-generated cli docs.
+func imagePruneExample() string {
+	return `$ pouch image prune -a --filter until=24h
+deleted: sha256:8ac48589692a53a9b8c2d1ceaa6b402665aa7fe667ba51ccc03002300856d8c7
+Total reclaimed space: 708.80 KB`
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// importDescription is used to describe import command in detail and auto generate command doc.
+var importDescription = "Create an image from the tar archive of a filesystem, the image has only one layer. " +
+	"The tar archive may be compressed, and the owner and mode of files are kept in the image."
+
+// ImportCommand use to implement 'import' command.
+type ImportCommand struct {
+	baseCommand
+	message string
+}
+
+// Init initialize import command.
+func (i *ImportCommand) Init(c *Cli) {
+	i.cli = c
+	i.cmd = &cobra.Command{
+		Use:   "import [OPTIONS] FILE|- REPOSITORY[:TAG]",
+		Short: "Import the contents from a tar archive to create an image",
+		Long:  importDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return i.runImport(args)
+		},
+		Example: importExample(),
+	}
+	i.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (i *ImportCommand) addFlags() {
+	flagSet := i.cmd.Flags()
+	flagSet.StringVarP(&i.message, "message", "m", "", "Set commit message for imported image")
+}
+
+// runImport is the entry of import command.
+func (i *ImportCommand) runImport(args []string) error {
+	ctx := context.Background()
+	apiClient := i.cli.Client()
+
+	var in io.Reader = os.Stdin
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	image, err := apiClient.ImageImport(ctx, args[1], i.message, in)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, image.ID)
+	return nil
+}
+
+// importExample shows examples in import command, and is used in auto-generated cli docs.
+func importExample() string {
+	return `$ pouch import rootfs.tar foo:rootfs
+sha256:1c7e415c4ea5b3e3a5c13e0a3e38f5e4a0e0f1b1ad7e7d5ac6aa3d2d5a8b0c9e
+$ cat rootfs.tar.gz | pouch import -m "base rootfs" - foo:base
+sha256:5f3d2c9a1b7e3c80a6f4bd3c8d81e2b7c9e2e1e0d1c4ea5b3e3a5c13e0a3e38f`
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
 
@@ -69,6 +71,7 @@ func prettyPrintInfo(cli *Cli, info *types.SystemInfo) error {
 	fmt.Fprintf(os.Stdout, "Logging Driver: %s\n", info.LoggingDriver)
 	fmt.Fprintf(os.Stdout, "Volume Drivers: %v\n", info.VolumeDrivers)
 	fmt.Fprintf(os.Stdout, "Cgroup Driver: %s\n", info.CgroupDriver)
+	fmt.Fprintf(os.Stdout, "Cgroup Version: %s\n", info.CgroupVersion)
 	fmt.Fprintf(os.Stdout, "Default Runtime: %s\n", info.DefaultRuntime)
 	if len(info.Runtimes) > 0 {
 		fmt.Fprint(os.Stdout, "Runtimes:")
@@ -77,6 +80,7 @@ func prettyPrintInfo(cli *Cli, info *types.SystemInfo) error {
 		}
 		fmt.Fprint(os.Stdout, "\n")
 	}
+	printRuntimeStatus(info.RuntimeStatus)
 	fmt.Fprintf(os.Stdout, "runc: %v\n", info.RuncCommit)
 	fmt.Fprintf(os.Stdout, "containerd: %v\n", info.ContainerdCommit)
 
@@ -107,14 +111,18 @@ func prettyPrintInfo(cli *Cli, info *types.SystemInfo) error {
 	fmt.Fprintf(os.Stdout, "CriEnabled: %v\n", info.CriEnabled)
 	if info.RegistryConfig != nil && (len(info.RegistryConfig.InsecureRegistryCIDRs) > 0 || len(info.RegistryConfig.IndexConfigs) > 0) {
 		fmt.Fprintln(os.Stdout, "Insecure Registries:")
+		printed := map[string]bool{}
 		for _, registry := range info.RegistryConfig.IndexConfigs {
 			if !registry.Secure {
 				fmt.Fprintf(os.Stdout, " %s\n", registry.Name)
+				printed[registry.Name] = true
 			}
 		}
 
 		for _, registry := range info.RegistryConfig.InsecureRegistryCIDRs {
-			fmt.Fprintf(os.Stdout, " %s\n", registry)
+			if !printed[registry] {
+				fmt.Fprintf(os.Stdout, " %s\n", registry)
+			}
 		}
 	}
 
@@ -125,11 +133,91 @@ func prettyPrintInfo(cli *Cli, info *types.SystemInfo) error {
 		}
 	}
 
+	if info.RegistryConfig != nil {
+		printRegistryConfigs(info.RegistryConfig.IndexConfigs)
+	}
+
 	fmt.Fprintf(os.Stdout, "Daemon Listen Addresses: %v\n", info.ListenAddresses)
 
 	return nil
 }
 
+// printRegistryConfigs prints the mirrors and credential helpers of registries.
+func printRegistryConfigs(configs map[string]types.IndexInfo) {
+	var names []string
+	for name, registry := range configs {
+		if len(registry.Mirrors) > 0 || registry.CredentialHelper != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stdout, "Registry Configs:")
+	for _, name := range names {
+		registry := configs[name]
+		fmt.Fprintf(os.Stdout, " %s:\n", name)
+		if len(registry.Mirrors) > 0 {
+			fmt.Fprintf(os.Stdout, "  Mirrors: %s\n", strings.Join(registry.Mirrors, ", "))
+		}
+		if registry.CredentialHelper != "" {
+			fmt.Fprintf(os.Stdout, "  Credential Helper: %s\n", registry.CredentialHelper)
+		}
+	}
+}
+
+// printRuntimeStatus prints the status and capabilities of runtimes.
+func printRuntimeStatus(runtimes map[string]types.RuntimeStatus) {
+	if len(runtimes) == 0 {
+		return
+	}
+
+	var names []string
+	for name := range runtimes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stdout, "Runtime Status:")
+	for _, name := range names {
+		r := runtimes[name]
+		fmt.Fprintf(os.Stdout, " %s:\n", name)
+		fmt.Fprintf(os.Stdout, "  Type: %s\n", r.Type)
+		fmt.Fprintf(os.Stdout, "  Healthy: %v\n", r.Healthy)
+		if r.Error != "" {
+			fmt.Fprintf(os.Stdout, "  Error: %s\n", r.Error)
+		}
+		if r.ShimPath != "" {
+			fmt.Fprintf(os.Stdout, "  Shim: %s\n", binaryWithVersion(r.ShimPath, r.ShimVersion))
+		}
+		if r.RuntimePath != "" {
+			fmt.Fprintf(os.Stdout, "  Runtime: %s\n", binaryWithVersion(r.RuntimePath, r.RuntimeVersion))
+		}
+
+		var capabilities []string
+		if r.Checkpoint {
+			capabilities = append(capabilities, "checkpoint")
+		}
+		if r.Pause {
+			capabilities = append(capabilities, "pause")
+		}
+		fmt.Fprintf(os.Stdout, "  Capabilities: %s\n", strings.Join(capabilities, ", "))
+		if len(r.Update) != 0 {
+			fmt.Fprintf(os.Stdout, "  Updatable Resources: %s\n", strings.Join(r.Update, ", "))
+		}
+	}
+}
+
+// binaryWithVersion returns the path of binary with its version if any.
+func binaryWithVersion(path, version string) string {
+	if version == "" {
+		return path
+	}
+	return fmt.Sprintf("%s (%s)", path, version)
+}
+
 // infoExample shows examples in info command, and is used in auto-generated cli docs.
 func infoExample() string {
 	return `$ pouch info
@@ -145,6 +233,16 @@ Storage Driver:
 Driver Status: []
 Logging Driver:
 Cgroup Driver:
+Cgroup Version: 1
+Default Runtime: runc
+Runtimes: runc
+Runtime Status:
+ runc:
+  Type: io.containerd.runtime.v1.linux
+  Healthy: true
+  Shim: /usr/local/bin/containerd-shim
+  Runtime: /usr/local/bin/runc (runc version 1.0.0-rc8)
+  Capabilities: checkpoint, pause
 runc: <nil>
 containerd: <nil>
 Security Options: []
@@ -17,6 +17,7 @@ var inspectDescription = "Return detailed information on Pouch container"
 type InspectCommand struct {
 	baseCommand
 	format string
+	size   bool
 }
 
 // Init initializes InspectCommand command.
@@ -37,7 +38,8 @@ func (p *InspectCommand) Init(c *Cli) {
 
 // addFlags adds flags for specific command.
 func (p *InspectCommand) addFlags() {
-	p.cmd.Flags().StringVarP(&p.format, "format", "f", "", "Format the output using the given go template")
+	p.cmd.Flags().StringVarP(&p.format, "format", "f", "", "Format the output using the given go template, or json for the indented JSON")
+	p.cmd.Flags().BoolVarP(&p.size, "size", "s", false, "Display total file sizes")
 }
 
 // runInspect is the entry of InspectCommand command.
@@ -46,7 +48,11 @@ func (p *InspectCommand) runInspect(args []string) error {
 	apiClient := p.cli.Client()
 
 	getRefFunc := func(ref string) (interface{}, error) {
-		res, err := apiClient.ContainerGet(ctx, ref)
+		getContainer := apiClient.ContainerGet
+		if p.size {
+			getContainer = apiClient.ContainerGetWithSize
+		}
+		res, err := getContainer(ctx, ref)
 		if err != nil {
 			return nil, err
 		}
@@ -36,9 +36,10 @@ func NewTemplateInspector(out io.Writer, tmpl *template.Template) Inspector {
 	}
 }
 
-// NewTemplateInspectorFromString creates a new TemplateInspector from a string.
+// NewTemplateInspectorFromString creates a new TemplateInspector from a string,
+// the indented JSON is written if the string is empty or "json".
 func NewTemplateInspectorFromString(out io.Writer, tmplStr string) (Inspector, error) {
-	if tmplStr == "" {
+	if tmplStr == "" || tmplStr == "json" {
 		return NewIndentedInspector(out), nil
 	}
 	if strings.Contains(tmplStr, ".Id") {
@@ -77,6 +77,19 @@ func TestNewTemplateInspectorFromString(t *testing.T) {
 			wantOut: "",
 			wantErr: false,
 		},
+		{
+			name: "testJSONTmplStr",
+			args: args{
+				tmplStr: "json",
+			},
+			want: &IndentedInspector{
+				outputStream: &bytes.Buffer{},
+				elements:     nil,
+				rawElements:  nil,
+			},
+			wantOut: "",
+			wantErr: false,
+		},
 		{
 			name: "testCorrectTmplStr",
 			args: args{
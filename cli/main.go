@@ -17,6 +17,7 @@ func main() {
 	// Add all subcommands.
 	cli.AddCommand(base, &PullCommand{})
 	cli.AddCommand(base, &PushCommand{})
+	cli.AddCommand(base, &ManifestCommand{})
 	cli.AddCommand(base, &CreateCommand{})
 	cli.AddCommand(base, &StartCommand{})
 	cli.AddCommand(base, &StopCommand{})
@@ -26,14 +27,18 @@ func main() {
 	cli.AddCommand(base, &ExecCommand{})
 	cli.AddCommand(base, &VersionCommand{})
 	cli.AddCommand(base, &InfoCommand{})
+	cli.AddCommand(base, &SystemMgmtCommand{})
 	cli.AddCommand(base, &ImageMgmtCommand{})
 	cli.AddCommand(base, &ImagesCommand{})
 	cli.AddCommand(base, &RmiCommand{})
 	cli.AddCommand(base, &VolumeCommand{})
 	cli.AddCommand(base, &NetworkCommand{})
+	cli.AddCommand(base, &ComposeCommand{})
 	cli.AddCommand(base, &TagCommand{})
 	cli.AddCommand(base, &LoadCommand{})
 	cli.AddCommand(base, &SaveCommand{})
+	cli.AddCommand(base, &ImportCommand{})
+	cli.AddCommand(base, &ExportCommand{})
 	cli.AddCommand(base, &HistoryCommand{})
 	cli.AddCommand(base, &SearchCommand{})
 
@@ -47,6 +52,7 @@ func main() {
 	cli.AddCommand(base, &LogoutCommand{})
 	cli.AddCommand(base, &UpgradeCommand{})
 	cli.AddCommand(base, &TopCommand{})
+	cli.AddCommand(base, &DiffCommand{})
 	cli.AddCommand(base, &LogsCommand{})
 	cli.AddCommand(base, &RemountLxcfsCommand{})
 	cli.AddCommand(base, &WaitCommand{})
@@ -58,6 +64,7 @@ func main() {
 	cli.AddCommand(base, &BuildCommand{})
 	cli.AddCommand(base, &CopyCommand{})
 	cli.AddCommand(base, &PortCommand{})
+	cli.AddCommand(base, &MigrateCommand{})
 
 	// add generate doc command
 	cli.AddCommand(base, &GenDocCommand{})
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/spf13/cobra"
+)
+
+// manifestDescription is used to describe manifest command in detail and auto generate command doc.
+var manifestDescription = "\nManage manifest list commands, create manifest list of images for different platforms."
+
+// ManifestCommand use to implement 'manifest' command, it manages manifest lists.
+type ManifestCommand struct {
+	baseCommand
+}
+
+// Init initialize manifest command.
+func (m *ManifestCommand) Init(c *Cli) {
+	m.cli = c
+	m.cmd = &cobra.Command{
+		Use:   "manifest COMMAND",
+		Short: "Manage manifest list commands",
+		Long:  manifestDescription,
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	// add subcommands
+	c.AddCommand(m, &ManifestCreateCommand{})
+}
+
+// manifest subcommands
+
+// manifestCreateDescription is used to describe manifest create command in detail and auto generate command doc.
+var manifestCreateDescription = "Create a local manifest list from the local images of different platforms, " +
+	"the manifest list can be pushed to registry by push command as one image."
+
+// ManifestCreateCommand use to implement 'manifest create' command, it creates a manifest list.
+type ManifestCreateCommand struct {
+	ManifestCommand
+}
+
+// Init initialize manifest create command.
+func (mc *ManifestCreateCommand) Init(c *Cli) {
+	mc.cli = c
+	mc.cmd = &cobra.Command{
+		Use:   "create MANIFEST_LIST IMAGE [IMAGE...]",
+		Short: "create a local manifest list from local images",
+		Long:  manifestCreateDescription,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return mc.runManifestCreate(args)
+		},
+		Example: manifestCreateExample(),
+	}
+}
+
+// runManifestCreate is the entry of manifest create command.
+func (mc *ManifestCreateCommand) runManifestCreate(args []string) error {
+	ctx := context.Background()
+	apiClient := mc.cli.Client()
+
+	if err := apiClient.ManifestCreate(ctx, &types.ManifestCreateConfig{
+		Name:   args[0],
+		Images: args[1:],
+	}); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, args[0])
+	return nil
+}
+
+// manifestCreateExample shows examples in manifest create command, and is used in auto-generated cli docs.
+func manifestCreateExample() string {
+	return `$ pouch manifest create registry.hub.docker.com/library/busybox:1.28 registry.hub.docker.com/library/busybox:1.28-amd64 registry.hub.docker.com/library/busybox:1.28-arm64
+registry.hub.docker.com/library/busybox:1.28
+$ pouch push registry.hub.docker.com/library/busybox:1.28`
+}
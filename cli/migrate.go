@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/client"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/spf13/cobra"
+)
+
+// migrateDescription is used to describe migrate command in detail and auto generate command doc.
+var migrateDescription = "Migrate a running container to the pouchd on another host. " +
+	"The image and the container are prepared on the target host while the container keeps running, " +
+	"and the memory is pre-dumped and streamed to the target host iteratively if --pre-dump-iterations is specified. " +
+	"Then the container is checkpointed with only the memory changed since the last pre-dump and the changes of its rootfs, " +
+	"the checkpoint is streamed to the target host and the container is restored there. " +
+	"If restore fails on the target host, the container is restored on the source host again."
+
+// MigrateCommand use to implement 'migrate' command, it migrates a container to another host.
+type MigrateCommand struct {
+	baseCommand
+	cpDir          string
+	tcpEstablished bool
+	keep           bool
+	lazyPages      bool
+	pageServer     string
+	preDumps       int
+}
+
+// Init initialize migrate command.
+func (m *MigrateCommand) Init(c *Cli) {
+	m.cli = c
+	m.cmd = &cobra.Command{
+		Use:   "migrate [OPTIONS] CONTAINER TARGET_HOST",
+		Short: "Migrate a running container to another host",
+		Long:  migrateDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return m.runMigrate(args)
+		},
+		Example: migrateExample(),
+	}
+	m.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (m *MigrateCommand) addFlags() {
+	flagSet := m.cmd.Flags()
+	flagSet.StringVar(&m.cpDir, "checkpoint-dir", "", "Directory to store checkpoints images on both hosts")
+	flagSet.BoolVar(&m.tcpEstablished, "tcp-established", false, "Migrate established TCP connections")
+	flagSet.BoolVar(&m.keep, "keep", false, "Keep the stopped container on source host after migration")
+	flagSet.BoolVar(&m.lazyPages, "lazy-pages", false, "Migrate memory pages lazily after the container is restored on target host")
+	flagSet.StringVar(&m.pageServer, "page-server", "", "Address of page server on source host for lazy migration, format is host:port")
+	flagSet.IntVar(&m.preDumps, "pre-dump-iterations", 0, "Number of memory pre-dumps transferred while the container keeps running, each one only contains the pages changed since the previous one")
+}
+
+// runMigrate is the entry of migrate command.
+func (m *MigrateCommand) runMigrate(args []string) error {
+	ctx := context.Background()
+	srcClient := m.cli.Client()
+
+	name, target := args[0], args[1]
+	if m.lazyPages && m.pageServer == "" {
+		return fmt.Errorf("--page-server should be specified for lazy migration")
+	}
+	if m.preDumps < 0 {
+		return fmt.Errorf("--pre-dump-iterations %d cannot be negative", m.preDumps)
+	}
+
+	dstClient, err := client.NewAPIClient(target, m.cli.Option.TLS)
+	if err != nil {
+		return err
+	}
+
+	c, err := srcClient.ContainerGet(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !c.State.Running {
+		return fmt.Errorf("cannot migrate container %s: container is not running", name)
+	}
+
+	if _, err := dstClient.SystemPing(ctx); err != nil {
+		return fmt.Errorf("failed to connect target host %s: %v", target, err)
+	}
+
+	// pre-copy: prepare the image and the container on target host while
+	// the container is still running, so that the downtime only covers
+	// the transfer of the checkpoint.
+	if err := migrateImage(ctx, srcClient, dstClient, c.Config.Image); err != nil {
+		return fmt.Errorf("failed to migrate image %s: %v", c.Config.Image, err)
+	}
+
+	resp, err := dstClient.ContainerCreate(ctx, *c.Config, c.HostConfig, migrateNetworkingConfig(c.NetworkSettings), c.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create container on target host: %v", err)
+	}
+
+	// the checkpoints are created on source host in order, each one is the
+	// parent of the next one, and they are transferred in the same order.
+	var (
+		prefix      = fmt.Sprintf("migrate-%d", time.Now().UnixNano())
+		checkpoints []string
+		transferred []string
+	)
+	removeTarget := func() {
+		m.deleteCheckpoints(ctx, dstClient, resp.ID, transferred)
+		if err := dstClient.ContainerRemove(ctx, resp.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to remove container %s on target host: %v\n", resp.ID, err)
+		}
+	}
+
+	// pre-copy: dump the memory iteratively while the container keeps
+	// running, each pre-dump only contains the pages changed since the
+	// previous one, so that less pages are left to the final dump.
+	parent := ""
+	for i := 0; i < m.preDumps; i++ {
+		preDump := fmt.Sprintf("%s-pre%d", prefix, i)
+		if err := srcClient.ContainerCheckpointCreate(ctx, c.ID, types.CheckpointCreateOptions{
+			CheckpointID:     preDump,
+			CheckpointDir:    m.cpDir,
+			PreDump:          true,
+			ParentCheckpoint: parent,
+			TCPEstablished:   m.tcpEstablished,
+		}); err != nil {
+			removeTarget()
+			m.deleteCheckpoints(ctx, srcClient, c.ID, checkpoints)
+			return fmt.Errorf("failed to pre-dump container %s: %v", name, err)
+		}
+		checkpoints = append(checkpoints, preDump)
+
+		if err := m.transferCheckpoint(ctx, srcClient, dstClient, c.ID, resp.ID, preDump); err != nil {
+			removeTarget()
+			m.deleteCheckpoints(ctx, srcClient, c.ID, checkpoints)
+			return fmt.Errorf("failed to transfer pre-dump of container %s: %v", name, err)
+		}
+		transferred = append(transferred, preDump)
+		parent = preDump
+	}
+
+	// stop-and-copy: checkpoint the container with the changes of its
+	// rootfs, and restore it on target host.
+	checkpointID := prefix
+	if err := srcClient.ContainerCheckpointCreate(ctx, c.ID, types.CheckpointCreateOptions{
+		CheckpointID:     checkpointID,
+		CheckpointDir:    m.cpDir,
+		Exit:             true,
+		TCPEstablished:   m.tcpEstablished,
+		LazyPages:        m.lazyPages,
+		PageServer:       m.pageServer,
+		ParentCheckpoint: parent,
+		RootfsDiff:       c.HostConfig == nil || c.HostConfig.Rootfs == nil,
+	}); err != nil {
+		removeTarget()
+		m.deleteCheckpoints(ctx, srcClient, c.ID, checkpoints)
+		return fmt.Errorf("failed to checkpoint container %s: %v", name, err)
+	}
+	checkpoints = append(checkpoints, checkpointID)
+
+	err = m.transferCheckpoint(ctx, srcClient, dstClient, c.ID, resp.ID, checkpointID)
+	if err == nil {
+		transferred = append(transferred, checkpointID)
+		err = dstClient.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{
+			CheckpointID:   checkpointID,
+			CheckpointDir:  m.cpDir,
+			TCPEstablished: m.tcpEstablished,
+			LazyPages:      m.lazyPages,
+			PageServer:     m.pageServer,
+		})
+	}
+	if err != nil {
+		removeTarget()
+
+		// the memory pages of a lazy checkpoint are not dumped, so the
+		// container can not be restored on source host.
+		if m.lazyPages {
+			return fmt.Errorf("failed to migrate container %s lazily: %v", name, err)
+		}
+
+		// bring the container back on the source host, the checkpoints
+		// are kept if it fails so that it can be restored manually.
+		if rerr := srcClient.ContainerStart(ctx, c.ID, types.ContainerStartOptions{
+			CheckpointID:   checkpointID,
+			CheckpointDir:  m.cpDir,
+			TCPEstablished: m.tcpEstablished,
+		}); rerr != nil {
+			return fmt.Errorf("failed to migrate container %s: %v, and failed to restore it on source host: %v", name, err, rerr)
+		}
+		m.deleteCheckpoints(ctx, srcClient, c.ID, checkpoints)
+		return fmt.Errorf("failed to migrate container %s: %v", name, err)
+	}
+
+	// the checkpoints on target host are not needed after restored, except
+	// the lazy one whose memory pages are being pulled from source host.
+	if !m.lazyPages {
+		m.deleteCheckpoints(ctx, dstClient, resp.ID, transferred)
+	}
+
+	// the source task keeps serving memory pages until all of them are
+	// pulled by target host, then it exits.
+	if m.lazyPages {
+		if _, err := srcClient.ContainerWait(ctx, c.ID, ""); err != nil {
+			return fmt.Errorf("failed to wait for memory pages transferred: %v", err)
+		}
+	}
+
+	m.deleteCheckpoints(ctx, srcClient, c.ID, checkpoints)
+
+	if !m.keep {
+		if err := srcClient.ContainerRemove(ctx, c.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to remove container %s on source host: %v\n", name, err)
+		}
+	}
+
+	fmt.Fprintln(os.Stdout, resp.ID)
+	return nil
+}
+
+// transferCheckpoint streams the checkpoint from source host to target host.
+func (m *MigrateCommand) transferCheckpoint(ctx context.Context, srcClient, dstClient client.CommonAPIClient, srcID, dstID, checkpointID string) error {
+	r, err := srcClient.ContainerCheckpointExport(ctx, srcID, checkpointID, m.cpDir)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return dstClient.ContainerCheckpointImport(ctx, dstID, checkpointID, m.cpDir, r)
+}
+
+// deleteCheckpoints deletes the checkpoints of container in reverse order,
+// so that a checkpoint is deleted before its parent.
+func (m *MigrateCommand) deleteCheckpoints(ctx context.Context, apiClient client.CommonAPIClient, container string, checkpoints []string) {
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		if err := apiClient.ContainerCheckpointDelete(ctx, container, types.CheckpointDeleteOptions{
+			CheckpointID:  checkpoints[i],
+			CheckpointDir: m.cpDir,
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to delete checkpoint %s of container %s: %v\n", checkpoints[i], container, err)
+		}
+	}
+}
+
+// migrateImage transfers the image from source host to target host if
+// target host does not have it.
+func migrateImage(ctx context.Context, srcClient, dstClient client.CommonAPIClient, image string) error {
+	if _, err := dstClient.ImageInspect(ctx, image); err == nil {
+		return nil
+	}
+
+	namedRef, err := reference.Parse(image)
+	if err != nil {
+		return err
+	}
+
+	r, err := srcClient.ImageSave(ctx, image, "")
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// the oci tarstream only records the tag of image, so load it with
+	// the name of image.
+	return dstClient.ImageLoad(ctx, namedRef.Name(), r)
+}
+
+// migrateNetworkingConfig keeps the user specified endpoint settings, the
+// runtime information will be allocated by target host.
+func migrateNetworkingConfig(settings *types.NetworkSettings) *types.NetworkingConfig {
+	if settings == nil || len(settings.Networks) == 0 {
+		return nil
+	}
+
+	config := &types.NetworkingConfig{
+		EndpointsConfig: map[string]*types.EndpointSettings{},
+	}
+	for name, ep := range settings.Networks {
+		if ep == nil {
+			continue
+		}
+		config.EndpointsConfig[name] = &types.EndpointSettings{
+			Aliases:    ep.Aliases,
+			DriverOpts: ep.DriverOpts,
+			IPAMConfig: ep.IPAMConfig,
+			Links:      ep.Links,
+		}
+	}
+	return config
+}
+
+// migrateExample shows examples in migrate command, and is used in auto-generated cli docs.
+func migrateExample() string {
+	return `$ pouch migrate foo tcp://192.168.0.2:4243
+e05637b3b2e8e2f1d4b1b7b1ab6b3d6b7e8e0c1f3a2a8e0bbf9f5e3d2c1b0a99`
+}
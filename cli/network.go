@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 
@@ -47,7 +48,17 @@ func (n *NetworkCommand) Init(c *Cli) {
 
 // networkCreateDescription is used to describe network create command in detail and auto generate command doc.
 var networkCreateDescription = "Create a network in pouchd. " +
-	"It must specify network's name and driver. You can use 'network driver' to get drivers that pouch support."
+	"It must specify network's name and driver. You can use 'network driver' to get drivers that pouch support. " +
+	"The macvlan and ipvlan networks are bound to the parent interface specified by '-o parent', " +
+	"and the mode of them is specified by '-o macvlan_mode' or '-o ipvlan_mode'. " +
+	"The overlay network connects the containers on the hosts sharing the cluster store of pouchd by VXLAN, " +
+	"and the VXLAN IDs are specified by '-o com.docker.network.driver.overlay.vxlanid_list'. " +
+	"The hostdev network moves the VFs of the SR-IOV physical function specified by '-o parent' into containers, " +
+	"or the NICs of host specified by the endpoint option 'hostdev.device' if the parent is omitted. " +
+	"The traffic between containers on a bridge network is filtered by '--policy' rules in order, " +
+	"the rule is in the form of '<allow|deny>[,from=<selector>][,to=<selector>]', " +
+	"where the selector is a CIDR or a container label 'key' or 'key=value', and any container matches if it's omitted. " +
+	"The traffic matching no rule is allowed unless '--disable-icc' is set."
 
 // NetworkCreateCommand is used to implement 'network create' command.
 type NetworkCreateCommand struct {
@@ -55,14 +66,16 @@ type NetworkCreateCommand struct {
 
 	name       string
 	driver     string
-	gateway    string
-	ipRange    string
+	gateways   []string
+	ipRanges   []string
 	ipamDriver string
 	ipamOpts   []string
-	subnet     string
+	subnets    []string
 	enableIPv6 bool
 	options    []string
 	labels     []string
+	disableICC bool
+	policies   []string
 }
 
 // Init initializes NetworkCreateCommand command.
@@ -89,14 +102,16 @@ func (n *NetworkCreateCommand) addFlags() {
 
 	flagSet.StringVarP(&n.name, "name", "n", "", "the name of network")
 	flagSet.StringVarP(&n.driver, "driver", "d", "bridge", "the driver of network")
-	flagSet.StringVar(&n.gateway, "gateway", "", "the gateway of network")
-	flagSet.StringVar(&n.ipRange, "ip-range", "", "the range of network's ip")
-	flagSet.StringVar(&n.subnet, "subnet", "", "the subnet of network")
+	flagSet.StringSliceVar(&n.gateways, "gateway", nil, "the gateway of network, one for each subnet")
+	flagSet.StringSliceVar(&n.ipRanges, "ip-range", nil, "the range of network's ip, one for each subnet")
+	flagSet.StringSliceVar(&n.subnets, "subnet", nil, "the subnet of network, both IPv4 and IPv6 subnets can be specified")
 	flagSet.StringVar(&n.ipamDriver, "ipam-driver", "default", "the ipam driver of network")
 	flagSet.StringSliceVarP(&n.ipamOpts, "ipam-opt", "", nil, "the ipam driver options of network")
 	flagSet.BoolVar(&n.enableIPv6, "enable-ipv6", false, "enable ipv6 network")
 	flagSet.StringSliceVarP(&n.options, "option", "o", nil, "create network with options")
 	flagSet.StringSliceVarP(&n.labels, "label", "l", nil, "create network with labels")
+	flagSet.BoolVar(&n.disableICC, "disable-icc", false, "disable the communication between containers on the bridge network unless allowed by policy")
+	flagSet.StringArrayVar(&n.policies, "policy", nil, "add policy rule of traffic between containers on the bridge network")
 }
 
 // runNetworkCreate is the entry of NetworkCreateCommand command.
@@ -149,20 +164,15 @@ func (n *NetworkCreateCommand) buildNetworkCreateRequest(name string) (*types.Ne
 		return nil, err
 	}
 
+	ipamConfigs, err := parseIPAMConfigs(n.subnets, n.gateways, n.ipRanges)
+	if err != nil {
+		return nil, err
+	}
+
 	ipam := &types.IPAM{
 		Driver:  n.ipamDriver,
 		Options: ipamOptions,
-		Config:  []types.IPAMConfig{},
-	}
-
-	if n.subnet != "" || n.gateway != "" || n.ipRange != "" {
-		ipamConfig := types.IPAMConfig{
-			AuxAddress: make(map[string]string),
-			Subnet:     n.subnet,
-			Gateway:    n.gateway,
-			IPRange:    n.ipRange,
-		}
-		ipam.Config = append(ipam.Config, ipamConfig)
+		Config:  ipamConfigs,
 	}
 
 	networkCreate := types.NetworkCreate{
@@ -174,6 +184,18 @@ func (n *NetworkCreateCommand) buildNetworkCreateRequest(name string) (*types.Ne
 		Labels:         labels,
 		IPAM:           ipam,
 	}
+
+	if n.disableICC || len(n.policies) > 0 {
+		policy := &types.NetworkPolicy{DisableICC: n.disableICC}
+		for _, p := range n.policies {
+			rule, err := parseNetworkPolicyRule(p)
+			if err != nil {
+				return nil, err
+			}
+			policy.Rules = append(policy.Rules, rule)
+		}
+		networkCreate.Policy = policy
+	}
 	networkRequest := &types.NetworkCreateConfig{
 		Name:          name,
 		NetworkCreate: networkCreate,
@@ -182,6 +204,92 @@ func (n *NetworkCreateCommand) buildNetworkCreateRequest(name string) (*types.Ne
 	return networkRequest, nil
 }
 
+// parseIPAMConfigs builds the IPAM config of each subnet, the gateways and
+// ip ranges belong to the subnets which contain them.
+func parseIPAMConfigs(subnets, gateways, ipRanges []string) ([]types.IPAMConfig, error) {
+	configs := make([]types.IPAMConfig, 0, len(subnets))
+	nets := make([]*net.IPNet, 0, len(subnets))
+	for _, subnet := range subnets {
+		_, ipNet, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet %s: %v", subnet, err)
+		}
+		nets = append(nets, ipNet)
+		configs = append(configs, types.IPAMConfig{
+			AuxAddress: make(map[string]string),
+			Subnet:     subnet,
+		})
+	}
+
+	// subnetOf returns the index of subnet which contains ip.
+	subnetOf := func(ip net.IP) int {
+		for i, ipNet := range nets {
+			if ipNet.Contains(ip) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, ipRange := range ipRanges {
+		ip, _, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip range %s: %v", ipRange, err)
+		}
+		i := subnetOf(ip)
+		if i < 0 {
+			return nil, fmt.Errorf("no subnet contains ip range %s", ipRange)
+		}
+		if configs[i].IPRange != "" {
+			return nil, fmt.Errorf("cannot set multiple ip ranges in subnet %s", configs[i].Subnet)
+		}
+		configs[i].IPRange = ipRange
+	}
+
+	for _, gateway := range gateways {
+		ip := net.ParseIP(gateway)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid gateway %s", gateway)
+		}
+		i := subnetOf(ip)
+		if i < 0 {
+			return nil, fmt.Errorf("no subnet contains gateway %s", gateway)
+		}
+		if configs[i].Gateway != "" {
+			return nil, fmt.Errorf("cannot set multiple gateways in subnet %s", configs[i].Subnet)
+		}
+		configs[i].Gateway = gateway
+	}
+
+	return configs, nil
+}
+
+// parseNetworkPolicyRule parses the policy rule in the form of
+// `<allow|deny>[,from=<selector>][,to=<selector>]`.
+func parseNetworkPolicyRule(s string) (types.NetworkPolicyRule, error) {
+	fields := strings.Split(s, ",")
+	rule := types.NetworkPolicyRule{Action: fields[0]}
+	if rule.Action != types.NetworkPolicyRuleActionAllow && rule.Action != types.NetworkPolicyRuleActionDeny {
+		return rule, fmt.Errorf("invalid policy %s: action should be allow or deny", s)
+	}
+
+	for _, field := range fields[1:] {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return rule, fmt.Errorf("invalid policy %s: invalid field %s", s, field)
+		}
+		switch kv[0] {
+		case "from":
+			rule.From = kv[1]
+		case "to":
+			rule.To = kv[1]
+		default:
+			return rule, fmt.Errorf("invalid policy %s: unknown field %s", s, kv[0])
+		}
+	}
+	return rule, nil
+}
+
 func parseSliceToMap(slices []string) (map[string]string, error) {
 	maps := map[string]string{}
 
@@ -198,7 +306,15 @@ func parseSliceToMap(slices []string) (map[string]string, error) {
 // networkCreateExample shows examples in network create command, and is used in auto-generated cli docs.
 func networkCreateExample() string {
 	return `$ pouch network create -n pouchnet -d bridge --gateway 192.168.1.1 --subnet 192.168.1.0/24
-pouchnet: e1d541722d68dc5d133cca9e7bd8fd9338603e1763096c8e853522b60d11f7b9`
+pouchnet: e1d541722d68dc5d133cca9e7bd8fd9338603e1763096c8e853522b60d11f7b9
+$ pouch network create --enable-ipv6 --subnet 192.168.2.0/24 --subnet fd00:2::/64 pouchnet6
+pouchnet6: 9f8d0e3bb5b2e8f6b2c0a0c31f2c1c4d7e0b5a8c6d3e2f1a0b9c8d7e6f5a4b3c
+$ pouch network create -d macvlan --subnet 10.0.0.0/24 --gateway 10.0.0.1 -o parent=eth0 macnet
+macnet: 5b7c2e8a96e1c2c2cc7a8d0b3e2b3cfa30c8a7f1b8e3d2b0c7c1d6d3a8e9f0a1
+$ pouch network create -d overlay --subnet 10.10.0.0/16 overlaynet
+overlaynet: 8c4e1f0a7d2b9e3c6a5f4d1b0e9c8a7f6d5e4c3b2a1f0e9d8c7b6a5f4e3d2c1b
+$ pouch network create --disable-icc --policy allow,from=tenant=a,to=tenant=a --policy allow,to=10.0.0.0/8 tenantnet
+tenantnet: 3a6f9b1e2d4c8a7b5e0f1d2c3b4a5968778695a4b3c2d1e0f9a8b7c6d5e4f3a2`
 }
 
 // networkRemoveDescription is used to describe network remove command in detail and auto generate command doc.
@@ -284,7 +400,7 @@ func (n *NetworkInspectCommand) Init(c *Cli) {
 // addFlags adds flags for specific command.
 func (n *NetworkInspectCommand) addFlags() {
 	//TODO add flags
-	n.cmd.Flags().StringVarP(&n.format, "format", "f", "", "Format the output using the given go template")
+	n.cmd.Flags().StringVarP(&n.format, "format", "f", "", "Format the output using the given go template, or json for the indented JSON")
 }
 
 // runNetworkInspect is the entry of NetworkInspectCommand command.
@@ -381,7 +497,9 @@ d8684bf988   host     host     local
 
 // networkConnectDescription is used to describe network connect command in detail and auto generate command doc.
 var networkConnectDescription = "Connect a container to a network in pouchd. " +
-	"It must specify network's name and container's name."
+	"It must specify network's name and container's name. " +
+	"The options of endpoint are passed to the network driver by '--driver-opt', " +
+	"such as 'hostdev.device', 'hostdev.vf', 'hostdev.vlan', 'hostdev.mac' and 'hostdev.trust' of the hostdev network."
 
 // NetworkConnectCommand is used to implement 'network connect' command.
 type NetworkConnectCommand struct {
@@ -392,6 +510,7 @@ type NetworkConnectCommand struct {
 	links        []string
 	aliases      []string
 	linklocalips []string
+	driverOpts   []string
 }
 
 // Init initializes NetworkConnectCommand command.
@@ -421,6 +540,7 @@ func (n *NetworkConnectCommand) addFlags() {
 	flagSet.StringSliceVar(&n.links, "link", []string{}, "Add link to another container")
 	flagSet.StringSliceVar(&n.aliases, "alias", []string{}, "Add network-scoped alias for the container")
 	flagSet.StringSliceVar(&n.linklocalips, "link-local-ip", []string{}, "Add a link-local address for the container")
+	flagSet.StringSliceVar(&n.driverOpts, "driver-opt", nil, "Add driver option of the endpoint")
 }
 
 // runNetworkConnect is the entry of NetworkConnectCommand command.
@@ -434,6 +554,11 @@ func (n *NetworkConnectCommand) runNetworkConnect(args []string) error {
 		return fmt.Errorf("container name cannot be empty")
 	}
 
+	driverOpts, err := parseSliceToMap(n.driverOpts)
+	if err != nil {
+		return err
+	}
+
 	networkReq := &types.NetworkConnect{
 		Container: container,
 		EndpointConfig: &types.EndpointSettings{
@@ -442,15 +567,15 @@ func (n *NetworkConnectCommand) runNetworkConnect(args []string) error {
 				IPV6Address:  n.ipv6Address,
 				LinkLocalIps: n.linklocalips,
 			},
-			Links:   n.links,
-			Aliases: n.aliases,
+			Links:      n.links,
+			Aliases:    n.aliases,
+			DriverOpts: driverOpts,
 		},
 	}
 
 	ctx := context.Background()
 	apiClient := n.cli.Client()
-	err := apiClient.NetworkConnect(ctx, network, networkReq)
-	if err != nil {
+	if err := apiClient.NetworkConnect(ctx, network, networkReq); err != nil {
 		return err
 	}
 	fmt.Printf("container %s is connected to network %s\n", container, network)
@@ -461,7 +586,9 @@ func (n *NetworkConnectCommand) runNetworkConnect(args []string) error {
 // networkConnectExample shows examples in network connect command, and is used in auto-generated cli docs.
 func networkConnectExample() string {
 	return `$ pouch network connect net1 container1
-container container1 is connected to network net1`
+container container1 is connected to network net1
+$ pouch network connect --driver-opt hostdev.vlan=100 --driver-opt hostdev.trust=true sriov container1
+container container1 is connected to network sriov`
 }
 
 // networkDisconnectDescription is used to describe network disconnect command in detail and auto generate comand doc.
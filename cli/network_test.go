@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseIPAMConfigs(t *testing.T) {
+	configs, err := parseIPAMConfigs(
+		[]string{"192.168.2.0/24", "fd00:2::/64"},
+		[]string{"fd00:2::1", "192.168.2.1"},
+		[]string{"192.168.2.128/25"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.IPAMConfig{
+		{AuxAddress: map[string]string{}, Subnet: "192.168.2.0/24", Gateway: "192.168.2.1", IPRange: "192.168.2.128/25"},
+		{AuxAddress: map[string]string{}, Subnet: "fd00:2::/64", Gateway: "fd00:2::1"},
+	}, configs)
+
+	configs, err = parseIPAMConfigs(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, configs)
+
+	for _, tc := range []struct {
+		subnets, gateways, ipRanges []string
+	}{
+		{subnets: []string{"192.168.2.0"}},
+		{gateways: []string{"192.168.2.1"}},
+		{subnets: []string{"192.168.2.0/24"}, gateways: []string{"192.168.3.1"}},
+		{subnets: []string{"192.168.2.0/24"}, gateways: []string{"192.168.2.1", "192.168.2.254"}},
+		{subnets: []string{"192.168.2.0/24"}, ipRanges: []string{"192.168.3.0/25"}},
+		{subnets: []string{"192.168.2.0/24"}, ipRanges: []string{"192.168.2.0/25", "192.168.2.128/25"}},
+	} {
+		_, err := parseIPAMConfigs(tc.subnets, tc.gateways, tc.ipRanges)
+		assert.Error(t, err, "%v", tc)
+	}
+}
+
+func Test_parseNetworkPolicyRule(t *testing.T) {
+	rule, err := parseNetworkPolicyRule("allow,from=tenant=a,to=10.0.0.0/8")
+	assert.NoError(t, err)
+	assert.Equal(t, types.NetworkPolicyRule{Action: "allow", From: "tenant=a", To: "10.0.0.0/8"}, rule)
+
+	rule, err = parseNetworkPolicyRule("deny")
+	assert.NoError(t, err)
+	assert.Equal(t, types.NetworkPolicyRule{Action: "deny"}, rule)
+
+	for _, s := range []string{"", "accept", "allow,from", "allow,from=", "allow,via=app"} {
+		_, err := parseNetworkPolicyRule(s)
+		assert.Error(t, err, s)
+	}
+}
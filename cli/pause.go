@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/alibaba/pouch/pkg/utils/filters"
+
 	"github.com/spf13/cobra"
 )
 
@@ -18,28 +20,58 @@ var pauseDescription = "Pause one or more running containers in Pouchd. " +
 // PauseCommand use to implement 'pause' command, it pauses one or more containers.
 type PauseCommand struct {
 	baseCommand
+	flagFilter []string
+	group      bool
 }
 
 // Init initialize pause command.
 func (p *PauseCommand) Init(c *Cli) {
 	p.cli = c
 	p.cmd = &cobra.Command{
-		Use:   "pause CONTAINER [CONTAINER...]",
+		Use:   "pause [OPTIONS] CONTAINER [CONTAINER...]",
 		Short: "Pause one or more running containers",
 		Long:  pauseDescription,
-		Args:  cobra.MinimumNArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return p.runPause(args)
 		},
 		Example: pauseExample(),
 	}
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PauseCommand) addFlags() {
+	flagSet := p.cmd.Flags()
+	flagSet.StringSliceVarP(&p.flagFilter, "filter", "f", nil, "Pause the containers matching the given conditions as a group, support filter key [ id label name status ]")
+	flagSet.BoolVar(&p.group, "group", false, "Pause the containers as a group, none of them is paused if any fails")
 }
 
 // runPause is the entry of pause command.
 func (p *PauseCommand) runPause(args []string) error {
+	if len(args) == 0 && len(p.flagFilter) == 0 {
+		return fmt.Errorf("requires at least 1 container or --filter")
+	}
+
 	ctx := context.Background()
 	apiClient := p.cli.Client()
 
+	if p.group || len(p.flagFilter) > 0 {
+		filter, err := filters.Parse(p.flagFilter)
+		if err != nil {
+			return err
+		}
+
+		ids, err := apiClient.ContainerPauseGroup(ctx, args, filter)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			fmt.Printf("%s\n", id)
+		}
+		return nil
+	}
+
 	var errs []string
 	for _, name := range args {
 		if err := apiClient.ContainerPause(ctx, name); err != nil {
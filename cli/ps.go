@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/cli/formatter"
 	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/pkg/utils/filters"
 
@@ -27,7 +30,9 @@ type PsCommand struct {
 	flagAll     bool
 	flagQuiet   bool
 	flagNoTrunc bool
+	flagSize    bool
 	flagFilter  []string
+	flagFormat  string
 }
 
 // Init initializes PsCommand command.
@@ -52,7 +57,9 @@ func (p *PsCommand) addFlags() {
 	flagSet.BoolVarP(&p.flagAll, "all", "a", false, "Show all containers (default shows just running)")
 	flagSet.BoolVarP(&p.flagQuiet, "quiet", "q", false, "Only show numeric IDs")
 	flagSet.BoolVar(&p.flagNoTrunc, "no-trunc", false, "Do not truncate output")
+	flagSet.BoolVarP(&p.flagSize, "size", "s", false, "Display total file sizes")
 	flagSet.StringSliceVarP(&p.flagFilter, "filter", "f", nil, "Filter output based on given conditions, support filter key [ id label name status ]")
+	flagSet.StringVar(&p.flagFormat, "format", "", "Format the output using the given go template, json, or table with the template")
 }
 
 // runPs is the entry of PsCommand command.
@@ -70,6 +77,7 @@ func (p *PsCommand) runPs(args []string) error {
 	option := types.ContainerListOptions{
 		All:    p.flagAll,
 		Filter: filter,
+		Size:   p.flagSize,
 	}
 	containers, err = apiClient.ContainerList(ctx, option)
 	if err != nil {
@@ -89,8 +97,16 @@ func (p *PsCommand) runPs(args []string) error {
 		return nil
 	}
 
+	if p.flagFormat != "" {
+		return p.formatContainers(containers)
+	}
+
 	display := p.cli.NewTableDisplay()
-	display.AddRow([]string{"Name", "ID", "Status", "Created", "Image", "Runtime"})
+	header := []string{"Name", "ID", "Status", "Created", "Image", "Runtime"}
+	if p.flagSize {
+		header = append(header, "Size")
+	}
+	display.AddRow(header)
 
 	for _, c := range containers {
 		created, err := utils.FormatTimeInterval(c.Created, 0)
@@ -103,12 +119,173 @@ func (p *PsCommand) runPs(args []string) error {
 			id = c.ID
 		}
 
-		display.AddRow([]string{c.Names[0], id, c.Status, created + " ago", c.Image, c.HostConfig.Runtime})
+		row := []string{c.Names[0], id, c.Status, created + " ago", c.Image, c.HostConfig.Runtime}
+		if p.flagSize {
+			row = append(row, fmt.Sprintf("%s (virtual %s)", utils.FormatSize(c.SizeRw), utils.FormatSize(c.SizeRootFs)))
+		}
+		display.AddRow(row)
 	}
 	display.Flush()
 	return nil
 }
 
+// formatContainers writes the containers in the format of --format.
+func (p *PsCommand) formatContainers(containers containerList) error {
+	defaultTable := "{{.Names}}\t{{.ID}}\t{{.Status}}\t{{.RunningFor}}\t{{.Image}}\t{{.Runtime}}"
+	if p.flagSize {
+		defaultTable += "\t{{.Size}}"
+	}
+
+	elements := make([]interface{}, 0, len(containers))
+	for _, c := range containers {
+		elements = append(elements, containerContext{c: c, noTrunc: p.flagNoTrunc})
+	}
+
+	ctx := &formatter.Context{
+		Output:       os.Stdout,
+		Format:       formatter.Format(p.flagFormat),
+		DefaultTable: defaultTable,
+		Header:       containerFormatHeader,
+	}
+	return ctx.Write(elements)
+}
+
+// containerFormatHeader is the headers of fields of containerContext.
+var containerFormatHeader = map[string]string{
+	"ID":         "ID",
+	"Names":      "Name",
+	"Image":      "Image",
+	"ImageID":    "Image ID",
+	"Command":    "Command",
+	"CreatedAt":  "Created At",
+	"RunningFor": "Created",
+	"Status":     "Status",
+	"State":      "State",
+	"Runtime":    "Runtime",
+	"Size":       "Size",
+	"Labels":     "Labels",
+	"Mounts":     "Mounts",
+	"Networks":   "Networks",
+}
+
+// containerContext is the container rendered by the template of --format.
+type containerContext struct {
+	c       *types.Container
+	noTrunc bool
+}
+
+// MarshalJSON implements json.Marshaler, so that {{json .}} shows all the
+// fields.
+func (c containerContext) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(c)
+}
+
+// ID returns the ID of container, it's truncated unless --no-trunc.
+func (c containerContext) ID() string {
+	if c.noTrunc {
+		return c.c.ID
+	}
+	return c.c.ID[:6]
+}
+
+// Names returns the names of container.
+func (c containerContext) Names() string {
+	return strings.Join(c.c.Names, ",")
+}
+
+// Image returns the image of container.
+func (c containerContext) Image() string {
+	return c.c.Image
+}
+
+// ImageID returns the ID of image of container.
+func (c containerContext) ImageID() string {
+	return c.c.ImageID
+}
+
+// Command returns the command of container.
+func (c containerContext) Command() string {
+	return c.c.Command
+}
+
+// CreatedAt returns the time when container is created.
+func (c containerContext) CreatedAt() string {
+	return time.Unix(c.c.Created, 0).String()
+}
+
+// RunningFor returns the time elapsed since container is created.
+func (c containerContext) RunningFor() string {
+	created, err := utils.FormatTimeInterval(c.c.Created, 0)
+	if err != nil {
+		return ""
+	}
+	return created + " ago"
+}
+
+// Status returns the status of container, like "Up 15 minutes".
+func (c containerContext) Status() string {
+	return c.c.Status
+}
+
+// State returns the state of container, like "running".
+func (c containerContext) State() string {
+	return c.c.State
+}
+
+// Runtime returns the runtime of container.
+func (c containerContext) Runtime() string {
+	if c.c.HostConfig == nil {
+		return ""
+	}
+	return c.c.HostConfig.Runtime
+}
+
+// Size returns the size of container, which is only set with --size.
+func (c containerContext) Size() string {
+	return fmt.Sprintf("%s (virtual %s)", utils.FormatSize(c.c.SizeRw), utils.FormatSize(c.c.SizeRootFs))
+}
+
+// Labels returns the labels of container in the form of key=value.
+func (c containerContext) Labels() string {
+	labels := make([]string, 0, len(c.c.Labels))
+	for k, v := range c.c.Labels {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ",")
+}
+
+// Label returns the value of label, like {{.Label "com.example.owner"}}.
+func (c containerContext) Label(name string) string {
+	return c.c.Labels[name]
+}
+
+// Mounts returns the volume names or source paths of mounts.
+func (c containerContext) Mounts() string {
+	mounts := make([]string, 0, len(c.c.Mounts))
+	for _, m := range c.c.Mounts {
+		if m.Name != "" {
+			mounts = append(mounts, m.Name)
+		} else {
+			mounts = append(mounts, m.Source)
+		}
+	}
+	return strings.Join(mounts, ",")
+}
+
+// Networks returns the names of networks which container is connected to.
+func (c containerContext) Networks() string {
+	if c.c.NetworkSettings == nil {
+		return ""
+	}
+	networks := make([]string, 0, len(c.c.NetworkSettings.Networks))
+	for name := range c.c.NetworkSettings.Networks {
+		networks = append(networks, name)
+	}
+	sort.Strings(networks)
+	return strings.Join(networks, ",")
+}
+
 // psExample shows examples in ps command, and is used in auto-generated cli docs.
 func psExample() string {
 	return `$ pouch ps
@@ -136,6 +313,15 @@ Name   ID                                                                 Status
 foo2   692c77587b38f60bbd91d986ec3703848d72aea5030e320d4988eb02aa3f9d48   Up 1 minute   1 minute ago   docker.io/library/redis:alpine   runc
 foo    18592900006405ee64788bd108ef1de3d24dc3add73725891f4787d0f8e036f5   Up 1 minute   1 minute ago   docker.io/library/redis:alpine   runc
 
+$ pouch ps --format "{{.Names}}: {{.Label \"app\"}}"
+2: web
+1: db
+
+$ pouch ps --format "table {{.ID}}\t{{.Names}}\t{{.Networks}}"
+ID       Name   Networks
+e42c68   2      bridge
+a8c2ea   1      bridge
+
 $ pouch ps --no-trunc -q
 692c77587b38f60bbd91d986ec3703848d72aea5030e320d4988eb02aa3f9d48
 18592900006405ee64788bd108ef1de3d24dc3add73725891f4787d0f8e036f5
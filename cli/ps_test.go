@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerContext(t *testing.T) {
+	c := &types.Container{
+		ID:         "e42c68a8c2ea0b1c",
+		Names:      []string{"web"},
+		Image:      "docker.io/library/nginx:alpine",
+		State:      "running",
+		Status:     "Up 15 minutes",
+		Labels:     map[string]string{"tier": "frontend", "app": "shop"},
+		HostConfig: &types.HostConfig{Runtime: "runc"},
+		Mounts:     []types.MountPoint{{Name: "data"}, {Source: "/var/log"}},
+		NetworkSettings: &types.ContainerNetworkSettings{
+			Networks: map[string]*types.EndpointSettings{"front": {}, "back": {}},
+		},
+	}
+
+	ctx := containerContext{c: c}
+	assert.Equal(t, "e42c68", ctx.ID())
+	assert.Equal(t, "app=shop,tier=frontend", ctx.Labels())
+	assert.Equal(t, "shop", ctx.Label("app"))
+	assert.Equal(t, "data,/var/log", ctx.Mounts())
+	assert.Equal(t, "back,front", ctx.Networks())
+
+	ctx.noTrunc = true
+	assert.Equal(t, "e42c68a8c2ea0b1c", ctx.ID())
+
+	// all the fields without arguments are marshaled.
+	b, err := json.Marshal(ctx)
+	assert.NoError(t, err)
+
+	fields := map[string]string{}
+	assert.NoError(t, json.Unmarshal(b, &fields))
+	for field := range containerFormatHeader {
+		assert.Contains(t, fields, field)
+	}
+	assert.Equal(t, "web", fields["Names"])
+	assert.Equal(t, "runc", fields["Runtime"])
+	assert.NotContains(t, fields, "Label")
+}
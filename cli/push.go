@@ -10,7 +10,8 @@ import (
 )
 
 // pushDescription is used to describe push command in detail and auto generate command doc.
-var pushDescription = "Push a local image to remote registry."
+var pushDescription = "Push a local image or manifest list to remote registry, " +
+	"the upload of each blob and manifest is retried on the transient failure."
 
 // PushCommand is used to implement 'push' command, it pushes image to some registries.
 type PushCommand struct {
@@ -34,7 +34,7 @@ func (rc *RunCommand) Init(c *Cli) {
 		Use:   "run [OPTIONS] IMAGE [ARG...]",
 		Short: "Create a new container and start it",
 		Long:  runDescription,
-		Args:  cobra.MinimumNArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return rc.runRun(args)
 		},
@@ -71,18 +71,32 @@ func (rc *RunCommand) runRun(args []string) error {
 	if err != nil {
 		return nil
 	}
-	config.Image = args[0]
-	if len(args) > 1 {
-		config.Cmd = args[1:]
+	image, cmd, err := rc.imageAndCmd(args)
+	if err != nil {
+		return err
+	}
+	config.Image = image
+	if len(cmd) > 0 {
+		config.Cmd = cmd
 	}
 	containerName := rc.name
 	config.ContainerConfig.OpenStdin = rc.stdin
+	// the default of daemon is used unless init is set explicitly.
+	if rc.cmd.Flags().Changed("init") {
+		config.HostConfig.Init = &rc.init
+	}
+
+	// the detached container is removed by daemon after it exits, the
+	// attached one is removed here after the exit code is got.
+	config.HostConfig.AutoRemove = rc.rm && rc.detach
 
 	ctx := context.Background()
 	apiClient := rc.cli.Client()
 
-	if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
-		return err
+	if config.Image != "" {
+		if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
+			return err
+		}
 	}
 
 	result, err := apiClient.ContainerCreate(ctx, config.ContainerConfig, config.HostConfig, config.NetworkingConfig, containerName)
@@ -101,9 +115,6 @@ func (rc *RunCommand) runRun(args []string) error {
 	if (rc.attach || rc.stdin) && rc.detach {
 		return fmt.Errorf("Conflicting options: -a (or -i) and -d")
 	}
-	if rc.rm && rc.detach {
-		return fmt.Errorf("Conflicting options: --rm and -d")
-	}
 
 	// default attach container's stdout and stderr
 	if !rc.detach {
@@ -129,14 +140,14 @@ func (rc *RunCommand) runRun(args []string) error {
 			}()
 		}
 
-		conn, br, err := apiClient.ContainerAttach(ctx, containerName, rc.stdin)
+		conn, br, err := apiClient.ContainerAttach(ctx, containerName, rc.stdin, rc.detachKeys)
 		if err != nil {
 			return fmt.Errorf("failed to attach container: %v", err)
 		}
 		defer conn.Close()
 
 		go func() {
-			io.Copy(os.Stdout, br)
+			copyAttachStream(rc.tty, br)
 			wait <- struct{}{}
 		}()
 		go func() {
@@ -160,6 +171,11 @@ func (rc *RunCommand) runRun(args []string) error {
 		<-wait
 	} else {
 		fmt.Fprintf(os.Stdout, "%s\n", result.ID)
+
+		// the container may have been removed by daemon.
+		if rc.rm {
+			return nil
+		}
 	}
 
 	info, err := apiClient.ContainerGet(ctx, containerName)
@@ -168,7 +184,7 @@ func (rc *RunCommand) runRun(args []string) error {
 	}
 
 	if rc.rm {
-		if err := apiClient.ContainerRemove(ctx, containerName, &types.ContainerRemoveOptions{Force: true}); err != nil {
+		if err := apiClient.ContainerRemove(ctx, containerName, &types.ContainerRemoveOptions{Force: true, Volumes: true}); err != nil {
 			return fmt.Errorf("failed to remove container %s: %v", containerName, err)
 		}
 	}
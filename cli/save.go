@@ -9,12 +9,13 @@ import (
 )
 
 // saveDescription is used to describe save command in detail and auto generate command doc.
-var saveDescription = "save an image to a tar archive."
+var saveDescription = "save an image to a tar archive of OCI image layout or docker-archive format."
 
 // SaveCommand use to implement 'save' command.
 type SaveCommand struct {
 	baseCommand
 	output string
+	format string
 }
 
 // Init initialize save command.
@@ -37,6 +38,7 @@ func (save *SaveCommand) Init(c *Cli) {
 func (save *SaveCommand) addFlags() {
 	flagSet := save.cmd.Flags()
 	flagSet.StringVarP(&save.output, "output", "o", "", "Save to a tar archive file, instead of STDOUT")
+	flagSet.StringVar(&save.format, "format", "oci", "Format of the tar archive, oci or docker-archive")
 }
 
 // runSave is the entry of save command.
@@ -44,7 +46,7 @@ func (save *SaveCommand) runSave(args []string) error {
 	ctx := context.Background()
 	apiClient := save.cli.Client()
 
-	r, err := apiClient.ImageSave(ctx, args[0])
+	r, err := apiClient.ImageSave(ctx, args[0], save.format)
 	if err != nil {
 		return err
 	}
@@ -73,5 +75,8 @@ $ pouch images
 IMAGE ID       IMAGE NAME                                           SIZE
 8c811b4aec35   registry.hub.docker.com/library/busybox:latest       710.81 KB
 8c811b4aec35   foo:latest                                           710.81 KB
+$ pouch save --format docker-archive -o busybox.tar busybox:latest
+$ docker load -i busybox.tar
+Loaded image: registry.hub.docker.com/library/busybox:latest
 `
 }
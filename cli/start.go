@@ -9,7 +9,9 @@ import (
 	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/ioutils"
 
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -23,11 +25,14 @@ var startDescription = "Start one or more created container objects in Pouchd. "
 // StartCommand use to implement 'start' command, it start one or more containers.
 type StartCommand struct {
 	baseCommand
-	detachKeys string
-	attach     bool
-	stdin      bool
-	checkpoint string
-	cpDir      string
+	detachKeys     string
+	attach         bool
+	stdin          bool
+	checkpoint     string
+	cpDir          string
+	tcpEstablished bool
+	lazyPages      bool
+	pageServer     string
 }
 
 // Init initialize start command.
@@ -54,6 +59,9 @@ func (s *StartCommand) addFlags() {
 	flagSet.BoolVarP(&s.stdin, "interactive", "i", false, "Attach container's STDIN")
 	flagSet.StringVar(&s.checkpoint, "checkpoint", "", "Restore container state from the checkpoint")
 	flagSet.StringVar(&s.cpDir, "checkpoint-dir", "", "Directory to store checkpoints images")
+	flagSet.BoolVar(&s.tcpEstablished, "tcp-established", false, "Restore established TCP connections from the checkpoint")
+	flagSet.BoolVar(&s.lazyPages, "lazy-pages", false, "Restore memory pages lazily from the page server")
+	flagSet.StringVar(&s.pageServer, "page-server", "", "Address of the page server serving a lazy checkpoint, format is host:port")
 }
 
 // runStart is the entry of start command.
@@ -90,7 +98,7 @@ func (s *StartCommand) runStart(args []string) error {
 			}()
 		}
 
-		conn, br, err := apiClient.ContainerAttach(ctx, container, s.stdin)
+		conn, br, err := apiClient.ContainerAttach(ctx, container, s.stdin, s.detachKeys)
 		if err != nil {
 			return fmt.Errorf("failed to attach container: %v", err)
 		}
@@ -98,18 +106,25 @@ func (s *StartCommand) runStart(args []string) error {
 
 		wait = make(chan struct{})
 		go func() {
-			io.Copy(os.Stdout, br)
+			copyAttachStream(c.Config.Tty, br)
 			close(wait)
 		}()
 		go func() {
 			io.Copy(conn, os.Stdin)
+			// close write if receive CTRL-D
+			if cw, ok := conn.(ioutils.CloseWriter); ok {
+				cw.CloseWrite()
+			}
 		}()
 
 		// start container
 		if err := apiClient.ContainerStart(ctx, container, types.ContainerStartOptions{
-			DetachKeys:    s.detachKeys,
-			CheckpointID:  s.checkpoint,
-			CheckpointDir: s.cpDir,
+			DetachKeys:     s.detachKeys,
+			CheckpointID:   s.checkpoint,
+			CheckpointDir:  s.cpDir,
+			TCPEstablished: s.tcpEstablished,
+			LazyPages:      s.lazyPages,
+			PageServer:     s.pageServer,
 		}); err != nil {
 			return fmt.Errorf("failed to start container %s: %v", container, err)
 		}
@@ -133,9 +148,12 @@ func (s *StartCommand) runStart(args []string) error {
 		var errs []string
 		for _, name := range args {
 			if err := apiClient.ContainerStart(ctx, name, types.ContainerStartOptions{
-				DetachKeys:    s.detachKeys,
-				CheckpointID:  s.checkpoint,
-				CheckpointDir: s.cpDir,
+				DetachKeys:     s.detachKeys,
+				CheckpointID:   s.checkpoint,
+				CheckpointDir:  s.cpDir,
+				TCPEstablished: s.tcpEstablished,
+				LazyPages:      s.lazyPages,
+				PageServer:     s.pageServer,
 			}); err != nil {
 				errs = append(errs, err.Error())
 				continue
@@ -185,6 +203,16 @@ func restoreMode(in, out *terminal.State) error {
 	return nil
 }
 
+// copyAttachStream copies the output of attached container to stdout and
+// stderr, the output is multiplexed if the container has no tty.
+func copyAttachStream(tty bool, r io.Reader) {
+	if tty {
+		io.Copy(os.Stdout, r)
+		return
+	}
+	stdcopy.StdCopy(os.Stdout, os.Stderr, r)
+}
+
 // CheckTty checks if we are trying to attach to a container tty
 // from a non-tty client input stream, and if so, returns an error.
 func checkTty(attachStdin, ttyMode bool, fd uintptr) error {
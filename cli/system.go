@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// systemMgmtDescription is used to describe system command in detail and auto generate command doc.
+var systemMgmtDescription = "Manage Pouch system"
+
+// SystemMgmtCommand use to implement 'system' command.
+type SystemMgmtCommand struct {
+	baseCommand
+}
+
+// Init initialize "system" command.
+func (s *SystemMgmtCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "system",
+		Short: "Manage system",
+		Long:  systemMgmtDescription,
+		Args:  cobra.NoArgs,
+	}
+
+	s.cli.AddCommand(s, &SystemDfCommand{})
+}
+
+// systemDfDescription is used to describe system df command in detail and auto generate command doc.
+var systemDfDescription = "Show the disk usage of images, containers and volumes. " +
+	"The size of images is the size of their unpacked layers, and the size of image content " +
+	"is the size of the blobs pulled from registry. The reclaimable size of images is the size " +
+	"of the layers used only by the images which are not used by any container."
+
+// SystemDfCommand use to implement 'system df' command.
+type SystemDfCommand struct {
+	baseCommand
+	verbose bool
+}
+
+// Init initialize "system df" command.
+func (s *SystemDfCommand) Init(c *Cli) {
+	s.cli = c
+	s.cmd = &cobra.Command{
+		Use:   "df [OPTIONS]",
+		Short: "Show pouch disk usage",
+		Long:  systemDfDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSystemDf(args)
+		},
+		Example: systemDfExample(),
+	}
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SystemDfCommand) addFlags() {
+	s.cmd.Flags().BoolVarP(&s.verbose, "verbose", "v", false, "Show detailed information on space usage")
+}
+
+// runSystemDf is the entry of system df command.
+func (s *SystemDfCommand) runSystemDf(args []string) error {
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	du, err := apiClient.SystemDiskUsage(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get disk usage: %v", err)
+	}
+
+	if s.verbose {
+		s.printVerbose(du)
+		return nil
+	}
+
+	var (
+		activeImages, activeContainers, activeVolumes int64
+		imagesReclaimable                             int64
+		containersSize, containersReclaimable         int64
+		volumesReclaimable                            int64
+	)
+	for _, img := range du.Images {
+		if img.Containers > 0 {
+			activeImages++
+			continue
+		}
+		imagesReclaimable += img.Size - img.SharedSize
+	}
+	for _, c := range du.Containers {
+		containersSize += c.SizeRw
+		if c.State == string(types.StatusRunning) || c.State == string(types.StatusPaused) {
+			activeContainers++
+			continue
+		}
+		containersReclaimable += c.SizeRw
+	}
+	for _, v := range du.Volumes {
+		if v.Size < 0 {
+			continue
+		}
+		if v.RefCount > 0 {
+			activeVolumes++
+			continue
+		}
+		volumesReclaimable += v.Size
+	}
+
+	display := s.cli.NewTableDisplay()
+	display.AddRow([]string{"TYPE", "TOTAL", "ACTIVE", "SIZE", "RECLAIMABLE"})
+	display.AddRow([]string{"Images", strconv.Itoa(len(du.Images)), strconv.FormatInt(activeImages, 10),
+		utils.FormatSize(du.ImagesSize), utils.FormatSize(imagesReclaimable)})
+	display.AddRow([]string{"Image Content", "-", "-", utils.FormatSize(du.LayersSize), "-"})
+	display.AddRow([]string{"Containers", strconv.Itoa(len(du.Containers)), strconv.FormatInt(activeContainers, 10),
+		utils.FormatSize(containersSize), utils.FormatSize(containersReclaimable)})
+	display.AddRow([]string{"Local Volumes", strconv.Itoa(len(du.Volumes)), strconv.FormatInt(activeVolumes, 10),
+		utils.FormatSize(du.VolumesSize), utils.FormatSize(volumesReclaimable)})
+	display.Flush()
+	return nil
+}
+
+// printVerbose prints the disk usage of each image, container and volume.
+func (s *SystemDfCommand) printVerbose(du *types.DiskUsage) {
+	fmt.Println("Images space usage:")
+	fmt.Println()
+	display := s.cli.NewTableDisplay()
+	display.AddRow([]string{"IMAGE ID", "IMAGE NAME", "SIZE", "SHARED SIZE", "UNIQUE SIZE", "CONTAINERS"})
+	for _, img := range du.Images {
+		name := "<none>"
+		if len(img.RepoTags) > 0 {
+			name = strings.Join(img.RepoTags, ",")
+		}
+		display.AddRow([]string{utils.TruncateID(img.ID), name, utils.FormatSize(img.Size),
+			utils.FormatSize(img.SharedSize), utils.FormatSize(img.Size - img.SharedSize), strconv.FormatInt(img.Containers, 10)})
+	}
+	display.Flush()
+
+	fmt.Println()
+	fmt.Println("Containers space usage:")
+	fmt.Println()
+	display = s.cli.NewTableDisplay()
+	display.AddRow([]string{"CONTAINER ID", "NAME", "IMAGE ID", "STATUS", "SIZE RW", "SIZE ROOTFS"})
+	for _, c := range du.Containers {
+		display.AddRow([]string{utils.TruncateID(c.ID), c.Name, utils.TruncateID(c.Image), c.State,
+			utils.FormatSize(c.SizeRw), utils.FormatSize(c.SizeRootFs)})
+	}
+	display.Flush()
+
+	fmt.Println()
+	fmt.Println("Local Volumes space usage:")
+	fmt.Println()
+	display = s.cli.NewTableDisplay()
+	display.AddRow([]string{"VOLUME NAME", "DRIVER", "LINKS", "SIZE"})
+	for _, v := range du.Volumes {
+		size := "N/A"
+		if v.Size >= 0 {
+			size = utils.FormatSize(v.Size)
+		}
+		display.AddRow([]string{v.Name, v.Driver, strconv.FormatInt(v.RefCount, 10), size})
+	}
+	display.Flush()
+}
+
+// systemDfExample shows examples in system df command, and is used in auto-generated cli docs.
+func systemDfExample() string {
+	return `$ pouch system df
+TYPE            TOTAL   ACTIVE   SIZE        RECLAIMABLE
+Images          2       1        7.67 MB     6.43 MB
+Image Content   -       -        3.76 MB     -
+Containers      1       1        12.00 KB    0.00 B
+Local Volumes   1       0        1.00 KB     1.00 KB`
+}
@@ -12,7 +12,9 @@ import (
 
 // topDescription
 var topDescription = "top command is to display the running processes of a container. " +
-	"You can add options just like using Linux ps command."
+	"You can add options just like using Linux ps command. " +
+	"The options are not supported by the runtimes running processes in the sandbox like kata and gVisor, " +
+	"whose processes are displayed with the PIDs in the sandbox and the exec IDs."
 
 // TopCommand use to implement 'top' command, it displays all processes in a container.
 type TopCommand struct {
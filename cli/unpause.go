@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/alibaba/pouch/pkg/utils/filters"
+
 	"github.com/spf13/cobra"
 )
 
@@ -17,28 +19,58 @@ var unpauseDescription = "Unpause one or more paused containers in Pouchd. " +
 // UnpauseCommand use to implement 'unpause' command, it unpauses one or more containers.
 type UnpauseCommand struct {
 	baseCommand
+	flagFilter []string
+	group      bool
 }
 
 // Init initialize unpause command.
 func (p *UnpauseCommand) Init(c *Cli) {
 	p.cli = c
 	p.cmd = &cobra.Command{
-		Use:   "unpause CONTAINER [CONTAINER...]",
+		Use:   "unpause [OPTIONS] CONTAINER [CONTAINER...]",
 		Short: "Unpause one or more paused container",
 		Long:  unpauseDescription,
-		Args:  cobra.MinimumNArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return p.runUnpause(args)
 		},
 		Example: unpauseExample(),
 	}
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *UnpauseCommand) addFlags() {
+	flagSet := p.cmd.Flags()
+	flagSet.StringSliceVarP(&p.flagFilter, "filter", "f", nil, "Unpause the containers matching the given conditions as a group, support filter key [ id label name status ]")
+	flagSet.BoolVar(&p.group, "group", false, "Unpause the containers as a group, none of them is unpaused if any fails")
 }
 
 // runUnpause is the entry of unpause command.
 func (p *UnpauseCommand) runUnpause(args []string) error {
+	if len(args) == 0 && len(p.flagFilter) == 0 {
+		return fmt.Errorf("requires at least 1 container or --filter")
+	}
+
 	ctx := context.Background()
 	apiClient := p.cli.Client()
 
+	if p.group || len(p.flagFilter) > 0 {
+		filter, err := filters.Parse(p.flagFilter)
+		if err != nil {
+			return err
+		}
+
+		ids, err := apiClient.ContainerUnpauseGroup(ctx, args, filter)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			fmt.Printf("%s\n", id)
+		}
+		return nil
+	}
+
 	var errs []string
 	for _, name := range args {
 		if err := apiClient.ContainerUnpause(ctx, name); err != nil {
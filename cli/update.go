@@ -10,13 +10,16 @@ import (
 )
 
 // updateDescription is used to describe update command in detail and auto generate command doc.
-var updateDescription = "Update a container's configurations, including memory, cpu and diskquota etc.  " +
-	"You can update a container when it is running."
+var updateDescription = "Update a container's configurations, including memory, cpu, blkio, pids, hugepages, diskquota, network bandwidth, labels and annotations etc.  " +
+	"You can update a container when it is running. The annotations for runtime spec take effect when the container is started again."
 
 // UpdateCommand use to implement 'update' command, it modifies the configurations of a container.
 type UpdateCommand struct {
 	baseCommand
 	container
+
+	removeLabels         []string
+	removeSpecAnnotation []string
 }
 
 // Init initialize update command.
@@ -51,17 +54,44 @@ func (uc *UpdateCommand) addFlags() {
 	flagSet.StringVar(&uc.cpusetmems, "cpuset-mems", "", "MEMs in cpuset which to allow execution (0-3, 0, 1)")
 	flagSet.StringVarP(&uc.memory, "memory", "m", "", "Container memory limit")
 	flagSet.StringVar(&uc.memorySwap, "memory-swap", "", "Container swap limit")
+	flagSet.StringVar(&uc.memoryReservation, "memory-reservation", "", "Memory soft limit")
+	flagSet.Int64Var(&uc.memorySwappiness, "memory-swappiness", 0, "Container memory swappiness [0, 100]")
+	flagSet.StringVar(&uc.kernelMemory, "kernel-memory", "", "Kernel memory limit (in bytes)")
+	flagSet.Int64Var(&uc.pidsLimit, "pids-limit", 0, "Update container pids limit, -1 for unlimited")
+	flagSet.StringSliceVar(&uc.hugepageLimits, "hugepage-limit", nil, "Update hugepage limit of container, format is <page size>=<limit>, such as 2MB=1g")
 	flagSet.StringSliceVarP(&uc.env, "env", "e", nil, "Update environment variables for container('--env A=' means updating env A to be empty and '--env A' means removing env A)")
 	flagSet.StringSliceVarP(&uc.labels, "label", "l", nil, "Update labels for container")
 	flagSet.StringVar(&uc.restartPolicy, "restart", "", "Restart policy to apply when container exits")
 	flagSet.StringSliceVar(&uc.diskQuota, "disk-quota", nil, "Update disk quota for container(/=10g)")
 	flagSet.StringSliceVar(&uc.specAnnotation, "annotation", nil, "Update annotation for runtime spec")
+	flagSet.StringSliceVar(&uc.removeLabels, "label-rm", nil, "Remove labels from container by the keys")
+	flagSet.StringSliceVar(&uc.removeSpecAnnotation, "annotation-rm", nil, "Remove annotations for runtime spec by the keys")
+	flagSet.StringVar(&uc.egressBandwidth, "egress-bandwidth", "", "Update egress bandwidth limit of container network, format is <rate>[:<burst>] in bytes, 0 means no limit")
+	flagSet.StringVar(&uc.ingressBandwidth, "ingress-bandwidth", "", "Update ingress bandwidth limit of container network, format is <rate>[:<burst>] in bytes, 0 means no limit")
 }
 
 // updateRun is the entry of update command.
 func (uc *UpdateCommand) updateRun(args []string) error {
 	container := args[0]
 	ctx := context.Background()
+	apiClient := uc.cli.Client()
+
+	// the bandwidth limits are updated by the network endpoint.
+	flagSet := uc.cmd.Flags()
+	networkFlags := 0
+	for _, name := range []string{"egress-bandwidth", "ingress-bandwidth"} {
+		if flagSet.Changed(name) {
+			networkFlags++
+		}
+	}
+	if networkFlags > 0 {
+		if err := uc.updateNetworkBandwidth(ctx, container); err != nil {
+			return err
+		}
+		if flagSet.NFlag() == networkFlags {
+			return nil
+		}
+	}
 
 	memory, err := opts.ParseMemory(uc.memory)
 	if err != nil {
@@ -73,6 +103,27 @@ func (uc *UpdateCommand) updateRun(args []string) error {
 		return err
 	}
 
+	memoryReservation, err := opts.ParseMemoryReservation(uc.memoryReservation)
+	if err != nil {
+		return err
+	}
+
+	kernelMemory, err := opts.ParseMemory(uc.kernelMemory)
+	if err != nil {
+		return err
+	}
+
+	// memory swappiness is only updated if it's set, since 0 is valid.
+	var memorySwappiness *int64
+	if flagSet.Changed("memory-swappiness") {
+		memorySwappiness = &uc.memorySwappiness
+	}
+
+	hugepageLimits, err := opts.ParseHugepageLimits(uc.hugepageLimits)
+	if err != nil {
+		return err
+	}
+
 	resource := types.Resources{
 		BlkioWeight:          uc.blkioWeight,
 		BlkioDeviceReadBps:   uc.blkioDeviceReadBps.Value(),
@@ -86,6 +137,11 @@ func (uc *UpdateCommand) updateRun(args []string) error {
 		CpusetMems:           uc.cpusetmems,
 		Memory:               memory,
 		MemorySwap:           memorySwap,
+		MemoryReservation:    memoryReservation,
+		MemorySwappiness:     memorySwappiness,
+		KernelMemory:         kernelMemory,
+		PidsLimit:            uc.pidsLimit,
+		HugepageLimits:       hugepageLimits,
 	}
 
 	restartPolicy, err := opts.ParseRestartPolicy(uc.restartPolicy)
@@ -104,18 +160,50 @@ func (uc *UpdateCommand) updateRun(args []string) error {
 	}
 
 	updateConfig := &types.UpdateConfig{
-		Env:            uc.env,
-		Label:          uc.labels,
-		RestartPolicy:  restartPolicy,
-		Resources:      resource,
-		DiskQuota:      diskQuota,
-		SpecAnnotation: annotation,
+		Env:                  uc.env,
+		Label:                uc.labels,
+		RemoveLabel:          uc.removeLabels,
+		RestartPolicy:        restartPolicy,
+		Resources:            resource,
+		DiskQuota:            diskQuota,
+		SpecAnnotation:       annotation,
+		RemoveSpecAnnotation: uc.removeSpecAnnotation,
 	}
 
-	apiClient := uc.cli.Client()
 	return apiClient.ContainerUpdate(ctx, container, updateConfig)
 }
 
+// updateNetworkBandwidth updates the bandwidth limits of container network,
+// the limit of direction not specified is kept.
+func (uc *UpdateCommand) updateNetworkBandwidth(ctx context.Context, container string) error {
+	apiClient := uc.cli.Client()
+
+	c, err := apiClient.ContainerGet(ctx, container)
+	if err != nil {
+		return err
+	}
+	bandwidth := &types.NetworkBandwidth{}
+	if c.HostConfig != nil && c.HostConfig.NetworkBandwidth != nil {
+		*bandwidth = *c.HostConfig.NetworkBandwidth
+	}
+
+	update, err := opts.ParseNetworkBandwidth(uc.egressBandwidth, uc.ingressBandwidth)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		update = &types.NetworkBandwidth{}
+	}
+	if uc.cmd.Flags().Changed("egress-bandwidth") {
+		bandwidth.EgressRate, bandwidth.EgressBurst = update.EgressRate, update.EgressBurst
+	}
+	if uc.cmd.Flags().Changed("ingress-bandwidth") {
+		bandwidth.IngressRate, bandwidth.IngressBurst = update.IngressRate, update.IngressBurst
+	}
+
+	return apiClient.ContainerUpdateNetwork(ctx, container, bandwidth)
+}
+
 // updateExample shows examples in update command, and is used in auto-generated cli docs.
 func updateExample() string {
 	return `$ pouch run -d -m 20m --name test-update registry.hub.docker.com/library/busybox:latest
@@ -125,5 +213,11 @@ $ cat /sys/fs/cgroup/memory/8649804cb63ff9713a2734d99728b9d6d5d1e4d2fbafb2b4dbdf
 $ pouch update -m 30m test-update
 $ cat /sys/fs/cgroup/memory/8649804cb63ff9713a2734d99728b9d6d5d1e4d2fbafb2b4dbdf79c6bbaef812/memory.limit_in_bytes
 31457280
+$ pouch update --egress-bandwidth 1mb test-update
+$ pouch inspect -f "{{.HostConfig.NetworkBandwidth.EgressRate}}" test-update
+1048576
+$ pouch update --label tier=frontend --label-rm env test-update
+$ pouch inspect -f "{{json .Config.Labels}}" test-update
+{"tier":"frontend"}
 	`
 }
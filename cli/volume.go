@@ -42,6 +42,8 @@ func (v *VolumeCommand) Init(c *Cli) {
 	c.AddCommand(v, &VolumeRemoveCommand{})
 	c.AddCommand(v, &VolumeInspectCommand{})
 	c.AddCommand(v, &VolumeListCommand{})
+	c.AddCommand(v, &VolumeSnapshotCommand{})
+	c.AddCommand(v, &VolumeCloneCommand{})
 }
 
 // RunE is the entry of VolumeCommand command.
@@ -86,7 +88,7 @@ func (v *VolumeCreateCommand) addFlags() {
 	flagSet := v.cmd.Flags()
 	flagSet.StringVarP(&v.name, "name", "n", "", "Specify name for volume")
 	flagSet.StringVarP(&v.driver, "driver", "d", "local", "Specify volume driver name (default 'local')")
-	flagSet.StringSliceVarP(&v.options, "option", "o", nil, "Set volume driver options")
+	flagSet.StringArrayVarP(&v.options, "option", "o", nil, "Set volume driver options, such as type=tmpfs, device=tmpfs and o=size=64m")
 	flagSet.StringSliceVarP(&v.labels, "label", "l", nil, "Set labels for volume")
 	flagSet.StringSliceVarP(&v.selectors, "selector", "s", nil, "Set volume selectors")
 }
@@ -133,8 +135,9 @@ func parseVolume(volumeCreateConfig *types.VolumeCreateConfig, v *VolumeCreateCo
 
 	// analyze options.
 	for _, option := range v.options {
-		opt := strings.Split(option, "=")
-		if len(opt) != 2 {
+		// the value of option may contain '=', such as o=size=64m.
+		opt := strings.SplitN(option, "=", 2)
+		if len(opt) != 2 || opt[0] == "" {
 			return fmt.Errorf("unknown option %s: option format must be key=value", option)
 		}
 		volumeCreateConfig.DriverOpts[opt[0]] = opt[1]
@@ -158,6 +161,12 @@ Mountpoint:
 Name:         pouch-volume
 Scope:
 CreatedAt:
+Driver:       local
+$ pouch volume create -n tmpfs-volume -o type=tmpfs -o o=size=64m,mode=1777 -o nocopy=true
+Mountpoint:
+Name:         tmpfs-volume
+Scope:
+CreatedAt:
 Driver:       local`
 }
 
@@ -221,6 +230,7 @@ var volumeInspectDescription = "Inspect one or more volumes in pouchd. " +
 type VolumeInspectCommand struct {
 	baseCommand
 	format string
+	size   bool
 }
 
 // Init initializes VolumeInspectCommand command.
@@ -241,7 +251,8 @@ func (v *VolumeInspectCommand) Init(c *Cli) {
 
 // addFlags adds flags for specific command.
 func (v *VolumeInspectCommand) addFlags() {
-	v.cmd.Flags().StringVarP(&v.format, "format", "f", "", "Format the output using the given go template")
+	v.cmd.Flags().StringVarP(&v.format, "format", "f", "", "Format the output using the given go template, or json for the indented JSON")
+	v.cmd.Flags().BoolVarP(&v.size, "size", "s", false, "Display the usage and capacity of volume")
 }
 
 // runVolumeInspect is the entry of VolumeInspectCommand command.
@@ -250,6 +261,9 @@ func (v *VolumeInspectCommand) runVolumeInspect(args []string) error {
 	apiClient := v.cli.Client()
 
 	getRefFunc := func(ref string) (interface{}, error) {
+		if v.size {
+			return apiClient.VolumeInspectWithSize(ctx, ref)
+		}
 		return apiClient.VolumeInspect(ctx, ref)
 	}
 
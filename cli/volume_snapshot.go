@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/opts"
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/spf13/cobra"
+)
+
+// volumeSnapshotDescription is used to describe volume snapshot command in detail and auto generate command doc.
+var volumeSnapshotDescription = "Manage the snapshots of volume, " +
+	"the snapshot is only supported by the volume drivers which are able to take snapshots. " +
+	"The local driver copies the data of volume into the snapshot, so the volume should not be used by any container, " +
+	"and the volume mounted with device is not supported."
+
+// VolumeSnapshotCommand is used to implement 'volume snapshot' command.
+type VolumeSnapshotCommand struct {
+	baseCommand
+}
+
+// Init initializes VolumeSnapshotCommand command.
+func (v *VolumeSnapshotCommand) Init(c *Cli) {
+	v.cli = c
+
+	v.cmd = &cobra.Command{
+		Use:   "snapshot [command]",
+		Short: "Manage volume snapshots",
+		Long:  volumeSnapshotDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch volume snapshot %s' does not exist.\nPlease execute `pouch volume snapshot --help` for more help", args[0])
+		},
+	}
+
+	c.AddCommand(v, &VolumeSnapshotCreateCommand{})
+	c.AddCommand(v, &VolumeSnapshotListCommand{})
+	c.AddCommand(v, &VolumeSnapshotRemoveCommand{})
+}
+
+// volumeSnapshotCreateDescription is used to describe volume snapshot create command in detail and auto generate command doc.
+var volumeSnapshotCreateDescription = "Take a snapshot of volume. " +
+	"The name of snapshot is unique on the host."
+
+// VolumeSnapshotCreateCommand is used to implement 'volume snapshot create' command.
+type VolumeSnapshotCreateCommand struct {
+	baseCommand
+	labels []string
+}
+
+// Init initializes VolumeSnapshotCreateCommand command.
+func (v *VolumeSnapshotCreateCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:   "create [OPTIONS] VOLUME SNAPSHOT",
+		Short: "Take a snapshot of volume",
+		Long:  volumeSnapshotCreateDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeSnapshotCreate(args)
+		},
+		Example: volumeSnapshotCreateExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeSnapshotCreateCommand) addFlags() {
+	v.cmd.Flags().StringSliceVarP(&v.labels, "label", "l", nil, "Set labels for snapshot")
+}
+
+// runVolumeSnapshotCreate is the entry of VolumeSnapshotCreateCommand command.
+func (v *VolumeSnapshotCreateCommand) runVolumeSnapshotCreate(args []string) error {
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	snapshot, err := apiClient.VolumeSnapshotCreate(ctx, args[0], &types.VolumeSnapshotCreateConfig{
+		Name:   args[1],
+		Labels: opts.ParseLabels(v.labels),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(snapshot.Name)
+	return nil
+}
+
+// volumeSnapshotCreateExample shows examples in volume snapshot create command, and is used in auto-generated cli docs.
+func volumeSnapshotCreateExample() string {
+	return `$ pouch volume snapshot create pouch-volume pouch-volume-snap
+pouch-volume-snap`
+}
+
+// volumeSnapshotListDescription is used to describe volume snapshot list command in detail and auto generate command doc.
+var volumeSnapshotListDescription = "List the snapshots of volume."
+
+// VolumeSnapshotListCommand is used to implement 'volume snapshot list' command.
+type VolumeSnapshotListCommand struct {
+	baseCommand
+	quiet bool
+}
+
+// Init initializes VolumeSnapshotListCommand command.
+func (v *VolumeSnapshotListCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:     "list [OPTIONS] VOLUME",
+		Aliases: []string{"ls"},
+		Short:   "List the snapshots of volume",
+		Long:    volumeSnapshotListDescription,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeSnapshotList(args)
+		},
+		Example: volumeSnapshotListExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeSnapshotListCommand) addFlags() {
+	v.cmd.Flags().BoolVarP(&v.quiet, "quiet", "q", false, "Only display snapshot names")
+}
+
+// runVolumeSnapshotList is the entry of VolumeSnapshotListCommand command.
+func (v *VolumeSnapshotListCommand) runVolumeSnapshotList(args []string) error {
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	snapshots, err := apiClient.VolumeSnapshotList(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if v.quiet {
+		for _, s := range snapshots {
+			fmt.Println(s.Name)
+		}
+		return nil
+	}
+
+	display := v.cli.NewTableDisplay()
+	display.AddRow([]string{"SNAPSHOT NAME", "VOLUME NAME", "DRIVER", "CREATED AT"})
+	for _, s := range snapshots {
+		display.AddRow([]string{s.Name, s.Volume, s.Driver, s.CreatedAt})
+	}
+	display.Flush()
+	return nil
+}
+
+// volumeSnapshotListExample shows examples in volume snapshot list command, and is used in auto-generated cli docs.
+func volumeSnapshotListExample() string {
+	return `$ pouch volume snapshot ls pouch-volume
+SNAPSHOT NAME         VOLUME NAME    DRIVER   CREATED AT
+pouch-volume-snap     pouch-volume   lvm      2018-4-2 14:33:45`
+}
+
+// volumeSnapshotRmDescription is used to describe volume snapshot rm command in detail and auto generate command doc.
+var volumeSnapshotRmDescription = "Remove one or more snapshots of volume. " +
+	"The volume can't be removed until all its snapshots are removed."
+
+// VolumeSnapshotRemoveCommand is used to implement 'volume snapshot rm' command.
+type VolumeSnapshotRemoveCommand struct {
+	baseCommand
+}
+
+// Init initializes VolumeSnapshotRemoveCommand command.
+func (v *VolumeSnapshotRemoveCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:     "remove VOLUME SNAPSHOT [SNAPSHOT...]",
+		Aliases: []string{"rm"},
+		Short:   "Remove one or more snapshots of volume",
+		Long:    volumeSnapshotRmDescription,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeSnapshotRm(args)
+		},
+		Example: volumeSnapshotRmExample(),
+	}
+}
+
+// runVolumeSnapshotRm is the entry of VolumeSnapshotRemoveCommand command.
+func (v *VolumeSnapshotRemoveCommand) runVolumeSnapshotRm(args []string) error {
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	var errs []string
+	for _, name := range args[1:] {
+		if err := apiClient.VolumeSnapshotRemove(ctx, args[0], name); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Printf("Removed: %s\n", name)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove snapshots: %s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// volumeSnapshotRmExample shows examples in volume snapshot rm command, and is used in auto-generated cli docs.
+func volumeSnapshotRmExample() string {
+	return `$ pouch volume snapshot rm pouch-volume pouch-volume-snap
+Removed: pouch-volume-snap`
+}
+
+// volumeCloneDescription is used to describe volume clone command in detail and auto generate command doc.
+var volumeCloneDescription = "Create a volume from the snapshot of volume. " +
+	"The new volume is created by the driver of the volume, and is able to be mounted by containers as the other volumes."
+
+// VolumeCloneCommand is used to implement 'volume clone' command.
+type VolumeCloneCommand struct {
+	baseCommand
+
+	name    string
+	options []string
+	labels  []string
+}
+
+// Init initializes VolumeCloneCommand command.
+func (v *VolumeCloneCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:   "clone [OPTIONS] VOLUME SNAPSHOT",
+		Short: "Create a volume from the snapshot of volume",
+		Long:  volumeCloneDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeClone(args)
+		},
+		Example: volumeCloneExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeCloneCommand) addFlags() {
+	flagSet := v.cmd.Flags()
+	flagSet.StringVarP(&v.name, "name", "n", "", "Specify name for the new volume")
+	flagSet.StringArrayVarP(&v.options, "option", "o", nil, "Set volume driver options")
+	flagSet.StringSliceVarP(&v.labels, "label", "l", nil, "Set labels for the new volume")
+}
+
+// runVolumeClone is the entry of VolumeCloneCommand command.
+func (v *VolumeCloneCommand) runVolumeClone(args []string) error {
+	config := &types.VolumeCloneConfig{
+		Name:       v.name,
+		Snapshot:   args[1],
+		DriverOpts: map[string]string{},
+		Labels:     opts.ParseLabels(v.labels),
+	}
+	for _, option := range v.options {
+		opt := strings.SplitN(option, "=", 2)
+		if len(opt) != 2 || opt[0] == "" {
+			return fmt.Errorf("unknown option %s: option format must be key=value", option)
+		}
+		config.DriverOpts[opt[0]] = opt[1]
+	}
+
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	volume, err := apiClient.VolumeClone(ctx, args[0], config)
+	if err != nil {
+		return err
+	}
+
+	v.cli.Print(volume)
+	return nil
+}
+
+// volumeCloneExample shows examples in volume clone command, and is used in auto-generated cli docs.
+func volumeCloneExample() string {
+	return `$ pouch volume clone -n pouch-volume-clone pouch-volume pouch-volume-snap
+Mountpoint:   /mnt/lvm/pouch-volume-clone
+Name:         pouch-volume-clone
+Scope:
+CreatedAt:    2018-4-2 14:40:12
+Driver:       lvm
+$ pouch run -v pouch-volume-clone:/data busybox ls /data`
+}
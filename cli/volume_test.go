@@ -25,7 +25,7 @@ func Test_parseVolume(t *testing.T) {
 				},
 				v: &VolumeCreateCommand{
 					labels:    []string{"a=b"},
-					options:   []string{"1=2"},
+					options:   []string{"1=2", "o=size=64m,mode=1777"},
 					selectors: []string{"c=d"},
 				},
 			},
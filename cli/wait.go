@@ -12,18 +12,20 @@ import (
 // waitDescription is used to describe wait command in detail and auto generate command doc.
 var waitDescription = "Block until one or more containers stop, then print their exit codes. " +
 	"If container state is already stopped, the command will return exit code immediately. " +
-	"On a successful stop, the exit code of the container is returned. "
+	"On a successful stop, the exit code of the container is returned. " +
+	"With --condition, it waits for the next exit of container, or the removal of container instead. "
 
 // WaitCommand is used to implement 'wait' command.
 type WaitCommand struct {
 	baseCommand
+	condition string
 }
 
 // Init initializes wait command.
 func (wait *WaitCommand) Init(c *Cli) {
 	wait.cli = c
 	wait.cmd = &cobra.Command{
-		Use:   "wait CONTAINER [CONTAINER...]",
+		Use:   "wait [OPTIONS] CONTAINER [CONTAINER...]",
 		Short: "Block until one or more containers stop, then print their exit codes",
 		Long:  waitDescription,
 		Args:  cobra.MinimumNArgs(1),
@@ -32,6 +34,13 @@ func (wait *WaitCommand) Init(c *Cli) {
 		},
 		Example: waitExamples(),
 	}
+	wait.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (wait *WaitCommand) addFlags() {
+	flagSet := wait.cmd.Flags()
+	flagSet.StringVar(&wait.condition, "condition", "not-running", "Wait until the container satisfies the condition, support not-running, next-exit and removed")
 }
 
 // runWait is the entry of wait command.
@@ -41,7 +50,7 @@ func (wait *WaitCommand) runWait(args []string) error {
 
 	var errs []string
 	for _, name := range args {
-		response, err := apiClient.ContainerWait(ctx, name)
+		response, err := apiClient.ContainerWait(ctx, name, wait.condition)
 		if err != nil {
 			errs = append(errs, err.Error())
 			continue
@@ -66,5 +75,9 @@ $ pouch ps -a
 Name   ID       Status                 Created         Image                                            Runtime
 foo    f6717e   Stopped (0) 1 minute   2 minutes ago   registry.hub.docker.com/library/busybox:latest   runc
 $ pouch wait foo
+0
+$ pouch wait --condition removed foo &
+$ pouch rm foo
+foo
 0`
 }
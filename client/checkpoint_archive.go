@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+)
+
+// ContainerCheckpointExport exports a checkpoint of container as a tar stream.
+// It's up to the caller to close the reader.
+func (client *APIClient) ContainerCheckpointExport(ctx context.Context, name, checkpointID, checkpointDir string) (io.ReadCloser, error) {
+	q := url.Values{}
+	if checkpointDir != "" {
+		q.Set("dir", checkpointDir)
+	}
+
+	resp, err := client.get(ctx, "/containers/"+name+"/checkpoints/"+checkpointID+"/archive", q, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// ContainerCheckpointImport imports a checkpoint into container from a tar stream.
+func (client *APIClient) ContainerCheckpointImport(ctx context.Context, name, checkpointID, checkpointDir string, content io.Reader) error {
+	q := url.Values{}
+	if checkpointDir != "" {
+		q.Set("dir", checkpointDir)
+	}
+
+	headers := map[string][]string{}
+	headers["Content-Type"] = []string{"application/x-tar"}
+
+	resp, err := client.putRawData(ctx, "/containers/"+name+"/checkpoints/"+checkpointID+"/archive", q, content, headers)
+	ensureCloseReader(resp)
+
+	return err
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckpointExportError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.ContainerCheckpointExport(context.Background(), "nothing", "noid", "")
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestCheckpointExport(t *testing.T) {
+	expectedURL := "/containers/container_id/checkpoints/cp0/archive"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+		if got := req.FormValue("dir"); got != "/tmp" {
+			return nil, fmt.Errorf("expected dir /tmp, got %s", got)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("tarstream"))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	body, err := client.ContainerCheckpointExport(context.Background(), "container_id", "cp0", "/tmp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+}
+
+func TestCheckpointImportError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	err := client.ContainerCheckpointImport(context.Background(), "nothing", "noid", "", bytes.NewReader(nil))
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestCheckpointImport(t *testing.T) {
+	expectedURL := "/containers/container_id/checkpoints/cp0/archive"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "PUT" {
+			return nil, fmt.Errorf("expected PUT method, got %s", req.Method)
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	err := client.ContainerCheckpointImport(context.Background(), "container_id", "cp0", "", bytes.NewReader([]byte("tarstream")))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -37,6 +37,10 @@ type TLSConfig struct {
 	Key              string `json:"tlskey,omitempty"`
 	VerifyRemote     bool   `json:"tlsverify,omitempty"`
 	ManagerWhiteList string `json:"manager-whitelist,omitempty"`
+
+	// MinVersion and CipherSuites are only used by the server side.
+	MinVersion   string   `json:"tls-min-version,omitempty"`
+	CipherSuites []string `json:"tls-cipher-suites,omitempty"`
 }
 
 // NewAPIClient initializes a new API client for the given host
@@ -7,14 +7,21 @@ import (
 	"net/url"
 )
 
-// ContainerAttach attachs a container.
-func (client *APIClient) ContainerAttach(ctx context.Context, name string, stdin bool) (net.Conn, *bufio.Reader, error) {
+// ContainerAttach attachs a container. The detach keys override the key
+// sequence for detaching from the container if set.
+func (client *APIClient) ContainerAttach(ctx context.Context, name string, stdin bool, detachKeys string) (net.Conn, *bufio.Reader, error) {
 	q := url.Values{}
 	if stdin {
 		q.Set("stdin", "1")
 	} else {
 		q.Set("stdin", "0")
 	}
+	q.Set("stdout", "1")
+	q.Set("stderr", "1")
+
+	if detachKeys != "" {
+		q.Set("detachKeys", detachKeys)
+	}
 
 	header := map[string][]string{
 		"Content-Type": {"text/plain"},
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerChangesError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.ContainerChanges(context.Background(), "nothing")
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestContainerChanges(t *testing.T) {
+	expectedURL := "/containers/container_id/changes"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+
+		b, err := json.Marshal([]*types.ContainerChangeResponseItem{
+			{Kind: 0, Path: "/etc"},
+			{Kind: 1, Path: "/etc/foo"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	changes, err := client.ContainerChanges(context.Background(), "container_id")
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.ContainerChangeResponseItem{
+		{Kind: 0, Path: "/etc"},
+		{Kind: 1, Path: "/etc/foo"},
+	}, changes)
+}
@@ -15,6 +15,9 @@ func (client *APIClient) ContainerCommit(ctx context.Context, name string, optio
 	q.Set("tag", options.Tag)
 	q.Set("comment", options.Comment)
 	q.Set("author", options.Author)
+	for _, change := range options.Changes {
+		q.Add("changes", change)
+	}
 
 	response := &types.ContainerCommitResp{}
 	resp, err := client.post(ctx, "/commit", q, nil, nil)
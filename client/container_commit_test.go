@@ -43,6 +43,9 @@ func TestCommit(t *testing.T) {
 		if options.Tag != "bar" {
 			return nil, fmt.Errorf("expected Tag %s, obtain %s", "bar", options.Tag)
 		}
+		if changes := req.Form["changes"]; len(changes) != 2 || changes[1] != "EXPOSE 80" {
+			return nil, fmt.Errorf("expected Changes %v, obtain %v", []string{"CMD top", "EXPOSE 80"}, changes)
+		}
 
 		resp := types.ContainerCommitResp{
 			ID: "newid",
@@ -64,7 +67,8 @@ func TestCommit(t *testing.T) {
 
 	r, err := client.ContainerCommit(context.Background(), "id", types.ContainerCommitOptions{
 		Repository: "foo",
-		Tag:        "bar"})
+		Tag:        "bar",
+		Changes:    []string{"CMD top", "EXPOSE 80"}})
 	if err != nil {
 		t.Fatal(err)
 	}
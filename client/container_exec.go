@@ -61,3 +61,23 @@ func (client *APIClient) ContainerExecResize(ctx context.Context, execID string,
 	ensureCloseReader(resp)
 	return err
 }
+
+// ContainerExecPrune removes the finished exec processes, only the ones of
+// container name are removed if name is not empty.
+func (client *APIClient) ContainerExecPrune(ctx context.Context, name string) (*types.ExecPruneResp, error) {
+	query := url.Values{}
+	if name != "" {
+		query.Set("container", name)
+	}
+
+	resp, err := client.post(ctx, "/exec/prune", query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &types.ExecPruneResp{}
+	err = decodeBody(body, resp.Body)
+	ensureCloseReader(resp)
+
+	return body, err
+}
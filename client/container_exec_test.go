@@ -153,3 +153,40 @@ func TestContainerExecResize(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestContainerExecPrune(t *testing.T) {
+	expectedURL := "/exec/prune"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if container := req.FormValue("container"); container != "container_id" {
+			return nil, fmt.Errorf("expected container = container_id, got %s", container)
+		}
+
+		b, err := json.Marshal(types.ExecPruneResp{
+			ExecsDeleted: []string{"exec_id"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	resp, err := client.ContainerExecPrune(context.Background(), "container_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"exec_id"}, resp.ExecsDeleted)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestContainerExportServerError(t *testing.T) {
+	expectedError := "Server error"
+
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, expectedError)),
+	}
+
+	_, err := client.ContainerExport(context.Background(), "nothing")
+	if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected (%v), got (%v)", expectedError, err)
+	}
+}
+
+func TestContainerExportOK(t *testing.T) {
+	expectedURL := "/containers/container_export_ok/export"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		if req.Method != http.MethodGet {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("rootfs"))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	r, err := client.ContainerExport(context.Background(), "container_export_ok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "rootfs" {
+		t.Fatalf("expected (rootfs), got %s", data)
+	}
+}
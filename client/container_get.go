@@ -2,13 +2,26 @@ package client
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/alibaba/pouch/apis/types"
 )
 
 // ContainerGet returns the detailed information of container.
 func (client *APIClient) ContainerGet(ctx context.Context, name string) (*types.ContainerJSON, error) {
-	resp, err := client.get(ctx, "/containers/"+name+"/json", nil, nil)
+	return client.containerGet(ctx, name, nil)
+}
+
+// ContainerGetWithSize returns the detailed information of container with
+// the size of its writable layer and rootfs.
+func (client *APIClient) ContainerGetWithSize(ctx context.Context, name string) (*types.ContainerJSON, error) {
+	q := url.Values{}
+	q.Set("size", "true")
+	return client.containerGet(ctx, name, q)
+}
+
+func (client *APIClient) containerGet(ctx context.Context, name string, query url.Values) (*types.ContainerJSON, error) {
+	resp, err := client.get(ctx, "/containers/"+name+"/json", query, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -50,3 +50,38 @@ func TestContainerGet(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestContainerGetWithSize(t *testing.T) {
+	expectedURL := "/containers/container_id/json"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if size := req.URL.Query().Get("size"); size != "true" {
+			return nil, fmt.Errorf("size not set in URL query properly. Expected 'true', got %s", size)
+		}
+		sizeRw, sizeRootFs := int64(10), int64(100)
+		b, err := json.Marshal(types.ContainerJSON{
+			SizeRw:     &sizeRw,
+			SizeRootFs: &sizeRootFs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	container, err := client.ContainerGetWithSize(context.Background(), "container_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *container.SizeRw != 10 || *container.SizeRootFs != 100 {
+		t.Fatalf("expected size 10 and 100, got %d and %d", *container.SizeRw, *container.SizeRootFs)
+	}
+}
@@ -16,6 +16,10 @@ func (client *APIClient) ContainerList(ctx context.Context, option types.Contain
 		q.Set("all", "true")
 	}
 
+	if option.Size {
+		q.Set("size", "true")
+	}
+
 	if len(option.Filter) > 0 {
 		fJSON, err := filters.ToURLParam(option.Filter)
 		if err != nil {
@@ -37,6 +37,9 @@ func TestContainerList(t *testing.T) {
 		if all != "true" {
 			return nil, fmt.Errorf("all not set in URL query properly. Expected 'true', got %s", all)
 		}
+		if size := query.Get("size"); size != "true" {
+			return nil, fmt.Errorf("size not set in URL query properly. Expected 'true', got %s", size)
+		}
 		containersJSON := []types.ContainerJSON{
 			{
 				Name:  "container1",
@@ -60,7 +63,8 @@ func TestContainerList(t *testing.T) {
 		HTTPCli: httpClient,
 	}
 	containers, err := client.ContainerList(context.Background(), types.ContainerListOptions{
-		All: true,
+		All:  true,
+		Size: true,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/pkg/utils/filters"
+)
+
+// ContainerPauseGroup pauses a group of containers specified by names or
+// filter atomically, and returns IDs of the paused containers.
+func (client *APIClient) ContainerPauseGroup(ctx context.Context, names []string, filter map[string][]string) ([]string, error) {
+	return client.freezeContainerGroup(ctx, "/containers/pause", names, filter)
+}
+
+// ContainerUnpauseGroup unpauses a group of containers specified by names or
+// filter atomically, and returns IDs of the unpaused containers.
+func (client *APIClient) ContainerUnpauseGroup(ctx context.Context, names []string, filter map[string][]string) ([]string, error) {
+	return client.freezeContainerGroup(ctx, "/containers/unpause", names, filter)
+}
+
+func (client *APIClient) freezeContainerGroup(ctx context.Context, path string, names []string, filter map[string][]string) ([]string, error) {
+	q := url.Values{}
+	for _, name := range names {
+		q.Add("id", name)
+	}
+
+	if len(filter) > 0 {
+		fJSON, err := filters.ToURLParam(filter)
+		if err != nil {
+			return nil, err
+		}
+		q.Set("filters", fJSON)
+	}
+
+	resp, err := client.post(ctx, path, q, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	err = decodeBody(&ids, resp.Body)
+	ensureCloseReader(resp)
+
+	return ids, err
+}
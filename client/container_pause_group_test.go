@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestContainerPauseGroupError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.ContainerPauseGroup(context.Background(), []string{"a"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestContainerPauseGroup(t *testing.T) {
+	expectedURL := "/containers/pause"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if ids := req.URL.Query()["id"]; !reflect.DeepEqual(ids, []string{"a", "b"}) {
+			return nil, fmt.Errorf("expected ids [a b], got %v", ids)
+		}
+		if filters := req.URL.Query().Get("filters"); filters != `{"label":["app=web"]}` {
+			return nil, fmt.Errorf("unexpected filters %s", filters)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`["a1","b1","c1"]`))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	ids, err := client.ContainerPauseGroup(context.Background(), []string{"a", "b"}, map[string][]string{"label": {"app=web"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []string{"a1", "b1", "c1"}) {
+		t.Fatalf("expected ids [a1 b1 c1], got %v", ids)
+	}
+}
+
+func TestContainerUnpauseGroup(t *testing.T) {
+	expectedURL := "/containers/unpause"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`["a1"]`))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	ids, err := client.ContainerUnpauseGroup(context.Background(), []string{"a"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []string{"a1"}) {
+		t.Fatalf("expected ids [a1], got %v", ids)
+	}
+}
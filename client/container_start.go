@@ -19,6 +19,15 @@ func (client *APIClient) ContainerStart(ctx context.Context, name string, option
 	if len(options.CheckpointDir) != 0 {
 		query.Set("checkpoint-dir", options.CheckpointDir)
 	}
+	if options.TCPEstablished {
+		query.Set("tcp-established", "true")
+	}
+	if options.LazyPages {
+		query.Set("lazy-pages", "true")
+	}
+	if len(options.PageServer) != 0 {
+		query.Set("page-server", options.PageServer)
+	}
 
 	resp, err := client.post(ctx, "/containers/"+name+"/start", query, nil, nil)
 	ensureCloseReader(resp)
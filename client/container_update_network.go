@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ContainerUpdateNetwork updates the bandwidth limits of container network.
+func (client *APIClient) ContainerUpdateNetwork(ctx context.Context, name string, bandwidth *types.NetworkBandwidth) error {
+	resp, err := client.post(ctx, "/containers/"+name+"/update-network", url.Values{}, bandwidth, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestContainerUpdateNetworkError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	err := client.ContainerUpdateNetwork(context.Background(), "nothing", &types.NetworkBandwidth{})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestContainerUpdateNetwork(t *testing.T) {
+	expectedURL := "/containers/container_id/update-network"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		bandwidth := &types.NetworkBandwidth{}
+		if err := json.NewDecoder(req.Body).Decode(bandwidth); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if bandwidth.EgressRate != 1024 {
+			return nil, fmt.Errorf("expected egress rate 1024, got %d", bandwidth.EgressRate)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	if err := client.ContainerUpdateNetwork(context.Background(), "container_id", &types.NetworkBandwidth{EgressRate: 1024}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,15 +2,21 @@ package client
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/alibaba/pouch/apis/types"
 )
 
-// ContainerWait pauses execution until a container exits.
-// It returns the API status code as response of its readiness.
-func (client *APIClient) ContainerWait(ctx context.Context, name string) (types.ContainerWaitOKBody, error) {
-	resp, err := client.post(ctx, "/containers/"+name+"/wait", nil, nil, nil)
+// ContainerWait pauses execution until a container satisfies the condition,
+// which is not-running, next-exit or removed, the empty condition means
+// not-running. It returns the API status code as response of its readiness.
+func (client *APIClient) ContainerWait(ctx context.Context, name, condition string) (types.ContainerWaitOKBody, error) {
+	q := url.Values{}
+	if condition != "" {
+		q.Set("condition", condition)
+	}
 
+	resp, err := client.post(ctx, "/containers/"+name+"/wait", q, nil, nil)
 	if err != nil {
 		return types.ContainerWaitOKBody{}, err
 	}
@@ -17,7 +17,7 @@ func TestContainerWaitError(t *testing.T) {
 	client := &APIClient{
 		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
 	}
-	_, err := client.ContainerWait(context.Background(), "nothing")
+	_, err := client.ContainerWait(context.Background(), "nothing", "")
 	if err == nil || !strings.Contains(err.Error(), "Server error") {
 		t.Fatalf("expected a Server Error, got %v", err)
 	}
@@ -27,7 +27,7 @@ func TestContainerWaitNotFoundError(t *testing.T) {
 	client := &APIClient{
 		HTTPCli: newMockClient(errorMockResponse(http.StatusNotFound, "Not Found")),
 	}
-	_, err := client.ContainerWait(context.Background(), "no container")
+	_, err := client.ContainerWait(context.Background(), "no container", "")
 	if err == nil || !strings.Contains(err.Error(), "Not Found") {
 		t.Fatalf("expected a Not Found Error, got %v", err)
 	}
@@ -40,6 +40,9 @@ func TestContainerWait(t *testing.T) {
 		if !strings.HasPrefix(req.URL.Path, expectedURL) {
 			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
 		}
+		if condition := req.URL.Query().Get("condition"); condition != "removed" {
+			return nil, fmt.Errorf("expected condition removed, got %s", condition)
+		}
 		waitJSON := types.ContainerWaitOKBody{
 			Error:      "",
 			StatusCode: 0,
@@ -58,7 +61,7 @@ func TestContainerWait(t *testing.T) {
 		HTTPCli: httpClient,
 	}
 
-	_, err := client.ContainerWait(context.Background(), "container_id")
+	_, err := client.ContainerWait(context.Background(), "container_id", "removed")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+)
+
+// DaemonReload requests daemon to reload daemon config from config file.
+func (client *APIClient) DaemonReload(ctx context.Context) error {
+	resp, err := client.post(ctx, "/daemon/reload", nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	ensureCloseReader(resp)
+	return nil
+}
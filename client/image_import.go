@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/reference"
+)
+
+// ImageImport requests daemon to create an image from the tar archive of rootfs.
+func (client *APIClient) ImageImport(ctx context.Context, ref, message string, reader io.Reader) (types.ImageInfo, error) {
+	namedRef, err := reference.Parse(ref)
+	if err != nil {
+		return types.ImageInfo{}, fmt.Errorf("the image reference (%s) is not valid reference", ref)
+	}
+
+	q := url.Values{}
+	q.Set("repo", namedRef.Name())
+	if tagRef, ok := namedRef.(reference.Tagged); ok {
+		q.Set("tag", tagRef.Tag())
+	}
+	if message != "" {
+		q.Set("message", message)
+	}
+
+	headers := map[string][]string{}
+	headers["Content-Type"] = []string{"application/x-tar"}
+
+	resp, err := client.postRawData(ctx, "/images/import", q, reader, headers)
+	if err != nil {
+		return types.ImageInfo{}, err
+	}
+
+	image := types.ImageInfo{}
+	err = decodeBody(&image, resp.Body)
+	ensureCloseReader(resp)
+
+	return image, err
+}
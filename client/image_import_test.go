@@ -0,0 +1,68 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestImageImportServerError(t *testing.T) {
+	expectedError := "Server error"
+
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, expectedError)),
+	}
+
+	_, err := client.ImageImport(context.Background(), "foo:bar", "", bytes.NewReader(nil))
+	if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected (%v), got (%v)", expectedError, err)
+	}
+}
+
+func TestImageImportOK(t *testing.T) {
+	expectedURL := "/images/import"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		if req.Method != http.MethodPost {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		for key, expected := range map[string]string{"repo": "foo", "tag": "bar", "message": "base"} {
+			if got := req.URL.Query().Get(key); got != expected {
+				return nil, fmt.Errorf("expected %s (%s), got %s", key, expected, got)
+			}
+		}
+
+		b, err := json.Marshal(types.ImageInfo{ID: "sha256:abc"})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	image, err := client.ImageImport(context.Background(), "foo:bar", "base", bytes.NewReader([]byte("rootfs")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.ID != "sha256:abc" {
+		t.Fatalf("expected (sha256:abc), got %s", image.ID)
+	}
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ImagePrune requests daemon to remove the images not used by containers.
+func (client *APIClient) ImagePrune(ctx context.Context, filter filters.Args) (*types.ImagePruneResp, error) {
+	query := url.Values{}
+
+	if filter.Len() > 0 {
+		filtersJSON, err := filters.ToParam(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Set("filters", filtersJSON)
+	}
+
+	resp, err := client.post(ctx, "/images/prune", query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pruneResp := &types.ImagePruneResp{}
+	err = decodeBody(pruneResp, resp.Body)
+	ensureCloseReader(resp)
+
+	return pruneResp, err
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagePruneServerError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.ImagePrune(context.Background(), filters.NewArgs())
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestImagePrune(t *testing.T) {
+	expectedURL := "/images/prune"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		filter, err := filters.FromParam(req.FormValue("filters"))
+		if err != nil {
+			return nil, err
+		}
+		if !filter.ExactMatch("unused", "true") {
+			return nil, fmt.Errorf("expected unused filter, got %s", req.FormValue("filters"))
+		}
+
+		b, err := json.Marshal(types.ImagePruneResp{
+			ImagesDeleted:  []string{"sha256:1"},
+			SpaceReclaimed: 703,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	resp, err := client.ImagePrune(context.Background(), filters.NewArgs(filters.Arg("unused", "true")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"sha256:1"}, resp.ImagesDeleted)
+	assert.Equal(t, int64(703), resp.SpaceReclaimed)
+}
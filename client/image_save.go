@@ -6,10 +6,14 @@ import (
 	"net/url"
 )
 
-// ImageSave requests daemon to save an image to a tar archive.
-func (client *APIClient) ImageSave(ctx context.Context, imageName string) (io.ReadCloser, error) {
+// ImageSave requests daemon to save an image to a tar archive of format,
+// which is oci or docker-archive.
+func (client *APIClient) ImageSave(ctx context.Context, imageName, format string) (io.ReadCloser, error) {
 	q := url.Values{}
 	q.Set("name", imageName)
+	if format != "" {
+		q.Set("format", format)
+	}
 
 	resp, err := client.get(ctx, "/images/save", q, nil)
 	if err != nil {
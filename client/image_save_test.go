@@ -17,7 +17,7 @@ func TestImageSaveServerError(t *testing.T) {
 		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, expectedError)),
 	}
 
-	_, err := client.ImageSave(context.Background(), "test_image_save_500")
+	_, err := client.ImageSave(context.Background(), "test_image_save_500", "")
 	if err == nil || !strings.Contains(err.Error(), expectedError) {
 		t.Fatalf("expected (%v), got (%v)", expectedError, err)
 	}
@@ -40,6 +40,10 @@ func TestImageSaveOK(t *testing.T) {
 			return nil, fmt.Errorf("expected (%s), got %s", expectedImageName, got)
 		}
 
+		if got := req.FormValue("format"); got != "docker-archive" {
+			return nil, fmt.Errorf("expected (docker-archive), got %s", got)
+		}
+
 		return &http.Response{
 			StatusCode: http.StatusOK,
 			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
@@ -50,7 +54,7 @@ func TestImageSaveOK(t *testing.T) {
 		HTTPCli: httpClient,
 	}
 
-	if _, err := client.ImageSave(context.Background(), expectedImageName); err != nil {
+	if _, err := client.ImageSave(context.Background(), expectedImageName, "docker-archive"); err != nil {
 		t.Fatal(err)
 	}
 }
@@ -26,30 +26,39 @@ type ContainerAPIClient interface {
 	ContainerStop(ctx context.Context, name, timeout string) error
 	ContainerRemove(ctx context.Context, name string, options *types.ContainerRemoveOptions) error
 	ContainerList(ctx context.Context, option types.ContainerListOptions) ([]*types.Container, error)
-	ContainerAttach(ctx context.Context, name string, stdin bool) (net.Conn, *bufio.Reader, error)
+	ContainerAttach(ctx context.Context, name string, stdin bool, detachKeys string) (net.Conn, *bufio.Reader, error)
 	ContainerCreateExec(ctx context.Context, name string, config *types.ExecCreateConfig) (*types.ExecCreateResp, error)
 	ContainerStartExec(ctx context.Context, execID string, config *types.ExecStartConfig) (net.Conn, *bufio.Reader, error)
 	ContainerExecInspect(ctx context.Context, execID string) (*types.ContainerExecInspect, error)
 	ContainerExecResize(ctx context.Context, execID string, options types.ResizeOptions) error
+	ContainerExecPrune(ctx context.Context, name string) (*types.ExecPruneResp, error)
 	ContainerGet(ctx context.Context, name string) (*types.ContainerJSON, error)
+	ContainerGetWithSize(ctx context.Context, name string) (*types.ContainerJSON, error)
 	ContainerRename(ctx context.Context, id string, name string) error
 	ContainerRestart(ctx context.Context, name string, timeout string) error
 	ContainerPause(ctx context.Context, name string) error
 	ContainerUnpause(ctx context.Context, name string) error
+	ContainerPauseGroup(ctx context.Context, names []string, filter map[string][]string) ([]string, error)
+	ContainerUnpauseGroup(ctx context.Context, names []string, filter map[string][]string) ([]string, error)
 	ContainerUpdate(ctx context.Context, name string, config *types.UpdateConfig) error
+	ContainerUpdateNetwork(ctx context.Context, name string, bandwidth *types.NetworkBandwidth) error
 	ContainerUpgrade(ctx context.Context, name string, config *types.ContainerUpgradeConfig) error
 	ContainerTop(ctx context.Context, name string, arguments []string) (types.ContainerProcessList, error)
+	ContainerChanges(ctx context.Context, name string) ([]*types.ContainerChangeResponseItem, error)
 	ContainerLogs(ctx context.Context, name string, options types.ContainerLogsOptions) (io.ReadCloser, error)
 	ContainerResize(ctx context.Context, name, height, width string) error
-	ContainerWait(ctx context.Context, name string) (types.ContainerWaitOKBody, error)
+	ContainerWait(ctx context.Context, name, condition string) (types.ContainerWaitOKBody, error)
 	ContainerCheckpointCreate(ctx context.Context, name string, options types.CheckpointCreateOptions) error
 	ContainerCheckpointList(ctx context.Context, name string, options types.CheckpointListOptions) ([]string, error)
 	ContainerCheckpointDelete(ctx context.Context, name string, options types.CheckpointDeleteOptions) error
+	ContainerCheckpointExport(ctx context.Context, name, checkpointID, checkpointDir string) (io.ReadCloser, error)
+	ContainerCheckpointImport(ctx context.Context, name, checkpointID, checkpointDir string, content io.Reader) error
 	ContainerCommit(ctx context.Context, name string, options types.ContainerCommitOptions) (*types.ContainerCommitResp, error)
 	ContainerStats(ctx context.Context, name string, stream bool) (io.ReadCloser, error)
 	ContainerStatPath(ctx context.Context, name string, path string) (types.ContainerPathStat, error)
 	CopyFromContainer(ctx context.Context, container, srcPath string) (io.ReadCloser, types.ContainerPathStat, error)
 	CopyToContainer(ctx context.Context, container, path string, content io.Reader) error
+	ContainerExport(ctx context.Context, name string) (io.ReadCloser, error)
 }
 
 // ImageAPIClient defines methods of Image client.
@@ -58,11 +67,14 @@ type ImageAPIClient interface {
 	ImageInspect(ctx context.Context, name string) (types.ImageInfo, error)
 	ImagePull(ctx context.Context, name, tag, encodedAuth string) (io.ReadCloser, error)
 	ImageRemove(ctx context.Context, name string, force bool) error
+	ImagePrune(ctx context.Context, filter filters.Args) (*types.ImagePruneResp, error)
 	ImageTag(ctx context.Context, image string, tag string) error
 	ImageLoad(ctx context.Context, name string, r io.Reader) error
-	ImageSave(ctx context.Context, imageName string) (io.ReadCloser, error)
+	ImageImport(ctx context.Context, ref, message string, r io.Reader) (types.ImageInfo, error)
+	ImageSave(ctx context.Context, imageName, format string) (io.ReadCloser, error)
 	ImageHistory(ctx context.Context, name string) ([]types.HistoryResultItem, error)
 	ImagePush(ctx context.Context, ref, encodedAuth string) (io.ReadCloser, error)
+	ManifestCreate(ctx context.Context, config *types.ManifestCreateConfig) error
 	ImageSearch(ctx context.Context, term, registry, encodedAuth string) ([]types.SearchResultItem, error)
 }
 
@@ -71,16 +83,25 @@ type VolumeAPIClient interface {
 	VolumeCreate(ctx context.Context, config *types.VolumeCreateConfig) (*types.VolumeInfo, error)
 	VolumeRemove(ctx context.Context, name string) error
 	VolumeInspect(ctx context.Context, name string) (*types.VolumeInfo, error)
+	VolumeInspectWithSize(ctx context.Context, name string) (*types.VolumeInfo, error)
 	VolumeList(ctx context.Context, filter filters.Args) (*types.VolumeListResp, error)
+	VolumeStats(ctx context.Context, name string) (*types.VolumeStats, error)
+	VolumeSnapshotCreate(ctx context.Context, name string, config *types.VolumeSnapshotCreateConfig) (*types.VolumeSnapshot, error)
+	VolumeSnapshotList(ctx context.Context, name string) ([]*types.VolumeSnapshot, error)
+	VolumeSnapshotRemove(ctx context.Context, name, snapshot string) error
+	VolumeClone(ctx context.Context, name string, config *types.VolumeCloneConfig) (*types.VolumeInfo, error)
 }
 
 // SystemAPIClient defines methods of System client.
 type SystemAPIClient interface {
 	SystemPing(ctx context.Context) (string, error)
+	NegotiateAPIVersion(ctx context.Context) error
 	SystemVersion(ctx context.Context) (*types.SystemVersion, error)
 	SystemInfo(ctx context.Context) (*types.SystemInfo, error)
+	SystemDiskUsage(ctx context.Context) (*types.DiskUsage, error)
 	RegistryLogin(ctx context.Context, auth *types.AuthConfig) (*types.AuthResponse, error)
 	DaemonUpdate(ctx context.Context, daemonConfig *types.DaemonUpdateConfig) error
+	DaemonReload(ctx context.Context) error
 	Events(ctx context.Context, since string, until string, filters filters.Args) (io.ReadCloser, error)
 }
 
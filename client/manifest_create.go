@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ManifestCreate creates a manifest list from local images.
+func (client *APIClient) ManifestCreate(ctx context.Context, config *types.ManifestCreateConfig) error {
+	resp, err := client.post(ctx, "/images/manifests/create", nil, config, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
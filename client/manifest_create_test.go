@@ -0,0 +1,62 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestManifestCreateError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusNotFound, "Not Found")),
+	}
+	err := client.ManifestCreate(context.Background(), &types.ManifestCreateConfig{Name: "list", Images: []string{"oops"}})
+	if err == nil || !strings.Contains(err.Error(), "Not Found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestManifestCreate(t *testing.T) {
+	expectedURL := "/images/manifests/create"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != http.MethodPost {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		config := types.ManifestCreateConfig{}
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if config.Name != "list" || len(config.Images) != 2 {
+			return nil, fmt.Errorf("unexpected manifest create config %+v", config)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	err := client.ManifestCreate(context.Background(), &types.ManifestCreateConfig{
+		Name:   "list",
+		Images: []string{"busybox:amd64", "busybox:arm64"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// SystemDiskUsage requests daemon for disk usage of images, containers and volumes.
+func (client *APIClient) SystemDiskUsage(ctx context.Context) (*types.DiskUsage, error) {
+	resp, err := client.get(ctx, "/system/df", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	du := &types.DiskUsage{}
+	err = decodeBody(du, resp.Body)
+	ensureCloseReader(resp)
+
+	return du, err
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemDiskUsageError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.SystemDiskUsage(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestSystemDiskUsage(t *testing.T) {
+	expectedURL := "/system/df"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		du := types.DiskUsage{
+			LayersSize: 1024,
+			Images:     []*types.ImageDiskUsage{{ID: "sha256:1", Size: 2048, Containers: 1}},
+			Containers: []*types.ContainerDiskUsage{{ID: "c1", Image: "sha256:1", SizeRw: 10, SizeRootFs: 2058}},
+			Volumes:    []*types.VolumeDiskUsage{{Name: "v1", Size: -1}},
+		}
+		b, err := json.Marshal(du)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	du, err := client.SystemDiskUsage(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1024), du.LayersSize)
+	assert.Equal(t, int64(2048), du.Images[0].Size)
+	assert.Equal(t, int64(2058), du.Containers[0].SizeRootFs)
+	assert.Equal(t, int64(-1), du.Volumes[0].Size)
+}
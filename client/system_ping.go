@@ -2,7 +2,12 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/httputils"
 )
 
 // SystemPing shows whether server is ok.
@@ -20,3 +25,34 @@ func (client *APIClient) SystemPing(ctx context.Context) (string, error) {
 
 	return string(data), nil
 }
+
+// NegotiateAPIVersion downgrades the api version of client to the max one
+// supported by server, if the server is older than client. The server which
+// doesn't return its api version is treated as compatible.
+func (client *APIClient) NegotiateAPIVersion(ctx context.Context) error {
+	req, err := client.newRequest(http.MethodGet, "/_ping", nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the response of too new client version is an error, but it
+	// still carries the api version of server.
+	resp, err := cancellableDo(ctx, client.HTTPCli, req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	serverVersion := resp.Header.Get(httputils.APIVersionHeader)
+	if serverVersion == "" {
+		return nil
+	}
+	if _, _, err := httputils.ParseAPIVersion(serverVersion); err != nil {
+		return fmt.Errorf("invalid api version of server: %v", err)
+	}
+
+	if httputils.CompareAPIVersion(serverVersion, strings.TrimPrefix(client.version, "v")) < 0 {
+		client.UpdateClientVersion("v" + serverVersion)
+	}
+	return nil
+}
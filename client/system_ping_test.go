@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/alibaba/pouch/pkg/httputils"
 )
 
 func TestSystemPingError(t *testing.T) {
@@ -42,3 +44,39 @@ func TestSystemPing(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	for _, tc := range []struct {
+		clientVersion string
+		serverVersion string
+		expected      string
+	}{
+		{clientVersion: "v1.25", serverVersion: "1.24", expected: "v1.24"},
+		{clientVersion: "v1.24", serverVersion: "1.25", expected: "v1.24"},
+		{clientVersion: "v1.24", serverVersion: "", expected: "v1.24"},
+	} {
+		serverVersion := tc.serverVersion
+		httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+			header := http.Header{}
+			if serverVersion != "" {
+				header.Set(httputils.APIVersionHeader, serverVersion)
+			}
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+			}, nil
+		})
+
+		client := &APIClient{
+			HTTPCli: httpClient,
+			version: tc.clientVersion,
+		}
+		if err := client.NegotiateAPIVersion(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if client.version != tc.expected {
+			t.Fatalf("expected version %s with server version %q, got %s", tc.expected, tc.serverVersion, client.version)
+		}
+	}
+}
@@ -2,13 +2,25 @@ package client
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/alibaba/pouch/apis/types"
 )
 
 // VolumeInspect inspects a volume.
 func (client *APIClient) VolumeInspect(ctx context.Context, name string) (*types.VolumeInfo, error) {
-	resp, err := client.get(ctx, "/volumes/"+name, nil, nil)
+	return client.volumeInspect(ctx, name, nil)
+}
+
+// VolumeInspectWithSize inspects a volume with its usage and capacity.
+func (client *APIClient) VolumeInspectWithSize(ctx context.Context, name string) (*types.VolumeInfo, error) {
+	q := url.Values{}
+	q.Set("size", "true")
+	return client.volumeInspect(ctx, name, q)
+}
+
+func (client *APIClient) volumeInspect(ctx context.Context, name string, query url.Values) (*types.VolumeInfo, error) {
+	resp, err := client.get(ctx, "/volumes/"+name, query, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -70,3 +70,38 @@ func TestVolumeInspect(t *testing.T) {
 	assert.Equal(t, volume.Name, "volume-1")
 	assert.Equal(t, volume.Driver, "local")
 }
+
+func TestVolumeInspectWithSize(t *testing.T) {
+	expectedURL := "/volumes/volume_id"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if size := req.URL.Query().Get("size"); size != "true" {
+			return nil, fmt.Errorf("size not set in URL query properly. Expected 'true', got %s", size)
+		}
+
+		volInspectResp, err := json.Marshal(types.VolumeInfo{
+			Name:  "volume-1",
+			Stats: &types.VolumeStats{Used: 1024},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(volInspectResp))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	volume, err := client.VolumeInspectWithSize(context.Background(), "volume_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1024), volume.Stats.Used)
+}
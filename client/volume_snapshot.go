@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// VolumeSnapshotCreate takes a snapshot of volume.
+func (client *APIClient) VolumeSnapshotCreate(ctx context.Context, name string, config *types.VolumeSnapshotCreateConfig) (*types.VolumeSnapshot, error) {
+	resp, err := client.post(ctx, "/volumes/"+name+"/snapshot", nil, config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &types.VolumeSnapshot{}
+
+	err = decodeBody(snapshot, resp.Body)
+	ensureCloseReader(resp)
+
+	return snapshot, err
+}
+
+// VolumeSnapshotList returns the snapshots of volume.
+func (client *APIClient) VolumeSnapshotList(ctx context.Context, name string) ([]*types.VolumeSnapshot, error) {
+	resp, err := client.get(ctx, "/volumes/"+name+"/snapshots", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*types.VolumeSnapshot
+
+	err = decodeBody(&snapshots, resp.Body)
+	ensureCloseReader(resp)
+
+	return snapshots, err
+}
+
+// VolumeSnapshotRemove removes a snapshot of volume.
+func (client *APIClient) VolumeSnapshotRemove(ctx context.Context, name, snapshot string) error {
+	resp, err := client.delete(ctx, "/volumes/"+name+"/snapshots/"+snapshot, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
+
+// VolumeClone creates a volume from the snapshot of volume.
+func (client *APIClient) VolumeClone(ctx context.Context, name string, config *types.VolumeCloneConfig) (*types.VolumeInfo, error) {
+	resp, err := client.post(ctx, "/volumes/"+name+"/clone", nil, config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	volume := &types.VolumeInfo{}
+
+	err = decodeBody(volume, resp.Body)
+	ensureCloseReader(resp)
+
+	return volume, err
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeSnapshotCreateError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.VolumeSnapshotCreate(context.Background(), "volume", &types.VolumeSnapshotCreateConfig{Name: "snap"})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestVolumeSnapshotCreate(t *testing.T) {
+	expectedURL := "/volumes/volume/snapshot"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		config := types.VolumeSnapshotCreateConfig{}
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+
+		b, err := json.Marshal(types.VolumeSnapshot{Name: config.Name, Volume: "volume", Driver: "lvm"})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	snapshot, err := client.VolumeSnapshotCreate(context.Background(), "volume", &types.VolumeSnapshotCreateConfig{Name: "snap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &types.VolumeSnapshot{Name: "snap", Volume: "volume", Driver: "lvm"}, snapshot)
+}
+
+func TestVolumeSnapshotList(t *testing.T) {
+	expectedURL := "/volumes/volume/snapshots"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		b, err := json.Marshal([]*types.VolumeSnapshot{{Name: "snap", Volume: "volume"}})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	snapshots, err := client.VolumeSnapshotList(context.Background(), "volume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []*types.VolumeSnapshot{{Name: "snap", Volume: "volume"}}, snapshots)
+}
+
+func TestVolumeSnapshotRemove(t *testing.T) {
+	expectedURL := "/volumes/volume/snapshots/snap"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "DELETE" {
+			return nil, fmt.Errorf("expected DELETE method, got %s", req.Method)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if err := client.VolumeSnapshotRemove(context.Background(), "volume", "snap"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVolumeClone(t *testing.T) {
+	expectedURL := "/volumes/volume/clone"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		config := types.VolumeCloneConfig{}
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if config.Snapshot != "snap" {
+			return nil, fmt.Errorf("expected snapshot snap, got %s", config.Snapshot)
+		}
+
+		b, err := json.Marshal(types.VolumeInfo{Name: config.Name, Driver: "lvm"})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	volume, err := client.VolumeClone(context.Background(), "volume", &types.VolumeCloneConfig{Name: "clone", Snapshot: "snap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "clone", volume.Name)
+	assert.Equal(t, "lvm", volume.Driver)
+}
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// VolumeStats returns the usage and capacity of a volume.
+func (client *APIClient) VolumeStats(ctx context.Context, name string) (*types.VolumeStats, error) {
+	resp, err := client.get(ctx, "/volumes/"+name+"/stats", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &types.VolumeStats{}
+
+	err = decodeBody(stats, resp.Body)
+	ensureCloseReader(resp)
+
+	return stats, err
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeStatsServerError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.VolumeStats(context.Background(), "volume_id")
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestVolumeStats(t *testing.T) {
+	expectedURL := "/volumes/volume_id/stats"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != expectedURL {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+
+		statsResp, err := json.Marshal(types.VolumeStats{
+			Used:      1024,
+			Available: 3072,
+			Capacity:  4096,
+			Inodes:    -1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(statsResp))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	stats, err := client.VolumeStats(context.Background(), "volume_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1024), stats.Used)
+	assert.Equal(t, int64(4096), stats.Capacity)
+	assert.Equal(t, int64(-1), stats.Inodes)
+}
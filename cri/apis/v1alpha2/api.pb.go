@@ -137,6 +137,17 @@ limitations under the License.
 		RemoveVolumeResponse
 		StartPodSandboxRequest
 		StartPodSandboxResponse
+		PodSandboxStatsRequest
+		PodSandboxStatsResponse
+		PodSandboxStatsFilter
+		ListPodSandboxStatsRequest
+		ListPodSandboxStatsResponse
+		PodSandboxAttributes
+		PodSandboxStats
+		LinuxPodSandboxStats
+		NetworkUsage
+		NetworkInterfaceUsage
+		ProcessUsage
 */
 package v1alpha2
 
@@ -4041,6 +4052,341 @@ func (m *StartPodSandboxResponse) Reset()                    { *m = StartPodSand
 func (*StartPodSandboxResponse) ProtoMessage()               {}
 func (*StartPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{113} }
 
+type PodSandboxStatsRequest struct {
+	// ID of the pod sandbox for which to retrieve stats.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id,json=podSandboxId,proto3" json:"pod_sandbox_id,omitempty"`
+}
+
+func (m *PodSandboxStatsRequest) Reset()                    { *m = PodSandboxStatsRequest{} }
+func (*PodSandboxStatsRequest) ProtoMessage()               {}
+func (*PodSandboxStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{114} }
+
+func (m *PodSandboxStatsRequest) GetPodSandboxId() string {
+	if m != nil {
+		return m.PodSandboxId
+	}
+	return ""
+}
+
+type PodSandboxStatsResponse struct {
+	// Stats of the pod sandbox.
+	Stats *PodSandboxStats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
+}
+
+func (m *PodSandboxStatsResponse) Reset()                    { *m = PodSandboxStatsResponse{} }
+func (*PodSandboxStatsResponse) ProtoMessage()               {}
+func (*PodSandboxStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{115} }
+
+func (m *PodSandboxStatsResponse) GetStats() *PodSandboxStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// PodSandboxStatsFilter is used to filter pod sandboxes.
+// All those fields are combined with 'AND'.
+type PodSandboxStatsFilter struct {
+	// ID of the pod sandbox.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// LabelSelector to select matches.
+	// Only api.MatchLabels is supported for now and the requirements
+	// are ANDed. MatchExpressions is not supported yet.
+	LabelSelector map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PodSandboxStatsFilter) Reset()                    { *m = PodSandboxStatsFilter{} }
+func (*PodSandboxStatsFilter) ProtoMessage()               {}
+func (*PodSandboxStatsFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{116} }
+
+func (m *PodSandboxStatsFilter) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PodSandboxStatsFilter) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+type ListPodSandboxStatsRequest struct {
+	// Filter for the list request.
+	Filter *PodSandboxStatsFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *ListPodSandboxStatsRequest) Reset()                    { *m = ListPodSandboxStatsRequest{} }
+func (*ListPodSandboxStatsRequest) ProtoMessage()               {}
+func (*ListPodSandboxStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{117} }
+
+func (m *ListPodSandboxStatsRequest) GetFilter() *PodSandboxStatsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type ListPodSandboxStatsResponse struct {
+	// Stats of the pod sandbox.
+	Stats []*PodSandboxStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *ListPodSandboxStatsResponse) Reset()      { *m = ListPodSandboxStatsResponse{} }
+func (*ListPodSandboxStatsResponse) ProtoMessage() {}
+func (*ListPodSandboxStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{118}
+}
+
+func (m *ListPodSandboxStatsResponse) GetStats() []*PodSandboxStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// PodSandboxAttributes provides basic information of the pod sandbox.
+type PodSandboxAttributes struct {
+	// ID of the pod sandbox.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Metadata of the pod sandbox.
+	Metadata *PodSandboxMetadata `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding PodSandboxStatus used to
+	// instantiate the PodSandbox this status represents.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PodSandboxAttributes) Reset()                    { *m = PodSandboxAttributes{} }
+func (*PodSandboxAttributes) ProtoMessage()               {}
+func (*PodSandboxAttributes) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{119} }
+
+func (m *PodSandboxAttributes) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PodSandboxAttributes) GetMetadata() *PodSandboxMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PodSandboxAttributes) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PodSandboxAttributes) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+// PodSandboxStats provides the resource usage statistics for a pod.
+type PodSandboxStats struct {
+	// Information of the pod.
+	Attributes *PodSandboxAttributes `protobuf:"bytes,1,opt,name=attributes" json:"attributes,omitempty"`
+	// Stats from linux.
+	Linux *LinuxPodSandboxStats `protobuf:"bytes,2,opt,name=linux" json:"linux,omitempty"`
+}
+
+func (m *PodSandboxStats) Reset()                    { *m = PodSandboxStats{} }
+func (*PodSandboxStats) ProtoMessage()               {}
+func (*PodSandboxStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{120} }
+
+func (m *PodSandboxStats) GetAttributes() *PodSandboxAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *PodSandboxStats) GetLinux() *LinuxPodSandboxStats {
+	if m != nil {
+		return m.Linux
+	}
+	return nil
+}
+
+// LinuxPodSandboxStats provides the resource usage statistics for a pod sandbox on linux.
+type LinuxPodSandboxStats struct {
+	// CPU usage gathered for the pod sandbox.
+	Cpu *CpuUsage `protobuf:"bytes,1,opt,name=cpu" json:"cpu,omitempty"`
+	// Memory usage gathered for the pod sandbox.
+	Memory *MemoryUsage `protobuf:"bytes,2,opt,name=memory" json:"memory,omitempty"`
+	// Network usage gathered for the pod sandbox.
+	Network *NetworkUsage `protobuf:"bytes,3,opt,name=network" json:"network,omitempty"`
+	// Stats pertaining to processes in the pod sandbox.
+	Process *ProcessUsage `protobuf:"bytes,4,opt,name=process" json:"process,omitempty"`
+	// Stats of containers in the measured pod sandbox.
+	Containers []*ContainerStats `protobuf:"bytes,5,rep,name=containers" json:"containers,omitempty"`
+}
+
+func (m *LinuxPodSandboxStats) Reset()                    { *m = LinuxPodSandboxStats{} }
+func (*LinuxPodSandboxStats) ProtoMessage()               {}
+func (*LinuxPodSandboxStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{121} }
+
+func (m *LinuxPodSandboxStats) GetCpu() *CpuUsage {
+	if m != nil {
+		return m.Cpu
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetMemory() *MemoryUsage {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetNetwork() *NetworkUsage {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetProcess() *ProcessUsage {
+	if m != nil {
+		return m.Process
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetContainers() []*ContainerStats {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+// NetworkUsage contains data about network resources.
+type NetworkUsage struct {
+	// The time at which these stats were updated.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Stats for the default network interface.
+	DefaultInterface *NetworkInterfaceUsage `protobuf:"bytes,2,opt,name=default_interface,json=defaultInterface" json:"default_interface,omitempty"`
+	// Stats for all found network interfaces, excluding the default.
+	Interfaces []*NetworkInterfaceUsage `protobuf:"bytes,3,rep,name=interfaces" json:"interfaces,omitempty"`
+}
+
+func (m *NetworkUsage) Reset()                    { *m = NetworkUsage{} }
+func (*NetworkUsage) ProtoMessage()               {}
+func (*NetworkUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{122} }
+
+func (m *NetworkUsage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *NetworkUsage) GetDefaultInterface() *NetworkInterfaceUsage {
+	if m != nil {
+		return m.DefaultInterface
+	}
+	return nil
+}
+
+func (m *NetworkUsage) GetInterfaces() []*NetworkInterfaceUsage {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+// NetworkInterfaceUsage contains resource value data about a network interface.
+type NetworkInterfaceUsage struct {
+	// The name of the network interface.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Cumulative count of bytes received.
+	RxBytes *UInt64Value `protobuf:"bytes,2,opt,name=rx_bytes,json=rxBytes" json:"rx_bytes,omitempty"`
+	// Cumulative count of receive errors encountered.
+	RxErrors *UInt64Value `protobuf:"bytes,3,opt,name=rx_errors,json=rxErrors" json:"rx_errors,omitempty"`
+	// Cumulative count of bytes transmitted.
+	TxBytes *UInt64Value `protobuf:"bytes,4,opt,name=tx_bytes,json=txBytes" json:"tx_bytes,omitempty"`
+	// Cumulative count of transmit errors encountered.
+	TxErrors *UInt64Value `protobuf:"bytes,5,opt,name=tx_errors,json=txErrors" json:"tx_errors,omitempty"`
+}
+
+func (m *NetworkInterfaceUsage) Reset()                    { *m = NetworkInterfaceUsage{} }
+func (*NetworkInterfaceUsage) ProtoMessage()               {}
+func (*NetworkInterfaceUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{123} }
+
+func (m *NetworkInterfaceUsage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkInterfaceUsage) GetRxBytes() *UInt64Value {
+	if m != nil {
+		return m.RxBytes
+	}
+	return nil
+}
+
+func (m *NetworkInterfaceUsage) GetRxErrors() *UInt64Value {
+	if m != nil {
+		return m.RxErrors
+	}
+	return nil
+}
+
+func (m *NetworkInterfaceUsage) GetTxBytes() *UInt64Value {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *NetworkInterfaceUsage) GetTxErrors() *UInt64Value {
+	if m != nil {
+		return m.TxErrors
+	}
+	return nil
+}
+
+// ProcessUsage are stats pertaining to processes.
+type ProcessUsage struct {
+	// The time at which these stats were updated.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of processes.
+	ProcessCount *UInt64Value `protobuf:"bytes,2,opt,name=process_count,json=processCount" json:"process_count,omitempty"`
+}
+
+func (m *ProcessUsage) Reset()                    { *m = ProcessUsage{} }
+func (*ProcessUsage) ProtoMessage()               {}
+func (*ProcessUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{124} }
+
+func (m *ProcessUsage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ProcessUsage) GetProcessCount() *UInt64Value {
+	if m != nil {
+		return m.ProcessCount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VersionRequest)(nil), "runtime.v1alpha2.VersionRequest")
 	proto.RegisterType((*VersionResponse)(nil), "runtime.v1alpha2.VersionResponse")
@@ -4156,6 +4502,17 @@ func init() {
 	proto.RegisterType((*RemoveVolumeResponse)(nil), "runtime.v1alpha2.RemoveVolumeResponse")
 	proto.RegisterType((*StartPodSandboxRequest)(nil), "runtime.v1alpha2.StartPodSandboxRequest")
 	proto.RegisterType((*StartPodSandboxResponse)(nil), "runtime.v1alpha2.StartPodSandboxResponse")
+	proto.RegisterType((*PodSandboxStatsRequest)(nil), "runtime.v1alpha2.PodSandboxStatsRequest")
+	proto.RegisterType((*PodSandboxStatsResponse)(nil), "runtime.v1alpha2.PodSandboxStatsResponse")
+	proto.RegisterType((*PodSandboxStatsFilter)(nil), "runtime.v1alpha2.PodSandboxStatsFilter")
+	proto.RegisterType((*ListPodSandboxStatsRequest)(nil), "runtime.v1alpha2.ListPodSandboxStatsRequest")
+	proto.RegisterType((*ListPodSandboxStatsResponse)(nil), "runtime.v1alpha2.ListPodSandboxStatsResponse")
+	proto.RegisterType((*PodSandboxAttributes)(nil), "runtime.v1alpha2.PodSandboxAttributes")
+	proto.RegisterType((*PodSandboxStats)(nil), "runtime.v1alpha2.PodSandboxStats")
+	proto.RegisterType((*LinuxPodSandboxStats)(nil), "runtime.v1alpha2.LinuxPodSandboxStats")
+	proto.RegisterType((*NetworkUsage)(nil), "runtime.v1alpha2.NetworkUsage")
+	proto.RegisterType((*NetworkInterfaceUsage)(nil), "runtime.v1alpha2.NetworkInterfaceUsage")
+	proto.RegisterType((*ProcessUsage)(nil), "runtime.v1alpha2.ProcessUsage")
 	proto.RegisterEnum("runtime.v1alpha2.Protocol", Protocol_name, Protocol_value)
 	proto.RegisterEnum("runtime.v1alpha2.MountPropagation", MountPropagation_name, MountPropagation_value)
 	proto.RegisterEnum("runtime.v1alpha2.NamespaceMode", NamespaceMode_name, NamespaceMode_value)
@@ -4243,6 +4600,11 @@ type RuntimeServiceClient interface {
 	ContainerStats(ctx context.Context, in *ContainerStatsRequest, opts ...grpc.CallOption) (*ContainerStatsResponse, error)
 	// ListContainerStats returns stats of all running containers.
 	ListContainerStats(ctx context.Context, in *ListContainerStatsRequest, opts ...grpc.CallOption) (*ListContainerStatsResponse, error)
+	// PodSandboxStats returns stats of the pod sandbox. If the pod sandbox does not
+	// exist, the call returns an error.
+	PodSandboxStats(ctx context.Context, in *PodSandboxStatsRequest, opts ...grpc.CallOption) (*PodSandboxStatsResponse, error)
+	// ListPodSandboxStats returns stats of the pod sandboxes matching a filter.
+	ListPodSandboxStats(ctx context.Context, in *ListPodSandboxStatsRequest, opts ...grpc.CallOption) (*ListPodSandboxStatsResponse, error)
 	// UpdateRuntimeConfig updates the runtime configuration based on the given request.
 	UpdateRuntimeConfig(ctx context.Context, in *UpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*UpdateRuntimeConfigResponse, error)
 	// Status returns the status of the runtime.
@@ -4459,6 +4821,24 @@ func (c *runtimeServiceClient) ListContainerStats(ctx context.Context, in *ListC
 	return out, nil
 }
 
+func (c *runtimeServiceClient) PodSandboxStats(ctx context.Context, in *PodSandboxStatsRequest, opts ...grpc.CallOption) (*PodSandboxStatsResponse, error) {
+	out := new(PodSandboxStatsResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/PodSandboxStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListPodSandboxStats(ctx context.Context, in *ListPodSandboxStatsRequest, opts ...grpc.CallOption) (*ListPodSandboxStatsResponse, error) {
+	out := new(ListPodSandboxStatsResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ListPodSandboxStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) UpdateRuntimeConfig(ctx context.Context, in *UpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*UpdateRuntimeConfigResponse, error) {
 	out := new(UpdateRuntimeConfigResponse)
 	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/UpdateRuntimeConfig", in, out, c.cc, opts...)
@@ -4558,6 +4938,11 @@ type RuntimeServiceServer interface {
 	ContainerStats(context.Context, *ContainerStatsRequest) (*ContainerStatsResponse, error)
 	// ListContainerStats returns stats of all running containers.
 	ListContainerStats(context.Context, *ListContainerStatsRequest) (*ListContainerStatsResponse, error)
+	// PodSandboxStats returns stats of the pod sandbox. If the pod sandbox does not
+	// exist, the call returns an error.
+	PodSandboxStats(context.Context, *PodSandboxStatsRequest) (*PodSandboxStatsResponse, error)
+	// ListPodSandboxStats returns stats of the pod sandboxes matching a filter.
+	ListPodSandboxStats(context.Context, *ListPodSandboxStatsRequest) (*ListPodSandboxStatsResponse, error)
 	// UpdateRuntimeConfig updates the runtime configuration based on the given request.
 	UpdateRuntimeConfig(context.Context, *UpdateRuntimeConfigRequest) (*UpdateRuntimeConfigResponse, error)
 	// Status returns the status of the runtime.
@@ -4968,6 +5353,42 @@ func _RuntimeService_ListContainerStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_PodSandboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSandboxStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PodSandboxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha2.RuntimeService/PodSandboxStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PodSandboxStats(ctx, req.(*PodSandboxStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListPodSandboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodSandboxStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListPodSandboxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha2.RuntimeService/ListPodSandboxStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListPodSandboxStats(ctx, req.(*ListPodSandboxStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_UpdateRuntimeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRuntimeConfigRequest)
 	if err := dec(in); err != nil {
@@ -5114,6 +5535,14 @@ var _RuntimeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListContainerStats",
 			Handler:    _RuntimeService_ListContainerStats_Handler,
 		},
+		{
+			MethodName: "PodSandboxStats",
+			Handler:    _RuntimeService_PodSandboxStats_Handler,
+		},
+		{
+			MethodName: "ListPodSandboxStats",
+			Handler:    _RuntimeService_ListPodSandboxStats_Handler,
+		},
 		{
 			MethodName: "UpdateRuntimeConfig",
 			Handler:    _RuntimeService_UpdateRuntimeConfig_Handler,
@@ -10162,910 +10591,863 @@ func (m *StartPodSandboxResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *VersionRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (m *PodSandboxStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VersionResponse) Size() (n int) {
+func (m *PodSandboxStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.RuntimeName)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.RuntimeVersion)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.PodSandboxId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.PodSandboxId)))
+		i += copy(dAtA[i:], m.PodSandboxId)
 	}
-	l = len(m.RuntimeApiVersion)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	return i, nil
+}
+
+func (m *PodSandboxStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DNSConfig) Size() (n int) {
+func (m *PodSandboxStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Servers) > 0 {
-		for _, s := range m.Servers {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Searches) > 0 {
-		for _, s := range m.Searches {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
+	if m.Stats != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Stats.Size()))
+		n76, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n76
 	}
-	return n
+	return i, nil
 }
 
-func (m *PortMapping) Size() (n int) {
-	var l int
-	_ = l
-	if m.Protocol != 0 {
-		n += 1 + sovApi(uint64(m.Protocol))
-	}
-	if m.ContainerPort != 0 {
-		n += 1 + sovApi(uint64(m.ContainerPort))
-	}
-	if m.HostPort != 0 {
-		n += 1 + sovApi(uint64(m.HostPort))
-	}
-	l = len(m.HostIp)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (m *PodSandboxStatsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Mount) Size() (n int) {
+func (m *PodSandboxStatsFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.HostPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Readonly {
-		n += 2
-	}
-	if m.SelinuxRelabel {
-		n += 2
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if m.Propagation != 0 {
-		n += 1 + sovApi(uint64(m.Propagation))
+	if len(m.LabelSelector) > 0 {
+		for k := range m.LabelSelector {
+			dAtA[i] = 0x12
+			i++
+			v := m.LabelSelector[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 2 + l + sovApi(uint64(l))
+	return i, nil
+}
+
+func (m *ListPodSandboxStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NamespaceOption) Size() (n int) {
+func (m *ListPodSandboxStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Network != 0 {
-		n += 1 + sovApi(uint64(m.Network))
-	}
-	if m.Pid != 0 {
-		n += 1 + sovApi(uint64(m.Pid))
-	}
-	if m.Ipc != 0 {
-		n += 1 + sovApi(uint64(m.Ipc))
+	if m.Filter != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Filter.Size()))
+		n77, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
 	}
-	return n
+	return i, nil
 }
 
-func (m *Int64Value) Size() (n int) {
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovApi(uint64(m.Value))
+func (m *ListPodSandboxStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LinuxSandboxSecurityContext) Size() (n int) {
+func (m *ListPodSandboxStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NamespaceOptions != nil {
-		l = m.NamespaceOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SelinuxOptions != nil {
-		l = m.SelinuxOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.RunAsUser != nil {
-		l = m.RunAsUser.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.ReadonlyRootfs {
-		n += 2
-	}
-	if len(m.SupplementalGroups) > 0 {
-		l = 0
-		for _, e := range m.SupplementalGroups {
-			l += sovApi(uint64(e))
+	if len(m.Stats) > 0 {
+		for _, msg := range m.Stats {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		n += 1 + sovApi(uint64(l)) + l
-	}
-	if m.Privileged {
-		n += 2
-	}
-	l = len(m.SeccompProfilePath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.RunAsGroup != nil {
-		l = m.RunAsGroup.Size()
-		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
+	return i, nil
 }
 
-func (m *LinuxPodSandboxConfig) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.CgroupParent)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.Sysctls) > 0 {
-		for k, v := range m.Sysctls {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+func (m *PodSandboxAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PodSandboxMetadata) Size() (n int) {
+func (m *PodSandboxAttributes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovApi(uint64(m.Attempt))
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	return n
-}
-
-func (m *PodSandboxConfig) Size() (n int) {
-	var l int
-	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.LogDirectory)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.DnsConfig != nil {
-		l = m.DnsConfig.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.PortMappings) > 0 {
-		for _, e := range m.PortMappings {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
+		n78, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n78
 	}
 	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		for k := range m.Labels {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
 	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		for k := range m.Annotations {
+			dAtA[i] = 0x22
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *RunPodSandboxRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.RuntimeHandler)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (m *PodSandboxStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RunPodSandboxResponse) Size() (n int) {
+func (m *PodSandboxStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Attributes != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Attributes.Size()))
+		n79, err := m.Attributes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
-	return n
-}
-
-func (m *StopPodSandboxRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Linux != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Linux.Size()))
+		n80, err := m.Linux.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
 	}
-	return n
+	return i, nil
 }
 
-func (m *StopPodSandboxResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *LinuxPodSandboxStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *RemovePodSandboxRequest) Size() (n int) {
+func (m *LinuxPodSandboxStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Cpu != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Cpu.Size()))
+		n81, err := m.Cpu.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
 	}
-	return n
+	if m.Memory != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Memory.Size()))
+		n82, err := m.Memory.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	if m.Network != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Network.Size()))
+		n83, err := m.Network.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if m.Process != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Process.Size()))
+		n84, err := m.Process.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if len(m.Containers) > 0 {
+		for _, msg := range m.Containers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *RemovePodSandboxResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *NetworkUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *PodSandboxStatusRequest) Size() (n int) {
+func (m *NetworkUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
 	}
-	if m.Verbose {
-		n += 2
+	if m.DefaultInterface != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DefaultInterface.Size()))
+		n85, err := m.DefaultInterface.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
 	}
-	return n
+	if len(m.Interfaces) > 0 {
+		for _, msg := range m.Interfaces {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *PodSandboxNetworkStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Ip)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (m *NetworkInterfaceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Namespace) Size() (n int) {
+func (m *NetworkInterfaceUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Options != nil {
-		l = m.Options.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return n
+	if m.RxBytes != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.RxBytes.Size()))
+		n86, err := m.RxBytes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	if m.RxErrors != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.RxErrors.Size()))
+		n87, err := m.RxErrors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n87
+	}
+	if m.TxBytes != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxBytes.Size()))
+		n88, err := m.TxBytes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n88
+	}
+	if m.TxErrors != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxErrors.Size()))
+		n89, err := m.TxErrors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
+	}
+	return i, nil
 }
 
-func (m *LinuxPodSandboxStatus) Size() (n int) {
+func (m *ProcessUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Namespaces != nil {
-		l = m.Namespaces.Size()
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.ProcessCount != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ProcessCount.Size()))
+		n90, err := m.ProcessCount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
+	}
+	return i, nil
+}
+
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *VersionRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *PodSandboxStatus) Size() (n int) {
+func (m *VersionResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Version)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.RuntimeName)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApi(uint64(m.CreatedAt))
-	}
-	if m.Network != nil {
-		l = m.Network.Size()
+	l = len(m.RuntimeVersion)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
+	l = len(m.RuntimeApiVersion)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
 	return n
 }
 
-func (m *PodSandboxStatusResponse) Size() (n int) {
+func (m *DNSConfig) Size() (n int) {
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Servers) > 0 {
+		for _, s := range m.Servers {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+	if len(m.Searches) > 0 {
+		for _, s := range m.Searches {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *PodSandboxStateValue) Size() (n int) {
-	var l int
-	_ = l
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *PodSandboxFilter) Size() (n int) {
+func (m *PortMapping) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Protocol != 0 {
+		n += 1 + sovApi(uint64(m.Protocol))
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.ContainerPort != 0 {
+		n += 1 + sovApi(uint64(m.ContainerPort))
 	}
-	if len(m.LabelSelector) > 0 {
-		for k, v := range m.LabelSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if m.HostPort != 0 {
+		n += 1 + sovApi(uint64(m.HostPort))
 	}
-	return n
-}
-
-func (m *ListPodSandboxRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
+	l = len(m.HostIp)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *PodSandbox) Size() (n int) {
+func (m *Mount) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.ContainerPath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.HostPath)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
+	if m.Readonly {
+		n += 2
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApi(uint64(m.CreatedAt))
+	if m.SelinuxRelabel {
+		n += 2
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if m.Propagation != 0 {
+		n += 1 + sovApi(uint64(m.Propagation))
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	l = len(m.Name)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ListPodSandboxResponse) Size() (n int) {
+func (m *NamespaceOption) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.Network != 0 {
+		n += 1 + sovApi(uint64(m.Network))
+	}
+	if m.Pid != 0 {
+		n += 1 + sovApi(uint64(m.Pid))
+	}
+	if m.Ipc != 0 {
+		n += 1 + sovApi(uint64(m.Ipc))
 	}
 	return n
 }
 
-func (m *ImageSpec) Size() (n int) {
+func (m *Int64Value) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Value != 0 {
+		n += 1 + sovApi(uint64(m.Value))
 	}
 	return n
 }
 
-func (m *KeyValue) Size() (n int) {
+func (m *LinuxSandboxSecurityContext) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
+	if m.NamespaceOptions != nil {
+		l = m.NamespaceOptions.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Value)
-	if l > 0 {
+	if m.SelinuxOptions != nil {
+		l = m.SelinuxOptions.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *LinuxContainerResources) Size() (n int) {
-	var l int
-	_ = l
-	if m.CpuPeriod != 0 {
-		n += 1 + sovApi(uint64(m.CpuPeriod))
+	if m.RunAsUser != nil {
+		l = m.RunAsUser.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.CpuQuota != 0 {
-		n += 1 + sovApi(uint64(m.CpuQuota))
-	}
-	if m.CpuShares != 0 {
-		n += 1 + sovApi(uint64(m.CpuShares))
+	if m.ReadonlyRootfs {
+		n += 2
 	}
-	if m.MemoryLimitInBytes != 0 {
-		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
+	if len(m.SupplementalGroups) > 0 {
+		l = 0
+		for _, e := range m.SupplementalGroups {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
 	}
-	if m.OomScoreAdj != 0 {
-		n += 1 + sovApi(uint64(m.OomScoreAdj))
+	if m.Privileged {
+		n += 2
 	}
-	l = len(m.CpusetCpus)
+	l = len(m.SeccompProfilePath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.CpusetMems)
-	if l > 0 {
+	if m.RunAsGroup != nil {
+		l = m.RunAsGroup.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.DiskQuota) > 0 {
-		for k, v := range m.DiskQuota {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	if m.BlkioWeight != 0 {
-		n += 2 + sovApi(uint64(m.BlkioWeight))
-	}
-	if len(m.BlkioWeightDevice) > 0 {
-		for _, e := range m.BlkioWeightDevice {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceReadBps) > 0 {
-		for _, e := range m.BlkioDeviceReadBps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceWriteBps) > 0 {
-		for _, e := range m.BlkioDeviceWriteBps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceRead_IOps) > 0 {
-		for _, e := range m.BlkioDeviceRead_IOps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceWrite_IOps) > 0 {
-		for _, e := range m.BlkioDeviceWrite_IOps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if m.KernelMemory != 0 {
-		n += 2 + sovApi(uint64(m.KernelMemory))
-	}
-	if m.MemoryReservation != 0 {
-		n += 2 + sovApi(uint64(m.MemoryReservation))
-	}
-	if m.MemorySwappiness != nil {
-		l = m.MemorySwappiness.Size()
-		n += 2 + l + sovApi(uint64(l))
-	}
-	if len(m.Ulimits) > 0 {
-		for _, e := range m.Ulimits {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *WeightDevice) Size() (n int) {
+func (m *LinuxPodSandboxConfig) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.CgroupParent)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Weight != 0 {
-		n += 1 + sovApi(uint64(m.Weight))
-	}
-	return n
-}
-
-func (m *ThrottleDevice) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Rate != 0 {
-		n += 1 + sovApi(uint64(m.Rate))
+	if len(m.Sysctls) > 0 {
+		for k, v := range m.Sysctls {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *Ulimit) Size() (n int) {
+func (m *PodSandboxMetadata) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Hard != 0 {
-		n += 1 + sovApi(uint64(m.Hard))
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Soft != 0 {
-		n += 1 + sovApi(uint64(m.Soft))
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovApi(uint64(m.Attempt))
 	}
 	return n
 }
 
-func (m *SELinuxOption) Size() (n int) {
+func (m *PodSandboxConfig) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.User)
-	if l > 0 {
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Role)
+	l = len(m.Hostname)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Type)
+	l = len(m.LogDirectory)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Level)
-	if l > 0 {
+	if m.DnsConfig != nil {
+		l = m.DnsConfig.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *Capability) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AddCapabilities) > 0 {
-		for _, s := range m.AddCapabilities {
-			l = len(s)
+	if len(m.PortMappings) > 0 {
+		for _, e := range m.PortMappings {
+			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	if len(m.DropCapabilities) > 0 {
-		for _, s := range m.DropCapabilities {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *LinuxContainerSecurityContext) Size() (n int) {
+func (m *RunPodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Capabilities != nil {
-		l = m.Capabilities.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Privileged {
-		n += 2
-	}
-	if m.NamespaceOptions != nil {
-		l = m.NamespaceOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SelinuxOptions != nil {
-		l = m.SelinuxOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.RunAsUser != nil {
-		l = m.RunAsUser.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.RunAsUsername)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.ReadonlyRootfs {
-		n += 2
-	}
-	if len(m.SupplementalGroups) > 0 {
-		l = 0
-		for _, e := range m.SupplementalGroups {
-			l += sovApi(uint64(e))
-		}
-		n += 1 + sovApi(uint64(l)) + l
-	}
-	l = len(m.ApparmorProfile)
-	if l > 0 {
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.SeccompProfilePath)
+	l = len(m.RuntimeHandler)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.NoNewPrivs {
-		n += 2
-	}
-	if m.RunAsGroup != nil {
-		l = m.RunAsGroup.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.MaskedPaths) > 0 {
-		for _, s := range m.MaskedPaths {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.ReadonlyPaths) > 0 {
-		for _, s := range m.ReadonlyPaths {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *LinuxContainerConfig) Size() (n int) {
+func (m *RunPodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
+	l = len(m.PodSandboxId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *WindowsContainerSecurityContext) Size() (n int) {
+func (m *StopPodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.RunAsUsername)
+	l = len(m.PodSandboxId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *WindowsContainerConfig) Size() (n int) {
+func (m *StopPodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
 	return n
 }
 
-func (m *WindowsContainerResources) Size() (n int) {
+func (m *RemovePodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.CpuShares != 0 {
-		n += 1 + sovApi(uint64(m.CpuShares))
-	}
-	if m.CpuCount != 0 {
-		n += 1 + sovApi(uint64(m.CpuCount))
-	}
-	if m.CpuMaximum != 0 {
-		n += 1 + sovApi(uint64(m.CpuMaximum))
-	}
-	if m.MemoryLimitInBytes != 0 {
-		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ContainerMetadata) Size() (n int) {
+func (m *RemovePodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	return n
+}
+
+func (m *PodSandboxStatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodSandboxId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovApi(uint64(m.Attempt))
+	if m.Verbose {
+		n += 2
 	}
 	return n
 }
 
-func (m *Device) Size() (n int) {
+func (m *PodSandboxNetworkStatus) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerPath)
+	l = len(m.Ip)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.HostPath)
-	if l > 0 {
+	return n
+}
+
+func (m *Namespace) Size() (n int) {
+	var l int
+	_ = l
+	if m.Options != nil {
+		l = m.Options.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Permissions)
-	if l > 0 {
+	return n
+}
+
+func (m *LinuxPodSandboxStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Namespaces != nil {
+		l = m.Namespaces.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ContainerConfig) Size() (n int) {
+func (m *PodSandboxStatus) Size() (n int) {
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.Id)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Command) > 0 {
-		for _, s := range m.Command {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
 	}
-	l = len(m.WorkingDir)
-	if l > 0 {
+	if m.Network != nil {
+		l = m.Network.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Envs) > 0 {
-		for _, e := range m.Envs {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Mounts) > 0 {
-		for _, e := range m.Mounts {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
 	if len(m.Labels) > 0 {
 		for k, v := range m.Labels {
@@ -11083,117 +11465,28 @@ func (m *ContainerConfig) Size() (n int) {
 			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	l = len(m.LogPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Stdin {
-		n += 2
-	}
-	if m.StdinOnce {
-		n += 2
-	}
-	if m.Tty {
-		n += 2
-	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Windows != nil {
-		l = m.Windows.Size()
-		n += 2 + l + sovApi(uint64(l))
-	}
-	if m.NetPriority != 0 {
-		n += 2 + sovApi(uint64(m.NetPriority))
-	}
-	l = len(m.QuotaId)
-	if l > 0 {
-		n += 2 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *CreateContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SandboxConfig != nil {
-		l = m.SandboxConfig.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *CreateContainerResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *StartContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *StartContainerResponse) Size() (n int) {
-	var l int
-	_ = l
 	return n
 }
 
-func (m *StopContainerRequest) Size() (n int) {
+func (m *PodSandboxStatusResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
+	if m.Status != nil {
+		l = m.Status.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovApi(uint64(m.Timeout))
-	}
-	return n
-}
-
-func (m *StopContainerResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *RemoveContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *RemoveContainerResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ContainerStateValue) Size() (n int) {
+func (m *PodSandboxStateValue) Size() (n int) {
 	var l int
 	_ = l
 	if m.State != 0 {
@@ -11202,7 +11495,7 @@ func (m *ContainerStateValue) Size() (n int) {
 	return n
 }
 
-func (m *ContainerFilter) Size() (n int) {
+func (m *PodSandboxFilter) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Id)
@@ -11213,10 +11506,6 @@ func (m *ContainerFilter) Size() (n int) {
 		l = m.State.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
 	if len(m.LabelSelector) > 0 {
 		for k, v := range m.LabelSelector {
 			_ = k
@@ -11228,7 +11517,7 @@ func (m *ContainerFilter) Size() (n int) {
 	return n
 }
 
-func (m *ListContainersRequest) Size() (n int) {
+func (m *ListPodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Filter != nil {
@@ -11238,29 +11527,17 @@ func (m *ListContainersRequest) Size() (n int) {
 	return n
 }
 
-func (m *Container) Size() (n int) {
+func (m *PodSandbox) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
 	if m.Metadata != nil {
 		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.ImageRef)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
 	if m.State != 0 {
 		n += 1 + sovApi(uint64(m.State))
 	}
@@ -11286,11 +11563,11 @@ func (m *Container) Size() (n int) {
 	return n
 }
 
-func (m *ListContainersResponse) Size() (n int) {
+func (m *ListPodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
@@ -11298,110 +11575,109 @@ func (m *ListContainersResponse) Size() (n int) {
 	return n
 }
 
-func (m *ContainerStatusRequest) Size() (n int) {
+func (m *ImageSpec) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Image)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Verbose {
-		n += 2
-	}
 	return n
 }
 
-func (m *ContainerStatus) Size() (n int) {
+func (m *KeyValue) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.Value)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApi(uint64(m.CreatedAt))
-	}
-	if m.StartedAt != 0 {
-		n += 1 + sovApi(uint64(m.StartedAt))
+	return n
+}
+
+func (m *LinuxContainerResources) Size() (n int) {
+	var l int
+	_ = l
+	if m.CpuPeriod != 0 {
+		n += 1 + sovApi(uint64(m.CpuPeriod))
 	}
-	if m.FinishedAt != 0 {
-		n += 1 + sovApi(uint64(m.FinishedAt))
+	if m.CpuQuota != 0 {
+		n += 1 + sovApi(uint64(m.CpuQuota))
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovApi(uint64(m.ExitCode))
+	if m.CpuShares != 0 {
+		n += 1 + sovApi(uint64(m.CpuShares))
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.MemoryLimitInBytes != 0 {
+		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
 	}
-	l = len(m.ImageRef)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.OomScoreAdj != 0 {
+		n += 1 + sovApi(uint64(m.OomScoreAdj))
 	}
-	l = len(m.Reason)
+	l = len(m.CpusetCpus)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Message)
+	l = len(m.CpusetMems)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
+	if len(m.DiskQuota) > 0 {
+		for k, v := range m.DiskQuota {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+	if m.BlkioWeight != 0 {
+		n += 2 + sovApi(uint64(m.BlkioWeight))
+	}
+	if len(m.BlkioWeightDevice) > 0 {
+		for _, e := range m.BlkioWeightDevice {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
 		}
 	}
-	if len(m.Mounts) > 0 {
-		for _, e := range m.Mounts {
+	if len(m.BlkioDeviceReadBps) > 0 {
+		for _, e := range m.BlkioDeviceReadBps {
 			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
+			n += 2 + l + sovApi(uint64(l))
 		}
 	}
-	l = len(m.LogPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.BlkioDeviceWriteBps) > 0 {
+		for _, e := range m.BlkioDeviceWriteBps {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
+		}
 	}
-	if len(m.Volumes) > 0 {
-		for k, v := range m.Volumes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovApi(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+	if len(m.BlkioDeviceRead_IOps) > 0 {
+		for _, e := range m.BlkioDeviceRead_IOps {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
 		}
 	}
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 2 + l + sovApi(uint64(l))
+	if len(m.BlkioDeviceWrite_IOps) > 0 {
+		for _, e := range m.BlkioDeviceWrite_IOps {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
+		}
 	}
-	l = len(m.QuotaId)
-	if l > 0 {
+	if m.KernelMemory != 0 {
+		n += 2 + sovApi(uint64(m.KernelMemory))
+	}
+	if m.MemoryReservation != 0 {
+		n += 2 + sovApi(uint64(m.MemoryReservation))
+	}
+	if m.MemorySwappiness != nil {
+		l = m.MemorySwappiness.Size()
 		n += 2 + l + sovApi(uint64(l))
 	}
-	if len(m.Envs) > 0 {
-		for _, e := range m.Envs {
+	if len(m.Ulimits) > 0 {
+		for _, e := range m.Ulimits {
 			l = e.Size()
 			n += 2 + l + sovApi(uint64(l))
 		}
@@ -11409,339 +11685,342 @@ func (m *ContainerStatus) Size() (n int) {
 	return n
 }
 
-func (m *Volume) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ContainerStatusResponse) Size() (n int) {
+func (m *WeightDevice) Size() (n int) {
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
+	l = len(m.Path)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if m.Weight != 0 {
+		n += 1 + sovApi(uint64(m.Weight))
 	}
 	return n
 }
 
-func (m *UpdateContainerResourcesRequest) Size() (n int) {
+func (m *ThrottleDevice) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.SpecAnnotations) > 0 {
-		for k, v := range m.SpecAnnotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
-		}
+	if m.Rate != 0 {
+		n += 1 + sovApi(uint64(m.Rate))
 	}
 	return n
 }
 
-func (m *UpdateContainerResourcesResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ExecSyncRequest) Size() (n int) {
+func (m *Ulimit) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.Hard != 0 {
+		n += 1 + sovApi(uint64(m.Hard))
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovApi(uint64(m.Timeout))
+	if m.Soft != 0 {
+		n += 1 + sovApi(uint64(m.Soft))
 	}
 	return n
 }
 
-func (m *ExecSyncResponse) Size() (n int) {
+func (m *SELinuxOption) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Stdout)
+	l = len(m.User)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Stderr)
+	l = len(m.Role)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovApi(uint64(m.ExitCode))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ExecRequest) Size() (n int) {
+func (m *Capability) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
+	if len(m.AddCapabilities) > 0 {
+		for _, s := range m.AddCapabilities {
 			l = len(s)
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	if m.Tty {
-		n += 2
-	}
-	if m.Stdin {
-		n += 2
-	}
-	if m.Stdout {
-		n += 2
-	}
-	if m.Stderr {
-		n += 2
+	if len(m.DropCapabilities) > 0 {
+		for _, s := range m.DropCapabilities {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *ExecResponse) Size() (n int) {
+func (m *LinuxContainerSecurityContext) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *AttachRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
+	if m.Privileged {
+		n += 2
+	}
+	if m.NamespaceOptions != nil {
+		l = m.NamespaceOptions.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Stdin {
-		n += 2
+	if m.SelinuxOptions != nil {
+		l = m.SelinuxOptions.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Tty {
-		n += 2
+	if m.RunAsUser != nil {
+		l = m.RunAsUser.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Stdout {
-		n += 2
+	l = len(m.RunAsUsername)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Stderr {
+	if m.ReadonlyRootfs {
 		n += 2
 	}
-	return n
-}
-
-func (m *AttachResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Url)
+	if len(m.SupplementalGroups) > 0 {
+		l = 0
+		for _, e := range m.SupplementalGroups {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	l = len(m.ApparmorProfile)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *PortForwardRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PodSandboxId)
+	l = len(m.SeccompProfilePath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Port) > 0 {
-		l = 0
-		for _, e := range m.Port {
-			l += sovApi(uint64(e))
+	if m.NoNewPrivs {
+		n += 2
+	}
+	if m.RunAsGroup != nil {
+		l = m.RunAsGroup.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.MaskedPaths) > 0 {
+		for _, s := range m.MaskedPaths {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.ReadonlyPaths) > 0 {
+		for _, s := range m.ReadonlyPaths {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
 		}
-		n += 1 + sovApi(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *PortForwardResponse) Size() (n int) {
+func (m *LinuxContainerConfig) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ImageFilter) Size() (n int) {
+func (m *WindowsContainerSecurityContext) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.RunAsUsername)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ListImagesRequest) Size() (n int) {
+func (m *WindowsContainerConfig) Size() (n int) {
 	var l int
 	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *Image) Size() (n int) {
+func (m *WindowsContainerResources) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.RepoTags) > 0 {
-		for _, s := range m.RepoTags {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.RepoDigests) > 0 {
-		for _, s := range m.RepoDigests {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovApi(uint64(m.Size_))
+	if m.CpuShares != 0 {
+		n += 1 + sovApi(uint64(m.CpuShares))
 	}
-	if m.Uid != nil {
-		l = m.Uid.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.CpuCount != 0 {
+		n += 1 + sovApi(uint64(m.CpuCount))
 	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.CpuMaximum != 0 {
+		n += 1 + sovApi(uint64(m.CpuMaximum))
 	}
-	if len(m.Volumes) > 0 {
-		for k, v := range m.Volumes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovApi(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
-		}
+	if m.MemoryLimitInBytes != 0 {
+		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
 	}
 	return n
 }
 
-func (m *ListImagesResponse) Size() (n int) {
+func (m *ContainerMetadata) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovApi(uint64(m.Attempt))
 	}
 	return n
 }
 
-func (m *ImageStatusRequest) Size() (n int) {
+func (m *Device) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.ContainerPath)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Verbose {
-		n += 2
+	l = len(m.HostPath)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Permissions)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ImageStatusResponse) Size() (n int) {
+func (m *ContainerConfig) Size() (n int) {
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.Image != nil {
 		l = m.Image.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.WorkingDir)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Envs) > 0 {
+		for _, e := range m.Envs {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Mounts) > 0 {
+		for _, e := range m.Mounts {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
 			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *AuthConfig) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	l = len(m.Password)
+	l = len(m.LogPath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Auth)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Stdin {
+		n += 2
 	}
-	l = len(m.ServerAddress)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.StdinOnce {
+		n += 2
 	}
-	l = len(m.IdentityToken)
-	if l > 0 {
+	if m.Tty {
+		n += 2
+	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.RegistryToken)
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 2 + l + sovApi(uint64(l))
+	}
+	if m.NetPriority != 0 {
+		n += 2 + sovApi(uint64(m.NetPriority))
+	}
+	l = len(m.QuotaId)
 	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+		n += 2 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *PullImageRequest) Size() (n int) {
+func (m *CreateContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.PodSandboxId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Auth != nil {
-		l = m.Auth.Size()
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	if m.SandboxConfig != nil {
@@ -11751,119 +12030,93 @@ func (m *PullImageRequest) Size() (n int) {
 	return n
 }
 
-func (m *PullImageResponse) Size() (n int) {
+func (m *CreateContainerResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ImageRef)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveImageRequest) Size() (n int) {
+func (m *StartContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.ContainerId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveImageResponse) Size() (n int) {
+func (m *StartContainerResponse) Size() (n int) {
 	var l int
 	_ = l
 	return n
 }
 
-func (m *NetworkConfig) Size() (n int) {
+func (m *StopContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.PodCidr)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Timeout != 0 {
+		n += 1 + sovApi(uint64(m.Timeout))
+	}
 	return n
 }
 
-func (m *RuntimeConfig) Size() (n int) {
+func (m *StopContainerResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.NetworkConfig != nil {
-		l = m.NetworkConfig.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
 	return n
 }
 
-func (m *UpdateRuntimeConfigRequest) Size() (n int) {
+func (m *RemoveContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.RuntimeConfig != nil {
-		l = m.RuntimeConfig.Size()
+	l = len(m.ContainerId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *UpdateRuntimeConfigResponse) Size() (n int) {
+func (m *RemoveContainerResponse) Size() (n int) {
 	var l int
 	_ = l
 	return n
 }
 
-func (m *RuntimeCondition) Size() (n int) {
+func (m *ContainerStateValue) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Status {
-		n += 2
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
 	}
 	return n
 }
 
-func (m *RuntimeStatus) Size() (n int) {
+func (m *ContainerFilter) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *StatusRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Verbose {
-		n += 2
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *StatusResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
+	l = len(m.PodSandboxId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
@@ -11873,57 +12126,69 @@ func (m *StatusResponse) Size() (n int) {
 	return n
 }
 
-func (m *ImageFsInfoRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *UInt64Value) Size() (n int) {
+func (m *ListContainersRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovApi(uint64(m.Value))
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *FilesystemIdentifier) Size() (n int) {
+func (m *Container) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Mountpoint)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *FilesystemUsage) Size() (n int) {
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovApi(uint64(m.Timestamp))
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.FsId != nil {
-		l = m.FsId.Size()
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.UsedBytes != nil {
-		l = m.UsedBytes.Size()
+	if m.Image != nil {
+		l = m.Image.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.InodesUsed != nil {
-		l = m.InodesUsed.Size()
+	l = len(m.ImageRef)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ImageFsInfoResponse) Size() (n int) {
+func (m *ListContainersResponse) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.ImageFilesystems) > 0 {
-		for _, e := range m.ImageFilesystems {
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
 			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
@@ -11931,79 +12196,59 @@ func (m *ImageFsInfoResponse) Size() (n int) {
 	return n
 }
 
-func (m *ContainerStatsRequest) Size() (n int) {
+func (m *ContainerStatusRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *ContainerStatsResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *ListContainerStatsRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.Verbose {
+		n += 2
 	}
 	return n
 }
 
-func (m *ContainerStatsFilter) Size() (n int) {
+func (m *ContainerStatus) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.PodSandboxId)
-	if l > 0 {
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.LabelSelector) > 0 {
-		for k, v := range m.LabelSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
 	}
-	return n
-}
-
-func (m *ListContainerStatsResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
 	}
-	return n
-}
-
-func (m *ContainerAttributes) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
+	if m.StartedAt != 0 {
+		n += 1 + sovApi(uint64(m.StartedAt))
+	}
+	if m.FinishedAt != 0 {
+		n += 1 + sovApi(uint64(m.FinishedAt))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovApi(uint64(m.ExitCode))
+	}
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ImageRef)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	if len(m.Labels) > 0 {
@@ -12022,563 +12267,1406 @@ func (m *ContainerAttributes) Size() (n int) {
 			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *ContainerStats) Size() (n int) {
-	var l int
-	_ = l
-	if m.Attributes != nil {
-		l = m.Attributes.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Cpu != nil {
-		l = m.Cpu.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Mounts) > 0 {
+		for _, e := range m.Mounts {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	if m.Memory != nil {
-		l = m.Memory.Size()
+	l = len(m.LogPath)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.WritableLayer != nil {
-		l = m.WritableLayer.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Volumes) > 0 {
+		for k, v := range m.Volumes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovApi(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	return n
-}
-
-func (m *CpuUsage) Size() (n int) {
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovApi(uint64(m.Timestamp))
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 2 + l + sovApi(uint64(l))
 	}
-	if m.UsageCoreNanoSeconds != nil {
-		l = m.UsageCoreNanoSeconds.Size()
-		n += 1 + l + sovApi(uint64(l))
+	l = len(m.QuotaId)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
+	if len(m.Envs) > 0 {
+		for _, e := range m.Envs {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MemoryUsage) Size() (n int) {
+func (m *Volume) Size() (n int) {
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovApi(uint64(m.Timestamp))
-	}
-	if m.WorkingSetBytes != nil {
-		l = m.WorkingSetBytes.Size()
+	return n
+}
+
+func (m *ContainerStatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ReopenContainerLogRequest) Size() (n int) {
+func (m *UpdateContainerResourcesRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.SpecAnnotations) > 0 {
+		for k, v := range m.SpecAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ReopenContainerLogResponse) Size() (n int) {
+func (m *UpdateContainerResourcesResponse) Size() (n int) {
 	var l int
 	_ = l
 	return n
 }
 
-func (m *PauseContainerRequest) Size() (n int) {
+func (m *ExecSyncRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovApi(uint64(m.Timeout))
+	}
 	return n
 }
 
-func (m *PauseContainerResponse) Size() (n int) {
+func (m *ExecSyncResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovApi(uint64(m.ExitCode))
+	}
 	return n
 }
 
-func (m *UnpauseContainerRequest) Size() (n int) {
+func (m *ExecRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Tty {
+		n += 2
+	}
+	if m.Stdin {
+		n += 2
+	}
+	if m.Stdout {
+		n += 2
+	}
+	if m.Stderr {
+		n += 2
+	}
 	return n
 }
 
-func (m *UnpauseContainerResponse) Size() (n int) {
+func (m *ExecResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *RemoveVolumeRequest) Size() (n int) {
+func (m *AttachRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.VolumeName)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Stdin {
+		n += 2
+	}
+	if m.Tty {
+		n += 2
+	}
+	if m.Stdout {
+		n += 2
+	}
+	if m.Stderr {
+		n += 2
+	}
 	return n
 }
 
-func (m *RemoveVolumeResponse) Size() (n int) {
+func (m *AttachResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *StartPodSandboxRequest) Size() (n int) {
+func (m *PortForwardRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.PodSandboxId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Port) > 0 {
+		l = 0
+		for _, e := range m.Port {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
 	return n
 }
 
-func (m *StartPodSandboxResponse) Size() (n int) {
+func (m *PortForwardResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func sovApi(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *ImageFilter) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *VersionRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&VersionRequest{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *VersionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListImagesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&VersionResponse{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
-		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
-		`RuntimeApiVersion:` + fmt.Sprintf("%v", this.RuntimeApiVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *DNSConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Image) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&DNSConfig{`,
-		`Servers:` + fmt.Sprintf("%v", this.Servers) + `,`,
-		`Searches:` + fmt.Sprintf("%v", this.Searches) + `,`,
-		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PortMapping) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RepoTags) > 0 {
+		for _, s := range m.RepoTags {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PortMapping{`,
-		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
-		`ContainerPort:` + fmt.Sprintf("%v", this.ContainerPort) + `,`,
-		`HostPort:` + fmt.Sprintf("%v", this.HostPort) + `,`,
-		`HostIp:` + fmt.Sprintf("%v", this.HostIp) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Mount) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RepoDigests) > 0 {
+		for _, s := range m.RepoDigests {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Mount{`,
-		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
-		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
-		`Readonly:` + fmt.Sprintf("%v", this.Readonly) + `,`,
-		`SelinuxRelabel:` + fmt.Sprintf("%v", this.SelinuxRelabel) + `,`,
-		`Propagation:` + fmt.Sprintf("%v", this.Propagation) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NamespaceOption) String() string {
-	if this == nil {
-		return "nil"
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
 	}
-	s := strings.Join([]string{`&NamespaceOption{`,
-		`Network:` + fmt.Sprintf("%v", this.Network) + `,`,
-		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
-		`Ipc:` + fmt.Sprintf("%v", this.Ipc) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Int64Value) String() string {
-	if this == nil {
-		return "nil"
+	if m.Uid != nil {
+		l = m.Uid.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Int64Value{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LinuxSandboxSecurityContext) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&LinuxSandboxSecurityContext{`,
-		`NamespaceOptions:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceOptions), "NamespaceOption", "NamespaceOption", 1) + `,`,
-		`SelinuxOptions:` + strings.Replace(fmt.Sprintf("%v", this.SelinuxOptions), "SELinuxOption", "SELinuxOption", 1) + `,`,
-		`RunAsUser:` + strings.Replace(fmt.Sprintf("%v", this.RunAsUser), "Int64Value", "Int64Value", 1) + `,`,
-		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
-		`SupplementalGroups:` + fmt.Sprintf("%v", this.SupplementalGroups) + `,`,
-		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
-		`SeccompProfilePath:` + fmt.Sprintf("%v", this.SeccompProfilePath) + `,`,
-		`RunAsGroup:` + strings.Replace(fmt.Sprintf("%v", this.RunAsGroup), "Int64Value", "Int64Value", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Volumes) > 0 {
+		for k, v := range m.Volumes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovApi(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *LinuxPodSandboxConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListImagesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	keysForSysctls := make([]string, 0, len(this.Sysctls))
-	for k := range this.Sysctls {
-		keysForSysctls = append(keysForSysctls, k)
+	return n
+}
+
+func (m *ImageStatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSysctls)
-	mapStringForSysctls := "map[string]string{"
-	for _, k := range keysForSysctls {
-		mapStringForSysctls += fmt.Sprintf("%v: %v,", k, this.Sysctls[k])
+	if m.Verbose {
+		n += 2
 	}
-	mapStringForSysctls += "}"
-	s := strings.Join([]string{`&LinuxPodSandboxConfig{`,
-		`CgroupParent:` + fmt.Sprintf("%v", this.CgroupParent) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "LinuxSandboxSecurityContext", "LinuxSandboxSecurityContext", 1) + `,`,
-		`Sysctls:` + mapStringForSysctls + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxMetadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageStatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&PodSandboxMetadata{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *PodSandboxConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AuthConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	l = len(m.Auth)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	l = len(m.ServerAddress)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	l = len(m.IdentityToken)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodSandboxConfig{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
-		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
-		`LogDirectory:` + fmt.Sprintf("%v", this.LogDirectory) + `,`,
-		`DnsConfig:` + strings.Replace(fmt.Sprintf("%v", this.DnsConfig), "DNSConfig", "DNSConfig", 1) + `,`,
-		`PortMappings:` + strings.Replace(fmt.Sprintf("%v", this.PortMappings), "PortMapping", "PortMapping", 1) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxConfig", "LinuxPodSandboxConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RunPodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.RegistryToken)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RunPodSandboxRequest{`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
-		`RuntimeHandler:` + fmt.Sprintf("%v", this.RuntimeHandler) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RunPodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PullImageRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RunPodSandboxResponse{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StopPodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&StopPodSandboxRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StopPodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.SandboxConfig != nil {
+		l = m.SandboxConfig.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&StopPodSandboxResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RemovePodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PullImageResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ImageRef)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RemovePodSandboxRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RemovePodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveImageRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RemovePodSandboxResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStatusRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PodSandboxStatusRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RemoveImageResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
 }
-func (this *PodSandboxNetworkStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NetworkConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodCidr)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&PodSandboxNetworkStatus{`,
-		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Namespace) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.NetworkConfig != nil {
+		l = m.NetworkConfig.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Namespace{`,
-		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "NamespaceOption", "NamespaceOption", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *LinuxPodSandboxStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateRuntimeConfigRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.RuntimeConfig != nil {
+		l = m.RuntimeConfig.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&LinuxPodSandboxStatus{`,
-		`Namespaces:` + strings.Replace(fmt.Sprintf("%v", this.Namespaces), "Namespace", "Namespace", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+
+func (m *UpdateRuntimeConfigResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RuntimeCondition) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Status {
+		n += 2
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodSandboxStatus{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "PodSandboxNetworkStatus", "PodSandboxNetworkStatus", 1) + `,`,
-		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxStatus", "LinuxPodSandboxStatus", 1) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStatusResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForInfo := make([]string, 0, len(this.Info))
-	for k := range this.Info {
-		keysForInfo = append(keysForInfo, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
-	mapStringForInfo := "map[string]string{"
-	for _, k := range keysForInfo {
-		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+
+func (m *RuntimeStatus) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	mapStringForInfo += "}"
-	s := strings.Join([]string{`&PodSandboxStatusResponse{`,
-		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "PodSandboxStatus", "PodSandboxStatus", 1) + `,`,
-		`Info:` + mapStringForInfo + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStateValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Verbose {
+		n += 2
 	}
-	s := strings.Join([]string{`&PodSandboxStateValue{`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxFilter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
-	for k := range this.LabelSelector {
-		keysForLabelSelector = append(keysForLabelSelector, k)
+
+func (m *StatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
-	mapStringForLabelSelector := "map[string]string{"
-	for _, k := range keysForLabelSelector {
-		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	mapStringForLabelSelector += "}"
-	s := strings.Join([]string{`&PodSandboxFilter{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "PodSandboxStateValue", "PodSandboxStateValue", 1) + `,`,
-		`LabelSelector:` + mapStringForLabelSelector + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ListPodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageFsInfoRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UInt64Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovApi(uint64(m.Value))
 	}
-	s := strings.Join([]string{`&ListPodSandboxRequest{`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "PodSandboxFilter", "PodSandboxFilter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandbox) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FilesystemIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mountpoint)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	return n
+}
+
+func (m *FilesystemUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.FsId != nil {
+		l = m.FsId.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	if m.UsedBytes != nil {
+		l = m.UsedBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.InodesUsed != nil {
+		l = m.InodesUsed.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodSandbox{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ListPodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
+
+func (m *ImageFsInfoResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ImageFilesystems) > 0 {
+		for _, e := range m.ImageFilesystems {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ListContainerStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerStatsFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListContainerStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerAttributes) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ContainerStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Cpu != nil {
+		l = m.Cpu.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Memory != nil {
+		l = m.Memory.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.WritableLayer != nil {
+		l = m.WritableLayer.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CpuUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	if m.UsageCoreNanoSeconds != nil {
+		l = m.UsageCoreNanoSeconds.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MemoryUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	if m.WorkingSetBytes != nil {
+		l = m.WorkingSetBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ReopenContainerLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ReopenContainerLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveVolumeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.VolumeName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveVolumeResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StartPodSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *StartPodSandboxResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PodSandboxStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *PodSandboxStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *PodSandboxStatsFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListPodSandboxStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPodSandboxStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PodSandboxAttributes) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PodSandboxStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *LinuxPodSandboxStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Cpu != nil {
+		l = m.Cpu.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Memory != nil {
+		l = m.Memory.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Network != nil {
+		l = m.Network.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	if m.DefaultInterface != nil {
+		l = m.DefaultInterface.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkInterfaceUsage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.RxBytes != nil {
+		l = m.RxBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.RxErrors != nil {
+		l = m.RxErrors.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxBytes != nil {
+		l = m.TxBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxErrors != nil {
+		l = m.TxErrors.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	if m.ProcessCount != nil {
+		l = m.ProcessCount.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *VersionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionRequest{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionResponse{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
+		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
+		`RuntimeApiVersion:` + fmt.Sprintf("%v", this.RuntimeApiVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DNSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSConfig{`,
+		`Servers:` + fmt.Sprintf("%v", this.Servers) + `,`,
+		`Searches:` + fmt.Sprintf("%v", this.Searches) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortMapping) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortMapping{`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`ContainerPort:` + fmt.Sprintf("%v", this.ContainerPort) + `,`,
+		`HostPort:` + fmt.Sprintf("%v", this.HostPort) + `,`,
+		`HostIp:` + fmt.Sprintf("%v", this.HostIp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Mount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Mount{`,
+		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
+		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
+		`Readonly:` + fmt.Sprintf("%v", this.Readonly) + `,`,
+		`SelinuxRelabel:` + fmt.Sprintf("%v", this.SelinuxRelabel) + `,`,
+		`Propagation:` + fmt.Sprintf("%v", this.Propagation) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceOption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceOption{`,
+		`Network:` + fmt.Sprintf("%v", this.Network) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Ipc:` + fmt.Sprintf("%v", this.Ipc) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Int64Value) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Int64Value{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LinuxSandboxSecurityContext) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LinuxSandboxSecurityContext{`,
+		`NamespaceOptions:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceOptions), "NamespaceOption", "NamespaceOption", 1) + `,`,
+		`SelinuxOptions:` + strings.Replace(fmt.Sprintf("%v", this.SelinuxOptions), "SELinuxOption", "SELinuxOption", 1) + `,`,
+		`RunAsUser:` + strings.Replace(fmt.Sprintf("%v", this.RunAsUser), "Int64Value", "Int64Value", 1) + `,`,
+		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
+		`SupplementalGroups:` + fmt.Sprintf("%v", this.SupplementalGroups) + `,`,
+		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
+		`SeccompProfilePath:` + fmt.Sprintf("%v", this.SeccompProfilePath) + `,`,
+		`RunAsGroup:` + strings.Replace(fmt.Sprintf("%v", this.RunAsGroup), "Int64Value", "Int64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LinuxPodSandboxConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSysctls := make([]string, 0, len(this.Sysctls))
+	for k := range this.Sysctls {
+		keysForSysctls = append(keysForSysctls, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSysctls)
+	mapStringForSysctls := "map[string]string{"
+	for _, k := range keysForSysctls {
+		mapStringForSysctls += fmt.Sprintf("%v: %v,", k, this.Sysctls[k])
+	}
+	mapStringForSysctls += "}"
+	s := strings.Join([]string{`&LinuxPodSandboxConfig{`,
+		`CgroupParent:` + fmt.Sprintf("%v", this.CgroupParent) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "LinuxSandboxSecurityContext", "LinuxSandboxSecurityContext", 1) + `,`,
+		`Sysctls:` + mapStringForSysctls + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxMetadata{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandboxConfig{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`LogDirectory:` + fmt.Sprintf("%v", this.LogDirectory) + `,`,
+		`DnsConfig:` + strings.Replace(fmt.Sprintf("%v", this.DnsConfig), "DNSConfig", "DNSConfig", 1) + `,`,
+		`PortMappings:` + strings.Replace(fmt.Sprintf("%v", this.PortMappings), "PortMapping", "PortMapping", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxConfig", "LinuxPodSandboxConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RunPodSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunPodSandboxRequest{`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
+		`RuntimeHandler:` + fmt.Sprintf("%v", this.RuntimeHandler) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RunPodSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunPodSandboxResponse{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopPodSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopPodSandboxRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopPodSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopPodSandboxResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemovePodSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemovePodSandboxRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemovePodSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemovePodSandboxResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStatusRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxNetworkStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxNetworkStatus{`,
+		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Namespace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Namespace{`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "NamespaceOption", "NamespaceOption", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LinuxPodSandboxStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LinuxPodSandboxStatus{`,
+		`Namespaces:` + strings.Replace(fmt.Sprintf("%v", this.Namespaces), "Namespace", "Namespace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandboxStatus{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "PodSandboxNetworkStatus", "PodSandboxNetworkStatus", 1) + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxStatus", "LinuxPodSandboxStatus", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForInfo := make([]string, 0, len(this.Info))
+	for k := range this.Info {
+		keysForInfo = append(keysForInfo, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
+	mapStringForInfo := "map[string]string{"
+	for _, k := range keysForInfo {
+		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+	}
+	mapStringForInfo += "}"
+	s := strings.Join([]string{`&PodSandboxStatusResponse{`,
+		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "PodSandboxStatus", "PodSandboxStatus", 1) + `,`,
+		`Info:` + mapStringForInfo + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStateValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStateValue{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
+	s := strings.Join([]string{`&PodSandboxFilter{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "PodSandboxStateValue", "PodSandboxStateValue", 1) + `,`,
+		`LabelSelector:` + mapStringForLabelSelector + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPodSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPodSandboxRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "PodSandboxFilter", "PodSandboxFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandbox) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandbox{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPodSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
 	s := strings.Join([]string{`&ListPodSandboxResponse{`,
 		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "PodSandbox", "PodSandbox", 1) + `,`,
 		`}`,
@@ -13748,6 +14836,162 @@ func (this *StartPodSandboxResponse) String() string {
 	}, "")
 	return s
 }
+func (this *PodSandboxStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStatsRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStatsResponse{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PodSandboxStats", "PodSandboxStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatsFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
+	s := strings.Join([]string{`&PodSandboxStatsFilter{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`LabelSelector:` + mapStringForLabelSelector + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPodSandboxStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPodSandboxStatsRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "PodSandboxStatsFilter", "PodSandboxStatsFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPodSandboxStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPodSandboxStatsResponse{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PodSandboxStats", "PodSandboxStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandboxAttributes{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStats{`,
+		`Attributes:` + strings.Replace(fmt.Sprintf("%v", this.Attributes), "PodSandboxAttributes", "PodSandboxAttributes", 1) + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxStats", "LinuxPodSandboxStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LinuxPodSandboxStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LinuxPodSandboxStats{`,
+		`Cpu:` + strings.Replace(fmt.Sprintf("%v", this.Cpu), "CpuUsage", "CpuUsage", 1) + `,`,
+		`Memory:` + strings.Replace(fmt.Sprintf("%v", this.Memory), "MemoryUsage", "MemoryUsage", 1) + `,`,
+		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "NetworkUsage", "NetworkUsage", 1) + `,`,
+		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "ProcessUsage", "ProcessUsage", 1) + `,`,
+		`Containers:` + strings.Replace(fmt.Sprintf("%v", this.Containers), "ContainerStats", "ContainerStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`DefaultInterface:` + strings.Replace(fmt.Sprintf("%v", this.DefaultInterface), "NetworkInterfaceUsage", "NetworkInterfaceUsage", 1) + `,`,
+		`Interfaces:` + strings.Replace(fmt.Sprintf("%v", this.Interfaces), "NetworkInterfaceUsage", "NetworkInterfaceUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkInterfaceUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkInterfaceUsage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RxBytes:` + strings.Replace(fmt.Sprintf("%v", this.RxBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+		`RxErrors:` + strings.Replace(fmt.Sprintf("%v", this.RxErrors), "UInt64Value", "UInt64Value", 1) + `,`,
+		`TxBytes:` + strings.Replace(fmt.Sprintf("%v", this.TxBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+		`TxErrors:` + strings.Replace(fmt.Sprintf("%v", this.TxErrors), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProcessUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProcessUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ProcessCount:` + strings.Replace(fmt.Sprintf("%v", this.ProcessCount), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApi(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -23201,11 +24445,101 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			m.FinishedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StartedAt = 0
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23215,16 +24549,26 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.FinishedAt = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23234,16 +24578,26 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinishedAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ExitCode = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23253,14 +24607,24 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23284,18 +24648,103 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23305,26 +24754,115 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23334,24 +24872,26 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Mounts = append(m.Mounts, &Mount{})
+			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23376,11 +24916,11 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.LogPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23404,11 +24944,11 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Volumes == nil {
+				m.Volumes = make(map[string]*Volume)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue *Volume
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -23454,7 +24994,7 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApi
@@ -23464,21 +25004,26 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						mapmsglen |= (int(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthApi
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &Volume{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipApi(dAtA[iNdEx:])
@@ -23494,11 +25039,237 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Volumes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &LinuxContainerResources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Envs = append(m.Envs, &KeyValue{})
+			if err := m.Envs[len(m.Envs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Volume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ContainerStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23522,8 +25293,8 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Info == nil {
+				m.Info = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -23612,13 +25383,63 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Info[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateContainerResourcesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateContainerResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23628,28 +25449,26 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mounts = append(m.Mounts, &Mount{})
-			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23659,24 +25478,28 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogPath = string(dAtA[iNdEx:postIndex])
+			if m.Linux == nil {
+				m.Linux = &LinuxContainerResources{}
+			}
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23700,11 +25523,11 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Volumes == nil {
-				m.Volumes = make(map[string]*Volume)
+			if m.SpecAnnotations == nil {
+				m.SpecAnnotations = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *Volume
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -23750,7 +25573,7 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApi
@@ -23760,26 +25583,21 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthApi
 					}
-					if postmsgIndex > l {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &Volume{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipApi(dAtA[iNdEx:])
@@ -23795,13 +25613,113 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Volumes[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 101:
+			m.SpecAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateContainerResourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateContainerResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecSyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23811,28 +25729,24 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = &LinuxContainerResources{}
-			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuotaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23857,13 +25771,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuotaId = string(dAtA[iNdEx:postIndex])
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envs", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23873,73 +25787,11 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Timeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Envs = append(m.Envs, &KeyValue{})
-			if err := m.Envs[len(m.Envs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Volume) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -23961,7 +25813,7 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23984,17 +25836,17 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecSyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24004,30 +25856,28 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ContainerStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Stdout = append(m.Stdout[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stdout == nil {
+				m.Stdout = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24037,110 +25887,42 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = make(map[string]string)
+			m.Stderr = append(m.Stderr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stderr == nil {
+				m.Stderr = []byte{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Info[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24162,7 +25944,7 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24185,10 +25967,10 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerResourcesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24222,9 +26004,9 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24234,30 +26016,46 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Linux == nil {
-				m.Linux = &LinuxContainerResources{}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
 			}
-			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecAnnotations", wireType)
+			m.Tty = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24267,109 +26065,130 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Stdin = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdout = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stderr = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecAnnotations == nil {
-				m.SpecAnnotations = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SpecAnnotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24392,7 +26211,7 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24415,12 +26234,121 @@ func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttachRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttachRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdin = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tty = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdout = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stderr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24442,7 +26370,7 @@ func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
+func (m *AttachResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24465,15 +26393,15 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecSyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttachResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24498,11 +26426,61 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortForwardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortForwardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24527,26 +26505,69 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Port = append(m.Port, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Port = append(m.Port, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -24569,7 +26590,7 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
+func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24592,48 +26613,17 @@ func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecSyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PortForwardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PortForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stdout = append(m.Stdout[:0], dAtA[iNdEx:postIndex]...)
-			if m.Stdout == nil {
-				m.Stdout = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24643,42 +26633,21 @@ func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stderr = append(m.Stderr[:0], dAtA[iNdEx:postIndex]...)
-			if m.Stderr == nil {
-				m.Stderr = []byte{}
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
-			}
-			m.ExitCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExitCode |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24700,7 +26669,7 @@ func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecRequest) Unmarshal(dAtA []byte) error {
+func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24723,17 +26692,17 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24743,130 +26712,25 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tty = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stdin = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stdout = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stderr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24888,7 +26752,7 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecResponse) Unmarshal(dAtA []byte) error {
+func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24911,17 +26775,17 @@ func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListImagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListImagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24931,20 +26795,24 @@ func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &ImageFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24967,7 +26835,7 @@ func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachRequest) Unmarshal(dAtA []byte) error {
+func (m *Image) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24990,15 +26858,15 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25023,13 +26891,13 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoTags", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25039,17 +26907,26 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stdin = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoTags = append(m.RepoTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoDigests", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25059,17 +26936,26 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tty = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoDigests = append(m.RepoDigests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var v int
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25079,17 +26965,16 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Size_ |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stdout = bool(v != 0)
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25099,65 +26984,28 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stderr = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttachResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Uid == nil {
+				m.Uid = &Int64Value{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Uid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttachResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25182,63 +27030,13 @@ func (m *AttachResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PortForwardRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortForwardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25248,24 +27046,26 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int32
+			if m.Volumes == nil {
+				m.Volumes = make(map[string]*Volume)
+			}
+			var mapkey string
+			var mapvalue *Volume
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowApi
@@ -25275,37 +27075,40 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (int32(b) & 0x7F) << shift
+					wire |= (uint64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.Port = append(m.Port, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
 					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApi
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int32
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApi
@@ -25315,16 +27118,43 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (int32(b) & 0x7F) << shift
+						mapmsglen |= (int(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Port = append(m.Port, v)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Volume{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
+			m.Volumes[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25346,7 +27176,7 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
+func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25369,17 +27199,17 @@ func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PortForwardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListImagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListImagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25389,20 +27219,22 @@ func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, &Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25425,7 +27257,7 @@ func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageFilter) Unmarshal(dAtA []byte) error {
+func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25448,10 +27280,10 @@ func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25487,6 +27319,26 @@ func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25508,7 +27360,7 @@ func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25531,15 +27383,15 @@ func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListImagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListImagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25563,13 +27415,131 @@ func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ImageFilter{}
+			if m.Image == nil {
+				m.Image = &Image{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Info[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25591,7 +27561,7 @@ func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25614,15 +27584,15 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25647,11 +27617,11 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25676,11 +27646,11 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoTags = append(m.RepoTags, string(dAtA[iNdEx:postIndex]))
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoDigests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25705,13 +27675,71 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoDigests = append(m.RepoDigests, string(dAtA[iNdEx:postIndex]))
+			m.Auth = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryToken", wireType)
 			}
-			m.Size_ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25721,14 +27749,74 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullImageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25752,18 +27840,18 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Uid == nil {
-				m.Uid = &Int64Value{}
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
 			}
-			if err := m.Uid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25773,24 +27861,28 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.Auth == nil {
+				m.Auth = &AuthConfig{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 100:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25814,102 +27906,91 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Volumes == nil {
-				m.Volumes = make(map[string]*Volume)
+			if m.SandboxConfig == nil {
+				m.SandboxConfig = &PodSandboxConfig{}
 			}
-			var mapkey string
-			var mapvalue *Volume
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.SandboxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullImageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Volume{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Volumes[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageRef = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25932,7 +28013,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25955,15 +28036,15 @@ func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListImagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveImageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListImagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25987,8 +28068,10 @@ func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, &Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26013,7 +28096,7 @@ func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveImageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26036,65 +28119,12 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveImageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -26116,7 +28146,7 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26139,17 +28169,17 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodCidr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26159,28 +28189,74 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &Image{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PodCidr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26204,97 +28280,12 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.NetworkConfig == nil {
+				m.NetworkConfig = &NetworkConfig{}
+			}
+			if err := m.NetworkConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Info[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26317,7 +28308,7 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthConfig) Unmarshal(dAtA []byte) error {
+func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26340,17 +28331,17 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26360,24 +28351,128 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.RuntimeConfig == nil {
+				m.RuntimeConfig = &RuntimeConfig{}
+			}
+			if err := m.RuntimeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRuntimeConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26402,11 +28497,31 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26431,11 +28546,11 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auth = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26460,13 +28575,63 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerAddress = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26476,26 +28641,78 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, &RuntimeCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryToken", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26505,21 +28722,12 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RegistryToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -26541,7 +28749,7 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26564,15 +28772,15 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullImageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26596,16 +28804,16 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
+			if m.Status == nil {
+				m.Status = &RuntimeStatus{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26629,18 +28837,203 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &AuthConfig{}
+			if m.Info == nil {
+				m.Info = make(map[string]string)
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Info[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxConfig", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageFsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageFsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageFsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UInt64Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UInt64Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UInt64Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26650,25 +29043,11 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Value |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SandboxConfig == nil {
-				m.SandboxConfig = &PodSandboxConfig{}
-			}
-			if err := m.SandboxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -26690,7 +29069,7 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
+func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26713,15 +29092,15 @@ func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullImageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilesystemIdentifier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilesystemIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mountpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26746,7 +29125,7 @@ func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			m.Mountpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26769,7 +29148,7 @@ func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
+func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26792,15 +29171,34 @@ func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveImageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilesystemUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilesystemUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FsId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26824,10 +29222,76 @@ func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
+			if m.FsId == nil {
+				m.FsId = &FilesystemIdentifier{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FsId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsedBytes == nil {
+				m.UsedBytes = &UInt64Value{}
+			}
+			if err := m.UsedBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InodesUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InodesUsed == nil {
+				m.InodesUsed = &UInt64Value{}
+			}
+			if err := m.InodesUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26852,7 +29316,7 @@ func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveImageResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26875,12 +29339,43 @@ func (m *RemoveImageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveImageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageFsInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageFsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFilesystems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageFilesystems = append(m.ImageFilesystems, &FilesystemUsage{})
+			if err := m.ImageFilesystems[len(m.ImageFilesystems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -26902,7 +29397,7 @@ func (m *RemoveImageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26925,15 +29420,15 @@ func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodCidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26958,7 +29453,7 @@ func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodCidr = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26981,7 +29476,7 @@ func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27004,15 +29499,15 @@ func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27036,10 +29531,10 @@ func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkConfig == nil {
-				m.NetworkConfig = &NetworkConfig{}
+			if m.Stats == nil {
+				m.Stats = &ContainerStats{}
 			}
-			if err := m.NetworkConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27064,7 +29559,7 @@ func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27087,15 +29582,15 @@ func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27104,78 +29599,28 @@ func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RuntimeConfig == nil {
-				m.RuntimeConfig = &RuntimeConfig{}
-			}
-			if err := m.RuntimeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateRuntimeConfigResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Filter == nil {
+				m.Filter = &ContainerStatsFilter{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -27197,7 +29642,7 @@ func (m *UpdateRuntimeConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27220,15 +29665,15 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27253,31 +29698,11 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Status = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27302,13 +29727,13 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27318,20 +29743,109 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LabelSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27354,7 +29868,7 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
+func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27377,15 +29891,15 @@ func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27409,81 +29923,11 @@ func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, &RuntimeCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+			m.Stats = append(m.Stats, &ContainerStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Verbose = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -27505,7 +29949,7 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27528,15 +29972,44 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27560,16 +30033,16 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &RuntimeStatus{}
+			if m.Metadata == nil {
+				m.Metadata = &ContainerMetadata{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27593,8 +30066,8 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -27683,113 +30156,13 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Info[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ImageFsInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageFsInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageFsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UInt64Value) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UInt64Value: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UInt64Value: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27799,89 +30172,109 @@ func (m *UInt64Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FilesystemIdentifier: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilesystemIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mountpoint", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Mountpoint = string(dAtA[iNdEx:postIndex])
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27904,7 +30297,7 @@ func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
+func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27927,34 +30320,15 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilesystemUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilesystemUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27978,16 +30352,16 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FsId == nil {
-				m.FsId = &FilesystemIdentifier{}
+			if m.Attributes == nil {
+				m.Attributes = &ContainerAttributes{}
 			}
-			if err := m.FsId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28011,16 +30385,16 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsedBytes == nil {
-				m.UsedBytes = &UInt64Value{}
+			if m.Cpu == nil {
+				m.Cpu = &CpuUsage{}
 			}
-			if err := m.UsedBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InodesUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28044,66 +30418,16 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InodesUsed == nil {
-				m.InodesUsed = &UInt64Value{}
+			if m.Memory == nil {
+				m.Memory = &MemoryUsage{}
 			}
-			if err := m.InodesUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageFsInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageFsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFilesystems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WritableLayer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28115,101 +30439,24 @@ func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImageFilesystems = append(m.ImageFilesystems, &FilesystemUsage{})
-			if err := m.ImageFilesystems[len(m.ImageFilesystems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerStatsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.WritableLayer == nil {
+				m.WritableLayer = &FilesystemUsage{}
+			}
+			if err := m.WritableLayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28232,7 +30479,7 @@ func (m *ContainerStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28255,15 +30502,34 @@ func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageCoreNanoSeconds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28287,10 +30553,10 @@ func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ContainerStats{}
+			if m.UsageCoreNanoSeconds == nil {
+				m.UsageCoreNanoSeconds = &UInt64Value{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UsageCoreNanoSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28315,7 +30581,7 @@ func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28338,15 +30604,34 @@ func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainerStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoryUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoryUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingSetBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28370,10 +30655,10 @@ func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ContainerStatsFilter{}
+			if m.WorkingSetBytes == nil {
+				m.WorkingSetBytes = &UInt64Value{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkingSetBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28398,7 +30683,7 @@ func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
+func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28421,187 +30706,40 @@ func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatsFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReopenContainerLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReopenContainerLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.LabelSelector[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28624,7 +30762,7 @@ func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28647,43 +30785,12 @@ func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainerStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReopenContainerLogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReopenContainerLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stats = append(m.Stats, &ContainerStats{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -28705,7 +30812,7 @@ func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
+func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28728,15 +30835,15 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28761,164 +30868,113 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ContainerMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -28928,109 +30984,149 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveVolumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29053,7 +31149,7 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStats) Unmarshal(dAtA []byte) error {
+func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29076,17 +31172,67 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartPodSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29096,63 +31242,126 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = &ContainerAttributes{}
-			}
-			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthApi
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartPodSandboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cpu == nil {
-				m.Cpu = &CpuUsage{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartPodSandboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSandboxStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSandboxStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSandboxStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29162,28 +31371,74 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memory == nil {
-				m.Memory = &MemoryUsage{}
-			}
-			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSandboxStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSandboxStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSandboxStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WritableLayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29207,10 +31462,10 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WritableLayer == nil {
-				m.WritableLayer = &FilesystemUsage{}
+			if m.Stats == nil {
+				m.Stats = &PodSandboxStats{}
 			}
-			if err := m.WritableLayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29235,7 +31490,7 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuUsage) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29258,17 +31513,17 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStatsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStatsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29278,14 +31533,24 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageCoreNanoSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29309,12 +31574,97 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsageCoreNanoSeconds == nil {
-				m.UsageCoreNanoSeconds = &UInt64Value{}
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
 			}
-			if err := m.UsageCoreNanoSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.LabelSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29337,7 +31687,7 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
+func (m *ListPodSandboxStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29360,34 +31710,15 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPodSandboxStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPodSandboxStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkingSetBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29411,10 +31742,10 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkingSetBytes == nil {
-				m.WorkingSetBytes = &UInt64Value{}
+			if m.Filter == nil {
+				m.Filter = &PodSandboxStatsFilter{}
 			}
-			if err := m.WorkingSetBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29439,7 +31770,7 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPodSandboxStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29462,17 +31793,17 @@ func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReopenContainerLogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPodSandboxStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReopenContainerLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPodSandboxStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29482,20 +31813,22 @@ func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Stats = append(m.Stats, &PodSandboxStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29518,7 +31851,7 @@ func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29541,67 +31874,79 @@ func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReopenContainerLogResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReopenContainerLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Metadata == nil {
+				m.Metadata = &PodSandboxMetadata{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29611,71 +31956,228 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -29697,7 +32199,7 @@ func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29720,17 +32222,17 @@ func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpauseContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29740,20 +32242,57 @@ func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.Attributes == nil {
+				m.Attributes = &PodSandboxAttributes{}
+			}
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Linux == nil {
+				m.Linux = &LinuxPodSandboxStats{}
+			}
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29776,7 +32315,7 @@ func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *LinuxPodSandboxStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29799,67 +32338,116 @@ func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpauseContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinuxPodSandboxStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinuxPodSandboxStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cpu == nil {
+				m.Cpu = &CpuUsage{}
+			}
+			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			if m.Memory == nil {
+				m.Memory = &MemoryUsage{}
 			}
-			if iNdEx >= l {
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Network == nil {
+				m.Network = &NetworkUsage{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveVolumeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Network.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29869,20 +32457,55 @@ func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &ProcessUsage{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeName = string(dAtA[iNdEx:postIndex])
+			m.Containers = append(m.Containers, &ContainerStats{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29905,7 +32528,7 @@ func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
+func (m *NetworkUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29928,12 +32551,95 @@ func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveVolumeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInterface", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultInterface == nil {
+				m.DefaultInterface = &NetworkInterfaceUsage{}
+			}
+			if err := m.DefaultInterface.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interfaces = append(m.Interfaces, &NetworkInterfaceUsage{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -29955,7 +32661,7 @@ func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *NetworkInterfaceUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29978,15 +32684,15 @@ func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartPodSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkInterfaceUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkInterfaceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30011,7 +32717,139 @@ func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RxBytes == nil {
+				m.RxBytes = &UInt64Value{}
+			}
+			if err := m.RxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RxErrors == nil {
+				m.RxErrors = &UInt64Value{}
+			}
+			if err := m.RxErrors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxBytes == nil {
+				m.TxBytes = &UInt64Value{}
+			}
+			if err := m.TxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxErrors == nil {
+				m.TxErrors = &UInt64Value{}
+			}
+			if err := m.TxErrors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30034,7 +32872,7 @@ func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartPodSandboxResponse) Unmarshal(dAtA []byte) error {
+func (m *ProcessUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30057,12 +32895,64 @@ func (m *StartPodSandboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartPodSandboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessCount == nil {
+				m.ProcessCount = &UInt64Value{}
+			}
+			if err := m.ProcessCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -30192,339 +33082,359 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 5333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x73, 0x1b, 0x57,
-	0x76, 0x30, 0xf1, 0x20, 0x09, 0x1c, 0x10, 0x24, 0x78, 0x49, 0x89, 0x10, 0x64, 0xbd, 0x5a, 0x6f,
-	0xd9, 0xa2, 0x2c, 0x7a, 0x46, 0xb6, 0x1e, 0x23, 0x9b, 0x22, 0x29, 0x09, 0xdf, 0x48, 0x20, 0xbe,
-	0x06, 0x69, 0xd9, 0x63, 0x57, 0xf5, 0x34, 0xd1, 0x97, 0x60, 0x5b, 0x40, 0x77, 0xbb, 0x6f, 0x43,
-	0x14, 0x93, 0xaa, 0x94, 0xab, 0x52, 0x35, 0x8b, 0xac, 0xb2, 0xce, 0x72, 0x66, 0x91, 0x45, 0x36,
-	0xa9, 0x54, 0x65, 0x95, 0x6c, 0x92, 0x9a, 0x4a, 0xcd, 0x66, 0xaa, 0xb2, 0x4a, 0xe5, 0xb1, 0x89,
-	0x9d, 0xac, 0x66, 0x91, 0xca, 0x3f, 0x48, 0xea, 0xbe, 0x1a, 0xfd, 0xc4, 0x83, 0x96, 0xc7, 0xce,
-	0x8a, 0xb8, 0xa7, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0x4f, 0x9f, 0x7b, 0x1e, 0xb7, 0x09, 0x45, 0xdd,
-	0x31, 0x57, 0x1d, 0xd7, 0xf6, 0x6c, 0x54, 0x71, 0xfb, 0x96, 0x67, 0xf6, 0xf0, 0xea, 0xab, 0xdb,
-	0x7a, 0xd7, 0x39, 0xd0, 0xd7, 0x6a, 0x37, 0x3b, 0xa6, 0x77, 0xd0, 0xdf, 0x5b, 0x6d, 0xdb, 0xbd,
-	0x5b, 0x1d, 0xbb, 0x63, 0xdf, 0x62, 0x88, 0x7b, 0xfd, 0x7d, 0x36, 0x62, 0x03, 0xf6, 0x8b, 0x33,
-	0x50, 0x6e, 0xc0, 0xfc, 0xc7, 0xd8, 0x25, 0xa6, 0x6d, 0xa9, 0xf8, 0xcb, 0x3e, 0x26, 0x1e, 0xaa,
-	0xc2, 0xec, 0x2b, 0x0e, 0xa9, 0x66, 0xce, 0x67, 0xae, 0x15, 0x55, 0x39, 0x54, 0xfe, 0x3c, 0x03,
-	0x0b, 0x3e, 0x32, 0x71, 0x6c, 0x8b, 0xe0, 0x74, 0x6c, 0x74, 0x01, 0xe6, 0x84, 0x70, 0x9a, 0xa5,
-	0xf7, 0x70, 0x35, 0xcb, 0x1e, 0x97, 0x04, 0xac, 0xa1, 0xf7, 0x30, 0xba, 0x0a, 0x0b, 0x12, 0x45,
-	0x32, 0xc9, 0x31, 0xac, 0x79, 0x01, 0x16, 0xb3, 0xa1, 0x55, 0x58, 0x92, 0x88, 0xba, 0x63, 0xfa,
-	0xc8, 0x79, 0x86, 0xbc, 0x28, 0x1e, 0xad, 0x3b, 0xa6, 0xc0, 0x57, 0x3e, 0x83, 0xe2, 0x66, 0xa3,
-	0xb5, 0x61, 0x5b, 0xfb, 0x66, 0x87, 0x8a, 0x48, 0xb0, 0x4b, 0x69, 0xaa, 0x99, 0xf3, 0x39, 0x2a,
-	0xa2, 0x18, 0xa2, 0x1a, 0x14, 0x08, 0xd6, 0xdd, 0xf6, 0x01, 0x26, 0xd5, 0x2c, 0x7b, 0xe4, 0x8f,
-	0x29, 0x95, 0xed, 0x78, 0xa6, 0x6d, 0x91, 0x6a, 0x8e, 0x53, 0x89, 0xa1, 0xf2, 0xcb, 0x0c, 0x94,
-	0x9a, 0xb6, 0xeb, 0x3d, 0xd7, 0x1d, 0xc7, 0xb4, 0x3a, 0xe8, 0x0e, 0x14, 0x98, 0x2e, 0xdb, 0x76,
-	0x97, 0xe9, 0x60, 0x7e, 0xad, 0xb6, 0x1a, 0xdd, 0x96, 0xd5, 0xa6, 0xc0, 0x50, 0x7d, 0x5c, 0x74,
-	0x19, 0xe6, 0xdb, 0xb6, 0xe5, 0xe9, 0xa6, 0x85, 0x5d, 0xcd, 0xb1, 0x5d, 0x8f, 0xa9, 0x68, 0x5a,
-	0x2d, 0xfb, 0x50, 0x3a, 0x0b, 0x3a, 0x0d, 0xc5, 0x03, 0x9b, 0x78, 0x1c, 0x23, 0xc7, 0x30, 0x0a,
-	0x14, 0xc0, 0x1e, 0xae, 0xc0, 0x2c, 0x7b, 0x68, 0x3a, 0x42, 0x19, 0x33, 0x74, 0x58, 0x77, 0x94,
-	0xdf, 0x65, 0x60, 0xfa, 0xb9, 0xdd, 0xb7, 0xbc, 0xc8, 0x34, 0xba, 0x77, 0x20, 0x36, 0x2a, 0x30,
-	0x8d, 0xee, 0x1d, 0x0c, 0xa6, 0xa1, 0x18, 0x7c, 0xaf, 0xf8, 0x34, 0xf4, 0x61, 0x0d, 0x0a, 0x2e,
-	0xd6, 0x0d, 0xdb, 0xea, 0x1e, 0x31, 0x11, 0x0a, 0xaa, 0x3f, 0xa6, 0x9b, 0x48, 0x70, 0xd7, 0xb4,
-	0xfa, 0xaf, 0x35, 0x17, 0x77, 0xf5, 0x3d, 0xdc, 0x65, 0xa2, 0x14, 0xd4, 0x79, 0x01, 0x56, 0x39,
-	0x14, 0x6d, 0x42, 0xc9, 0x71, 0x6d, 0x47, 0xef, 0xe8, 0x54, 0x8f, 0xd5, 0x69, 0xa6, 0x2a, 0x25,
-	0xae, 0x2a, 0x26, 0x76, 0x73, 0x80, 0xa9, 0x06, 0xc9, 0x10, 0x82, 0x3c, 0x33, 0x27, 0x83, 0x89,
-	0xc8, 0x7e, 0x2b, 0x7f, 0x95, 0x81, 0x05, 0x6a, 0x50, 0xc4, 0xd1, 0xdb, 0x78, 0x9b, 0x6d, 0x13,
-	0xba, 0x0b, 0xb3, 0x16, 0xf6, 0x0e, 0x6d, 0xf7, 0xa5, 0xd8, 0x94, 0x73, 0xf1, 0x99, 0x7c, 0x9a,
-	0xe7, 0xb6, 0x81, 0x55, 0x89, 0x8f, 0x6e, 0x43, 0xce, 0x31, 0x0d, 0xa6, 0x84, 0x31, 0xc8, 0x28,
-	0x2e, 0x25, 0x31, 0x9d, 0x36, 0xd3, 0xcd, 0x38, 0x24, 0xa6, 0xd3, 0x56, 0x14, 0x80, 0xba, 0xe5,
-	0xdd, 0xf9, 0xd1, 0xc7, 0x7a, 0xb7, 0x8f, 0xd1, 0x32, 0x4c, 0xbf, 0xa2, 0x3f, 0x98, 0xb0, 0x39,
-	0x95, 0x0f, 0x94, 0xaf, 0x73, 0x70, 0xfa, 0x19, 0xd5, 0x61, 0x4b, 0xb7, 0x8c, 0x3d, 0xfb, 0x75,
-	0x0b, 0xb7, 0xfb, 0xae, 0xe9, 0x1d, 0x6d, 0xd8, 0x96, 0x87, 0x5f, 0x7b, 0xa8, 0x01, 0x8b, 0x96,
-	0xe4, 0xac, 0x49, 0x73, 0xa5, 0x1c, 0x4a, 0x6b, 0x17, 0x86, 0x08, 0xc1, 0x55, 0xa4, 0x56, 0xac,
-	0x30, 0x80, 0xa0, 0xa7, 0x83, 0xbd, 0x94, 0xdc, 0xb2, 0x8c, 0x5b, 0xc2, 0x92, 0x5a, 0x5b, 0x4c,
-	0x32, 0xc1, 0x4b, 0x6e, 0xb6, 0xe4, 0xf4, 0x00, 0xe8, 0x9b, 0xae, 0xe9, 0x44, 0xeb, 0x13, 0xec,
-	0x32, 0xc5, 0x94, 0xd6, 0xde, 0x8a, 0x73, 0x19, 0xa8, 0x40, 0x2d, 0xba, 0x7d, 0x6b, 0x9d, 0xec,
-	0x12, 0xec, 0x32, 0xc7, 0x20, 0xec, 0x4b, 0x73, 0x6d, 0xdb, 0xdb, 0x27, 0xd2, 0xa6, 0x24, 0x58,
-	0x65, 0x50, 0x74, 0x0b, 0x96, 0x48, 0xdf, 0x71, 0xba, 0xb8, 0x87, 0x2d, 0x4f, 0xef, 0x6a, 0x1d,
-	0xd7, 0xee, 0x3b, 0xa4, 0x3a, 0x7d, 0x3e, 0x77, 0x2d, 0xa7, 0xa2, 0xe0, 0xa3, 0x27, 0xec, 0x09,
-	0x3a, 0x0b, 0xe0, 0xb8, 0xe6, 0x2b, 0xb3, 0x8b, 0x3b, 0xd8, 0xa8, 0xce, 0x30, 0xa6, 0x01, 0x08,
-	0x7a, 0x17, 0x96, 0x09, 0x6e, 0xb7, 0xed, 0x9e, 0xa3, 0x39, 0xae, 0xbd, 0x6f, 0x76, 0x31, 0x7f,
-	0x23, 0x66, 0x99, 0xb9, 0x21, 0xf1, 0xac, 0xc9, 0x1f, 0xb1, 0x77, 0xe3, 0x21, 0xf3, 0x73, 0x74,
-	0xa5, 0x6c, 0xf2, 0x6a, 0x61, 0x8c, 0xa5, 0x02, 0x5b, 0x2a, 0x13, 0x49, 0xf9, 0x65, 0x16, 0x4e,
-	0x30, 0x4d, 0x36, 0x6d, 0x43, 0x6c, 0xb3, 0x70, 0x5c, 0x17, 0xa1, 0xdc, 0x66, 0x3c, 0x35, 0x47,
-	0x77, 0xb1, 0xe5, 0x89, 0x17, 0x77, 0x8e, 0x03, 0x9b, 0x0c, 0x86, 0x3e, 0x81, 0x0a, 0x11, 0x56,
-	0xa1, 0xb5, 0xb9, 0x59, 0x88, 0x3d, 0xbb, 0x19, 0x17, 0x61, 0x88, 0x2d, 0xa9, 0x0b, 0x24, 0x66,
-	0x5c, 0xb3, 0xe4, 0x88, 0xb4, 0xbd, 0x2e, 0xf7, 0x80, 0xa5, 0xb5, 0x1f, 0xa5, 0x30, 0x8c, 0x0a,
-	0xbe, 0xda, 0xe2, 0x64, 0x5b, 0x96, 0xe7, 0x1e, 0xa9, 0x92, 0x49, 0xed, 0x1e, 0xcc, 0x05, 0x1f,
-	0xa0, 0x0a, 0xe4, 0x5e, 0xe2, 0x23, 0xb1, 0x28, 0xfa, 0x73, 0xf0, 0x12, 0x70, 0xff, 0xc3, 0x07,
-	0xf7, 0xb2, 0x1f, 0x64, 0x14, 0x17, 0xd0, 0x60, 0x96, 0xe7, 0xd8, 0xd3, 0x0d, 0xdd, 0xd3, 0x7d,
-	0x5f, 0x90, 0x19, 0xf8, 0x02, 0xca, 0xb5, 0x2f, 0x5e, 0xde, 0xa2, 0x4a, 0x7f, 0xa2, 0xb7, 0xa0,
-	0xe8, 0x1b, 0xba, 0x38, 0x5f, 0x06, 0x00, 0xea, 0xe7, 0x75, 0xcf, 0xc3, 0x3d, 0xc7, 0x63, 0x26,
-	0x56, 0x56, 0xe5, 0x50, 0xf9, 0xaf, 0x3c, 0x54, 0x62, 0x7b, 0xf2, 0x11, 0x14, 0x7a, 0x62, 0x7a,
-	0xf1, 0xa2, 0x5d, 0x4a, 0x70, 0xf6, 0x31, 0x51, 0x55, 0x9f, 0x8a, 0xfa, 0x52, 0xea, 0x57, 0x03,
-	0x67, 0xa2, 0x3f, 0xa6, 0x3b, 0xde, 0xb5, 0x3b, 0x9a, 0x61, 0xba, 0xb8, 0xed, 0xd9, 0xee, 0x91,
-	0x10, 0x77, 0xae, 0x6b, 0x77, 0x36, 0x25, 0x0c, 0xdd, 0x03, 0x30, 0x2c, 0x42, 0x37, 0x7b, 0xdf,
-	0xec, 0x30, 0xa1, 0x4b, 0x6b, 0xa7, 0xe3, 0x42, 0xf8, 0x07, 0xa0, 0x5a, 0x34, 0x2c, 0x22, 0xc4,
-	0x7f, 0x04, 0x65, 0x7a, 0x8e, 0x68, 0x3d, 0x7e, 0x76, 0xf1, 0x37, 0xa5, 0xb4, 0x76, 0x26, 0x69,
-	0x0d, 0xfe, 0x09, 0xa7, 0xce, 0x39, 0x83, 0x01, 0x41, 0x8f, 0x61, 0x86, 0x39, 0x74, 0x52, 0x9d,
-	0x61, 0xc4, 0xab, 0xc3, 0x14, 0x20, 0x2c, 0xe2, 0x19, 0x23, 0xe0, 0x06, 0x21, 0xa8, 0xd1, 0x2e,
-	0x94, 0x74, 0xcb, 0xb2, 0x3d, 0x9d, 0x3b, 0x9a, 0x59, 0xc6, 0xec, 0xbd, 0x31, 0x98, 0xad, 0x0f,
-	0xa8, 0x38, 0xc7, 0x20, 0x1f, 0xf4, 0x13, 0x98, 0x66, 0x9e, 0x48, 0xbc, 0x88, 0x57, 0xc7, 0x34,
-	0x5a, 0x95, 0x53, 0xd5, 0xee, 0x42, 0x29, 0x20, 0xec, 0x24, 0x46, 0x5a, 0x7b, 0x08, 0x95, 0xa8,
-	0x68, 0x13, 0x19, 0xf9, 0x1f, 0xc2, 0xb2, 0xda, 0xb7, 0x06, 0x82, 0xc9, 0x88, 0xec, 0x1e, 0xcc,
-	0x88, 0xcd, 0xe6, 0x16, 0xa7, 0x8c, 0xd6, 0x91, 0x2a, 0x28, 0x82, 0x21, 0xd6, 0x81, 0x6e, 0x19,
-	0x5d, 0xec, 0x8a, 0x79, 0x65, 0x88, 0xf5, 0x94, 0x43, 0x95, 0x9f, 0xc0, 0x89, 0xc8, 0xe4, 0x22,
-	0xc2, 0xbb, 0x04, 0xf3, 0x8e, 0x6d, 0x68, 0x84, 0x83, 0x35, 0xd3, 0x90, 0x6e, 0xc8, 0xf1, 0x71,
-	0xeb, 0x06, 0x25, 0x6f, 0x79, 0xb6, 0x13, 0x17, 0x7e, 0x3c, 0xf2, 0x2a, 0x9c, 0x8c, 0x92, 0xf3,
-	0xe9, 0x95, 0x0f, 0x61, 0x45, 0xc5, 0x3d, 0xfb, 0x15, 0x3e, 0x2e, 0xeb, 0x1a, 0x54, 0xe3, 0x0c,
-	0x04, 0xf3, 0x4f, 0x61, 0x65, 0x00, 0x6d, 0x79, 0xba, 0xd7, 0x27, 0x13, 0x31, 0x17, 0xe1, 0xef,
-	0x9e, 0x4d, 0xf8, 0x76, 0x16, 0x54, 0x39, 0x54, 0xae, 0x07, 0x59, 0x37, 0x78, 0x64, 0xc1, 0x67,
-	0x40, 0xf3, 0x90, 0x35, 0x1d, 0xc1, 0x2e, 0x6b, 0x3a, 0xca, 0x53, 0x28, 0xfa, 0x47, 0x33, 0xba,
-	0x3f, 0x88, 0x3b, 0xb3, 0xe3, 0x1e, 0xe4, 0x7e, 0x68, 0xba, 0x13, 0x3b, 0x4a, 0xc4, 0x94, 0xf7,
-	0x01, 0x7c, 0x97, 0x27, 0x23, 0x84, 0xd3, 0x43, 0x18, 0xab, 0x01, 0x74, 0xe5, 0x5f, 0x43, 0x8e,
-	0x30, 0xb0, 0x08, 0xc3, 0x5f, 0x84, 0x11, 0x72, 0x8c, 0xd9, 0x63, 0x39, 0xc6, 0xf7, 0x61, 0x9a,
-	0x78, 0xba, 0x87, 0x45, 0x14, 0x75, 0x61, 0x18, 0x39, 0x15, 0x02, 0xab, 0x1c, 0x1f, 0x9d, 0x01,
-	0x68, 0xbb, 0x58, 0xf7, 0xb0, 0xa1, 0xe9, 0xdc, 0x8b, 0xe7, 0xd4, 0xa2, 0x80, 0xac, 0x7b, 0x68,
-	0x63, 0x10, 0x09, 0x4e, 0x33, 0xc1, 0xae, 0x0f, 0xe3, 0x1c, 0xda, 0xaa, 0x41, 0x4c, 0xe8, 0x7b,
-	0x95, 0x99, 0x31, 0xbd, 0x8a, 0x60, 0xc0, 0xa9, 0x02, 0x3e, 0x73, 0x76, 0xb4, 0xcf, 0xe4, 0xa4,
-	0xe3, 0xf8, 0xcc, 0xc2, 0x68, 0x9f, 0x29, 0x98, 0x0d, 0xf5, 0x99, 0xdf, 0xa7, 0xd3, 0xfb, 0x97,
-	0x0c, 0x54, 0xe3, 0xef, 0xa0, 0xf0, 0x3d, 0xf7, 0x60, 0x86, 0x30, 0xc8, 0x38, 0x9e, 0x4f, 0xd0,
-	0x0a, 0x0a, 0xf4, 0x14, 0xf2, 0xa6, 0xb5, 0x6f, 0xb3, 0xc4, 0x2e, 0x31, 0x76, 0x49, 0x9b, 0x75,
-	0xb5, 0x6e, 0xed, 0xdb, 0x5c, 0x49, 0x8c, 0x43, 0xed, 0x7d, 0x28, 0xfa, 0xa0, 0x89, 0xd6, 0xb6,
-	0x0d, 0xcb, 0x11, 0x93, 0xe5, 0xc1, 0xbe, 0x6f, 0xe9, 0x99, 0xc9, 0x2c, 0x5d, 0xf9, 0x2a, 0x1b,
-	0x7c, 0x13, 0x1f, 0x9b, 0x5d, 0x0f, 0xbb, 0xb1, 0x37, 0xf1, 0x81, 0xe4, 0xce, 0x5f, 0xc3, 0x2b,
-	0x23, 0xb9, 0xf3, 0x98, 0x54, 0xbc, 0x4c, 0x9f, 0xc3, 0x3c, 0xb3, 0x35, 0x8d, 0xe0, 0x2e, 0x0b,
-	0x38, 0x44, 0xf0, 0xf7, 0xe3, 0x61, 0x6c, 0xb8, 0x24, 0xdc, 0x62, 0x5b, 0x82, 0x8e, 0x6b, 0xb0,
-	0xdc, 0x0d, 0xc2, 0x6a, 0x1f, 0x01, 0x8a, 0x23, 0x4d, 0xa4, 0xd3, 0x16, 0x75, 0x71, 0x34, 0xfb,
-	0x4d, 0x38, 0x25, 0xf7, 0x99, 0x18, 0xe3, 0xd8, 0x0a, 0x17, 0x58, 0x15, 0x14, 0xca, 0xaf, 0x73,
-	0x00, 0x83, 0x87, 0xff, 0x87, 0x7c, 0xdb, 0x47, 0xbe, 0x5f, 0xe1, 0x81, 0xdc, 0xb5, 0x61, 0x8c,
-	0x13, 0x3d, 0xca, 0x76, 0xd8, 0xa3, 0xf0, 0x90, 0xee, 0xe6, 0x50, 0x36, 0x3f, 0x58, 0x5f, 0xf2,
-	0x0c, 0x4e, 0x46, 0x6d, 0x43, 0x38, 0x92, 0x35, 0x98, 0x36, 0x3d, 0xdc, 0xe3, 0x15, 0xa0, 0xc4,
-	0xec, 0x2c, 0x40, 0xc4, 0x51, 0x95, 0x0b, 0x50, 0xac, 0xf7, 0xf4, 0x0e, 0x6e, 0x39, 0xb8, 0x4d,
-	0x27, 0x35, 0xe9, 0x40, 0x08, 0xc2, 0x07, 0xca, 0x1a, 0x14, 0x7e, 0x8a, 0x8f, 0xf8, 0x4b, 0x3d,
-	0xa6, 0xa0, 0xca, 0x3f, 0x14, 0x60, 0x85, 0x9d, 0x15, 0x1b, 0xb2, 0xfe, 0xa2, 0x62, 0x62, 0xf7,
-	0xdd, 0x36, 0x26, 0x6c, 0xb7, 0x9d, 0xbe, 0xe6, 0x60, 0xd7, 0xb4, 0x0d, 0x51, 0x0a, 0x28, 0xb6,
-	0x9d, 0x7e, 0x93, 0x01, 0xd0, 0x69, 0xa0, 0x03, 0xed, 0xcb, 0xbe, 0x2d, 0x0c, 0x31, 0xa7, 0x16,
-	0xda, 0x4e, 0xff, 0xff, 0xd3, 0xb1, 0xa4, 0x25, 0x07, 0xba, 0x8b, 0x09, 0xb3, 0x33, 0x4e, 0xdb,
-	0x62, 0x00, 0x74, 0x1b, 0x4e, 0xf4, 0x70, 0xcf, 0x76, 0x8f, 0xb4, 0xae, 0xd9, 0x33, 0x3d, 0xcd,
-	0xb4, 0xb4, 0xbd, 0x23, 0x0f, 0x13, 0x61, 0x53, 0x88, 0x3f, 0x7c, 0x46, 0x9f, 0xd5, 0xad, 0x47,
-	0xf4, 0x09, 0x52, 0xa0, 0x6c, 0xdb, 0x3d, 0x8d, 0xb4, 0x6d, 0x17, 0x6b, 0xba, 0xf1, 0x05, 0x3b,
-	0x3e, 0x73, 0x6a, 0xc9, 0xb6, 0x7b, 0x2d, 0x0a, 0x5b, 0x37, 0xbe, 0x40, 0xe7, 0xa0, 0xd4, 0x76,
-	0xfa, 0x04, 0x7b, 0x1a, 0xfd, 0xc3, 0x4e, 0xc7, 0xa2, 0x0a, 0x1c, 0xb4, 0xe1, 0xf4, 0x49, 0x00,
-	0xa1, 0x47, 0xf5, 0x3f, 0x1b, 0x44, 0x78, 0x8e, 0x7b, 0x04, 0xbd, 0x00, 0x30, 0x4c, 0xf2, 0x52,
-	0xac, 0xca, 0x60, 0xfb, 0xf3, 0x41, 0xca, 0xf1, 0x1a, 0x57, 0xd9, 0xea, 0xa6, 0x49, 0x5e, 0x32,
-	0x05, 0x70, 0x53, 0x2c, 0x1a, 0x72, 0x8c, 0x2e, 0xc0, 0xdc, 0x5e, 0xf7, 0xa5, 0x69, 0x6b, 0x87,
-	0xd8, 0xec, 0x1c, 0x78, 0x55, 0xcc, 0xd2, 0xbb, 0x12, 0x83, 0xbd, 0x60, 0x20, 0xd4, 0x80, 0xa5,
-	0x20, 0x8a, 0x66, 0xe0, 0x57, 0x66, 0x1b, 0x57, 0xf7, 0x99, 0x10, 0x67, 0xe3, 0x42, 0x70, 0xb2,
-	0x4d, 0x86, 0xa5, 0x2e, 0x06, 0x38, 0x71, 0x10, 0x6a, 0xc1, 0x09, 0xce, 0x8f, 0x33, 0xd2, 0x5c,
-	0xac, 0x1b, 0xda, 0x9e, 0x43, 0xaa, 0x1d, 0xc6, 0xf1, 0x7c, 0x9c, 0xe3, 0xce, 0x81, 0x6b, 0x7b,
-	0x5e, 0x17, 0x0b, 0x9e, 0x88, 0x91, 0x8b, 0x01, 0xd6, 0x8d, 0x47, 0x0e, 0x3d, 0xf3, 0x4f, 0x86,
-	0x98, 0x1e, 0xba, 0xa6, 0x87, 0x19, 0xd7, 0x83, 0x31, 0xb9, 0x2e, 0x05, 0xb8, 0xbe, 0xa0, 0xd4,
-	0x49, 0x6c, 0x99, 0xac, 0xf5, 0x6d, 0x87, 0x54, 0xcd, 0x63, 0xb0, 0xa5, 0xc2, 0x52, 0x62, 0xf4,
-	0x02, 0x56, 0x12, 0xa4, 0x65, 0x7c, 0xbf, 0x18, 0x93, 0xef, 0x72, 0x54, 0x5c, 0xc6, 0xf8, 0x22,
-	0x94, 0x5f, 0x62, 0xd7, 0xc2, 0x5d, 0x8d, 0x9b, 0x6a, 0xf5, 0x25, 0xb3, 0xc6, 0x39, 0x0e, 0x7c,
-	0xce, 0x60, 0xe8, 0x26, 0x08, 0x43, 0xd6, 0x5c, 0x4c, 0xb0, 0xfb, 0x8a, 0x97, 0x1a, 0xbb, 0x0c,
-	0x73, 0x91, 0x3f, 0x51, 0x07, 0x0f, 0x50, 0x1d, 0x04, 0x50, 0x23, 0x87, 0x2c, 0xbd, 0xc5, 0x84,
-	0x54, 0x7b, 0x63, 0x14, 0x70, 0x2a, 0x9c, 0xac, 0xe5, 0x53, 0xa1, 0x35, 0x98, 0xed, 0xb3, 0x37,
-	0x8b, 0x54, 0x2d, 0xb6, 0xce, 0x6a, 0x9c, 0xc1, 0x2e, 0x43, 0x50, 0x25, 0x62, 0xed, 0x01, 0xcc,
-	0x87, 0xcd, 0x77, 0x22, 0x6f, 0x77, 0x0f, 0xe6, 0x42, 0xc6, 0x87, 0x20, 0x1f, 0x28, 0xef, 0xb2,
-	0xdf, 0xe8, 0x24, 0xcc, 0x70, 0x1c, 0x46, 0x5e, 0x56, 0xc5, 0x48, 0xf9, 0x00, 0xe6, 0xc3, 0x4a,
-	0x4f, 0xa4, 0x46, 0x90, 0x77, 0x65, 0x20, 0x91, 0x57, 0xd9, 0x6f, 0x65, 0x13, 0x66, 0xf8, 0x32,
-	0x12, 0xab, 0x2f, 0x08, 0xf2, 0x07, 0xba, 0x6b, 0x08, 0xe7, 0xc4, 0x7e, 0x53, 0x18, 0xb1, 0xf7,
-	0x3d, 0xe1, 0x92, 0xd8, 0x6f, 0x45, 0x87, 0x72, 0xa8, 0x7e, 0x48, 0x91, 0x58, 0xa1, 0x50, 0x30,
-	0xa3, 0xbf, 0xd9, 0xf4, 0x76, 0x57, 0xae, 0x9c, 0xfd, 0xa6, 0x30, 0xef, 0xc8, 0x91, 0x75, 0x1c,
-	0xf6, 0x9b, 0xaa, 0xa8, 0x8b, 0x5f, 0x89, 0xba, 0x73, 0x51, 0xe5, 0x03, 0xc5, 0x00, 0xd8, 0xd0,
-	0x1d, 0x7d, 0xcf, 0xec, 0x9a, 0xde, 0x11, 0xba, 0x0e, 0x15, 0xdd, 0x30, 0xb4, 0xb6, 0x84, 0x98,
-	0x58, 0x76, 0x03, 0x16, 0x74, 0xc3, 0xd8, 0x08, 0x80, 0xd1, 0xdb, 0xb0, 0x68, 0xb8, 0xb6, 0x13,
-	0xc6, 0xe5, 0xed, 0x81, 0x0a, 0x7d, 0x10, 0x44, 0x56, 0xfe, 0x73, 0x1a, 0xce, 0x84, 0x5d, 0x53,
-	0xb4, 0x46, 0xfb, 0x11, 0xcc, 0x45, 0x66, 0x4d, 0x31, 0xaf, 0x81, 0xb4, 0x6a, 0x88, 0x22, 0x52,
-	0xb3, 0xcc, 0xc6, 0x6a, 0x96, 0x89, 0x55, 0xe0, 0xdc, 0x1b, 0xad, 0x02, 0xe7, 0xdf, 0x48, 0x15,
-	0x78, 0x7a, 0xb2, 0x2a, 0xf0, 0x15, 0x56, 0xbb, 0x90, 0xd4, 0xcc, 0xd6, 0xf8, 0xf9, 0x52, 0xf6,
-	0x71, 0x2c, 0xd9, 0x46, 0x8a, 0x54, 0x8b, 0x67, 0x27, 0xa9, 0x16, 0x17, 0x52, 0xab, 0xc5, 0xd4,
-	0x6a, 0x1c, 0x47, 0x77, 0x7b, 0xb6, 0x2b, 0xcb, 0xc1, 0xd5, 0x22, 0x13, 0x61, 0x41, 0xc2, 0x45,
-	0x29, 0x38, 0xb5, 0x70, 0x0c, 0xa9, 0x85, 0xe3, 0xf3, 0x30, 0x67, 0xd9, 0x9a, 0x85, 0x0f, 0x35,
-	0xba, 0x97, 0xa4, 0x5a, 0xe2, 0x1b, 0x6b, 0xd9, 0x0d, 0x7c, 0xd8, 0xa4, 0x90, 0x58, 0x69, 0x79,
-	0x6e, 0xb2, 0xd2, 0x32, 0x3d, 0x01, 0x7b, 0x3a, 0x79, 0x89, 0x0d, 0x26, 0x0a, 0xa9, 0x96, 0x99,
-	0x11, 0x97, 0x38, 0x8c, 0xca, 0x40, 0xd0, 0x65, 0xf0, 0x95, 0x24, 0x90, 0xe6, 0x19, 0x52, 0x59,
-	0x42, 0x19, 0x9a, 0xf2, 0x37, 0x19, 0x58, 0x0e, 0x9b, 0xb9, 0x28, 0x28, 0x3e, 0x81, 0xa2, 0x2b,
-	0xcf, 0x62, 0x61, 0xda, 0xd7, 0xc7, 0x3e, 0xbc, 0xd5, 0x01, 0x2d, 0xfa, 0x59, 0x6a, 0x1d, 0xfb,
-	0xd6, 0x28, 0x7e, 0xa3, 0x2a, 0xd9, 0x4a, 0x1d, 0xce, 0xbd, 0x30, 0x2d, 0xc3, 0x3e, 0x24, 0xa9,
-	0x6f, 0x69, 0x82, 0xad, 0x65, 0x12, 0x6c, 0x4d, 0xf9, 0xbb, 0x0c, 0x9c, 0x8c, 0xf2, 0x12, 0xaa,
-	0xa8, 0xc7, 0x55, 0xf1, 0x76, 0x42, 0x08, 0x11, 0x21, 0x4e, 0x54, 0xc6, 0xe7, 0xa9, 0xca, 0xb8,
-	0x3d, 0x9a, 0xe3, 0x48, 0x75, 0xfc, 0x45, 0x06, 0x4e, 0xa5, 0x8a, 0x11, 0x89, 0x23, 0x33, 0xd1,
-	0x38, 0x52, 0xc4, 0xa0, 0x6d, 0xbb, 0x6f, 0x79, 0x81, 0x18, 0x74, 0x83, 0xf5, 0x1a, 0x79, 0xb0,
-	0xa7, 0xf5, 0xf4, 0xd7, 0x66, 0xaf, 0xdf, 0x13, 0x1e, 0x9f, 0xb2, 0x7b, 0xce, 0x21, 0xc7, 0x88,
-	0x42, 0x95, 0x75, 0x58, 0xf4, 0xa5, 0x1c, 0x5a, 0xf9, 0x0f, 0x54, 0xf2, 0xb3, 0xe1, 0x4a, 0xbe,
-	0x05, 0x33, 0xe2, 0x94, 0x7b, 0x13, 0xcd, 0xd0, 0xf3, 0x50, 0x72, 0xb0, 0xdb, 0x33, 0x09, 0xf1,
-	0x1d, 0x6d, 0x51, 0x0d, 0x82, 0x94, 0x5f, 0xcd, 0xc2, 0x42, 0xd4, 0x3a, 0x3e, 0x8c, 0x35, 0x0e,
-	0x2e, 0x26, 0x1c, 0x01, 0xd1, 0x85, 0x06, 0x52, 0xc8, 0xdb, 0x32, 0x03, 0xc9, 0xa6, 0x55, 0xef,
-	0xfc, 0x6c, 0x45, 0xa4, 0x27, 0x54, 0x23, 0x6d, 0xbb, 0xd7, 0xd3, 0x2d, 0x43, 0xf6, 0xb0, 0xc5,
-	0x90, 0xea, 0x4f, 0x77, 0x3b, 0x54, 0xed, 0x14, 0xcc, 0x7e, 0xd3, 0xcd, 0x3b, 0xb4, 0xdd, 0x97,
-	0xa6, 0xc5, 0x1a, 0x10, 0xcc, 0x59, 0x17, 0x55, 0x10, 0xa0, 0x4d, 0xd3, 0x45, 0xab, 0x90, 0xc7,
-	0xd6, 0x2b, 0x99, 0x23, 0x26, 0x34, 0xb9, 0x65, 0x2e, 0xa4, 0x32, 0x3c, 0x74, 0x0b, 0x66, 0x7a,
-	0xd4, 0x2c, 0x64, 0xd1, 0x6b, 0x25, 0xa5, 0xd7, 0xab, 0x0a, 0x34, 0x1a, 0x43, 0xf1, 0xa8, 0x51,
-	0x56, 0xb6, 0x12, 0x62, 0x28, 0x11, 0x23, 0x4a, 0x44, 0xb4, 0xe5, 0x67, 0xc0, 0xc5, 0xb4, 0xd4,
-	0x35, 0xb2, 0x15, 0x89, 0x69, 0xf0, 0x4e, 0x38, 0x0d, 0x06, 0xc6, 0x6b, 0x6d, 0x34, 0xaf, 0xe1,
-	0xbd, 0x88, 0x53, 0x50, 0xe8, 0xda, 0x1d, 0x6e, 0x46, 0x25, 0x7e, 0x3d, 0xa2, 0x6b, 0x77, 0x98,
-	0x15, 0x2d, 0xc3, 0x34, 0xf1, 0x0c, 0xd3, 0x62, 0x4e, 0xbd, 0xa0, 0xf2, 0x01, 0x7d, 0xf9, 0xd8,
-	0x0f, 0xcd, 0xb6, 0xda, 0xb8, 0x5a, 0x66, 0x8f, 0x8a, 0x0c, 0xb2, 0x6d, 0xb5, 0x59, 0x8e, 0xe9,
-	0x79, 0x47, 0xd5, 0x79, 0x06, 0xa7, 0x3f, 0xd1, 0x03, 0x59, 0x97, 0x5c, 0x48, 0x2b, 0xf6, 0x24,
-	0xb9, 0x6d, 0x59, 0x96, 0x7c, 0x04, 0xb3, 0x87, 0xdc, 0x11, 0x54, 0x2b, 0x8c, 0xfe, 0xda, 0x68,
-	0xf7, 0x22, 0x38, 0x48, 0x42, 0x7a, 0xc8, 0x58, 0xd8, 0xa3, 0x67, 0x98, 0x4d, 0x9d, 0x0c, 0x6b,
-	0xcc, 0xe7, 0xd4, 0x92, 0x85, 0xbd, 0xa6, 0x00, 0x51, 0x35, 0xb0, 0xec, 0x4e, 0x33, 0x0d, 0x96,
-	0x85, 0x15, 0xd5, 0x59, 0x36, 0xae, 0x1b, 0xdf, 0x67, 0xb5, 0xe0, 0xd7, 0x19, 0x38, 0xb9, 0xc1,
-	0x2a, 0x29, 0x01, 0x2f, 0x38, 0x49, 0xf1, 0xff, 0xae, 0xdf, 0x97, 0x49, 0xad, 0xd4, 0x47, 0xb5,
-	0x26, 0xdb, 0x32, 0x75, 0x98, 0x97, 0xcc, 0x05, 0x8b, 0xdc, 0xd8, 0xad, 0x9d, 0x32, 0x09, 0x0e,
-	0x95, 0x07, 0xb0, 0x12, 0x5b, 0x85, 0xa8, 0x7a, 0x5c, 0x80, 0xb9, 0x81, 0xb7, 0xf3, 0x17, 0x51,
-	0xf2, 0x61, 0x75, 0x43, 0xb9, 0x07, 0x27, 0x5a, 0x9e, 0xee, 0x7a, 0x31, 0x15, 0x8c, 0x41, 0xcb,
-	0x9a, 0x36, 0x61, 0x5a, 0xd1, 0x57, 0x69, 0xc1, 0x72, 0xcb, 0xb3, 0x9d, 0x63, 0x30, 0xa5, 0x3e,
-	0x8b, 0xae, 0xdf, 0xee, 0xcb, 0xd3, 0x45, 0x0e, 0x95, 0x15, 0xde, 0x62, 0x8a, 0xcf, 0x76, 0x1f,
-	0x4e, 0xf2, 0x0e, 0xcf, 0x71, 0x16, 0x71, 0x4a, 0xf6, 0x97, 0xe2, 0x7c, 0x9f, 0xc3, 0xd2, 0xe0,
-	0x50, 0x1d, 0x54, 0x6f, 0xef, 0x84, 0xab, 0xb7, 0xe7, 0x87, 0xec, 0x7a, 0xa8, 0x78, 0xfb, 0xab,
-	0x6c, 0xe0, 0x54, 0x48, 0xa9, 0xdd, 0xde, 0x0f, 0xd7, 0x6e, 0x2f, 0x8f, 0xe2, 0x1d, 0x2a, 0xdd,
-	0xc6, 0xad, 0x36, 0x97, 0x60, 0xb5, 0x9f, 0xc5, 0x0a, 0xbc, 0xf9, 0xb4, 0x0a, 0x79, 0x44, 0xda,
-	0xdf, 0x4b, 0x7d, 0x57, 0xe5, 0xf5, 0x5d, 0x7f, 0x6a, 0xbf, 0x21, 0x77, 0x37, 0x52, 0xdf, 0xbd,
-	0x30, 0x52, 0x5e, 0xbf, 0xbc, 0xfb, 0xd7, 0x79, 0x28, 0xfa, 0xcf, 0x62, 0x3a, 0x8f, 0xab, 0x2d,
-	0x9b, 0xa0, 0xb6, 0xe0, 0xf9, 0x9d, 0xfb, 0x56, 0xe7, 0x77, 0x7e, 0xec, 0xf3, 0xfb, 0x34, 0x14,
-	0xd9, 0x0f, 0xcd, 0xc5, 0xfb, 0xe2, 0x3c, 0x2e, 0x30, 0x80, 0x8a, 0xf7, 0x07, 0x66, 0x38, 0x33,
-	0x91, 0x19, 0x46, 0x2a, 0xca, 0xb3, 0xd1, 0x8a, 0xf2, 0x87, 0xfe, 0x79, 0xca, 0x8f, 0xe0, 0xab,
-	0x43, 0xf8, 0x26, 0x9e, 0xa4, 0x8d, 0xf0, 0x49, 0xca, 0x4f, 0xe5, 0x77, 0x86, 0x71, 0xf9, 0xc1,
-	0xd6, 0x93, 0x77, 0x79, 0x3d, 0x39, 0x68, 0x8b, 0xc2, 0xb3, 0xde, 0x07, 0xf0, 0x9d, 0x88, 0x2c,
-	0x2a, 0x9f, 0x1e, 0xb2, 0x46, 0x35, 0x80, 0x4e, 0xd9, 0x86, 0xb6, 0x66, 0xd0, 0x74, 0x1e, 0xcf,
-	0x3f, 0xa6, 0x74, 0x9c, 0xff, 0xb2, 0x10, 0xf0, 0x2f, 0x29, 0x5d, 0xda, 0x0f, 0x63, 0x9d, 0x8c,
-	0x09, 0xad, 0xf8, 0x4e, 0xb8, 0x91, 0x71, 0x4c, 0xab, 0x8b, 0xf5, 0x31, 0x58, 0xdc, 0xa3, 0xbb,
-	0xe2, 0x31, 0xaf, 0x33, 0x17, 0x05, 0x64, 0x9d, 0xe5, 0x15, 0xfb, 0xa6, 0x65, 0x92, 0x03, 0xfe,
-	0x7c, 0x86, 0xe7, 0x15, 0x12, 0xb4, 0xce, 0x2e, 0x49, 0xe2, 0xd7, 0xa6, 0xa7, 0xb5, 0x6d, 0x03,
-	0x33, 0x9b, 0x9e, 0x56, 0x0b, 0x14, 0xb0, 0x61, 0x1b, 0x78, 0xf0, 0xe6, 0x15, 0x8e, 0xf7, 0xe6,
-	0x15, 0x23, 0x6f, 0xde, 0x49, 0x98, 0x71, 0xb1, 0x4e, 0x6c, 0x4b, 0x24, 0xf7, 0x62, 0x44, 0xb7,
-	0xa6, 0x87, 0x09, 0xa1, 0x33, 0x89, 0x60, 0x4f, 0x0c, 0x03, 0x41, 0xea, 0xdc, 0xc8, 0x20, 0x75,
-	0x48, 0xf7, 0x37, 0x12, 0xa4, 0x96, 0x47, 0x06, 0xa9, 0xe3, 0x34, 0x7f, 0x03, 0x61, 0xfa, 0xfc,
-	0x78, 0x61, 0x7a, 0x30, 0xaa, 0x5d, 0x08, 0x47, 0xb5, 0x4f, 0x61, 0xf6, 0x95, 0xdd, 0xed, 0xf7,
-	0x30, 0x11, 0x95, 0xfc, 0xd5, 0xd1, 0xd2, 0x7d, 0xcc, 0x09, 0xc4, 0x6d, 0x31, 0x41, 0x1e, 0x2e,
-	0x2c, 0xe0, 0x6f, 0x51, 0x58, 0x08, 0x06, 0x9f, 0xfb, 0xa1, 0xe0, 0xd3, 0x4f, 0x68, 0x3a, 0xe3,
-	0x25, 0x34, 0xdf, 0xa3, 0x2b, 0xaa, 0xed, 0xc0, 0x5c, 0x50, 0x4f, 0x09, 0xb4, 0xab, 0x41, 0xda,
-	0xc4, 0xd4, 0x89, 0x33, 0x08, 0x3a, 0xb8, 0x02, 0xcc, 0x70, 0xa0, 0xf2, 0x4f, 0x19, 0x58, 0x89,
-	0x39, 0x25, 0xe1, 0xec, 0xee, 0x46, 0xba, 0xf0, 0x17, 0x46, 0xee, 0xa9, 0xdf, 0x84, 0x7f, 0x12,
-	0x6a, 0xc2, 0xbf, 0x37, 0x9a, 0xf0, 0x8d, 0xf7, 0xe0, 0xff, 0x36, 0x0b, 0xe7, 0x76, 0x1d, 0x23,
-	0x12, 0x1f, 0x0b, 0x33, 0x19, 0xdf, 0xed, 0x7e, 0x28, 0xf3, 0xac, 0xec, 0xa4, 0xa6, 0x28, 0x52,
-	0xad, 0x2f, 0xa1, 0x42, 0x1c, 0xdc, 0xd6, 0x82, 0x2f, 0x30, 0x7f, 0x45, 0x1e, 0x27, 0x34, 0x0a,
-	0x86, 0x0b, 0xbc, 0x4a, 0x5d, 0x55, 0xec, 0xa5, 0x5e, 0x20, 0x61, 0x68, 0xed, 0x11, 0x2c, 0x27,
-	0x21, 0x4e, 0xa4, 0x3e, 0x05, 0xce, 0xa7, 0x0b, 0x23, 0xe2, 0xe4, 0x9f, 0xc3, 0xc2, 0xd6, 0x6b,
-	0xdc, 0x6e, 0x1d, 0x59, 0xed, 0x09, 0x34, 0x5a, 0x81, 0x5c, 0xbb, 0x67, 0x88, 0xc2, 0x3a, 0xfd,
-	0x19, 0x0c, 0xfd, 0x73, 0xe1, 0xd0, 0x5f, 0x83, 0xca, 0x60, 0x06, 0x61, 0x95, 0x27, 0xa9, 0x55,
-	0x1a, 0x14, 0x99, 0x32, 0x9f, 0x53, 0xc5, 0x48, 0xc0, 0xb1, 0xcb, 0x2f, 0xba, 0x71, 0x38, 0x76,
-	0xdd, 0xf0, 0x11, 0x91, 0x0b, 0x1f, 0x11, 0xca, 0x9f, 0x65, 0xa0, 0x44, 0x67, 0xf8, 0x56, 0xf2,
-	0x8b, 0xec, 0x3c, 0x37, 0xc8, 0xce, 0xfd, 0x24, 0x3f, 0x1f, 0x4c, 0xf2, 0x07, 0x92, 0x4f, 0x33,
-	0x70, 0x5c, 0xf2, 0x19, 0x1f, 0x8e, 0x5d, 0x57, 0x39, 0x0f, 0x73, 0x5c, 0x36, 0xb1, 0xf2, 0x0a,
-	0xe4, 0xfa, 0x6e, 0x57, 0xee, 0x5f, 0xdf, 0xed, 0x2a, 0x7f, 0x92, 0x81, 0xf2, 0xba, 0xe7, 0xe9,
-	0xed, 0x83, 0x09, 0x16, 0xe0, 0x0b, 0x97, 0x0d, 0x0a, 0x17, 0x5f, 0xc4, 0x40, 0xdc, 0x7c, 0x8a,
-	0xb8, 0xd3, 0x21, 0x71, 0x15, 0x98, 0x97, 0xb2, 0xa4, 0x0a, 0xdc, 0x00, 0xd4, 0xb4, 0x5d, 0xef,
-	0xb1, 0xed, 0x1e, 0xea, 0xae, 0x31, 0x59, 0xda, 0x8d, 0x20, 0x2f, 0xbe, 0x96, 0xc8, 0x5d, 0x9b,
-	0x56, 0xd9, 0x6f, 0xe5, 0x2a, 0x2c, 0x85, 0xf8, 0xa5, 0x4e, 0xfc, 0x11, 0x94, 0xd8, 0x61, 0x2f,
-	0xf2, 0xaf, 0xdb, 0xc1, 0xb6, 0xfe, 0x58, 0xa1, 0x81, 0xf2, 0xff, 0x60, 0x91, 0x06, 0x85, 0x0c,
-	0xee, 0x7b, 0x90, 0x1f, 0x47, 0x92, 0x93, 0x33, 0x29, 0x8c, 0x22, 0x89, 0xc9, 0x6f, 0xb3, 0x30,
-	0xcd, 0xe0, 0xb1, 0x40, 0xed, 0x34, 0x3d, 0xfe, 0x1c, 0x5b, 0xf3, 0xf4, 0x8e, 0xff, 0x6d, 0x0a,
-	0x05, 0xec, 0xe8, 0x1d, 0x56, 0x72, 0x61, 0x0f, 0x0d, 0xb3, 0x83, 0x89, 0x27, 0x3f, 0x50, 0x29,
-	0x51, 0xd8, 0x26, 0x07, 0xb1, 0xa6, 0x9b, 0xf9, 0x07, 0x3c, 0xd9, 0xc8, 0xab, 0xec, 0x37, 0x5a,
-	0xe5, 0x57, 0xa3, 0xc7, 0xe9, 0xc2, 0xb0, 0x8b, 0xd3, 0x35, 0x28, 0x44, 0x1a, 0x2f, 0xfe, 0x18,
-	0x3d, 0x8c, 0x1e, 0xf4, 0x97, 0x52, 0x56, 0x9c, 0x7c, 0xbc, 0x7f, 0x47, 0xe7, 0xd9, 0x16, 0xa0,
-	0xe0, 0xde, 0x08, 0x2b, 0xb8, 0x05, 0x33, 0x6c, 0xeb, 0x64, 0xa0, 0xbe, 0x92, 0x22, 0xaa, 0x2a,
-	0xd0, 0x14, 0x1d, 0x10, 0xdf, 0xf6, 0x50, 0x70, 0x3e, 0xb9, 0xad, 0x0c, 0x09, 0xd6, 0xff, 0x3e,
-	0x03, 0x4b, 0xa1, 0x39, 0x84, 0xac, 0x37, 0xc3, 0x93, 0xa4, 0x8a, 0x2a, 0x26, 0xd8, 0x08, 0x9d,
-	0xaf, 0xb7, 0xd2, 0x44, 0xfa, 0x8e, 0xce, 0xd6, 0xdf, 0x66, 0x00, 0xd6, 0xfb, 0xde, 0x81, 0x28,
-	0x71, 0x07, 0xed, 0x25, 0x13, 0xb1, 0x97, 0x1a, 0x14, 0x1c, 0x9d, 0x90, 0x43, 0xdb, 0x95, 0xe9,
-	0xb5, 0x3f, 0x66, 0xc5, 0xe8, 0xbe, 0x77, 0x20, 0x7b, 0xba, 0xf4, 0x37, 0xba, 0x0c, 0xf3, 0xfc,
-	0x2b, 0x2d, 0x4d, 0x37, 0x0c, 0x17, 0x13, 0x22, 0x9a, 0xbb, 0x65, 0x0e, 0x5d, 0xe7, 0x40, 0x8a,
-	0x66, 0x1a, 0xd8, 0xf2, 0x4c, 0xef, 0x48, 0xf3, 0xec, 0x97, 0xd8, 0x12, 0x69, 0x72, 0x59, 0x42,
-	0x77, 0x28, 0x90, 0x77, 0xb9, 0x3a, 0x26, 0xf1, 0x5c, 0x89, 0x26, 0x1b, 0x89, 0x02, 0xca, 0xd0,
-	0xe8, 0xa6, 0x54, 0x9a, 0xfd, 0x6e, 0x97, 0xab, 0xf8, 0xf8, 0xdb, 0xfe, 0xae, 0x58, 0x50, 0x36,
-	0xed, 0x4d, 0x1b, 0x28, 0x4d, 0x2c, 0xf7, 0x0d, 0xd6, 0x03, 0xdf, 0x85, 0xc5, 0xc0, 0x1a, 0x84,
-	0x59, 0x85, 0xf2, 0x99, 0x4c, 0x38, 0x9f, 0x51, 0x9e, 0x00, 0xe2, 0x25, 0xb0, 0x6f, 0xb9, 0x6e,
-	0xe5, 0x04, 0x2c, 0x85, 0x18, 0x89, 0xf8, 0xe0, 0x06, 0x94, 0xc5, 0xad, 0x5a, 0x61, 0x28, 0xa7,
-	0xa0, 0x40, 0xfd, 0x7c, 0xdb, 0x34, 0x64, 0xc3, 0x7f, 0xd6, 0xb1, 0x8d, 0x0d, 0xd3, 0x70, 0x95,
-	0x17, 0x50, 0x56, 0xf9, 0x3c, 0x02, 0xf7, 0x31, 0xcc, 0x8b, 0x3b, 0xb8, 0x5a, 0xe8, 0x12, 0x7c,
-	0xd2, 0x47, 0x56, 0xc1, 0x49, 0xd4, 0xb2, 0x15, 0x1c, 0x2a, 0x06, 0xd4, 0x78, 0x20, 0x13, 0x62,
-	0x2f, 0x17, 0xfb, 0x18, 0xe4, 0x7d, 0xf8, 0x91, 0xb3, 0x84, 0xe9, 0xcb, 0x6e, 0x70, 0xa8, 0x9c,
-	0x81, 0xd3, 0x89, 0xb3, 0x08, 0x4d, 0x38, 0x50, 0x19, 0x3c, 0x30, 0x4c, 0x79, 0xf3, 0x81, 0xdd,
-	0x68, 0xc8, 0x04, 0x6e, 0x34, 0x9c, 0xf4, 0x23, 0xee, 0xac, 0x3c, 0x5a, 0x59, 0x38, 0x3d, 0xc8,
-	0x3c, 0x73, 0x69, 0x99, 0x67, 0x3e, 0x94, 0x79, 0x2a, 0x2d, 0x5f, 0x9f, 0xa2, 0x22, 0xf0, 0x88,
-	0x55, 0x2e, 0xf8, 0xdc, 0xd2, 0x21, 0x2a, 0xc3, 0x56, 0xc9, 0x51, 0xd5, 0x00, 0x95, 0x72, 0x1d,
-	0xca, 0x61, 0xd7, 0x18, 0xf0, 0x73, 0x99, 0x98, 0x9f, 0x9b, 0x8f, 0xb8, 0xb8, 0xf7, 0x23, 0xe9,
-	0x44, 0xba, 0x8e, 0x23, 0xc9, 0xc4, 0xc3, 0x90, 0xb3, 0xbb, 0x91, 0x70, 0x19, 0xe1, 0x3b, 0xf2,
-	0x73, 0xcb, 0xe2, 0x3c, 0x78, 0x4c, 0x28, 0xbd, 0x58, 0xb4, 0x72, 0x11, 0x4a, 0xbb, 0x69, 0x5f,
-	0xf0, 0xe5, 0xe5, 0x6d, 0xbf, 0x3b, 0xb0, 0xfc, 0xd8, 0xec, 0x62, 0x72, 0x44, 0x3c, 0xdc, 0xab,
-	0x33, 0xa7, 0xb4, 0x6f, 0x62, 0x17, 0x9d, 0x05, 0x60, 0xd9, 0xb4, 0x63, 0x9b, 0xfe, 0x87, 0x5d,
-	0x01, 0x88, 0xf2, 0xbb, 0x0c, 0x2c, 0x0c, 0x08, 0x77, 0x59, 0x15, 0xe1, 0x2d, 0x28, 0xd2, 0xf5,
-	0x12, 0x4f, 0xef, 0x39, 0xb2, 0x31, 0xeb, 0x03, 0xd0, 0x7d, 0x98, 0xde, 0x27, 0xb2, 0x7a, 0x99,
-	0xd8, 0x09, 0x4a, 0x12, 0x44, 0xcd, 0xef, 0x93, 0xba, 0x81, 0x1e, 0x00, 0xf4, 0x09, 0x36, 0x44,
-	0x33, 0x36, 0x97, 0x16, 0xc3, 0xec, 0x06, 0x2f, 0x6a, 0x50, 0x02, 0x7e, 0x51, 0xf0, 0x21, 0x94,
-	0x4c, 0xcb, 0x36, 0x30, 0x6b, 0x9e, 0x1b, 0xa2, 0xc0, 0x39, 0x82, 0x1c, 0x38, 0xc5, 0x2e, 0xc1,
-	0x86, 0x82, 0xc5, 0x59, 0x28, 0xf5, 0x2b, 0x0c, 0xa5, 0x01, 0x8b, 0xdc, 0x69, 0xed, 0xfb, 0x82,
-	0x4b, 0x8b, 0xbd, 0x30, 0x6c, 0x75, 0x4c, 0x5b, 0x6a, 0xc5, 0x14, 0x01, 0x97, 0x24, 0x55, 0xee,
-	0xc1, 0x89, 0x50, 0xba, 0x39, 0x41, 0xfe, 0xa7, 0x34, 0x23, 0x35, 0xbb, 0x81, 0x39, 0x8b, 0x8a,
-	0x98, 0xb4, 0xe6, 0x51, 0x15, 0x31, 0xc2, 0x2b, 0x62, 0x44, 0xf9, 0x0c, 0x4e, 0x85, 0x8a, 0x8b,
-	0x21, 0x89, 0x1e, 0x46, 0xe2, 0xc9, 0x2b, 0xa3, 0xb8, 0x46, 0x02, 0xcb, 0xff, 0xce, 0xc0, 0x72,
-	0x12, 0xc2, 0x31, 0x8b, 0xdf, 0x3f, 0x4f, 0xb9, 0x14, 0x7e, 0x77, 0x3c, 0xb1, 0x7e, 0x2f, 0x8d,
-	0x83, 0x1d, 0xa8, 0x25, 0xe9, 0x33, 0xbe, 0x4b, 0xb9, 0x49, 0x76, 0xe9, 0x17, 0xb9, 0x40, 0x13,
-	0x68, 0xdd, 0xf3, 0x5c, 0x73, 0xaf, 0x4f, 0x4d, 0xfe, 0x8d, 0x17, 0x56, 0xeb, 0x7e, 0x89, 0x90,
-	0xab, 0xf6, 0xf6, 0x10, 0xf2, 0x81, 0x1c, 0x89, 0x65, 0xc2, 0x4f, 0xc2, 0x65, 0x42, 0xde, 0xde,
-	0xb9, 0x33, 0x1e, 0xbf, 0x1f, 0x6c, 0x2d, 0xfe, 0x17, 0x59, 0x98, 0x0f, 0x6f, 0x11, 0xda, 0x02,
-	0xd0, 0x7d, 0xc9, 0xc5, 0x8b, 0x72, 0x79, 0xac, 0x65, 0xaa, 0x01, 0x42, 0xf4, 0x0e, 0xe4, 0xda,
-	0x4e, 0x5f, 0xec, 0x5a, 0x42, 0x11, 0x70, 0xc3, 0xe9, 0x73, 0x8f, 0x42, 0xd1, 0x68, 0xa6, 0x27,
-	0xee, 0x9f, 0xa6, 0x7a, 0x49, 0x7e, 0x17, 0x95, 0xd3, 0x08, 0x64, 0xf4, 0x14, 0xe6, 0x0f, 0x5d,
-	0xd3, 0xd3, 0xf7, 0xba, 0x58, 0xeb, 0xea, 0x47, 0xd8, 0x15, 0x5e, 0x72, 0x0c, 0x47, 0x56, 0x96,
-	0x84, 0xcf, 0x28, 0x9d, 0xf2, 0x47, 0x50, 0x90, 0x12, 0x8d, 0x38, 0x11, 0x76, 0x60, 0xa5, 0x4f,
-	0xd1, 0x34, 0x76, 0x81, 0xdb, 0xd2, 0x2d, 0x5b, 0x23, 0x98, 0x1e, 0xe3, 0xf2, 0xd3, 0xb2, 0x11,
-	0x2e, 0x7a, 0x99, 0x51, 0x6f, 0xd8, 0x2e, 0x6e, 0xe8, 0x96, 0xdd, 0xe2, 0xa4, 0xca, 0x2b, 0x28,
-	0x05, 0x16, 0x38, 0x42, 0x84, 0x3a, 0x2c, 0xca, 0x3b, 0x25, 0x04, 0x7b, 0xe2, 0x78, 0x19, 0x6b,
-	0xf2, 0x05, 0x41, 0xd7, 0xc2, 0x1e, 0xbf, 0x07, 0xf4, 0x10, 0x4e, 0xa9, 0xd8, 0x76, 0xb0, 0xe5,
-	0xef, 0xe7, 0x33, 0xbb, 0x33, 0x81, 0x07, 0x7f, 0x0b, 0x6a, 0x49, 0xf4, 0x22, 0x32, 0xbb, 0x07,
-	0x27, 0x9a, 0x7a, 0x9f, 0xe0, 0x63, 0xf6, 0xc1, 0xa3, 0xb4, 0x82, 0xeb, 0x03, 0x58, 0xd9, 0xb5,
-	0x9c, 0xe3, 0xf2, 0xad, 0x41, 0x35, 0x4e, 0x2d, 0x38, 0xdf, 0x91, 0xa1, 0xb6, 0x48, 0x82, 0x05,
-	0xd7, 0x73, 0x50, 0xe2, 0x19, 0xb6, 0x16, 0xc8, 0xc2, 0x80, 0x83, 0x1a, 0x7a, 0x0f, 0x2b, 0x27,
-	0x61, 0x39, 0x4c, 0x27, 0xf8, 0x3d, 0x14, 0xbd, 0xfc, 0xe3, 0x7e, 0x65, 0x79, 0x0a, 0x56, 0x62,
-	0xf4, 0x9c, 0xf5, 0x8d, 0x2b, 0x50, 0x90, 0xff, 0xfd, 0x02, 0xcd, 0x42, 0x6e, 0x67, 0xa3, 0x59,
-	0x99, 0xa2, 0x3f, 0x76, 0x37, 0x9b, 0x95, 0x0c, 0x2a, 0x40, 0xbe, 0xb5, 0xb1, 0xd3, 0xac, 0x64,
-	0x6f, 0xf4, 0xa0, 0x12, 0xfd, 0xd7, 0x0f, 0x68, 0x05, 0x96, 0x9a, 0xea, 0x76, 0x73, 0xfd, 0xc9,
-	0xfa, 0x4e, 0x7d, 0xbb, 0xa1, 0x35, 0xd5, 0xfa, 0xc7, 0xeb, 0x3b, 0x5b, 0x95, 0x29, 0x74, 0x01,
-	0xce, 0x04, 0x1f, 0x3c, 0xdd, 0x6e, 0xed, 0x68, 0x3b, 0xdb, 0xda, 0xc6, 0x76, 0x63, 0x67, 0xbd,
-	0xde, 0xd8, 0x52, 0x2b, 0x19, 0x74, 0x06, 0x4e, 0x05, 0x51, 0x1e, 0xd5, 0x37, 0xeb, 0xea, 0xd6,
-	0x06, 0xfd, 0xbd, 0xfe, 0xac, 0x92, 0xbd, 0x71, 0x1b, 0xca, 0xa1, 0xff, 0xca, 0x40, 0x45, 0x6a,
-	0x6e, 0x6f, 0x56, 0xa6, 0x50, 0x19, 0x8a, 0x41, 0x3e, 0x05, 0xc8, 0x37, 0xb6, 0x37, 0xb7, 0x2a,
-	0xd9, 0x1b, 0x4d, 0x58, 0x88, 0x7c, 0xa6, 0x83, 0x16, 0xa1, 0xdc, 0x5a, 0x6f, 0x6c, 0x3e, 0xda,
-	0xfe, 0x44, 0x53, 0xb7, 0xd6, 0x37, 0x3f, 0xad, 0x4c, 0xa1, 0x65, 0xa8, 0x48, 0x50, 0x63, 0x7b,
-	0x87, 0x43, 0x33, 0x11, 0xe8, 0xe3, 0xed, 0xdd, 0xc6, 0x66, 0xc5, 0xb8, 0xf1, 0x55, 0x26, 0xe2,
-	0xd5, 0x30, 0x3a, 0x01, 0x8b, 0xfe, 0xec, 0xda, 0x86, 0xba, 0xb5, 0xbe, 0xb3, 0x45, 0x85, 0x0a,
-	0x81, 0xd5, 0xdd, 0x46, 0xa3, 0xde, 0x78, 0xc2, 0xd9, 0x0e, 0xc0, 0x5b, 0x9f, 0xd4, 0x29, 0x72,
-	0x36, 0x8c, 0xbc, 0xdb, 0xf8, 0x69, 0x63, 0xfb, 0x45, 0xa3, 0x92, 0x43, 0x4b, 0xb0, 0x30, 0x00,
-	0x37, 0xd7, 0x77, 0x5b, 0x5b, 0x95, 0xfc, 0xda, 0xff, 0x2c, 0xc1, 0xbc, 0x8c, 0xb7, 0xb1, 0xcb,
-	0x6e, 0xc9, 0x35, 0x61, 0x56, 0xfe, 0xe7, 0x95, 0x84, 0x83, 0x32, 0xfc, 0xff, 0x62, 0x6a, 0x17,
-	0x86, 0x60, 0x08, 0xe3, 0x9a, 0x42, 0x7b, 0x2c, 0x0d, 0x09, 0x7c, 0x62, 0x75, 0x25, 0x31, 0xe8,
-	0x8f, 0x59, 0x5f, 0xed, 0xea, 0x48, 0x3c, 0x7f, 0x0e, 0x4c, 0x33, 0x8d, 0xe0, 0x37, 0xc4, 0xe8,
-	0x6a, 0x52, 0x8a, 0x90, 0xf0, 0x91, 0x72, 0xed, 0xda, 0x68, 0x44, 0x7f, 0x9a, 0x97, 0x50, 0x89,
-	0x7e, 0x4f, 0x8c, 0x12, 0xda, 0x01, 0x29, 0x1f, 0x2d, 0xd7, 0x6e, 0x8c, 0x83, 0x1a, 0x9c, 0x2c,
-	0xf6, 0xe5, 0xed, 0xf5, 0x71, 0x3e, 0x65, 0x4c, 0x9d, 0x2c, 0xed, 0xab, 0x47, 0xae, 0xc0, 0xf0,
-	0xe7, 0x53, 0x28, 0xf1, 0x33, 0xd7, 0x84, 0x8f, 0xef, 0x92, 0x14, 0x98, 0xfc, 0x25, 0x96, 0x32,
-	0x85, 0x0e, 0x60, 0x21, 0x72, 0x61, 0x09, 0x25, 0x90, 0x27, 0xdf, 0xcc, 0xaa, 0x5d, 0x1f, 0x03,
-	0x33, 0x6c, 0x11, 0xc1, 0x0b, 0x4a, 0xc9, 0x16, 0x91, 0x70, 0xfd, 0x29, 0xd9, 0x22, 0x12, 0xef,
-	0x3a, 0x31, 0xe3, 0x0e, 0x5d, 0x4c, 0x4a, 0x32, 0xee, 0xa4, 0xeb, 0x50, 0xb5, 0xab, 0x23, 0xf1,
-	0x82, 0x4a, 0x8b, 0x5c, 0x53, 0x4a, 0x52, 0x5a, 0xf2, 0x35, 0xa8, 0xda, 0xf5, 0x31, 0x30, 0xa3,
-	0x56, 0x30, 0xb8, 0xf4, 0x90, 0x66, 0x05, 0xb1, 0x2b, 0x3a, 0x69, 0x56, 0x10, 0xbf, 0x3f, 0x21,
-	0xac, 0x20, 0x72, 0x59, 0xe1, 0xda, 0x18, 0xed, 0xc1, 0x74, 0x2b, 0x48, 0x6e, 0x24, 0x2a, 0x53,
-	0xe8, 0x8f, 0x33, 0x50, 0x4d, 0xeb, 0x61, 0xa1, 0xdb, 0x13, 0x37, 0xdf, 0x6a, 0x6b, 0x93, 0x90,
-	0xf8, 0x52, 0x7c, 0x09, 0x28, 0x1e, 0x7e, 0xa0, 0xb7, 0x93, 0x76, 0x26, 0x25, 0xc8, 0xa9, 0xbd,
-	0x33, 0x1e, 0x72, 0x70, 0x27, 0xc3, 0x71, 0x49, 0xd2, 0x4e, 0x26, 0x46, 0x3d, 0x49, 0x3b, 0x99,
-	0x12, 0xe2, 0x30, 0x1f, 0x15, 0x0d, 0x53, 0x92, 0x7c, 0x54, 0x4a, 0x20, 0x94, 0xe4, 0xa3, 0x52,
-	0xa3, 0x9e, 0x29, 0xd4, 0x82, 0x82, 0xec, 0x04, 0xa2, 0x84, 0x93, 0x27, 0xd2, 0x87, 0xac, 0x29,
-	0xc3, 0x50, 0x7c, 0xa6, 0x4f, 0x20, 0x4f, 0xa1, 0xe8, 0x4c, 0x32, 0xb6, 0x64, 0x76, 0x36, 0xed,
-	0xb1, 0xcf, 0xe8, 0x39, 0xcc, 0xf0, 0xd6, 0x17, 0x4a, 0x28, 0x6a, 0x85, 0x1a, 0x74, 0xb5, 0xf3,
-	0xe9, 0x08, 0x3e, 0xbb, 0xcf, 0xf9, 0x3f, 0x1a, 0x13, 0x5d, 0x2d, 0x74, 0x29, 0xf9, 0xbf, 0xb4,
-	0x84, 0x9b, 0x68, 0xb5, 0xcb, 0x23, 0xb0, 0x82, 0xe6, 0x11, 0x49, 0xa8, 0xae, 0x8e, 0xcc, 0x8a,
-	0xd3, 0xcd, 0x23, 0x39, 0xef, 0xe6, 0x86, 0x1f, 0xcf, 0xcb, 0x93, 0x0c, 0x3f, 0xb5, 0x1a, 0x92,
-	0x64, 0xf8, 0xe9, 0xa9, 0xbe, 0x32, 0x85, 0x3c, 0x58, 0x4a, 0xa8, 0xc2, 0xa2, 0x77, 0xd2, 0x5e,
-	0xdc, 0xa4, 0x92, 0x70, 0xed, 0xe6, 0x98, 0xd8, 0xc1, 0xcd, 0x17, 0x8e, 0xec, 0x5c, 0x7a, 0x69,
-	0x32, 0x75, 0xf3, 0x63, 0x6e, 0xeb, 0x00, 0x16, 0x22, 0x11, 0x35, 0x4a, 0x3b, 0x94, 0xe2, 0xe7,
-	0xf1, 0xf5, 0x31, 0x30, 0xe5, 0x4c, 0x6b, 0xff, 0x96, 0x83, 0x39, 0x5e, 0xcb, 0x17, 0xf1, 0xdf,
-	0xa7, 0x00, 0x83, 0x36, 0x1a, 0xba, 0x98, 0xac, 0xfd, 0x50, 0x03, 0xb4, 0x76, 0x69, 0x38, 0x52,
-	0xd0, 0xa4, 0x03, 0x2d, 0x29, 0x74, 0x69, 0x44, 0xc7, 0x2a, 0xd5, 0xa4, 0x13, 0xfa, 0x5a, 0xca,
-	0x14, 0xfa, 0x18, 0x8a, 0x7e, 0xef, 0x03, 0x25, 0xf5, 0x4e, 0x22, 0xcd, 0x9d, 0xda, 0xc5, 0xa1,
-	0x38, 0x41, 0xa9, 0x03, 0x8d, 0x8d, 0x24, 0xa9, 0xe3, 0x0d, 0x94, 0x24, 0xa9, 0x93, 0xba, 0x23,
-	0x03, 0x9d, 0xf0, 0xf2, 0x67, 0xaa, 0x4e, 0x42, 0xd5, 0xe7, 0x54, 0x9d, 0x84, 0x6b, 0xa8, 0xca,
-	0xd4, 0x9a, 0x03, 0x65, 0x9e, 0xeb, 0xc9, 0xdd, 0xd5, 0x60, 0x2e, 0x98, 0x02, 0xa2, 0x54, 0x39,
-	0x43, 0xa9, 0x65, 0xed, 0xca, 0x28, 0x34, 0x39, 0xe3, 0xa3, 0x2b, 0xbf, 0xf9, 0xfa, 0x6c, 0xe6,
-	0x9f, 0xbf, 0x3e, 0x3b, 0xf5, 0xd5, 0x37, 0x67, 0x33, 0xbf, 0xf9, 0xe6, 0x6c, 0xe6, 0x1f, 0xbf,
-	0x39, 0x9b, 0xf9, 0xf7, 0x6f, 0xce, 0x66, 0xfe, 0xf4, 0x3f, 0xce, 0x4e, 0xfd, 0xac, 0x20, 0xc9,
-	0xf7, 0x66, 0xd8, 0xbf, 0x42, 0x7c, 0xef, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x3f, 0x1a,
-	0x13, 0xd0, 0x52, 0x00, 0x00,
+	// 5662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4d, 0x73, 0x1b, 0xd9,
+	0x71, 0xc2, 0x07, 0x49, 0xa0, 0x41, 0x90, 0xe0, 0x23, 0x25, 0x42, 0xd0, 0xea, 0x6b, 0xa4, 0xd5,
+	0x97, 0x57, 0x94, 0x45, 0xdb, 0xda, 0x95, 0x56, 0x96, 0x44, 0x91, 0x94, 0x04, 0x5b, 0x02, 0x91,
+	0x01, 0x29, 0xed, 0xda, 0x4e, 0x8d, 0x87, 0x98, 0x47, 0x72, 0x2c, 0x60, 0x66, 0x76, 0x66, 0x20,
+	0x89, 0x49, 0x55, 0xca, 0x55, 0xa9, 0xf2, 0xc1, 0xa7, 0xdc, 0x5c, 0x95, 0xa3, 0x7d, 0xc8, 0x21,
+	0x97, 0x24, 0x55, 0x39, 0x25, 0x97, 0xa4, 0x5c, 0x29, 0x57, 0x25, 0xae, 0xca, 0x29, 0x95, 0x8f,
+	0x43, 0xb2, 0x9b, 0x9c, 0x72, 0x48, 0xe5, 0x27, 0xa4, 0xde, 0xd7, 0xe0, 0xcd, 0x17, 0x30, 0xe0,
+	0x6a, 0x3f, 0x72, 0xc2, 0x4c, 0x4f, 0x77, 0xbf, 0x7e, 0xef, 0xf5, 0x74, 0xf7, 0xeb, 0xee, 0x01,
+	0x94, 0x75, 0xc7, 0x5c, 0x71, 0x5c, 0xdb, 0xb7, 0x51, 0xcd, 0x1d, 0x58, 0xbe, 0xd9, 0xc7, 0x2b,
+	0xaf, 0x6e, 0xea, 0x3d, 0xe7, 0x40, 0x5f, 0x6d, 0x5c, 0xdf, 0x37, 0xfd, 0x83, 0xc1, 0xee, 0x4a,
+	0xd7, 0xee, 0xdf, 0xd8, 0xb7, 0xf7, 0xed, 0x1b, 0x14, 0x71, 0x77, 0xb0, 0x47, 0xef, 0xe8, 0x0d,
+	0xbd, 0x62, 0x0c, 0x94, 0x6b, 0x30, 0xf7, 0x1c, 0xbb, 0x9e, 0x69, 0x5b, 0x2a, 0xfe, 0x64, 0x80,
+	0x3d, 0x1f, 0xd5, 0x61, 0xe6, 0x15, 0x83, 0xd4, 0x73, 0xe7, 0x72, 0x57, 0xca, 0xaa, 0xb8, 0x55,
+	0xfe, 0x24, 0x07, 0xf3, 0x01, 0xb2, 0xe7, 0xd8, 0x96, 0x87, 0xd3, 0xb1, 0xd1, 0x79, 0x98, 0xe5,
+	0xc2, 0x69, 0x96, 0xde, 0xc7, 0xf5, 0x3c, 0x7d, 0x5c, 0xe1, 0xb0, 0x96, 0xde, 0xc7, 0xe8, 0x32,
+	0xcc, 0x0b, 0x14, 0xc1, 0xa4, 0x40, 0xb1, 0xe6, 0x38, 0x98, 0x8f, 0x86, 0x56, 0x60, 0x51, 0x20,
+	0xea, 0x8e, 0x19, 0x20, 0x17, 0x29, 0xf2, 0x02, 0x7f, 0xb4, 0xe6, 0x98, 0x1c, 0x5f, 0xf9, 0x21,
+	0x94, 0x37, 0x5a, 0x9d, 0x75, 0xdb, 0xda, 0x33, 0xf7, 0x89, 0x88, 0x1e, 0x76, 0x09, 0x4d, 0x3d,
+	0x77, 0xae, 0x40, 0x44, 0xe4, 0xb7, 0xa8, 0x01, 0x25, 0x0f, 0xeb, 0x6e, 0xf7, 0x00, 0x7b, 0xf5,
+	0x3c, 0x7d, 0x14, 0xdc, 0x13, 0x2a, 0xdb, 0xf1, 0x4d, 0xdb, 0xf2, 0xea, 0x05, 0x46, 0xc5, 0x6f,
+	0x95, 0x5f, 0xe6, 0xa0, 0xd2, 0xb6, 0x5d, 0xff, 0x99, 0xee, 0x38, 0xa6, 0xb5, 0x8f, 0x6e, 0x41,
+	0x89, 0xae, 0x65, 0xd7, 0xee, 0xd1, 0x35, 0x98, 0x5b, 0x6d, 0xac, 0x44, 0xb7, 0x65, 0xa5, 0xcd,
+	0x31, 0xd4, 0x00, 0x17, 0xbd, 0x0b, 0x73, 0x5d, 0xdb, 0xf2, 0x75, 0xd3, 0xc2, 0xae, 0xe6, 0xd8,
+	0xae, 0x4f, 0x97, 0x68, 0x4a, 0xad, 0x06, 0x50, 0x32, 0x0a, 0x3a, 0x05, 0xe5, 0x03, 0xdb, 0xf3,
+	0x19, 0x46, 0x81, 0x62, 0x94, 0x08, 0x80, 0x3e, 0x5c, 0x86, 0x19, 0xfa, 0xd0, 0x74, 0xf8, 0x62,
+	0x4c, 0x93, 0xdb, 0xa6, 0xa3, 0xfc, 0x77, 0x0e, 0xa6, 0x9e, 0xd9, 0x03, 0xcb, 0x8f, 0x0c, 0xa3,
+	0xfb, 0x07, 0x7c, 0xa3, 0xa4, 0x61, 0x74, 0xff, 0x60, 0x38, 0x0c, 0xc1, 0x60, 0x7b, 0xc5, 0x86,
+	0x21, 0x0f, 0x1b, 0x50, 0x72, 0xb1, 0x6e, 0xd8, 0x56, 0xef, 0x90, 0x8a, 0x50, 0x52, 0x83, 0x7b,
+	0xb2, 0x89, 0x1e, 0xee, 0x99, 0xd6, 0xe0, 0x8d, 0xe6, 0xe2, 0x9e, 0xbe, 0x8b, 0x7b, 0x54, 0x94,
+	0x92, 0x3a, 0xc7, 0xc1, 0x2a, 0x83, 0xa2, 0x0d, 0xa8, 0x38, 0xae, 0xed, 0xe8, 0xfb, 0x3a, 0x59,
+	0xc7, 0xfa, 0x14, 0x5d, 0x2a, 0x25, 0xbe, 0x54, 0x54, 0xec, 0xf6, 0x10, 0x53, 0x95, 0xc9, 0x10,
+	0x82, 0x22, 0x55, 0x27, 0x83, 0x8a, 0x48, 0xaf, 0x95, 0xbf, 0xc8, 0xc1, 0x3c, 0x51, 0x28, 0xcf,
+	0xd1, 0xbb, 0x78, 0x8b, 0x6e, 0x13, 0xba, 0x0d, 0x33, 0x16, 0xf6, 0x5f, 0xdb, 0xee, 0x4b, 0xbe,
+	0x29, 0x67, 0xe3, 0x23, 0x05, 0x34, 0xcf, 0x6c, 0x03, 0xab, 0x02, 0x1f, 0xdd, 0x84, 0x82, 0x63,
+	0x1a, 0xf5, 0x7c, 0x36, 0x32, 0x82, 0x4b, 0x48, 0x4c, 0xa7, 0x5b, 0x2f, 0x64, 0x24, 0x31, 0x9d,
+	0xae, 0xa2, 0x00, 0x34, 0x2d, 0xff, 0xd6, 0xb7, 0x9f, 0xeb, 0xbd, 0x01, 0x46, 0x4b, 0x30, 0xf5,
+	0x8a, 0x5c, 0x50, 0x61, 0x0b, 0x2a, 0xbb, 0x51, 0x3e, 0x2d, 0xc0, 0xa9, 0xa7, 0x64, 0x0d, 0x3b,
+	0xba, 0x65, 0xec, 0xda, 0x6f, 0x3a, 0xb8, 0x3b, 0x70, 0x4d, 0xff, 0x70, 0xdd, 0xb6, 0x7c, 0xfc,
+	0xc6, 0x47, 0x2d, 0x58, 0xb0, 0x04, 0x67, 0x4d, 0xa8, 0x2b, 0xe1, 0x50, 0x59, 0x3d, 0x3f, 0x42,
+	0x08, 0xb6, 0x44, 0x6a, 0xcd, 0x0a, 0x03, 0x3c, 0xf4, 0x64, 0xb8, 0x97, 0x82, 0x5b, 0x9e, 0x72,
+	0x4b, 0x98, 0x52, 0x67, 0x93, 0x4a, 0xc6, 0x79, 0x89, 0xcd, 0x16, 0x9c, 0xee, 0x02, 0x79, 0xd3,
+	0x35, 0xdd, 0xd3, 0x06, 0x1e, 0x76, 0xe9, 0xc2, 0x54, 0x56, 0xdf, 0x89, 0x73, 0x19, 0x2e, 0x81,
+	0x5a, 0x76, 0x07, 0xd6, 0x9a, 0xb7, 0xe3, 0x61, 0x97, 0x1a, 0x06, 0xae, 0x5f, 0x9a, 0x6b, 0xdb,
+	0xfe, 0x9e, 0x27, 0x74, 0x4a, 0x80, 0x55, 0x0a, 0x45, 0x37, 0x60, 0xd1, 0x1b, 0x38, 0x4e, 0x0f,
+	0xf7, 0xb1, 0xe5, 0xeb, 0x3d, 0x6d, 0xdf, 0xb5, 0x07, 0x8e, 0x57, 0x9f, 0x3a, 0x57, 0xb8, 0x52,
+	0x50, 0x91, 0xfc, 0xe8, 0x31, 0x7d, 0x82, 0xce, 0x00, 0x38, 0xae, 0xf9, 0xca, 0xec, 0xe1, 0x7d,
+	0x6c, 0xd4, 0xa7, 0x29, 0x53, 0x09, 0x82, 0xbe, 0x09, 0x4b, 0x1e, 0xee, 0x76, 0xed, 0xbe, 0xa3,
+	0x39, 0xae, 0xbd, 0x67, 0xf6, 0x30, 0x7b, 0x23, 0x66, 0xa8, 0xba, 0x21, 0xfe, 0xac, 0xcd, 0x1e,
+	0xd1, 0x77, 0xe3, 0x1e, 0xcc, 0xf2, 0x99, 0xd2, 0xc1, 0xeb, 0xa5, 0x0c, 0x53, 0x05, 0x3a, 0x55,
+	0x2a, 0x92, 0xf2, 0xcb, 0x3c, 0x1c, 0xa7, 0x2b, 0xd9, 0xb6, 0x0d, 0xbe, 0xcd, 0xdc, 0x70, 0x5d,
+	0x80, 0x6a, 0x97, 0xf2, 0xd4, 0x1c, 0xdd, 0xc5, 0x96, 0xcf, 0x5f, 0xdc, 0x59, 0x06, 0x6c, 0x53,
+	0x18, 0xfa, 0x08, 0x6a, 0x1e, 0xd7, 0x0a, 0xad, 0xcb, 0xd4, 0x82, 0xef, 0xd9, 0xf5, 0xb8, 0x08,
+	0x23, 0x74, 0x49, 0x9d, 0xf7, 0x62, 0xca, 0x35, 0xe3, 0x1d, 0x7a, 0x5d, 0xbf, 0xc7, 0x2c, 0x60,
+	0x65, 0xf5, 0xdb, 0x29, 0x0c, 0xa3, 0x82, 0xaf, 0x74, 0x18, 0xd9, 0xa6, 0xe5, 0xbb, 0x87, 0xaa,
+	0x60, 0xd2, 0xb8, 0x03, 0xb3, 0xf2, 0x03, 0x54, 0x83, 0xc2, 0x4b, 0x7c, 0xc8, 0x27, 0x45, 0x2e,
+	0x87, 0x2f, 0x01, 0xb3, 0x3f, 0xec, 0xe6, 0x4e, 0xfe, 0x83, 0x9c, 0xe2, 0x02, 0x1a, 0x8e, 0xf2,
+	0x0c, 0xfb, 0xba, 0xa1, 0xfb, 0x7a, 0x60, 0x0b, 0x72, 0x43, 0x5b, 0x40, 0xb8, 0x0e, 0xf8, 0xcb,
+	0x5b, 0x56, 0xc9, 0x25, 0x7a, 0x07, 0xca, 0x81, 0xa2, 0x73, 0xff, 0x32, 0x04, 0x10, 0x3b, 0xaf,
+	0xfb, 0x3e, 0xee, 0x3b, 0x3e, 0x55, 0xb1, 0xaa, 0x2a, 0x6e, 0x95, 0xff, 0x29, 0x42, 0x2d, 0xb6,
+	0x27, 0x0f, 0xa0, 0xd4, 0xe7, 0xc3, 0xf3, 0x17, 0xed, 0x62, 0x82, 0xb1, 0x8f, 0x89, 0xaa, 0x06,
+	0x54, 0xc4, 0x96, 0x12, 0xbb, 0x2a, 0xf9, 0xc4, 0xe0, 0x9e, 0xec, 0x78, 0xcf, 0xde, 0xd7, 0x0c,
+	0xd3, 0xc5, 0x5d, 0xdf, 0x76, 0x0f, 0xb9, 0xb8, 0xb3, 0x3d, 0x7b, 0x7f, 0x43, 0xc0, 0xd0, 0x1d,
+	0x00, 0xc3, 0xf2, 0xc8, 0x66, 0xef, 0x99, 0xfb, 0x54, 0xe8, 0xca, 0xea, 0xa9, 0xb8, 0x10, 0x81,
+	0x03, 0x54, 0xcb, 0x86, 0xe5, 0x71, 0xf1, 0x1f, 0x42, 0x95, 0xf8, 0x11, 0xad, 0xcf, 0x7c, 0x17,
+	0x7b, 0x53, 0x2a, 0xab, 0xa7, 0x93, 0xe6, 0x10, 0x78, 0x38, 0x75, 0xd6, 0x19, 0xde, 0x78, 0xe8,
+	0x11, 0x4c, 0x53, 0x83, 0xee, 0xd5, 0xa7, 0x29, 0xf1, 0xca, 0xa8, 0x05, 0xe0, 0x1a, 0xf1, 0x94,
+	0x12, 0x30, 0x85, 0xe0, 0xd4, 0x68, 0x07, 0x2a, 0xba, 0x65, 0xd9, 0xbe, 0xce, 0x0c, 0xcd, 0x0c,
+	0x65, 0xf6, 0xad, 0x0c, 0xcc, 0xd6, 0x86, 0x54, 0x8c, 0xa3, 0xcc, 0x07, 0x7d, 0x17, 0xa6, 0xa8,
+	0x25, 0xe2, 0x2f, 0xe2, 0xe5, 0x8c, 0x4a, 0xab, 0x32, 0xaa, 0xc6, 0x6d, 0xa8, 0x48, 0xc2, 0x4e,
+	0xa2, 0xa4, 0x8d, 0x7b, 0x50, 0x8b, 0x8a, 0x36, 0x91, 0x92, 0xff, 0x3e, 0x2c, 0xa9, 0x03, 0x6b,
+	0x28, 0x98, 0x88, 0xc8, 0xee, 0xc0, 0x34, 0xdf, 0x6c, 0xa6, 0x71, 0xca, 0xf8, 0x35, 0x52, 0x39,
+	0x85, 0x1c, 0x62, 0x1d, 0xe8, 0x96, 0xd1, 0xc3, 0x6e, 0x3d, 0x1f, 0x0a, 0xb1, 0x9e, 0x30, 0xa8,
+	0xf2, 0x5d, 0x38, 0x1e, 0x19, 0x9c, 0x47, 0x78, 0x17, 0x61, 0xce, 0xb1, 0x0d, 0xcd, 0x63, 0x60,
+	0xcd, 0x34, 0x84, 0x19, 0x72, 0x02, 0xdc, 0xa6, 0x41, 0xc8, 0x3b, 0xbe, 0xed, 0xc4, 0x85, 0xcf,
+	0x46, 0x5e, 0x87, 0x13, 0x51, 0x72, 0x36, 0xbc, 0x72, 0x1f, 0x96, 0x55, 0xdc, 0xb7, 0x5f, 0xe1,
+	0xa3, 0xb2, 0x6e, 0x40, 0x3d, 0xce, 0x80, 0x33, 0xff, 0x18, 0x96, 0x87, 0xd0, 0x8e, 0xaf, 0xfb,
+	0x03, 0x6f, 0x22, 0xe6, 0x3c, 0xfc, 0xdd, 0xb5, 0x3d, 0xb6, 0x9d, 0x25, 0x55, 0xdc, 0x2a, 0x57,
+	0x65, 0xd6, 0x2d, 0x16, 0x59, 0xb0, 0x11, 0xd0, 0x1c, 0xe4, 0x4d, 0x87, 0xb3, 0xcb, 0x9b, 0x8e,
+	0xf2, 0x04, 0xca, 0x81, 0x6b, 0x46, 0x1f, 0x0e, 0xe3, 0xce, 0x7c, 0x56, 0x47, 0x1e, 0x84, 0xa6,
+	0xdb, 0x31, 0x57, 0xc2, 0x87, 0xfc, 0x10, 0x20, 0x30, 0x79, 0x22, 0x42, 0x38, 0x35, 0x82, 0xb1,
+	0x2a, 0xa1, 0x2b, 0xff, 0x1a, 0x32, 0x84, 0xd2, 0x24, 0x8c, 0x60, 0x12, 0x46, 0xc8, 0x30, 0xe6,
+	0x8f, 0x64, 0x18, 0xdf, 0x87, 0x29, 0xcf, 0xd7, 0x7d, 0xcc, 0xa3, 0xa8, 0xf3, 0xa3, 0xc8, 0x89,
+	0x10, 0x58, 0x65, 0xf8, 0xe8, 0x34, 0x40, 0xd7, 0xc5, 0xba, 0x8f, 0x0d, 0x4d, 0x67, 0x56, 0xbc,
+	0xa0, 0x96, 0x39, 0x64, 0xcd, 0x47, 0xeb, 0xc3, 0x48, 0x70, 0x8a, 0x0a, 0x76, 0x75, 0x14, 0xe7,
+	0xd0, 0x56, 0x0d, 0x63, 0xc2, 0xc0, 0xaa, 0x4c, 0x67, 0xb4, 0x2a, 0x9c, 0x01, 0xa3, 0x92, 0x6c,
+	0xe6, 0xcc, 0x78, 0x9b, 0xc9, 0x48, 0xb3, 0xd8, 0xcc, 0xd2, 0x78, 0x9b, 0xc9, 0x99, 0x8d, 0xb4,
+	0x99, 0x5f, 0xa5, 0xd1, 0xfb, 0x97, 0x1c, 0xd4, 0xe3, 0xef, 0x20, 0xb7, 0x3d, 0x77, 0x60, 0xda,
+	0xa3, 0x90, 0x2c, 0x96, 0x8f, 0xd3, 0x72, 0x0a, 0xf4, 0x04, 0x8a, 0xa6, 0xb5, 0x67, 0xd7, 0xf3,
+	0x69, 0xb1, 0x4b, 0xda, 0xa8, 0x2b, 0x4d, 0x6b, 0xcf, 0x66, 0x8b, 0x44, 0x39, 0x34, 0xde, 0x87,
+	0x72, 0x00, 0x9a, 0x68, 0x6e, 0x5b, 0xb0, 0x14, 0x51, 0x59, 0x16, 0xec, 0x07, 0x9a, 0x9e, 0x9b,
+	0x4c, 0xd3, 0x95, 0x9f, 0xe6, 0xe5, 0x37, 0xf1, 0x91, 0xd9, 0xf3, 0xb1, 0x1b, 0x7b, 0x13, 0xef,
+	0x0a, 0xee, 0xec, 0x35, 0xbc, 0x34, 0x96, 0x3b, 0x8b, 0x49, 0xf9, 0xcb, 0xf4, 0x23, 0x98, 0xa3,
+	0xba, 0xa6, 0x79, 0xb8, 0x47, 0x03, 0x0e, 0x1e, 0xfc, 0x7d, 0x67, 0x14, 0x1b, 0x26, 0x09, 0xd3,
+	0xd8, 0x0e, 0xa7, 0x63, 0x2b, 0x58, 0xed, 0xc9, 0xb0, 0xc6, 0x03, 0x40, 0x71, 0xa4, 0x89, 0xd6,
+	0xb4, 0x43, 0x4c, 0x9c, 0xe7, 0x0f, 0xc7, 0x96, 0xbc, 0xe4, 0x1e, 0x15, 0x23, 0x8b, 0xae, 0x30,
+	0x81, 0x55, 0x4e, 0xa1, 0xfc, 0xba, 0x00, 0x30, 0x7c, 0xf8, 0xff, 0xc8, 0xb6, 0x3d, 0x08, 0xec,
+	0x0a, 0x0b, 0xe4, 0xae, 0x8c, 0x62, 0x9c, 0x68, 0x51, 0xb6, 0xc2, 0x16, 0x85, 0x85, 0x74, 0xd7,
+	0x47, 0xb2, 0xf9, 0xda, 0xda, 0x92, 0xa7, 0x70, 0x22, 0xaa, 0x1b, 0xdc, 0x90, 0xac, 0xc2, 0x94,
+	0xe9, 0xe3, 0x3e, 0xcb, 0x00, 0x25, 0x9e, 0xce, 0x24, 0x22, 0x86, 0xaa, 0x9c, 0x87, 0x72, 0xb3,
+	0xaf, 0xef, 0xe3, 0x8e, 0x83, 0xbb, 0x64, 0x50, 0x93, 0xdc, 0x70, 0x41, 0xd8, 0x8d, 0xb2, 0x0a,
+	0xa5, 0xef, 0xe3, 0x43, 0xf6, 0x52, 0x67, 0x14, 0x54, 0xf9, 0xbb, 0x12, 0x2c, 0x53, 0x5f, 0xb1,
+	0x2e, 0xf2, 0x2f, 0x2a, 0xf6, 0xec, 0x81, 0xdb, 0xc5, 0x1e, 0xdd, 0x6d, 0x67, 0xa0, 0x39, 0xd8,
+	0x35, 0x6d, 0x83, 0xa7, 0x02, 0xca, 0x5d, 0x67, 0xd0, 0xa6, 0x00, 0x92, 0xa3, 0x21, 0x8f, 0x3f,
+	0x19, 0xd8, 0x5c, 0x11, 0x0b, 0x6a, 0xa9, 0xeb, 0x0c, 0x7e, 0x87, 0xdc, 0x0b, 0x5a, 0xef, 0x40,
+	0x77, 0xb1, 0x57, 0x2f, 0x04, 0xb4, 0x1d, 0x0a, 0x40, 0x37, 0xe1, 0x78, 0x1f, 0xf7, 0x6d, 0xf7,
+	0x50, 0xeb, 0x99, 0x7d, 0xd3, 0xd7, 0x4c, 0x4b, 0xdb, 0x3d, 0xf4, 0xb1, 0xc7, 0x75, 0x0a, 0xb1,
+	0x87, 0x4f, 0xc9, 0xb3, 0xa6, 0xf5, 0x90, 0x3c, 0x41, 0x0a, 0x54, 0x6d, 0xbb, 0xaf, 0x79, 0x5d,
+	0xdb, 0xc5, 0x9a, 0x6e, 0xfc, 0x84, 0xba, 0xcf, 0x82, 0x5a, 0xb1, 0xed, 0x7e, 0x87, 0xc0, 0xd6,
+	0x8c, 0x9f, 0xa0, 0xb3, 0x50, 0xe9, 0x3a, 0x03, 0x0f, 0xfb, 0x1a, 0xf9, 0xa1, 0xde, 0xb1, 0xac,
+	0x02, 0x03, 0xad, 0x3b, 0x03, 0x4f, 0x42, 0xe8, 0x93, 0xf5, 0x9f, 0x91, 0x11, 0x9e, 0xe1, 0xbe,
+	0x87, 0x5e, 0x00, 0x18, 0xa6, 0xf7, 0x92, 0xcf, 0xca, 0xa0, 0xfb, 0xf3, 0x41, 0x8a, 0x7b, 0x8d,
+	0x2f, 0xd9, 0xca, 0x86, 0xe9, 0xbd, 0xa4, 0x0b, 0xc0, 0x54, 0xb1, 0x6c, 0x88, 0x7b, 0x92, 0x80,
+	0xdc, 0xed, 0xbd, 0x34, 0x6d, 0xed, 0x35, 0x36, 0xf7, 0x0f, 0xfc, 0x3a, 0xa6, 0xc7, 0xbb, 0x0a,
+	0x85, 0xbd, 0xa0, 0x20, 0xd4, 0x82, 0x45, 0x19, 0x45, 0x33, 0xf0, 0x2b, 0xb3, 0x8b, 0xeb, 0x7b,
+	0x54, 0x88, 0x33, 0x71, 0x21, 0x18, 0xd9, 0x06, 0xc5, 0x52, 0x17, 0x24, 0x4e, 0x0c, 0x84, 0x3a,
+	0x70, 0x9c, 0xf1, 0x63, 0x8c, 0x34, 0x92, 0xad, 0xd0, 0x76, 0x1d, 0xaf, 0xbe, 0x4f, 0x39, 0x9e,
+	0x8b, 0x73, 0xdc, 0x3e, 0x70, 0x6d, 0xdf, 0xef, 0x61, 0xce, 0x13, 0x51, 0x72, 0x7e, 0x83, 0x75,
+	0xe3, 0xa1, 0x43, 0x7c, 0xfe, 0x89, 0x10, 0xd3, 0xd7, 0xae, 0xe9, 0x63, 0xca, 0xf5, 0x20, 0x23,
+	0xd7, 0x45, 0x89, 0xeb, 0x0b, 0x42, 0x9d, 0xc4, 0x96, 0xca, 0xda, 0xdc, 0x72, 0xbc, 0xba, 0x79,
+	0x04, 0xb6, 0x44, 0x58, 0x42, 0x8c, 0x5e, 0xc0, 0x72, 0x82, 0xb4, 0x94, 0xef, 0x4f, 0x32, 0xf2,
+	0x5d, 0x8a, 0x8a, 0x4b, 0x19, 0x5f, 0x80, 0xea, 0x4b, 0xec, 0x5a, 0xb8, 0xa7, 0x31, 0x55, 0xad,
+	0xbf, 0xa4, 0xda, 0x38, 0xcb, 0x80, 0xcf, 0x28, 0x0c, 0x5d, 0x07, 0xae, 0xc8, 0x9a, 0x8b, 0x49,
+	0x96, 0x97, 0xa5, 0x1a, 0x7b, 0x14, 0x73, 0x81, 0x3d, 0x51, 0x87, 0x0f, 0x50, 0x13, 0x38, 0x50,
+	0xf3, 0x5e, 0xd3, 0xe3, 0x2d, 0xf6, 0xbc, 0x7a, 0x3f, 0x43, 0x02, 0xa7, 0xc6, 0xc8, 0x3a, 0x01,
+	0x15, 0x5a, 0x85, 0x99, 0x01, 0x7d, 0xb3, 0xbc, 0xba, 0x45, 0xe7, 0x59, 0x8f, 0x33, 0xd8, 0xa1,
+	0x08, 0xaa, 0x40, 0x6c, 0xdc, 0x85, 0xb9, 0xb0, 0xfa, 0x4e, 0x64, 0xed, 0xee, 0xc0, 0x6c, 0x48,
+	0xf9, 0x10, 0x14, 0xa5, 0xf4, 0x2e, 0xbd, 0x46, 0x27, 0x60, 0x9a, 0xe1, 0x50, 0xf2, 0xaa, 0xca,
+	0xef, 0x94, 0x0f, 0x60, 0x2e, 0xbc, 0xe8, 0x89, 0xd4, 0x08, 0x8a, 0xae, 0x08, 0x24, 0x8a, 0x2a,
+	0xbd, 0x56, 0x36, 0x60, 0x9a, 0x4d, 0x23, 0x31, 0xfb, 0x82, 0xa0, 0x78, 0xa0, 0xbb, 0x06, 0x37,
+	0x4e, 0xf4, 0x9a, 0xc0, 0x3c, 0x7b, 0xcf, 0xe7, 0x26, 0x89, 0x5e, 0x2b, 0x3a, 0x54, 0x43, 0xf9,
+	0x43, 0x82, 0x44, 0x13, 0x85, 0x9c, 0x19, 0xb9, 0xa6, 0xc3, 0xdb, 0x3d, 0x31, 0x73, 0x7a, 0x4d,
+	0x60, 0xfe, 0xa1, 0x23, 0xf2, 0x38, 0xf4, 0x9a, 0x2c, 0x51, 0x0f, 0xbf, 0xe2, 0x79, 0xe7, 0xb2,
+	0xca, 0x6e, 0x14, 0x03, 0x60, 0x5d, 0x77, 0xf4, 0x5d, 0xb3, 0x67, 0xfa, 0x87, 0xe8, 0x2a, 0xd4,
+	0x74, 0xc3, 0xd0, 0xba, 0x02, 0x62, 0x62, 0x51, 0x0d, 0x98, 0xd7, 0x0d, 0x63, 0x5d, 0x02, 0xa3,
+	0x6f, 0xc0, 0x82, 0xe1, 0xda, 0x4e, 0x18, 0x97, 0x95, 0x07, 0x6a, 0xe4, 0x81, 0x8c, 0xac, 0xfc,
+	0xd7, 0x14, 0x9c, 0x0e, 0x9b, 0xa6, 0x68, 0x8e, 0xf6, 0x01, 0xcc, 0x46, 0x46, 0x4d, 0x51, 0xaf,
+	0xa1, 0xb4, 0x6a, 0x88, 0x22, 0x92, 0xb3, 0xcc, 0xc7, 0x72, 0x96, 0x89, 0x59, 0xe0, 0xc2, 0x5b,
+	0xcd, 0x02, 0x17, 0xdf, 0x4a, 0x16, 0x78, 0x6a, 0xb2, 0x2c, 0xf0, 0x25, 0x98, 0x97, 0xa8, 0xa9,
+	0xae, 0x31, 0xff, 0x52, 0x0d, 0x70, 0x2c, 0x51, 0x46, 0x8a, 0x64, 0x8b, 0x67, 0x26, 0xc9, 0x16,
+	0x97, 0x52, 0xb3, 0xc5, 0x44, 0x6b, 0x1c, 0x47, 0x77, 0xfb, 0xb6, 0x2b, 0xd2, 0xc1, 0xf5, 0x32,
+	0x15, 0x61, 0x5e, 0xc0, 0x79, 0x2a, 0x38, 0x35, 0x71, 0x0c, 0xa9, 0x89, 0xe3, 0x73, 0x30, 0x6b,
+	0xd9, 0x9a, 0x85, 0x5f, 0x6b, 0x64, 0x2f, 0xbd, 0x7a, 0x85, 0x6d, 0xac, 0x65, 0xb7, 0xf0, 0xeb,
+	0x36, 0x81, 0xc4, 0x52, 0xcb, 0xb3, 0x93, 0xa5, 0x96, 0x89, 0x07, 0xec, 0xeb, 0xde, 0x4b, 0x6c,
+	0x50, 0x51, 0xbc, 0x7a, 0x95, 0x2a, 0x71, 0x85, 0xc1, 0x88, 0x0c, 0x1e, 0xa9, 0x0e, 0x05, 0x6b,
+	0xc7, 0x90, 0xe6, 0x28, 0x52, 0x55, 0x40, 0x29, 0x9a, 0xf2, 0x57, 0x39, 0x58, 0x0a, 0xab, 0x39,
+	0x4f, 0x28, 0x3e, 0x86, 0xb2, 0x2b, 0x7c, 0x71, 0x3d, 0x97, 0x76, 0xbc, 0x4e, 0x71, 0xde, 0xea,
+	0x90, 0x16, 0xfd, 0x20, 0x35, 0x8f, 0x7d, 0x63, 0x1c, 0xbf, 0x71, 0x99, 0x6c, 0xa5, 0x09, 0x67,
+	0x5f, 0x98, 0x96, 0x61, 0xbf, 0xf6, 0x52, 0xdf, 0xd2, 0x04, 0x5d, 0xcb, 0x25, 0xe8, 0x9a, 0xf2,
+	0x37, 0x39, 0x38, 0x11, 0xe5, 0xc5, 0x97, 0xa2, 0x19, 0x5f, 0x8a, 0x6f, 0x24, 0x84, 0x10, 0x11,
+	0xe2, 0xc4, 0xc5, 0xf8, 0x51, 0xea, 0x62, 0xdc, 0x1c, 0xcf, 0x71, 0xec, 0x72, 0xfc, 0x69, 0x0e,
+	0x4e, 0xa6, 0x8a, 0x11, 0x89, 0x23, 0x73, 0xd1, 0x38, 0x92, 0xc7, 0xa0, 0x5d, 0x7b, 0x60, 0xf9,
+	0x52, 0x0c, 0xba, 0x4e, 0xee, 0x79, 0xb0, 0xa7, 0xf5, 0xf5, 0x37, 0x66, 0x7f, 0xd0, 0xe7, 0x16,
+	0x9f, 0xb0, 0x7b, 0xc6, 0x20, 0x47, 0x88, 0x42, 0x95, 0x35, 0x58, 0x08, 0xa4, 0x1c, 0x99, 0xf9,
+	0x97, 0x32, 0xf9, 0xf9, 0x70, 0x26, 0xdf, 0x82, 0x69, 0xee, 0xe5, 0xde, 0x46, 0x31, 0xf4, 0x1c,
+	0x54, 0x1c, 0xec, 0xf6, 0x4d, 0xcf, 0x0b, 0x0c, 0x6d, 0x59, 0x95, 0x41, 0xca, 0xaf, 0x66, 0x60,
+	0x3e, 0xaa, 0x1d, 0xf7, 0x63, 0x85, 0x83, 0x0b, 0x09, 0x2e, 0x20, 0x3a, 0x51, 0xe9, 0x08, 0x79,
+	0x53, 0x9c, 0x40, 0xf2, 0x69, 0xd9, 0xbb, 0xe0, 0xb4, 0xc2, 0x8f, 0x27, 0x64, 0x45, 0xba, 0x76,
+	0xbf, 0xaf, 0x5b, 0x86, 0xa8, 0x61, 0xf3, 0x5b, 0xb2, 0x7e, 0xba, 0xbb, 0x4f, 0x96, 0x9d, 0x80,
+	0xe9, 0x35, 0xd9, 0x3c, 0x92, 0xea, 0x32, 0x2d, 0x5a, 0x80, 0xa0, 0xc6, 0xba, 0xac, 0x02, 0x07,
+	0x6d, 0x98, 0x2e, 0x5a, 0x81, 0x22, 0xb6, 0x5e, 0x89, 0x33, 0x62, 0x42, 0x91, 0x5b, 0x9c, 0x85,
+	0x54, 0x8a, 0x87, 0x6e, 0xc0, 0x74, 0x9f, 0xa8, 0x85, 0x48, 0x7a, 0x2d, 0xa7, 0xd4, 0x7a, 0x55,
+	0x8e, 0x46, 0x62, 0x28, 0x16, 0x35, 0x8a, 0xcc, 0x56, 0x42, 0x0c, 0xc5, 0x63, 0x44, 0x81, 0x88,
+	0x36, 0x83, 0x13, 0x70, 0x39, 0xed, 0xe8, 0x1a, 0xd9, 0x8a, 0xc4, 0x63, 0xf0, 0x76, 0xf8, 0x18,
+	0x0c, 0x94, 0xd7, 0xea, 0x78, 0x5e, 0xa3, 0x6b, 0x11, 0x27, 0xa1, 0x44, 0xea, 0x39, 0x54, 0x8d,
+	0x2a, 0xac, 0x3d, 0xa2, 0x67, 0xef, 0x53, 0x2d, 0x5a, 0x22, 0x19, 0x01, 0xc3, 0xb4, 0xa8, 0x51,
+	0x2f, 0xa9, 0xec, 0x86, 0xbc, 0x7c, 0xf4, 0x42, 0xb3, 0xad, 0x2e, 0xae, 0x57, 0xe9, 0xa3, 0x32,
+	0x85, 0x6c, 0x59, 0x5d, 0x7a, 0xc6, 0xf4, 0xfd, 0xc3, 0xfa, 0x1c, 0x85, 0x93, 0x4b, 0x92, 0xec,
+	0x61, 0x79, 0xc9, 0xf9, 0xb4, 0x64, 0x4f, 0x92, 0xd9, 0x16, 0x69, 0xc9, 0x87, 0x30, 0xf3, 0x9a,
+	0x19, 0x82, 0x7a, 0xed, 0x5c, 0x2e, 0x39, 0x7f, 0x90, 0x6c, 0xed, 0x54, 0x41, 0x48, 0x9c, 0x8c,
+	0x85, 0x7d, 0xe2, 0xc3, 0x6c, 0x62, 0x64, 0x68, 0x61, 0xbe, 0xa0, 0x56, 0x2c, 0xec, 0xb7, 0x39,
+	0x88, 0x2c, 0x03, 0x3d, 0xdd, 0x91, 0x2c, 0x3a, 0x66, 0xcb, 0x40, 0xef, 0x9b, 0xc6, 0x57, 0x99,
+	0x2d, 0xf8, 0x75, 0x0e, 0x4e, 0xac, 0xd3, 0x4c, 0x8a, 0x64, 0x05, 0x27, 0x49, 0xfe, 0xdf, 0x0e,
+	0xea, 0x32, 0xa9, 0x99, 0xfa, 0xe8, 0xaa, 0x71, 0x02, 0xd4, 0x84, 0x39, 0xc1, 0x9c, 0xb3, 0x28,
+	0x64, 0x2e, 0xed, 0x54, 0x3d, 0xf9, 0x56, 0xb9, 0x0b, 0xcb, 0xb1, 0x59, 0xf0, 0xac, 0xc7, 0x79,
+	0x98, 0x1d, 0x5a, 0xbb, 0x60, 0x12, 0x95, 0x00, 0xd6, 0x34, 0x94, 0x3b, 0xa4, 0x6e, 0xa3, 0xbb,
+	0x7e, 0x6c, 0x09, 0x32, 0xd0, 0xd2, 0xa2, 0x4d, 0x98, 0x96, 0xd7, 0x55, 0x3a, 0xb0, 0x44, 0xca,
+	0x39, 0x47, 0x60, 0x4a, 0x6c, 0x16, 0x99, 0xbf, 0x3d, 0x10, 0xde, 0x45, 0xdc, 0x2a, 0xcb, 0x70,
+	0x3c, 0xc2, 0x94, 0x8f, 0xf6, 0x21, 0x9c, 0x60, 0x15, 0x9e, 0xa3, 0x4c, 0xe2, 0x24, 0x2c, 0xc7,
+	0x88, 0x39, 0xdf, 0x67, 0xb0, 0x18, 0x00, 0xa5, 0xec, 0xed, 0xad, 0x70, 0xf6, 0xf6, 0xdc, 0x88,
+	0x5d, 0x0f, 0x25, 0x6f, 0x7f, 0x95, 0x97, 0xbc, 0x42, 0x4a, 0xee, 0xf6, 0xc3, 0x70, 0xee, 0xf6,
+	0xdd, 0x71, 0xbc, 0x43, 0xa9, 0xdb, 0xb8, 0xd6, 0x16, 0x12, 0xb4, 0xf6, 0x87, 0xb1, 0x04, 0x6f,
+	0x31, 0x2d, 0x43, 0x1e, 0x91, 0xf6, 0x4b, 0xc9, 0xef, 0xaa, 0x2c, 0xbf, 0x1b, 0x0c, 0x1d, 0x14,
+	0xe4, 0x6e, 0x47, 0xf2, 0xbb, 0xe7, 0xc7, 0xca, 0x1b, 0xa4, 0x77, 0xff, 0xb2, 0x08, 0xe5, 0xe0,
+	0x59, 0x6c, 0xcd, 0xe3, 0xcb, 0x96, 0x4f, 0x58, 0x36, 0xd9, 0x7f, 0x17, 0x3e, 0x97, 0xff, 0x2e,
+	0x66, 0xf6, 0xdf, 0xa7, 0xa0, 0x4c, 0x2f, 0x34, 0x17, 0xef, 0x71, 0x7f, 0x5c, 0xa2, 0x00, 0x15,
+	0xef, 0x0d, 0xd5, 0x70, 0x7a, 0x22, 0x35, 0x8c, 0x64, 0x94, 0x67, 0xa2, 0x19, 0xe5, 0xfb, 0x81,
+	0x3f, 0x65, 0x2e, 0xf8, 0xf2, 0x08, 0xbe, 0x89, 0x9e, 0xb4, 0x15, 0xf6, 0xa4, 0xcc, 0x2b, 0xbf,
+	0x37, 0x8a, 0xcb, 0xd7, 0x36, 0x9f, 0xbc, 0xc3, 0xf2, 0xc9, 0xb2, 0x2e, 0x72, 0xcb, 0xfa, 0x21,
+	0x40, 0x60, 0x44, 0x44, 0x52, 0xf9, 0xd4, 0x88, 0x39, 0xaa, 0x12, 0x3a, 0x61, 0x1b, 0xda, 0x9a,
+	0x81, 0x97, 0xdd, 0x5e, 0x8d, 0xa8, 0x38, 0xff, 0x59, 0x09, 0xe6, 0x23, 0x7c, 0x63, 0xba, 0x7e,
+	0x3f, 0x56, 0xc9, 0x98, 0x50, 0x8b, 0x6f, 0x85, 0x0b, 0x19, 0x47, 0xd4, 0xba, 0x58, 0x1d, 0x83,
+	0xc6, 0x3d, 0xba, 0xcb, 0x1f, 0xb3, 0x3c, 0x73, 0x99, 0x43, 0xd6, 0xe8, 0xb9, 0x62, 0xcf, 0xb4,
+	0x4c, 0xef, 0x80, 0x3d, 0x9f, 0xa6, 0xcf, 0x41, 0x80, 0xd6, 0x68, 0x93, 0x24, 0x7e, 0x63, 0xfa,
+	0x5a, 0xd7, 0x36, 0x30, 0xd5, 0xe9, 0x29, 0xb5, 0x44, 0x00, 0xeb, 0xb6, 0x81, 0x87, 0x6f, 0x5e,
+	0xe9, 0x68, 0x6f, 0x5e, 0x39, 0xf2, 0xe6, 0x9d, 0x80, 0x69, 0x17, 0xeb, 0x9e, 0x6d, 0xf1, 0xc3,
+	0x3d, 0xbf, 0x23, 0x5b, 0xd3, 0xc7, 0x9e, 0x47, 0x46, 0xe2, 0xc1, 0x1e, 0xbf, 0x95, 0x82, 0xd4,
+	0xd9, 0xb1, 0x41, 0xea, 0x88, 0xea, 0x6f, 0x24, 0x48, 0xad, 0x8e, 0x0d, 0x52, 0xb3, 0x14, 0x7f,
+	0xa5, 0x30, 0x7d, 0x2e, 0x5b, 0x98, 0x2e, 0x47, 0xb5, 0xf3, 0xe1, 0xa8, 0xf6, 0x09, 0xcc, 0xbc,
+	0xb2, 0x7b, 0x83, 0x3e, 0xf6, 0xea, 0x46, 0x5a, 0xa1, 0x3b, 0x2a, 0xdd, 0x73, 0x46, 0xc0, 0xbb,
+	0xc5, 0x38, 0x79, 0x38, 0xb1, 0x80, 0x3f, 0x47, 0x62, 0x41, 0x0e, 0x3e, 0xf7, 0x42, 0xc1, 0x67,
+	0x70, 0xa0, 0xd9, 0xcf, 0x76, 0xa0, 0xf9, 0x0a, 0x4d, 0x51, 0x63, 0x1b, 0x66, 0xe5, 0x75, 0x4a,
+	0xa0, 0x5d, 0x91, 0x69, 0x13, 0x8f, 0x4e, 0x8c, 0x81, 0x6c, 0xe0, 0x4a, 0x30, 0xcd, 0x80, 0xca,
+	0x3f, 0xe5, 0x60, 0x39, 0x66, 0x94, 0xb8, 0xb1, 0xbb, 0x1d, 0xa9, 0xc2, 0x9f, 0x1f, 0xbb, 0xa7,
+	0x41, 0x11, 0xfe, 0x71, 0xa8, 0x08, 0xff, 0xad, 0xf1, 0x84, 0x6f, 0xbd, 0x06, 0xff, 0xd7, 0x79,
+	0x38, 0xbb, 0xe3, 0x18, 0x91, 0xf8, 0x98, 0xab, 0x49, 0x76, 0xb3, 0x7b, 0x5f, 0x9c, 0xb3, 0xf2,
+	0x93, 0xaa, 0x22, 0xa3, 0x43, 0x9f, 0x40, 0xcd, 0x73, 0x70, 0x57, 0x93, 0x5f, 0x60, 0xf6, 0x8a,
+	0x3c, 0x4a, 0x28, 0x14, 0x8c, 0x16, 0x78, 0x85, 0x98, 0xaa, 0xd8, 0x4b, 0x3d, 0xef, 0x85, 0xa1,
+	0x8d, 0x87, 0xb0, 0x94, 0x84, 0x38, 0xd1, 0xf2, 0x29, 0x70, 0x2e, 0x5d, 0x18, 0x1e, 0x27, 0xff,
+	0x18, 0xe6, 0x37, 0xdf, 0xe0, 0x6e, 0xe7, 0xd0, 0xea, 0x4e, 0xb0, 0xa2, 0x35, 0x28, 0x74, 0xfb,
+	0x06, 0x4f, 0xac, 0x93, 0x4b, 0x39, 0xf4, 0x2f, 0x84, 0x43, 0x7f, 0x0d, 0x6a, 0xc3, 0x11, 0xb8,
+	0x56, 0x9e, 0x20, 0x5a, 0x69, 0x10, 0x64, 0xc2, 0x7c, 0x56, 0xe5, 0x77, 0x1c, 0x8e, 0x5d, 0xd6,
+	0xe8, 0xc6, 0xe0, 0xd8, 0x75, 0xc3, 0x2e, 0xa2, 0x10, 0x76, 0x11, 0xca, 0x1f, 0xe7, 0xa0, 0x42,
+	0x46, 0xf8, 0x5c, 0xf2, 0xf3, 0xd3, 0x79, 0x61, 0x78, 0x3a, 0x0f, 0x0e, 0xf9, 0x45, 0xf9, 0x90,
+	0x3f, 0x94, 0x7c, 0x8a, 0x82, 0xe3, 0x92, 0x4f, 0x07, 0x70, 0xec, 0xba, 0xca, 0x39, 0x98, 0x65,
+	0xb2, 0xf1, 0x99, 0x93, 0x16, 0x57, 0xb7, 0x27, 0xf6, 0x6f, 0xe0, 0xf6, 0x94, 0x9f, 0xe7, 0xa0,
+	0xba, 0xe6, 0xfb, 0x7a, 0xf7, 0x60, 0x82, 0x09, 0x04, 0xc2, 0xe5, 0x65, 0xe1, 0xe2, 0x93, 0x18,
+	0x8a, 0x5b, 0x4c, 0x11, 0x77, 0x2a, 0x24, 0xae, 0x02, 0x73, 0x42, 0x96, 0x54, 0x81, 0x5b, 0xa4,
+	0x9f, 0xd7, 0xf5, 0x1f, 0xd9, 0xee, 0x6b, 0xdd, 0x35, 0x26, 0x3b, 0x76, 0x93, 0x4a, 0x15, 0xfb,
+	0x5a, 0xa2, 0x70, 0x65, 0x4a, 0xa5, 0xd7, 0xca, 0x65, 0x58, 0x0c, 0xf1, 0x4b, 0x1d, 0xf8, 0x01,
+	0x54, 0xa8, 0xb3, 0xe7, 0xe7, 0xaf, 0x9b, 0x72, 0x59, 0x3f, 0x53, 0x68, 0xa0, 0x7c, 0x0f, 0x16,
+	0x48, 0x50, 0x48, 0xe1, 0x81, 0x05, 0xf9, 0x4e, 0xe4, 0x70, 0x72, 0x3a, 0x85, 0x51, 0xe4, 0x60,
+	0xf2, 0xdb, 0x3c, 0x4c, 0x51, 0x78, 0x2c, 0x50, 0x3b, 0x45, 0xdc, 0x9f, 0x63, 0x6b, 0xbe, 0xbe,
+	0x1f, 0x7c, 0x9b, 0x42, 0x00, 0xdb, 0xfa, 0x3e, 0x4d, 0xb9, 0xd0, 0x87, 0x86, 0xb9, 0x8f, 0x3d,
+	0x5f, 0x7c, 0xa0, 0x52, 0x21, 0xb0, 0x0d, 0x06, 0xa2, 0x45, 0x37, 0xf3, 0xf7, 0xd8, 0x61, 0xa3,
+	0xa8, 0xd2, 0x6b, 0xb4, 0xc2, 0x5a, 0xa3, 0xb3, 0x54, 0x61, 0x08, 0x22, 0xe9, 0x54, 0x8e, 0x14,
+	0x5e, 0x82, 0x7b, 0x74, 0x2f, 0xea, 0xe8, 0x2f, 0xa6, 0xcc, 0x38, 0xd9, 0xbd, 0x7f, 0x41, 0xfe,
+	0x6c, 0x13, 0x90, 0xbc, 0x37, 0x5c, 0x0b, 0x6e, 0xc0, 0x34, 0xdd, 0x3a, 0x11, 0xa8, 0x2f, 0xa7,
+	0x88, 0xaa, 0x72, 0x34, 0x45, 0x07, 0xc4, 0xb6, 0x3d, 0x14, 0x9c, 0x4f, 0xae, 0x2b, 0x23, 0x82,
+	0xf5, 0xbf, 0xcd, 0xc1, 0x62, 0x68, 0x0c, 0x2e, 0xeb, 0xf5, 0xf0, 0x20, 0xa9, 0xa2, 0xf2, 0x01,
+	0xd6, 0x43, 0xfe, 0xf5, 0x46, 0x9a, 0x48, 0x5f, 0x90, 0x6f, 0xfd, 0x6d, 0x0e, 0x60, 0x6d, 0xe0,
+	0x1f, 0xf0, 0x14, 0xb7, 0xac, 0x2f, 0xb9, 0x88, 0xbe, 0x34, 0xa0, 0xe4, 0xe8, 0x9e, 0xf7, 0xda,
+	0x76, 0xc5, 0xf1, 0x3a, 0xb8, 0xa7, 0xc9, 0xe8, 0x81, 0x7f, 0x20, 0x6a, 0xba, 0xe4, 0x9a, 0x24,
+	0xea, 0xd9, 0x57, 0x5a, 0x9a, 0x6e, 0x18, 0x2e, 0x29, 0xcb, 0xb3, 0xe2, 0x6e, 0x95, 0x41, 0xd7,
+	0x18, 0x90, 0xa0, 0x99, 0x06, 0xb6, 0x7c, 0x52, 0x28, 0xf1, 0xed, 0x97, 0xd8, 0xe2, 0xc7, 0xe4,
+	0xaa, 0x80, 0x6e, 0x13, 0x20, 0xab, 0x72, 0xed, 0x9b, 0x9e, 0xef, 0x0a, 0x34, 0x51, 0x48, 0xe4,
+	0x50, 0x8a, 0x46, 0x36, 0xa5, 0xd6, 0x1e, 0xf4, 0x7a, 0x6c, 0x89, 0x8f, 0xbe, 0xed, 0xdf, 0xe4,
+	0x13, 0xca, 0xa7, 0xbd, 0x69, 0xc3, 0x45, 0xe3, 0xd3, 0x7d, 0x8b, 0xf9, 0xc0, 0x6f, 0xc2, 0x82,
+	0x34, 0x07, 0xae, 0x56, 0xa1, 0xf3, 0x4c, 0x2e, 0x7c, 0x9e, 0x51, 0x1e, 0x03, 0x62, 0x29, 0xb0,
+	0xcf, 0x39, 0x6f, 0xe5, 0x38, 0x2c, 0x86, 0x18, 0xf1, 0xf8, 0xe0, 0x1a, 0x54, 0x79, 0x57, 0x2d,
+	0x57, 0x94, 0x93, 0x50, 0x22, 0x76, 0xbe, 0x6b, 0x1a, 0xa2, 0xe0, 0x3f, 0xe3, 0xd8, 0xc6, 0xba,
+	0x69, 0xb8, 0xca, 0x0b, 0xa8, 0xaa, 0x6c, 0x1c, 0x8e, 0xfb, 0x08, 0xe6, 0x78, 0x0f, 0xae, 0x16,
+	0x6a, 0x82, 0x4f, 0xfa, 0xc8, 0x4a, 0x1e, 0x44, 0xad, 0x5a, 0xf2, 0xad, 0x62, 0x40, 0x83, 0x05,
+	0x32, 0x21, 0xf6, 0x62, 0xb2, 0x8f, 0x40, 0xf4, 0xc3, 0x8f, 0x1d, 0x25, 0x4c, 0x5f, 0x75, 0xe5,
+	0x5b, 0xe5, 0x34, 0x9c, 0x4a, 0x1c, 0x85, 0xaf, 0x84, 0x03, 0xb5, 0xe1, 0x03, 0xc3, 0x14, 0x9d,
+	0x0f, 0xb4, 0xa3, 0x21, 0x27, 0x75, 0x34, 0x9c, 0x08, 0x22, 0xee, 0xbc, 0x70, 0xad, 0xe4, 0x4e,
+	0x3a, 0x79, 0x16, 0xd2, 0x4e, 0x9e, 0xc5, 0xd0, 0xc9, 0x53, 0xe9, 0x04, 0xeb, 0xc9, 0x33, 0x02,
+	0x0f, 0x69, 0xe6, 0x82, 0x8d, 0x2d, 0x0c, 0xa2, 0x32, 0x6a, 0x96, 0x0c, 0x55, 0x95, 0xa8, 0x94,
+	0xab, 0x50, 0x0d, 0x9b, 0x46, 0xc9, 0xce, 0xe5, 0x62, 0x76, 0x6e, 0x2e, 0x62, 0xe2, 0xde, 0x8f,
+	0x1c, 0x27, 0xd2, 0xd7, 0x38, 0x72, 0x98, 0xb8, 0x17, 0x32, 0x76, 0xd7, 0xe2, 0x64, 0x5f, 0x94,
+	0x9d, 0x5b, 0xe2, 0xfe, 0xe0, 0x91, 0x47, 0xe8, 0xf9, 0xa4, 0x95, 0x0b, 0x50, 0xd9, 0x49, 0xfb,
+	0x82, 0xaf, 0xc8, 0xc9, 0x95, 0x5b, 0xb0, 0xf4, 0xc8, 0xec, 0x61, 0xef, 0xd0, 0xf3, 0x71, 0xbf,
+	0x49, 0x8d, 0xd2, 0x9e, 0x89, 0x5d, 0xd2, 0xd3, 0x41, 0x4f, 0xd3, 0x8e, 0x6d, 0x06, 0x1f, 0x76,
+	0x49, 0x10, 0xf2, 0xfd, 0xe6, 0xfc, 0x90, 0x70, 0x87, 0x66, 0x11, 0xde, 0x81, 0x32, 0x99, 0xaf,
+	0xe7, 0xeb, 0x7d, 0x47, 0x14, 0x66, 0x03, 0x00, 0x49, 0x1d, 0xef, 0x79, 0x22, 0x7b, 0x99, 0x58,
+	0x09, 0x4a, 0x12, 0x44, 0x2d, 0xee, 0x79, 0x4d, 0xd2, 0x33, 0x0c, 0x03, 0x0f, 0x1b, 0xbc, 0x18,
+	0x5b, 0x48, 0x8b, 0x61, 0x76, 0xe4, 0x46, 0x0d, 0x42, 0xc0, 0x1a, 0x05, 0xef, 0x41, 0xc5, 0xb4,
+	0x6c, 0x03, 0xd3, 0xe2, 0xb9, 0x51, 0x2f, 0x66, 0x21, 0x07, 0x46, 0xb1, 0xe3, 0x61, 0x43, 0xc1,
+	0xb0, 0x18, 0x5a, 0x5f, 0xae, 0x28, 0x2d, 0x58, 0x60, 0x46, 0x6b, 0x2f, 0x10, 0x5c, 0x68, 0xec,
+	0xf9, 0x51, 0xb3, 0xa3, 0xab, 0xa5, 0xd6, 0x4c, 0x1e, 0x70, 0x09, 0x52, 0x52, 0xeb, 0x08, 0x1d,
+	0x37, 0x27, 0x38, 0xff, 0x29, 0xed, 0x48, 0xce, 0x6e, 0xa8, 0xce, 0x3c, 0x23, 0x26, 0xb4, 0x79,
+	0x5c, 0x46, 0xcc, 0x63, 0x19, 0x31, 0x4f, 0xf9, 0x21, 0x9c, 0x0c, 0x25, 0x17, 0x43, 0x12, 0xdd,
+	0x8b, 0xc4, 0x93, 0x97, 0xc6, 0x71, 0x8d, 0x04, 0x96, 0xff, 0x9b, 0x83, 0xa5, 0x24, 0x84, 0x23,
+	0x26, 0xbf, 0x7f, 0x9c, 0xd2, 0x14, 0x7e, 0x3b, 0x9b, 0x58, 0x5f, 0x4a, 0xe1, 0x60, 0x1b, 0x1a,
+	0x49, 0xeb, 0x19, 0xdf, 0xa5, 0xc2, 0x24, 0xbb, 0xf4, 0xb3, 0x82, 0x54, 0x04, 0x5a, 0xf3, 0x7d,
+	0xd7, 0xdc, 0x1d, 0x10, 0x95, 0x7f, 0xeb, 0x89, 0xd5, 0x66, 0x90, 0x22, 0x64, 0x4b, 0x7b, 0x73,
+	0x04, 0xf9, 0x50, 0x8e, 0xc4, 0x34, 0xe1, 0x47, 0xe1, 0x34, 0x21, 0x2b, 0xef, 0xdc, 0xca, 0xc6,
+	0xef, 0x6b, 0x9b, 0x8b, 0xff, 0x59, 0x1e, 0xe6, 0xc2, 0x5b, 0x84, 0x36, 0x01, 0xf4, 0x40, 0xf2,
+	0x7a, 0x6e, 0x6c, 0xc5, 0x6c, 0x38, 0x4d, 0x55, 0x22, 0x44, 0xef, 0x41, 0xa1, 0xeb, 0x0c, 0xf8,
+	0xae, 0x25, 0x24, 0x01, 0xd7, 0x9d, 0x01, 0xb3, 0x28, 0x04, 0x8d, 0x9c, 0xf4, 0x78, 0xff, 0x69,
+	0xaa, 0x95, 0x64, 0xbd, 0xa8, 0x8c, 0x86, 0x23, 0xa3, 0x27, 0x30, 0x47, 0x3a, 0x61, 0xf5, 0xdd,
+	0x1e, 0xd6, 0x7a, 0xfa, 0x21, 0x76, 0xb9, 0x95, 0xcc, 0x60, 0xc8, 0xaa, 0x82, 0xf0, 0x29, 0xa1,
+	0x53, 0xfe, 0x00, 0x4a, 0x42, 0xa2, 0x31, 0x1e, 0x61, 0x1b, 0x96, 0x07, 0x04, 0x4d, 0xa3, 0x0d,
+	0xdc, 0x96, 0x6e, 0xd9, 0x9a, 0x87, 0x89, 0x1b, 0x17, 0x9f, 0x96, 0x8d, 0x31, 0xd1, 0x4b, 0x94,
+	0x7a, 0xdd, 0x76, 0x71, 0x4b, 0xb7, 0xec, 0x0e, 0x23, 0x55, 0x5e, 0x41, 0x45, 0x9a, 0xe0, 0x18,
+	0x11, 0x9a, 0xb0, 0x20, 0x7a, 0x4a, 0x48, 0x0b, 0x38, 0x73, 0x2f, 0x99, 0x06, 0x9f, 0xe7, 0x74,
+	0x1d, 0xec, 0xb3, 0x3e, 0xa0, 0x7b, 0x70, 0x52, 0xc5, 0xb6, 0x83, 0xad, 0x60, 0x3f, 0x9f, 0xda,
+	0xfb, 0x13, 0x58, 0xf0, 0x77, 0xa0, 0x91, 0x44, 0xcf, 0x23, 0xb3, 0x3b, 0x70, 0xbc, 0xad, 0x0f,
+	0x3c, 0x7c, 0xc4, 0x3a, 0x78, 0x94, 0x96, 0x73, 0xbd, 0x0b, 0xcb, 0x3b, 0x96, 0x73, 0x54, 0xbe,
+	0x0d, 0xa8, 0xc7, 0xa9, 0x39, 0xe7, 0x5b, 0x22, 0xd4, 0xe6, 0x87, 0x60, 0xce, 0xf5, 0x2c, 0x54,
+	0xd8, 0x09, 0x5b, 0x93, 0x4e, 0x61, 0xc0, 0x40, 0xa4, 0xf1, 0x53, 0x39, 0x01, 0x4b, 0x61, 0x3a,
+	0xce, 0xef, 0x1e, 0xaf, 0xe5, 0x1f, 0xf5, 0x2b, 0xcb, 0x93, 0xb0, 0x1c, 0xa3, 0x1f, 0xb2, 0x0e,
+	0x7f, 0xdc, 0x32, 0xd9, 0x37, 0x96, 0x8a, 0x1a, 0xfd, 0x48, 0x53, 0x0e, 0x25, 0x43, 0xbe, 0x77,
+	0xec, 0x67, 0x35, 0x81, 0x59, 0xff, 0x87, 0x1c, 0x1c, 0x8f, 0x3c, 0x4a, 0x71, 0x90, 0x7a, 0xcc,
+	0xf5, 0xb1, 0xf0, 0xf3, 0xce, 0xd8, 0xb1, 0xbe, 0x44, 0xdf, 0xf7, 0xbb, 0xcc, 0xf7, 0xa5, 0x2c,
+	0xf3, 0xfd, 0x48, 0x30, 0x71, 0x39, 0xa3, 0xe8, 0x41, 0x34, 0xf1, 0x1c, 0x4e, 0x25, 0xb2, 0x8f,
+	0xef, 0x42, 0x61, 0xa2, 0x5d, 0xf8, 0x79, 0x41, 0xfe, 0x40, 0x6e, 0x84, 0x77, 0xfd, 0xfc, 0x1f,
+	0x60, 0x7d, 0x2f, 0xe2, 0x5e, 0x57, 0x47, 0xd1, 0x8f, 0xf1, 0xaf, 0x1f, 0x27, 0xf9, 0xd7, 0xf7,
+	0x33, 0x32, 0xfc, 0xda, 0x3a, 0xd8, 0x5f, 0xe4, 0x60, 0x3e, 0xb2, 0x4f, 0xe8, 0x51, 0x82, 0x87,
+	0xbd, 0x94, 0x6d, 0xa2, 0x21, 0x17, 0x7b, 0x37, 0x5c, 0x3d, 0xb9, 0x94, 0xe9, 0xeb, 0x59, 0x51,
+	0x3a, 0x51, 0xfe, 0x3c, 0x0f, 0x4b, 0x49, 0xcf, 0x85, 0xe7, 0xce, 0x4d, 0xea, 0xb9, 0xf3, 0x93,
+	0x78, 0xee, 0x0f, 0x86, 0x9f, 0x0f, 0x33, 0x8f, 0x7f, 0x26, 0x35, 0xf3, 0xc0, 0x08, 0x05, 0x3a,
+	0xa1, 0x74, 0x5c, 0xbb, 0x2b, 0x92, 0x57, 0x89, 0x94, 0x6d, 0x86, 0xc0, 0x29, 0x39, 0x3a, 0x7a,
+	0x10, 0x6a, 0x2f, 0x98, 0xca, 0x18, 0xb2, 0x4a, 0x34, 0xca, 0xdf, 0xe7, 0x60, 0x56, 0x96, 0x6a,
+	0x6c, 0xa8, 0xb0, 0x60, 0xe0, 0x3d, 0x7d, 0xd0, 0x23, 0x2d, 0xb9, 0x3e, 0x76, 0xf7, 0xc8, 0x7f,
+	0x65, 0xe4, 0xd3, 0xac, 0x05, 0x67, 0xdc, 0x14, 0x98, 0xfc, 0xc0, 0xc5, 0x39, 0x04, 0x60, 0xf4,
+	0x18, 0x20, 0xe0, 0x26, 0x5e, 0xbc, 0xcc, 0xec, 0x24, 0x52, 0xe5, 0x17, 0x79, 0x38, 0x9e, 0x88,
+	0x95, 0xd8, 0x08, 0xfc, 0x01, 0x94, 0xdc, 0x37, 0x93, 0xc4, 0x1a, 0x33, 0xee, 0x1b, 0x76, 0x90,
+	0xbd, 0x03, 0x65, 0xf7, 0x8d, 0x86, 0x5d, 0xd7, 0x76, 0x33, 0x9e, 0x82, 0x4b, 0xee, 0x9b, 0x4d,
+	0x8a, 0x4e, 0x46, 0xf5, 0xdf, 0x48, 0xdd, 0xcc, 0xe3, 0x47, 0xf5, 0x87, 0xa3, 0xfa, 0xc1, 0xa8,
+	0x53, 0x99, 0x46, 0xf5, 0xf9, 0xa8, 0x8a, 0x03, 0xb3, 0xb2, 0x0a, 0x8d, 0xd9, 0x66, 0xf2, 0xf7,
+	0x1f, 0x0c, 0x5b, 0x6a, 0xe0, 0x1e, 0x3b, 0xda, 0x2c, 0xa7, 0xa1, 0x3d, 0xde, 0xd7, 0x2e, 0x41,
+	0x49, 0xfc, 0x99, 0x15, 0x9a, 0x81, 0xc2, 0xf6, 0x7a, 0xbb, 0x76, 0x8c, 0x5c, 0xec, 0x6c, 0xb4,
+	0x6b, 0x39, 0x54, 0x82, 0x62, 0x67, 0x7d, 0xbb, 0x5d, 0xcb, 0x5f, 0xeb, 0x43, 0x2d, 0xfa, 0x4f,
+	0x4e, 0x68, 0x19, 0x16, 0xdb, 0xea, 0x56, 0x7b, 0xed, 0xf1, 0xda, 0x76, 0x73, 0xab, 0xa5, 0xb5,
+	0xd5, 0xe6, 0xf3, 0xb5, 0xed, 0xcd, 0xda, 0x31, 0x74, 0x1e, 0x4e, 0xcb, 0x0f, 0x9e, 0x6c, 0x75,
+	0xb6, 0xb5, 0xed, 0x2d, 0x6d, 0x7d, 0xab, 0xb5, 0xbd, 0xd6, 0x6c, 0x6d, 0xaa, 0xb5, 0x1c, 0x3a,
+	0x0d, 0x27, 0x65, 0x94, 0x87, 0xcd, 0x8d, 0xa6, 0xba, 0xb9, 0x4e, 0xae, 0xd7, 0x9e, 0xd6, 0xf2,
+	0xd7, 0x6e, 0x42, 0x35, 0xf4, 0x27, 0x4b, 0x44, 0xa4, 0xf6, 0xd6, 0x46, 0xed, 0x18, 0xaa, 0x42,
+	0x59, 0xe6, 0x53, 0x82, 0x62, 0x6b, 0x6b, 0x63, 0xb3, 0x96, 0xbf, 0xd6, 0x8e, 0x1a, 0x3c, 0x8c,
+	0x16, 0xa0, 0xda, 0x59, 0x6b, 0x6d, 0x3c, 0xdc, 0xfa, 0x48, 0x53, 0x37, 0xd7, 0x36, 0x3e, 0xae,
+	0x1d, 0x43, 0x4b, 0x50, 0x13, 0xa0, 0xd6, 0xd6, 0x36, 0x83, 0xe6, 0x22, 0xd0, 0x47, 0x5b, 0x3b,
+	0xad, 0x8d, 0x9a, 0x71, 0xed, 0xa7, 0xb9, 0xc8, 0x21, 0x05, 0xa3, 0xe3, 0xb0, 0x10, 0x8c, 0xae,
+	0xad, 0xab, 0x9b, 0x6b, 0xdb, 0x9b, 0x44, 0xa8, 0x10, 0x58, 0xdd, 0x69, 0xb5, 0x9a, 0xad, 0xc7,
+	0x8c, 0xed, 0x10, 0xbc, 0xf9, 0x51, 0x93, 0x20, 0xe7, 0xc3, 0xc8, 0x3b, 0xad, 0xef, 0xb7, 0xb6,
+	0x5e, 0xb4, 0x6a, 0x05, 0xb4, 0x08, 0xf3, 0x43, 0x70, 0x7b, 0x6d, 0xa7, 0xb3, 0x59, 0x2b, 0xae,
+	0xfe, 0xfb, 0x71, 0x98, 0x13, 0xe9, 0x33, 0xec, 0xd2, 0xa6, 0xf7, 0x36, 0xcc, 0x88, 0x3f, 0x52,
+	0x4b, 0x30, 0x22, 0xe1, 0xbf, 0x7f, 0x6b, 0x9c, 0x1f, 0x81, 0xc1, 0x03, 0xba, 0x63, 0x68, 0x97,
+	0x66, 0x15, 0x87, 0x8b, 0x87, 0x2e, 0x25, 0xe6, 0xf0, 0x62, 0xc1, 0x64, 0xe3, 0xf2, 0x58, 0xbc,
+	0x60, 0x0c, 0x0c, 0x73, 0xe1, 0xbf, 0x04, 0x41, 0x97, 0x93, 0x32, 0x7e, 0x09, 0xff, 0x39, 0xd2,
+	0xb8, 0x32, 0x1e, 0x31, 0x18, 0xe6, 0x25, 0xd4, 0xa2, 0x7f, 0x0f, 0x82, 0x12, 0xaa, 0xfb, 0x29,
+	0xff, 0x41, 0xd2, 0xb8, 0x96, 0x05, 0x55, 0x1e, 0x2c, 0xf6, 0x47, 0x1a, 0x57, 0xb3, 0xfc, 0x33,
+	0x41, 0xea, 0x60, 0x69, 0x7f, 0x62, 0xc0, 0x16, 0x30, 0x1c, 0xb5, 0xa1, 0xc4, 0x7f, 0xad, 0xf0,
+	0xfc, 0x4c, 0x0b, 0x98, 0xfc, 0x61, 0xb5, 0x72, 0x0c, 0x1d, 0xc0, 0x7c, 0xa4, 0xff, 0x18, 0x25,
+	0x90, 0x27, 0x37, 0x5a, 0x37, 0xae, 0x66, 0xc0, 0x0c, 0x6b, 0x84, 0xdc, 0x6f, 0x9c, 0xac, 0x11,
+	0x09, 0xdd, 0xcc, 0x8d, 0x2b, 0xe3, 0x11, 0x65, 0xe5, 0x0e, 0xf5, 0x19, 0x27, 0x29, 0x77, 0x52,
+	0x77, 0x73, 0xe3, 0xf2, 0x58, 0x3c, 0x79, 0xd1, 0x22, 0x5d, 0xc7, 0x49, 0x8b, 0x96, 0xdc, 0xd5,
+	0xdc, 0xb8, 0x9a, 0x01, 0x33, 0xaa, 0x05, 0xc1, 0x23, 0x2f, 0x4d, 0x0b, 0x62, 0x1d, 0xb7, 0x8d,
+	0x2b, 0xe3, 0x11, 0x43, 0x5a, 0x10, 0xe9, 0x3d, 0xbc, 0x92, 0xa1, 0xdb, 0x27, 0x5d, 0x0b, 0x92,
+	0xfb, 0x82, 0x94, 0x63, 0xe8, 0x0f, 0x73, 0x50, 0x4f, 0x6b, 0x49, 0x41, 0x37, 0x27, 0xee, 0xa5,
+	0x69, 0xac, 0x4e, 0x42, 0x12, 0x48, 0xf1, 0x09, 0xa0, 0x78, 0x36, 0x01, 0x7d, 0x23, 0x69, 0x67,
+	0x52, 0x72, 0x16, 0x8d, 0xf7, 0xb2, 0x21, 0xcb, 0x3b, 0x19, 0x4e, 0x33, 0x24, 0xed, 0x64, 0x62,
+	0x12, 0xa3, 0x71, 0x65, 0x3c, 0xa2, 0x6c, 0xa3, 0xa2, 0x59, 0x87, 0x24, 0x1b, 0x95, 0x92, 0xd7,
+	0x68, 0x5c, 0xcb, 0x82, 0x1a, 0x0c, 0xd6, 0x81, 0x92, 0x68, 0xec, 0x41, 0x09, 0x9e, 0x27, 0xd2,
+	0x56, 0xd4, 0x50, 0x46, 0xa1, 0x04, 0x4c, 0x1f, 0x43, 0x91, 0x40, 0xd1, 0xe9, 0x64, 0x6c, 0xc1,
+	0xec, 0x4c, 0xda, 0xe3, 0x80, 0xd1, 0x33, 0x98, 0x66, 0x9d, 0x2c, 0x28, 0xa1, 0x46, 0x15, 0xea,
+	0xb7, 0x69, 0x9c, 0x4b, 0x47, 0x08, 0xd8, 0xfd, 0x08, 0x2a, 0x52, 0x93, 0x0a, 0xba, 0x98, 0xfc,
+	0xa7, 0x6b, 0xe1, 0x9e, 0x98, 0xc6, 0xbb, 0x63, 0xb0, 0x64, 0xf5, 0x88, 0xe4, 0x47, 0x2f, 0x8f,
+	0x3d, 0x31, 0xa4, 0xab, 0x47, 0x72, 0x1a, 0x9d, 0x29, 0x7e, 0x3c, 0xcd, 0x9e, 0xa4, 0xf8, 0xa9,
+	0xc5, 0x8d, 0xc6, 0x7b, 0xd9, 0x90, 0x65, 0xdb, 0x12, 0x3d, 0xf9, 0x5d, 0x19, 0x9f, 0x63, 0x48,
+	0xb7, 0x2d, 0x29, 0x79, 0x0c, 0xe5, 0x18, 0xf2, 0x61, 0x31, 0x21, 0xd1, 0x81, 0xde, 0x1b, 0xe7,
+	0x0e, 0x43, 0x23, 0x5e, 0xcf, 0x88, 0x2d, 0x8f, 0x9a, 0x50, 0x34, 0x4e, 0x1a, 0x35, 0xbd, 0x82,
+	0xdd, 0xb8, 0x9e, 0x11, 0x5b, 0x56, 0x6e, 0x6e, 0xa8, 0xcf, 0xa6, 0x57, 0x52, 0x53, 0x95, 0x3b,
+	0x66, 0x96, 0x0f, 0x60, 0x3e, 0x92, 0x00, 0x44, 0x69, 0x4e, 0x37, 0x1e, 0x6f, 0x5c, 0xcd, 0x80,
+	0x29, 0x46, 0x5a, 0xfd, 0xb7, 0x02, 0xcc, 0xb2, 0xd6, 0x03, 0x1e, 0xdf, 0x7e, 0x0c, 0x30, 0xec,
+	0xfa, 0x41, 0x17, 0x92, 0x97, 0x3f, 0xd4, 0xaf, 0xd5, 0xb8, 0x38, 0x1a, 0x49, 0x7e, 0x65, 0xa5,
+	0x0e, 0x1a, 0x74, 0x71, 0x4c, 0x83, 0x4d, 0xea, 0x2b, 0x9b, 0xd0, 0x86, 0xa3, 0x1c, 0x43, 0xcf,
+	0xa1, 0x1c, 0xb4, 0x6a, 0xa0, 0xa4, 0x56, 0x8f, 0x48, 0x2f, 0x4a, 0xe3, 0xc2, 0x48, 0x1c, 0x59,
+	0x6a, 0xa9, 0x0f, 0x23, 0x49, 0xea, 0x78, 0xbf, 0x47, 0xe3, 0xdd, 0x31, 0x58, 0xb1, 0x35, 0x61,
+	0xd5, 0xda, 0xd4, 0x35, 0x09, 0x15, 0xcb, 0x1b, 0xef, 0x8e, 0xc1, 0x0a, 0x76, 0xd7, 0x81, 0x2a,
+	0x4b, 0x4d, 0x8b, 0xdd, 0xd5, 0x60, 0x56, 0xce, 0x58, 0xa3, 0x54, 0x39, 0x43, 0x99, 0xf0, 0xc6,
+	0xa5, 0x71, 0x68, 0x62, 0xc4, 0x87, 0x97, 0x7e, 0xf3, 0xe9, 0x99, 0xdc, 0x3f, 0x7f, 0x7a, 0xe6,
+	0xd8, 0x4f, 0x3f, 0x3b, 0x93, 0xfb, 0xcd, 0x67, 0x67, 0x72, 0xff, 0xf8, 0xd9, 0x99, 0xdc, 0x7f,
+	0x7c, 0x76, 0x26, 0xf7, 0x47, 0xff, 0x79, 0xe6, 0xd8, 0x0f, 0x4a, 0x82, 0x7c, 0x77, 0x9a, 0xfe,
+	0x73, 0xf3, 0xb7, 0xfe, 0x6f, 0x00, 0xbb, 0xf9, 0xeb, 0x11, 0x7f, 0x5b, 0x00, 0x00,
 }
@@ -1,5 +1,9 @@
 package config
 
+import (
+	"strings"
+)
+
 const (
 	// K8sNamespace is the namespace we use to connect containerd when CRI is enabled.
 	K8sNamespace = "k8s.io"
@@ -27,4 +31,31 @@ type Config struct {
 	EnableCriStatsCollect bool `json:"enable-cri-stats-collect,omitempty"`
 	// RuntimeConfigFile is a file to make the runtime config persistent.
 	RuntimeConfigFile string `json:"runtime-config-file"`
+	// RuntimeHandlers maps the runtime handlers of Kubernetes RuntimeClass
+	// to the runtimes of pouchd.
+	RuntimeHandlers map[string]RuntimeHandler `json:"runtime-handlers,omitempty"`
+}
+
+// RuntimeHandler is the runtime of pods whose RuntimeClass has the handler.
+type RuntimeHandler struct {
+	// Runtime is the name of runtime added to pouchd, such as runc, kata or runsc.
+	Runtime string `json:"runtime"`
+	// PodAnnotations are the annotations of pod passed to the runtime in the
+	// OCI spec of sandbox and containers, the annotation ends with "*"
+	// matches the annotations with the prefix, such as "io.katacontainers.*".
+	PodAnnotations []string `json:"pod-annotations,omitempty"`
+}
+
+// Annotations returns the annotations of pod passed to the runtime.
+func (h RuntimeHandler) Annotations(annotations map[string]string) map[string]string {
+	res := make(map[string]string)
+	for k, v := range annotations {
+		for _, pattern := range h.PodAnnotations {
+			if k == pattern || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(k, strings.TrimSuffix(pattern, "*"))) {
+				res[k] = v
+				break
+			}
+		}
+	}
+	return res
 }
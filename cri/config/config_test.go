@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeHandlerAnnotations(t *testing.T) {
+	h := RuntimeHandler{
+		Runtime:        "kata",
+		PodAnnotations: []string{"io.katacontainers.*", "io.kubernetes.cri.untrusted-workload"},
+	}
+
+	annotations := h.Annotations(map[string]string{
+		"io.katacontainers.config.hypervisor.default_memory": "4096",
+		"io.kubernetes.cri.untrusted-workload":               "true",
+		"io.kubernetes.cri.untrusted-workload.extra":         "true",
+		"io.katacontainers":                                  "false",
+	})
+	assert.Equal(t, map[string]string{
+		"io.katacontainers.config.hypervisor.default_memory": "4096",
+		"io.kubernetes.cri.untrusted-workload":               "true",
+	}, annotations)
+
+	assert.Empty(t, RuntimeHandler{Runtime: "runc"}.Annotations(map[string]string{"foo": "bar"}))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/alibaba/pouch/cri/config"
 	"github.com/alibaba/pouch/pkg/log"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	cnicurrent "github.com/containernetworking/cni/pkg/types/current"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/pkg/errors"
@@ -78,13 +79,13 @@ func (c *CniManager) GetDefaultNetworkName() string {
 
 // SetUpPodNetwork is the method called after the sandbox container of the
 // pod has been created but before the other containers of the pod
-// are launched.
-func (c *CniManager) SetUpPodNetwork(podNetwork *ocicni.PodNetwork) error {
+// are launched, the results of the networks are returned.
+func (c *CniManager) SetUpPodNetwork(podNetwork *ocicni.PodNetwork) ([]cnitypes.Result, error) {
 	c.RLock()
 	c.updateDefaultRuntimeConfig(podNetwork)
 	c.RUnlock()
 
-	_, err := c.plugin.SetUpPod(*podNetwork)
+	results, err := c.plugin.SetUpPod(*podNetwork)
 
 	defer func() {
 		if err != nil {
@@ -97,10 +98,10 @@ func (c *CniManager) SetUpPodNetwork(podNetwork *ocicni.PodNetwork) error {
 	}()
 
 	if err != nil {
-		return fmt.Errorf("failed to setup network for sandbox %q: %v", podNetwork.ID, err)
+		return nil, fmt.Errorf("failed to setup network for sandbox %q: %v", podNetwork.ID, err)
 	}
 
-	return nil
+	return results, nil
 }
 
 // updateDefaultRuntimeConfig set some config of the pod default network interface.
@@ -1,6 +1,9 @@
 package ocicni
 
-import "github.com/cri-o/ocicni/pkg/ocicni"
+import (
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+)
 
 // CniMgr as an interface defines all operations against CNI.
 type CniMgr interface {
@@ -14,8 +17,8 @@ type CniMgr interface {
 
 	// SetUpPodNetwork is the method called after the sandbox container of the
 	// pod has been created but before the other containers of the pod
-	// are launched.
-	SetUpPodNetwork(podNetwork *ocicni.PodNetwork) error
+	// are launched, the results of the networks are returned.
+	SetUpPodNetwork(podNetwork *ocicni.PodNetwork) ([]cnitypes.Result, error)
 
 	// TearDownPodNetwork is the method called before a pod's sandbox container will be deleted.
 	TearDownPodNetwork(podNetwork *ocicni.PodNetwork) error
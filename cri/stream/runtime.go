@@ -111,6 +111,10 @@ func (s *streamRuntime) Attach(ctx context.Context, containerID string, streamOp
 		UseStderr: streamOpts.Stderr,
 		Stderr:    streams.StderrStream,
 		Terminal:  streamOpts.TTY,
+
+		// NOTE: the attaching of CRI is ended by the client closing
+		// the stream, so no detach keys by default.
+		DetachKeys: []byte{},
 	}
 	if err := s.containerMgr.AttachContainerIO(ctx, containerID, attachCfg); err != nil {
 		return fmt.Errorf("failed to attach to container %q: %v", containerID, err)
@@ -776,6 +776,7 @@ func (c *CriManager) CreateContainer(ctx context.Context, r *runtime.CreateConta
 	specAnnotation[anno.CRIOSandboxName] = podSandboxID
 	specAnnotation[anno.CRIOSandboxID] = podSandboxID
 	specAnnotation[anno.SandboxID] = podSandboxID
+	applyRuntimeAnnotations(specAnnotation, sandboxMeta)
 
 	resources := r.GetConfig().GetLinux().GetResources()
 	createConfig := &apitypes.ContainerCreateConfig{
@@ -1066,7 +1067,7 @@ func (c *CriManager) ContainerStats(ctx context.Context, r *runtime.ContainerSta
 		return nil, fmt.Errorf("failed to get container %q with error: %v", containerID, err)
 	}
 
-	cs, err := c.getContainerMetrics(ctx, container)
+	cs, _, err := c.getContainerMetrics(ctx, container)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode container metrics: %v", err)
 	}
@@ -1111,7 +1112,7 @@ func (c *CriManager) ListContainerStats(ctx context.Context, r *runtime.ListCont
 
 	result := &runtime.ListContainerStatsResponse{}
 	for _, container := range containers {
-		cs, err := c.getContainerMetrics(ctx, container)
+		cs, _, err := c.getContainerMetrics(ctx, container)
 		if err != nil {
 			log.With(ctx).Warnf("failed to decode metrics of container %q: %v", container.ID, err)
 			continue
@@ -1125,6 +1126,81 @@ func (c *CriManager) ListContainerStats(ctx context.Context, r *runtime.ListCont
 	return result, nil
 }
 
+// PodSandboxStats returns stats of the pod sandbox. If the pod sandbox does not
+// exist, the call returns an error.
+func (c *CriManager) PodSandboxStats(ctx context.Context, r *runtime.PodSandboxStatsRequest) (*runtime.PodSandboxStatsResponse, error) {
+	label := util_metrics.ActionStatsLabel
+	defer func(start time.Time) {
+		metrics.PodActionsCounter.WithLabelValues(label).Inc()
+		metrics.PodActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	podSandboxID := r.GetPodSandboxId()
+
+	sandbox, err := c.ContainerMgr.Get(ctx, podSandboxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sandbox %q with error: %v", podSandboxID, err)
+	}
+
+	stats, err := c.getPodSandboxMetrics(ctx, sandbox)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode sandbox metrics: %v", err)
+	}
+
+	metrics.PodSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	return &runtime.PodSandboxStatsResponse{Stats: stats}, nil
+}
+
+// ListPodSandboxStats returns stats of all ready pod sandboxes.
+func (c *CriManager) ListPodSandboxStats(ctx context.Context, r *runtime.ListPodSandboxStatsRequest) (*runtime.ListPodSandboxStatsResponse, error) {
+	label := util_metrics.ActionStatsListLabel
+	defer func(start time.Time) {
+		metrics.PodActionsCounter.WithLabelValues(label).Inc()
+		metrics.PodActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	opts := &mgr.ContainerListOption{All: true}
+	filter := func(c *mgr.Container) bool {
+		if c.Config.Labels[containerTypeLabelKey] != containerTypeLabelSandbox {
+			return false
+		}
+		if !c.IsRunning() {
+			return false
+		}
+
+		if r.GetFilter().GetId() != "" && c.ID != r.GetFilter().GetId() {
+			return false
+		}
+		if r.GetFilter().GetLabelSelector() != nil &&
+			!utils.MatchLabelSelector(r.GetFilter().GetLabelSelector(), c.Config.Labels) {
+			return false
+		}
+		return true
+	}
+	opts.FilterFunc = filter
+
+	sandboxes, err := c.ContainerMgr.List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sandboxes: %v", err)
+	}
+
+	result := &runtime.ListPodSandboxStatsResponse{}
+	for _, sandbox := range sandboxes {
+		stats, err := c.getPodSandboxMetrics(ctx, sandbox)
+		if err != nil {
+			log.With(ctx).Warnf("failed to decode metrics of sandbox %q: %v", sandbox.ID, err)
+			continue
+		}
+
+		result.Stats = append(result.Stats, stats)
+	}
+
+	metrics.PodSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	return result, nil
+}
+
 // UpdateContainerResources updates ContainerConfig of the container.
 func (c *CriManager) UpdateContainerResources(ctx context.Context, r *runtime.UpdateContainerResourcesRequest) (*runtime.UpdateContainerResourcesResponse, error) {
 	label := util_metrics.ActionUpdateLabel
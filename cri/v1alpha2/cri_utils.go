@@ -1,6 +1,7 @@
 package v1alpha2
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -22,10 +23,12 @@ import (
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/netutils"
 	"github.com/alibaba/pouch/pkg/randomid"
 	"github.com/alibaba/pouch/pkg/utils"
 
+	"github.com/containerd/cgroups"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/go-openapi/strfmt"
 	"golang.org/x/net/context"
@@ -299,6 +302,8 @@ func applySandboxLinuxOptions(hc *apitypes.HostConfig, lc *runtime.LinuxPodSandb
 }
 
 // applySandboxRuntimeHandler applies the runtime of container specified by the caller.
+// The runtime handler of RuntimeClass is mapped to the runtime of pouchd by the
+// runtime handlers of cri config, or it's the name of runtime itself.
 func (c *CriManager) applySandboxRuntimeHandler(sandboxMeta *metatypes.SandboxMeta, runtimehandler string, annotations map[string]string) error {
 	if runtimehandler == "" {
 		// apply the annotation of io.kubernetes.runtime which specify the runtime of container.
@@ -309,10 +314,28 @@ func (c *CriManager) applySandboxRuntimeHandler(sandboxMeta *metatypes.SandboxMe
 		}
 		runtimehandler = rt
 	}
-	sandboxMeta.Runtime = runtimehandler
+
+	if h, ok := c.DaemonConfig.CriConfig.RuntimeHandlers[runtimehandler]; ok {
+		sandboxMeta.Runtime = h.Runtime
+		sandboxMeta.RuntimeAnnotations = h.Annotations(annotations)
+	} else if _, ok := c.DaemonConfig.Runtimes[runtimehandler]; ok {
+		sandboxMeta.Runtime = runtimehandler
+	} else {
+		return fmt.Errorf("runtime handler %q is not supported", runtimehandler)
+	}
 	return c.SandboxStore.Put(sandboxMeta)
 }
 
+// applyRuntimeAnnotations passes the annotations of pod to the runtime by
+// the spec annotations, which don't override the ones set by pouch.
+func applyRuntimeAnnotations(specAnnotation map[string]string, sandboxMeta *metatypes.SandboxMeta) {
+	for k, v := range sandboxMeta.RuntimeAnnotations {
+		if _, ok := specAnnotation[k]; !ok {
+			specAnnotation[k] = v
+		}
+	}
+}
+
 // applySandboxAnnotations applies the annotations extended.
 func (c *CriManager) applySandboxAnnotations(sandboxMeta *metatypes.SandboxMeta, annotations map[string]string) error {
 	// apply the annotation of io.kubernetes.lxcfs.enabled
@@ -340,6 +363,7 @@ func makeSandboxPouchConfig(config *runtime.PodSandboxConfig, sandboxMeta *metat
 	specAnnotation := make(map[string]string)
 	specAnnotation[anno.CRIOContainerType] = anno.ContainerTypeSandbox
 	specAnnotation[anno.ContainerType] = anno.ContainerTypeSandbox
+	applyRuntimeAnnotations(specAnnotation, sandboxMeta)
 
 	hc := &apitypes.HostConfig{}
 
@@ -511,7 +535,7 @@ func setupSandboxFiles(sandboxRootDir string, config *runtime.PodSandboxConfig)
 // setupPodNetwork sets up the network of PodSandbox
 // and do nothing when networkNamespaceMode equals runtime.NamespaceMode_NODE.
 func (c *CriManager) setupPodNetwork(id, netnsPath string, config *runtime.PodSandboxConfig) error {
-	return c.CniMgr.SetUpPodNetwork(&ocicni.PodNetwork{
+	_, err := c.CniMgr.SetUpPodNetwork(&ocicni.PodNetwork{
 		Name:      config.GetMetadata().GetName(),
 		Namespace: config.GetMetadata().GetNamespace(),
 		ID:        id,
@@ -522,6 +546,7 @@ func (c *CriManager) setupPodNetwork(id, netnsPath string, config *runtime.PodSa
 			},
 		},
 	})
+	return err
 }
 
 // teardownNetwork teardown the network of PodSandbox.
@@ -1008,12 +1033,14 @@ func parseUserFromImageUser(id string) string {
 	return id
 }
 
-func (c *CriManager) getContainerMetrics(ctx context.Context, meta *mgr.Container) (*runtime.ContainerStats, error) {
+// getContainerMetrics gets the stats of the container, the process usage
+// of container is only reported in the stats of its pod sandbox.
+func (c *CriManager) getContainerMetrics(ctx context.Context, meta *mgr.Container) (*runtime.ContainerStats, *runtime.ProcessUsage, error) {
 	var usedBytes, inodesUsed uint64
 
 	metadata, err := parseContainerName(meta.Name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get metadata of container %q: %v", meta.ID, err)
+		return nil, nil, fmt.Errorf("failed to get metadata of container %q: %v", meta.ID, err)
 	}
 
 	sn, err := c.SnapshotStore.Get(meta.SnapshotID)
@@ -1042,25 +1069,217 @@ func (c *CriManager) getContainerMetrics(ctx context.Context, meta *mgr.Containe
 
 	metricsMeta, metrics, err := c.ContainerMgr.Stats(ctx, meta.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get stats of container %q: %v", meta.ID, err)
+		return nil, nil, fmt.Errorf("failed to get stats of container %q: %v", meta.ID, err)
 	}
 
+	var process *runtime.ProcessUsage
 	if metricsMeta != nil {
-		if metrics.CPU != nil && metrics.CPU.Usage != nil {
-			cs.Cpu = &runtime.CpuUsage{
-				Timestamp:            metricsMeta.Timestamp.UnixNano(),
-				UsageCoreNanoSeconds: &runtime.UInt64Value{Value: metrics.CPU.Usage.Total},
-			}
+		cs.Cpu, cs.Memory, process = toCriUsage(metricsMeta.Timestamp.UnixNano(), metrics)
+	}
+
+	return cs, process, nil
+}
+
+// toCriUsage converts the cgroup metrics of container to CRI usages.
+func toCriUsage(timestamp int64, metrics *cgroups.Metrics) (*runtime.CpuUsage, *runtime.MemoryUsage, *runtime.ProcessUsage) {
+	var (
+		cpu     *runtime.CpuUsage
+		memory  *runtime.MemoryUsage
+		process *runtime.ProcessUsage
+	)
+
+	if metrics.CPU != nil && metrics.CPU.Usage != nil {
+		cpu = &runtime.CpuUsage{
+			Timestamp:            timestamp,
+			UsageCoreNanoSeconds: &runtime.UInt64Value{Value: metrics.CPU.Usage.Total},
+		}
+	}
+	if metrics.Memory != nil && metrics.Memory.Usage != nil {
+		// the working set excludes the inactive page cache which is
+		// reclaimable, the same as the one calculated by cAdvisor.
+		workingSet := metrics.Memory.Usage.Usage
+		if workingSet < metrics.Memory.TotalInactiveFile {
+			workingSet = 0
+		} else {
+			workingSet -= metrics.Memory.TotalInactiveFile
 		}
-		if metrics.Memory != nil && metrics.Memory.Usage != nil {
-			cs.Memory = &runtime.MemoryUsage{
-				Timestamp:       metricsMeta.Timestamp.UnixNano(),
-				WorkingSetBytes: &runtime.UInt64Value{Value: metrics.Memory.Usage.Usage},
+		memory = &runtime.MemoryUsage{
+			Timestamp:       timestamp,
+			WorkingSetBytes: &runtime.UInt64Value{Value: workingSet},
+		}
+	}
+	if metrics.Pids != nil {
+		process = &runtime.ProcessUsage{
+			Timestamp:    timestamp,
+			ProcessCount: &runtime.UInt64Value{Value: metrics.Pids.Current},
+		}
+	}
+	return cpu, memory, process
+}
+
+// getPodSandboxMetrics gets the stats of the pod sandbox, the CPU, memory and
+// process usages are the sum of the sandbox container and the containers in
+// the pod, and the network usage is read from the network namespace of pod.
+func (c *CriManager) getPodSandboxMetrics(ctx context.Context, sandbox *mgr.Container) (*runtime.PodSandboxStats, error) {
+	metadata, err := parseSandboxName(sandbox.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of sandbox %q: %v", sandbox.ID, err)
+	}
+	labels, annotations := extractLabels(sandbox.Config.Labels)
+
+	linux := &runtime.LinuxPodSandboxStats{}
+
+	metricsMeta, metrics, err := c.ContainerMgr.Stats(ctx, sandbox.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats of sandbox %q: %v", sandbox.ID, err)
+	}
+	if metricsMeta != nil {
+		cpu, memory, process := toCriUsage(metricsMeta.Timestamp.UnixNano(), metrics)
+		addPodUsage(linux, cpu, memory, process)
+	}
+
+	containers, err := c.ContainerMgr.List(ctx, &mgr.ContainerListOption{
+		All: true,
+		FilterFunc: func(c *mgr.Container) bool {
+			return c.Config.Labels[containerTypeLabelKey] == containerTypeLabelContainer &&
+				c.Config.Labels[sandboxIDLabelKey] == sandbox.ID
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers of sandbox %q: %v", sandbox.ID, err)
+	}
+	for _, container := range containers {
+		cs, process, err := c.getContainerMetrics(ctx, container)
+		if err != nil {
+			log.With(ctx).Warnf("failed to decode metrics of container %q: %v", container.ID, err)
+			continue
+		}
+		addPodUsage(linux, cs.Cpu, cs.Memory, process)
+		linux.Containers = append(linux.Containers, cs)
+	}
+
+	// the network usage of pod in host network is the one of host.
+	if res, err := c.SandboxStore.Get(sandbox.ID); err == nil {
+		sandboxMeta := res.(*metatypes.SandboxMeta)
+		if sandboxNetworkMode(sandboxMeta.Config) != runtime.NamespaceMode_NODE && sandbox.State.Pid != 0 {
+			linux.Network, err = sandboxNetworkUsage(sandbox.State.Pid)
+			if err != nil {
+				log.With(ctx).Warnf("failed to get network usage of sandbox %q: %v", sandbox.ID, err)
 			}
 		}
 	}
 
-	return cs, nil
+	return &runtime.PodSandboxStats{
+		Attributes: &runtime.PodSandboxAttributes{
+			Id:          sandbox.ID,
+			Metadata:    metadata,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Linux: linux,
+	}, nil
+}
+
+// addPodUsage adds the usages of a container to the usages of its pod, the
+// timestamp of pod usage is the latest one of the containers.
+func addPodUsage(linux *runtime.LinuxPodSandboxStats, cpu *runtime.CpuUsage, memory *runtime.MemoryUsage, process *runtime.ProcessUsage) {
+	add := func(sum **runtime.UInt64Value, timestamp *int64, value *runtime.UInt64Value, ts int64) {
+		if *sum == nil {
+			*sum = &runtime.UInt64Value{}
+		}
+		(*sum).Value += value.GetValue()
+		if ts > *timestamp {
+			*timestamp = ts
+		}
+	}
+
+	if cpu != nil {
+		if linux.Cpu == nil {
+			linux.Cpu = &runtime.CpuUsage{}
+		}
+		add(&linux.Cpu.UsageCoreNanoSeconds, &linux.Cpu.Timestamp, cpu.UsageCoreNanoSeconds, cpu.Timestamp)
+	}
+	if memory != nil {
+		if linux.Memory == nil {
+			linux.Memory = &runtime.MemoryUsage{}
+		}
+		add(&linux.Memory.WorkingSetBytes, &linux.Memory.Timestamp, memory.WorkingSetBytes, memory.Timestamp)
+	}
+	if process != nil {
+		if linux.Process == nil {
+			linux.Process = &runtime.ProcessUsage{}
+		}
+		add(&linux.Process.ProcessCount, &linux.Process.Timestamp, process.ProcessCount, process.Timestamp)
+	}
+}
+
+// sandboxNetworkUsage returns the network usage of the network namespace of
+// sandbox process.
+func sandboxNetworkUsage(pid int64) (*runtime.NetworkUsage, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/net/dev", pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	interfaces, err := parseNetDev(f)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &runtime.NetworkUsage{Timestamp: time.Now().UnixNano()}
+	for _, iface := range interfaces {
+		if iface.Name == ocicni.DefaultInterfaceName {
+			usage.DefaultInterface = iface
+			continue
+		}
+		usage.Interfaces = append(usage.Interfaces, iface)
+	}
+	return usage, nil
+}
+
+// parseNetDev parses the usages of network interfaces except the loopback
+// from the content of /proc/net/dev.
+func parseNetDev(r io.Reader) ([]*runtime.NetworkInterfaceUsage, error) {
+	var interfaces []*runtime.NetworkInterfaceUsage
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			// the headers.
+			continue
+		}
+		name := strings.TrimSpace(parts[0])
+		if name == "lo" {
+			continue
+		}
+
+		// the fields are the receive bytes, packets, errs, drop, fifo,
+		// frame, compressed, multicast and the transmit bytes, packets,
+		// errs, drop, fifo, colls, carrier, compressed.
+		fields := strings.Fields(parts[1])
+		if len(fields) < 16 {
+			return nil, fmt.Errorf("invalid statistics of network interface %s: %s", name, parts[1])
+		}
+		values := make([]uint64, 11)
+		for _, i := range []int{0, 2, 8, 10} {
+			v, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid statistics of network interface %s: %v", name, err)
+			}
+			values[i] = v
+		}
+
+		interfaces = append(interfaces, &runtime.NetworkInterfaceUsage{
+			Name:     name,
+			RxBytes:  &runtime.UInt64Value{Value: values[0]},
+			RxErrors: &runtime.UInt64Value{Value: values[2]},
+			TxBytes:  &runtime.UInt64Value{Value: values[8]},
+			TxErrors: &runtime.UInt64Value{Value: values[10]},
+		})
+	}
+	return interfaces, scanner.Err()
 }
 
 // imageFSPath returns containerd image filesystem path.
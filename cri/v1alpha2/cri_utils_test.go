@@ -11,9 +11,11 @@ import (
 	apitypes "github.com/alibaba/pouch/apis/types"
 	anno "github.com/alibaba/pouch/cri/annotations"
 	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	metatypes "github.com/alibaba/pouch/cri/v1alpha2/types"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/utils"
 
+	"github.com/containerd/cgroups"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/stretchr/testify/assert"
 )
@@ -1974,3 +1976,88 @@ func Test_applyContainerConfigByAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func Test_applyRuntimeAnnotations(t *testing.T) {
+	specAnnotation := map[string]string{
+		anno.ContainerType: anno.ContainerTypeSandbox,
+	}
+	sandboxMeta := &metatypes.SandboxMeta{
+		RuntimeAnnotations: map[string]string{
+			anno.ContainerType: anno.ContainerTypeContainer,
+			"io.katacontainers.config.hypervisor.default_vcpus": "2",
+		},
+	}
+
+	applyRuntimeAnnotations(specAnnotation, sandboxMeta)
+	assert.Equal(t, map[string]string{
+		anno.ContainerType: anno.ContainerTypeSandbox,
+		"io.katacontainers.config.hypervisor.default_vcpus": "2",
+	}, specAnnotation)
+}
+
+func Test_toCriUsage(t *testing.T) {
+	cpu, memory, process := toCriUsage(100, &cgroups.Metrics{
+		CPU:    &cgroups.CPUStat{Usage: &cgroups.CPUUsage{Total: 2000}},
+		Memory: &cgroups.MemoryStat{Usage: &cgroups.MemoryEntry{Usage: 4096}, TotalInactiveFile: 1024},
+		Pids:   &cgroups.PidsStat{Current: 3},
+	})
+	assert.Equal(t, &runtime.CpuUsage{Timestamp: 100, UsageCoreNanoSeconds: &runtime.UInt64Value{Value: 2000}}, cpu)
+	assert.Equal(t, &runtime.MemoryUsage{Timestamp: 100, WorkingSetBytes: &runtime.UInt64Value{Value: 3072}}, memory)
+	assert.Equal(t, &runtime.ProcessUsage{Timestamp: 100, ProcessCount: &runtime.UInt64Value{Value: 3}}, process)
+
+	_, memory, _ = toCriUsage(100, &cgroups.Metrics{
+		Memory: &cgroups.MemoryStat{Usage: &cgroups.MemoryEntry{Usage: 1024}, TotalInactiveFile: 4096},
+	})
+	assert.Equal(t, uint64(0), memory.WorkingSetBytes.Value)
+
+	cpu, memory, process = toCriUsage(100, &cgroups.Metrics{})
+	assert.Nil(t, cpu)
+	assert.Nil(t, memory)
+	assert.Nil(t, process)
+}
+
+func Test_addPodUsage(t *testing.T) {
+	linux := &runtime.LinuxPodSandboxStats{}
+	addPodUsage(linux,
+		&runtime.CpuUsage{Timestamp: 100, UsageCoreNanoSeconds: &runtime.UInt64Value{Value: 2000}},
+		&runtime.MemoryUsage{Timestamp: 100, WorkingSetBytes: &runtime.UInt64Value{Value: 1024}},
+		&runtime.ProcessUsage{Timestamp: 100, ProcessCount: &runtime.UInt64Value{Value: 1}})
+	addPodUsage(linux,
+		&runtime.CpuUsage{Timestamp: 200, UsageCoreNanoSeconds: &runtime.UInt64Value{Value: 3000}},
+		&runtime.MemoryUsage{Timestamp: 50, WorkingSetBytes: &runtime.UInt64Value{Value: 2048}},
+		nil)
+
+	assert.Equal(t, &runtime.CpuUsage{Timestamp: 200, UsageCoreNanoSeconds: &runtime.UInt64Value{Value: 5000}}, linux.Cpu)
+	assert.Equal(t, &runtime.MemoryUsage{Timestamp: 100, WorkingSetBytes: &runtime.UInt64Value{Value: 3072}}, linux.Memory)
+	assert.Equal(t, &runtime.ProcessUsage{Timestamp: 100, ProcessCount: &runtime.UInt64Value{Value: 1}}, linux.Process)
+}
+
+func Test_parseNetDev(t *testing.T) {
+	content := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:     840      10    0    0    0     0          0         0      840      10    0    0    0     0       0          0
+  eth0:   15460     147    1    0    0     0          0         0     3124      38    2    0    0     0       0          0
+  net1:     100       1    0    0    0     0          0         0      200       2    0    0    0     0       0          0
+`
+	interfaces, err := parseNetDev(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, []*runtime.NetworkInterfaceUsage{
+		{
+			Name:     "eth0",
+			RxBytes:  &runtime.UInt64Value{Value: 15460},
+			RxErrors: &runtime.UInt64Value{Value: 1},
+			TxBytes:  &runtime.UInt64Value{Value: 3124},
+			TxErrors: &runtime.UInt64Value{Value: 2},
+		},
+		{
+			Name:     "net1",
+			RxBytes:  &runtime.UInt64Value{Value: 100},
+			RxErrors: &runtime.UInt64Value{Value: 0},
+			TxBytes:  &runtime.UInt64Value{Value: 200},
+			TxErrors: &runtime.UInt64Value{Value: 0},
+		},
+	}, interfaces)
+
+	_, err = parseNetDev(strings.NewReader("  eth0: 1 2 3\n"))
+	assert.Error(t, err)
+}
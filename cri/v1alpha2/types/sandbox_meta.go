@@ -12,9 +12,13 @@ type SandboxMeta struct {
 	// Config is CRI sandbox config.
 	Config *runtime.PodSandboxConfig
 
-	// Runtime is the runtime handler name of the pod.
+	// Runtime is the runtime of the pod resolved from its runtime handler.
 	Runtime string
 
+	// RuntimeAnnotations are the annotations of pod passed to the runtime
+	// by its runtime handler.
+	RuntimeAnnotations map[string]string
+
 	// Runtime whether to enable lxcfs for a container
 	LxcfsEnabled bool
 
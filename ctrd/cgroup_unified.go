@@ -0,0 +1,220 @@
+package ctrd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// unifiedCgroupRoot is where the unified hierarchy is mounted.
+	unifiedCgroupRoot = "/sys/fs/cgroup"
+
+	// defaultCPUPeriod is the default cfs period in microseconds.
+	defaultCPUPeriod = 100000
+)
+
+// ToUnifiedResources translates Pouch Resources into the interface files of
+// cgroup v2. Only the fields which have been set are translated, so that it
+// can be used to update part of the resources.
+func ToUnifiedResources(r types.Resources) (map[string]string, error) {
+	unified := map[string]string{}
+
+	// cpu
+	if r.CPUShares > 0 {
+		unified["cpu.weight"] = strconv.FormatUint(cpuSharesToWeight(uint64(r.CPUShares)), 10)
+	}
+	if r.CPUQuota != 0 || r.CPUPeriod != 0 {
+		quota, period := "max", int64(defaultCPUPeriod)
+		if r.CPUQuota > 0 {
+			quota = strconv.FormatInt(r.CPUQuota, 10)
+		}
+		if r.CPUPeriod > 0 {
+			period = r.CPUPeriod
+		}
+		unified["cpu.max"] = fmt.Sprintf("%s %d", quota, period)
+	}
+	if r.CpusetCpus != "" {
+		unified["cpuset.cpus"] = r.CpusetCpus
+	}
+	if r.CpusetMems != "" {
+		unified["cpuset.mems"] = r.CpusetMems
+	}
+
+	// memory, the swap limit of cgroup v1 includes the memory, while the
+	// one of cgroup v2 only limits the swap.
+	if r.Memory > 0 {
+		unified["memory.max"] = strconv.FormatInt(r.Memory, 10)
+
+		// the memory above the watermark is reclaimed in background.
+		if ratio := r.MemoryWmarkRatio; ratio != nil && *ratio > 0 && *ratio < 100 {
+			unified["memory.high"] = strconv.FormatInt(r.Memory/100*(*ratio), 10)
+		}
+	}
+	if r.MemorySwap == -1 {
+		unified["memory.swap.max"] = "max"
+	} else if r.MemorySwap > 0 && r.Memory > 0 {
+		unified["memory.swap.max"] = strconv.FormatInt(r.MemorySwap-r.Memory, 10)
+	}
+	if r.MemoryReservation > 0 {
+		unified["memory.low"] = strconv.FormatInt(r.MemoryReservation, 10)
+	}
+
+	// io
+	if r.BlkioWeight > 0 {
+		unified["io.weight"] = strconv.FormatUint(blkioWeightToIOWeight(uint64(r.BlkioWeight)), 10)
+	}
+	ioMax, err := toUnifiedIOMax(r)
+	if err != nil {
+		return nil, err
+	}
+	if ioMax != "" {
+		unified["io.max"] = ioMax
+	}
+
+	// hugetlb
+	for _, l := range r.HugepageLimits {
+		unified["hugetlb."+l.PageSize+".max"] = strconv.FormatUint(l.Limit, 10)
+	}
+
+	// pids
+	if r.PidsLimit > 0 {
+		unified["pids.max"] = strconv.FormatInt(r.PidsLimit, 10)
+	} else if r.PidsLimit < 0 {
+		unified["pids.max"] = "max"
+	}
+
+	return unified, nil
+}
+
+// cpuSharesToWeight converts cpu shares in range [2, 262144] to cpu weight in
+// range [1, 10000].
+func cpuSharesToWeight(shares uint64) uint64 {
+	if shares < 2 {
+		shares = 2
+	}
+	return 1 + ((shares-2)*9999)/262142
+}
+
+// blkioWeightToIOWeight converts blkio weight in range [10, 1000] to io
+// weight in range [1, 10000].
+func blkioWeightToIOWeight(weight uint64) uint64 {
+	if weight < 10 {
+		weight = 10
+	}
+	return 1 + ((weight-10)*9999)/990
+}
+
+// toUnifiedIOMax merges the throttle devices into lines of io.max, one line
+// for each device.
+func toUnifiedIOMax(r types.Resources) (string, error) {
+	limits := map[string][]string{}
+	for key, devs := range map[string][]*types.ThrottleDevice{
+		"rbps":  r.BlkioDeviceReadBps,
+		"wbps":  r.BlkioDeviceWriteBps,
+		"riops": r.BlkioDeviceReadIOps,
+		"wiops": r.BlkioDeviceWriteIOps,
+	} {
+		throttles, err := GetThrottleDevice(devs)
+		if err != nil {
+			return "", err
+		}
+		for _, t := range throttles {
+			dev := fmt.Sprintf("%d:%d", t.Major, t.Minor)
+			limits[dev] = append(limits[dev], fmt.Sprintf("%s=%d", key, t.Rate))
+		}
+	}
+
+	lines := make([]string, 0, len(limits))
+	for dev, l := range limits {
+		sort.Strings(l)
+		lines = append(lines, dev+" "+strings.Join(l, " "))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n"), nil
+}
+
+// applyUnifiedResources sets the resources into the cgroup of the process.
+func applyUnifiedResources(pid uint32, resources types.Resources) error {
+	unified, err := ToUnifiedResources(resources)
+	if err != nil {
+		return err
+	}
+
+	dir, err := unifiedCgroupPath(pid)
+	if err != nil {
+		return err
+	}
+	return setUnifiedResources(dir, unified)
+}
+
+// unifiedCgroupPath returns the cgroup v2 directory of the process.
+func unifiedCgroupPath(pid uint32) (string, error) {
+	path, err := parseUnifiedCgroup(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(unifiedCgroupRoot, path), nil
+}
+
+// parseUnifiedCgroup gets the path of unified hierarchy from the cgroup file
+// of process, the line of unified hierarchy is like "0::/path".
+func parseUnifiedCgroup(cgroupFile string) (string, error) {
+	f, err := os.Open(cgroupFile)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if path := strings.TrimPrefix(scanner.Text(), "0::"); path != scanner.Text() {
+			return path, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no unified hierarchy found in %s", cgroupFile)
+}
+
+// setUnifiedResources writes the resources into the interface files of the
+// cgroup. The value contains multiple lines is written line by line, since
+// the kernel only accepts one line per write for files like io.max.
+func setUnifiedResources(dir string, unified map[string]string) error {
+	keys := make([]string, 0, len(unified))
+	for k := range unified {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, line := range strings.Split(unified[k], "\n") {
+			if err := writeCgroupFile(filepath.Join(dir, k), line); err != nil {
+				return errors.Wrapf(err, "failed to set %s to %q", k, line)
+			}
+		}
+	}
+	return nil
+}
+
+// writeCgroupFile writes the interface file without creating it, since the
+// missing file means the controller is not enabled in the cgroup.
+func writeCgroupFile(path, data string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(data)
+	return err
+}
@@ -0,0 +1,137 @@
+package ctrd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToUnifiedResources(t *testing.T) {
+	ratio := int64(80)
+	swappiness := int64(10)
+	for _, tc := range []struct {
+		resources types.Resources
+		expected  map[string]string
+	}{
+		{
+			resources: types.Resources{},
+			expected:  map[string]string{},
+		},
+		{
+			resources: types.Resources{
+				CPUShares:  1024,
+				CPUQuota:   50000,
+				CpusetCpus: "0-1",
+			},
+			expected: map[string]string{
+				"cpu.weight":  "39",
+				"cpu.max":     "50000 100000",
+				"cpuset.cpus": "0-1",
+			},
+		},
+		{
+			resources: types.Resources{
+				CPUQuota:  -1,
+				CPUPeriod: 200000,
+			},
+			expected: map[string]string{
+				"cpu.max": "max 200000",
+			},
+		},
+		{
+			resources: types.Resources{
+				Memory:            100 << 20,
+				MemorySwap:        300 << 20,
+				MemoryReservation: 50 << 20,
+				MemoryWmarkRatio:  &ratio,
+			},
+			expected: map[string]string{
+				"memory.max":      "104857600",
+				"memory.swap.max": "209715200",
+				"memory.low":      "52428800",
+				"memory.high":     "83886080",
+			},
+		},
+		{
+			// swap equal to memory disables swap, kernel memory and
+			// swappiness have no equivalent in cgroup v2.
+			resources: types.Resources{
+				Memory:           100 << 20,
+				MemorySwap:       100 << 20,
+				MemorySwappiness: &swappiness,
+				KernelMemory:     50 << 20,
+			},
+			expected: map[string]string{
+				"memory.max":      "104857600",
+				"memory.swap.max": "0",
+			},
+		},
+		{
+			resources: types.Resources{
+				MemorySwap:  -1,
+				BlkioWeight: 500,
+				PidsLimit:   -1,
+				HugepageLimits: []*types.HugepageLimit{
+					{PageSize: "2MB", Limit: 1 << 30},
+				},
+			},
+			expected: map[string]string{
+				"memory.swap.max": "max",
+				"io.weight":       "4950",
+				"pids.max":        "max",
+				"hugetlb.2MB.max": "1073741824",
+			},
+		},
+	} {
+		unified, err := ToUnifiedResources(tc.resources)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, unified)
+	}
+}
+
+func TestParseUnifiedCgroup(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-unified-cgroup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	file := filepath.Join(tmpDir, "cgroup")
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("0::/default/abc\n"), 0644))
+	path, err := parseUnifiedCgroup(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "/default/abc", path)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("4:memory:/default/abc\n1:name=systemd:/\n"), 0644))
+	_, err = parseUnifiedCgroup(file)
+	assert.Error(t, err)
+}
+
+func TestSetUnifiedResources(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-unified-resources")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	for _, f := range []string{"cpu.max", "io.max"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, f), nil, 0644))
+	}
+
+	assert.NoError(t, setUnifiedResources(tmpDir, map[string]string{
+		"cpu.max": "max 100000",
+		"io.max":  "8:0 rbps=1024\n8:16 wiops=100",
+	}))
+
+	data, err := ioutil.ReadFile(filepath.Join(tmpDir, "cpu.max"))
+	assert.NoError(t, err)
+	assert.Equal(t, "max 100000", string(data))
+
+	// the lines are written one by one.
+	data, err = ioutil.ReadFile(filepath.Join(tmpDir, "io.max"))
+	assert.NoError(t, err)
+	assert.Equal(t, "8:16 wiops=100", string(data))
+
+	assert.Error(t, setUnifiedResources(tmpDir, map[string]string{"pids.max": "max"}))
+}
@@ -0,0 +1,66 @@
+package ctrd
+
+import (
+	"github.com/containerd/cgroups"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// updateCgroupLimits sets the blkio throttles and hugepage limits into the
+// cgroup of container directly, since the runtime ignores them when updating
+// a running container.
+func updateCgroupLimits(pid uint32, r *specs.LinuxResources) error {
+	limits := &specs.LinuxResources{
+		HugepageLimits: r.HugepageLimits,
+	}
+	if r.BlockIO != nil {
+		limits.BlockIO = &specs.LinuxBlockIO{
+			ThrottleReadBpsDevice:   r.BlockIO.ThrottleReadBpsDevice,
+			ThrottleReadIOPSDevice:  r.BlockIO.ThrottleReadIOPSDevice,
+			ThrottleWriteBpsDevice:  r.BlockIO.ThrottleWriteBpsDevice,
+			ThrottleWriteIOPSDevice: r.BlockIO.ThrottleWriteIOPSDevice,
+		}
+	}
+
+	subsystems, err := cgroups.V1()
+	if err != nil {
+		return err
+	}
+
+	path := cgroups.PidPath(int(pid))
+	for _, s := range subsystems {
+		if s.Name() != cgroups.Blkio && s.Name() != cgroups.Hugetlb {
+			continue
+		}
+
+		// the hugetlb controller can't be updated, but creating the existing
+		// cgroup writes the limits too.
+		c, ok := s.(interface {
+			Create(path string, resources *specs.LinuxResources) error
+		})
+		if !ok {
+			continue
+		}
+
+		p, err := path(s.Name())
+		if err != nil {
+			return errors.Wrapf(err, "failed to get %s cgroup of container", s.Name())
+		}
+		if err := c.Create(p, limits); err != nil {
+			return errors.Wrapf(err, "failed to update %s cgroup of container", s.Name())
+		}
+	}
+	return nil
+}
+
+// hasCgroupLimits returns whether there are limits should be set by
+// updateCgroupLimits.
+func hasCgroupLimits(r *specs.LinuxResources) bool {
+	if len(r.HugepageLimits) != 0 {
+		return true
+	}
+	return r.BlockIO != nil && (len(r.BlockIO.ThrottleReadBpsDevice) != 0 ||
+		len(r.BlockIO.ThrottleReadIOPSDevice) != 0 ||
+		len(r.BlockIO.ThrottleWriteBpsDevice) != 0 ||
+		len(r.BlockIO.ThrottleWriteIOPSDevice) != 0)
+}
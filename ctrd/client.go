@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/nri"
 	"github.com/alibaba/pouch/pkg/scheduler"
 	"github.com/alibaba/pouch/pkg/utils"
 
@@ -20,12 +21,14 @@ import (
 	"github.com/containerd/containerd/snapshots"
 	"github.com/containerd/typeurl"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
 )
 
 const (
 	unixSocketPath                = "/run/containerd/containerd.sock"
 	defaultGrpcClientPoolCapacity = 5
 	defaultMaxStreamsClient       = 100
+	defaultHealthCheckInterval    = 10 * time.Second
 	// PluginStatusOk means plugin status is ok
 	PluginStatusOk = "ok"
 	// PluginStatusError means plugin status is error
@@ -46,6 +49,18 @@ type Client struct {
 
 	// insecureRegistries stores the insecure registries
 	insecureRegistries []string
+	insecureLock       sync.RWMutex
+
+	// cgroupVersion is the version of cgroup hierarchy on host
+	cgroupVersion string
+
+	// downloadSlots limits the concurrent layer downloads of daemon, nil
+	// means no limit.
+	downloadSlots *semaphore.Weighted
+
+	// downloadBandwidth is the bandwidth limit of each image pull in bytes
+	// per second, 0 means no limit.
+	downloadBandwidth int64
 
 	// containerd grpc pool
 	pool      []scheduler.Factory
@@ -55,6 +70,14 @@ type Client struct {
 
 	// eventsHooks specified methods that handle containerd events
 	eventsHooks []func(context.Context, string, string, map[string]string) error
+
+	// rpcAddr and defaultns are used to re-establish the lease when
+	// containerd restarts.
+	rpcAddr   string
+	defaultns string
+
+	// nri invokes the NRI plugins, nil if NRI is disabled.
+	nri *nri.Client
 }
 
 // Plugin is the containerd plugin type
@@ -72,6 +95,7 @@ func NewClient(opts ...ClientOpt) (APIClient, error) {
 		grpcClientPoolCapacity: defaultGrpcClientPoolCapacity,
 		maxStreamsClient:       defaultMaxStreamsClient,
 		insecureRegistries:     []string{},
+		healthCheckInterval:    defaultHealthCheckInterval,
 	}
 
 	for _, opt := range opts {
@@ -81,13 +105,20 @@ func NewClient(opts ...ClientOpt) (APIClient, error) {
 	}
 
 	client := &Client{
-		lock: &containerLock{
-			ids: make(map[string]struct{}),
-		},
+		lock: newContainerLock(copts.lockMode, copts.lockTimeouts),
 		watch: &watch{
 			containers: make(map[string]*containerPack),
+			nri:        copts.nri,
 		},
 		insecureRegistries: copts.insecureRegistries,
+		cgroupVersion:      copts.cgroupVersion,
+		downloadBandwidth:  copts.downloadBandwidth,
+		rpcAddr:            copts.rpcAddr,
+		defaultns:          copts.defaultns,
+		nri:                copts.nri,
+	}
+	if copts.maxConcurrentDownloads > 0 {
+		client.downloadSlots = semaphore.NewWeighted(int64(copts.maxConcurrentDownloads))
 	}
 
 	lease, err := client.preparePouchdLease(copts.rpcAddr, copts.defaultns)
@@ -105,14 +136,21 @@ func NewClient(opts ...ClientOpt) (APIClient, error) {
 
 	log.With(nil).Infof("success to create %d containerd clients, connect to: %s", copts.grpcClientPoolCapacity, copts.rpcAddr)
 
-	scheduler, err := scheduler.NewLRUScheduler(client.pool)
+	// the containers are assigned to the clients in turn, so that their
+	// streams are spread over the connections.
+	scheduler, err := scheduler.NewRoundRobinScheduler(client.pool)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create clients pool scheduler")
 	}
 	client.scheduler = scheduler
 
-	// start collect containerd events
-	go client.collectContainerdEvents()
+	// start collect containerd events, and restore the watches when
+	// containerd restarts.
+	go client.supervise()
+
+	if copts.healthCheckInterval > 0 {
+		go client.checkHealth(copts.healthCheckInterval)
+	}
 
 	return client, nil
 }
@@ -143,6 +181,19 @@ func (c *Client) Get(ctx context.Context) (*WrapperClient, error) {
 	return wrapperCli, nil
 }
 
+// SetInsecureRegistries replaces the insecure registries.
+func (c *Client) SetInsecureRegistries(endpoints []string) error {
+	registries, err := parseInsecureRegistries(endpoints)
+	if err != nil {
+		return err
+	}
+
+	c.insecureLock.Lock()
+	c.insecureRegistries = registries
+	c.insecureLock.Unlock()
+	return nil
+}
+
 // SetExitHooks specified the handlers of container exit.
 func (c *Client) SetExitHooks(hooks ...func(string, *Message, func() error) error) {
 	c.watch.hooks = hooks
@@ -245,15 +296,15 @@ func (c *Client) Plugins(ctx context.Context, filters []string) ([]Plugin, error
 	return plugins, nil
 }
 
-// collectContainerdEvents collects events generated by containerd.
-func (c *Client) collectContainerdEvents() {
+// collectContainerdEvents collects events generated by containerd, it
+// returns when the events stream breaks.
+func (c *Client) collectContainerdEvents() error {
 	ctx := context.Background()
 
 	// get client
 	wrapperCli, err := c.Get(ctx)
 	if err != nil {
-		log.With(nil).Errorf("failed to get a containerd grpc client: %v", err)
-		return
+		return errors.Wrap(err, "failed to get a containerd grpc client")
 	}
 	eventsClient := wrapperCli.client.EventService()
 
@@ -265,15 +316,14 @@ func (c *Client) collectContainerdEvents() {
 	eventCh, errCh := eventsClient.Subscribe(ctx, ef...)
 
 	for {
-		// TODO(ziren):need reconnect the event service
 		var e *events.Envelope
 		select {
 		case e = <-eventCh:
 		case err := <-errCh:
-			if err != nil {
-				log.With(nil).Errorf("failed to receive event: %v", err)
+			if err == nil {
+				err = fmt.Errorf("events stream is closed")
 			}
-			return
+			return errors.Wrap(err, "failed to receive event")
 		}
 
 		if !utils.StringInSlice(topicsToHandle, e.Topic) || e.Event == nil {
@@ -321,11 +371,17 @@ func (c *Client) collectContainerdEvents() {
 		}
 
 		// handles the event
-		for _, hook := range c.eventsHooks {
-			if err := hook(ctx, containerID, action, attributes); err != nil {
-				log.With(nil).Errorf("failed to execute the containerd events hooks: %v", err)
-				break
-			}
+		c.runEventsHooks(ctx, containerID, action, attributes)
+	}
+}
+
+// runEventsHooks executes the events hooks in order, it stops at the first
+// failed hook.
+func (c *Client) runEventsHooks(ctx context.Context, containerID, action string, attributes map[string]string) {
+	for _, hook := range c.eventsHooks {
+		if err := hook(ctx, containerID, action, attributes); err != nil {
+			log.With(nil).Errorf("failed to execute the containerd events hooks: %v", err)
+			break
 		}
 	}
 }
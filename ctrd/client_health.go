@@ -0,0 +1,177 @@
+package ctrd
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+// checkHealth checks the health of containerd clients in pool periodically
+// until the client is closed.
+func (c *Client) checkHealth(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		c.mu.RLock()
+		pool := c.pool
+		c.mu.RUnlock()
+
+		if pool == nil {
+			return
+		}
+
+		// the clients are reset when containerd restarts.
+		if c.watch.isContainerdDead() {
+			continue
+		}
+
+		unhealthy := 0
+		for _, f := range pool {
+			wrapperCli, ok := f.(*WrapperClient)
+			if !ok {
+				continue
+			}
+			if !c.checkClient(wrapperCli, interval) {
+				unhealthy++
+			}
+		}
+		unhealthyClientsGauge.WithLabelValues().Set(float64(unhealthy))
+	}
+}
+
+// checkClient checks the health of client, the containers watched by the
+// unhealthy client are rebound to the healthy ones, then it reconnects to
+// containerd.
+func (c *Client) checkClient(wrapperCli *WrapperClient, timeout time.Duration) bool {
+	ctx := context.Background()
+
+	err := wrapperCli.checkHealth(ctx, timeout)
+	if err == nil {
+		if wrapperCli.setHealthy(true) {
+			log.With(ctx).Infof("containerd client recovers")
+
+			// the task streams of the containers failed to rebind have
+			// been closed by reconnection, watch them again.
+			if failed := c.rebindContainers(ctx, wrapperCli); failed > 0 {
+				log.With(ctx).Warnf("failed to rebind %d containers of recovered containerd client", failed)
+			}
+		}
+		return true
+	}
+
+	if wrapperCli.setHealthy(false) {
+		log.With(ctx).Warnf("containerd client is unhealthy: %v", err)
+	}
+
+	if failed := c.rebindContainers(ctx, wrapperCli); failed > 0 {
+		log.With(ctx).Warnf("failed to rebind %d containers of unhealthy containerd client", failed)
+	}
+
+	if err := wrapperCli.client.Reconnect(); err != nil {
+		log.With(ctx).Warnf("failed to reconnect containerd client: %v", err)
+	}
+	return false
+}
+
+// rebindContainers rebinds the containers watched by the client to the
+// healthy clients, and returns the number of containers failed to rebind.
+func (c *Client) rebindContainers(ctx context.Context, wrapperCli *WrapperClient) int {
+	c.watch.Lock()
+	var ids []string
+	for id, pack := range c.watch.containers {
+		pack.l.RLock()
+		if pack.client == wrapperCli {
+			ids = append(ids, id)
+		}
+		pack.l.RUnlock()
+	}
+	c.watch.Unlock()
+
+	failed := 0
+	for _, id := range ids {
+		if err := c.rebindContainer(ctx, id, wrapperCli); err != nil {
+			log.With(ctx).Warnf("failed to rebind container %s: %v", id, err)
+			rebindCounter.WithLabelValues(rebindResultFailure).Inc()
+			failed++
+			continue
+		}
+		rebindCounter.WithLabelValues(rebindResultSuccess).Inc()
+	}
+	return failed
+}
+
+// rebindContainer loads the task of container by a healthy client, and
+// watches its exit on the new connection.
+func (c *Client) rebindContainer(ctx context.Context, id string, old *WrapperClient) error {
+	if err := c.lock.Lock(ctx, lockOpRebind, id); err != nil {
+		return err
+	}
+	defer c.lock.Unlock(id)
+
+	pack, err := c.watch.get(id)
+	if err != nil {
+		// the container has been removed.
+		return nil
+	}
+
+	pack.l.RLock()
+	bound := pack.client == old
+	pack.l.RUnlock()
+	if !bound {
+		return nil
+	}
+
+	if err := c.rewatchContainer(ctx, pack); err != nil {
+		return err
+	}
+
+	log.With(ctx).Infof("success to rebind container %s to another containerd client", id)
+	return nil
+}
+
+// rewatchContainer loads the task of container by a healthy client, and
+// watches its exit on the new connection. The caller should hold the
+// container lock.
+func (c *Client) rewatchContainer(ctx context.Context, pack *containerPack) error {
+	// the unhealthy client is not scheduled, and the recovered one may
+	// be chosen again.
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get a healthy containerd grpc client")
+	}
+
+	lc, err := wrapperCli.client.LoadContainer(ctx, pack.id)
+	if err != nil {
+		return errors.Wrap(err, "failed to load container")
+	}
+
+	// the io of task has been attached when it's created or recovered.
+	task, err := lc.Task(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get task")
+	}
+
+	statusCh, err := task.Wait(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to wait task")
+	}
+
+	pack.l.Lock()
+	old := pack.client
+	pack.container = lc
+	pack.task = task
+	pack.sch = statusCh
+	pack.client = wrapperCli
+	pack.l.Unlock()
+
+	// TODO: Consume may occurred an error like watch.add.
+	_ = wrapperCli.Consume(1)
+	old.Produce(1)
+
+	c.watch.watchExit(ctx, pack, statusCh)
+	return nil
+}
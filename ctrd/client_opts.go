@@ -5,6 +5,9 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/nri"
 )
 
 type clientOpts struct {
@@ -13,6 +16,13 @@ type clientOpts struct {
 	maxStreamsClient       int
 	defaultns              string
 	insecureRegistries     []string
+	cgroupVersion          string
+	maxConcurrentDownloads int
+	downloadBandwidth      int64
+	lockMode               string
+	lockTimeouts           map[string]time.Duration
+	healthCheckInterval    time.Duration
+	nri                    *nri.Client
 }
 
 // ClientOpt allows caller to set options for containerd client.
@@ -55,6 +65,20 @@ func WithMaxStreamsClient(maxStreamsClient int) ClientOpt {
 	}
 }
 
+// WithHealthCheckInterval sets the interval to check the health of
+// containerd clients, the containers watched by the unhealthy client are
+// rebound to the healthy ones, 0 means disabled.
+func WithHealthCheckInterval(interval time.Duration) ClientOpt {
+	return func(c *clientOpts) error {
+		if interval < 0 {
+			return fmt.Errorf("containerd health check interval %s cannot be negative", interval)
+		}
+
+		c.healthCheckInterval = interval
+		return nil
+	}
+}
+
 // WithDefaultNamespace sets the default namespace on the client
 //
 // Any operation that does not have a namespace set on the context will
@@ -70,23 +94,101 @@ func WithDefaultNamespace(ns string) ClientOpt {
 // and skip secure verify.
 func WithInsecureRegistries(endpoints []string) ClientOpt {
 	return func(c *clientOpts) error {
-		registries := make([]string, 0, len(endpoints))
+		registries, err := parseInsecureRegistries(endpoints)
+		if err != nil {
+			return err
+		}
+		c.insecureRegistries = registries
+		return nil
+	}
+}
 
-		for _, r := range endpoints {
-			if strings.Contains(strings.ToLower(r), "://") {
-				return fmt.Errorf("insecure registry %s should not contain any '://'", r)
-			}
+// WithCgroupVersion sets the version of cgroup hierarchy on host, the
+// resources are written into cgroup directly in unified hierarchy.
+func WithCgroupVersion(version string) ClientOpt {
+	return func(c *clientOpts) error {
+		c.cgroupVersion = version
+		return nil
+	}
+}
 
-			if err := validateHostPort(r); err != nil {
-				return err
-			}
-			registries = append(registries, r)
+// WithMaxConcurrentDownloads sets the max number of concurrent layer
+// downloads of daemon, 0 means no limit.
+func WithMaxConcurrentDownloads(n int) ClientOpt {
+	return func(c *clientOpts) error {
+		if n < 0 {
+			return fmt.Errorf("max concurrent downloads %d cannot be negative", n)
 		}
-		c.insecureRegistries = registries
+		c.maxConcurrentDownloads = n
+		return nil
+	}
+}
+
+// WithDownloadBandwidth sets the bandwidth limit of each image pull in bytes
+// per second, 0 means no limit.
+func WithDownloadBandwidth(bandwidth int64) ClientOpt {
+	return func(c *clientOpts) error {
+		if bandwidth < 0 {
+			return fmt.Errorf("download bandwidth %d cannot be negative", bandwidth)
+		}
+		c.downloadBandwidth = bandwidth
+		return nil
+	}
+}
+
+// WithLockMode sets the mode of container lock, fair or unfair, the lock
+// is fair by default.
+func WithLockMode(mode string) ClientOpt {
+	return func(c *clientOpts) error {
+		switch mode {
+		case "", LockModeFair, LockModeUnfair:
+		default:
+			return fmt.Errorf("invalid container lock mode %s, should be %s or %s", mode, LockModeFair, LockModeUnfair)
+		}
+		c.lockMode = mode
+		return nil
+	}
+}
+
+// WithLockTimeouts sets the timeouts to acquire the container lock keyed
+// by the operations, the timeout of key "default" is used by the operations
+// without timeout, 0 means no timeout. The timeouts only apply to the
+// requests without deadline.
+func WithLockTimeouts(timeouts map[string]time.Duration) ClientOpt {
+	return func(c *clientOpts) error {
+		if err := validateLockTimeouts(timeouts); err != nil {
+			return err
+		}
+		c.lockTimeouts = timeouts
 		return nil
 	}
 }
 
+// WithNRI sets the client invoking the NRI plugins at the lifecycle events
+// of containers, NRI is disabled if it is nil.
+func WithNRI(client *nri.Client) ClientOpt {
+	return func(c *clientOpts) error {
+		c.nri = client
+		return nil
+	}
+}
+
+func parseInsecureRegistries(endpoints []string) ([]string, error) {
+	registries := make([]string, 0, len(endpoints))
+
+	for _, r := range endpoints {
+		if strings.Contains(strings.ToLower(r), "://") {
+			return nil, fmt.Errorf("insecure registry %s should not contain any '://'", r)
+		}
+
+		if err := validateHostPort(r); err != nil {
+			return nil, err
+		}
+		registries = append(registries, r)
+	}
+	return registries, nil
+}
+
 func validateHostPort(s string) error {
 	_, port, err := net.SplitHostPort(s)
 	if err != nil {
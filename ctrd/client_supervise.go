@@ -0,0 +1,156 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+var (
+	// reconnectInterval is the interval to check whether containerd serves
+	// again after the connection is lost.
+	reconnectInterval = time.Second
+
+	// maxRestoreRetries is the max times to restore the watches after
+	// containerd restarts.
+	maxRestoreRetries = 3
+)
+
+// supervise collects the containerd events until the events stream breaks,
+// which means containerd restarts or the connection is lost. Then it waits
+// for containerd to serve again, re-establishes the lease, watches the exits
+// of containers again, and resubscribes the events.
+func (c *Client) supervise() {
+	ctx := context.Background()
+
+	for {
+		err := c.collectContainerdEvents()
+		if c.closed() || c.watch.isContainerdDead() {
+			return
+		}
+		log.With(ctx).Warnf("lost connection to containerd: %v", err)
+
+		if !c.waitForContainerd(ctx) {
+			return
+		}
+
+		for i := 0; i < maxRestoreRetries; i++ {
+			if err = c.restore(ctx); err == nil {
+				break
+			}
+			time.Sleep(reconnectInterval)
+		}
+		if err != nil {
+			log.With(ctx).Errorf("failed to restore after containerd restarts: %v", err)
+			restoreCounter.WithLabelValues(restoreResultFailure).Inc()
+			continue
+		}
+		restoreCounter.WithLabelValues(restoreResultSuccess).Inc()
+		log.With(ctx).Infof("success to restore after containerd restarts")
+	}
+}
+
+// closed returns whether the client has been closed.
+func (c *Client) closed() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.pool == nil
+}
+
+// waitForContainerd waits until containerd serves on any connection, it
+// returns false if the client is closed.
+func (c *Client) waitForContainerd(ctx context.Context) bool {
+	ticker := time.NewTicker(reconnectInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		c.mu.RLock()
+		pool := c.pool
+		c.mu.RUnlock()
+
+		if pool == nil || c.watch.isContainerdDead() {
+			return false
+		}
+
+		for _, f := range pool {
+			wrapperCli, ok := f.(*WrapperClient)
+			if !ok {
+				continue
+			}
+			if err := wrapperCli.checkHealth(ctx, reconnectInterval); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// restore re-establishes the lease of pouchd, and watches the exits of
+// containers on the new streams, the containers whose tasks are lost during
+// restart are regarded as exited.
+func (c *Client) restore(ctx context.Context) error {
+	if _, err := c.preparePouchdLease(c.rpcAddr, c.defaultns); err != nil {
+		return errors.Wrap(err, "failed to prepare the lease for pouchd")
+	}
+
+	c.watch.Lock()
+	ids := make([]string, 0, len(c.watch.containers))
+	for id := range c.watch.containers {
+		ids = append(ids, id)
+	}
+	c.watch.Unlock()
+
+	failed := 0
+	for _, id := range ids {
+		if err := c.restoreContainer(ctx, id); err != nil {
+			log.With(ctx).Warnf("failed to watch container %s again: %v", id, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to watch %d containers again", failed)
+	}
+	return nil
+}
+
+// restoreContainer watches the exit of container again.
+func (c *Client) restoreContainer(ctx context.Context, id string) error {
+	if err := c.lock.Lock(ctx, lockOpRebind, id); err != nil {
+		return err
+	}
+	defer c.lock.Unlock(id)
+
+	pack, err := c.watch.get(id)
+	if err != nil {
+		// the container has been removed.
+		return nil
+	}
+
+	err = c.rewatchContainer(ctx, pack)
+	if err == nil || !errdefs.IsNotFound(err) {
+		return err
+	}
+
+	// the task exits while containerd is down, and the exit status is lost.
+	pack.l.Lock()
+	client := pack.client
+	pack.sch = nil
+	pack.l.Unlock()
+
+	client.Produce(1)
+
+	log.With(ctx).Warnf("the task of container %s is lost after containerd restarts", id)
+	go c.watch.exit(ctx, pack, &Message{
+		err:      fmt.Errorf("task is lost after containerd restarts"),
+		exitCode: 255,
+		exitTime: time.Now(),
+	})
+	return nil
+}
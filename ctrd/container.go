@@ -16,6 +16,8 @@ import (
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/ioutils"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/nri"
+	"github.com/alibaba/pouch/pkg/system"
 	"github.com/sirupsen/logrus"
 
 	"github.com/containerd/containerd"
@@ -27,7 +29,11 @@ import (
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/leases"
 	"github.com/containerd/containerd/oci"
+	"github.com/containerd/containerd/runtime/linux/runctypes"
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
+	"github.com/containerd/typeurl"
 	imagespec "github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 )
 
@@ -42,6 +48,10 @@ var (
 	RuntimeTypeV2kataV2 = "io.containerd.kata.v2"
 	// RuntimeTypeV2runcV1 is the runtime type name for runc containerd shim implement the shim v2 api.
 	RuntimeTypeV2runcV1 = "io.containerd.runc.v1"
+	// RuntimeTypeV2wasmedgeV1 is the runtime type name for WasmEdge containerd shim implement the shim v2 api.
+	RuntimeTypeV2wasmedgeV1 = "io.containerd.wasmedge.v1"
+	// RuntimeTypeV2wasmtimeV1 is the runtime type name for wasmtime containerd shim implement the shim v2 api.
+	RuntimeTypeV2wasmtimeV1 = "io.containerd.wasmtime.v1"
 
 	// cleanupTimeout is used to clean up the container/task meta data in containerd.
 	cleanupTimeout = 100 * time.Second
@@ -58,6 +68,11 @@ type containerPack struct {
 	client        *WrapperClient
 	skipStopHooks bool
 	l             sync.RWMutex
+
+	// execLock serializes the start of exec processes, since the exec
+	// process with resources is found by its cgroup when it starts, it's
+	// held by startExec.
+	execLock sync.Mutex
 }
 
 // ContainerStats returns stats of the container.
@@ -71,10 +86,10 @@ func (c *Client) ContainerStats(ctx context.Context, id string) (*containerdtype
 
 // containerStats returns stats of the container.
 func (c *Client) containerStats(ctx context.Context, id string) (*containerdtypes.Metric, error) {
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return nil, errtypes.ErrLockfailed
+	if err := c.lock.RLock(ctx, lockOpStats, id); err != nil {
+		return nil, err
 	}
-	defer c.lock.Unlock(id)
+	defer c.lock.RUnlock(id)
 
 	pack, err := c.watch.get(id)
 	if err != nil {
@@ -104,6 +119,12 @@ func (c *Client) execContainer(ctx context.Context, process *Process, timeout in
 		return err
 	}
 
+	// the exec process is started in a sub cgroup of container, which
+	// conflicts with the processes of container in unified hierarchy.
+	if process.Resources != nil && c.cgroupVersion == system.CgroupV2 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "resources of exec process are not supported in cgroup v2")
+	}
+
 	closeStdinCh := make(chan struct{})
 
 	var (
@@ -150,7 +171,8 @@ func (c *Client) execContainer(ctx context.Context, process *Process, timeout in
 		}
 	}
 	// start the exec process
-	if err := execProcess.Start(ctx); err != nil {
+	cg, started, err := pack.startExec(ctx, execProcess, execID, process.Resources)
+	if !started {
 		close(closeStdinCh)
 
 		// delete exec process in containerd to cleanup pipe fd
@@ -162,6 +184,31 @@ func (c *Client) execContainer(ctx context.Context, process *Process, timeout in
 	// make sure the closeStdinCh has been closed.
 	close(closeStdinCh)
 
+	if cg != nil {
+		// the sub cgroup is removed after the exec process exits.
+		processCleanup := cleanup
+		cleanup = func(msg *Message) {
+			processCleanup(msg)
+			if err := cg.Delete(); err != nil {
+				log.With(ctx).Warnf("failed to delete cgroup of exec process %s: %s", execID, err)
+			}
+		}
+	}
+
+	if err != nil {
+		// the exec process must not run without the limits.
+		if kerr := execProcess.Kill(context.TODO(), syscall.SIGKILL); kerr != nil {
+			log.With(ctx).Warnf("failed to kill exec process %s: %s", execID, kerr)
+		}
+		status := <-exitStatus
+		cleanup(&Message{
+			err:      status.Error(),
+			exitCode: status.ExitCode(),
+			exitTime: status.ExitTime(),
+		})
+		return errors.Wrapf(err, "failed to limit resources of exec, exec id %s", execID)
+	}
+
 	if process.Detach {
 		go func() {
 			status := <-exitStatus
@@ -247,21 +294,21 @@ func (c *Client) containerPID(ctx context.Context, id string) (int, error) {
 	return int(pack.task.Pid()), nil
 }
 
-// ContainerPIDs returns the all processes's ids inside the container.
-func (c *Client) ContainerPIDs(ctx context.Context, id string) ([]int, error) {
-	pids, err := c.containerPIDs(ctx, id)
+// ContainerProcesses returns the all processes inside the container.
+func (c *Client) ContainerProcesses(ctx context.Context, id string) ([]ProcessInfo, error) {
+	processes, err := c.containerProcesses(ctx, id)
 	if err != nil {
-		return pids, convertCtrdErr(err)
+		return processes, convertCtrdErr(err)
 	}
-	return pids, nil
+	return processes, nil
 }
 
-// containerPIDs returns the all processes's ids inside the container.
-func (c *Client) containerPIDs(ctx context.Context, id string) ([]int, error) {
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return nil, errtypes.ErrLockfailed
+// containerProcesses returns the all processes inside the container.
+func (c *Client) containerProcesses(ctx context.Context, id string) ([]ProcessInfo, error) {
+	if err := c.lock.RLock(ctx, lockOpProcesses, id); err != nil {
+		return nil, err
 	}
-	defer c.lock.Unlock(id)
+	defer c.lock.RUnlock(id)
 
 	pack, err := c.watch.get(id)
 	if err != nil {
@@ -273,14 +320,37 @@ func (c *Client) containerPIDs(ctx context.Context, id string) ([]int, error) {
 		return nil, errors.Wrap(err, "failed to get task's pids")
 	}
 
-	// convert []uint32 to []int.
-	list := make([]int, 0, len(processes))
+	list := make([]ProcessInfo, 0, len(processes))
 	for _, ps := range processes {
-		list = append(list, int(ps.Pid))
+		list = append(list, ProcessInfo{
+			Pid:    int(ps.Pid),
+			ExecID: processExecID(ps),
+		})
 	}
 	return list, nil
 }
 
+// processExecID returns the exec id in the details of process, which are
+// only provided by the runc shims.
+func processExecID(ps containerd.ProcessInfo) string {
+	if ps.Info == nil {
+		return ""
+	}
+
+	details, err := typeurl.UnmarshalAny(ps.Info)
+	if err != nil {
+		return ""
+	}
+
+	switch d := details.(type) {
+	case *runctypes.ProcessDetails:
+		return d.ExecID
+	case *runcoptions.ProcessDetails:
+		return d.ExecID
+	}
+	return ""
+}
+
 // ProbeContainer probe the container's status, if timeout <= 0, will block to receive message.
 func (c *Client) ProbeContainer(ctx context.Context, id string, timeout time.Duration) *Message {
 	ch := c.watch.notify(id)
@@ -317,8 +387,8 @@ func (c *Client) recoverContainer(ctx context.Context, id string, io *containeri
 		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
 	}
 
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpRecover, id); err != nil {
+		return err
 	}
 	defer c.lock.Unlock(id)
 
@@ -381,14 +451,16 @@ func (c *Client) recoverContainer(ctx context.Context, id string, io *containeri
 		return errors.Wrap(err, "failed to wait task")
 	}
 
-	c.watch.add(ctx, &containerPack{
+	pack := &containerPack{
 		id:        id,
 		container: lc,
 		task:      task,
 		ch:        make(chan *Message, 1),
 		client:    wrapperCli,
 		sch:       statusCh,
-	})
+	}
+	c.watch.add(ctx, pack)
+	c.watchMemoryPressure(ctx, pack)
 
 	log.With(ctx).Infof("success to recover container")
 	return nil
@@ -415,8 +487,8 @@ func (c *Client) destroyContainer(ctx context.Context, id string, timeout int64)
 
 	ctx = leases.WithLease(ctx, wrapperCli.lease.ID)
 
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return nil, errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpDestroy, id); err != nil {
+		return nil, err
 	}
 	defer c.lock.Unlock(id)
 
@@ -503,8 +575,8 @@ func (c *Client) PauseContainer(ctx context.Context, id string) error {
 
 // pauseContainer pause container.
 func (c *Client) pauseContainer(ctx context.Context, id string) error {
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpPause, id); err != nil {
+		return err
 	}
 	defer c.lock.Unlock(id)
 
@@ -518,6 +590,7 @@ func (c *Client) pauseContainer(ctx context.Context, id string) error {
 			return errors.Wrap(err, "failed to pause task")
 		}
 	}
+	notifyNRI(ctx, c.nri, pack, nri.Pause, nil)
 
 	log.With(ctx).Infof("success to pause container")
 
@@ -534,8 +607,8 @@ func (c *Client) UnpauseContainer(ctx context.Context, id string) error {
 
 // unpauseContainer unpauses a container.
 func (c *Client) unpauseContainer(ctx context.Context, id string) error {
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpUnpause, id); err != nil {
+		return err
 	}
 	defer c.lock.Unlock(id)
 
@@ -549,36 +622,73 @@ func (c *Client) unpauseContainer(ctx context.Context, id string) error {
 			return errors.Wrap(err, "failed to resume task")
 		}
 	}
+	notifyNRI(ctx, c.nri, pack, nri.Resume, nil)
 
 	log.With(ctx).Infof("success to unpause container")
 
 	return nil
 }
 
+// KillContainer sends the signal to the init process of container.
+func (c *Client) KillContainer(ctx context.Context, id string, signal syscall.Signal) error {
+	if err := c.killContainer(ctx, id, signal); err != nil {
+		return convertCtrdErr(err)
+	}
+	return nil
+}
+
+// killContainer sends the signal to the init process of container.
+func (c *Client) killContainer(ctx context.Context, id string, signal syscall.Signal) error {
+	if err := c.lock.Lock(ctx, lockOpKill, id); err != nil {
+		return err
+	}
+	defer c.lock.Unlock(id)
+
+	pack, err := c.watch.get(id)
+	if err != nil {
+		return err
+	}
+
+	if err := pack.task.Kill(ctx, signal); err != nil {
+		return errors.Wrapf(err, "failed to send signal %d to task", signal)
+	}
+
+	log.With(ctx).Infof("success to send signal %d to container", signal)
+
+	return nil
+}
+
 // CreateContainer create container and start process.
-func (c *Client) CreateContainer(ctx context.Context, container *Container, checkpointDir string) error {
+func (c *Client) CreateContainer(ctx context.Context, container *Container, restore *RestoreOptions) error {
 	var (
 		ref = container.Image
 		id  = container.ID
 	)
 
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpCreate, id); err != nil {
+		return err
 	}
 	defer c.lock.Unlock(id)
 
-	if err := c.createContainer(ctx, ref, id, checkpointDir, container); err != nil {
+	if err := c.createContainer(ctx, ref, id, restore, container); err != nil {
 		return convertCtrdErr(err)
 	}
 	return nil
 }
 
-func (c *Client) createContainer(ctx context.Context, ref, id, checkpointDir string, container *Container) (err0 error) {
+func (c *Client) createContainer(ctx context.Context, ref, id string, restore *RestoreOptions, container *Container) (err0 error) {
 	wrapperCli, err := c.Get(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
 	}
 
+	// create container
+	options := []containerd.NewContainerOpts{
+		containerd.WithSnapshotter(CurrentSnapshotterName(ctx)),
+		containerd.WithContainerLabels(container.Labels),
+		containerd.WithRuntime(container.RuntimeType, container.RuntimeOptions),
+	}
+
 	// if creating the container by specify rootfs, we no need use the image
 	if !container.RootFSProvided {
 		// get image
@@ -591,13 +701,11 @@ func (c *Client) createContainer(ctx context.Context, ref, id, checkpointDir str
 		}
 
 		log.With(ctx).Infof("success to get image %s", img.Name())
-	}
 
-	// create container
-	options := []containerd.NewContainerOpts{
-		containerd.WithSnapshotter(CurrentSnapshotterName(ctx)),
-		containerd.WithContainerLabels(container.Labels),
-		containerd.WithRuntime(container.RuntimeType, container.RuntimeOptions),
+		// the wasm shims load the wasm modules from the image of container.
+		if IsWasmRuntime(container.RuntimeType) {
+			options = append(options, containerd.WithImage(img))
+		}
 	}
 
 	rootFSPath := "rootfs"
@@ -637,7 +745,7 @@ func (c *Client) createContainer(ctx context.Context, ref, id, checkpointDir str
 	log.With(ctx).Infof("success to new container")
 
 	// create task
-	pack, err := c.createTask(ctx, id, checkpointDir, nc, container, wrapperCli.client)
+	pack, err := c.createTask(ctx, id, restore, nc, container, wrapperCli.client)
 	if err != nil {
 		return err
 	}
@@ -646,14 +754,15 @@ func (c *Client) createContainer(ctx context.Context, ref, id, checkpointDir str
 	pack.client = wrapperCli
 
 	c.watch.add(ctx, pack)
+	c.watchMemoryPressure(ctx, pack)
 
 	return nil
 }
 
-func (c *Client) createTask(ctx context.Context, id, checkpointDir string, container containerd.Container, cc *Container, client *containerd.Client) (p *containerPack, err0 error) {
+func (c *Client) createTask(ctx context.Context, id string, restore *RestoreOptions, container containerd.Container, cc *Container, client *containerd.Client) (p *containerPack, err0 error) {
 	var pack *containerPack
 
-	checkpoint, err := createCheckpointDescriptor(ctx, checkpointDir, client)
+	checkpoint, err := createCheckpointDescriptor(ctx, restore, client)
 	if err != nil {
 		return pack, errors.Wrapf(err, "failed to create checkpoint descriptor")
 	}
@@ -682,7 +791,7 @@ func (c *Client) createTask(ctx context.Context, id, checkpointDir string, conta
 			return nil, err
 		}
 		return c.createIO(fifoset, cntrID, execID, closeStdinCh, cc.IO.InitContainerIO)
-	}, withCheckpointOpt(checkpoint))
+	}, withCheckpointOpt(checkpoint), withRestoreOpts(restore))
 	close(closeStdinCh)
 
 	if err != nil {
@@ -707,6 +816,17 @@ func (c *Client) createTask(ctx context.Context, id, checkpointDir string, conta
 
 	log.With(ctx).Infof("success to create task(pid=%d)", task.Pid())
 
+	if c.cgroupVersion == system.CgroupV2 && cc.Resources != nil {
+		if err := applyUnifiedResources(task.Pid(), *cc.Resources); err != nil {
+			return pack, errors.Wrapf(err, "failed to set resources of container(%s)", id)
+		}
+	}
+
+	// the NRI plugins adjust the container before its process starts.
+	if err := invokeNRI(ctx, c.nri, container, task.Pid(), nri.Create, nil); err != nil {
+		return pack, errors.Wrapf(err, "failed to invoke NRI plugins for container(%s)", id)
+	}
+
 	// start task
 	if err := task.Start(ctx); err != nil {
 		return pack, errors.Wrapf(err, "failed to start task(%d) in container(%s)", task.Pid(), id)
@@ -735,8 +855,8 @@ func (c *Client) UpdateResources(ctx context.Context, id string, resources types
 
 // updateResources updates the configurations of a container.
 func (c *Client) updateResources(ctx context.Context, id string, resources types.Resources) error {
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpUpdate, id); err != nil {
+		return err
 	}
 	defer c.lock.Unlock(id)
 
@@ -750,7 +870,69 @@ func (c *Client) updateResources(ctx context.Context, id string, resources types
 		return err
 	}
 
-	return pack.task.Update(ctx, containerd.WithResources(r))
+	runtimeType, err := runtimeTypeOf(ctx, pack.container)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case runtimeType == RuntimeTypeV2kataV2:
+		// the shim of kata hotplugs cpus and memory to resize the guest VM,
+		// and applies the resources to the cgroups in guest.
+		if err := pack.task.Update(ctx, containerd.WithResources(r)); err != nil {
+			return errors.Wrapf(err, "failed to resize the guest VM of container %s", id)
+		}
+	case c.cgroupVersion == system.CgroupV2:
+		// the resources of runtime spec only describe cgroup v1 controllers.
+		err = applyUnifiedResources(pack.task.Pid(), resources)
+	default:
+		err = updateTaskResources(ctx, pack, r)
+	}
+	if err != nil {
+		return err
+	}
+
+	notifyNRI(ctx, c.nri, pack, nri.Update, r)
+	return nil
+}
+
+// updateTaskResources updates the cgroup v1 resources of task.
+func updateTaskResources(ctx context.Context, pack *containerPack, r *specs.LinuxResources) error {
+	if err := pack.task.Update(ctx, containerd.WithResources(r)); err != nil {
+		return err
+	}
+
+	if hasCgroupLimits(r) {
+		return updateCgroupLimits(pack.task.Pid(), r)
+	}
+	return nil
+}
+
+// UpdateContainerLabels updates the labels of containerd container, the
+// labels with empty value are removed, and the others are kept.
+func (c *Client) UpdateContainerLabels(ctx context.Context, id string, labels map[string]string) error {
+	if err := c.updateContainerLabels(ctx, id, labels); err != nil {
+		return convertCtrdErr(err)
+	}
+	return nil
+}
+
+// updateContainerLabels updates the labels of containerd container.
+func (c *Client) updateContainerLabels(ctx context.Context, id string, labels map[string]string) error {
+	if err := c.lock.Lock(ctx, lockOpUpdate, id); err != nil {
+		return err
+	}
+	defer c.lock.Unlock(id)
+
+	pack, err := c.watch.get(id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := pack.container.SetLabels(ctx, labels); err != nil {
+		return errors.Wrap(err, "failed to set labels of container")
+	}
+	return nil
 }
 
 // ResizeContainer changes the size of the TTY of the init process running
@@ -765,8 +947,8 @@ func (c *Client) ResizeContainer(ctx context.Context, id string, opts types.Resi
 // resizeContainer changes the size of the TTY of the init process running
 // in the container to the given height and width.
 func (c *Client) resizeContainer(ctx context.Context, id string, opts types.ResizeOptions) error {
-	if !c.lock.TrylockWithRetry(ctx, id) {
-		return errtypes.ErrLockfailed
+	if err := c.lock.Lock(ctx, lockOpResize, id); err != nil {
+		return err
 	}
 	defer c.lock.Unlock(id)
 
@@ -819,7 +1001,7 @@ func (c *Client) waitContainer(ctx context.Context, id string) (types.ContainerW
 }
 
 // CreateCheckpoint create a checkpoint from a running container
-func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error {
+func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir string, options *CheckpointOptions) error {
 	pack, err := c.watch.get(id)
 	if err != nil {
 		return err
@@ -831,11 +1013,7 @@ func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir
 	}
 	client := wrapperCli.client
 
-	var opts []containerd.CheckpointTaskOpts
-	if exit {
-		opts = append(opts, withExitShimV1CheckpointTaskOpts())
-	}
-	checkpoint, err := pack.task.Checkpoint(ctx, opts...)
+	checkpoint, err := pack.task.Checkpoint(ctx, withShimV1CheckpointTaskOpts(options))
 	if err != nil {
 		return fmt.Errorf("failed to checkpoint: %s", err)
 	}
@@ -904,10 +1082,11 @@ func writeContent(ctx context.Context, mediaType, ref string, r io.Reader, clien
 
 }
 
-func createCheckpointDescriptor(ctx context.Context, checkpointDir string, client *containerd.Client) (*containerdtypes.Descriptor, error) {
-	if checkpointDir == "" {
+func createCheckpointDescriptor(ctx context.Context, restore *RestoreOptions, client *containerd.Client) (*containerdtypes.Descriptor, error) {
+	if restore == nil || restore.CheckpointDir == "" {
 		return nil, nil
 	}
+	checkpointDir := restore.CheckpointDir
 
 	// create a checkpoint blob
 	tar := archive.Diff(ctx, "", checkpointDir)
@@ -929,6 +1108,21 @@ func withCheckpointOpt(checkpoint *containerdtypes.Descriptor) containerd.NewTas
 	}
 }
 
+// withRestoreOpts sets the runtime options used by shim to restore the task
+// from checkpoint.
+func withRestoreOpts(restore *RestoreOptions) containerd.NewTaskOpts {
+	return func(_ context.Context, _ *containerd.Client, t *containerd.TaskInfo) error {
+		if restore == nil || restore.CheckpointDir == "" {
+			return nil
+		}
+
+		t.Options = &runctypes.CreateOptions{
+			OpenTcp: restore.TCPEstablished,
+		}
+		return nil
+	}
+}
+
 // InitStdio allows caller to handle any initialize job.
 type InitStdio func(dio *cio.DirectIO) (cio.IO, error)
 
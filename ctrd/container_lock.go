@@ -2,53 +2,338 @@ package ctrd
 
 import (
 	"context"
-	"math/rand"
+	"fmt"
 	"sync"
 	"time"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// LockModeFair hands the released container lock over to the waiters
+	// in FIFO order, so that no one is starved.
+	LockModeFair = "fair"
+
+	// LockModeUnfair allows the new callers to take the released container
+	// lock before the waiters, it has better throughput under contention,
+	// but the waiters may be starved.
+	LockModeUnfair = "unfair"
+
+	// LockOperationDefault is the key of the timeout of the operations
+	// whose timeouts are not set.
+	LockOperationDefault = "default"
 )
 
-// containerLock use to make sure that only one operates the container at the same time.
+// the operations holding the container lock.
+const (
+	lockOpCreate    = "create"
+	lockOpDestroy   = "destroy"
+	lockOpKill      = "kill"
+	lockOpPause     = "pause"
+	lockOpUnpause   = "unpause"
+	lockOpRecover   = "recover"
+	lockOpUpdate    = "update"
+	lockOpResize    = "resize"
+	lockOpStats     = "stats"
+	lockOpProcesses = "processes"
+	lockOpRebind    = "rebind"
+)
+
+// lockOperations is the set of operations whose timeouts can be set.
+var lockOperations = map[string]bool{
+	LockOperationDefault: true,
+	lockOpCreate:         true,
+	lockOpDestroy:        true,
+	lockOpKill:           true,
+	lockOpPause:          true,
+	lockOpUnpause:        true,
+	lockOpRecover:        true,
+	lockOpUpdate:         true,
+	lockOpResize:         true,
+	lockOpStats:          true,
+	lockOpProcesses:      true,
+	lockOpRebind:         true,
+}
+
+// lockEntry is the lock of a container, it's held by a writer exclusively
+// or by the readers shared.
+type lockEntry struct {
+	// held is true if the lock is held by a writer.
+	held bool
+
+	// readers is the number of readers holding the lock.
+	readers int
+
+	// waiters are notified in FIFO order when the lock is released.
+	waiters []*lockWaiter
+}
+
+// lockWaiter is a caller waiting for the lock.
+type lockWaiter struct {
+	ch     chan struct{}
+	shared bool
+}
+
+// containerLock use to make sure that only one operates the container at
+// the same time, except that the read-only operations can share the lock.
 type containerLock struct {
 	mutex sync.Mutex
-	ids   map[string]struct{}
+	ids   map[string]*lockEntry
+
+	// fair means the lock is handed over to the first waiters directly.
+	fair bool
+
+	// timeouts are the default timeouts to acquire the lock keyed by the
+	// operations, they only apply to the context without deadline.
+	timeouts map[string]time.Duration
+}
+
+// newContainerLock creates the container lock in the mode.
+func newContainerLock(mode string, timeouts map[string]time.Duration) *containerLock {
+	return &containerLock{
+		ids:      make(map[string]*lockEntry),
+		fair:     mode != LockModeUnfair,
+		timeouts: timeouts,
+	}
+}
+
+// validateLockTimeouts checks whether the operations of timeouts are known.
+func validateLockTimeouts(timeouts map[string]time.Duration) error {
+	for op, timeout := range timeouts {
+		if !lockOperations[op] {
+			return fmt.Errorf("unknown operation %s of container lock timeout", op)
+		}
+		if timeout < 0 {
+			return fmt.Errorf("container lock timeout %s of operation %s cannot be negative", timeout, op)
+		}
+	}
+	return nil
 }
 
+// Trylock acquires the lock of container exclusively without waiting.
 func (l *containerLock) Trylock(id string) bool {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 
-	_, ok := l.ids[id]
-	if !ok {
-		l.ids[id] = struct{}{}
-		return true
-	}
-	return false
+	return l.acquire(id, false)
 }
 
+// Unlock releases the exclusive lock of container, it is handed over to the
+// first waiters in fair mode, or the first waiters are woken up to compete
+// with the new callers in unfair mode.
 func (l *containerLock) Unlock(id string) {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
-	delete(l.ids, id)
+
+	l.release(id, false)
+}
+
+// RUnlock releases the shared lock of container held by a reader.
+func (l *containerLock) RUnlock(id string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.release(id, true)
 }
 
-func (l *containerLock) TrylockWithRetry(ctx context.Context, id string) bool {
-	var retry = 32
+// Lock acquires the lock of container exclusively for the operation, it
+// waits until the lock is released or the context is done. The default
+// timeout of operation is applied if the context has no deadline.
+func (l *containerLock) Lock(ctx context.Context, op, id string) error {
+	return l.lock(ctx, op, id, false)
+}
+
+// RLock acquires the lock of container shared with the other readers for
+// the read-only operation, it only waits for the writers.
+func (l *containerLock) RLock(ctx context.Context, op, id string) error {
+	return l.lock(ctx, op, id, true)
+}
+
+func (l *containerLock) lock(ctx context.Context, op, id string, shared bool) error {
+	start := time.Now()
+
+	if timeout := l.timeout(op); timeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	l.mutex.Lock()
+	if l.acquire(id, shared) {
+		l.mutex.Unlock()
+		lockWaitTimer.WithLabelValues(op, lockResultAcquired).Observe(time.Since(start).Seconds())
+		return nil
+	}
+	lockContentionCounter.WithLabelValues(op).Inc()
+
+	lockWaiters.WithLabelValues().Inc()
+	defer lockWaiters.WithLabelValues().Dec()
 
+	// the woken waiter in unfair mode keeps its place if the lock is taken
+	// by others before it.
+	front := false
 	for {
-		ok := l.Trylock(id)
-		if ok {
-			return true
+		e := l.entry(id)
+		w := &lockWaiter{ch: make(chan struct{}), shared: shared}
+		if front {
+			e.waiters = append([]*lockWaiter{w}, e.waiters...)
+		} else {
+			e.waiters = append(e.waiters, w)
 		}
+		l.mutex.Unlock()
 
-		// sleep random duration by retry
 		select {
-		case <-time.After(time.Millisecond * time.Duration(rand.Intn(retry))):
-			if retry < 2048 {
-				retry = retry << 1
+		case <-w.ch:
+			l.mutex.Lock()
+			// the lock has been handed over in fair mode.
+			if l.fair || l.acquire(id, shared) {
+				l.mutex.Unlock()
+				lockWaitTimer.WithLabelValues(op, lockResultAcquired).Observe(time.Since(start).Seconds())
+				return nil
 			}
-			continue
+			front = true
 		case <-ctx.Done():
-			return false
+			l.mutex.Lock()
+			l.abandon(id, e, w)
+			l.mutex.Unlock()
+
+			result := lockResultCanceled
+			if ctx.Err() == context.DeadlineExceeded {
+				result = lockResultTimeout
+			}
+			lockWaitTimer.WithLabelValues(op, result).Observe(time.Since(start).Seconds())
+			return errors.Wrapf(errtypes.ErrLockfailed, "failed to lock container %s for %s: %v", id, op, ctx.Err())
+		}
+	}
+}
+
+// timeout returns the default timeout of operation.
+func (l *containerLock) timeout(op string) time.Duration {
+	if timeout, ok := l.timeouts[op]; ok {
+		return timeout
+	}
+	return l.timeouts[LockOperationDefault]
+}
+
+// entry returns the lock entry of container, the entry is created if it
+// doesn't exist. The caller should hold the mutex.
+func (l *containerLock) entry(id string) *lockEntry {
+	e, ok := l.ids[id]
+	if !ok {
+		e = &lockEntry{}
+		l.ids[id] = e
+	}
+	return e
+}
+
+// acquire takes the lock if it's not held by a writer, or by the readers
+// for a writer, and no one is waiting for it in fair mode. The caller should
+// hold the mutex.
+func (l *containerLock) acquire(id string, shared bool) bool {
+	e := l.entry(id)
+	if e.held || (l.fair && len(e.waiters) > 0) {
+		return false
+	}
+
+	if shared {
+		e.readers++
+		return true
+	}
+	if e.readers > 0 {
+		return false
+	}
+	e.held = true
+	return true
+}
+
+// release releases the lock and notifies the first waiters. The caller
+// should hold the mutex.
+func (l *containerLock) release(id string, shared bool) {
+	e, ok := l.ids[id]
+	if !ok {
+		return
+	}
+
+	if shared {
+		if e.readers == 0 {
+			return
 		}
+		e.readers--
+	} else {
+		if !e.held {
+			return
+		}
+		e.held = false
+	}
+
+	l.notify(e)
+	l.cleanup(id, e)
+}
+
+// abandon removes the waiter w which gives up waiting. If w has been
+// notified, the lock is released or the next waiters are notified instead.
+// The caller should hold the mutex.
+func (l *containerLock) abandon(id string, e *lockEntry, w *lockWaiter) {
+	select {
+	case <-w.ch:
+		if l.fair {
+			l.release(id, w.shared)
+		} else if cur, ok := l.ids[id]; ok {
+			l.notify(cur)
+			l.cleanup(id, cur)
+		}
+		return
+	default:
+	}
+
+	for i, waiter := range e.waiters {
+		if waiter == w {
+			e.waiters = append(e.waiters[:i], e.waiters[i+1:]...)
+			break
+		}
+	}
+
+	// the readers queued behind the writer w may take the lock now.
+	l.notify(e)
+	l.cleanup(id, e)
+}
+
+// notify wakes up the waiters at the head of queue which can take the lock,
+// that is a writer, or the consecutive readers. The lock is handed over to
+// them in fair mode. The caller should hold the mutex.
+func (l *containerLock) notify(e *lockEntry) {
+	woken := false
+	for len(e.waiters) > 0 {
+		w := e.waiters[0]
+		if e.held || (!w.shared && (e.readers > 0 || woken)) {
+			return
+		}
+
+		e.waiters = e.waiters[1:]
+		if l.fair {
+			if w.shared {
+				e.readers++
+			} else {
+				e.held = true
+			}
+		}
+		close(w.ch)
+
+		if !w.shared {
+			return
+		}
+		woken = true
+	}
+}
+
+// cleanup removes the lock entry if no one holds or waits for it. The
+// caller should hold the mutex.
+func (l *containerLock) cleanup(id string, e *lockEntry) {
+	if !e.held && e.readers == 0 && len(e.waiters) == 0 && l.ids[id] == e {
+		delete(l.ids, id)
 	}
 }
@@ -5,32 +5,32 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alibaba/pouch/pkg/errtypes"
+
 	"github.com/stretchr/testify/assert"
 )
 
-func Test_containerLock_TrylockWithRetry(t *testing.T) {
-	l := &containerLock{
-		ids: make(map[string]struct{}),
-	}
+func Test_containerLock_Lock(t *testing.T) {
+	l := newContainerLock(LockModeFair, nil)
 
-	// basically, if the releaseTimeout < the tryLockTimeout,
-	// TrylockWithTimeout will lock successfully. If not, it will fail.
-	runTrylockWithT := func(tryLockTimeout, releaseTimeout time.Duration) bool {
+	// basically, if the releaseTimeout < the lockTimeout,
+	// Lock will lock successfully. If not, it will fail.
+	runLockWithT := func(lockTimeout, releaseTimeout time.Duration) error {
 		id := "c"
 		assert.Equal(t, l.Trylock(id), true)
 		defer l.Unlock(id)
 
 		var (
 			releaseCh = make(chan struct{})
-			waitCh    = make(chan bool)
-			res       bool
+			waitCh    = make(chan error)
+			res       error
 		)
 
 		go func() {
 			close(releaseCh)
-			ctx, cancel := context.WithTimeout(context.TODO(), tryLockTimeout)
+			ctx, cancel := context.WithTimeout(context.TODO(), lockTimeout)
 			defer cancel()
-			waitCh <- l.TrylockWithRetry(ctx, id)
+			waitCh <- l.Lock(ctx, lockOpStats, id)
 		}()
 
 		<-releaseCh
@@ -39,20 +39,185 @@ func Test_containerLock_TrylockWithRetry(t *testing.T) {
 
 		select {
 		case <-time.After(3 * time.Second):
-			t.Fatalf("timeout to get the Trylock result")
+			t.Fatalf("timeout to get the Lock result")
 		case res = <-waitCh:
 		}
 		return res
 	}
 
-	assert.Equal(t, true, runTrylockWithT(5*time.Second, 200*time.Millisecond))
-	assert.Equal(t, false, runTrylockWithT(200*time.Millisecond, 500*time.Millisecond))
+	assert.NoError(t, runLockWithT(5*time.Second, 200*time.Millisecond))
+
+	err := runLockWithT(200*time.Millisecond, 500*time.Millisecond)
+	assert.Error(t, err)
+	assert.True(t, errtypes.IsLockfailed(err))
+
+	// the waiter which gives up is removed.
+	assert.Equal(t, len(l.ids), 0)
 }
 
-func Test_containerLock_Trylock(t *testing.T) {
-	l := &containerLock{
-		ids: make(map[string]struct{}),
+func Test_containerLock_LockTimeout(t *testing.T) {
+	l := newContainerLock(LockModeFair, map[string]time.Duration{
+		LockOperationDefault: 100 * time.Millisecond,
+		lockOpDestroy:        0,
+	})
+
+	assert.Equal(t, l.Trylock("c"), true)
+
+	// the default timeout is applied to the context without deadline.
+	err := l.Lock(context.Background(), lockOpStats, "c")
+	assert.True(t, errtypes.IsLockfailed(err))
+
+	// the timeout of operation overrides the default one.
+	done := make(chan error)
+	go func() {
+		done <- l.Lock(context.Background(), lockOpDestroy, "c")
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("expected to wait for the lock, but got %v", err)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	l.Unlock("c")
+	assert.NoError(t, <-done)
+	l.Unlock("c")
+	assert.Equal(t, len(l.ids), 0)
+}
+
+func Test_containerLock_Fair(t *testing.T) {
+	l := newContainerLock(LockModeFair, nil)
+	assert.Equal(t, l.Trylock("c"), true)
+
+	order := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		go func(i int) {
+			assert.NoError(t, l.Lock(context.Background(), lockOpKill, "c"))
+			order <- i
+			l.Unlock("c")
+		}(i)
+
+		// make sure the waiters are queued in order.
+		waitForWaiters(t, l, "c", i+1)
+	}
+
+	// the new caller cannot barge in before the waiters.
+	l.mutex.Lock()
+	l.release("c", false)
+	assert.Equal(t, l.acquire("c", false), false)
+	l.mutex.Unlock()
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, <-order)
+	}
+}
+
+func Test_containerLock_Unfair(t *testing.T) {
+	l := newContainerLock(LockModeUnfair, nil)
+	assert.Equal(t, l.Trylock("c"), true)
+
+	done := make(chan error)
+	go func() {
+		done <- l.Lock(context.Background(), lockOpKill, "c")
+	}()
+	waitForWaiters(t, l, "c", 1)
+
+	// the new caller takes the lock before the woken waiter.
+	l.mutex.Lock()
+	l.release("c", false)
+	assert.Equal(t, l.acquire("c", false), true)
+	l.mutex.Unlock()
+
+	l.Unlock("c")
+	assert.NoError(t, <-done)
+	l.Unlock("c")
+	assert.Equal(t, len(l.ids), 0)
+}
+
+func Test_containerLock_RLock(t *testing.T) {
+	l := newContainerLock(LockModeFair, nil)
+
+	// the readers share the lock.
+	assert.NoError(t, l.RLock(context.Background(), lockOpStats, "c"))
+	assert.NoError(t, l.RLock(context.Background(), lockOpProcesses, "c"))
+	assert.Equal(t, l.ids["c"].readers, 2)
+	assert.Equal(t, l.Trylock("c"), false)
+
+	// the writer waits for the readers.
+	writer := make(chan error)
+	go func() {
+		writer <- l.Lock(context.Background(), lockOpDestroy, "c")
+	}()
+	waitForWaiters(t, l, "c", 1)
+
+	// the new reader waits behind the writer.
+	reader := make(chan error)
+	go func() {
+		reader <- l.RLock(context.Background(), lockOpStats, "c")
+	}()
+	waitForWaiters(t, l, "c", 2)
+
+	l.RUnlock("c")
+	l.RUnlock("c")
+	assert.NoError(t, <-writer)
+
+	l.Unlock("c")
+	assert.NoError(t, <-reader)
+	assert.Equal(t, l.ids["c"].readers, 1)
+
+	l.RUnlock("c")
+	assert.Equal(t, len(l.ids), 0)
+}
+
+func Test_containerLock_RLockAbandon(t *testing.T) {
+	l := newContainerLock(LockModeFair, nil)
+	assert.NoError(t, l.RLock(context.Background(), lockOpStats, "c"))
+
+	// the writer gives up waiting for the reader.
+	ctx, cancel := context.WithCancel(context.Background())
+	writer := make(chan error)
+	go func() {
+		writer <- l.Lock(ctx, lockOpKill, "c")
+	}()
+	waitForWaiters(t, l, "c", 1)
+
+	reader := make(chan error)
+	go func() {
+		reader <- l.RLock(context.Background(), lockOpProcesses, "c")
+	}()
+	waitForWaiters(t, l, "c", 2)
+
+	// the reader queued behind the writer takes the lock.
+	cancel()
+	assert.True(t, errtypes.IsLockfailed(<-writer))
+	assert.NoError(t, <-reader)
+
+	l.RUnlock("c")
+	l.RUnlock("c")
+	assert.Equal(t, len(l.ids), 0)
+}
+
+// waitForWaiters waits until the number of waiters of lock is n.
+func waitForWaiters(t *testing.T, l *containerLock, id string, n int) {
+	for i := 0; i < 100; i++ {
+		l.mutex.Lock()
+		e, ok := l.ids[id]
+		count := 0
+		if ok {
+			count = len(e.waiters)
+		}
+		l.mutex.Unlock()
+
+		if count == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
 	}
+	t.Fatalf("timeout to wait for %d waiters of %s", n, id)
+}
+
+func Test_containerLock_Trylock(t *testing.T) {
+	l := newContainerLock(LockModeFair, nil)
 
 	assert.Equal(t, len(l.ids), 0)
 
@@ -60,25 +225,23 @@ func Test_containerLock_Trylock(t *testing.T) {
 	ok := l.Trylock("element1")
 	assert.Equal(t, ok, true)
 	assert.Equal(t, len(l.ids), 1)
-	assert.Equal(t, l.ids["element1"], struct{}{})
+	assert.Equal(t, l.ids["element1"].held, true)
 
 	// lock an existent element
 	ok = l.Trylock("element1")
 	assert.Equal(t, ok, false)
 	assert.Equal(t, len(l.ids), 1)
-	assert.Equal(t, l.ids["element1"], struct{}{})
+	assert.Equal(t, l.ids["element1"].held, true)
 
 	// lock another new element
 	ok = l.Trylock("element2")
 	assert.Equal(t, ok, true)
 	assert.Equal(t, len(l.ids), 2)
-	assert.Equal(t, l.ids["element1"], struct{}{})
+	assert.Equal(t, l.ids["element1"].held, true)
 }
 
 func Test_containerLock_Unlock(t *testing.T) {
-	l := &containerLock{
-		ids: make(map[string]struct{}),
-	}
+	l := newContainerLock(LockModeFair, nil)
 
 	// unlock a non-existent element
 	l.Unlock("non-existent")
@@ -88,9 +251,16 @@ func Test_containerLock_Unlock(t *testing.T) {
 	ok := l.Trylock("element1")
 	assert.Equal(t, ok, true)
 	assert.Equal(t, len(l.ids), 1)
-	assert.Equal(t, l.ids["element1"], struct{}{})
+	assert.Equal(t, l.ids["element1"].held, true)
 
 	// unlock an existent element
 	l.Unlock("element1")
 	assert.Equal(t, len(l.ids), 0)
 }
+
+func Test_validateLockTimeouts(t *testing.T) {
+	assert.NoError(t, validateLockTimeouts(nil))
+	assert.NoError(t, validateLockTimeouts(map[string]time.Duration{LockOperationDefault: time.Second, lockOpCreate: 0}))
+	assert.Error(t, validateLockTimeouts(map[string]time.Duration{"unknown": time.Second}))
+	assert.Error(t, validateLockTimeouts(map[string]time.Duration{lockOpStats: -time.Second}))
+}
@@ -1,6 +1,7 @@
 package ctrd
 
 import (
+	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/daemon/containerio"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -28,6 +29,28 @@ type Container struct {
 
 	// UseSystemd tells whether container use systemd cgroup driver
 	UseSystemd bool
+
+	// Resources is set into cgroup before the task starts in unified
+	// hierarchy, since the spec can't carry the resources of cgroup v2.
+	Resources *types.Resources
+}
+
+// CheckpointOptions contains the options used to checkpoint a task.
+type CheckpointOptions struct {
+	// Exit stops the task after checkpoint is created.
+	Exit bool
+
+	// TCPEstablished allows to checkpoint established TCP connections.
+	TCPEstablished bool
+}
+
+// RestoreOptions contains the options used to restore a task from checkpoint.
+type RestoreOptions struct {
+	// CheckpointDir is the directory which stores the checkpoint images.
+	CheckpointDir string
+
+	// TCPEstablished allows to restore established TCP connections.
+	TCPEstablished bool
 }
 
 // Process wraps exec process's info.
@@ -37,4 +60,20 @@ type Process struct {
 	IO          *containerio.IO
 	P           *specs.Process
 	Detach      bool
+
+	// Resources limits the exec process in a dedicated sub cgroup of
+	// container, nil means the process shares the cgroup of container.
+	Resources *specs.LinuxResources
+}
+
+// ProcessInfo is the info of a process inside the container.
+type ProcessInfo struct {
+	// Pid is the id of process, it's the pid on host for the runtimes
+	// running processes on host like runc, and the pid inside the sandbox
+	// for the others like kata and gVisor.
+	Pid int
+
+	// ExecID is the id of exec process, it's empty for the init process
+	// and the processes forked by it.
+	ExecID string
 }
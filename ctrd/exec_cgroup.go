@@ -0,0 +1,250 @@
+package ctrd
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/cgroups"
+	"github.com/containerd/containerd"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// execCgroupPrefix is the name prefix of sub cgroup created for exec process.
+	execCgroupPrefix = "exec-"
+
+	// execJoinTimeout is how long to wait for the exec process to join the
+	// cgroup of container.
+	execJoinTimeout = 10 * time.Second
+
+	// execJoinInterval is the interval to check whether the exec process
+	// has joined the cgroup of container.
+	execJoinInterval = 10 * time.Millisecond
+)
+
+// execCgroupHierarchy only contains the subsystems which exec resources can
+// limit, so that the sub cgroup is not created in other hierarchies.
+func execCgroupHierarchy() ([]cgroups.Subsystem, error) {
+	return v1Subsystems(cgroups.Cpu, cgroups.Memory)
+}
+
+// freezerHierarchy only contains the freezer subsystem.
+func freezerHierarchy() ([]cgroups.Subsystem, error) {
+	return v1Subsystems(cgroups.Freezer)
+}
+
+// v1Subsystems returns the mounted cgroup v1 subsystems of names.
+func v1Subsystems(names ...cgroups.Name) ([]cgroups.Subsystem, error) {
+	subsystems, err := cgroups.V1()
+	if err != nil {
+		return nil, err
+	}
+
+	var enabled []cgroups.Subsystem
+	for _, s := range subsystems {
+		for _, name := range names {
+			if s.Name() == name {
+				enabled = append(enabled, s)
+			}
+		}
+	}
+	return enabled, nil
+}
+
+// startExec starts the exec process of container, the exec process is
+// started in a sub cgroup if resources is specified. The execLock of pack is
+// held while the exec process starts, so that the only new process in the
+// frozen container is the exec process. The returned bool is whether the
+// exec process is started, it should be killed if it's started but error is
+// returned.
+func (pack *containerPack) startExec(ctx context.Context, execProcess containerd.Process, execID string, resources *specs.LinuxResources) (cgroups.Cgroup, bool, error) {
+	pack.execLock.Lock()
+	defer pack.execLock.Unlock()
+
+	if resources == nil {
+		err := execProcess.Start(ctx)
+		return nil, err == nil, err
+	}
+	return startExecInCgroup(ctx, pack.task.Pid(), execProcess, execID, resources)
+}
+
+// startExecInCgroup creates a sub cgroup under the cgroup of container's init
+// process with the given resources, and starts the exec process in it.
+//
+// NOTE: runc always joins the exec process into the cgroup of container, so
+// the container is frozen while the exec process starts. The process created
+// by runc is frozen as soon as it joins the cgroup of container, and it is
+// moved into the sub cgroup before it runs anything of the exec command. It
+// requires runc older than 1.1, which refuses to exec in the frozen container,
+// and the version is checked by the daemon when the exec is created. It must
+// be called by startExec with the execLock held.
+func startExecInCgroup(ctx context.Context, taskPid uint32, execProcess containerd.Process, execID string, resources *specs.LinuxResources) (cgroups.Cgroup, bool, error) {
+	parent, err := cgroups.Load(execCgroupHierarchy, cgroups.PidPath(int(taskPid)))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to load cgroup of container")
+	}
+	freezer, err := cgroups.Load(freezerHierarchy, cgroups.PidPath(int(taskPid)))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to load freezer cgroup of container")
+	}
+	if state := freezer.State(); state != cgroups.Thawed {
+		return nil, false, errors.Errorf("failed to start exec process in %s container", state)
+	}
+
+	cg, err := parent.New(execCgroupPrefix+execID, resources)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to create cgroup for exec process")
+	}
+
+	pid, started, err := startFrozen(ctx, parent, freezer, execProcess, func(pid int) error {
+		return cg.Add(cgroups.Process{Pid: pid})
+	})
+	if err != nil {
+		// the sub cgroup can only be deleted after the process exits.
+		if !started {
+			cg.Delete()
+			return nil, false, err
+		}
+		return cg, true, err
+	}
+
+	log.With(ctx).Debugf("exec process %s(pid=%d) is started in cgroup %s", execID, pid, execCgroupPrefix+execID)
+	return cg, true, nil
+}
+
+// startFrozen starts the exec process while the container is frozen, and
+// calls join with the pid of exec process before the container is thawed.
+func startFrozen(ctx context.Context, parent, freezer cgroups.Cgroup, execProcess containerd.Process, join func(int) error) (int, bool, error) {
+	if err := freezer.Freeze(); err != nil {
+		return 0, false, errors.Wrap(err, "failed to freeze container")
+	}
+
+	thawed := false
+	thaw := func() error {
+		if thawed {
+			return nil
+		}
+		thawed = true
+		return freezer.Thaw()
+	}
+	defer func() {
+		if err := thaw(); err != nil {
+			log.With(ctx).Errorf("failed to thaw container: %v", err)
+		}
+	}()
+
+	// the processes of frozen container are not changed until exec starts.
+	existing, err := processes(freezer, cgroups.Freezer)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to list processes of container")
+	}
+
+	startCh := make(chan error, 1)
+	go func() {
+		startCh <- execProcess.Start(ctx)
+	}()
+
+	pid, err := waitJoined(ctx, parent, freezer, existing, startCh)
+	if err == nil {
+		err = join(pid)
+	}
+
+	// runc returns after the exec process runs, which is after the thaw.
+	if terr := thaw(); terr != nil && err == nil {
+		err = errors.Wrap(terr, "failed to thaw container")
+	}
+	if serr := <-startCh; serr != nil {
+		return 0, false, serr
+	}
+	return pid, true, err
+}
+
+// waitJoined waits for the new process to join the cgroups of container, it
+// returns if the exec process fails to start.
+func waitJoined(ctx context.Context, parent, freezer cgroups.Cgroup, existing map[int]bool, startCh chan error) (int, error) {
+	timeout := time.After(execJoinTimeout)
+	ticker := time.NewTicker(execJoinInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-startCh:
+			// put back the result for the caller.
+			startCh <- err
+			if err != nil {
+				return 0, err
+			}
+			return 0, errors.New("exec process is started out of the frozen container")
+		case <-timeout:
+			return 0, errors.New("timeout to wait for exec process to join the cgroup of container")
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+
+		var joined []map[int]bool
+		for _, c := range []struct {
+			cg   cgroups.Cgroup
+			name cgroups.Name
+		}{
+			{freezer, cgroups.Freezer},
+			{parent, cgroups.Cpu},
+			{parent, cgroups.Memory},
+		} {
+			pids, err := processes(c.cg, c.name)
+			if err != nil {
+				return 0, err
+			}
+			joined = append(joined, pids)
+		}
+
+		// runc writes the process into the cgroups one by one, it has
+		// joined the container only if it's in all of them.
+		if pid := newProcess(existing, joined...); pid != 0 {
+			return pid, nil
+		}
+	}
+}
+
+// processes returns the pids of processes in the cgroup of subsystem.
+func processes(cg cgroups.Cgroup, subsystem cgroups.Name) (map[int]bool, error) {
+	procs, err := cg.Processes(subsystem, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pids := make(map[int]bool, len(procs))
+	for _, p := range procs {
+		pids[p.Pid] = true
+	}
+	return pids, nil
+}
+
+// newProcess returns the process which is not in existing but in all of
+// joined, it returns 0 if not found.
+func newProcess(existing map[int]bool, joined ...map[int]bool) int {
+	if len(joined) == 0 {
+		return 0
+	}
+
+	for pid := range joined[0] {
+		if existing[pid] {
+			continue
+		}
+
+		found := true
+		for _, pids := range joined[1:] {
+			if !pids[pid] {
+				found = false
+				break
+			}
+		}
+		if found {
+			return pid
+		}
+	}
+	return 0
+}
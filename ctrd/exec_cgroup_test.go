@@ -0,0 +1,26 @@
+package ctrd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newProcess(t *testing.T) {
+	existing := map[int]bool{1: true, 10: true}
+
+	// the exec process has not joined all the cgroups of container.
+	assert.Equal(t, 0, newProcess(existing,
+		map[int]bool{1: true, 10: true, 20: true},
+		map[int]bool{1: true, 10: true},
+	))
+
+	assert.Equal(t, 20, newProcess(existing,
+		map[int]bool{1: true, 10: true, 20: true},
+		map[int]bool{1: true, 10: true, 20: true},
+		map[int]bool{1: true, 10: true, 20: true},
+	))
+
+	assert.Equal(t, 0, newProcess(existing, map[int]bool{1: true, 10: true}))
+	assert.Equal(t, 0, newProcess(existing))
+}
@@ -186,8 +186,9 @@ func (c *Client) importImage(ctx context.Context, reader io.Reader, opts ...cont
 	return res, nil
 }
 
-// PushImage pushes image to registry
-func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error {
+// PushImage pushes image to registry, the push of each blob and manifest is
+// retried on failure.
+func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.AuthConfig, opts docker.ResolverOptions, out io.Writer) error {
 	wrapperCli, err := c.Get(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
@@ -200,9 +201,8 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 
 	pushTracker := docker.NewInMemoryTracker()
 
-	resolver, err := c.preparePushResolver(authConfig, ref, docker.ResolverOptions{
-		Tracker: pushTracker,
-	})
+	opts.Tracker = pushTracker
+	resolver, err := c.preparePushResolver(authConfig, ref, opts)
 	if err != nil {
 		return err
 	}
@@ -222,9 +222,10 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 		close(wait)
 	}()
 
-	err = wrapperCli.client.Push(ctx, ref, img.Target(),
-		containerd.WithResolver(resolver),
-		containerd.WithImageHandler(handler))
+	pusher, err := resolver.Pusher(ctx, ref)
+	if err == nil {
+		err = pushContent(ctx, pusher, img.Target(), wrapperCli.client.ContentStore(), pushTracker, handler)
+	}
 
 	cancelProgress()
 	<-wait
@@ -361,13 +361,18 @@ func newImageConfig(c *types.ContainerConfig) ocispec.ImageConfig {
 			volumes[i] = struct{}(nv)
 		}
 	}
+	exposedPorts := make(map[string]struct{})
+	for port := range c.ExposedPorts {
+		exposedPorts[port] = struct{}{}
+	}
 	return ocispec.ImageConfig{
-		User:       c.User,
-		Env:        c.Env,
-		Entrypoint: c.Entrypoint,
-		Cmd:        c.Cmd,
-		Volumes:    volumes,
-		WorkingDir: c.WorkingDir,
-		Labels:     c.Labels,
+		User:         c.User,
+		ExposedPorts: exposedPorts,
+		Env:          c.Env,
+		Entrypoint:   c.Entrypoint,
+		Cmd:          c.Cmd,
+		Volumes:      volumes,
+		WorkingDir:   c.WorkingDir,
+		Labels:       c.Labels,
 	}
 }
@@ -0,0 +1,95 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/containerd/containerd/content"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// dockerArchiveManifest is the item of manifest.json in docker-archive.
+type dockerArchiveManifest struct {
+	Config   string
+	RepoTags []string
+	Layers   []string
+}
+
+// DockerArchiveExporter exports the image into the tarball of docker-archive
+// format, which is the same as the one of docker save, so that the image can
+// be loaded by docker or pouch.
+type DockerArchiveExporter struct {
+	// RepoTags are the tagged references of the image.
+	RepoTags []string
+}
+
+// Export exports the image of desc into writer.
+func (e *DockerArchiveExporter) Export(ctx context.Context, store content.Provider, desc ocispec.Descriptor, writer io.Writer) error {
+	manifest, err := ctrdmetaimages.Manifest(ctx, store, desc, platforms.Default())
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(writer)
+
+	item := dockerArchiveManifest{
+		Config:   manifest.Config.Digest.Hex() + ".json",
+		RepoTags: e.RepoTags,
+	}
+	if err := writeBlobRecord(ctx, tw, store, manifest.Config, item.Config); err != nil {
+		return err
+	}
+
+	// the layer is kept as it is in the registry, docker and pouch
+	// detect the compression of layer when load.
+	for _, layer := range manifest.Layers {
+		dir := layer.Digest.Hex() + "/"
+		if err := tw.WriteHeader(&tar.Header{Name: dir, Mode: 0755, Typeflag: tar.TypeDir}); err != nil {
+			return err
+		}
+		if err := writeBlobRecord(ctx, tw, store, layer, dir+"layer.tar"); err != nil {
+			return err
+		}
+		item.Layers = append(item.Layers, dir+"layer.tar")
+	}
+
+	data, err := json.Marshal([]dockerArchiveManifest{item})
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "manifest.json", Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// writeBlobRecord writes the blob of desc into the tarball as name, and
+// verifies the digest of blob.
+func writeBlobRecord(ctx context.Context, tw *tar.Writer, store content.Provider, desc ocispec.Descriptor, name string) error {
+	ra, err := store.ReaderAt(ctx, desc)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get reader of %s", desc.Digest)
+	}
+	defer ra.Close()
+
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0444, Size: desc.Size, Typeflag: tar.TypeReg}); err != nil {
+		return err
+	}
+
+	dgstr := desc.Digest.Algorithm().Digester()
+	if _, err := io.CopyN(io.MultiWriter(tw, dgstr.Hash()), content.NewReader(ra), desc.Size); err != nil {
+		return errors.Wrapf(err, "failed to copy %s to tar", desc.Digest)
+	}
+	if dgstr.Digest() != desc.Digest {
+		return errors.Errorf("unexpected digest %s copied", dgstr.Digest())
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryProvider provides the blobs in memory.
+type memoryProvider map[digest.Digest][]byte
+
+type memoryReaderAt struct {
+	*bytes.Reader
+}
+
+func (r memoryReaderAt) Close() error {
+	return nil
+}
+
+func (p memoryProvider) ReaderAt(ctx context.Context, desc ocispec.Descriptor) (content.ReaderAt, error) {
+	data, ok := p[desc.Digest]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", desc.Digest)
+	}
+	return memoryReaderAt{bytes.NewReader(data)}, nil
+}
+
+func (p memoryProvider) add(mediaType string, data []byte) ocispec.Descriptor {
+	dgst := digest.FromBytes(data)
+	p[dgst] = data
+	return ocispec.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(data))}
+}
+
+func TestDockerArchiveExporter(t *testing.T) {
+	store := memoryProvider{}
+	config := store.add(ocispec.MediaTypeImageConfig, []byte(`{"architecture":"amd64","os":"linux"}`))
+	layers := []ocispec.Descriptor{
+		store.add(ocispec.MediaTypeImageLayerGzip, []byte("layer1")),
+		store.add(ocispec.MediaTypeImageLayerGzip, []byte("layer2")),
+	}
+	manifest, _ := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Config:    config,
+		Layers:    layers,
+	})
+	desc := store.add(ocispec.MediaTypeImageManifest, manifest)
+
+	buf := bytes.NewBuffer(nil)
+	exporter := &DockerArchiveExporter{RepoTags: []string{"registry.hub.docker.com/library/busybox:latest"}}
+	assert.NoError(t, exporter.Export(context.Background(), store, desc, buf))
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(buf)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		data, err := ioutil.ReadAll(tr)
+		assert.NoError(t, err)
+		files[hdr.Name] = data
+	}
+
+	var items []dockerArchiveManifest
+	assert.NoError(t, json.Unmarshal(files["manifest.json"], &items))
+	assert.Equal(t, []dockerArchiveManifest{{
+		Config:   config.Digest.Hex() + ".json",
+		RepoTags: []string{"registry.hub.docker.com/library/busybox:latest"},
+		Layers: []string{
+			layers[0].Digest.Hex() + "/layer.tar",
+			layers[1].Digest.Hex() + "/layer.tar",
+		},
+	}}, items)
+
+	assert.Equal(t, store[config.Digest], files[items[0].Config])
+	for i, layer := range layers {
+		assert.Equal(t, store[layer.Digest], files[items[0].Layers[i]])
+	}
+
+	// the corrupted blob is not exported.
+	store[layers[1].Digest] = []byte("layer3")
+	assert.Error(t, exporter.Export(context.Background(), store, desc, ioutil.Discard))
+}
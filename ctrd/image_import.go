@@ -0,0 +1,221 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/docker/docker/pkg/archive"
+	digest "github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// isWhiteout returns whether the tar entry is the whiteout of aufs or
+// overlay format.
+func isWhiteout(hdr *tar.Header) bool {
+	if strings.HasPrefix(path.Base(hdr.Name), archive.WhiteoutPrefix) {
+		return true
+	}
+	return hdr.Typeflag == tar.TypeChar && hdr.Devmajor == 0 && hdr.Devminor == 0
+}
+
+// convertImportLayer converts the tarball into the gzip compressed layer
+// written to w, and returns the digest of the uncompressed layer.
+//
+// The tarball may be compressed. Since the imported layer is the only
+// layer of image, the whiteouts in the tarball are dropped. The owner and
+// mode of files are kept as they are in the tarball.
+func convertImportLayer(r io.Reader, w io.Writer) (digest.Digest, error) {
+	rc, err := archive.DecompressStream(r)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decompress tarball")
+	}
+	defer rc.Close()
+
+	var (
+		gw       = gzip.NewWriter(w)
+		dgstr    = digest.Canonical.Digester()
+		tw       = tar.NewWriter(io.MultiWriter(gw, dgstr.Hash()))
+		tr       = tar.NewReader(rc)
+		hasEntry = false
+	)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read tarball")
+		}
+		hasEntry = true
+
+		if isWhiteout(hdr) {
+			continue
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return "", err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return "", err
+		}
+	}
+
+	if !hasEntry {
+		return "", errors.Wrap(errdefs.ErrInvalidArgument, "tarball is empty")
+	}
+
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	if err := gw.Close(); err != nil {
+		return "", err
+	}
+	return dgstr.Digest(), nil
+}
+
+// writeImportLayer writes the layer converted from tarball into content
+// store.
+func writeImportLayer(ctx context.Context, cs content.Store, r io.Reader) (ocispec.Descriptor, digest.Digest, error) {
+	ref := fmt.Sprintf("import-layer-%d", time.Now().UnixNano())
+	writer, err := content.OpenWriter(ctx, cs, content.WithRef(ref))
+	if err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+	defer writer.Close()
+
+	dgstr := digest.Canonical.Digester()
+	counter := &countWriter{}
+	diffID, err := convertImportLayer(r, io.MultiWriter(writer, dgstr.Hash(), counter))
+	if err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+
+	labels := map[string]string{containerdUncompressed: diffID.String()}
+	if err := writer.Commit(ctx, counter.n, dgstr.Digest(), content.WithLabels(labels)); err != nil && !errdefs.IsAlreadyExists(err) {
+		return ocispec.Descriptor{}, "", err
+	}
+
+	return ocispec.Descriptor{
+		MediaType: layerType,
+		Digest:    dgstr.Digest(),
+		Size:      counter.n,
+	}, diffID, nil
+}
+
+// countWriter counts the bytes written.
+type countWriter struct {
+	n int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// CreateImageFromLayer creates the image named by ref whose only layer is
+// converted from the tarball, img is the config of the image without
+// rootfs.
+func (c *Client) CreateImageFromLayer(ctx context.Context, ref string, tarball io.Reader, img ocispec.Image) (containerd.Image, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+	client := wrapperCli.client
+
+	// NOTE: make sure that gc scheduler doesn't remove content during import
+	ctx, done, err := client.WithLease(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create lease for import")
+	}
+	defer done(ctx)
+
+	cs := client.ContentStore()
+
+	layer, diffID, err := writeImportLayer(ctx, cs, tarball)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write layer")
+	}
+
+	img.RootFS = ocispec.RootFS{
+		Type:    "layers",
+		DiffIDs: []digest.Digest{diffID},
+	}
+	imgJSON, err := json.Marshal(img)
+	if err != nil {
+		return nil, err
+	}
+	configDesc := ocispec.Descriptor{
+		MediaType: configType,
+		Digest:    digest.FromBytes(imgJSON),
+		Size:      int64(len(imgJSON)),
+	}
+	if err := content.WriteBlob(ctx, cs, configDesc.Digest.String(), bytes.NewReader(imgJSON), configDesc); err != nil {
+		return nil, errors.Wrap(err, "error writing config blob")
+	}
+
+	mfst := struct {
+		MediaType string `json:"mediaType,omitempty"`
+		ocispec.Manifest
+	}{
+		MediaType: manifestType,
+		Manifest: ocispec.Manifest{
+			Versioned: specs.Versioned{
+				SchemaVersion: 2,
+			},
+			Config: configDesc,
+			Layers: []ocispec.Descriptor{layer},
+		},
+	}
+	mfstJSON, err := json.MarshalIndent(mfst, "", "   ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal manifest")
+	}
+	desc := ocispec.Descriptor{
+		MediaType: manifestType,
+		Digest:    digest.FromBytes(mfstJSON),
+		Size:      int64(len(mfstJSON)),
+	}
+	labels := map[string]string{
+		"containerd.io/gc.ref.content.0": configDesc.Digest.String(),
+		"containerd.io/gc.ref.content.1": layer.Digest.String(),
+	}
+	if err := content.WriteBlob(ctx, cs, desc.Digest.String(), bytes.NewReader(mfstJSON), desc, content.WithLabels(labels)); err != nil {
+		return nil, errors.Wrapf(err, "error writing manifest blob %s", desc.Digest)
+	}
+
+	created := images.Image{
+		Name:      ref,
+		Target:    desc,
+		CreatedAt: time.Now(),
+	}
+	is := client.ImageService()
+	if created, err = is.Create(ctx, created); err != nil {
+		if !errdefs.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create new image %s", err)
+		}
+		if created, err = is.Update(ctx, images.Image{Name: ref, Target: desc}); err != nil {
+			return nil, fmt.Errorf("failed to cover exist image %s", err)
+		}
+	}
+
+	image := containerd.NewImage(client, created)
+	if err := image.Unpack(ctx, CurrentSnapshotterName(ctx)); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
@@ -0,0 +1,63 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertImportLayer(t *testing.T) {
+	src := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(src)
+	tw := tar.NewWriter(gw)
+	for _, hdr := range []*tar.Header{
+		{Name: "etc/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "etc/passwd", Typeflag: tar.TypeReg, Mode: 0600, Uid: 1000, Gid: 1001, Size: 4},
+		{Name: "etc/.wh.group", Typeflag: tar.TypeReg, Mode: 0600},
+		{Name: "etc/.wh..wh..opq", Typeflag: tar.TypeReg, Mode: 0600},
+		{Name: "etc/shadow", Typeflag: tar.TypeChar, Mode: 0600},
+	} {
+		assert.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Size > 0 {
+			tw.Write([]byte("root"))
+		}
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gw.Close())
+
+	dst := bytes.NewBuffer(nil)
+	diffID, err := convertImportLayer(src, dst)
+	assert.NoError(t, err)
+
+	gr, err := gzip.NewReader(dst)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(gr)
+	assert.NoError(t, err)
+	assert.Equal(t, digest.FromBytes(data), diffID)
+
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		names = append(names, hdr.Name)
+		if hdr.Name == "etc/passwd" {
+			assert.Equal(t, 1000, hdr.Uid)
+			assert.Equal(t, 1001, hdr.Gid)
+			assert.Equal(t, int64(0600), hdr.Mode)
+		}
+	}
+	assert.Equal(t, []string{"etc/", "etc/passwd"}, names)
+
+	_, err = convertImportLayer(bytes.NewReader(nil), ioutil.Discard)
+	assert.Error(t, err)
+}
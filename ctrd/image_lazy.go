@@ -0,0 +1,294 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/jsonstream"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/rootfs"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// LabelLazySnapshotter is the label of image which records the remote
+	// snapshotter that the image is lazily pulled into.
+	LabelLazySnapshotter = "io.alibaba.pouch.image.lazy-snapshotter"
+
+	// stargzTOCDigestAnnotation is the annotation of eStargz layer.
+	stargzTOCDigestAnnotation = "containerd.io/snapshot/stargz/toc.digest"
+	// zstdChunkedManifestAnnotation is the annotation of zstd:chunked layer.
+	zstdChunkedManifestAnnotation = "io.github.containers.zstd-chunked.manifest-checksum"
+
+	// targetSnapshotLabel asks the remote snapshotter to mount the layer
+	// from remote and commit it as the snapshot of the label value.
+	targetSnapshotLabel = "containerd.io/snapshot.ref"
+	// targetRefLabel is the image reference of the layer to mount.
+	targetRefLabel = "containerd.io/snapshot/cri.image-ref"
+	// targetDigestLabel is the digest of the layer to mount.
+	targetDigestLabel = "containerd.io/snapshot/cri.layer-digest"
+	// targetImageLayersLabel is the digests of the layers from the one to
+	// mount, so that the remote snapshotter can prefetch them.
+	targetImageLayersLabel = "containerd.io/snapshot/cri.image-layers"
+
+	// maxImageLayersLabelSize limits the size of image layers label, since
+	// the size of labels is limited by containerd.
+	maxImageLayersLabelSize = 4000
+)
+
+// ErrLazyPullUnsupported is returned when the image has no layer which can
+// be lazily pulled, the image should be pulled as usual.
+var ErrLazyPullUnsupported = errors.New("image has no layer which can be lazily pulled")
+
+// isLazyLayer returns whether the layer can be mounted from remote.
+func isLazyLayer(desc ocispec.Descriptor) bool {
+	if desc.Annotations == nil {
+		return false
+	}
+	return desc.Annotations[stargzTOCDigestAnnotation] != "" || desc.Annotations[zstdChunkedManifestAnnotation] != ""
+}
+
+// isLayerType returns whether the descriptor is the layer of image.
+func isLayerType(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "application/vnd.oci.image.layer.") ||
+		strings.HasPrefix(mediaType, "application/vnd.docker.image.rootfs.")
+}
+
+// skipLayers filters out the layers from the children of descriptor, the
+// layers are mounted from remote or fetched when they are applied.
+func skipLayers(f ctrdmetaimages.HandlerFunc) ctrdmetaimages.HandlerFunc {
+	return func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		children, err := f(ctx, desc)
+		if err != nil {
+			return nil, err
+		}
+
+		descs := children[:0]
+		for _, child := range children {
+			if !isLayerType(child.MediaType) {
+				descs = append(descs, child)
+			}
+		}
+		return descs, nil
+	}
+}
+
+// lazyLayerLabels returns the labels to prepare the snapshot of layers[0],
+// which asks the remote snapshotter to mount the layer.
+func lazyLayerLabels(ref string, chainID digest.Digest, layers []ocispec.Descriptor) map[string]string {
+	var digests string
+	for _, layer := range layers {
+		item := layer.Digest.String()
+		if digests != "" {
+			item = "," + item
+		}
+		if len(digests)+len(item) > maxImageLayersLabelSize {
+			break
+		}
+		digests += item
+	}
+
+	return map[string]string{
+		targetSnapshotLabel:    chainID.String(),
+		targetRefLabel:         ref,
+		targetDigestLabel:      layers[0].Digest.String(),
+		targetImageLayersLabel: digests,
+	}
+}
+
+// FetchImageLazily fetches the manifest and config of image, and prepares
+// the snapshots of its layers in the remote snapshotter, the eStargz and
+// zstd:chunked layers are mounted from remote so that the container starts
+// before the whole image is downloaded, and the other layers are fetched
+// and applied as usual.
+func (c *Client) FetchImageLazily(ctx context.Context, resolver remotes.Resolver, ref, snapshotter string, stream *jsonstream.JSONStream) (containerd.Image, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	ctx, done, err := wrapperCli.client.WithLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done(ctx)
+
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve reference %q", ref)
+	}
+	if desc.MediaType == ctrdmetaimages.MediaTypeDockerSchema1Manifest {
+		return nil, ErrLazyPullUnsupported
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get fetcher for %q", name)
+	}
+
+	var (
+		cs       = wrapperCli.client.ContentStore()
+		platform = platforms.Default()
+	)
+
+	children := ctrdmetaimages.SetChildrenLabels(cs, ctrdmetaimages.ChildrenHandler(cs))
+	children = ctrdmetaimages.FilterPlatforms(children, platform)
+	children = ctrdmetaimages.LimitManifests(children, platform, 1)
+	if err := ctrdmetaimages.Dispatch(ctx, ctrdmetaimages.Handlers(remotes.FetchHandler(cs, fetcher), skipLayers(children)), desc); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch image manifest")
+	}
+
+	img := ctrdmetaimages.Image{
+		Name:   name,
+		Target: desc,
+		Labels: map[string]string{LabelLazySnapshotter: snapshotter},
+	}
+
+	manifest, err := ctrdmetaimages.Manifest(ctx, cs, desc, platform)
+	if err != nil {
+		return nil, err
+	}
+	lazy := false
+	for _, layer := range manifest.Layers {
+		lazy = lazy || isLazyLayer(layer)
+	}
+	if !lazy {
+		return nil, ErrLazyPullUnsupported
+	}
+
+	diffIDs, err := img.RootFS(ctx, cs, platform)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve rootfs")
+	}
+	if len(diffIDs) != len(manifest.Layers) {
+		return nil, errors.Errorf("mismatched image rootfs and manifest layers")
+	}
+
+	if err := c.prepareLazyLayers(ctx, wrapperCli, fetcher, name, snapshotter, manifest.Layers, diffIDs, stream); err != nil {
+		return nil, err
+	}
+
+	// keep the snapshots referenced by the image config, as unpack does.
+	config, err := img.Config(ctx, cs, platform)
+	if err != nil {
+		return nil, err
+	}
+	cinfo := content.Info{
+		Digest: config.Digest,
+		Labels: map[string]string{
+			fmt.Sprintf("containerd.io/gc.ref.snapshot.%s", snapshotter): identity.ChainID(diffIDs).String(),
+		},
+	}
+	if _, err := cs.Update(ctx, cinfo, fmt.Sprintf("labels.containerd.io/gc.ref.snapshot.%s", snapshotter)); err != nil {
+		return nil, err
+	}
+
+	is := wrapperCli.client.ImageService()
+	created, err := is.Create(ctx, img)
+	if errdefs.IsAlreadyExists(err) {
+		created, err = is.Update(ctx, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.With(ctx).Infof("success to lazily pull image %s into snapshotter %s", name, snapshotter)
+	return containerd.NewImageWithPlatform(wrapperCli.client, created, platform), nil
+}
+
+// prepareLazyLayers prepares the snapshots of layers in order, the layer
+// is mounted by the remote snapshotter if it reports the target snapshot
+// already exists, otherwise the layer is fetched and applied.
+func (c *Client) prepareLazyLayers(ctx context.Context, wrapperCli *WrapperClient, fetcher remotes.Fetcher, ref, snapshotter string, layers []ocispec.Descriptor, diffIDs []digest.Digest, stream *jsonstream.JSONStream) error {
+	var (
+		sn       = wrapperCli.client.SnapshotService(snapshotter)
+		cs       = wrapperCli.client.ContentStore()
+		applier  = wrapperCli.client.DiffService()
+		chainIDs = identity.ChainIDs(append([]digest.Digest{}, diffIDs...))
+	)
+	defer sn.Close()
+
+	for i, layer := range layers {
+		msg := jsonstream.JSONMessage{
+			ID:     remotes.MakeRefKey(ctx, layer),
+			Status: jsonstream.PullStatusExists,
+		}
+
+		chainID := chainIDs[i]
+		if _, err := sn.Stat(ctx, chainID.String()); err == nil {
+			stream.WriteObject(msg)
+			continue
+		} else if !errdefs.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to stat snapshot %s", chainID)
+		}
+
+		if isLazyLayer(layer) {
+			mounted, err := prepareRemoteSnapshot(ctx, sn, ref, chainIDs[:i+1], layers[i:])
+			if err != nil {
+				return err
+			}
+			if mounted {
+				msg.Status = jsonstream.PullStatusMounted
+				stream.WriteObject(msg)
+				continue
+			}
+			log.With(ctx).Warnf("layer %s of image %s is not mounted by snapshotter %s, fetch it", layer.Digest, ref, snapshotter)
+		}
+
+		if _, err := remotes.FetchHandler(cs, fetcher)(ctx, layer); err != nil {
+			return errors.Wrapf(err, "failed to fetch layer %s", layer.Digest)
+		}
+		if _, err := rootfs.ApplyLayer(ctx, rootfs.Layer{
+			Blob: layer,
+			Diff: ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayer, Digest: diffIDs[i]},
+		}, diffIDs[:i], sn, applier); err != nil {
+			return errors.Wrapf(err, "failed to apply layer %s", layer.Digest)
+		}
+
+		msg.Status = jsonstream.PullStatusExtracted
+		msg.Detail = &jsonstream.ProgressDetail{Current: layer.Size, Total: layer.Size}
+		stream.WriteObject(msg)
+	}
+	return nil
+}
+
+// prepareRemoteSnapshot asks the remote snapshotter to mount the last layer
+// of chain, it returns false if the snapshotter doesn't mount it.
+func prepareRemoteSnapshot(ctx context.Context, sn snapshots.Snapshotter, ref string, chainIDs []digest.Digest, layers []ocispec.Descriptor) (bool, error) {
+	var (
+		chainID = chainIDs[len(chainIDs)-1]
+		parent  string
+		key     = fmt.Sprintf("extract-%d-%s", time.Now().UnixNano(), chainID)
+	)
+	if len(chainIDs) > 1 {
+		parent = chainIDs[len(chainIDs)-2].String()
+	}
+
+	_, err := sn.Prepare(ctx, key, parent, snapshots.WithLabels(lazyLayerLabels(ref, chainID, layers)))
+	if err == nil {
+		// the snapshotter prepares an active snapshot instead, the
+		// layer should be applied into it as usual.
+		return false, sn.Remove(ctx, key)
+	}
+	if !errdefs.IsAlreadyExists(err) {
+		return false, errors.Wrapf(err, "failed to prepare remote snapshot %s", chainID)
+	}
+
+	if _, err := sn.Stat(ctx, chainID.String()); err != nil {
+		return false, errors.Wrapf(err, "failed to stat remote snapshot %s", chainID)
+	}
+	return true, nil
+}
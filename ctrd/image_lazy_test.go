@@ -0,0 +1,67 @@
+package ctrd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLazyLayer(t *testing.T) {
+	assert.False(t, isLazyLayer(ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip}))
+	assert.True(t, isLazyLayer(ocispec.Descriptor{
+		MediaType:   ocispec.MediaTypeImageLayerGzip,
+		Annotations: map[string]string{stargzTOCDigestAnnotation: "sha256:toc"},
+	}))
+	assert.True(t, isLazyLayer(ocispec.Descriptor{
+		MediaType:   "application/vnd.oci.image.layer.v1.tar+zstd",
+		Annotations: map[string]string{zstdChunkedManifestAnnotation: "sha256:manifest"},
+	}))
+}
+
+func TestSkipLayers(t *testing.T) {
+	children := []ocispec.Descriptor{
+		{MediaType: ocispec.MediaTypeImageConfig},
+		{MediaType: ocispec.MediaTypeImageLayerGzip},
+		{MediaType: ctrdmetaimages.MediaTypeDockerSchema2LayerGzip},
+		{MediaType: ctrdmetaimages.MediaTypeDockerSchema2Config},
+		{MediaType: "application/vnd.oci.image.layer.v1.tar+zstd"},
+	}
+	handler := skipLayers(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		return children, nil
+	})
+
+	descs, err := handler(context.Background(), ocispec.Descriptor{})
+	assert.NoError(t, err)
+	assert.Equal(t, []ocispec.Descriptor{
+		{MediaType: ocispec.MediaTypeImageConfig},
+		{MediaType: ctrdmetaimages.MediaTypeDockerSchema2Config},
+	}, descs)
+}
+
+func TestLazyLayerLabels(t *testing.T) {
+	var layers []ocispec.Descriptor
+	for i := 0; i < 100; i++ {
+		layers = append(layers, ocispec.Descriptor{Digest: digest.FromString(strings.Repeat("l", i))})
+	}
+	chainID := digest.FromString("chain")
+
+	labels := lazyLayerLabels("docker.io/library/busybox:latest", chainID, layers[:2])
+	assert.Equal(t, map[string]string{
+		targetSnapshotLabel:    chainID.String(),
+		targetRefLabel:         "docker.io/library/busybox:latest",
+		targetDigestLabel:      layers[0].Digest.String(),
+		targetImageLayersLabel: layers[0].Digest.String() + "," + layers[1].Digest.String(),
+	}, labels)
+
+	// the digests of layers are truncated to limit the size of label.
+	labels = lazyLayerLabels("docker.io/library/busybox:latest", chainID, layers)
+	digests := strings.Split(labels[targetImageLayersLabel], ",")
+	assert.True(t, len(labels[targetImageLayersLabel]) <= maxImageLayersLabelSize)
+	assert.Equal(t, maxImageLayersLabelSize/(len(layers[0].Digest.String())+1), len(digests))
+	assert.Equal(t, layers[len(digests)-1].Digest.String(), digests[len(digests)-1])
+}
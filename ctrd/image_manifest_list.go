@@ -0,0 +1,164 @@
+package ctrd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// manifestList is the docker manifest list or OCI image index.
+type manifestList struct {
+	specs.Versioned
+
+	MediaType string               `json:"mediaType,omitempty"`
+	Manifests []ocispec.Descriptor `json:"manifests"`
+}
+
+// manifestListChildren returns the platform specific manifests of images,
+// the manifests of index are flattened into the list.
+func manifestListChildren(ctx context.Context, cs content.Provider, targets []ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+	var (
+		manifests []ocispec.Descriptor
+		seen      = map[digest.Digest]bool{}
+	)
+
+	add := func(desc ocispec.Descriptor) {
+		if !seen[desc.Digest] {
+			seen[desc.Digest] = true
+			manifests = append(manifests, desc)
+		}
+	}
+
+	for _, target := range targets {
+		switch target.MediaType {
+		case ctrdmetaimages.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest:
+			platforms, err := ctrdmetaimages.Platforms(ctx, cs, target)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve platform of manifest %s", target.Digest)
+			}
+			desc := ocispec.Descriptor{
+				MediaType: target.MediaType,
+				Digest:    target.Digest,
+				Size:      target.Size,
+			}
+			if len(platforms) > 0 {
+				desc.Platform = &platforms[0]
+			}
+			add(desc)
+		case ctrdmetaimages.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+			data, err := content.ReadBlob(ctx, cs, target)
+			if err != nil {
+				return nil, err
+			}
+			var idx ocispec.Index
+			if err := json.Unmarshal(data, &idx); err != nil {
+				return nil, err
+			}
+			for _, desc := range idx.Manifests {
+				add(desc)
+			}
+		default:
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unsupported manifest media type %s", target.MediaType)
+		}
+	}
+	return manifests, nil
+}
+
+// newManifestList returns the manifest list of manifests, the docker
+// manifest list is used if all the manifests are docker ones, otherwise
+// the OCI image index is used.
+func newManifestList(manifests []ocispec.Descriptor) ([]byte, string, error) {
+	mediaType := ctrdmetaimages.MediaTypeDockerSchema2ManifestList
+	for _, desc := range manifests {
+		if desc.MediaType != ctrdmetaimages.MediaTypeDockerSchema2Manifest {
+			mediaType = ocispec.MediaTypeImageIndex
+			break
+		}
+	}
+
+	list := manifestList{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Manifests: manifests,
+	}
+	if mediaType == ctrdmetaimages.MediaTypeDockerSchema2ManifestList {
+		list.MediaType = mediaType
+	}
+
+	data, err := json.Marshal(list)
+	return data, mediaType, err
+}
+
+// CreateManifestList creates the manifest list named by name from the
+// manifests of images, so that the images of different platforms can be
+// pushed as one image.
+func (c *Client) CreateManifestList(ctx context.Context, name string, refs []string) (containerd.Image, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	ctx, done, err := wrapperCli.client.WithLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done(ctx)
+
+	var targets []ocispec.Descriptor
+	for _, ref := range refs {
+		img, err := wrapperCli.client.GetImage(ctx, ref)
+		if err != nil {
+			return nil, convertCtrdErr(err)
+		}
+		targets = append(targets, img.Target())
+	}
+
+	cs := wrapperCli.client.ContentStore()
+	manifests, err := manifestListChildren(ctx, cs, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	data, mediaType, err := newManifestList(manifests)
+	if err != nil {
+		return nil, err
+	}
+	desc := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+
+	// the manifests are referenced by the list, so that they are kept
+	// by garbage collection.
+	labels := make(map[string]string, len(manifests))
+	for i, m := range manifests {
+		labels[fmt.Sprintf("containerd.io/gc.ref.content.m.%d", i)] = m.Digest.String()
+	}
+	if err := content.WriteBlob(ctx, cs, "manifest-list-"+desc.Digest.String(), bytes.NewReader(data), desc, content.WithLabels(labels)); err != nil {
+		return nil, errors.Wrap(err, "failed to write manifest list")
+	}
+
+	img := ctrdmetaimages.Image{
+		Name:   name,
+		Target: desc,
+	}
+	is := wrapperCli.client.ImageService()
+	created, err := is.Create(ctx, img)
+	if errdefs.IsAlreadyExists(err) {
+		created, err = is.Update(ctx, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return containerd.NewImage(wrapperCli.client, created), nil
+}
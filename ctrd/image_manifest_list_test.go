@@ -0,0 +1,66 @@
+package ctrd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func addManifest(store memoryProvider, mediaType, arch string) ocispec.Descriptor {
+	config := store.add(ocispec.MediaTypeImageConfig, []byte(`{"architecture":"`+arch+`","os":"linux"}`))
+	manifest, _ := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Config:    config,
+		Layers:    []ocispec.Descriptor{store.add(ocispec.MediaTypeImageLayerGzip, []byte(arch))},
+	})
+	return store.add(mediaType, manifest)
+}
+
+func TestManifestListChildren(t *testing.T) {
+	store := memoryProvider{}
+	amd64 := addManifest(store, ctrdmetaimages.MediaTypeDockerSchema2Manifest, "amd64")
+	arm64 := addManifest(store, ctrdmetaimages.MediaTypeDockerSchema2Manifest, "arm64")
+	ppc64le := addManifest(store, ctrdmetaimages.MediaTypeDockerSchema2Manifest, "ppc64le")
+	ppc64le.Platform = &ocispec.Platform{OS: "linux", Architecture: "ppc64le"}
+
+	index, _ := json.Marshal(ocispec.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Manifests: []ocispec.Descriptor{ppc64le},
+	})
+	indexDesc := store.add(ocispec.MediaTypeImageIndex, index)
+
+	manifests, err := manifestListChildren(context.Background(), store, []ocispec.Descriptor{amd64, arm64, amd64, indexDesc})
+	assert.NoError(t, err)
+	assert.Len(t, manifests, 3)
+	assert.Equal(t, "amd64", manifests[0].Platform.Architecture)
+	assert.Equal(t, "arm64", manifests[1].Platform.Architecture)
+	assert.Equal(t, ppc64le, manifests[2])
+
+	_, err = manifestListChildren(context.Background(), store, []ocispec.Descriptor{{MediaType: ocispec.MediaTypeImageLayerGzip}})
+	assert.Error(t, err)
+}
+
+func TestNewManifestList(t *testing.T) {
+	docker := ocispec.Descriptor{MediaType: ctrdmetaimages.MediaTypeDockerSchema2Manifest}
+	oci := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageManifest}
+
+	data, mediaType, err := newManifestList([]ocispec.Descriptor{docker, docker})
+	assert.NoError(t, err)
+	assert.Equal(t, ctrdmetaimages.MediaTypeDockerSchema2ManifestList, mediaType)
+
+	var list manifestList
+	assert.NoError(t, json.Unmarshal(data, &list))
+	assert.Equal(t, 2, list.SchemaVersion)
+	assert.Equal(t, ctrdmetaimages.MediaTypeDockerSchema2ManifestList, list.MediaType)
+	assert.Len(t, list.Manifests, 2)
+
+	data, mediaType, err = newManifestList([]ocispec.Descriptor{docker, oci})
+	assert.NoError(t, err)
+	assert.Equal(t, ocispec.MediaTypeImageIndex, mediaType)
+	assert.NotContains(t, string(data), "mediaType\":\""+ocispec.MediaTypeImageIndex)
+}
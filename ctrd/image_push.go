@@ -0,0 +1,115 @@
+package ctrd
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/content"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// maxPushRetries is the max number of retries to push a blob or manifest.
+const maxPushRetries = 3
+
+// pushRetryInterval is the interval before the first retry, the interval
+// grows with the number of retries.
+var pushRetryInterval = time.Second
+
+// isRetryablePushError returns whether the push is retried on the error,
+// the push is not retried if it is canceled or not authorized.
+func isRetryablePushError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Cause(err) == docker.ErrInvalidAuthorization {
+		return false
+	}
+
+	msg := err.Error()
+	for _, status := range []string{"400 Bad Request", "401 Unauthorized", "403 Forbidden", "404 Not Found"} {
+		if strings.Contains(msg, status) {
+			return false
+		}
+	}
+	return true
+}
+
+// retryPushHandler retries the push of content on failure, the upload of
+// the content restarts from the beginning on retry.
+func retryPushHandler(push ctrdmetaimages.HandlerFunc, tracker docker.StatusTracker) ctrdmetaimages.HandlerFunc {
+	return func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		for i := 0; ; i++ {
+			children, err := push(ctx, desc)
+			if err == nil || i >= maxPushRetries || !isRetryablePushError(ctx, err) {
+				return children, err
+			}
+
+			ref := remotes.MakeRefKey(ctx, desc)
+			log.With(ctx).Warnf("failed to push %s, retry %d/%d: %v", ref, i+1, maxPushRetries, err)
+
+			// reset the status, otherwise the pusher regards the
+			// content as pushed if it fails on commit.
+			tracker.SetStatus(ref, docker.Status{
+				Status: content.Status{
+					Ref:      ref,
+					Total:    desc.Size,
+					Expected: desc.Digest,
+				},
+			})
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(i+1) * pushRetryInterval):
+			}
+		}
+	}
+}
+
+// pushContent pushes the content of desc and its children for all the
+// platforms, it is the same as remotes.PushContent except that the push of
+// each blob and manifest is retried on failure.
+func pushContent(ctx context.Context, pusher remotes.Pusher, desc ocispec.Descriptor, provider content.Provider, tracker docker.StatusTracker, baseHandlers ...ctrdmetaimages.Handler) error {
+	var (
+		mu            sync.Mutex
+		manifestStack []ocispec.Descriptor
+	)
+
+	// the manifests are pushed after their children.
+	filterHandler := ctrdmetaimages.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		switch desc.MediaType {
+		case ctrdmetaimages.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest,
+			ctrdmetaimages.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+			mu.Lock()
+			manifestStack = append(manifestStack, desc)
+			mu.Unlock()
+			return nil, ctrdmetaimages.ErrStopHandler
+		default:
+			return nil, nil
+		}
+	})
+
+	pushHandler := retryPushHandler(remotes.PushHandler(pusher, provider), tracker)
+
+	handlers := append(baseHandlers,
+		ctrdmetaimages.FilterPlatforms(ctrdmetaimages.ChildrenHandler(provider), platforms.All),
+		filterHandler,
+		pushHandler,
+	)
+	if err := ctrdmetaimages.Dispatch(ctx, ctrdmetaimages.Handlers(handlers...), desc); err != nil {
+		return err
+	}
+
+	for i := len(manifestStack) - 1; i >= 0; i-- {
+		if _, err := pushHandler(ctx, manifestStack[i]); err != nil {
+			return errors.Wrapf(err, "failed to push manifest %s", manifestStack[i].Digest)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package ctrd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPushHandler(t *testing.T) {
+	interval := pushRetryInterval
+	pushRetryInterval = time.Millisecond
+	defer func() { pushRetryInterval = interval }()
+
+	ctx := context.Background()
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayerGzip,
+		Digest:    digest.FromString("layer"),
+		Size:      5,
+	}
+	ref := remotes.MakeRefKey(ctx, desc)
+
+	for _, tc := range []struct {
+		name     string
+		failures int
+		err      error
+		calls    int
+		hasError bool
+	}{
+		{name: "no failure", failures: 0, err: errors.New("connection reset by peer"), calls: 1},
+		{name: "transient failure", failures: 2, err: errors.New("connection reset by peer"), calls: 3},
+		{name: "too many failures", failures: maxPushRetries + 1, err: errors.New("connection reset by peer"), calls: maxPushRetries + 1, hasError: true},
+		{name: "unauthorized", failures: 1, err: errors.New("unexpected status: 401 Unauthorized"), calls: 1, hasError: true},
+		{name: "invalid authorization", failures: 1, err: docker.ErrInvalidAuthorization, calls: 1, hasError: true},
+	} {
+		tracker := docker.NewInMemoryTracker()
+		calls := 0
+		push := func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+			calls++
+			// the pusher regards the content as pushed if the offset
+			// reaches the total, which should be reset on retry.
+			tracker.SetStatus(ref, docker.Status{Status: content.Status{Ref: ref, Offset: desc.Size, Total: desc.Size}})
+			if calls <= tc.failures {
+				return nil, tc.err
+			}
+			return nil, nil
+		}
+
+		_, err := retryPushHandler(push, tracker)(ctx, desc)
+		assert.Equal(t, tc.hasError, err != nil, tc.name)
+		assert.Equal(t, tc.calls, calls, tc.name)
+	}
+}
+
+func TestRetryPushHandlerResetStatus(t *testing.T) {
+	interval := pushRetryInterval
+	pushRetryInterval = time.Millisecond
+	defer func() { pushRetryInterval = interval }()
+
+	ctx := context.Background()
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayerGzip,
+		Digest:    digest.FromString("layer"),
+		Size:      5,
+	}
+	ref := remotes.MakeRefKey(ctx, desc)
+
+	tracker := docker.NewInMemoryTracker()
+	var offsets []int64
+	push := func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		status, err := tracker.GetStatus(ref)
+		if err == nil {
+			offsets = append(offsets, status.Offset)
+		}
+		tracker.SetStatus(ref, docker.Status{Status: content.Status{Ref: ref, Offset: 3, Total: desc.Size}})
+		if len(offsets) < 2 {
+			return nil, errors.New("connection reset by peer")
+		}
+		return nil, nil
+	}
+
+	_, err := retryPushHandler(push, tracker)(ctx, desc)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 0}, offsets)
+}
+
+func TestRetryPushHandlerCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	push := func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		calls++
+		return nil, ctx.Err()
+	}
+
+	_, err := retryPushHandler(push, docker.NewInMemoryTracker())(ctx, ocispec.Descriptor{})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,95 @@
+package ctrd
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// maxBandwidthBurst limits the bytes read at once from throttled fetcher.
+const maxBandwidthBurst = 1 << 20
+
+// newBandwidthLimiter returns the limiter of bandwidth in bytes per second,
+// it returns nil if there is no limit.
+func newBandwidthLimiter(bandwidth int64) *rate.Limiter {
+	if bandwidth <= 0 {
+		return nil
+	}
+
+	burst := bandwidth
+	if burst > maxBandwidthBurst {
+		burst = maxBandwidthBurst
+	}
+	return rate.NewLimiter(rate.Limit(bandwidth), int(burst))
+}
+
+// throttledFetcher limits the concurrent downloads and the bandwidth of
+// fetcher, the download holds the slot of concurrency until it is closed.
+type throttledFetcher struct {
+	fetcher remotes.Fetcher
+	slots   *semaphore.Weighted
+	limiter *rate.Limiter
+}
+
+// Fetch fetches the content of descriptor once there is a free slot.
+func (f *throttledFetcher) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	release := func() {}
+	if f.slots != nil {
+		if err := f.slots.Acquire(ctx, 1); err != nil {
+			return nil, err
+		}
+		release = func() { f.slots.Release(1) }
+	}
+
+	rc, err := f.fetcher.Fetch(ctx, desc)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &throttledReader{
+		ctx:     ctx,
+		rc:      rc,
+		limiter: f.limiter,
+		release: release,
+	}, nil
+}
+
+// throttledReader limits the bandwidth of reader, and releases the slot of
+// download when it is closed.
+type throttledReader struct {
+	ctx     context.Context
+	rc      io.ReadCloser
+	limiter *rate.Limiter
+
+	once    sync.Once
+	release func()
+}
+
+// Read reads the content after the bandwidth allows.
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if r.limiter == nil {
+		return r.rc.Read(p)
+	}
+
+	if len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+	n, err := r.rc.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// Close closes the reader and releases the slot of download.
+func (r *throttledReader) Close() error {
+	r.once.Do(r.release)
+	return r.rc.Close()
+}
@@ -0,0 +1,100 @@
+package ctrd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sync/semaphore"
+)
+
+// fakeFetcher records the number of the downloads not closed.
+type fakeFetcher struct {
+	data    []byte
+	active  int32
+	maxSeen int32
+}
+
+type fakeReader struct {
+	io.Reader
+	f *fakeFetcher
+}
+
+func (r *fakeReader) Close() error {
+	atomic.AddInt32(&r.f.active, -1)
+	return nil
+}
+
+func (f *fakeFetcher) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	active := atomic.AddInt32(&f.active, 1)
+	for {
+		seen := atomic.LoadInt32(&f.maxSeen)
+		if active <= seen || atomic.CompareAndSwapInt32(&f.maxSeen, seen, active) {
+			break
+		}
+	}
+	return &fakeReader{Reader: bytes.NewReader(f.data), f: f}, nil
+}
+
+func TestThrottledFetcherConcurrency(t *testing.T) {
+	fetcher := &fakeFetcher{data: []byte("layer")}
+	throttled := &throttledFetcher{fetcher: fetcher, slots: semaphore.NewWeighted(2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			rc, err := throttled.Fetch(context.Background(), ocispec.Descriptor{})
+			assert.NoError(t, err)
+			time.Sleep(20 * time.Millisecond)
+			data, err := ioutil.ReadAll(rc)
+			assert.NoError(t, err)
+			assert.Equal(t, "layer", string(data))
+			assert.NoError(t, rc.Close())
+			// close again should not release the slot twice.
+			rc.Close()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetcher.maxSeen))
+
+	// the slot is not available when ctx is canceled.
+	for i := 0; i < 2; i++ {
+		_, err := throttled.Fetch(context.Background(), ocispec.Descriptor{})
+		assert.NoError(t, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := throttled.Fetch(ctx, ocispec.Descriptor{})
+	assert.Error(t, err)
+}
+
+func TestThrottledFetcherBandwidth(t *testing.T) {
+	assert.Nil(t, newBandwidthLimiter(0))
+	assert.Equal(t, maxBandwidthBurst, newBandwidthLimiter(100<<20).Burst())
+
+	bandwidth := int64(64 << 10)
+	fetcher := &fakeFetcher{data: make([]byte, bandwidth*3/2)}
+	throttled := &throttledFetcher{fetcher: fetcher, limiter: newBandwidthLimiter(bandwidth)}
+
+	start := time.Now()
+	rc, err := throttled.Fetch(context.Background(), ocispec.Descriptor{})
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.NoError(t, rc.Close())
+
+	assert.Equal(t, len(fetcher.data), len(data))
+	// the burst is consumed at once, and the rest half takes half second.
+	assert.True(t, time.Since(start) >= 400*time.Millisecond, "elapsed %v", time.Since(start))
+}
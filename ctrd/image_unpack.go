@@ -0,0 +1,160 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/jsonstream"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+)
+
+// unpackLayer is the layer to unpack, keyed by the same key as its download
+// progress so that the client updates the progress of layer in place.
+type unpackLayer struct {
+	key     string
+	size    int64
+	chainID digest.Digest
+}
+
+// UnpackImage unpacks the image into snapshotter, and sends the extract
+// progress of layers to client via stream.
+func (c *Client) UnpackImage(ctx context.Context, img containerd.Image, snapshotter string, stream *jsonstream.JSONStream) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	// the wasm modules are loaded by the wasm shims from content store.
+	wasm, err := isWasmImage(ctx, wrapperCli, img)
+	if err != nil {
+		return err
+	}
+	if wasm {
+		log.With(ctx).Infof("skip unpacking wasm image %s", img.Name())
+		return nil
+	}
+
+	layers, err := getUnpackLayers(ctx, wrapperCli, img)
+	if err != nil {
+		return err
+	}
+
+	stop := make(chan struct{})
+	wait := make(chan struct{})
+	go func() {
+		c.unpackProgress(ctx, stop, wrapperCli, snapshotter, layers, stream)
+		close(wait)
+	}()
+
+	err = img.Unpack(ctx, snapshotter)
+
+	// stop unpack progress and wait it to send the final status.
+	close(stop)
+	<-wait
+
+	return err
+}
+
+// getUnpackLayers returns the layers of image with the chain ids of their
+// snapshots.
+func getUnpackLayers(ctx context.Context, wrapperCli *WrapperClient, img containerd.Image) ([]unpackLayer, error) {
+	manifest, err := ctrdmetaimages.Manifest(ctx, wrapperCli.client.ContentStore(), img.Target(), platforms.Default())
+	if err != nil {
+		return nil, err
+	}
+
+	diffIDs, err := img.RootFS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(diffIDs) != len(manifest.Layers) {
+		return nil, fmt.Errorf("mismatched image rootfs and manifest layers")
+	}
+
+	chainIDs := identity.ChainIDs(diffIDs)
+	layers := make([]unpackLayer, 0, len(manifest.Layers))
+	for i, desc := range manifest.Layers {
+		layers = append(layers, unpackLayer{
+			key:     remotes.MakeRefKey(ctx, desc),
+			size:    desc.Size,
+			chainID: chainIDs[i],
+		})
+	}
+	return layers, nil
+}
+
+// unpackProgress sends the extract progress of layers until stop is closed,
+// the layer is extracted once its snapshot is committed.
+func (c *Client) unpackProgress(ctx context.Context, stop <-chan struct{}, wrapperCli *WrapperClient, snapshotter string, layers []unpackLayer, stream *jsonstream.JSONStream) {
+	var (
+		ticker = time.NewTicker(300 * time.Millisecond)
+		sn     = wrapperCli.client.SnapshotService(snapshotter)
+		done   bool
+	)
+	defer ticker.Stop()
+	defer sn.Close()
+
+	// the committed snapshots are cached to avoid stating them again.
+	committedSnapshots := map[digest.Digest]bool{}
+	committed := func(chainID digest.Digest) bool {
+		if committedSnapshots[chainID] {
+			return true
+		}
+		_, err := sn.Stat(ctx, chainID.String())
+		if err != nil && !errdefs.IsNotFound(err) {
+			log.With(nil).Errorf("failed to stat snapshot %s: %v", chainID, err)
+		}
+		committedSnapshots[chainID] = err == nil
+		return err == nil
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			done = true // allow ui to update once more
+		}
+
+		for _, msg := range unpackStatus(layers, committed) {
+			stream.WriteObject(msg)
+		}
+		if done {
+			return
+		}
+	}
+}
+
+// unpackStatus returns the extract progress of layers, the layers are
+// extracted in order, so the first layer without committed snapshot is the
+// extracting one, and the ones after it keep their download progress.
+func unpackStatus(layers []unpackLayer, committed func(digest.Digest) bool) []jsonstream.JSONMessage {
+	var msgs []jsonstream.JSONMessage
+	for _, layer := range layers {
+		status := jsonstream.PullStatusExtracted
+		if !committed(layer.chainID) {
+			status = jsonstream.PullStatusExtracting
+		}
+
+		msgs = append(msgs, jsonstream.JSONMessage{
+			ID:     layer.key,
+			Status: status,
+			Detail: &jsonstream.ProgressDetail{
+				Current: layer.size,
+				Total:   layer.size,
+			},
+		})
+		if status == jsonstream.PullStatusExtracting {
+			break
+		}
+	}
+	return msgs
+}
@@ -0,0 +1,44 @@
+package ctrd
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/jsonstream"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnpackStatus(t *testing.T) {
+	layers := []unpackLayer{
+		{key: "layer-sha256:1", size: 10, chainID: digest.FromString("1")},
+		{key: "layer-sha256:2", size: 20, chainID: digest.FromString("2")},
+		{key: "layer-sha256:3", size: 30, chainID: digest.FromString("3")},
+	}
+
+	for _, tc := range []struct {
+		committed int
+		expect    []string
+	}{
+		{committed: 0, expect: []string{jsonstream.PullStatusExtracting}},
+		{committed: 1, expect: []string{jsonstream.PullStatusExtracted, jsonstream.PullStatusExtracting}},
+		{committed: 3, expect: []string{jsonstream.PullStatusExtracted, jsonstream.PullStatusExtracted, jsonstream.PullStatusExtracted}},
+	} {
+		committed := map[digest.Digest]bool{}
+		for _, layer := range layers[:tc.committed] {
+			committed[layer.chainID] = true
+		}
+
+		msgs := unpackStatus(layers, func(chainID digest.Digest) bool {
+			return committed[chainID]
+		})
+		assert.Equal(t, len(tc.expect), len(msgs))
+		for i, msg := range msgs {
+			assert.Equal(t, layers[i].key, msg.ID)
+			assert.Equal(t, tc.expect[i], msg.Status)
+			// the size of layer is kept for the total progress of client.
+			assert.Equal(t, layers[i].size, msg.Detail.Current)
+			assert.Equal(t, layers[i].size, msg.Detail.Total)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ImageContentUsage returns the size of blobs in content store referenced by
+// all the images. The blobs which are not fetched, such as the layers of
+// lazily pulled images, are not included.
+func (c *Client) ImageContentUsage(ctx context.Context) (map[digest.Digest]int64, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	imgs, err := wrapperCli.client.ImageService().List(ctx)
+	if err != nil {
+		return nil, convertCtrdErr(err)
+	}
+
+	cs := wrapperCli.client.ContentStore()
+	usage := map[digest.Digest]int64{}
+	for _, img := range imgs {
+		if err := walkImageContent(ctx, cs, img.Target, usage); err != nil {
+			return nil, err
+		}
+	}
+	return usage, nil
+}
+
+// ImagePulledTimes returns when the image records are last updated in
+// containerd by name, that is, when the references are pulled, tagged or
+// imported. Unlike the created time in image config, it is refreshed if the
+// same reference is pulled again.
+func (c *Client) ImagePulledTimes(ctx context.Context) (map[string]time.Time, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	imgs, err := wrapperCli.client.ImageService().List(ctx)
+	if err != nil {
+		return nil, convertCtrdErr(err)
+	}
+
+	pulled := make(map[string]time.Time, len(imgs))
+	for _, img := range imgs {
+		pulled[img.Name] = img.UpdatedAt
+	}
+	return pulled, nil
+}
+
+// walkImageContent records the size of blobs referenced by desc into usage,
+// the blobs are walked one by one.
+func walkImageContent(ctx context.Context, cs content.Store, desc ocispec.Descriptor, usage map[digest.Digest]int64) error {
+	handler := ctrdmetaimages.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		if _, visited := usage[desc.Digest]; visited {
+			return nil, nil
+		}
+
+		info, err := cs.Info(ctx, desc.Digest)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		usage[desc.Digest] = info.Size
+
+		return ctrdmetaimages.Children(ctx, cs, desc)
+	})
+	return ctrdmetaimages.Walk(ctx, handler, desc)
+}
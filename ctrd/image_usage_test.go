@@ -0,0 +1,56 @@
+package ctrd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryStore is the content store of memoryProvider, only Info is
+// implemented.
+type memoryStore struct {
+	content.Store
+	memoryProvider
+}
+
+func (s memoryStore) Info(ctx context.Context, dgst digest.Digest) (content.Info, error) {
+	data, ok := s.memoryProvider[dgst]
+	if !ok {
+		return content.Info{}, errdefs.ErrNotFound
+	}
+	return content.Info{Digest: dgst, Size: int64(len(data))}, nil
+}
+
+func (s memoryStore) ReaderAt(ctx context.Context, desc ocispec.Descriptor) (content.ReaderAt, error) {
+	return s.memoryProvider.ReaderAt(ctx, desc)
+}
+
+func TestWalkImageContent(t *testing.T) {
+	store := memoryProvider{}
+	config := store.add(ocispec.MediaTypeImageConfig, []byte(`{"architecture":"amd64","os":"linux"}`))
+	layer := store.add(ocispec.MediaTypeImageLayerGzip, []byte("layer"))
+	// the layer of lazily pulled image is not in content store.
+	lazyLayer := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: digest.FromString("lazy"), Size: 100}
+
+	manifest, _ := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Config:    config,
+		Layers:    []ocispec.Descriptor{layer, lazyLayer},
+	})
+	desc := store.add(ocispec.MediaTypeImageManifest, manifest)
+
+	usage := map[digest.Digest]int64{}
+	assert.NoError(t, walkImageContent(context.Background(), memoryStore{memoryProvider: store}, desc, usage))
+	assert.Equal(t, map[digest.Digest]int64{
+		desc.Digest:   desc.Size,
+		config.Digest: config.Size,
+		layer.Digest:  layer.Size,
+	}, usage)
+}
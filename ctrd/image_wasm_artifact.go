@@ -0,0 +1,70 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// MediaTypeWasmConfig is the media type of the config of wasm OCI artifacts.
+	MediaTypeWasmConfig = "application/vnd.wasm.config.v0+json"
+	// MediaTypeWasmLayer is the media type of the wasm modules in wasm OCI artifacts.
+	MediaTypeWasmLayer = "application/wasm"
+	// MediaTypeWasmComponentLayer is the media type of the wasm modules in
+	// the OCI artifacts built for the runwasi shims.
+	MediaTypeWasmComponentLayer = "application/vnd.bytecodealliance.wasm.component.layer.v0+wasm"
+)
+
+// IsWasmRuntime returns true if the runtime type is a wasm shim, which loads
+// the wasm modules from the image of container.
+func IsWasmRuntime(runtimeType string) bool {
+	switch runtimeType {
+	case RuntimeTypeV2wasmedgeV1, RuntimeTypeV2wasmtimeV1:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsWasmImage returns true if the image is a wasm OCI artifact, whose layers
+// are wasm modules rather than filesystem changesets.
+func (c *Client) IsWasmImage(ctx context.Context, ref string) (bool, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	img, err := wrapperCli.client.GetImage(ctx, ref)
+	if err != nil {
+		return false, err
+	}
+	return isWasmImage(ctx, wrapperCli, img)
+}
+
+func isWasmImage(ctx context.Context, wrapperCli *WrapperClient, img containerd.Image) (bool, error) {
+	manifest, err := ctrdmetaimages.Manifest(ctx, wrapperCli.client.ContentStore(), img.Target(), platforms.Default())
+	if err != nil {
+		return false, err
+	}
+	return isWasmManifest(manifest), nil
+}
+
+// isWasmManifest returns true if the config or any layer of manifest is of
+// the wasm media types.
+func isWasmManifest(manifest ocispec.Manifest) bool {
+	if manifest.Config.MediaType == MediaTypeWasmConfig {
+		return true
+	}
+	for _, desc := range manifest.Layers {
+		switch desc.MediaType {
+		case MediaTypeWasmLayer, MediaTypeWasmComponentLayer:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package ctrd
+
+import (
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWasmManifest(t *testing.T) {
+	assert.True(t, isWasmManifest(ocispec.Manifest{
+		Config: ocispec.Descriptor{MediaType: MediaTypeWasmConfig},
+		Layers: []ocispec.Descriptor{{MediaType: MediaTypeWasmLayer}},
+	}))
+	assert.True(t, isWasmManifest(ocispec.Manifest{
+		Config: ocispec.Descriptor{MediaType: ocispec.MediaTypeImageConfig},
+		Layers: []ocispec.Descriptor{{MediaType: MediaTypeWasmComponentLayer}},
+	}))
+	assert.False(t, isWasmManifest(ocispec.Manifest{
+		Config: ocispec.Descriptor{MediaType: ocispec.MediaTypeImageConfig},
+		Layers: []ocispec.Descriptor{{MediaType: ocispec.MediaTypeImageLayerGzip}},
+	}))
+
+	assert.True(t, IsWasmRuntime(RuntimeTypeV2wasmedgeV1))
+	assert.True(t, IsWasmRuntime(RuntimeTypeV2wasmtimeV1))
+	assert.False(t, IsWasmRuntime(RuntimeTypeV2runcV1))
+}
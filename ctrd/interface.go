@@ -3,6 +3,7 @@ package ctrd
 import (
 	"context"
 	"io"
+	"syscall"
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/containerd/containerd/remotes/docker"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
 // APIClient defines common methods of containerd api client
@@ -29,18 +31,20 @@ type APIClient interface {
 	Cleanup() error
 	Plugins(ctx context.Context, filters []string) ([]Plugin, error)
 	CheckSnapshotterValid(snapshotter string, allowMultiSnapshotter bool) error
+	SetInsecureRegistries(endpoints []string) error
 }
 
 // ContainerAPIClient provides access to containerd container features.
 type ContainerAPIClient interface {
-	// CreateContainer creates a containerd container and start process.
-	CreateContainer(ctx context.Context, container *Container, checkpointDir string) error
+	// CreateContainer creates a containerd container and start process,
+	// the task will be restored from checkpoint if restore is not nil.
+	CreateContainer(ctx context.Context, container *Container, restore *RestoreOptions) error
 	// DestroyContainer kill container and delete it.
 	DestroyContainer(ctx context.Context, id string, timeout int64) (*Message, error)
 	// ProbeContainer probe the container's status, if timeout <= 0, will block to receive message.
 	ProbeContainer(ctx context.Context, id string, timeout time.Duration) *Message
-	// ContainerPIDs returns the all processes's ids inside the container.
-	ContainerPIDs(ctx context.Context, id string) ([]int, error)
+	// ContainerProcesses returns the all processes inside the container.
+	ContainerProcesses(ctx context.Context, id string) ([]ProcessInfo, error)
 	// ContainerPID returns the container's init process id.
 	ContainerPID(ctx context.Context, id string) (int, error)
 	// ContainerStats returns stats of the container.
@@ -56,6 +60,8 @@ type ContainerAPIClient interface {
 	PauseContainer(ctx context.Context, id string) error
 	// UnpauseContainer unpauses a container.
 	UnpauseContainer(ctx context.Context, id string) error
+	// KillContainer sends the signal to the init process of container.
+	KillContainer(ctx context.Context, id string, signal syscall.Signal) error
 	// ResizeContainer changes the size of the TTY of the init process running
 	// in the container to the given height and width.
 	ResizeContainer(ctx context.Context, id string, opts types.ResizeOptions) error
@@ -63,6 +69,8 @@ type ContainerAPIClient interface {
 	WaitContainer(ctx context.Context, id string) (types.ContainerWaitOKBody, error)
 	// UpdateResources updates the configurations of a container.
 	UpdateResources(ctx context.Context, id string, resources types.Resources) error
+	// UpdateContainerLabels updates the labels of containerd container.
+	UpdateContainerLabels(ctx context.Context, id string, labels map[string]string) error
 	// SetExitHooks specified the handlers of container exit.
 	SetExitHooks(hooks ...func(string, *Message, func() error) error)
 	// SetExecExitHooks specified the handlers of exec process exit.
@@ -81,24 +89,41 @@ type ImageAPIClient interface {
 	ListImages(ctx context.Context, filter ...string) ([]containerd.Image, error)
 	// FetchImage fetches image content by the given reference.
 	FetchImage(ctx context.Context, resolver remotes.Resolver, ref string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream) (containerd.Image, error)
+	// FetchImageLazily fetches image by mounting its layers from remote in the remote snapshotter.
+	FetchImageLazily(ctx context.Context, resolver remotes.Resolver, ref, snapshotter string, stream *jsonstream.JSONStream) (containerd.Image, error)
+	// UnpackImage unpacks image into snapshotter and sends the extract progress via stream.
+	UnpackImage(ctx context.Context, img containerd.Image, snapshotter string, stream *jsonstream.JSONStream) error
+	// IsWasmImage returns true if the image is a wasm OCI artifact.
+	IsWasmImage(ctx context.Context, ref string) (bool, error)
 	// ResolveImage attempts to resolve the image reference into a available reference and resolver.
 	ResolveImage(ctx context.Context, nameRef string, refs []string, authConfig *types.AuthConfig, opts docker.ResolverOptions) (remotes.Resolver, string, error)
+	// ImageContentUsage returns the size of blobs referenced by all the images.
+	ImageContentUsage(ctx context.Context) (map[digest.Digest]int64, error)
+	// ImagePulledTimes returns when the images are pulled by name.
+	ImagePulledTimes(ctx context.Context) (map[string]time.Time, error)
 	// RemoveImage removes the image by the given reference.
 	RemoveImage(ctx context.Context, ref string) error
 	// ImportImage creates a set of images by tarstream.
 	ImportImage(ctx context.Context, reader io.Reader, opts ...containerd.ImportOpt) ([]containerd.Image, error)
+	// CreateImageFromLayer creates an image whose only layer is converted from tarball.
+	CreateImageFromLayer(ctx context.Context, ref string, tarball io.Reader, img ocispec.Image) (containerd.Image, error)
 	// SaveImage saves image to tarstream
 	SaveImage(ctx context.Context, exporter ctrdmetaimages.Exporter, ref string) (io.ReadCloser, error)
 	// Commit commits an image from a container.
 	Commit(ctx context.Context, config *CommitConfig) (digest.Digest, error)
 	// PushImage pushes a image to registry
-	PushImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error
+	PushImage(ctx context.Context, ref string, authConfig *types.AuthConfig, opts docker.ResolverOptions, out io.Writer) error
+	// CreateManifestList creates a manifest list from the manifests of images.
+	CreateManifestList(ctx context.Context, name string, refs []string) (containerd.Image, error)
 }
 
 // SnapshotAPIClient provides access to containerd snapshot features
 type SnapshotAPIClient interface {
 	// CreateSnapshot creates a active snapshot with image's name and id.
 	CreateSnapshot(ctx context.Context, id, ref string) error
+	// CreateRemappedSnapshot creates a active snapshot with image's name and
+	// id, whose files are owned by the remapped uid and gid.
+	CreateRemappedSnapshot(ctx context.Context, id, ref string, uid, gid uint32) error
 	// GetSnapshot returns the snapshot's info by id.
 	GetSnapshot(ctx context.Context, id string) (snapshots.Info, error)
 	// RemoveSnapshot removes the snapshot by id.
@@ -109,9 +134,15 @@ type SnapshotAPIClient interface {
 	// GetSnapshotUsage returns the resource usage of an active or committed snapshot
 	// excluding the usage of parent snapshots.
 	GetSnapshotUsage(ctx context.Context, id string) (snapshots.Usage, error)
+	// SnapshotChanges returns the changes of the active snapshot against its parent.
+	SnapshotChanges(ctx context.Context, id string) ([]*types.ContainerChangeResponseItem, error)
+	// ExportSnapshotDiff writes the changes of the active snapshot against its parent as a layer tar.
+	ExportSnapshotDiff(ctx context.Context, id string, w io.Writer) error
+	// ApplySnapshotDiff applies the layer tar exported by ExportSnapshotDiff to the active snapshot.
+	ApplySnapshotDiff(ctx context.Context, id string, r io.Reader) error
 	// WalkSnapshot walk all snapshots in specific snapshotter. If not set specific snapshotter,
 	// it will be set to current snapshotter. For each snapshot, the function will be called.
 	WalkSnapshot(ctx context.Context, snapshotter string, fn func(context.Context, snapshots.Info) error) error
 	// CreateCheckpoint creates a checkpoint from a running container
-	CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error
+	CreateCheckpoint(ctx context.Context, id string, checkpointDir string, options *CheckpointOptions) error
 }
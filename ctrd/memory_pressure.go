@@ -0,0 +1,276 @@
+package ctrd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/system"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// MemoryPressureAction is the action of event sent when the memory of
+	// container is under pressure.
+	MemoryPressureAction = "memory_pressure"
+
+	// MemoryPressureMedium means the container is reclaiming memory, it is
+	// throttled by the high limit of memory.
+	MemoryPressureMedium = "medium"
+
+	// MemoryPressureCritical means the container is about to hit the limit
+	// of memory, the OOM killer may be triggered soon.
+	MemoryPressureCritical = "critical"
+
+	// memoryPressureInterval is the interval to check the memory pressure,
+	// the events in the same interval are merged into one.
+	memoryPressureInterval = time.Second
+)
+
+// watchMemoryPressure watches the memory pressure of the container in
+// background, and sends the events to events hooks. It stops when the cgroup
+// of container is removed.
+func (c *Client) watchMemoryPressure(ctx context.Context, pack *containerPack) {
+	// the watcher lives longer than the request.
+	ctx = log.AddFields(context.Background(), map[string]interface{}{"ContainerID": pack.id})
+
+	go func() {
+		var err error
+		if c.cgroupVersion == system.CgroupV2 {
+			err = c.pollMemoryEvents(ctx, pack)
+		} else {
+			err = c.notifyMemoryPressure(ctx, pack)
+		}
+		if err != nil {
+			log.With(ctx).Warnf("stop watching memory pressure of container %s: %v", pack.id, err)
+		}
+	}()
+}
+
+// isWatching returns whether the pack is still the one watched, the cgroup
+// may be reused by the container started again.
+func (c *Client) isWatching(pack *containerPack) bool {
+	p, err := c.watch.get(pack.id)
+	return err == nil && p == pack
+}
+
+// publishMemoryPressure sends the memory pressure event to events hooks.
+func (c *Client) publishMemoryPressure(ctx context.Context, id, level string) {
+	c.runEventsHooks(ctx, id, MemoryPressureAction, map[string]string{"level": level})
+}
+
+// pollMemoryEvents checks the memory.events of cgroup v2 periodically, the
+// counter "high" means the container is throttled by memory.high and "max"
+// means it hits memory.max.
+func (c *Client) pollMemoryEvents(ctx context.Context, pack *containerPack) error {
+	dir, err := unifiedCgroupPath(pack.task.Pid())
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(dir, "memory.events")
+	last, err := readMemoryEvents(file)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(memoryPressureInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if !c.isWatching(pack) {
+			return nil
+		}
+
+		current, err := readMemoryEvents(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if level := memoryEventsLevel(last, current); level != "" {
+			c.publishMemoryPressure(ctx, pack.id, level)
+		}
+		last = current
+	}
+	return nil
+}
+
+// readMemoryEvents reads the counters of memory.events.
+func readMemoryEvents(file string) (map[string]uint64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	events := map[string]uint64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid line %q in %s", scanner.Text(), file)
+		}
+		events[fields[0]] = v
+	}
+	return events, scanner.Err()
+}
+
+// memoryEventsLevel returns the pressure level by the counters increased
+// since last check, it returns empty string if there is no pressure.
+func memoryEventsLevel(last, current map[string]uint64) string {
+	switch {
+	case current["max"] > last["max"]:
+		return MemoryPressureCritical
+	case current["high"] > last["high"]:
+		return MemoryPressureMedium
+	default:
+		return ""
+	}
+}
+
+// notifyMemoryPressure registers eventfds on memory.pressure_level of cgroup
+// v1, and sends the highest level notified in each interval.
+func (c *Client) notifyMemoryPressure(ctx context.Context, pack *containerPack) error {
+	root, err := memoryCgroupPath(pack.task.Pid())
+	if err != nil {
+		return err
+	}
+
+	var (
+		levels = make(chan string)
+		done   = make(chan struct{})
+	)
+	defer close(done)
+
+	efds := map[string]int{}
+	for _, level := range []string{MemoryPressureMedium, MemoryPressureCritical} {
+		efd, err := registerMemoryPressure(root, level)
+		if err != nil {
+			for _, fd := range efds {
+				unix.Close(fd)
+			}
+			return err
+		}
+		efds[level] = efd
+	}
+
+	for level, efd := range efds {
+		go func(level string, efd int) {
+			defer unix.Close(efd)
+
+			buf := make([]byte, 8)
+			for {
+				// the eventfd is also signaled when the cgroup is removed.
+				_, err := unix.Read(efd, buf)
+				if err == unix.EINTR {
+					continue
+				}
+				if err != nil || !cgroupExists(root) {
+					level = ""
+				}
+
+				select {
+				case levels <- level:
+				case <-done:
+					return
+				}
+				if level == "" {
+					return
+				}
+			}
+		}(level, efd)
+	}
+
+	ticker := time.NewTicker(memoryPressureInterval)
+	defer ticker.Stop()
+
+	pending := ""
+	for {
+		select {
+		case level := <-levels:
+			if level == "" || !c.isWatching(pack) {
+				return nil
+			}
+			if pending != MemoryPressureCritical {
+				pending = level
+			}
+		case <-ticker.C:
+			if pending != "" {
+				c.publishMemoryPressure(ctx, pack.id, pending)
+				pending = ""
+			}
+		}
+	}
+}
+
+// memoryCgroupPath returns the directory of memory cgroup v1 of the process.
+func memoryCgroupPath(pid uint32) (string, error) {
+	subsystems, err := cgroups.V1()
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range subsystems {
+		if s.Name() != cgroups.Memory {
+			continue
+		}
+
+		p, ok := s.(interface {
+			Path(path string) string
+		})
+		if !ok {
+			break
+		}
+
+		path, err := cgroups.PidPath(int(pid))(cgroups.Memory)
+		if err != nil {
+			return "", err
+		}
+		return p.Path(path), nil
+	}
+	return "", fmt.Errorf("memory cgroup is not mounted")
+}
+
+// registerMemoryPressure registers an eventfd notified when the memory
+// pressure of the cgroup reaches the level.
+func registerMemoryPressure(root, level string) (int, error) {
+	f, err := os.Open(filepath.Join(root, "memory.pressure_level"))
+	if err != nil {
+		return -1, err
+	}
+	defer f.Close()
+
+	efd, err := unix.Eventfd(0, unix.EFD_CLOEXEC)
+	if err != nil {
+		return -1, err
+	}
+
+	control := fmt.Sprintf("%d %d %s", efd, f.Fd(), level)
+	if err := writeCgroupFile(filepath.Join(root, "cgroup.event_control"), control); err != nil {
+		unix.Close(efd)
+		return -1, errors.Wrapf(err, "failed to register memory pressure level %s", level)
+	}
+	return efd, nil
+}
+
+// cgroupExists returns whether the cgroup directory still exists.
+func cgroupExists(root string) bool {
+	_, err := os.Stat(filepath.Join(root, "cgroup.event_control"))
+	return err == nil
+}
@@ -0,0 +1,44 @@
+package ctrd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadMemoryEvents(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-memory-events")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	file := filepath.Join(tmpDir, "memory.events")
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("low 0\nhigh 12\nmax 3\noom 0\noom_kill 0\n"), 0644))
+	events, err := readMemoryEvents(file)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"low": 0, "high": 12, "max": 3, "oom": 0, "oom_kill": 0}, events)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("high abc\n"), 0644))
+	_, err = readMemoryEvents(file)
+	assert.Error(t, err)
+
+	_, err = readMemoryEvents(filepath.Join(tmpDir, "none"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMemoryEventsLevel(t *testing.T) {
+	last := map[string]uint64{"high": 1, "max": 1}
+	for _, tc := range []struct {
+		current  map[string]uint64
+		expected string
+	}{
+		{map[string]uint64{"high": 1, "max": 1}, ""},
+		{map[string]uint64{"high": 2, "max": 1}, MemoryPressureMedium},
+		{map[string]uint64{"high": 1, "max": 2}, MemoryPressureCritical},
+		{map[string]uint64{"high": 5, "max": 2}, MemoryPressureCritical},
+	} {
+		assert.Equal(t, tc.expected, memoryEventsLevel(last, tc.current), "%v", tc.current)
+	}
+}
@@ -0,0 +1,101 @@
+package ctrd
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/utils/metrics"
+
+	"github.com/containerd/containerd/defaults"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/pkg/dialer"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+)
+
+const (
+	subsystemContainerd = "containerd"
+
+	lockResultAcquired = "acquired"
+	lockResultCanceled = "canceled"
+	lockResultTimeout  = "timeout"
+
+	rebindResultSuccess = "success"
+	rebindResultFailure = "failure"
+
+	restoreResultSuccess = "success"
+	restoreResultFailure = "failure"
+)
+
+var (
+	// lockWaitTimer records the time waiting for the container lock.
+	lockWaitTimer = metrics.NewLabelTimer(subsystemContainerd, "lock_wait", "The number of seconds it takes to acquire the container lock", "operation", "result")
+
+	// lockContentionCounter records the number of times the container lock is held by others.
+	lockContentionCounter = metrics.NewLabelCounter(subsystemContainerd, "lock_contention", "The number of times the container lock is held by others", "operation")
+
+	// lockWaiters records the number of callers waiting for the container lock.
+	lockWaiters = metrics.NewLabelGaugeWithUnit(subsystemContainerd, "lock", "The number of callers waiting for the container lock", metrics.Unit("waiters"))
+
+	// unhealthyClientsGauge records the number of unhealthy containerd clients in pool.
+	unhealthyClientsGauge = metrics.NewLabelGaugeWithUnit(subsystemContainerd, "unhealthy", "The number of unhealthy containerd clients in pool", metrics.Unit("clients"))
+
+	// rebindCounter records the number of times the containers are rebound to another containerd client.
+	rebindCounter = metrics.NewLabelCounter(subsystemContainerd, "container_rebind", "The number of times the containers are rebound to another containerd client", "result")
+
+	// restoreCounter records the number of times the watches are restored after containerd restarts.
+	restoreCounter = metrics.NewLabelCounter(subsystemContainerd, "restore", "The number of times the watches are restored after containerd restarts", "result")
+
+	// grpcClientMetrics records the rpc count, error codes and latency of the containerd client.
+	grpcClientMetrics = grpc_prometheus.NewClientMetrics()
+)
+
+func init() {
+	grpcClientMetrics.EnableClientHandlingTimeHistogram()
+
+	metrics.GetPrometheusRegistry().MustRegister(
+		lockWaitTimer,
+		lockContentionCounter,
+		lockWaiters,
+		unhealthyClientsGauge,
+		rebindCounter,
+		restoreCounter,
+		grpcClientMetrics,
+	)
+}
+
+// dialOptions returns the grpc dial options of containerd client, the
+// options are the same as the default ones in containerd.New, except that
+// the metrics interceptors are chained after the namespace ones.
+//
+// NOTE: the grpc client only supports one interceptor, and the namespace
+// interceptor of containerd.WithDefaultNamespace will override ours, so
+// the default namespace is set by the interceptor here.
+func dialOptions(defaultns string) []grpc.DialOption {
+	unary := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return grpcClientMetrics.UnaryClientInterceptor()(withNamespace(ctx, defaultns), method, req, reply, cc, invoker, opts...)
+	}
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return grpcClientMetrics.StreamClientInterceptor()(withNamespace(ctx, defaultns), desc, cc, method, streamer, opts...)
+	}
+
+	return []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithInsecure(),
+		grpc.FailOnNonTempDialError(true),
+		grpc.WithBackoffMaxDelay(3 * time.Second),
+		grpc.WithDialer(dialer.Dialer),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(defaults.DefaultMaxRecvMsgSize)),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(defaults.DefaultMaxSendMsgSize)),
+		grpc.WithUnaryInterceptor(unary),
+		grpc.WithStreamInterceptor(stream),
+	}
+}
+
+// withNamespace sets the default namespace if the context doesn't have one.
+func withNamespace(ctx context.Context, defaultns string) context.Context {
+	if _, ok := namespaces.Namespace(ctx); !ok {
+		ctx = namespaces.WithNamespace(ctx, defaultns)
+	}
+	return ctx
+}
@@ -0,0 +1,58 @@
+package ctrd
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/cri/annotations"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/nri"
+
+	"github.com/containerd/containerd"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// invokeNRI invokes the NRI plugins at the state of container, the spec of
+// container is passed to the plugins with the resources overridden if they
+// are not nil. Nothing is done if NRI is disabled.
+func invokeNRI(ctx context.Context, client *nri.Client, container containerd.Container, pid uint32, state nri.State, resources *specs.LinuxResources) error {
+	if client == nil {
+		return nil
+	}
+
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get spec of container")
+	}
+	if resources != nil && spec.Linux != nil {
+		spec.Linux.Resources = resources
+	}
+
+	labels, err := container.Labels(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get labels of container")
+	}
+
+	s, err := nri.NewSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Invoke(ctx, &nri.Request{
+		ID:        container.ID(),
+		SandboxID: spec.Annotations[annotations.SandboxID],
+		Pid:       int(pid),
+		State:     state,
+		Spec:      s,
+		Labels:    labels,
+	})
+	return err
+}
+
+// notifyNRI invokes the NRI plugins at the state of container which has
+// already changed, so the failure is only logged.
+func notifyNRI(ctx context.Context, client *nri.Client, pack *containerPack, state nri.State, resources *specs.LinuxResources) {
+	if err := invokeNRI(ctx, client, pack.container, pack.task.Pid(), state, resources); err != nil {
+		log.With(ctx).Warnf("failed to invoke NRI plugins at %s of container %s: %v", state, pack.id, err)
+	}
+}
@@ -0,0 +1,105 @@
+package ctrd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// RunscOptionsTypeURL is the type of the config file of the gVisor shim, the
+// shim refuses the config file of other types.
+const RunscOptionsTypeURL = "io.containerd.runsc.v1.options"
+
+// RunscDebugLogDir is the directory under the home dir of pouchd, which the
+// debug logs of runsc are only allowed to be written into.
+const RunscDebugLogDir = "runsc-logs"
+
+// RuntimeOptions is the options of the kata and gVisor shims, which read
+// their configuration files from ConfigPath. It has the same wire format and
+// name as the runtime options passed by the CRI plugin of containerd, since
+// the shims only recognize the options by name.
+type RuntimeOptions struct {
+	// TypeURL specifies the type of the content inside the config file.
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// ConfigPath specifies the filesystem location of the config file
+	// used by the runtime.
+	ConfigPath string `protobuf:"bytes,2,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
+}
+
+// Reset implements proto.Message.
+func (m *RuntimeOptions) Reset() { *m = RuntimeOptions{} }
+
+// String implements proto.Message.
+func (m *RuntimeOptions) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage implements proto.Message.
+func (*RuntimeOptions) ProtoMessage() {}
+
+func init() {
+	proto.RegisterType((*RuntimeOptions)(nil), "cri.runtimeoptions.v1.Options")
+}
+
+// RunscOptions is the options of the gVisor shim configured in daemon or per
+// container. They are the flags of runsc, which are written into the
+// runsc_config table of the config file read by the shim.
+type RunscOptions struct {
+	// ConfigPath is the config file of the shim, the flags are merged into
+	// a copy of it.
+	ConfigPath string `json:"config_path,omitempty"`
+	// Platform is the platform of runsc intercepting the syscalls, ptrace or kvm.
+	Platform string `json:"platform,omitempty"`
+	// Network is the network stack of runsc, sandbox, host or none.
+	Network string `json:"network,omitempty"`
+	// DebugLog is the path of the debug logs of runsc, the logs are written
+	// into the directory if it ends with "/". It should be within the debug
+	// log directory of pouchd.
+	DebugLog string `json:"debug_log,omitempty"`
+}
+
+// Validate checks the flags of runsc, the debug log is only allowed within
+// logDir, since runsc writes it on host.
+func (o *RunscOptions) Validate(logDir string) error {
+	switch o.Platform {
+	case "", "ptrace", "kvm":
+	default:
+		return errors.Wrapf(errtypes.ErrInvalidParam, "invalid runsc platform %s: should be ptrace or kvm", o.Platform)
+	}
+
+	switch o.Network {
+	case "", "sandbox", "host", "none":
+	default:
+		return errors.Wrapf(errtypes.ErrInvalidParam, "invalid runsc network %s: should be sandbox, host or none", o.Network)
+	}
+
+	if o.DebugLog != "" {
+		if !filepath.IsAbs(o.DebugLog) {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid runsc debug log %s: should be an absolute path", o.DebugLog)
+		}
+		logDir = filepath.Clean(logDir)
+		if path := filepath.Clean(o.DebugLog); path != logDir && !strings.HasPrefix(path, logDir+"/") {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid runsc debug log %s: should be within %s", o.DebugLog, logDir)
+		}
+	}
+	return nil
+}
+
+// Flags returns the flags of runsc in the runsc_config table of the config
+// file.
+func (o *RunscOptions) Flags() map[string]string {
+	flags := make(map[string]string)
+	if o.Platform != "" {
+		flags["platform"] = o.Platform
+	}
+	if o.Network != "" {
+		flags["network"] = o.Network
+	}
+	if o.DebugLog != "" {
+		flags["debug"] = "true"
+		flags["debug-log"] = o.DebugLog
+	}
+	return flags
+}
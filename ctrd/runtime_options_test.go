@@ -0,0 +1,43 @@
+package ctrd
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/containerd/typeurl"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeOptionsTypeURL(t *testing.T) {
+	any, err := typeurl.MarshalAny(&RuntimeOptions{ConfigPath: "/etc/kata-containers/configuration.toml"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cri.runtimeoptions.v1.Options", any.TypeUrl)
+
+	v, err := typeurl.UnmarshalAny(any)
+	assert.NoError(t, err)
+	assert.Equal(t, &RuntimeOptions{ConfigPath: "/etc/kata-containers/configuration.toml"}, v)
+}
+
+func TestRunscOptions(t *testing.T) {
+	o := &RunscOptions{Platform: "kvm", Network: "host", DebugLog: "/var/log/runsc/"}
+	assert.NoError(t, o.Validate("/var/log/runsc"))
+	assert.Equal(t, map[string]string{
+		"platform":  "kvm",
+		"network":   "host",
+		"debug":     "true",
+		"debug-log": "/var/log/runsc/",
+	}, o.Flags())
+	assert.Equal(t, map[string]string{}, (&RunscOptions{}).Flags())
+
+	for _, o := range []*RunscOptions{
+		{Platform: "systrap"},
+		{Network: "bridge"},
+		{DebugLog: "runsc.log"},
+		{DebugLog: "/var/log/runsc.log"},
+		{DebugLog: "/var/log/runsc/../messages"},
+	} {
+		assert.True(t, errtypes.IsInvalidParam(errors.Cause(o.Validate("/var/log/runsc"))), "%+v", o)
+	}
+}
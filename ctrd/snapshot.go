@@ -3,13 +3,18 @@ package ctrd
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
 
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/leases"
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/opencontainers/image-spec/identity"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -43,6 +48,33 @@ func (c *Client) CreateSnapshot(ctx context.Context, id, ref string) error {
 	originalCtx := ctx
 	ctx = leases.WithLease(ctx, wrapperCli.lease.ID)
 
+	image, err := wrapperCli.client.GetImage(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	diffIDs, err := image.RootFS(ctx)
+	if err != nil {
+		return err
+	}
+	parent := identity.ChainID(diffIDs).String()
+
+	_, err = prepareImageSnapshot(ctx, originalCtx, wrapperCli.client, image, id, parent)
+	return err
+}
+
+// CreateRemappedSnapshot creates a active snapshot with image's name and id,
+// whose files are owned by the remapped uid and gid. The remapped layers are
+// committed once and shared by the snapshots with the same uid and gid.
+func (c *Client) CreateRemappedSnapshot(ctx context.Context, id, ref string, uid, gid uint32) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	originalCtx := ctx
+	ctx = leases.WithLease(ctx, wrapperCli.lease.ID)
+
 	var (
 		snName = CurrentSnapshotterName(ctx)
 		snSrv  = wrapperCli.client.SnapshotService(snName)
@@ -58,24 +90,87 @@ func (c *Client) CreateSnapshot(ctx context.Context, id, ref string) error {
 		return err
 	}
 	parent := identity.ChainID(diffIDs).String()
+	remappedID := fmt.Sprintf("%s-%d-%d", parent, uid, gid)
 
-	// NOTE: PouchContainer always unpacks image during pulling. But there
-	// maybe crash or terminated by some reason. The image have been stored
-	// in containerd without unpacking. And the following creating container
-	// request will fail on preparing snapshot because there is no such
-	// parent snapshotter. Based on this case, we should skip the not
-	// found error and try to unpack it again.
-	_, err = snSrv.Prepare(ctx, id, parent)
-	if err == nil || !errdefs.IsNotFound(err) {
+	if _, err := snSrv.Stat(ctx, remappedID); err == nil {
+		_, err = snSrv.Prepare(ctx, id, remappedID)
+		return err
+	} else if !errdefs.IsNotFound(err) {
+		return err
+	}
+
+	// NOTE: the key is unique for each container, so that the containers
+	// created at the same time remap the layers independently, and only
+	// the first committed one is shared.
+	remapKey := remappedID + "-remap-" + id
+	mounts, err := prepareImageSnapshot(ctx, originalCtx, wrapperCli.client, image, remapKey, parent)
+	if err != nil {
 		return err
 	}
+
+	if err := mount.WithTempMount(ctx, mounts, func(root string) error {
+		return remapOwner(root, uid, gid)
+	}); err != nil {
+		snSrv.Remove(ctx, remapKey)
+		return errors.Wrapf(err, "failed to remap snapshot of image %s", ref)
+	}
+
+	if err := snSrv.Commit(ctx, remappedID, remapKey); err != nil {
+		snSrv.Remove(ctx, remapKey)
+		if !errdefs.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	_, err = snSrv.Prepare(ctx, id, remappedID)
+	return err
+}
+
+// remapOwner shifts the owner of files under root by uid and gid. The chown
+// clears the setuid and setgid bits, so the mode is restored after it.
+func remapOwner(root string, uid, gid uint32) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		stat := info.Sys().(*syscall.Stat_t)
+		// lchown the path so that the symlink to host file is not dereferenced.
+		if err := os.Lchown(path, int(stat.Uid+uid), int(stat.Gid+gid)); err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return nil
+		}
+		return os.Chmod(path, info.Mode())
+	})
+}
+
+// prepareImageSnapshot prepares the active snapshot with key on the parent
+// unpacked from image.
+//
+// NOTE: PouchContainer always unpacks image during pulling. But there
+// maybe crash or terminated by some reason. The image have been stored
+// in containerd without unpacking. And the following creating container
+// request will fail on preparing snapshot because there is no such
+// parent snapshotter. Based on this case, we should skip the not
+// found error and try to unpack it again.
+func prepareImageSnapshot(ctx, originalCtx context.Context, client *containerd.Client, image containerd.Image, key, parent string) ([]mount.Mount, error) {
+	var (
+		snName = CurrentSnapshotterName(ctx)
+		snSrv  = client.SnapshotService(snName)
+	)
+
+	mounts, err := snSrv.Prepare(ctx, key, parent)
+	if err == nil || !errdefs.IsNotFound(err) {
+		return mounts, err
+	}
 	log.With(ctx).Warnf("checking unpack status for image %s on %s snapshotter...", image.Name(), snName)
 
 	// check unpacked status
 	unpacked, werr := image.IsUnpacked(ctx, snName)
 	if werr != nil {
 		log.With(ctx).Warnf("failed to check unpack status for image %s on %s snapshotter: %v", image.Name(), snName, werr)
-		return err
+		return nil, err
 	}
 
 	// if it is not unpacked, try to unpack it.
@@ -86,13 +181,13 @@ func (c *Client) CreateSnapshot(ctx context.Context, id, ref string) error {
 		// snapshotter will not removed if we remove image.
 		if werr = image.Unpack(originalCtx, snName); werr != nil {
 			log.With(ctx).Warnf("failed to unpack for image %s on %s snapshotter: %v", image.Name(), snName, werr)
-			return err
+			return nil, err
 		}
 
 		// do it again.
-		_, err = snSrv.Prepare(ctx, id, parent)
+		mounts, err = snSrv.Prepare(ctx, key, parent)
 	}
-	return err
+	return mounts, err
 }
 
 // GetSnapshot returns the snapshot's info by id.
@@ -0,0 +1,156 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/diff"
+	"github.com/containerd/containerd/mount"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ChangeModify represents the modify operation.
+	ChangeModify = iota
+	// ChangeAdd represents the add operation.
+	ChangeAdd
+	// ChangeDelete represents the delete operation.
+	ChangeDelete
+)
+
+const (
+	// whiteoutPrefix prefix means file is a whiteout.
+	whiteoutPrefix = ".wh."
+	// whiteoutOpaqueDir means the directory has been made opaque.
+	whiteoutOpaqueDir = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// SnapshotChanges returns the changes of the active snapshot against its
+// parent. The diff service of containerd exports the changes as a tar stream,
+// and the files in it which exist in the parent are modified.
+func (c *Client) SnapshotChanges(ctx context.Context, id string) ([]*types.ContainerChangeResponseItem, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+	client := wrapperCli.client
+
+	// NOTE: make sure that gc scheduler doesn't remove the diff content
+	// before it is read.
+	ctx, done, err := client.WithLease(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create lease for changes")
+	}
+	defer done(context.TODO())
+
+	var (
+		sn = client.SnapshotService(CurrentSnapshotterName(ctx))
+		cs = client.ContentStore()
+	)
+
+	info, err := sn.Stat(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := createDiff(ctx, id, sn, client.DiffService(), diff.WithMediaType(ocispec.MediaTypeImageLayer))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to diff snapshot %s", id)
+	}
+	defer func() {
+		if err := cs.Delete(context.TODO(), desc.Digest); err != nil {
+			log.With(ctx).Warnf("failed to cleanup diff content %s: %v", desc.Digest, err)
+		}
+	}()
+
+	ra, err := cs.ReaderAt(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer ra.Close()
+
+	// mount the parent to check whether the changed file exists before.
+	lowerKey := fmt.Sprintf("%s-changes-view-%s", info.Parent, utils.RandString(5, "", ""))
+	lower, err := sn.View(ctx, lowerKey, info.Parent)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := sn.Remove(context.TODO(), lowerKey); err != nil {
+			log.With(ctx).Warnf("failed to cleanup changes lower snapshot(key=%s): %v", lowerKey, err)
+		}
+	}()
+
+	root, err := ioutil.TempDir("", "pouch-changes-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(root)
+
+	if err := mount.All(lower, root); err != nil {
+		return nil, errors.Wrapf(err, "failed to mount parent of snapshot %s", id)
+	}
+	defer mount.UnmountAll(root, 0)
+
+	return parseChanges(content.NewReader(ra), root)
+}
+
+// parseChanges parses the changes from the diff tar stream, the whiteout
+// file means the file is deleted, and the file exists in parent is modified,
+// otherwise it is added.
+func parseChanges(r io.Reader, parent string) ([]*types.ContainerChangeResponseItem, error) {
+	var (
+		tr      = tar.NewReader(r)
+		changes = []*types.ContainerChangeResponseItem{}
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read diff")
+		}
+
+		path := filepath.Join("/", hdr.Name)
+		if path == "/" {
+			continue
+		}
+
+		dir, base := filepath.Split(path)
+		if base == whiteoutOpaqueDir {
+			// the opaque directory itself is in the diff too.
+			continue
+		}
+
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			changes = append(changes, &types.ContainerChangeResponseItem{
+				Kind: ChangeDelete,
+				Path: filepath.Join(dir, strings.TrimPrefix(base, whiteoutPrefix)),
+			})
+			continue
+		}
+
+		kind := uint8(ChangeAdd)
+		if _, err := os.Lstat(filepath.Join(parent, path)); err == nil {
+			kind = ChangeModify
+		}
+		changes = append(changes, &types.ContainerChangeResponseItem{
+			Kind: kind,
+			Path: path,
+		})
+	}
+	return changes, nil
+}
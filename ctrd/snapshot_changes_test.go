@@ -0,0 +1,48 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChanges(t *testing.T) {
+	parent, err := ioutil.TempDir("", "changes")
+	assert.NoError(t, err)
+	defer os.RemoveAll(parent)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(parent, "etc"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(parent, "etc", "hosts"), nil, 0644))
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, hdr := range []*tar.Header{
+		{Name: "./", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "etc/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "etc/hosts", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "etc/.wh.passwd", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "tmp/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "tmp/.wh..wh..opq", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "tmp/new", Typeflag: tar.TypeReg, Mode: 0644},
+	} {
+		assert.NoError(t, tw.WriteHeader(hdr))
+	}
+	assert.NoError(t, tw.Close())
+
+	changes, err := parseChanges(buf, parent)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.ContainerChangeResponseItem{
+		{Kind: ChangeModify, Path: "/etc"},
+		{Kind: ChangeModify, Path: "/etc/hosts"},
+		{Kind: ChangeDelete, Path: "/etc/passwd"},
+		{Kind: ChangeAdd, Path: "/tmp"},
+		{Kind: ChangeAdd, Path: "/tmp/new"},
+	}, changes)
+}
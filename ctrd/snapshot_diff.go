@@ -0,0 +1,80 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/diff"
+	"github.com/containerd/containerd/mount"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// ExportSnapshotDiff writes the changes of the active snapshot against its
+// parent into w as an uncompressed layer tar, the removed files are recorded
+// as whiteouts.
+func (c *Client) ExportSnapshotDiff(ctx context.Context, id string, w io.Writer) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+	client := wrapperCli.client
+
+	// NOTE: make sure that gc scheduler doesn't remove the diff content
+	// before it is read.
+	ctx, done, err := client.WithLease(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create lease for diff")
+	}
+	defer done(context.TODO())
+
+	var (
+		sn = client.SnapshotService(CurrentSnapshotterName(ctx))
+		cs = client.ContentStore()
+	)
+
+	desc, err := createDiff(ctx, id, sn, client.DiffService(), diff.WithMediaType(ocispec.MediaTypeImageLayer))
+	if err != nil {
+		return errors.Wrapf(err, "failed to diff snapshot %s", id)
+	}
+	defer func() {
+		if err := cs.Delete(context.TODO(), desc.Digest); err != nil {
+			log.With(ctx).Warnf("failed to cleanup diff content %s: %v", desc.Digest, err)
+		}
+	}()
+
+	ra, err := cs.ReaderAt(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer ra.Close()
+
+	_, err = io.Copy(w, content.NewReader(ra))
+	return err
+}
+
+// ApplySnapshotDiff applies the layer tar exported by ExportSnapshotDiff to
+// the active snapshot, the files of whiteouts are removed from it.
+func (c *Client) ApplySnapshotDiff(ctx context.Context, id string, r io.Reader) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	mounts, err := wrapperCli.client.SnapshotService(CurrentSnapshotterName(ctx)).Mounts(ctx, id)
+	if err != nil {
+		return convertCtrdErr(err)
+	}
+
+	return mount.WithTempMount(ctx, mounts, func(root string) error {
+		if _, err := archive.Apply(ctx, root, r); err != nil {
+			return errors.Wrapf(err, "failed to apply diff to snapshot %s", id)
+		}
+		return nil
+	})
+}
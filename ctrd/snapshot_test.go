@@ -0,0 +1,44 @@
+package ctrd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemapOwner(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("remapping the owner requires root")
+	}
+
+	root, err := ioutil.TempDir("", "remap")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	su := filepath.Join(root, "su")
+	assert.NoError(t, ioutil.WriteFile(su, nil, 0755))
+	assert.NoError(t, os.Chmod(su, 0755|os.ModeSetuid|os.ModeSetgid))
+	assert.NoError(t, os.Symlink("/etc/passwd", filepath.Join(root, "passwd")))
+
+	assert.NoError(t, remapOwner(root, 1000, 2000))
+
+	// the setuid and setgid bits are kept after chown.
+	info, err := os.Stat(su)
+	assert.NoError(t, err)
+	assert.Equal(t, 0755|os.ModeSetuid|os.ModeSetgid, info.Mode())
+	stat := info.Sys().(*syscall.Stat_t)
+	assert.Equal(t, uint32(1000), stat.Uid)
+	assert.Equal(t, uint32(2000), stat.Gid)
+
+	// the target of symlink is not changed.
+	info, err = os.Stat("/etc/passwd")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), info.Sys().(*syscall.Stat_t).Uid)
+	info, err = os.Lstat(filepath.Join(root, "passwd"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1000), info.Sys().(*syscall.Stat_t).Uid)
+}
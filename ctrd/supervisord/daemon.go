@@ -36,8 +36,11 @@ const (
 	stopTimeout = 15 * time.Second
 
 	// delayRetryTimeout is used to hold for a while if the restart
-	// containerd fails
+	// containerd fails, it's doubled for each failure until maxRetryDelay.
 	delayRetryTimeout = 500 * time.Millisecond
+
+	// maxRetryDelay is the max delay to restart containerd.
+	maxRetryDelay = 10 * time.Second
 )
 
 // Opt is used to modify the daemon setting.
@@ -339,7 +342,7 @@ func (d *Daemon) monitor(ctx context.Context) {
 			count++
 			if err := d.runContainerd(ctx); err != nil {
 				log.With(ctx).Warnf("failed to restart containerd and will retry it again: %v", err)
-				time.Sleep(delayRetryTimeout)
+				time.Sleep(retryDelay(count))
 				continue
 			}
 		}
@@ -347,7 +350,7 @@ func (d *Daemon) monitor(ctx context.Context) {
 		if err := d.healthPostCheck(ctx); err != nil {
 			log.With(ctx).Warn("failed to do health check and will retry it again")
 			count++
-			time.Sleep(delayRetryTimeout)
+			time.Sleep(retryDelay(count))
 			continue
 		}
 
@@ -359,6 +362,20 @@ func (d *Daemon) monitor(ctx context.Context) {
 	}
 }
 
+// retryDelay returns the delay before the count-th retry to restart
+// containerd, it grows exponentially so that a broken containerd doesn't
+// restart in a tight loop.
+func retryDelay(count int) time.Duration {
+	delay := delayRetryTimeout
+	for i := 1; i < count && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
 func (d *Daemon) setContainerdPid(pid int) error {
 	d.pid = pid
 	return ioutil.WriteFile(d.pidPath(), []byte(fmt.Sprintf("%d", d.pid)), 0660)
@@ -0,0 +1,42 @@
+package ctrd
+
+import (
+	"context"
+
+	"github.com/containerd/containerd"
+)
+
+// The kinds of resources of container which could be updated when it's
+// running.
+const (
+	// UpdateResourceCPU is the cpu shares, quota, period and cpuset.
+	UpdateResourceCPU = "cpu"
+	// UpdateResourceMemory is the memory and swap limits.
+	UpdateResourceMemory = "memory"
+	// UpdateResourceBlkio is the blkio weight and throttles.
+	UpdateResourceBlkio = "blkio"
+	// UpdateResourcePids is the pids limit.
+	UpdateResourcePids = "pids"
+	// UpdateResourceHugetlb is the hugepage limits.
+	UpdateResourceHugetlb = "hugetlb"
+)
+
+// UpdatableResources returns the kinds of resources which could be updated
+// when the container of runtime type is running. The guest VM of kata is
+// resized by hotplugging cpus and memory, the other resources of guest
+// can't be changed.
+func UpdatableResources(runtimeType string) []string {
+	if runtimeType == RuntimeTypeV2kataV2 {
+		return []string{UpdateResourceCPU, UpdateResourceMemory}
+	}
+	return []string{UpdateResourceCPU, UpdateResourceMemory, UpdateResourceBlkio, UpdateResourcePids, UpdateResourceHugetlb}
+}
+
+// runtimeTypeOf returns the runtime type of containerd container.
+func runtimeTypeOf(ctx context.Context, container containerd.Container) (string, error) {
+	info, err := container.Info(ctx)
+	if err != nil {
+		return "", err
+	}
+	return info.Runtime.Name, nil
+}
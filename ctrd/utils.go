@@ -22,12 +22,18 @@ import (
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 )
 
-func withExitShimV1CheckpointTaskOpts() containerd.CheckpointTaskOpts {
+func withShimV1CheckpointTaskOpts(options *CheckpointOptions) containerd.CheckpointTaskOpts {
 	return func(r *containerd.CheckpointTaskInfo) error {
+		if options == nil {
+			return nil
+		}
 		r.Options = &runctypes.CheckpointOptions{
-			Exit: true,
+			Exit:    options.Exit,
+			OpenTcp: options.TCPEstablished,
 		}
 		return nil
 	}
@@ -43,6 +49,9 @@ func (c *Client) isInsecureDomain(ref string) bool {
 		return false
 	}
 
+	c.insecureLock.RLock()
+	defer c.insecureLock.RUnlock()
+
 	for _, r := range c.insecureRegistries {
 		if r == u.Host {
 			return true
@@ -56,6 +65,10 @@ func (c *Client) isInsecureDomain(ref string) bool {
 type resolverWrapper struct {
 	refToName map[string]string
 	resolver  remotes.Resolver
+
+	// downloadSlots and limiter throttle the fetchers of resolver.
+	downloadSlots *semaphore.Weighted
+	limiter       *rate.Limiter
 }
 
 // Resolve attempts to resolve the reference into a name and descriptor.
@@ -84,7 +97,19 @@ func (r *resolverWrapper) Fetcher(ctx context.Context, name string) (remotes.Fet
 			break
 		}
 	}
-	return r.resolver.Fetcher(ctx, ref)
+
+	fetcher, err := r.resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if r.downloadSlots == nil && r.limiter == nil {
+		return fetcher, nil
+	}
+	return &throttledFetcher{
+		fetcher: fetcher,
+		slots:   r.downloadSlots,
+		limiter: r.limiter,
+	}, nil
 }
 
 // Pusher returns a new pusher for the provided reference
@@ -99,10 +124,14 @@ func (r *resolverWrapper) Pusher(ctx context.Context, name string) (remotes.Push
 	return r.resolver.Pusher(ctx, ref)
 }
 
-func newImageResolver(refToName map[string]string, resolverOpt docker.ResolverOptions) remotes.Resolver {
+// newImageResolver returns the resolver whose fetchers share the slots of
+// concurrent downloads of daemon, and the bandwidth limit of each pull.
+func (c *Client) newImageResolver(refToName map[string]string, resolverOpt docker.ResolverOptions) remotes.Resolver {
 	return &resolverWrapper{
-		refToName: refToName,
-		resolver:  docker.NewResolver(resolverOpt),
+		refToName:     refToName,
+		resolver:      docker.NewResolver(resolverOpt),
+		downloadSlots: c.downloadSlots,
+		limiter:       newBandwidthLimiter(c.downloadBandwidth),
 	}
 }
 
@@ -114,6 +143,14 @@ func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig,
 		secret = authConfig.Password
 	}
 
+	credentials := resolverOpt.Credentials
+	if credentials == nil {
+		credentials = func(host string) (string, string, error) {
+			// Only one host
+			return username, secret, nil
+		}
+	}
+
 	var (
 		availableRef string
 		opt          docker.ResolverOptions
@@ -146,12 +183,9 @@ func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig,
 		}
 
 		opt = docker.ResolverOptions{
-			Tracker:   resolverOpt.Tracker,
-			PlainHTTP: insecure,
-			Credentials: func(host string) (string, string, error) {
-				// Only one host
-				return username, secret, nil
-			},
+			Tracker:     resolverOpt.Tracker,
+			PlainHTTP:   insecure,
+			Credentials: credentials,
 			Client: &http.Client{
 				Transport: tr,
 			},
@@ -165,6 +199,7 @@ func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig,
 			break
 		}
 
+		log.With(nil).Warnf("failed to resolve image %s, try the next reference: %v", namedRef, err)
 		if errors.Cause(err) == docker.ErrInvalidAuthorization {
 			resolveErr = errors.Wrap(errtypes.ErrInvalidAuthorization, err.Error())
 		} else {
@@ -181,7 +216,7 @@ func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig,
 		availableRef: name,
 	}
 
-	return newImageResolver(refToName, opt), availableRef, nil
+	return c.newImageResolver(refToName, opt), availableRef, nil
 }
 
 func (c *Client) preparePushResolver(authConfig *types.AuthConfig, ref string, resolverOpt docker.ResolverOptions) (remotes.Resolver, error) {
@@ -196,6 +231,14 @@ func (c *Client) preparePushResolver(authConfig *types.AuthConfig, ref string, r
 		secret = authConfig.Password
 	}
 
+	credentials := resolverOpt.Credentials
+	if credentials == nil {
+		credentials = func(host string) (string, string, error) {
+			// Only one host
+			return username, secret, nil
+		}
+	}
+
 	tr := &http.Transport{
 		Proxy: proxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -213,12 +256,9 @@ func (c *Client) preparePushResolver(authConfig *types.AuthConfig, ref string, r
 	}
 
 	options := docker.ResolverOptions{
-		Tracker:   resolverOpt.Tracker,
-		PlainHTTP: insecure,
-		Credentials: func(host string) (string, string, error) {
-			// Only one host
-			return username, secret, nil
-		},
+		Tracker:     resolverOpt.Tracker,
+		PlainHTTP:   insecure,
+		Credentials: credentials,
 		Client: &http.Client{
 			Transport: tr,
 		},
@@ -268,6 +308,18 @@ func GetThrottleDevice(devs []*types.ThrottleDevice) ([]specs.LinuxThrottleDevic
 	return ThrottleDevice, nil
 }
 
+// GetHugepageLimits Convert hugepage limits from []*types.HugepageLimit to []specs.LinuxHugepageLimit
+func GetHugepageLimits(limits []*types.HugepageLimit) []specs.LinuxHugepageLimit {
+	var hugepageLimits []specs.LinuxHugepageLimit
+	for _, l := range limits {
+		hugepageLimits = append(hugepageLimits, specs.LinuxHugepageLimit{
+			Pagesize: l.PageSize,
+			Limit:    l.Limit,
+		})
+	}
+	return hugepageLimits
+}
+
 // toLinuxResources transfers Pouch Resources to LinuxResources.
 func toLinuxResources(resources types.Resources) (*specs.LinuxResources, error) {
 	r := &specs.LinuxResources{}
@@ -316,6 +368,20 @@ func toLinuxResources(resources types.Resources) (*specs.LinuxResources, error)
 		Kernel:      &resources.KernelMemory,
 		// TODO: add other fields of specs.LinuxMemory
 	}
+	if resources.MemorySwappiness != nil && *resources.MemorySwappiness != -1 {
+		swappiness := uint64(*resources.MemorySwappiness)
+		r.Memory.Swappiness = &swappiness
+	}
+
+	// toLinuxPids
+	if resources.PidsLimit != 0 {
+		r.Pids = &specs.LinuxPids{
+			Limit: resources.PidsLimit,
+		}
+	}
+
+	// toLinuxHugepageLimits
+	r.HugepageLimits = GetHugepageLimits(resources.HugepageLimits)
 
 	// TODO: add more fields.
 
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/errtypes"
 
 	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_convertCtrdErr(t *testing.T) {
@@ -81,3 +84,37 @@ func Test_convertCtrdErr(t *testing.T) {
 		})
 	}
 }
+
+func Test_toLinuxResources(t *testing.T) {
+	r, err := toLinuxResources(types.Resources{
+		PidsLimit:  100,
+		CpusetCpus: "0-1",
+		HugepageLimits: []*types.HugepageLimit{
+			{PageSize: "2MB", Limit: 1 << 30},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &specs.LinuxPids{Limit: 100}, r.Pids)
+	assert.Equal(t, "0-1", r.CPU.Cpus)
+	assert.Equal(t, []specs.LinuxHugepageLimit{{Pagesize: "2MB", Limit: 1 << 30}}, r.HugepageLimits)
+	assert.True(t, hasCgroupLimits(r))
+
+	// the pids limit is kept if it is not updated.
+	r, err = toLinuxResources(types.Resources{CPUShares: 1024})
+	assert.NoError(t, err)
+	assert.Nil(t, r.Pids)
+	assert.Nil(t, r.Memory.Swappiness)
+	assert.False(t, hasCgroupLimits(r))
+
+	// -1 swappiness means the default of host.
+	swappiness := int64(60)
+	r, err = toLinuxResources(types.Resources{MemorySwappiness: &swappiness, KernelMemory: 1 << 30})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(60), *r.Memory.Swappiness)
+	assert.Equal(t, int64(1<<30), *r.Memory.Kernel)
+
+	swappiness = -1
+	r, err = toLinuxResources(types.Resources{MemorySwappiness: &swappiness})
+	assert.NoError(t, err)
+	assert.Nil(t, r.Memory.Swappiness)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/nri"
 
 	"github.com/containerd/containerd"
 	"github.com/pkg/errors"
@@ -42,6 +43,9 @@ type watch struct {
 
 	// containerdDead to specify whether containerd process is dead
 	containerdDead bool
+
+	// nri invokes the NRI plugins before the task is deleted.
+	nri *nri.Client
 }
 
 func (w *watch) setContainerdDead(isDead bool) error {
@@ -82,8 +86,29 @@ func (w *watch) add(ctx context.Context, pack *containerPack) {
 
 	w.containers[pack.id] = pack
 
+	w.watchExit(ctx, pack, pack.sch)
+
+	log.With(ctx).Infof("success to add container")
+}
+
+// watchExit waits for the exit of container on the task status channel sch,
+// the exit is ignored if the container has been rebound to another client
+// and watched on the new channel.
+func (w *watch) watchExit(ctx context.Context, pack *containerPack, sch <-chan containerd.ExitStatus) {
+	// NOTE: ctx may be the context of request, which is canceled once the
+	// request is done. The exit is handled in a detached context carrying
+	// the log fields only, so that the NRI plugins are still notified.
+	ctx = log.NewContext(context.Background(), log.With(ctx).Data)
+
 	go func(w *watch, pack *containerPack) {
-		status := <-pack.sch
+		status := <-sch
+
+		pack.l.RLock()
+		client, rebound := pack.client, pack.sch != sch
+		pack.l.RUnlock()
+		if rebound {
+			return
+		}
 
 		// if containerd is dead, the task.Wait channel return is not because
 		// container' task quit, but the channel has broken.
@@ -106,50 +131,53 @@ func (w *watch) add(ctx context.Context, pack *containerPack) {
 		// Also should release quota when the container destroyed
 		// We should release quota of client that the pack is in using,
 		// not the grpc client executing this parts of code.
-		pack.client.Produce(1)
+		client.Produce(1)
 
-		msg := &Message{
+		w.exit(ctx, pack, &Message{
 			err:      status.Error(),
 			exitCode: status.ExitCode(),
 			exitTime: status.ExitTime(),
-		}
+		})
+	}(w, pack)
+}
 
-		// NOTE: cleanup action should be taken only once!
-		var cleanupOnce sync.Once
-		cleanupFunc := func() error {
-			cleanupOnce.Do(func() {
-				if _, err := pack.task.Delete(context.Background()); err != nil {
-					log.With(ctx).Errorf("failed to delete task, container id: %s: %v", pack.id, err)
-				}
-
-				if err := pack.container.Delete(context.Background()); err != nil {
-					log.With(ctx).Errorf("failed to delete container, container id: %s: %v", pack.id, err)
-				}
-			})
-			return nil
-		}
+// exit executes the exit hooks of container, cleans up the task and
+// container in containerd, and notifies the waiters.
+func (w *watch) exit(ctx context.Context, pack *containerPack, msg *Message) {
+	// NOTE: cleanup action should be taken only once!
+	var cleanupOnce sync.Once
+	cleanupFunc := func() error {
+		cleanupOnce.Do(func() {
+			notifyNRI(ctx, w.nri, pack, nri.Delete, nil)
+
+			if _, err := pack.task.Delete(context.Background()); err != nil {
+				log.With(ctx).Errorf("failed to delete task, container id: %s: %v", pack.id, err)
+			}
 
-		pack.l.RLock()
-		skipCleanup := pack.skipStopHooks
-		pack.l.RUnlock()
-		if !skipCleanup {
-			for _, hook := range w.hooks {
-				if err := hook(pack.id, msg, cleanupFunc); err != nil {
-					log.With(ctx).Errorf("failed to execute the exit hooks: %v", err)
-					break
-				}
+			if err := pack.container.Delete(context.Background()); err != nil {
+				log.With(ctx).Errorf("failed to delete container, container id: %s: %v", pack.id, err)
 			}
+		})
+		return nil
+	}
 
-			// if stop container was triggered, skipStopHooks will be set to true, cleanup logic will be invoke by stop
-			// routine.
-			cleanupFunc()
+	pack.l.RLock()
+	skipCleanup := pack.skipStopHooks
+	pack.l.RUnlock()
+	if !skipCleanup {
+		for _, hook := range w.hooks {
+			if err := hook(pack.id, msg, cleanupFunc); err != nil {
+				log.With(ctx).Errorf("failed to execute the exit hooks: %v", err)
+				break
+			}
 		}
 
-		pack.ch <- msg
-
-	}(w, pack)
+		// if stop container was triggered, skipStopHooks will be set to true, cleanup logic will be invoke by stop
+		// routine.
+		cleanupFunc()
+	}
 
-	log.With(ctx).Infof("success to add container")
+	pack.ch <- msg
 }
 
 func (w *watch) remove(ctx context.Context, id string) error {
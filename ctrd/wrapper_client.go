@@ -1,8 +1,10 @@
 package ctrd
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/leases"
@@ -23,11 +25,15 @@ type WrapperClient struct {
 	mux sync.Mutex
 	// streamQuota records the numbers of stream client without be using
 	streamQuota int
+
+	// healthy is false if the connection to containerd is broken, the
+	// client is not scheduled until it recovers.
+	healthy bool
 }
 
 func newWrapperClient(rpcAddr string, defaultns string, maxStreamsClient int, lease *leases.Lease) (*WrapperClient, error) {
 	options := []containerd.ClientOpt{
-		containerd.WithDefaultNamespace(defaultns),
+		containerd.WithDialOpts(dialOptions(defaultns)),
 	}
 
 	cli, err := containerd.New(rpcAddr, options...)
@@ -39,6 +45,7 @@ func newWrapperClient(rpcAddr string, defaultns string, maxStreamsClient int, le
 		client:      cli,
 		lease:       lease,
 		streamQuota: maxStreamsClient,
+		healthy:     true,
 	}, nil
 }
 
@@ -71,3 +78,36 @@ func (w *WrapperClient) Value() int {
 
 	return w.streamQuota
 }
+
+// Healthy returns whether the connection to containerd is available.
+func (w *WrapperClient) Healthy() bool {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	return w.healthy
+}
+
+// setHealthy sets the health of client, and returns whether it changes.
+func (w *WrapperClient) setHealthy(healthy bool) bool {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	changed := w.healthy != healthy
+	w.healthy = healthy
+	return changed
+}
+
+// checkHealth checks whether containerd serves on the connection.
+func (w *WrapperClient) checkHealth(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	serving, err := w.client.IsServing(ctx)
+	if err != nil {
+		return err
+	}
+	if !serving {
+		return fmt.Errorf("containerd is not serving")
+	}
+	return nil
+}
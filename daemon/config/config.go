@@ -5,20 +5,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/client"
 	criconfig "github.com/alibaba/pouch/cri/config"
 	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/trust"
 	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/volume"
 
+	units "github.com/docker/go-units"
 	"github.com/spf13/pflag"
 )
 
@@ -31,6 +37,10 @@ const (
 	DefaultCgroupDriver = CgroupfsDriver
 	// ValidNameChars collects the characters allowed to represent a name, normally used to validate container and volume names.
 	ValidNameChars = `[a-zA-Z0-9][a-zA-Z0-9_.-]`
+	// EventSinkWebhook posts events to a http webhook
+	EventSinkWebhook = "webhook"
+	// EventSinkNATS publishes events to a nats subject
+	EventSinkNATS = "nats"
 )
 
 // ValidNamePattern is a regular expression to validate names against the collection of restricted characters.
@@ -55,6 +65,10 @@ type Config struct {
 	// Server listening address.
 	Listen []string `json:"listen,omitempty"`
 
+	// GRPCListen is the listening addresses of grpc server, which serves
+	// the streaming apis like exec. The grpc server is disabled if empty.
+	GRPCListen []string `json:"listen-grpc,omitempty"`
+
 	// Debug refers to the log mode.
 	Debug bool `json:"debug,omitempty"`
 
@@ -74,9 +88,29 @@ type Config struct {
 	// /usr/local/bin is the default.
 	ContainerdPath string `json:"containerd-path,omitempty"`
 
+	// ExternalContainerd means pouchd connects to the containerd listening
+	// on ContainerdAddr, instead of launching and supervising its own one.
+	ExternalContainerd bool `json:"external-containerd,omitempty"`
+
+	// ContainerdClients is the number of grpc connections to containerd,
+	// the containers are assigned to them in turn.
+	ContainerdClients int `json:"containerd-clients,omitempty"`
+
+	// ContainerdHealthCheckInterval is the interval in seconds to check the
+	// health of containerd connections, 0 means disabled.
+	ContainerdHealthCheckInterval int `json:"containerd-health-check-interval,omitempty"`
+
 	// TLS configuration
 	TLS client.TLSConfig `json:"TLS,omitempty"`
 
+	// AuthorizationPlugins are the names of plugins which authorize the api
+	// requests in order, the request is allowed only if all of them allow it.
+	AuthorizationPlugins []string `json:"authorization-plugins,omitempty"`
+
+	// AuditLog are the sinks of audit log which records the state-changing
+	// api requests, like file:///var/log/pouch/audit.log and syslog://.
+	AuditLog []string `json:"audit-log,omitempty"`
+
 	// Default OCI Runtime
 	DefaultRuntime string `json:"default-runtime,omitempty"`
 
@@ -131,6 +165,10 @@ type Config struct {
 	// AllowMultiSnapshotter allows multi snapshotter, default false
 	AllowMultiSnapshotter bool `json:"allow-multi-snapshotter,omitempty"`
 
+	// RemoteSnapshotter is the snapshotter to lazily pull images with
+	// eStargz or zstd:chunked layers, such as stargz
+	RemoteSnapshotter string `json:"remote-snapshotter,omitempty"`
+
 	// CgroupDriver sets cgroup driver for all containers
 	CgroupDriver string `json:"cgroup-driver,omitempty"`
 
@@ -138,18 +176,202 @@ type Config struct {
 	// insecure registries.
 	InsecureRegistries []string `json:"insecure-registries,omitempty"`
 
+	// Registries are the mirrors and credentials of registries keyed by
+	// registry host.
+	Registries map[string]RegistryConfig `json:"registries,omitempty"`
+
+	// MaxConcurrentDownloads is the max number of concurrent layer downloads
+	// of daemon, 0 means no limit.
+	MaxConcurrentDownloads int `json:"max-concurrent-downloads,omitempty"`
+
+	// MaxDownloadBandwidth is the max download bandwidth of each image pull
+	// in bytes per second, such as 10m, no limit if empty.
+	MaxDownloadBandwidth string `json:"max-download-bandwidth,omitempty"`
+
+	// ImageGCInterval is the interval in seconds to remove the images not
+	// used by containers in background, 0 means disabled.
+	ImageGCInterval int `json:"image-gc-interval,omitempty"`
+
+	// ImageGCUnused means all the unused images are removed by image gc,
+	// otherwise only the dangling images are removed.
+	ImageGCUnused bool `json:"image-gc-unused,omitempty"`
+
+	// ImageGCMinAge is the min age in seconds since the images are pulled,
+	// the younger images are not removed by image gc.
+	ImageGCMinAge int `json:"image-gc-min-age,omitempty"`
+
 	// EnableBuilder enable builder functionality
 	EnableBuilder bool `json:"enable-builder,omitempty"`
 
+	// ExecRetentionTime is the time in seconds to retain the metadata of
+	// finished exec processes, 0 means retaining it until it is inspected.
+	ExecRetentionTime int `json:"exec-retention-time,omitempty"`
+
+	// MaxExecsPerContainer caps the number of finished exec processes
+	// retained for each container, 0 means no limit.
+	MaxExecsPerContainer int `json:"max-execs-per-container,omitempty"`
+
+	// ContainerLockMode is the mode of lock held by the containerd
+	// operations of container, fair or unfair, fair by default.
+	ContainerLockMode string `json:"container-lock-mode,omitempty"`
+
+	// ContainerLockTimeouts are the timeouts in seconds to acquire the
+	// container lock keyed by the operations, such as create and stats, the
+	// key default applies to the other operations, 0 means no timeout.
+	ContainerLockTimeouts map[string]int `json:"container-lock-timeouts,omitempty"`
+
+	// ShutdownTimeout is the time in seconds to wait for the daemon to
+	// drain before it exits.
+	ShutdownTimeout int `json:"shutdown-timeout,omitempty"`
+
+	// ShutdownStopContainers stops the running containers when daemon
+	// shuts down, otherwise containers keep running without pouchd.
+	ShutdownStopContainers bool `json:"shutdown-stop-containers,omitempty"`
+
+	// UsernsRemap is the user and group in the format of user[:group] whose
+	// subordinate id ranges the root of containers is remapped into, "default"
+	// uses the user pouchremap.
+	UsernsRemap string `json:"userns-remap,omitempty"`
+
+	// SeccompProfile is the path of seccomp profile used by the containers
+	// without seccomp option, the built-in default profile is used if empty.
+	SeccompProfile string `json:"seccomp-profile,omitempty"`
+
+	// DefaultCapabilities overrides the default capabilities of containers,
+	// in which the capability to add and drop of container are tweaked.
+	DefaultCapabilities []string `json:"default-capabilities,omitempty"`
+
+	// EnableSelinux enables the SELinux labeling of container processes and
+	// mounts.
+	EnableSelinux bool `json:"selinux-enabled,omitempty"`
+
+	// TrustPolicies are the signature verification policies of images keyed
+	// by registry domain, "*" matches the registries without own policy.
+	TrustPolicies map[string]trust.Policy `json:"trust-policies,omitempty"`
+
+	// EventSinks are the external sinks which receive the daemon events.
+	EventSinks []EventSinkConfig `json:"event-sinks,omitempty"`
+
+	// Hooks are the OCI hooks injected into all the containers, which are
+	// executed before the hooks of container at the same stage.
+	Hooks []*types.OCIHook `json:"hooks,omitempty"`
+
+	// HooksDir is the directory scanned at daemon start for the OCI hooks
+	// injected into all the containers, each json file in it is a hook.
+	HooksDir string `json:"hooks-dir,omitempty"`
+
+	// SpecPlugins are the executables invoked in order just before the
+	// containers are created in containerd, which receive the OCI spec and
+	// config of container to mutate or reject the spec.
+	SpecPlugins []string `json:"spec-plugins,omitempty"`
+
+	// SpecPluginTimeout is the time in seconds to wait for each spec plugin,
+	// 0 means no timeout.
+	SpecPluginTimeout int `json:"spec-plugin-timeout,omitempty"`
+
+	// EnableNRI enables the NRI plugins invoked at the lifecycle events of
+	// containers, which are configured in NRIConfigPath.
+	EnableNRI bool `json:"enable-nri,omitempty"`
+
+	// NRIConfigPath is the path of the config of NRI plugins.
+	NRIConfigPath string `json:"nri-config-path,omitempty"`
+
+	// NRIBinPath is the directory of the NRI plugin binaries.
+	NRIBinPath string `json:"nri-bin-path,omitempty"`
+
+	// Init runs an init in the containers which don't set it, the init
+	// forwards signals to the entrypoint and reaps the zombie processes.
+	Init bool `json:"init,omitempty"`
+
+	// InitPath is the path of init binary injected into the containers,
+	// docker-init in PATH is used if it is empty.
+	InitPath string `json:"init-path,omitempty"`
+
+	// RootfsDirs are the directories on host which the containers are allowed
+	// to be created from the rootfs or OCI bundle in, the rootfs on host is
+	// not allowed if it's empty.
+	RootfsDirs []string `json:"rootfs-dirs,omitempty"`
+
+	// DefaultUlimits are the ulimits of containers keyed by their names,
+	// the ulimits set by containers override them.
+	DefaultUlimits map[string]*units.Ulimit `json:"default-ulimits,omitempty"`
+
 	// MachineMemory is the memory limit for a host.
 	MachineMemory uint64 `json:"-"`
 }
 
+// EventSinkConfig defines an external sink which receives the daemon events.
+type EventSinkConfig struct {
+	// Type is the type of sink, webhook or nats.
+	Type string `json:"type"`
+
+	// Address is the url of webhook or the address of nats server.
+	Address string `json:"address"`
+
+	// Subject is the nats subject which the events are published to.
+	Subject string `json:"subject,omitempty"`
+
+	// Filters only forwards the matched events to sink, it supports the
+	// same filters as pouch events.
+	Filters map[string][]string `json:"filters,omitempty"`
+}
+
+// RegistryConfig defines the mirrors and credentials of a registry.
+type RegistryConfig struct {
+	// Mirrors are the registry hosts with optional path prefix, which are
+	// tried in order before the registry when pulling images.
+	Mirrors []string `json:"mirrors,omitempty"`
+
+	// CredentialHelper is the suffix of credential helper binary
+	// docker-credential-<suffix> which provides the credentials of registry.
+	CredentialHelper string `json:"credential-helper,omitempty"`
+
+	// Username and Password are the credentials of registry for basic auth
+	// or token auth.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// GetDefaultRuntime returns the default runtime of containers, which is
+// changed by reloading the config with the lock held.
+func (cfg *Config) GetDefaultRuntime() string {
+	cfg.Lock()
+	defer cfg.Unlock()
+	return cfg.DefaultRuntime
+}
+
 // GetCgroupDriver gets cgroup driver used in runc.
 func (cfg *Config) GetCgroupDriver() string {
 	return cfg.CgroupDriver
 }
 
+// GetContainerLockTimeouts returns the timeouts to acquire the container
+// lock keyed by the operations.
+func (cfg *Config) GetContainerLockTimeouts() map[string]time.Duration {
+	timeouts := make(map[string]time.Duration, len(cfg.ContainerLockTimeouts))
+	for op, timeout := range cfg.ContainerLockTimeouts {
+		timeouts[op] = time.Duration(timeout) * time.Second
+	}
+	return timeouts
+}
+
+// GetMaxDownloadBandwidth returns the max download bandwidth of each image
+// pull in bytes per second, 0 means no limit.
+func (cfg *Config) GetMaxDownloadBandwidth() (int64, error) {
+	if cfg.MaxDownloadBandwidth == "" {
+		return 0, nil
+	}
+
+	bandwidth, err := units.RAMInBytes(cfg.MaxDownloadBandwidth)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max download bandwidth %q: %v", cfg.MaxDownloadBandwidth, err)
+	}
+	if bandwidth < 0 {
+		return 0, fmt.Errorf("max download bandwidth %q cannot be negative", cfg.MaxDownloadBandwidth)
+	}
+	return bandwidth, nil
+}
+
 // UseSystemd tells whether use systemd cgroup driver
 func (cfg *Config) UseSystemd() bool {
 	return cfg.CgroupDriver == CgroupSystemdDriver
@@ -163,20 +385,109 @@ func (cfg *Config) Validate() error {
 
 	// deduplicated elements in slice if there is any.
 	cfg.Listen = utils.DeDuplicate(cfg.Listen)
+	cfg.GRPCListen = utils.DeDuplicate(cfg.GRPCListen)
+	cfg.AuthorizationPlugins = utils.DeDuplicate(cfg.AuthorizationPlugins)
+	cfg.AuditLog = utils.DeDuplicate(cfg.AuditLog)
 	cfg.Labels = utils.DeDuplicate(cfg.Labels)
 
-	for _, label := range cfg.Labels {
-		data := strings.SplitN(label, "=", 2)
-		if len(data) != 2 {
-			return fmt.Errorf("daemon label %s must be in format of key=value", label)
-		}
-		if len(data[0]) == 0 || len(data[1]) == 0 {
-			return fmt.Errorf("key and value in daemon label %s cannot be empty", label)
-		}
+	if err := ValidateLabels(cfg.Labels); err != nil {
+		return err
 	}
 
 	// TODO: add config validation
 
+	if cfg.ImageGCInterval < 0 {
+		return fmt.Errorf("image gc interval %d cannot be negative", cfg.ImageGCInterval)
+	}
+	if cfg.ImageGCMinAge < 0 {
+		return fmt.Errorf("image gc min age %d cannot be negative", cfg.ImageGCMinAge)
+	}
+	if cfg.ExecRetentionTime < 0 {
+		return fmt.Errorf("exec retention time %d cannot be negative", cfg.ExecRetentionTime)
+	}
+	if cfg.MaxExecsPerContainer < 0 {
+		return fmt.Errorf("max execs per container %d cannot be negative", cfg.MaxExecsPerContainer)
+	}
+	if cfg.ContainerdClients < 0 {
+		return fmt.Errorf("containerd clients %d cannot be negative", cfg.ContainerdClients)
+	}
+	if cfg.ContainerdHealthCheckInterval < 0 {
+		return fmt.Errorf("containerd health check interval %d cannot be negative", cfg.ContainerdHealthCheckInterval)
+	}
+	if cfg.ShutdownTimeout < 0 {
+		return fmt.Errorf("shutdown timeout %d cannot be negative", cfg.ShutdownTimeout)
+	}
+	if err := validateTLS(cfg.TLS); err != nil {
+		return err
+	}
+	for _, sink := range cfg.EventSinks {
+		if err := validateEventSink(sink); err != nil {
+			return err
+		}
+	}
+	for host, registry := range cfg.Registries {
+		if err := validateRegistry(host, registry); err != nil {
+			return err
+		}
+	}
+	for _, hook := range cfg.Hooks {
+		if err := ValidateHook(hook); err != nil {
+			return err
+		}
+	}
+	if cfg.HooksDir != "" && !filepath.IsAbs(cfg.HooksDir) {
+		return fmt.Errorf("hooks dir %s must be an absolute path", cfg.HooksDir)
+	}
+	for _, plugin := range cfg.SpecPlugins {
+		if !filepath.IsAbs(plugin) {
+			return fmt.Errorf("spec plugin %s must be an absolute path", plugin)
+		}
+	}
+	if cfg.SpecPluginTimeout < 0 {
+		return fmt.Errorf("spec plugin timeout %d cannot be negative", cfg.SpecPluginTimeout)
+	}
+	if cfg.EnableNRI && (!filepath.IsAbs(cfg.NRIConfigPath) || !filepath.IsAbs(cfg.NRIBinPath)) {
+		return fmt.Errorf("NRI config path %s and bin path %s must be absolute paths", cfg.NRIConfigPath, cfg.NRIBinPath)
+	}
+	if cfg.InitPath != "" && !filepath.IsAbs(cfg.InitPath) {
+		return fmt.Errorf("init path %s must be an absolute path", cfg.InitPath)
+	}
+	for _, dir := range cfg.RootfsDirs {
+		if !filepath.IsAbs(dir) || filepath.Clean(dir) == "/" {
+			return fmt.Errorf("rootfs dir %s must be an absolute path other than /", dir)
+		}
+	}
+	for name, ul := range cfg.DefaultUlimits {
+		if ul == nil || ul.Name != name {
+			return fmt.Errorf("default ulimit %s should have the same name as its key", name)
+		}
+		if err := ValidateUlimit(ul); err != nil {
+			return err
+		}
+	}
+	if cfg.MaxConcurrentDownloads < 0 {
+		return fmt.Errorf("max concurrent downloads %d cannot be negative", cfg.MaxConcurrentDownloads)
+	}
+	if _, err := cfg.GetMaxDownloadBandwidth(); err != nil {
+		return err
+	}
+	switch cfg.ContainerLockMode {
+	case "", "fair", "unfair":
+	default:
+		return fmt.Errorf("invalid container lock mode %s, should be fair or unfair", cfg.ContainerLockMode)
+	}
+	for op, timeout := range cfg.ContainerLockTimeouts {
+		if timeout < 0 {
+			return fmt.Errorf("container lock timeout %d of %s cannot be negative", timeout, op)
+		}
+	}
+	if (cfg.NetworkConfig.ClusterStore == "") != (cfg.NetworkConfig.ClusterAdvertise == "") {
+		return fmt.Errorf("cluster store and cluster advertise should be set together")
+	}
+	if ip := cfg.NetworkConfig.BridgeConfig.HostGatewayIP; ip != "" && net.ParseIP(ip) == nil {
+		return fmt.Errorf("invalid host gateway ip %s", ip)
+	}
+
 	// validates runtimes config
 	if len(cfg.Runtimes) == 0 {
 		cfg.Runtimes = make(map[string]types.Runtime)
@@ -185,6 +496,16 @@ func (cfg *Config) Validate() error {
 		// add default runtime
 		cfg.Runtimes[cfg.DefaultRuntime] = types.Runtime{Path: cfg.DefaultRuntime}
 	}
+	for name, r := range cfg.Runtimes {
+		if err := validateRuntime(name, r); err != nil {
+			return err
+		}
+	}
+	for handler, h := range cfg.CriConfig.RuntimeHandlers {
+		if _, exist := cfg.Runtimes[h.Runtime]; !exist {
+			return fmt.Errorf("runtime %q of runtime handler %s is not added to pouchd", h.Runtime, handler)
+		}
+	}
 
 	// if cgroup driver is empty, use default cgroup driver
 	if cfg.CgroupDriver == "" {
@@ -194,6 +515,59 @@ func (cfg *Config) Validate() error {
 	return validateCgroupDriver(cfg.CgroupDriver)
 }
 
+// ValidateLabels validates the format of daemon labels.
+func ValidateLabels(labels []string) error {
+	for _, label := range labels {
+		data := strings.SplitN(label, "=", 2)
+		if len(data) != 2 {
+			return fmt.Errorf("daemon label %s must be in format of key=value", label)
+		}
+		if len(data[0]) == 0 || len(data[1]) == 0 {
+			return fmt.Errorf("key and value in daemon label %s cannot be empty", label)
+		}
+	}
+	return nil
+}
+
+// ReloadableFlags are the configurations which can be reloaded from config
+// file without restarting daemon.
+var ReloadableFlags = []string{"debug", "registry-mirrors", "default-runtime", "insecure-registries", "label"}
+
+// LoadReloadableConfigurations reads config file again, it returns the
+// configurations in config file and the reloadable flags set in it.
+func (cfg *Config) LoadReloadableConfigurations() (*Config, map[string]bool, error) {
+	fileConfig := &Config{}
+	reloaded := make(map[string]bool)
+
+	contents, err := ioutil.ReadFile(cfg.ConfigFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fileConfig, reloaded, nil
+		}
+		return nil, nil, fmt.Errorf("failed to read contents from config file %s: %s", cfg.ConfigFile, err)
+	}
+
+	var origin map[string]interface{}
+	if err = json.NewDecoder(bytes.NewReader(contents)).Decode(&origin); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode json: %s", err)
+	}
+
+	fileFlags := make(map[string]interface{})
+	flattenConfig(origin, fileFlags)
+	for _, flag := range ReloadableFlags {
+		if _, exist := fileFlags[flag]; exist {
+			reloaded[flag] = true
+		}
+	}
+
+	if err = json.NewDecoder(bytes.NewReader(contents)).Decode(fileConfig); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode json: %s", err)
+	}
+	fileConfig.Labels = utils.DeDuplicate(fileConfig.Labels)
+
+	return fileConfig, reloaded, nil
+}
+
 //MergeConfigurations merges flagSet flags and config file flags into Config.
 func (cfg *Config) MergeConfigurations(flagSet *pflag.FlagSet) error {
 	contents, err := ioutil.ReadFile(cfg.ConfigFile)
@@ -306,6 +680,114 @@ func mergeConfigurations(src *Config, dest *Config) error {
 	return utils.Merge(src, dest)
 }
 
+// validateTLS validates the TLS options of api listeners.
+func validateTLS(tlsCfg client.TLSConfig) error {
+	if (tlsCfg.Key == "") != (tlsCfg.Cert == "") {
+		return fmt.Errorf("both tlscert and tlskey should be specified to enable TLS")
+	}
+	if tlsCfg.VerifyRemote && tlsCfg.CA == "" {
+		return fmt.Errorf("tlscacert should be specified to verify the remote certificates")
+	}
+	if tlsCfg.MinVersion != "" {
+		if _, err := httputils.ParseTLSVersion(tlsCfg.MinVersion); err != nil {
+			return err
+		}
+	}
+	if _, err := httputils.ParseCipherSuites(tlsCfg.CipherSuites); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateEventSink validates the type and address of event sink.
+func validateEventSink(sink EventSinkConfig) error {
+	switch sink.Type {
+	case EventSinkWebhook, EventSinkNATS:
+	default:
+		return fmt.Errorf("event sink type %q is not supported, only %s and %s are supported", sink.Type, EventSinkWebhook, EventSinkNATS)
+	}
+
+	if sink.Address == "" {
+		return fmt.Errorf("address of %s event sink cannot be empty", sink.Type)
+	}
+	if sink.Type == EventSinkNATS && sink.Subject == "" {
+		return fmt.Errorf("subject of %s event sink cannot be empty", sink.Type)
+	}
+	return nil
+}
+
+// ValidateHook validates the OCI hook of daemon or container.
+func ValidateHook(hook *types.OCIHook) error {
+	if hook == nil {
+		return fmt.Errorf("hook cannot be empty")
+	}
+
+	switch hook.Stage {
+	case types.OCIHookStagePrestart, types.OCIHookStagePoststart, types.OCIHookStagePoststop:
+	default:
+		return fmt.Errorf("stage %q of hook %s is not supported, only %s, %s and %s are supported", hook.Stage, hook.Path,
+			types.OCIHookStagePrestart, types.OCIHookStagePoststart, types.OCIHookStagePoststop)
+	}
+
+	if !filepath.IsAbs(hook.Path) {
+		return fmt.Errorf("path of hook %s must be an absolute path", hook.Path)
+	}
+	if hook.Timeout < 0 {
+		return fmt.Errorf("timeout %d of hook %s cannot be negative", hook.Timeout, hook.Path)
+	}
+	return nil
+}
+
+// ValidateUlimit validates the ulimit of daemon or container, -1 means
+// unlimited.
+func ValidateUlimit(ul *units.Ulimit) error {
+	if _, err := ul.GetRlimit(); err != nil {
+		return err
+	}
+	if ul.Soft < -1 || ul.Hard < -1 {
+		return fmt.Errorf("limits of ulimit %s cannot be negative except -1 for unlimited", ul.Name)
+	}
+	// -1 is converted to the max value, so it's larger than any limit.
+	if uint64(ul.Soft) > uint64(ul.Hard) {
+		return fmt.Errorf("soft limit %d of ulimit %s should be less than or equal to hard limit %d", ul.Soft, ul.Name, ul.Hard)
+	}
+	return nil
+}
+
+func validateRegistry(host string, registry RegistryConfig) error {
+	if host == "" || strings.Contains(host, "/") {
+		return fmt.Errorf("invalid registry host %q", host)
+	}
+	for _, mirror := range registry.Mirrors {
+		if mirror == "" || strings.Contains(mirror, "://") {
+			return fmt.Errorf("mirror %q of registry %s should be host with optional path and without scheme", mirror, host)
+		}
+	}
+	if registry.CredentialHelper != "" && (registry.Username != "" || registry.Password != "") {
+		return fmt.Errorf("credential helper and username/password of registry %s cannot be set together", host)
+	}
+	return nil
+}
+
+// validateRuntime validates the defaults of containers using the runtime.
+func validateRuntime(name string, r types.Runtime) error {
+	for k := range r.Annotations {
+		if k == "" {
+			return fmt.Errorf("annotation of runtime %s cannot be empty", name)
+		}
+	}
+	for _, m := range r.Mounts {
+		parts := strings.Split(m, ":")
+		if len(parts) < 2 || len(parts) > 3 || !filepath.IsAbs(parts[0]) || !filepath.IsAbs(parts[1]) {
+			return fmt.Errorf("invalid mount %q of runtime %s, should be source:destination[:options] with absolute paths", m, name)
+		}
+	}
+	if r.BaseSpec != "" && !filepath.IsAbs(r.BaseSpec) {
+		return fmt.Errorf("base spec %s of runtime %s should be absolute path", r.BaseSpec, name)
+	}
+	return nil
+}
+
 // validateCgroupDriver validates cgroup driver
 func validateCgroupDriver(driver string) error {
 	if driver == CgroupfsDriver || driver == CgroupSystemdDriver {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/client"
@@ -14,6 +15,7 @@ import (
 	"github.com/alibaba/pouch/storage/volume"
 
 	"github.com/containerd/containerd/namespaces"
+	units "github.com/docker/go-units"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 )
@@ -97,6 +99,12 @@ func TestConfigValidate(t *testing.T) {
 	}
 	assert.Equal(nil, cfg.Validate())
 
+	cfg = &Config{NetworkConfig: network.Config{BridgeConfig: network.BridgeConfig{HostGatewayIP: "192.168.5.1"}}}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{NetworkConfig: network.Config{BridgeConfig: network.BridgeConfig{HostGatewayIP: "gateway"}}}
+	assert.NotNil(cfg.Validate())
+
 	// Test cri configuration
 	cfg = &Config{
 		IsCriEnabled: true,
@@ -129,10 +137,22 @@ func TestConfigValidate(t *testing.T) {
 			Key:              "/path/to/.pouchcert/key.pem",
 			VerifyRemote:     true,
 			ManagerWhiteList: "docker.alibaba.com",
+			MinVersion:       "1.2",
+			CipherSuites:     []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
 		},
 	}
 	assert.Equal(nil, cfg.Validate())
 
+	for _, tlsCfg := range []client.TLSConfig{
+		{Cert: "/path/to/.pouchcert/cert.pem"},
+		{Cert: "/path/to/.pouchcert/cert.pem", Key: "/path/to/.pouchcert/key.pem", VerifyRemote: true},
+		{Cert: "/path/to/.pouchcert/cert.pem", Key: "/path/to/.pouchcert/key.pem", MinVersion: "1.4"},
+		{Cert: "/path/to/.pouchcert/cert.pem", Key: "/path/to/.pouchcert/key.pem", CipherSuites: []string{"TLS_UNKNOWN"}},
+	} {
+		cfg = &Config{TLS: tlsCfg}
+		assert.Error(cfg.Validate())
+	}
+
 	// Test lxcfs configuration
 	cfg = &Config{
 		IsLxcfsEnabled: false,
@@ -169,6 +189,235 @@ func TestConfigValidate(t *testing.T) {
 	}
 	assert.Equal(nil, cfg.Validate())
 
+	// Test image gc configuration
+	cfg = &Config{
+		ImageGCInterval: 3600,
+		ImageGCUnused:   true,
+		ImageGCMinAge:   86400,
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		ImageGCInterval: -1,
+	}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{
+		ImageGCMinAge: -1,
+	}
+	assert.NotNil(cfg.Validate())
+
+	// Test exec configuration
+	cfg = &Config{
+		ExecRetentionTime:    3600,
+		MaxExecsPerContainer: 10,
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		ExecRetentionTime: -1,
+	}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{
+		MaxExecsPerContainer: -1,
+	}
+	assert.NotNil(cfg.Validate())
+
+	// Test shutdown configuration
+	cfg = &Config{
+		ShutdownTimeout:        60,
+		ShutdownStopContainers: true,
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		ShutdownTimeout: -1,
+	}
+	assert.NotNil(cfg.Validate())
+
+	// Test event sinks configuration
+	cfg = &Config{
+		EventSinks: []EventSinkConfig{
+			{Type: EventSinkWebhook, Address: "http://127.0.0.1:8080/events"},
+			{Type: EventSinkNATS, Address: "nats://127.0.0.1:4222", Subject: "pouch.events"},
+		},
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		EventSinks: []EventSinkConfig{{Type: "kafka", Address: "127.0.0.1:9092"}},
+	}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{
+		EventSinks: []EventSinkConfig{{Type: EventSinkWebhook}},
+	}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{
+		EventSinks: []EventSinkConfig{{Type: EventSinkNATS, Address: "127.0.0.1:4222"}},
+	}
+	assert.NotNil(cfg.Validate())
+
+	// Test registries configuration
+	cfg = &Config{
+		Registries: map[string]RegistryConfig{
+			"docker.io":            {Mirrors: []string{"mirror.example.com", "127.0.0.1:5000/docker.io"}},
+			"registry.example.com": {CredentialHelper: "pass"},
+			"127.0.0.1:5000":       {Username: "user", Password: "secret"},
+		},
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		Registries: map[string]RegistryConfig{"docker.io": {Mirrors: []string{"https://mirror.example.com"}}},
+	}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{
+		Registries: map[string]RegistryConfig{"registry.example.com": {CredentialHelper: "pass", Username: "user"}},
+	}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{
+		DefaultRuntime: "runc",
+		Runtimes:       map[string]types.Runtime{"kata": {Type: "io.containerd.kata.v2"}},
+		CriConfig: criconfig.Config{
+			RuntimeHandlers: map[string]criconfig.RuntimeHandler{"kata": {Runtime: "kata"}, "runc": {Runtime: "runc"}},
+		},
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		CriConfig: criconfig.Config{
+			RuntimeHandlers: map[string]criconfig.RuntimeHandler{"gvisor": {Runtime: "runsc"}},
+		},
+	}
+	assert.NotNil(cfg.Validate())
+
+	// Test runtime defaults configuration
+	cfg = &Config{
+		DefaultRuntime: "runc",
+		Runtimes: map[string]types.Runtime{"kata": {
+			Type:        "io.containerd.kata.v2",
+			Annotations: map[string]string{"io.katacontainers.config.hypervisor.default_vcpus": "2"},
+			Mounts:      []string{"/etc/localtime:/etc/localtime:ro"},
+			BaseSpec:    "/etc/pouch/kata-spec.json",
+		}},
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	for _, r := range []types.Runtime{
+		{Annotations: map[string]string{"": "foo"}},
+		{Mounts: []string{"/etc/localtime"}},
+		{Mounts: []string{"localtime:/etc/localtime"}},
+		{BaseSpec: "kata-spec.json"},
+	} {
+		cfg = &Config{DefaultRuntime: "runc", Runtimes: map[string]types.Runtime{"kata": r}}
+		assert.NotNil(cfg.Validate())
+	}
+
+	// Test image pull configuration
+	cfg = &Config{MaxConcurrentDownloads: 3, MaxDownloadBandwidth: "10m"}
+	assert.Equal(nil, cfg.Validate())
+	bandwidth, err := cfg.GetMaxDownloadBandwidth()
+	assert.Equal(nil, err)
+	assert.Equal(int64(10<<20), bandwidth)
+
+	cfg = &Config{MaxConcurrentDownloads: -1}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{MaxDownloadBandwidth: "fast"}
+	assert.NotNil(cfg.Validate())
+
+	// Test containerd clients configuration
+	cfg = &Config{ContainerdClients: 8, ContainerdHealthCheckInterval: 10}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{ContainerdClients: -1}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{ContainerdHealthCheckInterval: -1}
+	assert.NotNil(cfg.Validate())
+
+	// Test container lock configuration
+	cfg = &Config{ContainerLockMode: "unfair", ContainerLockTimeouts: map[string]int{"default": 30, "stats": 5}}
+	assert.Equal(nil, cfg.Validate())
+	assert.Equal(map[string]time.Duration{"default": 30 * time.Second, "stats": 5 * time.Second}, cfg.GetContainerLockTimeouts())
+
+	cfg = &Config{ContainerLockMode: "random"}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{ContainerLockTimeouts: map[string]int{"create": -1}}
+	assert.NotNil(cfg.Validate())
+
+	// Test OCI hooks configuration
+	cfg = &Config{
+		Hooks:    []*types.OCIHook{{Stage: types.OCIHookStagePrestart, Path: "/usr/bin/hook", Timeout: 5}},
+		HooksDir: "/etc/pouch/hooks.d",
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	for _, hook := range []*types.OCIHook{
+		nil,
+		{Stage: "createRuntime", Path: "/usr/bin/hook"},
+		{Stage: types.OCIHookStagePoststop, Path: "hook"},
+		{Stage: types.OCIHookStagePoststart, Path: "/usr/bin/hook", Timeout: -1},
+	} {
+		cfg = &Config{Hooks: []*types.OCIHook{hook}}
+		assert.NotNil(cfg.Validate())
+	}
+
+	cfg = &Config{HooksDir: "hooks.d"}
+	assert.NotNil(cfg.Validate())
+
+	// Test spec plugins configuration
+	cfg = &Config{SpecPlugins: []string{"/usr/bin/policy"}, SpecPluginTimeout: 10}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{SpecPlugins: []string{"policy"}}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{SpecPluginTimeout: -1}
+	assert.NotNil(cfg.Validate())
+
+	// Test NRI configuration
+	cfg = &Config{EnableNRI: true, NRIConfigPath: "/etc/nri/conf.json", NRIBinPath: "/opt/nri/bin"}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{EnableNRI: true, NRIConfigPath: "conf.json", NRIBinPath: "/opt/nri/bin"}
+	assert.NotNil(cfg.Validate())
+
+	// Test init configuration
+	cfg = &Config{Init: true, InitPath: "/usr/bin/docker-init"}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{InitPath: "docker-init"}
+	assert.NotNil(cfg.Validate())
+
+	// Test rootfs dirs configuration
+	cfg = &Config{RootfsDirs: []string{"/data/rootfs"}}
+	assert.Equal(nil, cfg.Validate())
+
+	for _, dir := range []string{"rootfs", "/"} {
+		cfg = &Config{RootfsDirs: []string{dir}}
+		assert.NotNil(cfg.Validate())
+	}
+
+	// Test default ulimits configuration
+	cfg = &Config{DefaultUlimits: map[string]*units.Ulimit{"nofile": {Name: "nofile", Soft: 65536, Hard: 65536}}}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{DefaultUlimits: map[string]*units.Ulimit{"nofile": {Name: "nproc", Soft: 1024, Hard: 1024}}}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{DefaultUlimits: map[string]*units.Ulimit{"foo": {Name: "foo", Soft: 1024, Hard: 1024}}}
+	assert.NotNil(cfg.Validate())
+
+	cfg = &Config{DefaultUlimits: map[string]*units.Ulimit{"nofile": {Name: "nofile", Soft: 65536, Hard: 1024}}}
+	assert.NotNil(cfg.Validate())
+
 	// Test others configuration
 	cfg = &Config{
 		Debug: true,
@@ -300,3 +549,34 @@ func TestValidateCgroupDriver(t *testing.T) {
 		}
 	}
 }
+
+func TestLoadReloadableConfigurations(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test configuration file doesn't exist
+	cfg := &Config{
+		ConfigFile: "/tmp/non-existent.json",
+	}
+	fileConfig, reloaded, err := cfg.LoadReloadableConfigurations()
+	assert.NoError(err)
+	assert.Equal(&Config{}, fileConfig)
+	assert.Empty(reloaded)
+
+	configFile := "/tmp/test-reload-config.json"
+	body := []byte(`{
+		"debug": true,
+		"registry-mirrors": ["https://mirror.example.com"],
+		"label": ["a=b", "a=b"],
+		"home-dir": "/var/lib/pouch-reload"
+	}`)
+	assert.NoError(ioutil.WriteFile(configFile, body, 0644))
+	defer os.Remove(configFile)
+
+	cfg.ConfigFile = configFile
+	fileConfig, reloaded, err = cfg.LoadReloadableConfigurations()
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"debug": true, "registry-mirrors": true, "label": true}, reloaded)
+	assert.Equal(true, fileConfig.Debug)
+	assert.Equal([]string{"https://mirror.example.com"}, fileConfig.RegistryMirrors)
+	assert.Equal([]string{"a=b"}, fileConfig.Labels)
+}
@@ -84,6 +84,8 @@ func (ctrio *IO) Reset() {
 	ctrio.logdriver = nil
 	ctrio.logcopier = nil
 	ctrio.criLog = nil
+	ctrio.nonBlock = false
+	ctrio.maxBufferSize = 0
 }
 
 // SetLogDriver sets log driver to the IO.
@@ -6,7 +6,9 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"time"
 
+	"github.com/alibaba/pouch/apis/grpcserver"
 	"github.com/alibaba/pouch/apis/server"
 	criservice "github.com/alibaba/pouch/cri"
 	"github.com/alibaba/pouch/cri/stream"
@@ -18,8 +20,11 @@ import (
 	"github.com/alibaba/pouch/hookplugins"
 	"github.com/alibaba/pouch/internal"
 	"github.com/alibaba/pouch/network/mode"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/authz"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/nri"
 	"github.com/alibaba/pouch/pkg/system"
 
 	systemddaemon "github.com/coreos/go-systemd/daemon"
@@ -43,12 +48,14 @@ type Daemon struct {
 	volumeMgr       mgr.VolumeMgr
 	networkMgr      mgr.NetworkMgr
 	server          server.Server
+	grpcServer      grpcserver.Server
 	containerPlugin hookplugins.ContainerPlugin
 	imagePlugin     hookplugins.ImagePlugin
 	daemonPlugin    hookplugins.DaemonPlugin
 	volumePlugin    hookplugins.VolumePlugin
 	criPlugin       hookplugins.CriPlugin
 	apiPlugin       hookplugins.APIPlugin
+	specPlugin      hookplugins.SpecPlugin
 	eventsService   *events.Events
 }
 
@@ -69,38 +76,74 @@ func NewDaemon(cfg *config.Config) *Daemon {
 		return nil
 	}
 
-	// start containerd
-	ctrdDaemonOpts := []supervisord.Opt{
-		supervisord.WithOOMScore(cfg.OOMScoreAdjust),
-		supervisord.WithGRPCAddress(cfg.ContainerdAddr),
-	}
+	// start the private containerd supervised by pouchd, unless an
+	// external one is used.
+	var ctrdDaemon *supervisord.Daemon
+	ctrdAddr := cfg.ContainerdAddr
+	if !cfg.ExternalContainerd {
+		ctrdDaemonOpts := []supervisord.Opt{
+			supervisord.WithOOMScore(cfg.OOMScoreAdjust),
+			supervisord.WithGRPCAddress(cfg.ContainerdAddr),
+		}
 
-	if cfg.ContainerdPath != "" {
-		ctrdDaemonOpts = append(ctrdDaemonOpts, supervisord.WithContainerdBinary(cfg.ContainerdPath))
-	}
+		if cfg.ContainerdPath != "" {
+			ctrdDaemonOpts = append(ctrdDaemonOpts, supervisord.WithContainerdBinary(cfg.ContainerdPath))
+		}
+
+		if cfg.Debug {
+			ctrdDaemonOpts = append(ctrdDaemonOpts, supervisord.WithLogLevel("debug"))
+		}
+
+		ctrdDaemonOpts = append(ctrdDaemonOpts, supervisord.WithV1RuntimeShimDebug())
 
-	if cfg.Debug {
-		ctrdDaemonOpts = append(ctrdDaemonOpts, supervisord.WithLogLevel("debug"))
+		ctrdDaemon, err = supervisord.Start(context.TODO(),
+			filepath.Join(cfg.HomeDir, "containerd/root"),
+			filepath.Join(cfg.HomeDir, "containerd/state"),
+			ctrdDaemonOpts...,
+		)
+		if err != nil {
+			log.With(nil).Errorf("failed to start containerd: %v", err)
+			return nil
+		}
+		ctrdAddr = ctrdDaemon.Address()
 	}
 
-	ctrdDaemonOpts = append(ctrdDaemonOpts, supervisord.WithV1RuntimeShimDebug())
+	cgroupVersion := system.GetCgroupVersion()
+	log.With(nil).Infof("cgroup hierarchy version: v%s", cgroupVersion)
 
-	ctrdDaemon, err := supervisord.Start(context.TODO(),
-		filepath.Join(cfg.HomeDir, "containerd/root"),
-		filepath.Join(cfg.HomeDir, "containerd/state"),
-		ctrdDaemonOpts...,
-	)
+	downloadBandwidth, err := cfg.GetMaxDownloadBandwidth()
 	if err != nil {
-		log.With(nil).Errorf("failed to start containerd: %v", err)
+		log.With(nil).Errorf("failed to get max download bandwidth: %v", err)
 		return nil
 	}
 
 	// create containerd client
-	ctrdClient, err := ctrd.NewClient(
-		ctrd.WithRPCAddr(cfg.ContainerdAddr),
+	ctrdClientOpts := []ctrd.ClientOpt{
+		ctrd.WithRPCAddr(ctrdAddr),
 		ctrd.WithDefaultNamespace(cfg.DefaultNamespace),
 		ctrd.WithInsecureRegistries(cfg.InsecureRegistries),
-	)
+		ctrd.WithCgroupVersion(cgroupVersion),
+		ctrd.WithMaxConcurrentDownloads(cfg.MaxConcurrentDownloads),
+		ctrd.WithDownloadBandwidth(downloadBandwidth),
+		ctrd.WithLockMode(cfg.ContainerLockMode),
+		ctrd.WithLockTimeouts(cfg.GetContainerLockTimeouts()),
+		ctrd.WithHealthCheckInterval(time.Duration(cfg.ContainerdHealthCheckInterval) * time.Second),
+	}
+	if cfg.ContainerdClients > 0 {
+		ctrdClientOpts = append(ctrdClientOpts, ctrd.WithGrpcClientPoolCapacity(cfg.ContainerdClients))
+	}
+	if cfg.EnableNRI {
+		nriClient, err := nri.New(cfg.NRIConfigPath, cfg.NRIBinPath)
+		if err != nil {
+			log.With(nil).Errorf("failed to load NRI plugins: %v", err)
+			return nil
+		}
+		if nriClient == nil {
+			log.With(nil).Warnf("no NRI plugin is invoked since config %s doesn't exist", cfg.NRIConfigPath)
+		}
+		ctrdClientOpts = append(ctrdClientOpts, ctrd.WithNRI(nriClient))
+	}
+	ctrdClient, err := ctrd.NewClient(ctrdClientOpts...)
 	if err != nil {
 		log.With(nil).Errorf("failed to new containerd's client: %v", err)
 		return nil
@@ -110,11 +153,21 @@ func NewDaemon(cfg *config.Config) *Daemon {
 		ctrd.SetSnapshotterName(cfg.Snapshotter)
 	}
 
-	if err = ctrdClient.CheckSnapshotterValid(ctrd.CurrentSnapshotterName(context.TODO()), cfg.AllowMultiSnapshotter); err != nil {
+	// the remote snapshotter keeps the snapshots of lazily pulled images
+	// along with the snapshotter of daemon.
+	allowMultiSnapshotter := cfg.AllowMultiSnapshotter || cfg.RemoteSnapshotter != ""
+	if err = ctrdClient.CheckSnapshotterValid(ctrd.CurrentSnapshotterName(context.TODO()), allowMultiSnapshotter); err != nil {
 		log.With(nil).Errorf("failed to check snapshotter driver: %v", err)
 		return nil
 	}
 
+	if cfg.RemoteSnapshotter != "" {
+		if err = ctrdClient.CheckSnapshotterValid(cfg.RemoteSnapshotter, true); err != nil {
+			log.With(nil).Warnf("disable lazy pulling since remote snapshotter is invalid: %v", err)
+			cfg.RemoteSnapshotter = ""
+		}
+	}
+
 	log.With(nil).Infof("Snapshotter is set to be %s", ctrd.CurrentSnapshotterName(context.TODO()))
 
 	return &Daemon{
@@ -158,6 +211,11 @@ func (d *Daemon) loadPlugin() error {
 		d.apiPlugin = apiPlugin
 	}
 
+	// load spec plugin if exist
+	if specPlugin := hookplugins.GetSpecPlugin(); specPlugin != nil {
+		d.specPlugin = specPlugin
+	}
+
 	if d.daemonPlugin != nil {
 		log.With(nil).Infof("invoke pre-start hook in plugin")
 		if err = d.daemonPlugin.PreStartHook(); err != nil {
@@ -183,6 +241,9 @@ func (d *Daemon) Run() error {
 	}
 
 	d.eventsService = events.NewEvents()
+	if err := d.addEventSinks(); err != nil {
+		return err
+	}
 
 	imageMgr, err := internal.GenImageMgr(d.config, d)
 	if err != nil {
@@ -190,17 +251,17 @@ func (d *Daemon) Run() error {
 	}
 	d.imageMgr = imageMgr
 
-	systemMgr, err := internal.GenSystemMgr(d.config, d)
+	volumeMgr, err := internal.GenVolumeMgr(d.config, d)
 	if err != nil {
 		return err
 	}
-	d.systemMgr = systemMgr
+	d.volumeMgr = volumeMgr
 
-	volumeMgr, err := internal.GenVolumeMgr(d.config, d)
+	systemMgr, err := internal.GenSystemMgr(d.config, d)
 	if err != nil {
 		return err
 	}
-	d.volumeMgr = volumeMgr
+	d.systemMgr = systemMgr
 
 	containerMgr, err := internal.GenContainerMgr(ctx, d)
 	if err != nil {
@@ -248,6 +309,18 @@ func (d *Daemon) Run() error {
 
 	streamRouter := <-criStreamRouterCh
 
+	var authzPlugins []authz.Plugin
+	for _, name := range d.config.AuthorizationPlugins {
+		authzPlugins = append(authzPlugins, authz.NewPlugin(name))
+	}
+
+	authorizer := authz.NewAuthorizer(authzPlugins...)
+
+	auditLogger, err := audit.New(d.config.AuditLog)
+	if err != nil {
+		return err
+	}
+
 	d.server = server.Server{
 		Config:          d.config,
 		ContainerMgr:    containerMgr,
@@ -258,6 +331,22 @@ func (d *Daemon) Run() error {
 		StreamRouter:    streamRouter,
 		ContainerPlugin: d.containerPlugin,
 		APIPlugin:       d.apiPlugin,
+		Authorizer:      authorizer,
+		AuditLogger:     auditLogger,
+	}
+
+	// the grpc server is optional, it is started only if the listening
+	// addresses are specified.
+	if len(d.config.GRPCListen) > 0 {
+		d.grpcServer = grpcserver.Server{
+			Config:       d.config,
+			ContainerMgr: containerMgr,
+			Authorizer:   authorizer,
+			AuditLogger:  auditLogger,
+		}
+		if err := d.grpcServer.Start(); err != nil {
+			return err
+		}
 	}
 
 	httpReadyCh := make(chan bool)
@@ -307,11 +396,24 @@ func (d *Daemon) Run() error {
 	return nil
 }
 
-// Shutdown stops daemon.
+// Shutdown drains and stops daemon.
 func (d *Daemon) Shutdown() error {
 	var errMsg string
 
-	if err := d.server.Stop(); err != nil {
+	// drain and stopping http server share one shutdown timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(d.config.ShutdownTimeout)*time.Second)
+	defer cancel()
+
+	d.drain(ctx)
+
+	if err := d.server.Stop(ctx); err != nil {
+		errMsg = fmt.Sprintf("%s\n", err.Error())
+	}
+	d.grpcServer.Stop()
+
+	// close the audit log after the http server is stopped, so that the
+	// requests handled are all recorded.
+	if err := d.server.AuditLogger.Close(); err != nil {
 		errMsg = fmt.Sprintf("%s\n", err.Error())
 	}
 
@@ -320,8 +422,10 @@ func (d *Daemon) Shutdown() error {
 		errMsg = fmt.Sprintf("%s\n", err.Error())
 	}
 
-	if err := d.ctrdDaemon.Stop(); err != nil {
-		errMsg = fmt.Sprintf("%s\n", err.Error())
+	if d.ctrdDaemon != nil {
+		if err := d.ctrdDaemon.Stop(); err != nil {
+			errMsg = fmt.Sprintf("%s\n", err.Error())
+		}
 	}
 
 	if errMsg != "" {
@@ -330,6 +434,14 @@ func (d *Daemon) Shutdown() error {
 	return nil
 }
 
+// Reload reloads daemon config from config file.
+func (d *Daemon) Reload() error {
+	if d.systemMgr == nil {
+		return fmt.Errorf("daemon is not ready")
+	}
+	return d.systemMgr.ReloadDaemon(context.Background())
+}
+
 // Config gets config of daemon.
 func (d *Daemon) Config() *config.Config {
 	return d.config
@@ -379,6 +491,11 @@ func (d *Daemon) ImagePlugin() hookplugins.ImagePlugin {
 	return d.imagePlugin
 }
 
+// SpecPlugin returns the spec plugin fetched from shared file
+func (d *Daemon) SpecPlugin() hookplugins.SpecPlugin {
+	return d.specPlugin
+}
+
 // ShutdownPlugin invoke pre-stop method in daemon plugin if exist
 func (d *Daemon) ShutdownPlugin() error {
 	if d.daemonPlugin != nil {
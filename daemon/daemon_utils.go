@@ -59,7 +59,7 @@ func initialRuntime(baseDir string, runtimes map[string]types.Runtime) error {
 			r.Type = ctrd.RuntimeTypeV1
 		}
 
-		options := getRuntimeOptionsType(r.Type)
+		options := getRuntimeOptionsType(r.Type, r.Options)
 		if options != nil {
 			// convert general json map to specific options type
 			b, err := json.Marshal(r.Options)
@@ -71,6 +71,12 @@ func initialRuntime(baseDir string, runtimes map[string]types.Runtime) error {
 			}
 		}
 
+		if o, ok := options.(*ctrd.RunscOptions); ok {
+			if err := o.Validate(filepath.Join(baseDir, ctrd.RunscDebugLogDir)); err != nil {
+				return fmt.Errorf("invalid options, runtime: %s: %v", name, err)
+			}
+		}
+
 		r.Options = options
 
 		runtimes[name] = r
@@ -79,12 +85,28 @@ func initialRuntime(baseDir string, runtimes map[string]types.Runtime) error {
 	return nil
 }
 
-func getRuntimeOptionsType(runtimeType string) interface{} {
+// getRuntimeOptionsType returns the options type of runtime, the kata and
+// gVisor shims are configured by the config file if config_path is set, and
+// the gVisor shim is also configured by the flags of runsc.
+func getRuntimeOptionsType(runtimeType string, options interface{}) interface{} {
 	switch runtimeType {
-	case
-		ctrd.RuntimeTypeV1,
-		ctrd.RuntimeTypeV2runscV1,
-		ctrd.RuntimeTypeV2kataV2:
+	case ctrd.RuntimeTypeV2runscV1:
+		if opts, ok := options.(map[string]interface{}); ok {
+			for _, k := range []string{"config_path", "platform", "network", "debug_log"} {
+				if _, ok := opts[k]; ok {
+					return &ctrd.RunscOptions{}
+				}
+			}
+		}
+		return &runctypes.RuncOptions{}
+	case ctrd.RuntimeTypeV2kataV2:
+		if opts, ok := options.(map[string]interface{}); ok {
+			if _, ok := opts["config_path"]; ok {
+				return &ctrd.RuntimeOptions{}
+			}
+		}
+		return &runctypes.RuncOptions{}
+	case ctrd.RuntimeTypeV1:
 		return &runctypes.RuncOptions{}
 	case ctrd.RuntimeTypeV2runcV1:
 		return &runcoptions.Options{}
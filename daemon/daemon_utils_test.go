@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
 
+	"github.com/containerd/containerd/runtime/linux/runctypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +67,44 @@ func TestInitialRuntime(t *testing.T) {
 		}
 	}
 }
+
+func TestInitialRuntimeOptions(t *testing.T) {
+	assert := assert.New(t)
+	tmpDir, err := ioutil.TempDir("", "runtime-options")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	debugLog := filepath.Join(tmpDir, ctrd.RunscDebugLogDir) + "/"
+	runtimes := map[string]types.Runtime{
+		"kata": {
+			Type:    ctrd.RuntimeTypeV2kataV2,
+			Options: map[string]interface{}{"config_path": "/etc/kata-containers/configuration-qemu.toml"},
+		},
+		"runsc": {
+			Type:    ctrd.RuntimeTypeV2runscV1,
+			Options: map[string]interface{}{"runtime_root": "/run/runsc"},
+		},
+		"runsc-kvm": {
+			Type:    ctrd.RuntimeTypeV2runscV1,
+			Options: map[string]interface{}{"platform": "kvm", "debug_log": debugLog},
+		},
+	}
+	assert.NoError(initialRuntime(tmpDir, runtimes))
+
+	assert.Equal(&ctrd.RuntimeOptions{ConfigPath: "/etc/kata-containers/configuration-qemu.toml"}, runtimes["kata"].Options)
+	assert.Equal(&runctypes.RuncOptions{RuntimeRoot: "/run/runsc"}, runtimes["runsc"].Options)
+	assert.Equal(&ctrd.RunscOptions{Platform: "kvm", DebugLog: debugLog}, runtimes["runsc-kvm"].Options)
+
+	assert.Error(initialRuntime(tmpDir, map[string]types.Runtime{
+		"runsc": {
+			Type:    ctrd.RuntimeTypeV2runscV1,
+			Options: map[string]interface{}{"platform": "xen"},
+		},
+	}))
+	assert.Error(initialRuntime(tmpDir, map[string]types.Runtime{
+		"runsc": {
+			Type:    ctrd.RuntimeTypeV2runscV1,
+			Options: map[string]interface{}{"debug_log": "/var/log/runsc/"},
+		},
+	}))
+}
@@ -0,0 +1,69 @@
+package daemon
+
+import (
+	"context"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+// drain prepares daemon to exit: requests which create or start containers
+// are rejected, running containers are stopped if configured, and the events
+// are flushed to the subscribers. It waits until ctx is done at most.
+func (d *Daemon) drain(ctx context.Context) {
+	log.With(nil).Infof("start to drain pouchd")
+	d.server.Drain()
+
+	if d.config.ShutdownStopContainers && d.containerMgr != nil {
+		d.stopContainers(ctx)
+	}
+
+	if d.eventsService != nil {
+		d.eventsService.Publish(ctx, "shutdown", types.EventTypeDaemon, nil)
+		if err := d.eventsService.Close(); err != nil {
+			log.With(nil).Errorf("failed to flush events: %v", err)
+		}
+	}
+}
+
+// stopContainers stops all the running containers with their own stop
+// timeout in parallel, and returns when all of them are stopped or ctx is done.
+func (d *Daemon) stopContainers(ctx context.Context) {
+	containers, err := d.containerMgr.List(ctx, &mgr.ContainerListOption{
+		All: true,
+		FilterFunc: func(c *mgr.Container) bool {
+			return c.IsRunningOrPaused()
+		},
+	})
+	if err != nil {
+		log.With(nil).Errorf("failed to list running containers: %v", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range containers {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			// timeout 0 means using the stop timeout of container.
+			if err := d.containerMgr.Stop(ctx, id, 0); err != nil {
+				log.With(nil).Errorf("failed to stop container %s: %v", id, err)
+			}
+		}(c.ID)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.With(nil).Infof("stopped %d running containers", len(containers))
+	case <-ctx.Done():
+		log.With(nil).Errorf("timeout to stop running containers")
+	}
+}
@@ -0,0 +1,35 @@
+package daemon
+
+import (
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/daemon/events"
+
+	goevents "github.com/docker/go-events"
+)
+
+// addEventSinks forwards the daemon events to the sinks in daemon config.
+func (d *Daemon) addEventSinks() error {
+	for _, cfg := range d.config.EventSinks {
+		ef := filters.NewArgs()
+		for k, values := range cfg.Filters {
+			for _, v := range values {
+				ef.Add(k, v)
+			}
+		}
+		if err := events.ValidateFilter(ef); err != nil {
+			return err
+		}
+
+		var sink goevents.Sink
+		switch cfg.Type {
+		case config.EventSinkWebhook:
+			sink = events.NewWebhookSink(cfg.Address)
+		case config.EventSinkNATS:
+			sink = events.NewNATSSink(cfg.Address, cfg.Subject)
+		}
+
+		d.eventsService.AddSink(sink, events.NewFilter(ef))
+	}
+	return nil
+}
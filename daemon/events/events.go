@@ -144,6 +144,9 @@ func (e *Events) Subscribe(ctx context.Context, since, until time.Time, ef *Filt
 				}
 			case <-ctx.Done():
 				break loop
+			case <-channel.Done():
+				// the events service has been closed.
+				break loop
 			}
 		}
 
@@ -159,6 +162,12 @@ func (e *Events) Subscribe(ctx context.Context, since, until time.Time, ef *Filt
 	return buffered, evch, errq
 }
 
+// Close flushes the events to all the subscribers and closes them, no more
+// events can be published after that.
+func (e *Events) Close() error {
+	return e.broadcaster.Close()
+}
+
 // filterBufferedEvents iterates over the cached events in the buffer
 // and returns those that were emitted between two specific dates.
 func (e *Events) filterBufferedEvents(since, until time.Time, ef *Filter) []types.EventsMessage {
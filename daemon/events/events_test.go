@@ -115,3 +115,40 @@ func TestBasicSubscribe(t *testing.T) {
 		}
 	}
 }
+
+func TestCloseFlushesSubscribers(t *testing.T) {
+	ctx := context.Background()
+	eventsService := NewEvents()
+
+	_, eventq, errq := eventsService.Subscribe(ctx, time.Time{}, time.Time{}, nil)
+
+	published := make(chan error, 1)
+	go func() {
+		published <- eventsService.Publish(ctx, "shutdown", types.EventTypeDaemon, nil)
+	}()
+
+	select {
+	case ev := <-eventq:
+		if ev.Action != "shutdown" {
+			t.Fatalf("expected shutdown event, got %s", ev.Action)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout to receive event")
+	}
+	if err := <-published; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := eventsService.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-errq:
+		if err != nil {
+			t.Fatalf("expected subscriber closed without error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout to close subscriber")
+	}
+}
@@ -1,10 +1,25 @@
 package events
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
 )
 
+// acceptedEventFilterTags is the filter tags set allowed when pouch events -f
+var acceptedEventFilterTags = map[string]bool{
+	"event":     true,
+	"type":      true,
+	"container": true,
+	"image":     true,
+	"volume":    true,
+	"network":   true,
+	"daemon":    true,
+	"label":     true,
+}
+
 // Filter uses to filter out pouch events from a stream
 type Filter struct {
 	filter filters.Args
@@ -15,9 +30,80 @@ func NewFilter(filter filters.Args) *Filter {
 	return &Filter{filter: filter}
 }
 
+// ValidateFilter checks whether all the filter tags are supported by events.
+func ValidateFilter(filter filters.Args) error {
+	if err := filter.Validate(acceptedEventFilterTags); err != nil {
+		return fmt.Errorf("invalid events filter: %v", err)
+	}
+	return nil
+}
+
 // Match returns true when the event ev is included by the filters
 func (ef *Filter) Match(ev types.EventsMessage) bool {
-	// TODO(ziren): add more filters
+	var (
+		id         = ev.ID
+		attributes map[string]string
+	)
+	if ev.Actor != nil {
+		id = ev.Actor.ID
+		attributes = ev.Actor.Attributes
+	}
+
 	return ef.filter.ExactMatch("event", ev.Action) &&
-		ef.filter.ExactMatch("type", string(ev.Type))
+		ef.filter.ExactMatch("type", string(ev.Type)) &&
+		ef.matchTyped("container", types.EventTypeContainer, ev.Type, id, attributes["name"]) &&
+		ef.matchImage(ev.Type, id, attributes) &&
+		ef.matchTyped("volume", types.EventTypeVolume, ev.Type, id, "") &&
+		ef.matchTyped("network", types.EventTypeNetwork, ev.Type, id, attributes["name"]) &&
+		ef.matchTyped("daemon", types.EventTypeDaemon, ev.Type, id, attributes["name"]) &&
+		ef.filter.MatchKVList("label", attributes)
+}
+
+// matchTyped matches the event of type typ by its id or name, id supports
+// prefix matching. The events of other types are filtered out if the field
+// is set.
+func (ef *Filter) matchTyped(field string, typ, evType types.EventType, id, name string) bool {
+	if !ef.filter.Contains(field) {
+		return true
+	}
+	if evType != typ {
+		return false
+	}
+	return ef.fuzzyMatch(field, id, name)
+}
+
+// matchImage matches the image events by the image id or reference, and
+// the container events by the image that the container is created from.
+func (ef *Filter) matchImage(evType types.EventType, id string, attributes map[string]string) bool {
+	if !ef.filter.Contains("image") {
+		return true
+	}
+
+	switch evType {
+	case types.EventTypeImage:
+		return ef.fuzzyMatch("image", id, attributes["name"])
+	case types.EventTypeContainer:
+		return ef.fuzzyMatch("image", "", attributes["image"])
+	default:
+		return false
+	}
+}
+
+// fuzzyMatch returns true if one of the values at field is the name or the
+// prefix of the id, the digest algorithm of id can be omitted.
+func (ef *Filter) fuzzyMatch(field, id, name string) bool {
+	shortID := id
+	if i := strings.Index(id, ":"); i >= 0 {
+		shortID = id[i+1:]
+	}
+
+	for _, v := range ef.filter.Get(field) {
+		if v == "" {
+			continue
+		}
+		if (name != "" && v == name) || (id != "" && (strings.HasPrefix(id, v) || strings.HasPrefix(shortID, v))) {
+			return true
+		}
+	}
+	return false
 }
@@ -52,6 +52,107 @@ func TestFilter_Match(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "container by name",
+			fields: fields{
+				filter: filters.NewArgs(filters.Arg("container", "foo")),
+			},
+			args: args{
+				ev: types.EventsMessage{
+					Action: "start",
+					Type:   types.EventTypeContainer,
+					Actor: &types.EventsActor{
+						ID:         "9a8b7c6d5e4f",
+						Attributes: map[string]string{"name": "foo", "image": "busybox:latest"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "container by id prefix",
+			fields: fields{
+				filter: filters.NewArgs(filters.Arg("container", "9a8b")),
+			},
+			args: args{
+				ev: types.EventsMessage{
+					Action: "die",
+					Type:   types.EventTypeContainer,
+					Actor: &types.EventsActor{
+						ID:         "9a8b7c6d5e4f",
+						Attributes: map[string]string{"name": "foo"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "container filter drops other types",
+			fields: fields{
+				filter: filters.NewArgs(filters.Arg("container", "foo")),
+			},
+			args: args{
+				ev: types.EventsMessage{
+					Action: "create",
+					Type:   types.EventTypeVolume,
+					Actor: &types.EventsActor{
+						ID: "foo",
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "image matches container events",
+			fields: fields{
+				filter: filters.NewArgs(filters.Arg("image", "busybox:latest")),
+			},
+			args: args{
+				ev: types.EventsMessage{
+					Action: "create",
+					Type:   types.EventTypeContainer,
+					Actor: &types.EventsActor{
+						ID:         "9a8b7c6d5e4f",
+						Attributes: map[string]string{"name": "foo", "image": "busybox:latest"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "image by id without algorithm",
+			fields: fields{
+				filter: filters.NewArgs(filters.Arg("image", "2b8fd9751c4c")),
+			},
+			args: args{
+				ev: types.EventsMessage{
+					Action: "delete",
+					Type:   types.EventTypeImage,
+					Actor: &types.EventsActor{
+						ID:         "sha256:2b8fd9751c4c0f5dd266fcae00707e67a2545ef34f9a29354585f93dac906749",
+						Attributes: map[string]string{"name": "busybox:latest"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "label",
+			fields: fields{
+				filter: filters.NewArgs(filters.Arg("label", "env=prod")),
+			},
+			args: args{
+				ev: types.EventsMessage{
+					Action: "create",
+					Type:   types.EventTypeContainer,
+					Actor: &types.EventsActor{
+						ID:         "9a8b7c6d5e4f",
+						Attributes: map[string]string{"env": "dev"},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -64,3 +165,12 @@ func TestFilter_Match(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFilter(t *testing.T) {
+	if err := ValidateFilter(filters.NewArgs(filters.Arg("container", "foo"), filters.Arg("label", "a=b"))); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := ValidateFilter(filters.NewArgs(filters.Arg("foo", "bar"))); err == nil {
+		t.Errorf("expected error for unknown filter")
+	}
+}
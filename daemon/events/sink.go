@@ -0,0 +1,236 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	goevents "github.com/docker/go-events"
+)
+
+const (
+	// sinkTimeout is the timeout of writing one event to sink.
+	sinkTimeout = 10 * time.Second
+
+	// sinkRetries is the number of retries before dropping the event.
+	sinkRetries = 3
+
+	// sinkRetryBackoff is the interval between retries.
+	sinkRetryBackoff = time.Second
+)
+
+// AddSink forwards the events matched by the filter to the sink. Events are
+// written asynchronously, so that a slow or broken sink doesn't block the
+// publishers, and are dropped after a few failed retries.
+func (e *Events) AddSink(sink goevents.Sink, ef *Filter) {
+	var dst goevents.Sink = goevents.NewQueue(&retrySink{sink: sink})
+
+	if ef != nil && ef.filter.Len() > 0 {
+		dst = goevents.NewFilter(dst, goevents.MatcherFunc(func(gev goevents.Event) bool {
+			msg := gev.(*types.EventsMessage)
+			return ef.Match(*msg)
+		}))
+	}
+
+	e.broadcaster.Add(dst)
+}
+
+// retrySink retries the failed writes before dropping the event.
+type retrySink struct {
+	sink goevents.Sink
+}
+
+// Write implements goevents.Sink.
+func (s *retrySink) Write(ev goevents.Event) error {
+	var err error
+	for i := 0; i < sinkRetries; i++ {
+		if i > 0 {
+			time.Sleep(sinkRetryBackoff)
+		}
+		if err = s.sink.Write(ev); err == nil {
+			return nil
+		}
+	}
+
+	msg := ev.(*types.EventsMessage)
+	log.With(nil).Errorf("failed to write event {action: %s, type: %s, id: %s} to %s, drop it: %v", msg.Action, msg.Type, msg.ID, s.sink, err)
+	return err
+}
+
+// Close implements goevents.Sink.
+func (s *retrySink) Close() error {
+	return s.sink.Close()
+}
+
+// WebhookSink posts the events to a http webhook in json.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a sink posting events to url.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: sinkTimeout},
+	}
+}
+
+// Write implements goevents.Sink.
+func (s *WebhookSink) Write(ev goevents.Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returns unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// Close implements goevents.Sink.
+func (s *WebhookSink) Close() error {
+	return nil
+}
+
+// String returns the description of sink.
+func (s *WebhookSink) String() string {
+	return "webhook " + s.url
+}
+
+// NATSSink publishes the events in json to a nats subject. It speaks the
+// nats text protocol directly and flushes every publication with PING, so
+// that a write succeeds only if the server has processed the message.
+type NATSSink struct {
+	address string
+	subject string
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// NewNATSSink creates a sink publishing events to the subject of nats
+// server at address.
+func NewNATSSink(address, subject string) *NATSSink {
+	return &NATSSink{
+		address: strings.TrimPrefix(address, "nats://"),
+		subject: subject,
+	}
+}
+
+// Write implements goevents.Sink.
+func (s *NATSSink) Write(ev goevents.Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.publish(data); err != nil {
+		// reconnect in next write.
+		s.conn.Close()
+		s.conn, s.r = nil, nil
+		return err
+	}
+	return nil
+}
+
+// connect dials the nats server and sends CONNECT after receiving INFO.
+func (s *NATSSink) connect() error {
+	conn, err := net.DialTimeout("tcp", s.address, sinkTimeout)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(sinkTimeout))
+
+	r := bufio.NewReader(conn)
+	line, err := r.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO") {
+		conn.Close()
+		return fmt.Errorf("unexpected greeting from nats server %s: %s", s.address, strings.TrimSpace(line))
+	}
+
+	if _, err := conn.Write([]byte("CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"pouchd\"}\r\n")); err != nil {
+		conn.Close()
+		return err
+	}
+
+	s.conn, s.r = conn, r
+	return nil
+}
+
+// publish sends the message and waits for PONG of the following PING.
+func (s *NATSSink) publish(data []byte) error {
+	s.conn.SetDeadline(time.Now().Add(sinkTimeout))
+
+	msg := fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", s.subject, len(data), data)
+	if _, err := s.conn.Write([]byte(msg)); err != nil {
+		return err
+	}
+
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := s.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server %s returns error: %s", s.address, line)
+		}
+	}
+}
+
+// Close implements goevents.Sink.
+func (s *NATSSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn, s.r = nil, nil
+	return err
+}
+
+// String returns the description of sink.
+func (s *NATSSink) String() string {
+	return fmt.Sprintf("nats %s/%s", s.address, s.subject)
+}
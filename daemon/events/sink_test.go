@@ -0,0 +1,103 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan types.EventsMessage, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg types.EventsMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- msg
+	}))
+	defer srv.Close()
+
+	e := NewEvents()
+	e.AddSink(NewWebhookSink(srv.URL), NewFilter(filters.NewArgs(filters.Arg("event", "die"))))
+
+	e.Publish(nil, "start", types.EventTypeContainer, &types.EventsActor{ID: "foo"})
+	e.Publish(nil, "die", types.EventTypeContainer, &types.EventsActor{
+		ID:         "foo",
+		Attributes: map[string]string{"exitCode": "137"},
+	})
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "die", msg.Action)
+		assert.Equal(t, "137", msg.Actor.Attributes["exitCode"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout to wait for webhook")
+	}
+	assert.NoError(t, e.Close())
+}
+
+func TestWebhookSinkStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookSink(srv.URL).Write(&types.EventsMessage{Action: "die"})
+	assert.Error(t, err)
+}
+
+func TestNATSSink(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	published := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.Write([]byte("INFO {}\r\n"))
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "PUB "):
+				payload, _ := r.ReadString('\n')
+				published <- strings.Fields(line)[1] + " " + strings.TrimSpace(payload)
+			case strings.HasPrefix(line, "PING"):
+				conn.Write([]byte("PONG\r\n"))
+			}
+		}
+	}()
+
+	s := NewNATSSink("nats://"+l.Addr().String(), "pouch.events")
+	defer s.Close()
+
+	assert.NoError(t, s.Write(&types.EventsMessage{Action: "oom"}))
+	select {
+	case got := <-published:
+		assert.True(t, strings.HasPrefix(got, "pouch.events {"))
+		assert.Contains(t, got, `"action":"oom"`)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout to wait for publication")
+	}
+}
@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Creator creates the log driver with the container information.
+type Creator func(info Info) (LogDriver, error)
+
+// LogOptValidator validates the log options of container in info.
+type LogOptValidator func(info Info) error
+
+type driverFactory struct {
+	mu         sync.RWMutex
+	creators   map[string]Creator
+	validators map[string]LogOptValidator
+}
+
+var factory = &driverFactory{
+	creators:   make(map[string]Creator),
+	validators: make(map[string]LogOptValidator),
+}
+
+// RegisterLogDriver registers the creator of log driver by name, the log
+// drivers register themselves in init function.
+func RegisterLogDriver(name string, c Creator) error {
+	factory.mu.Lock()
+	defer factory.mu.Unlock()
+
+	if _, exist := factory.creators[name]; exist {
+		return fmt.Errorf("log driver %s is already registered", name)
+	}
+	factory.creators[name] = c
+	return nil
+}
+
+// RegisterLogOptValidator registers the validator of log options of log
+// driver by name.
+func RegisterLogOptValidator(name string, v LogOptValidator) error {
+	factory.mu.Lock()
+	defer factory.mu.Unlock()
+
+	if _, exist := factory.validators[name]; exist {
+		return fmt.Errorf("log options validator of %s is already registered", name)
+	}
+	factory.validators[name] = v
+	return nil
+}
+
+// GetLogDriver returns the creator of log driver by name.
+func GetLogDriver(name string) (Creator, error) {
+	factory.mu.RLock()
+	defer factory.mu.RUnlock()
+
+	c, exist := factory.creators[name]
+	if !exist {
+		return nil, fmt.Errorf("not support (%v) log driver yet", name)
+	}
+	return c, nil
+}
+
+// ValidateLogOpts validates the log options by the validator of log driver,
+// the options are valid if the log driver has no validator.
+func ValidateLogOpts(name string, info Info) error {
+	factory.mu.RLock()
+	_, exist := factory.creators[name]
+	v := factory.validators[name]
+	factory.mu.RUnlock()
+
+	if !exist {
+		return fmt.Errorf("not support (%v) log driver yet", name)
+	}
+	if v == nil {
+		return nil
+	}
+	return v(info)
+}
+
+// ListDrivers returns the names of registered log drivers.
+func ListDrivers() []string {
+	factory.mu.RLock()
+	defer factory.mu.RUnlock()
+
+	names := make([]string, 0, len(factory.creators))
+	for name := range factory.creators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLogDriverFactory(t *testing.T) {
+	name := "fake-driver"
+	creator := func(info Info) (LogDriver, error) {
+		return nil, nil
+	}
+	validator := func(info Info) error {
+		if _, ok := info.LogConfig["invalid"]; ok {
+			return fmt.Errorf("invalid option")
+		}
+		return nil
+	}
+
+	defer func() {
+		factory.mu.Lock()
+		delete(factory.creators, name)
+		delete(factory.validators, name)
+		factory.mu.Unlock()
+	}()
+
+	if _, err := GetLogDriver(name); err == nil {
+		t.Fatalf("expected error for unregistered log driver %s", name)
+	}
+	if err := ValidateLogOpts(name, Info{}); err == nil {
+		t.Fatalf("expected error for validating unregistered log driver %s", name)
+	}
+
+	if err := RegisterLogDriver(name, creator); err != nil {
+		t.Fatalf("unexpected error during registering log driver: %v", err)
+	}
+	if err := RegisterLogDriver(name, creator); err == nil {
+		t.Fatalf("expected error for registering log driver %s twice", name)
+	}
+
+	if _, err := GetLogDriver(name); err != nil {
+		t.Fatalf("unexpected error during getting log driver: %v", err)
+	}
+	if err := ValidateLogOpts(name, Info{LogConfig: map[string]string{"invalid": ""}}); err != nil {
+		t.Fatalf("expected no error for log driver without validator, but got %v", err)
+	}
+
+	if err := RegisterLogOptValidator(name, validator); err != nil {
+		t.Fatalf("unexpected error during registering validator: %v", err)
+	}
+	if err := ValidateLogOpts(name, Info{LogConfig: map[string]string{"invalid": ""}}); err == nil {
+		t.Fatalf("expected error for invalid log options")
+	}
+	if err := ValidateLogOpts(name, Info{}); err != nil {
+		t.Fatalf("unexpected error during validating log options: %v", err)
+	}
+
+	if got := ListDrivers(); !reflect.DeepEqual(got, []string{name}) {
+		t.Fatalf("expected log drivers %v, but got %v", []string{name}, got)
+	}
+}
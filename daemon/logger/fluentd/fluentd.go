@@ -0,0 +1,241 @@
+package fluentd
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/daemon/logger"
+	"github.com/alibaba/pouch/daemon/logger/loggerutils"
+	"github.com/alibaba/pouch/pkg/bytefmt"
+
+	"github.com/ugorji/go/codec"
+)
+
+// name is the name of fluentd log driver.
+const name = "fluentd"
+
+const (
+	defaultTagTemplate = "{{.ID}}"
+
+	defaultHost        = "127.0.0.1"
+	defaultPort        = 24224
+	defaultBufferLimit = uint64(8 * 1024 * 1024)
+	defaultRetryWait   = time.Second
+	defaultMaxRetries  = 10
+
+	addressKey     = "fluentd-address"
+	asyncKey       = "fluentd-async"
+	bufferLimitKey = "fluentd-buffer-limit"
+	retryWaitKey   = "fluentd-retry-wait"
+	maxRetriesKey  = "fluentd-max-retries"
+)
+
+var msgpackHandle = &codec.MsgpackHandle{}
+
+func init() {
+	if err := logger.RegisterLogDriver(name, Init); err != nil {
+		panic(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateLogOpt); err != nil {
+		panic(err)
+	}
+}
+
+// Fluentd sends the log data to fluentd in forward protocol.
+type Fluentd struct {
+	tag           string
+	containerID   string
+	containerName string
+	extra         map[string]string
+
+	writer *forwardWriter
+}
+
+type options struct {
+	tag         string
+	network     string
+	address     string
+	async       bool
+	bufferLimit uint64
+	retryWait   time.Duration
+	maxRetries  int
+}
+
+// Init return the Fluentd log driver.
+func Init(info logger.Info) (logger.LogDriver, error) {
+	return NewFluentd(info)
+}
+
+// NewFluentd returns new Fluentd based on the log config. It connects to
+// fluentd immediately unless fluentd-async is enabled.
+func NewFluentd(info logger.Info) (*Fluentd, error) {
+	opts, err := parseOptions(info)
+	if err != nil {
+		return nil, err
+	}
+
+	extra, err := info.ExtraAttributes(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := newForwardWriter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fluentd{
+		tag:           opts.tag,
+		containerID:   info.FullID(),
+		containerName: "/" + info.Name(),
+		extra:         extra,
+		writer:        w,
+	}, nil
+}
+
+// Name return the log driver's name.
+func (f *Fluentd) Name() string {
+	return name
+}
+
+// WriteLogMessage will send the LogMessage to fluentd.
+func (f *Fluentd) WriteLogMessage(msg *logger.LogMessage) error {
+	record := make(map[string]string, len(f.extra)+4)
+	for k, v := range f.extra {
+		record[k] = v
+	}
+	record["container_id"] = f.containerID
+	record["container_name"] = f.containerName
+	record["source"] = msg.Source
+	record["log"] = strings.TrimSuffix(string(msg.Line), "\n")
+
+	ts := msg.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	// the entry is encoded as [tag, time, record] in message mode.
+	var data []byte
+	if err := codec.NewEncoderBytes(&data, msgpackHandle).Encode([]interface{}{f.tag, ts.Unix(), record}); err != nil {
+		return err
+	}
+	return f.writer.post(data)
+}
+
+// Close flushes the buffered log data and closes the connection.
+func (f *Fluentd) Close() error {
+	return f.writer.close()
+}
+
+// ValidateLogOpt validates the fluentd log options.
+func ValidateLogOpt(info logger.Info) error {
+	for key := range info.LogConfig {
+		switch key {
+		case "tag", "labels", "env", "env-regex":
+		case addressKey, asyncKey, bufferLimitKey, retryWaitKey, maxRetriesKey:
+		default:
+			return fmt.Errorf("unknown log opt '%s' for fluentd log driver", key)
+		}
+	}
+
+	_, err := parseOptions(info)
+	return err
+}
+
+// parseOptions parses the log config into options.
+func parseOptions(info logger.Info) (*options, error) {
+	var err error
+	opts := &options{
+		bufferLimit: defaultBufferLimit,
+		retryWait:   defaultRetryWait,
+		maxRetries:  defaultMaxRetries,
+	}
+
+	opts.tag, err = loggerutils.GenerateLogTag(info, defaultTagTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.network, opts.address, err = parseAddress(info.LogConfig[addressKey])
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := info.LogConfig[asyncKey]; ok {
+		if opts.async, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid value %s of %s: %v", v, asyncKey, err)
+		}
+	}
+
+	if v, ok := info.LogConfig[bufferLimitKey]; ok {
+		if opts.bufferLimit, err = bytefmt.ToBytes(v); err != nil {
+			return nil, fmt.Errorf("invalid value %s of %s: %v", v, bufferLimitKey, err)
+		}
+	}
+
+	if v, ok := info.LogConfig[retryWaitKey]; ok {
+		if opts.retryWait, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("invalid value %s of %s: %v", v, retryWaitKey, err)
+		}
+		if opts.retryWait <= 0 {
+			return nil, fmt.Errorf("%s should be positive, but got %s", retryWaitKey, v)
+		}
+	}
+
+	if v, ok := info.LogConfig[maxRetriesKey]; ok {
+		if opts.maxRetries, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("invalid value %s of %s: %v", v, maxRetriesKey, err)
+		}
+		if opts.maxRetries < 0 {
+			return nil, fmt.Errorf("%s cannot be negative, but got %s", maxRetriesKey, v)
+		}
+	}
+	return opts, nil
+}
+
+// parseAddress parses the address of fluentd, which can be host, host:port,
+// tcp://host:port or unix:///path/to/socket.
+func parseAddress(address string) (string, string, error) {
+	if address == "" {
+		return "tcp", net.JoinHostPort(defaultHost, strconv.Itoa(defaultPort)), nil
+	}
+
+	if !strings.Contains(address, "://") {
+		address = "tcp://" + address
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid %s %s: %v", addressKey, address, err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			return "", "", fmt.Errorf("invalid %s %s: socket path is required", addressKey, address)
+		}
+		return "unix", u.Path, nil
+	case "tcp":
+		if u.Path != "" && u.Path != "/" {
+			return "", "", fmt.Errorf("invalid %s %s: path is not allowed", addressKey, address)
+		}
+
+		host, port := u.Hostname(), u.Port()
+		if host == "" {
+			host = defaultHost
+		}
+		if port == "" {
+			port = strconv.Itoa(defaultPort)
+		}
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return "", "", fmt.Errorf("invalid %s %s: invalid port %s", addressKey, address, port)
+		}
+		return "tcp", net.JoinHostPort(host, port), nil
+	default:
+		return "", "", fmt.Errorf("invalid %s %s: unsupported protocol %s", addressKey, address, u.Scheme)
+	}
+}
@@ -0,0 +1,215 @@
+package fluentd
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/daemon/logger"
+
+	"github.com/ugorji/go/codec"
+)
+
+// readEntry decodes one [tag, time, record] entry from the connection.
+func readEntry(t *testing.T, conn net.Conn) (string, map[string]string) {
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+
+	var entry []interface{}
+	if err := codec.NewDecoder(conn, &codec.MsgpackHandle{RawToString: true}).Decode(&entry); err != nil {
+		t.Fatalf("unexpected error during decode entry: %v", err)
+	}
+	if len(entry) != 3 {
+		t.Fatalf("expected entry with 3 elements, but got %v", entry)
+	}
+
+	record := make(map[string]string)
+	for k, v := range entry[2].(map[interface{}]interface{}) {
+		record[k.(string)] = v.(string)
+	}
+	return entry[0].(string), record
+}
+
+func TestFluentdWriteLogMessage(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error during listen: %v", err)
+	}
+	defer l.Close()
+
+	f, err := NewFluentd(logger.Info{
+		LogConfig: map[string]string{
+			addressKey: l.Addr().String(),
+			"tag":      "app.{{.Name}}",
+			"env":      "REGION",
+		},
+		ContainerID:   "6a4ad1b2c3d4e5f60718293a4b5c6d7e8f90123456789abcdef0123456789abc",
+		ContainerName: "web",
+		ContainerEnvs: []string{"REGION=hangzhou"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error during create Fluentd: %v", err)
+	}
+	defer f.Close()
+
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatalf("unexpected error during accept: %v", err)
+	}
+	defer conn.Close()
+
+	if err := f.WriteLogMessage(&logger.LogMessage{Source: "stderr", Line: []byte("hello\n"), Timestamp: time.Now()}); err != nil {
+		t.Fatalf("unexpected error during write log message: %v", err)
+	}
+
+	tag, record := readEntry(t, conn)
+	if tag != "app.web" {
+		t.Fatalf("expected tag app.web, but got %s", tag)
+	}
+	for k, v := range map[string]string{
+		"container_id":   "6a4ad1b2c3d4e5f60718293a4b5c6d7e8f90123456789abcdef0123456789abc",
+		"container_name": "/web",
+		"source":         "stderr",
+		"log":            "hello",
+		"REGION":         "hangzhou",
+	} {
+		if record[k] != v {
+			t.Fatalf("expected %s=%s in record, but got %v", k, v, record)
+		}
+	}
+}
+
+func TestFluentdSyncModeRequiresConnection(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fluentd")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = NewFluentd(logger.Info{
+		LogConfig: map[string]string{addressKey: "unix://" + filepath.Join(dir, "fluentd.sock")},
+	})
+	if err == nil {
+		t.Fatalf("expected error for connecting to the absent fluentd in sync mode")
+	}
+}
+
+func TestFluentdAsyncModeReconnect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fluentd")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "fluentd.sock")
+	f, err := NewFluentd(logger.Info{
+		LogConfig: map[string]string{
+			addressKey:    "unix://" + socket,
+			asyncKey:      "true",
+			retryWaitKey:  "10ms",
+			maxRetriesKey: "100",
+		},
+		ContainerID: "6a4ad1b2c3d4e5f60718293a4b5c6d7e8f90123456789abcdef0123456789abc",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error during create Fluentd in async mode: %v", err)
+	}
+	defer f.Close()
+
+	// the message is buffered before fluentd is available.
+	if err := f.WriteLogMessage(&logger.LogMessage{Source: "stdout", Line: []byte("buffered")}); err != nil {
+		t.Fatalf("unexpected error during write log message: %v", err)
+	}
+
+	<-time.After(50 * time.Millisecond)
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("unexpected error during listen: %v", err)
+	}
+	defer l.Close()
+
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatalf("unexpected error during accept: %v", err)
+	}
+	defer conn.Close()
+
+	tag, record := readEntry(t, conn)
+	if tag != "6a4ad1b2c3d4" || record["log"] != "buffered" {
+		t.Fatalf("expected buffered log with tag 6a4ad1b2c3d4, but got %s %v", tag, record)
+	}
+}
+
+func TestFluentdAsyncModeBufferLimit(t *testing.T) {
+	f, err := NewFluentd(logger.Info{
+		LogConfig: map[string]string{
+			addressKey:     "unix:///path/not/exist",
+			asyncKey:       "true",
+			bufferLimitKey: "1k",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error during create Fluentd in async mode: %v", err)
+	}
+	defer f.Close()
+
+	line := make([]byte, 2048)
+	if err := f.WriteLogMessage(&logger.LogMessage{Source: "stdout", Line: line}); err == nil {
+		t.Fatalf("expected error for exceeding the buffer limit")
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	for _, tc := range []struct {
+		address string
+		network string
+		addr    string
+		hasErr  bool
+	}{
+		{address: "", network: "tcp", addr: "127.0.0.1:24224"},
+		{address: "fluentd", network: "tcp", addr: "fluentd:24224"},
+		{address: "fluentd:24225", network: "tcp", addr: "fluentd:24225"},
+		{address: "tcp://10.0.0.1:24225", network: "tcp", addr: "10.0.0.1:24225"},
+		{address: "unix:///var/run/fluentd.sock", network: "unix", addr: "/var/run/fluentd.sock"},
+		{address: "udp://fluentd:24224", hasErr: true},
+		{address: "tcp://fluentd:port", hasErr: true},
+		{address: "tcp://fluentd:24224/path", hasErr: true},
+		{address: "unix://", hasErr: true},
+	} {
+		network, addr, err := parseAddress(tc.address)
+		if tc.hasErr {
+			if err == nil {
+				t.Fatalf("expected error for address %s", tc.address)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error for address %s: %v", tc.address, err)
+		}
+		if network != tc.network || addr != tc.addr {
+			t.Fatalf("expected %s %s for address %s, but got %s %s", tc.network, tc.addr, tc.address, network, addr)
+		}
+	}
+}
+
+func TestValidateLogOpt(t *testing.T) {
+	for _, tc := range []struct {
+		opts   map[string]string
+		hasErr bool
+	}{
+		{opts: map[string]string{addressKey: "fluentd:24224", asyncKey: "true", bufferLimitKey: "1m", retryWaitKey: "2s", maxRetriesKey: "3"}},
+		{opts: map[string]string{"max-size": "10m"}, hasErr: true},
+		{opts: map[string]string{asyncKey: "yes"}, hasErr: true},
+		{opts: map[string]string{bufferLimitKey: "-1"}, hasErr: true},
+		{opts: map[string]string{retryWaitKey: "0s"}, hasErr: true},
+		{opts: map[string]string{maxRetriesKey: "-1"}, hasErr: true},
+	} {
+		err := ValidateLogOpt(logger.Info{LogConfig: tc.opts})
+		if tc.hasErr != (err != nil) {
+			t.Fatalf("expected error %v for %v, but got %v", tc.hasErr, tc.opts, err)
+		}
+	}
+}
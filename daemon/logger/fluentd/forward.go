@@ -0,0 +1,182 @@
+package fluentd
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+const (
+	dialTimeout  = 3 * time.Second
+	writeTimeout = 3 * time.Second
+
+	// maxRetryWait is the max interval between the retries of connecting.
+	maxRetryWait = time.Minute
+)
+
+// forwardWriter writes the encoded entries to fluentd. In async mode, the
+// entries are buffered and written by a background goroutine, which
+// reconnects to fluentd if the connection is broken.
+type forwardWriter struct {
+	opts *options
+
+	// conn is only used by the writing goroutine in async mode, or with mu
+	// held in sync mode.
+	conn net.Conn
+
+	mu      sync.Mutex
+	pending []byte
+
+	notify   chan struct{}
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+func newForwardWriter(opts *options) (*forwardWriter, error) {
+	w := &forwardWriter{
+		opts:   opts,
+		notify: make(chan struct{}, 1),
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	if opts.async {
+		go w.loop()
+		return w, nil
+	}
+
+	// NOTE: fail to create the log driver in sync mode, so that the user
+	// knows the fluentd is not available before starting the container.
+	close(w.done)
+	if err := w.connect(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// post writes the entry to fluentd, or buffers it in async mode.
+func (w *forwardWriter) post(data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	select {
+	case <-w.stopCh:
+		return fmt.Errorf("fluentd log driver has been closed")
+	default:
+	}
+
+	if !w.opts.async {
+		return w.writeWithRetry(data, w.opts.maxRetries)
+	}
+
+	if uint64(len(w.pending)+len(data)) > w.opts.bufferLimit {
+		return fmt.Errorf("fluentd buffer is full, the buffer limit is %d bytes", w.opts.bufferLimit)
+	}
+	w.pending = append(w.pending, data...)
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// loop writes the buffered entries to fluentd until the writer is closed.
+func (w *forwardWriter) loop() {
+	defer close(w.done)
+
+	for {
+		select {
+		case <-w.notify:
+			if data := w.takePending(); len(data) > 0 {
+				if err := w.writeWithRetry(data, w.opts.maxRetries); err != nil {
+					log.With(nil).Errorf("failed to send %d bytes logs to fluentd %s, drop them: %v", len(data), w.opts.address, err)
+				}
+			}
+		case <-w.stopCh:
+			// flush the rest of entries without retrying.
+			if data := w.takePending(); len(data) > 0 {
+				if err := w.writeWithRetry(data, 0); err != nil {
+					log.With(nil).Errorf("failed to flush %d bytes logs to fluentd %s: %v", len(data), w.opts.address, err)
+				}
+			}
+			return
+		}
+	}
+}
+
+func (w *forwardWriter) takePending() []byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data := w.pending
+	w.pending = nil
+	return data
+}
+
+// writeWithRetry writes the data to fluentd, and reconnects if failed.
+func (w *forwardWriter) writeWithRetry(data []byte, maxRetries int) error {
+	var err error
+	for i := 0; ; i++ {
+		if w.conn == nil {
+			err = w.connect()
+		}
+
+		if w.conn != nil {
+			w.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if _, err = w.conn.Write(data); err == nil {
+				return nil
+			}
+
+			w.conn.Close()
+			w.conn = nil
+		}
+
+		if i >= maxRetries {
+			return err
+		}
+
+		wait := w.opts.retryWait << uint(i)
+		if wait > maxRetryWait || wait <= 0 {
+			wait = maxRetryWait
+		}
+
+		select {
+		case <-w.stopCh:
+			return err
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (w *forwardWriter) connect() error {
+	conn, err := net.DialTimeout(w.opts.network, w.opts.address, dialTimeout)
+	if err != nil {
+		return err
+	}
+	w.conn = conn
+	return nil
+}
+
+// close flushes the buffered entries and closes the connection. The stop
+// channel is closed without the lock, so that the retrying in sync mode can
+// be interrupted.
+func (w *forwardWriter) close() error {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
+	<-w.done
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
@@ -0,0 +1,174 @@
+package journald
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/alibaba/pouch/daemon/logger"
+	"github.com/alibaba/pouch/daemon/logger/loggerutils"
+)
+
+// name is the name of journald log driver.
+const name = "journald"
+
+const defaultTagTemplate = "{{.ID}}"
+
+// priorities of the messages, which are the same to the syslog.
+const (
+	priorityErr  = "3"
+	priorityInfo = "6"
+)
+
+func init() {
+	if err := logger.RegisterLogDriver(name, Init); err != nil {
+		panic(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateLogOpt); err != nil {
+		panic(err)
+	}
+}
+
+// Journald writes the log data into the systemd journal.
+type Journald struct {
+	mu   sync.Mutex
+	conn *net.UnixConn
+
+	// vars are the fields of container sent with every message.
+	vars map[string]string
+}
+
+// Init return the Journald log driver.
+func Init(info logger.Info) (logger.LogDriver, error) {
+	return NewJournald(info)
+}
+
+// NewJournald returns new Journald based on the log config.
+func NewJournald(info logger.Info) (*Journald, error) {
+	if _, err := os.Stat(journalSocket); err != nil {
+		return nil, fmt.Errorf("journald is not enabled on this host: %v", err)
+	}
+
+	vars, err := containerFields(info)
+	if err != nil {
+		return nil, err
+	}
+	return &Journald{vars: vars}, nil
+}
+
+// containerFields returns the fields of container, such as CONTAINER_ID and
+// CONTAINER_NAME, and the extra attributes of container.
+func containerFields(info logger.Info) (map[string]string, error) {
+	tag, err := loggerutils.GenerateLogTag(info, defaultTagTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := map[string]string{
+		"CONTAINER_ID":      info.ID(),
+		"CONTAINER_ID_FULL": info.FullID(),
+		"CONTAINER_NAME":    info.Name(),
+		"CONTAINER_TAG":     tag,
+		"IMAGE_NAME":        info.ImageFullID(),
+		"SYSLOG_IDENTIFIER": tag,
+	}
+
+	extra, err := info.ExtraAttributes(sanitizeKey)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range extra {
+		if k == "" {
+			continue
+		}
+		vars[k] = v
+	}
+	return vars, nil
+}
+
+// sanitizeKey converts the key into the field name accepted by journald,
+// which only contains uppercase letters, digits and underscores, and doesn't
+// start with underscore.
+func sanitizeKey(key string) string {
+	key = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return unicode.ToUpper(r)
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+	return strings.TrimLeft(key, "_")
+}
+
+// Name return the log driver's name.
+func (j *Journald) Name() string {
+	return name
+}
+
+// WriteLogMessage will write the LogMessage into journal.
+func (j *Journald) WriteLogMessage(msg *logger.LogMessage) error {
+	priority := priorityInfo
+	if msg.Source == "stderr" {
+		priority = priorityErr
+	}
+
+	fields := make(map[string]string, len(j.vars)+2)
+	for k, v := range j.vars {
+		fields[k] = v
+	}
+	fields["MESSAGE"] = strings.TrimSuffix(string(msg.Line), "\n")
+	fields["PRIORITY"] = priority
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.conn == nil {
+		conn, err := dialJournal()
+		if err != nil {
+			return err
+		}
+		j.conn = conn
+	}
+
+	if err := send(j.conn, fields); err != nil {
+		// the connection will be recreated for the next message if
+		// journald has been restarted.
+		j.conn.Close()
+		j.conn = nil
+		return err
+	}
+	return nil
+}
+
+// Close closes the Journald.
+func (j *Journald) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.conn == nil {
+		return nil
+	}
+	err := j.conn.Close()
+	j.conn = nil
+	return err
+}
+
+// ValidateLogOpt validates the journald log options.
+func ValidateLogOpt(info logger.Info) error {
+	for key := range info.LogConfig {
+		switch key {
+		case "tag", "labels", "env", "env-regex":
+		default:
+			return fmt.Errorf("unknown log opt '%s' for journald log driver", key)
+		}
+	}
+
+	_, err := containerFields(info)
+	return err
+}
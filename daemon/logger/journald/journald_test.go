@@ -0,0 +1,180 @@
+package journald
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/daemon/logger"
+)
+
+// listenJournal listens on a fake journald socket.
+func listenJournal(t *testing.T) (*net.UnixConn, func()) {
+	dir, err := ioutil.TempDir("", "journald")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+
+	socket := filepath.Join(dir, "socket")
+	l, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unexpected error during listen %s: %v", socket, err)
+	}
+
+	origin := journalSocket
+	journalSocket = socket
+	return l, func() {
+		journalSocket = origin
+		l.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// readEntry reads one entry sent to the fake journald socket.
+func readEntry(t *testing.T, l *net.UnixConn) map[string]string {
+	l.SetReadDeadline(time.Now().Add(time.Second))
+
+	buf, oob := make([]byte, 1024*1024), make([]byte, 1024)
+	n, oobn, _, _, err := l.ReadMsgUnix(buf, oob)
+	if err != nil {
+		t.Fatalf("unexpected error during read entry: %v", err)
+	}
+
+	data := buf[:n]
+	if oobn > 0 {
+		msgs, err := syscall.ParseSocketControlMessage(oob[:oobn])
+		if err != nil {
+			t.Fatalf("unexpected error during parse control message: %v", err)
+		}
+		fds, err := syscall.ParseUnixRights(&msgs[0])
+		if err != nil {
+			t.Fatalf("unexpected error during parse unix rights: %v", err)
+		}
+
+		f := os.NewFile(uintptr(fds[0]), "entry")
+		defer f.Close()
+		f.Seek(0, os.SEEK_SET)
+		if data, err = ioutil.ReadAll(f); err != nil {
+			t.Fatalf("unexpected error during read entry from fd: %v", err)
+		}
+	}
+	return decodeFields(t, data)
+}
+
+func decodeFields(t *testing.T, data []byte) map[string]string {
+	fields := make(map[string]string)
+	for len(data) > 0 {
+		idx := bytes.IndexAny(data, "=\n")
+		if idx < 0 {
+			t.Fatalf("invalid entry %q", data)
+		}
+
+		key := string(data[:idx])
+		if data[idx] == '=' {
+			end := bytes.IndexByte(data, '\n')
+			fields[key] = string(data[idx+1 : end])
+			data = data[end+1:]
+			continue
+		}
+
+		size := binary.LittleEndian.Uint64(data[idx+1 : idx+9])
+		fields[key] = string(data[idx+9 : idx+9+int(size)])
+		data = data[idx+9+int(size)+1:]
+	}
+	return fields
+}
+
+func TestJournaldWriteLogMessage(t *testing.T) {
+	l, cleanup := listenJournal(t)
+	defer cleanup()
+
+	j, err := NewJournald(logger.Info{
+		LogConfig:        map[string]string{"labels": "app.name"},
+		ContainerID:      "6a4ad1b2c3d4e5f60718293a4b5c6d7e8f90123456789abcdef0123456789abc",
+		ContainerName:    "web",
+		ContainerImageID: "sha256:1234",
+		ContainerLabels:  map[string]string{"app.name": "nginx"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error during create Journald: %v", err)
+	}
+	defer j.Close()
+
+	for _, tc := range []struct {
+		msg      *logger.LogMessage
+		expected map[string]string
+	}{
+		{
+			msg: &logger.LogMessage{Source: "stdout", Line: []byte("hello\n")},
+			expected: map[string]string{
+				"MESSAGE":           "hello",
+				"PRIORITY":          priorityInfo,
+				"CONTAINER_ID":      "6a4ad1b2c3d4",
+				"CONTAINER_ID_FULL": "6a4ad1b2c3d4e5f60718293a4b5c6d7e8f90123456789abcdef0123456789abc",
+				"CONTAINER_NAME":    "web",
+				"CONTAINER_TAG":     "6a4ad1b2c3d4",
+				"SYSLOG_IDENTIFIER": "6a4ad1b2c3d4",
+				"IMAGE_NAME":        "sha256:1234",
+				"APP_NAME":          "nginx",
+			},
+		},
+		{
+			msg: &logger.LogMessage{Source: "stderr", Line: []byte("multi\nline")},
+			expected: map[string]string{
+				"MESSAGE":  "multi\nline",
+				"PRIORITY": priorityErr,
+			},
+		},
+		{
+			// the entry is too large to be sent in one datagram.
+			msg: &logger.LogMessage{Source: "stdout", Line: bytes.Repeat([]byte("x"), 512*1024)},
+			expected: map[string]string{
+				"MESSAGE": strings.Repeat("x", 512*1024),
+			},
+		},
+	} {
+		if err := j.WriteLogMessage(tc.msg); err != nil {
+			t.Fatalf("unexpected error during write log message: %v", err)
+		}
+
+		got := readEntry(t, l)
+		for k, v := range tc.expected {
+			if got[k] != v {
+				t.Fatalf("expected field %s=%.32q, but got %.32q", k, v, got[k])
+			}
+		}
+	}
+}
+
+func TestSanitizeKey(t *testing.T) {
+	for key, expected := range map[string]string{
+		"app.name":    "APP_NAME",
+		"_private":    "PRIVATE",
+		"com-example": "COM_EXAMPLE",
+		"VERSION2":    "VERSION2",
+	} {
+		if got := sanitizeKey(key); got != expected {
+			t.Fatalf("expected sanitized key %s for %s, but got %s", expected, key, got)
+		}
+	}
+}
+
+func TestValidateLogOpt(t *testing.T) {
+	if err := ValidateLogOpt(logger.Info{LogConfig: map[string]string{"tag": "{{.Name}}", "labels": "a"}}); err != nil {
+		t.Fatalf("unexpected error during validate log opts: %v", err)
+	}
+	if err := ValidateLogOpt(logger.Info{LogConfig: map[string]string{"max-size": "10m"}}); err == nil {
+		t.Fatalf("expected error for unknown log opt")
+	}
+	if err := ValidateLogOpt(logger.Info{LogConfig: map[string]string{"tag": "{{.Unknown"}}); err == nil {
+		t.Fatalf("expected error for invalid tag template")
+	}
+}
@@ -0,0 +1,94 @@
+package journald
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// journalSocket is the socket of journald which receives the messages in
+// native protocol.
+var journalSocket = "/run/systemd/journal/socket"
+
+// dialJournal returns the unconnected socket to send the messages to
+// journald, since the file descriptor cannot be passed by a connected
+// datagram socket.
+func dialJournal() (*net.UnixConn, error) {
+	return net.ListenUnixgram("unixgram", &net.UnixAddr{Name: "", Net: "unixgram"})
+}
+
+// send sends the fields as one entry to journald in native protocol. The
+// entry which is too large for a datagram is written into a temporary file,
+// and then the file descriptor is passed to journald.
+func send(conn *net.UnixConn, fields map[string]string) error {
+	data := encodeFields(fields)
+	addr := &net.UnixAddr{Name: journalSocket, Net: "unixgram"}
+
+	_, _, err := conn.WriteMsgUnix(data, nil, addr)
+	if err == nil {
+		return nil
+	}
+	if !isMessageTooLarge(err) {
+		return err
+	}
+
+	f, err := ioutil.TempFile("/dev/shm", "pouch-journal-")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := os.Remove(f.Name()); err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		return err
+	}
+
+	_, _, err = conn.WriteMsgUnix(nil, syscall.UnixRights(int(f.Fd())), addr)
+	return err
+}
+
+// encodeFields encodes the fields in native protocol of journald. The field
+// is encoded as KEY=value, or as the key followed by the little-endian
+// length and the value if the value contains newline.
+func encodeFields(fields map[string]string) []byte {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		v := fields[k]
+		if !strings.Contains(v, "\n") {
+			buf.WriteString(k + "=" + v + "\n")
+			continue
+		}
+
+		buf.WriteString(k + "\n")
+		binary.Write(&buf, binary.LittleEndian, uint64(len(v)))
+		buf.WriteString(v + "\n")
+	}
+	return buf.Bytes()
+}
+
+// isMessageTooLarge returns true if the datagram is too large to be sent.
+func isMessageTooLarge(err error) bool {
+	opErr, ok := err.(*net.OpError)
+	if !ok {
+		return false
+	}
+
+	sysErr, ok := opErr.Err.(*os.SyscallError)
+	if !ok {
+		return false
+	}
+	return sysErr.Err == syscall.EMSGSIZE || sysErr.Err == syscall.ENOBUFS
+}
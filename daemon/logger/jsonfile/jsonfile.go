@@ -1,8 +1,10 @@
 package jsonfile
 
 import (
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -10,13 +12,31 @@ import (
 
 	"github.com/alibaba/pouch/daemon/logger"
 	"github.com/alibaba/pouch/pkg/bytefmt"
+	"github.com/alibaba/pouch/pkg/log"
 )
 
 const defaultMaxSize = uint64(100 * 1024 * 1024)
 const defaultMaxFile = 2
 
+// name is the name of json-file log driver.
+const name = "json-file"
+
+// compressedExt is the extension of the rotated logs compressed by gzip.
+const compressedExt = ".gz"
+
 var jsonFilePathName = "json.log"
 
+func init() {
+	if err := logger.RegisterLogDriver(name, Init); err != nil {
+		panic(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, func(info logger.Info) error {
+		return ValidateLogOpt(info.LogConfig)
+	}); err != nil {
+		panic(err)
+	}
+}
+
 //MarshalFunc is the function of marshal the logMessage
 type MarshalFunc func(message *logger.LogMessage) ([]byte, error)
 
@@ -31,6 +51,10 @@ type JSONLogFile struct {
 	maxSize     uint64 // maximum size of log in byte
 	currentSize uint64 // current size of the latest log in byte
 	maxFile     int    // maximum number of logs
+	compress    bool   // whether to compress the rotated logs
+
+	// compressing is done when the last rotated log is compressed.
+	compressing sync.WaitGroup
 }
 
 // Init initializes the jsonfile log driver.
@@ -66,6 +90,7 @@ func NewJSONLogFile(logPath string, perms os.FileMode, logConfig map[string]stri
 		currentSize uint64
 		maxSize     = defaultMaxSize
 		maxFiles    = defaultMaxFile
+		compress    bool
 	)
 	f, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, perms)
 	if err != nil {
@@ -78,20 +103,10 @@ func NewJSONLogFile(logPath string, perms os.FileMode, logConfig map[string]stri
 			return nil, err
 		}
 		currentSize = uint64(size)
-		if maxSizeString, ok := logConfig["max-size"]; ok {
-			maxSize, err = bytefmt.ToBytes(maxSizeString)
-			if err != nil {
-				return nil, err
-			}
-		}
-		if maxFileString, ok := logConfig["max-file"]; ok {
-			maxFiles, err = strconv.Atoi(maxFileString)
-			if err != nil {
-				return nil, err
-			}
-			if maxFiles < 1 {
-				return nil, fmt.Errorf("max-file cannot be less than 1")
-			}
+
+		maxSize, maxFiles, compress, err = parseRotateOpts(logConfig)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -103,12 +118,44 @@ func NewJSONLogFile(logPath string, perms os.FileMode, logConfig map[string]stri
 		maxSize:     maxSize,
 		currentSize: currentSize,
 		maxFile:     maxFiles,
+		compress:    compress,
 	}, nil
 }
 
+// parseRotateOpts parses the max-size, max-file and compress options.
+func parseRotateOpts(logConfig map[string]string) (maxSize uint64, maxFiles int, compress bool, err error) {
+	maxSize, maxFiles = defaultMaxSize, defaultMaxFile
+
+	if maxSizeString, ok := logConfig["max-size"]; ok {
+		maxSize, err = bytefmt.ToBytes(maxSizeString)
+		if err != nil {
+			return 0, 0, false, err
+		}
+	}
+	if maxFileString, ok := logConfig["max-file"]; ok {
+		maxFiles, err = strconv.Atoi(maxFileString)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if maxFiles < 1 {
+			return 0, 0, false, fmt.Errorf("max-file cannot be less than 1")
+		}
+	}
+	if compressString, ok := logConfig["compress"]; ok {
+		compress, err = strconv.ParseBool(compressString)
+		if err != nil {
+			return 0, 0, false, fmt.Errorf("invalid value %s of compress: %v", compressString, err)
+		}
+		if compress && maxFiles < 2 {
+			return 0, 0, false, fmt.Errorf("compress cannot be enabled when max-file is less than 2")
+		}
+	}
+	return maxSize, maxFiles, compress, nil
+}
+
 // Name return the log driver's name.
 func (lf *JSONLogFile) Name() string {
-	return "json-file"
+	return name
 }
 
 // WriteLogMessage will write the LogMessage into the file.
@@ -131,8 +178,8 @@ func (lf *JSONLogFile) WriteLogMessage(msg *logger.LogMessage) error {
 	return err
 }
 
-// checkRotate rotates logs according to maxSize and maxFile parameters
-// TODO: after rotating logs, a notice should be made to the log reader
+// checkRotate rotates logs according to maxSize and maxFile parameters, the
+// readers following the log reopen the new log after rotating.
 func (lf *JSONLogFile) checkRotate() error {
 	if lf.maxSize == 0 || lf.currentSize < lf.maxSize {
 		// no need to rotate
@@ -144,8 +191,10 @@ func (lf *JSONLogFile) checkRotate() error {
 	if err := lf.f.Close(); err != nil {
 		return err
 	}
-	// step2. rotate logs. move x.log.(n-1) to x.log.n
-	if err := rotate(logName, lf.maxFile); err != nil {
+	// step2. rotate logs. move x.log.(n-1) to x.log.n, the last rotated
+	// log should be compressed before it is moved.
+	lf.compressing.Wait()
+	if err := rotate(logName, lf.maxFile, lf.compress); err != nil {
 		return err
 	}
 	// step3. reopen new log file with the same name
@@ -156,16 +205,32 @@ func (lf *JSONLogFile) checkRotate() error {
 	lf.f = newfile
 	lf.currentSize = 0
 
+	// step4. compress x.log.1 in background, so that the container is not
+	// blocked by writing its output.
+	if lf.compress && lf.maxFile > 1 {
+		lf.compressing.Add(1)
+		go func() {
+			defer lf.compressing.Done()
+			if err := compressFile(logName + ".1"); err != nil {
+				log.With(nil).Errorf("failed to compress rotated log %s.1: %v", logName, err)
+			}
+		}()
+	}
 	return nil
 }
 
-func rotate(logName string, maxFiles int) error {
+func rotate(logName string, maxFiles int, compress bool) error {
 	if maxFiles < 2 {
 		return nil
 	}
+
+	ext := ""
+	if compress {
+		ext = compressedExt
+	}
 	for i := maxFiles - 1; i > 1; i-- {
-		newName := logName + "." + strconv.Itoa(i)
-		oldName := logName + "." + strconv.Itoa(i-1)
+		newName := logName + "." + strconv.Itoa(i) + ext
+		oldName := logName + "." + strconv.Itoa(i-1) + ext
 		if err := os.Rename(oldName, newName); err != nil && !os.IsNotExist(err) {
 			return err
 		}
@@ -177,6 +242,44 @@ func rotate(logName string, maxFiles int) error {
 	return nil
 }
 
+// compressFile compresses the file into file.gz by gzip, and removes the
+// file after compressing.
+func compressFile(fileName string) error {
+	src, err := os.Open(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer src.Close()
+
+	tmpName := fileName + compressedExt + ".tmp"
+	dst, err := os.OpenFile(tmpName, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpName)
+
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpName, fileName+compressedExt); err != nil {
+		return err
+	}
+	return os.Remove(fileName)
+}
+
 // Close closes the file.
 func (lf *JSONLogFile) Close() error {
 	lf.mu.Lock()
@@ -185,6 +288,7 @@ func (lf *JSONLogFile) Close() error {
 	if lf.closed {
 		return nil
 	}
+	lf.compressing.Wait()
 
 	if err := lf.f.Close(); err != nil {
 		return err
@@ -209,5 +313,7 @@ func ValidateLogOpt(cfg map[string]string) error {
 			return fmt.Errorf("unknown log opt '%s' for json-file log driver", key)
 		}
 	}
-	return nil
+
+	_, _, _, err := parseRotateOpts(cfg)
+	return err
 }
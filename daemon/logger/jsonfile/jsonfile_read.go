@@ -1,7 +1,12 @@
 package jsonfile
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
 	"os"
+	"strconv"
 
 	"github.com/alibaba/pouch/daemon/logger"
 )
@@ -24,31 +29,100 @@ func (lf *JSONLogFile) ReadLogMessages(cfg *logger.ReadConfig) *logger.LogWatche
 	return watcher
 }
 
+// rotatedFile is the log file rotated by JSONLogFile, which may be
+// compressed.
+type rotatedFile struct {
+	f          *os.File
+	compressed bool
+}
+
+// reader returns the reader of content of rotated log from the beginning.
+func (rf *rotatedFile) reader() (io.Reader, error) {
+	if _, err := rf.f.Seek(0, os.SEEK_SET); err != nil {
+		return nil, err
+	}
+	if !rf.compressed {
+		return rf.f, nil
+	}
+	return gzip.NewReader(rf.f)
+}
+
 func (lf *JSONLogFile) read(cfg *logger.ReadConfig, watcher *logger.LogWatcher) {
+	// NOTE: open the current and rotated logs with the lock, so that the
+	// logs are not rotated during opening. The opened logs can be read
+	// even if they are rotated later.
 	lf.mu.Lock()
 	f, err := os.Open(lf.f.Name())
+	if err != nil {
+		lf.mu.Unlock()
+		watcher.Err <- err
+		return
+	}
+	defer f.Close()
+
+	rotated, err := openRotatedFiles(lf.f.Name(), lf.maxFile)
 	lf.mu.Unlock()
+	defer func() {
+		for _, rf := range rotated {
+			rf.f.Close()
+		}
+	}()
 
 	if err != nil {
 		watcher.Err <- err
 		return
 	}
-	defer f.Close()
 
 	// find the offset if the config contains the valid tail lines
+	var skipLines int
 	if cfg.Tail > 0 {
-		offset, err := seekOffsetByTailLines(f, cfg.Tail)
+		rotated, skipLines, err = tailRotatedFiles(f, rotated, cfg.Tail)
 		if err != nil {
 			watcher.Err <- err
 			return
 		}
 
-		if _, err := f.Seek(offset, os.SEEK_SET); err != nil {
+		if len(rotated) == 0 {
+			offset, err := seekOffsetByTailLines(f, cfg.Tail)
+			if err != nil {
+				watcher.Err <- err
+				return
+			}
+
+			if _, err := f.Seek(offset, os.SEEK_SET); err != nil {
+				watcher.Err <- err
+				return
+			}
+		} else if _, err := f.Seek(0, os.SEEK_SET); err != nil {
 			watcher.Err <- err
 			return
 		}
 	}
-	tailFile(f, cfg, newUnmarshal, watcher)
+
+	for i, rf := range rotated {
+		r, err := rf.reader()
+		if err != nil {
+			watcher.Err <- err
+			return
+		}
+
+		if i == 0 && skipLines > 0 {
+			br := bufio.NewReader(r)
+			if err := skipLinesOfReader(br, skipLines); err != nil {
+				watcher.Err <- err
+				return
+			}
+			r = br
+		}
+
+		if !tailFile(r, cfg, newUnmarshal, watcher) {
+			return
+		}
+	}
+
+	if !tailFile(f, cfg, newUnmarshal, watcher) {
+		return
+	}
 
 	if !cfg.Follow {
 		return
@@ -56,3 +130,105 @@ func (lf *JSONLogFile) read(cfg *logger.ReadConfig, watcher *logger.LogWatcher)
 
 	followFile(f, cfg, newUnmarshal, watcher)
 }
+
+// openRotatedFiles opens the rotated logs from the oldest to the newest, like
+// x.log.(n-1) ... x.log.1. The rotated log which has been compressed has
+// .gz extension.
+func openRotatedFiles(logName string, maxFiles int) ([]*rotatedFile, error) {
+	var rotated []*rotatedFile
+	for i := maxFiles - 1; i > 0; i-- {
+		name := logName + "." + strconv.Itoa(i)
+
+		f, err := os.Open(name)
+		if err == nil {
+			rotated = append(rotated, &rotatedFile{f: f})
+			continue
+		}
+		if !os.IsNotExist(err) {
+			return rotated, err
+		}
+
+		f, err = os.Open(name + compressedExt)
+		if err == nil {
+			rotated = append(rotated, &rotatedFile{f: f, compressed: true})
+			continue
+		}
+		if !os.IsNotExist(err) {
+			return rotated, err
+		}
+	}
+	return rotated, nil
+}
+
+// tailRotatedFiles returns the rotated logs which contain the last n lines
+// together with the current log, and the number of lines should be skipped
+// in the first returned rotated log. It returns no rotated log if the current
+// log contains enough lines.
+func tailRotatedFiles(current io.ReadSeeker, rotated []*rotatedFile, n int) ([]*rotatedFile, int, error) {
+	if _, err := current.Seek(0, os.SEEK_SET); err != nil {
+		return nil, 0, err
+	}
+	cnt, err := countLines(current)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	need := n - cnt
+	for i := len(rotated) - 1; i >= 0 && need > 0; i-- {
+		r, err := rotated[i].reader()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		cnt, err := countLines(r)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if cnt >= need {
+			return rotated[i:], cnt - need, nil
+		}
+		need -= cnt
+	}
+
+	if need > 0 {
+		return rotated, 0, nil
+	}
+	return nil, 0, nil
+}
+
+// countLines returns the number of lines in the reader.
+func countLines(r io.Reader) (int, error) {
+	var (
+		cnt int
+		buf = make([]byte, 32*blockSize)
+	)
+
+	for {
+		n, err := r.Read(buf)
+		cnt += bytes.Count(buf[:n], []byte{endOfLine})
+		if err == io.EOF {
+			return cnt, nil
+		}
+		if err != nil {
+			return cnt, err
+		}
+	}
+}
+
+// skipLinesOfReader discards the first n lines of the reader.
+func skipLinesOfReader(br *bufio.Reader, n int) error {
+	for ; n > 0; n-- {
+		if _, err := br.ReadSlice(endOfLine); err != nil {
+			if err == bufio.ErrBufferFull {
+				n++
+				continue
+			}
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
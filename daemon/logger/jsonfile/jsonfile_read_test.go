@@ -3,6 +3,8 @@ package jsonfile
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
@@ -75,3 +77,102 @@ func TestReadLogMessagesForEmptyFileWithoutFollow(t *testing.T) {
 	default:
 	}
 }
+
+func TestReadLogMessagesAcrossRotatedFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "json-file-read")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, compress := range []string{"false", "true"} {
+		logPath := filepath.Join(dir, "json.log."+compress)
+		jf, err := NewJSONLogFile(logPath, 0644, map[string]string{
+			"max-size": "100",
+			"max-file": "3",
+			"compress": compress,
+		}, func(msg *logger.LogMessage) ([]byte, error) {
+			return Marshal(msg, nil)
+		})
+		if err != nil {
+			t.Fatalf("unexpected error during create JSONLogFile: %v", err)
+		}
+
+		// #1 and #2 are dropped by rotating, #3, #4 and #5, #6 are
+		// rotated, and #7 is in the current log.
+		writeTestLogs(t, jf, 7)
+		jf.compressing.Wait()
+
+		for _, tc := range []struct {
+			tail     int
+			expected []string
+		}{
+			{tail: -1, expected: []string{"#3", "#4", "#5", "#6", "#7"}},
+			{tail: 1, expected: []string{"#7"}},
+			{tail: 2, expected: []string{"#6", "#7"}},
+			{tail: 4, expected: []string{"#4", "#5", "#6", "#7"}},
+			{tail: 10, expected: []string{"#3", "#4", "#5", "#6", "#7"}},
+		} {
+			watcher := jf.ReadLogMessages(&logger.ReadConfig{Tail: tc.tail})
+
+			var got []string
+			for msg := range watcher.Msgs {
+				got = append(got, string(msg.Line))
+			}
+			watcher.Close()
+
+			select {
+			case err := <-watcher.Err:
+				t.Fatalf("unexpected error from watcher: %v", err)
+			default:
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %v with tail %d and compress %s, but got %v", tc.expected, tc.tail, compress, got)
+			}
+		}
+		jf.Close()
+	}
+}
+
+func TestReadLogMessagesFollowRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "json-file-follow")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	jf, err := NewJSONLogFile(filepath.Join(dir, jsonFilePathName), 0644, map[string]string{
+		"max-size": "100",
+		"max-file": "2",
+	}, func(msg *logger.LogMessage) ([]byte, error) {
+		return Marshal(msg, nil)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error during create JSONLogFile: %v", err)
+	}
+	defer jf.Close()
+
+	watcher := jf.ReadLogMessages(&logger.ReadConfig{Follow: true})
+	defer watcher.Close()
+
+	// NOTE: make the goroutine for read has started.
+	<-time.After(100 * time.Millisecond)
+
+	// #3 and #4 are written into the new log after rotating.
+	writeTestLogs(t, jf, 4)
+
+	for _, expected := range []string{"#1", "#2", "#3", "#4"} {
+		select {
+		case msg, ok := <-watcher.Msgs:
+			if !ok {
+				t.Fatalf("expected log message %s, but watcher has been closed", expected)
+			}
+			if string(msg.Line) != expected {
+				t.Fatalf("expected log message %s, but got %s", expected, msg.Line)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected log message %s, but got nothing", expected)
+		}
+	}
+}
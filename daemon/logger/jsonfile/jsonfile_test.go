@@ -0,0 +1,114 @@
+package jsonfile
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/daemon/logger"
+)
+
+// writeTestLogs writes n log messages #1...#n into the JSONLogFile.
+func writeTestLogs(t *testing.T, lf *JSONLogFile, n int) {
+	for i := 1; i <= n; i++ {
+		msg := &logger.LogMessage{
+			Source:    "stdout",
+			Line:      []byte(fmt.Sprintf("#%d", i)),
+			Timestamp: time.Date(2018, 5, 9, 10, 0, i, 0, time.UTC),
+		}
+		if err := lf.WriteLogMessage(msg); err != nil {
+			t.Fatalf("unexpected error during writing log message: %v", err)
+		}
+	}
+}
+
+func TestJSONLogFileRotateWithCompress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "json-file-rotate")
+	if err != nil {
+		t.Fatalf("unexpected error during create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	logPath := filepath.Join(dir, jsonFilePathName)
+	lf, err := NewJSONLogFile(logPath, 0644, map[string]string{
+		"max-size": "100",
+		"max-file": "3",
+		"compress": "true",
+	}, func(msg *logger.LogMessage) ([]byte, error) {
+		return Marshal(msg, nil)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error during create JSONLogFile: %v", err)
+	}
+
+	// every two messages fill one log file
+	writeTestLogs(t, lf, 8)
+	if err := lf.Close(); err != nil {
+		t.Fatalf("unexpected error during close JSONLogFile: %v", err)
+	}
+
+	for _, name := range []string{logPath + ".1", logPath + ".2", logPath + ".3.gz"} {
+		if _, err := os.Stat(name); !os.IsNotExist(err) {
+			t.Fatalf("expected %s not exist, but got %v", name, err)
+		}
+	}
+
+	for name, expected := range map[string][]string{
+		logPath + ".1.gz": {"#5", "#6"},
+		logPath + ".2.gz": {"#3", "#4"},
+	} {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatalf("unexpected error during open rotated log: %v", err)
+		}
+		defer f.Close()
+
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatalf("unexpected error during decompress %s: %v", name, err)
+		}
+
+		decodeOneLine := newUnmarshal(zr)
+		for _, line := range expected {
+			msg, err := decodeOneLine()
+			if err != nil {
+				t.Fatalf("unexpected error during decode %s: %v", name, err)
+			}
+			if string(msg.Line) != line {
+				t.Fatalf("expected line %s in %s, but got %s", line, name, msg.Line)
+			}
+		}
+	}
+}
+
+func TestValidateLogOpt(t *testing.T) {
+	for _, tc := range []struct {
+		opts   map[string]string
+		hasErr string
+	}{
+		{opts: map[string]string{"max-size": "10m", "max-file": "3", "compress": "true"}},
+		{opts: map[string]string{"compress": "false", "max-file": "1"}},
+		{opts: map[string]string{"foo": "bar"}, hasErr: "unknown log opt"},
+		{opts: map[string]string{"max-size": "ten"}, hasErr: "Byte quantity"},
+		{opts: map[string]string{"max-file": "0"}, hasErr: "max-file cannot be less than 1"},
+		{opts: map[string]string{"compress": "yes"}, hasErr: "invalid value yes of compress"},
+		{opts: map[string]string{"compress": "true", "max-file": "1"}, hasErr: "compress cannot be enabled"},
+	} {
+		err := ValidateLogOpt(tc.opts)
+		if tc.hasErr == "" {
+			if err != nil {
+				t.Fatalf("expected no error for %v, but got %v", tc.opts, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tc.hasErr) {
+			t.Fatalf("expected error containing %q for %v, but got %v", tc.hasErr, tc.opts, err)
+		}
+	}
+}
@@ -18,6 +18,9 @@ type newUnmarshalFunc func(r io.Reader) func() (*logger.LogMessage, error)
 
 var watchFileTimeout = 200 * time.Millisecond
 
+// errDone means that the file is no longer followed.
+var errDone = errors.New("done")
+
 // followFile will act like `tail -f`.
 func followFile(f *os.File, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc, watcher *logger.LogWatcher) {
 	fileWatcher, err := watchFileChange(f.Name())
@@ -31,6 +34,15 @@ func followFile(f *os.File, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc
 		fileWatcher.Close()
 	}()
 
+	// NOTE: the file opened after rotating is owned by followFile, the
+	// caller only closes the file passed in.
+	origin := f
+	defer func() {
+		if f != origin {
+			f.Close()
+		}
+	}()
+
 	ctx, cancel := context.WithCancel(context.TODO())
 	defer cancel()
 
@@ -45,12 +57,14 @@ func followFile(f *os.File, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc
 
 	decodeOneLine := unmarshaler(f)
 
-	errDone := errors.New("done")
-
 	// NOTE: avoid to use time.After in select. We need local-global timeout
 	watchTimeout := time.NewTimer(time.Second)
 	defer watchTimeout.Stop()
 
+	// rotated is true if the file has been rotated, and the rest of the
+	// file should be read before reopening the new file with the same name.
+	rotated := false
+
 	// handleError will watch the file if the err is io.EOF so that
 	// the loop can continue to read the file. Or just return the error.
 	handleError := func(err error) error {
@@ -58,6 +72,29 @@ func followFile(f *os.File, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc
 			return err
 		}
 
+		if rotated {
+			newF, err := reopenRotatedFile(ctx, f.Name())
+			if err != nil {
+				if err == errDone {
+					return errDone
+				}
+				log.With(nil).Debugf("failed to reopen file %v after rotating: %v", f.Name(), err)
+				return errDone
+			}
+
+			if err := fileWatcher.Add(newF.Name()); err != nil {
+				newF.Close()
+				return err
+			}
+
+			if f != origin {
+				f.Close()
+			}
+			f, rotated = newF, false
+			decodeOneLine = unmarshaler(f)
+			return nil
+		}
+
 		for {
 			watchTimeout.Reset(watchFileTimeout)
 
@@ -70,6 +107,13 @@ func followFile(f *os.File, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc
 				case fsnotify.Write:
 					decodeOneLine = unmarshaler(f)
 					return nil
+				case fsnotify.Rename:
+					// the file has been rotated by the log driver, read
+					// the rest of the file and then reopen it.
+					fileWatcher.Remove(f.Name())
+					rotated = true
+					decodeOneLine = unmarshaler(f)
+					return nil
 				case fsnotify.Remove:
 					// ideally, it's caused by removing the container.
 					return errDone
@@ -130,6 +174,31 @@ func followFile(f *os.File, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc
 	}
 }
 
+// reopenRotatedFile opens the new file created by the log driver after
+// rotating, it waits for the file to be created in watchFileTimeout.
+func reopenRotatedFile(ctx context.Context, name string) (*os.File, error) {
+	timeout := time.NewTimer(watchFileTimeout)
+	defer timeout.Stop()
+
+	for {
+		f, err := os.Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errDone
+		case <-timeout.C:
+			return nil, err
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
 // watchFileChange will watch the change of file.
 func watchFileChange(filePath string) (*fsnotify.Watcher, error) {
 	fileWatcher, err := fsnotify.NewWatcher()
@@ -144,7 +213,9 @@ func watchFileChange(filePath string) (*fsnotify.Watcher, error) {
 }
 
 // tailFile will read the log message until the io.EOF or limited by config.
-func tailFile(r io.Reader, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc, watcher *logger.LogWatcher) {
+// It returns true if the reader has been read until the io.EOF, so that the
+// caller can continue to read the following logs.
+func tailFile(r io.Reader, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc, watcher *logger.LogWatcher) bool {
 	decodeOneLine := unmarshaler(r)
 
 	for {
@@ -152,8 +223,9 @@ func tailFile(r io.Reader, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc,
 		if err != nil {
 			if err != io.EOF {
 				watcher.Err <- err
+				return false
 			}
-			return
+			return true
 		}
 
 		if !cfg.Since.IsZero() && msg.Timestamp.Before(cfg.Since) {
@@ -161,12 +233,12 @@ func tailFile(r io.Reader, cfg *logger.ReadConfig, unmarshaler newUnmarshalFunc,
 		}
 
 		if !cfg.Until.IsZero() && msg.Timestamp.After(cfg.Until) {
-			return
+			return false
 		}
 
 		select {
 		case <-watcher.WatchClose():
-			return
+			return false
 		case watcher.Msgs <- msg:
 		}
 	}
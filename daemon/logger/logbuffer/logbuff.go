@@ -9,6 +9,9 @@ import (
 type LogBuffer struct {
 	ringBuffer *RingBuffer
 	logger     logger.LogDriver
+
+	// done is closed when the goroutine writing logs exits.
+	done chan struct{}
 }
 
 // NewLogBuffer return a new BufferLog.
@@ -16,6 +19,7 @@ func NewLogBuffer(logDriver logger.LogDriver, maxBytes int64) (logger.LogDriver,
 	bl := &LogBuffer{
 		logger:     logDriver,
 		ringBuffer: NewRingBuffer(maxBytes),
+		done:       make(chan struct{}),
 	}
 
 	// use a goroutine to write logs continuously with specified log driver
@@ -36,17 +40,26 @@ func (bl *LogBuffer) WriteLogMessage(msg *logger.LogMessage) error {
 // Close close the ringBuffer and drain the messages.
 func (bl *LogBuffer) Close() error {
 	bl.ringBuffer.Close()
+
+	// NOTE: wait for the log being written, so that the drained logs are
+	// written in order.
+	<-bl.done
 	for _, msg := range bl.ringBuffer.Drain() {
 		if err := bl.logger.WriteLogMessage(msg); err != nil {
 			log.With(nil).Debugf("failed to write log %v when closing with log driver %s", msg, bl.logger.Name())
 		}
 	}
 
+	if dropped := bl.ringBuffer.Dropped(); dropped > 0 {
+		log.With(nil).Warnf("%d logs are dropped because the buffer of log driver %s is full", dropped, bl.logger.Name())
+	}
+
 	return bl.logger.Close()
 }
 
 // write logs continuously with specified log driver from ringBuffer.
 func (bl *LogBuffer) run() {
+	defer close(bl.done)
 	for {
 		msg, err := bl.ringBuffer.Pop()
 		if err != nil {
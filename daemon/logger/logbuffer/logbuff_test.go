@@ -0,0 +1,74 @@
+package logbuffer
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/daemon/logger"
+)
+
+// stalledDriver blocks writing until it is released.
+type stalledDriver struct {
+	release chan struct{}
+
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (d *stalledDriver) Name() string {
+	return "stalled"
+}
+
+func (d *stalledDriver) WriteLogMessage(msg *logger.LogMessage) error {
+	<-d.release
+
+	d.mu.Lock()
+	d.msgs = append(d.msgs, string(msg.Line))
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *stalledDriver) Close() error {
+	return nil
+}
+
+func TestLogBufferWithStalledDriver(t *testing.T) {
+	d := &stalledDriver{release: make(chan struct{})}
+
+	bl, err := NewLogBuffer(d, 10)
+	if err != nil {
+		t.Fatalf("unexpected error during create LogBuffer: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			bl.WriteLogMessage(wrapLogWithInt(i))
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("expected writing logs not to be blocked by the stalled log driver")
+	}
+
+	close(d.release)
+	if err := bl.Close(); err != nil {
+		t.Fatalf("unexpected error during close LogBuffer: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// the newest logs are kept in the buffer.
+	if len(d.msgs) == 0 || d.msgs[len(d.msgs)-1] != strconv.Itoa(999) {
+		t.Fatalf("expected the last log 999 to be written, but got %v", d.msgs)
+	}
+	if len(d.msgs) > 10 {
+		t.Fatalf("expected most of logs dropped, but got %d logs", len(d.msgs))
+	}
+}
@@ -24,11 +24,15 @@ type RingBuffer struct {
 
 	maxBytes     int64
 	currentBytes int64
+
+	// dropped is the number of messages dropped because of full buffer.
+	dropped uint64
 }
 
-// NewRingBuffer creates new RingBuffer.
+// NewRingBuffer creates new RingBuffer, the default size is used if maxBytes
+// is not positive.
 func NewRingBuffer(maxBytes int64) *RingBuffer {
-	if maxBytes < 0 {
+	if maxBytes <= 0 {
 		maxBytes = defaultMaxBytes
 	}
 
@@ -41,8 +45,9 @@ func NewRingBuffer(maxBytes int64) *RingBuffer {
 	return rb
 }
 
-// Push pushes value into buffer and return whether it covers the oldest data
-// or not.
+// Push pushes value into buffer without blocking. The oldest data will be
+// dropped if the buffer is full, and the value larger than the buffer will
+// be dropped directly.
 func (rb *RingBuffer) Push(val *logger.LogMessage) error {
 	rb.mu.Lock()
 	defer rb.mu.Unlock()
@@ -56,16 +61,30 @@ func (rb *RingBuffer) Push(val *logger.LogMessage) error {
 	}
 
 	msgLength := int64(len(val.Line))
-	if (rb.currentBytes + msgLength) > rb.maxBytes {
-		rb.wait.Broadcast()
+	if msgLength > rb.maxBytes {
+		rb.dropped++
 		return nil
 	}
 
+	for rb.q.size() > 0 && (rb.currentBytes+msgLength) > rb.maxBytes {
+		old := rb.q.dequeue()
+		rb.currentBytes -= int64(len(old.Line))
+		rb.dropped++
+	}
+
 	rb.q.enqueue(val)
+	rb.currentBytes += msgLength
 	rb.wait.Broadcast()
 	return nil
 }
 
+// Dropped returns the number of messages dropped because of full buffer.
+func (rb *RingBuffer) Dropped() uint64 {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.dropped
+}
+
 // Pop pops the value in the buffer.
 //
 // NOTE: it returns ErrClosed if the buffer has been closed.
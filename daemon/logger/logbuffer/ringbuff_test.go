@@ -24,16 +24,16 @@ func TestPushNormal(t *testing.T) {
 	err = rb.Push(wrapLogWithByte(extraB))
 	assertHelper(t, nil, err, "unexpected error during push non-closed queue: %v", err)
 
-	// get data
+	// the oldest data has been dropped
 	logMsg, err := rb.Pop()
-	expectedDump := wrapLogWithByte(b)
+	expectedDump := wrapLogWithByte(extraB)
 	assertHelper(t, nil, err, "unexpected error during pop: %v", err)
 	assertHelper(t, expectedDump, logMsg, "expected return %v, but got %v", expectedDump, logMsg)
+	assertHelper(t, uint64(1), rb.Dropped(), "expected to drop 1 log, but got %d", rb.Dropped())
 
 	// get drain data
 	got := rb.Drain()
-	expectedLogs := []*logger.LogMessage{wrapLogWithByte(extraB)}
-	assertHelper(t, expectedLogs, got, "expected return %v, but got %v", expectedLogs, got)
+	assertHelper(t, 0, len(got), "expected return empty data, but got %v", got)
 
 	assertHelper(t, 0, rb.q.size(), "expected to have empty queue, but got %d size of queue", rb.q.size())
 	assertHelper(t, &rb.q.root, rb.q.root.next, "when empty, expected queue.root.next equal to &queue.root")
@@ -89,6 +89,24 @@ func TestPushAndPop(t *testing.T) {
 	assertHelper(t, expectedDump, got, "expected return %v, but got %v", expectedDump, got)
 }
 
+func TestPushDropOldest(t *testing.T) {
+	rb := NewRingBuffer(3)
+
+	// push 1, 2, 3 to fill the buffer, and then push 4 to drop 1
+	for _, v := range []int{1, 2, 3, 4} {
+		err := rb.Push(wrapLogWithInt(v))
+		assertHelper(t, nil, err, "unexpected error during push non-closed queue: %v", err)
+	}
+
+	// the data larger than the buffer is dropped directly
+	err := rb.Push(wrapLogWithInt(1000))
+	assertHelper(t, nil, err, "unexpected error during push non-closed queue: %v", err)
+
+	expectedDump, got := []*logger.LogMessage{wrapLogWithInt(2), wrapLogWithInt(3), wrapLogWithInt(4)}, rb.Drain()
+	assertHelper(t, expectedDump, got, "expected return %v, but got %v", expectedDump, got)
+	assertHelper(t, uint64(2), rb.Dropped(), "expected to drop 2 logs, but got %d", rb.Dropped())
+}
+
 func TestPopWaitWhenNotData(t *testing.T) {
 	rb := NewRingBuffer(defaultMaxBytes)
 
@@ -12,6 +12,18 @@ import (
 	"github.com/RackSec/srslog"
 )
 
+// name is the name of syslog log driver.
+const name = "syslog"
+
+func init() {
+	if err := logger.RegisterLogDriver(name, Init); err != nil {
+		panic(err)
+	}
+	if err := logger.RegisterLogOptValidator(name, ValidateSyslogOption); err != nil {
+		panic(err)
+	}
+}
+
 // Syslog writes the log data into syslog.
 type Syslog struct {
 	mu sync.RWMutex
@@ -58,7 +70,7 @@ func NewSyslog(info logger.Info) (*Syslog, error) {
 
 // Name return the log driver's name.
 func (s *Syslog) Name() string {
-	return "syslog"
+	return name
 }
 
 // WriteLogMessage will write the LogMessage.
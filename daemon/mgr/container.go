@@ -6,12 +6,13 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
@@ -35,9 +36,12 @@ import (
 	"github.com/containerd/cgroups"
 	containerdtypes "github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/mount"
+	"github.com/docker/docker/pkg/idtools"
 	"github.com/docker/go-units"
 	"github.com/go-openapi/strfmt"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	selinux "github.com/opencontainers/selinux/go-selinux"
+	"github.com/opencontainers/selinux/go-selinux/label"
 	"github.com/pkg/errors"
 )
 
@@ -69,6 +73,9 @@ type ContainerMgr interface {
 	// List returns the list of containers.
 	List(ctx context.Context, option *ContainerListOption) ([]*Container, error)
 
+	// IsImageUsed returns whether the image is used by any container.
+	IsImageUsed(ctx context.Context, imageID string) (bool, error)
+
 	// Start a container.
 	Start(ctx context.Context, id string, options *types.ContainerStartOptions) error
 
@@ -84,6 +91,12 @@ type ContainerMgr interface {
 	// Unpause a container.
 	Unpause(ctx context.Context, name string) error
 
+	// PauseContainers pauses a group of containers atomically.
+	PauseContainers(ctx context.Context, names []string, filter map[string][]string) ([]string, error)
+
+	// UnpauseContainers unpauses a group of containers atomically.
+	UnpauseContainers(ctx context.Context, names []string, filter map[string][]string) ([]string, error)
+
 	// Using a stream to get stats of a container.
 	StreamStats(ctx context.Context, name string, config *ContainerStatsConfig) error
 
@@ -102,20 +115,26 @@ type ContainerMgr interface {
 	// Update updates the configurations of a container.
 	Update(ctx context.Context, name string, config *types.UpdateConfig) error
 
+	// UpdateNetwork updates the bandwidth limits of container network.
+	UpdateNetwork(ctx context.Context, name string, bandwidth *types.NetworkBandwidth) error
+
 	// Upgrade upgrades a container with new image and args.
 	Upgrade(ctx context.Context, name string, config *types.ContainerUpgradeConfig) error
 
 	// Top lists the processes running inside of the given container
 	Top(ctx context.Context, name string, psArgs string) (*types.ContainerProcessList, error)
 
+	// Changes returns the changes of the container filesystem against its image.
+	Changes(ctx context.Context, name string) ([]*types.ContainerChangeResponseItem, error)
+
 	// Resize resizes the size of container tty.
 	Resize(ctx context.Context, name string, opts types.ResizeOptions) error
 
 	// Remove removes a container, it may be running or stopped and so on.
 	Remove(ctx context.Context, name string, option *types.ContainerRemoveOptions) error
 
-	// Wait stops processing until the given container is stopped.
-	Wait(ctx context.Context, name string) (types.ContainerWaitOKBody, error)
+	// Wait stops processing until the given container satisfies the condition.
+	Wait(ctx context.Context, name, condition string) (types.ContainerWaitOKBody, error)
 
 	// 2. The following five functions is related to container exec.
 
@@ -137,6 +156,10 @@ type ContainerMgr interface {
 	// ResizeExec resizes the size of exec process's tty.
 	ResizeExec(ctx context.Context, execid string, opts types.ResizeOptions) error
 
+	// PruneExecs removes the metadata of all finished exec processes, and
+	// only the ones of container `name` if it is not empty.
+	PruneExecs(ctx context.Context, name string) ([]string, error)
+
 	// 3. The following two function is related to network management.
 	// TODO: inconsistency, Connect/Disconnect operation is in newtork_bridge.go in upper API layer.
 	// Here we encapsualted them in container manager, inconsistency exists.
@@ -163,6 +186,12 @@ type ContainerMgr interface {
 	// DeleteCheckpoint deletes a checkpoint from a container
 	DeleteCheckpoint(ctx context.Context, name string, options *types.CheckpointDeleteOptions) error
 
+	// ExportCheckpoint exports a checkpoint of container as a tar stream.
+	ExportCheckpoint(ctx context.Context, name, checkpointID, checkpointDir string) (io.ReadCloser, error)
+
+	// ImportCheckpoint imports a checkpoint into container from a tar stream.
+	ImportCheckpoint(ctx context.Context, name, checkpointID, checkpointDir string, content io.Reader) error
+
 	// Commit commits an image from a container.
 	Commit(ctx context.Context, name string, options *types.ContainerCommitOptions) (*types.ContainerCommitResp, error)
 
@@ -174,6 +203,12 @@ type ContainerMgr interface {
 
 	// ExtractToDir extracts the given archive at the specified path in the container.
 	ExtractToDir(ctx context.Context, name, path string, copyUIDGID, noOverwriteDirNonDir bool, content io.Reader) error
+
+	// Export returns the tar stream of the container rootfs.
+	Export(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// Size returns the size of the writable layer and the whole rootfs of container.
+	Size(ctx context.Context, name string) (int64, int64, error)
 }
 
 // ContainerManager is the default implement of interface ContainerMgr.
@@ -209,10 +244,24 @@ type ContainerManager struct {
 
 	// eventsService is used to publish events generated by pouchd
 	eventsService *events.Events
+
+	// idMapping is the id mapping of user namespace remapping, it is nil if
+	// userns-remap is not enabled.
+	idMapping *idtools.IdentityMapping
+
+	// sizeCache caches the size of containers and images.
+	sizeCache containerSizeCache
+
+	// hooks are the OCI hooks of daemon injected into all the containers.
+	hooks []*types.OCIHook
+
+	// specPlugins mutate or reject the spec of containers just before they
+	// are created in containerd.
+	specPlugins []hookplugins.SpecPlugin
 }
 
 // NewContainerManager creates a brand new container manager.
-func NewContainerManager(ctx context.Context, store *meta.Store, cli ctrd.APIClient, imgMgr ImageMgr, volMgr VolumeMgr, cfg *daemon_config.Config, contPlugin hookplugins.ContainerPlugin, eventsService *events.Events) (*ContainerManager, error) {
+func NewContainerManager(ctx context.Context, store *meta.Store, cli ctrd.APIClient, imgMgr ImageMgr, volMgr VolumeMgr, cfg *daemon_config.Config, contPlugin hookplugins.ContainerPlugin, specPlugin hookplugins.SpecPlugin, eventsService *events.Events) (*ContainerManager, error) {
 	mgr := &ContainerManager{
 		Store:           store,
 		NameToID:        collect.NewSafeMap(),
@@ -225,15 +274,50 @@ func NewContainerManager(ctx context.Context, store *meta.Store, cli ctrd.APICli
 		Config:          cfg,
 		monitor:         NewContainerMonitor(),
 		containerPlugin: contPlugin,
+		specPlugins:     newSpecPlugins(specPlugin, cfg.SpecPlugins, cfg.SpecPluginTimeout),
 		eventsService:   eventsService,
 	}
 
+	idMapping, err := newIdentityMapping(cfg.UsernsRemap)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set up userns-remap")
+	}
+	mgr.idMapping = idMapping
+
+	if !cfg.EnableSelinux {
+		selinux.SetDisabled()
+	}
+
+	if err := installAppArmorProfile(); err != nil {
+		return nil, errors.Wrap(err, "failed to install default AppArmor profile")
+	}
+
+	if _, err := mgr.defaultCapabilities(); err != nil {
+		return nil, errors.Wrap(err, "invalid default capabilities")
+	}
+
+	if cfg.SeccompProfile != "" {
+		if _, err := loadSeccompProfile(cfg.SeccompProfile); err != nil {
+			return nil, errors.Wrap(err, "failed to load default seccomp profile")
+		}
+	}
+
+	hooks, err := loadOCIHooks(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load OCI hooks")
+	}
+	mgr.hooks = hooks
+
 	mgr.Client.SetExitHooks(mgr.exitedAndRelease)
 	mgr.Client.SetExecExitHooks(mgr.execExitedAndRelease)
-	mgr.Client.SetEventsHooks(mgr.publishContainerdEvent, mgr.updateContainerState)
+	mgr.Client.SetEventsHooks(mgr.publishContainerdEvent, mgr.updateContainerState, mgr.handleMemoryPressure)
 
 	go mgr.execProcessGC()
 
+	if cfg.ImageGCInterval > 0 {
+		go mgr.imageGC()
+	}
+
 	return mgr, nil
 }
 
@@ -253,6 +337,12 @@ func (mgr *ContainerManager) Load(ctx context.Context) error {
 		// put container into cache.
 		mgr.cache.Put(id, container)
 
+		// reserve the MCS level of container, so that it is not
+		// allocated to another one.
+		if err := label.ReserveLabel(container.ProcessLabel); err != nil {
+			log.With(ctx).Warnf("failed to reserve SELinux label of container %s: %v", id, err)
+		}
+
 		return nil
 	}
 
@@ -272,7 +362,9 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 		return errors.Wrap(err, "failed to get container list")
 	}
 
-	for _, c := range containers {
+	// recover the dependencies and schedule their restarts first, so that
+	// they are ready before the containers depending on them.
+	for _, c := range sortByDependencies(containers) {
 		id := c.Key()
 
 		ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": id})
@@ -301,8 +393,15 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 			return err
 		}
 
-		// recover the running or paused container.
+		// recover the running or paused container, and restart the exited
+		// one by its restart policy.
 		if !c.IsRunningOrPaused() {
+			c.Lock()
+			err := mgr.scheduleRestart(ctx, c, true)
+			c.Unlock()
+			if err != nil {
+				log.With(ctx).Errorf("failed to restart container by policy, err(%v)", err)
+			}
 			continue
 		}
 
@@ -311,6 +410,9 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 		// Start recover the container
 		err = mgr.Client.RecoverContainer(ctx, id, cntrio)
 		if err == nil {
+			c.Lock()
+			mgr.startHealthMonitor(c, false)
+			c.Unlock()
 			continue
 		}
 
@@ -339,8 +441,27 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 
 // Create checks passed in parameters and create a Container object whose status is set at Created.
 func (mgr *ContainerManager) Create(ctx context.Context, name string, config *types.ContainerCreateConfig) (resp *types.ContainerCreateResp, err error) {
+	if err := mgr.validateSnapshotter(config.Snapshotter); err != nil {
+		return nil, err
+	}
+
+	// the snapshotter is chosen by user, or the remote snapshotter if the
+	// image is lazily pulled, or the snapshotter labeled on the image,
+	// otherwise the default snapshotter is used.
+	if config.Snapshotter == "" {
+		config.Snapshotter = mgr.ImageMgr.LazySnapshotter(ctx, config.Image)
+	}
+	if config.Snapshotter == "" {
+		snapshotter, err := mgr.imageSnapshotter(ctx, config.Image)
+		if err != nil {
+			return nil, err
+		}
+		config.Snapshotter = snapshotter
+	}
 	currentSnapshotter := ctrd.CurrentSnapshotterName(ctx)
-	config.Snapshotter = currentSnapshotter
+	if config.Snapshotter == "" {
+		config.Snapshotter = currentSnapshotter
+	}
 
 	if mgr.containerPlugin != nil {
 		log.With(ctx).Infof("invoke container pre-create hook in plugin")
@@ -356,7 +477,7 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	}
 
 	// NOTE: choose snapshotter, snapshotter can only be set
-	// through containerPlugin in Create function
+	// by user or through containerPlugin in Create function
 	ctx = ctrd.WithSnapshotter(ctx, config.Snapshotter)
 
 	// cleanup allocated resources when failed
@@ -374,12 +495,6 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		}
 	}()
 
-	imgID, _, primaryRef, err := mgr.ImageMgr.CheckReference(ctx, config.Image)
-	if err != nil {
-		return nil, err
-	}
-	config.Image = primaryRef.String()
-
 	// TODO: check request validate.
 	if config.HostConfig == nil {
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "HostConfig cannot be empty")
@@ -388,11 +503,52 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "NetworkingConfig cannot be empty")
 	}
 
+	// the container is created from either the image or the existing
+	// rootfs on host.
+	var (
+		imgID        string
+		rootfs       string
+		bundleConfig ocispec.ImageConfig
+	)
+	if config.HostConfig.Rootfs != nil {
+		if rootfs, bundleConfig, err = mgr.prepareRootfs(config); err != nil {
+			return nil, err
+		}
+	} else {
+		if config.Image == "" {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "image cannot be empty unless rootfs is specified")
+		}
+
+		actualID, _, primaryRef, err := mgr.ImageMgr.CheckReference(ctx, config.Image)
+		if err != nil {
+			return nil, err
+		}
+		imgID = actualID.String()
+		config.Image = primaryRef.String()
+
+		// hold the image until the container is created, so that it is
+		// not pruned by image gc meanwhile.
+		defer mgr.ImageMgr.HoldImage(actualID)()
+
+		if err := mgr.ImageMgr.CheckImageTrust(ctx, config.Image); err != nil {
+			return nil, err
+		}
+
+		// the other snapshotter needs the whole image to unpack.
+		if err := mgr.ImageMgr.FetchLazyImage(ctx, config.Image, ctrd.CurrentSnapshotterName(ctx)); err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch lazily pulled image %s", config.Image)
+		}
+	}
+
 	// validate disk quota
 	if err := mgr.validateDiskQuota(config); err != nil {
 		return nil, errors.Wrapf(err, "invalid disk quota config")
 	}
 
+	if err := mgr.validateStorageOpt(ctx, config); err != nil {
+		return nil, err
+	}
+
 	id, err := mgr.generateContainerID(config.SpecificID)
 	if err != nil {
 		return nil, err
@@ -415,6 +571,12 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "container name %s", name)
 	}
 
+	// the hostname and domainname are the ones of the container whose uts
+	// namespace is joined.
+	if isContainer(config.HostConfig.UTSMode) && (config.Hostname.String() != "" || config.Domainname != "") {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "conflicting options: hostname and the container type uts mode")
+	}
+
 	// set hostname.
 	if config.Hostname.String() == "" {
 		// if hostname is empty, take the part of id as the hostname
@@ -423,7 +585,7 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 
 	// set container runtime
 	if config.HostConfig.Runtime == "" {
-		config.HostConfig.Runtime = mgr.Config.DefaultRuntime
+		config.HostConfig.Runtime = mgr.Config.GetDefaultRuntime()
 	}
 
 	config.HostConfig.RuntimeType, err = mgr.getRuntimeType(config.HostConfig.Runtime)
@@ -431,15 +593,29 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "unknown runtime %s: %v", config.HostConfig.Runtime, err)
 	}
 
+	// set the default annotations and mounts of runtime
+	mgr.mergeRuntimeDefaults(config)
+
 	snapID := id
-	// create a snapshot with image.
-	if err := mgr.Client.CreateSnapshot(ctx, snapID, config.Image); err != nil {
-		return nil, err
+	if rootfs == "" {
+		// the wasm OCI artifacts have no rootfs, only the wasm shims run them.
+		wasm, err := mgr.Client.IsWasmImage(ctx, config.Image)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check image %s", config.Image)
+		}
+		if wasm && !ctrd.IsWasmRuntime(config.HostConfig.RuntimeType) {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "wasm image %s cannot run with runtime %s of type %s", config.Image, config.HostConfig.Runtime, config.HostConfig.RuntimeType)
+		}
+
+		// create a snapshot with image.
+		if err := mgr.createSnapshot(ctx, snapID, config.Image, config.HostConfig); err != nil {
+			return nil, err
+		}
+		cleanups = append(cleanups, func() error {
+			log.With(ctx).Infof("start to cleanup snapshot, id is %v", id)
+			return mgr.Client.RemoveSnapshot(ctx, id)
+		})
 	}
-	cleanups = append(cleanups, func() error {
-		log.With(ctx).Infof("start to cleanup snapshot, id is %v", id)
-		return mgr.Client.RemoveSnapshot(ctx, id)
-	})
 
 	// set lxcfs binds
 	if config.HostConfig.EnableLxcfs && lxcfs.IsLxcfsEnabled {
@@ -455,6 +631,9 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	// set default log driver and validate for logger driver
 	config.HostConfig.LogConfig = mgr.getDefaultLogConfigIfMissing(config.HostConfig.LogConfig)
 
+	// set default ulimits, so that inspect shows the effective ones
+	config.HostConfig.Ulimits = mgr.mergeDefaultUlimits(config.HostConfig.Ulimits)
+
 	// set ReadonlyPaths and MaskedPaths to nil if privileged was set.
 	if config.HostConfig.Privileged {
 		config.HostConfig.ReadonlyPaths = nil
@@ -468,7 +647,7 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 			FinishedAt: time.Time{}.UTC().Format(utils.TimeLayout),
 		},
 		ID:         id,
-		Image:      imgID.String(),
+		Image:      imgID,
 		Name:       name,
 		Config:     &config.ContainerConfig,
 		Created:    time.Now().UTC().Format(utils.TimeLayout),
@@ -481,16 +660,35 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, err
 	}
 
-	// merge image's config into container
-	if err := container.merge(func() (ocispec.ImageConfig, error) {
-		return mgr.ImageMgr.GetOCIImageConfig(ctx, config.Image)
-	}); err != nil {
-		return nil, err
-	}
+	if rootfs != "" {
+		// merge the process of bundle into container, and the rootfs is
+		// taken as the basefs.
+		if err := container.merge(func() (ocispec.ImageConfig, error) {
+			return bundleConfig, nil
+		}); err != nil {
+			return nil, err
+		}
+		container.RootFSProvided = true
+		container.BaseFS = rootfs
+	} else {
+		// merge image's config into container
+		if err := container.merge(func() (ocispec.ImageConfig, error) {
+			return mgr.ImageMgr.GetOCIImageConfig(ctx, config.Image)
+		}); err != nil {
+			return nil, err
+		}
 
-	// set container basefs, basefs is not created in pouchd, it will created
-	// after create options passed to containerd.
-	mgr.setBaseFS(ctx, container)
+		// inherit the healthcheck of image.
+		imageHealthcheck, err := mgr.ImageMgr.GetImageHealthcheck(ctx, config.Image)
+		if err != nil {
+			return nil, err
+		}
+		container.Config.Healthcheck = mergeHealthcheck(container.Config.Healthcheck, imageHealthcheck)
+
+		// set container basefs, basefs is not created in pouchd, it will created
+		// after create options passed to containerd.
+		mgr.setBaseFS(ctx, container)
+	}
 
 	// init container storage module, such as: set volumes, set diskquota, set /etc/mtab, copy image's data to volume.
 	if err := mgr.initContainerStorage(ctx, container); err != nil {
@@ -504,28 +702,53 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	}
 	container.NetworkSettings = new(types.NetworkSettings)
 	if len(config.NetworkingConfig.EndpointsConfig) > 0 {
+		for name, epConfig := range config.NetworkingConfig.EndpointsConfig {
+			if err := validateEndpointAliases(name, epConfig); err != nil {
+				return nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+			}
+		}
 		container.NetworkSettings.Networks = config.NetworkingConfig.EndpointsConfig
 	}
 	if container.NetworkSettings.Networks == nil &&
-		!IsContainer(config.HostConfig.NetworkMode) && !IsNetNS(config.HostConfig.NetworkMode) {
+		!IsContainer(config.HostConfig.NetworkMode) && !IsNetNS(config.HostConfig.NetworkMode) &&
+		!IsCNI(config.HostConfig.NetworkMode) {
 		container.NetworkSettings.Networks = make(map[string]*types.EndpointSettings)
 		container.NetworkSettings.Networks[config.HostConfig.NetworkMode] = new(types.EndpointSettings)
 	}
 	container.NetworkSettings.Ports = config.HostConfig.PortBindings
 
-	if err := parseSecurityOpts(container, config.HostConfig.SecurityOpt); err != nil {
+	// the static addresses are reserved by the container until it's removed.
+	if err := mgr.validateStaticAddresses(ctx, container); err != nil {
 		return nil, err
 	}
 
-	// Get snapshot UpperDir
-	mounts, err := mgr.Client.GetMounts(ctx, id)
+	securityOpts, err := mgr.selinuxSecurityOpts(config.HostConfig)
 	if err != nil {
 		return nil, err
 	}
-	if len(mounts) != 1 {
-		return nil, fmt.Errorf("failed to get snapshot %s mounts: not equals one", id)
+	if err := parseSecurityOpts(container, securityOpts); err != nil {
+		return nil, err
+	}
+	cleanups = append(cleanups, func() error {
+		return label.ReleaseLabel(container.ProcessLabel)
+	})
+
+	if rootfs != "" {
+		// the rootfs on host has no snapshot.
+		container.Snapshotter = &types.SnapshotterData{
+			Data: make(map[string]string),
+		}
+	} else {
+		// Get snapshot UpperDir
+		mounts, err := mgr.Client.GetMounts(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(mounts) != 1 {
+			return nil, fmt.Errorf("failed to get snapshot %s mounts: not equals one", id)
+		}
+		container.SetSnapshotterMeta(ctrd.CurrentSnapshotterName(ctx), mounts)
 	}
-	container.SetSnapshotterMeta(mounts)
 
 	// amendContainerSettings modify container config settings to wanted
 	amendContainerSettings(&config.ContainerConfig, config.HostConfig)
@@ -536,6 +759,19 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, err
 	}
 
+	// resolve NUMA policy into cpuset, so that it is kept after the
+	// container restarts.
+	if err := mgr.resolveNumaPolicy(container); err != nil {
+		return nil, err
+	}
+
+	// change the owner of rootfs at last, since it can't be rolled back.
+	if rootfs != "" && config.HostConfig.Rootfs.Owner != "" {
+		if err := chownRootfs(rootfs, config.HostConfig.Rootfs.Owner); err != nil {
+			return nil, errors.Wrapf(err, "failed to change the owner of rootfs %s", rootfs)
+		}
+	}
+
 	// store disk
 	if err := container.Write(mgr.Store); err != nil {
 		log.With(ctx).Errorf("failed to update meta: %v", err)
@@ -578,6 +814,35 @@ func (mgr *ContainerManager) getDefaultLogConfigIfMissing(logConfig *types.LogCo
 	return logConfig
 }
 
+// mergeDefaultUlimits appends the default ulimits of daemon whose names
+// are not set by the container.
+func (mgr *ContainerManager) mergeDefaultUlimits(ulimits []*types.Ulimit) []*types.Ulimit {
+	set := make(map[string]bool, len(ulimits))
+	for _, ul := range ulimits {
+		if ul != nil {
+			set[ul.Name] = true
+		}
+	}
+
+	names := make([]string, 0, len(mgr.Config.DefaultUlimits))
+	for name := range mgr.Config.DefaultUlimits {
+		if !set[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ul := mgr.Config.DefaultUlimits[name]
+		ulimits = append(ulimits, &types.Ulimit{
+			Name: ul.Name,
+			Soft: ul.Soft,
+			Hard: ul.Hard,
+		})
+	}
+	return ulimits
+}
+
 // Get the detailed information of container.
 func (mgr *ContainerManager) Get(ctx context.Context, name string) (*Container, error) {
 	c, err := mgr.container(name)
@@ -623,6 +888,15 @@ func (mgr *ContainerManager) Start(ctx context.Context, id string, options *type
 	// through containerPlugin in Create function
 	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
 
+	// the retries of restart policy are counted again.
+	c.Lock()
+	c.RestartCount = 0
+	c.Unlock()
+
+	if err := mgr.startDependencies(ctx, c); err != nil {
+		return err
+	}
+
 	err = mgr.start(ctx, c, options)
 	if err == nil {
 		mgr.LogContainerEvent(ctx, c, "start")
@@ -632,10 +906,30 @@ func (mgr *ContainerManager) Start(ctx context.Context, id string, options *type
 }
 
 func (mgr *ContainerManager) start(ctx context.Context, c *Container, options *types.ContainerStartOptions) error {
+	if options.DetachKeys != "" {
+		if _, err := streams.ParseDetachKeys(options.DetachKeys); err != nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+	}
+
 	// NOTE: add a big lock when start a container
 	c.Lock()
 	defer c.Unlock()
 
+	// the container is started by user, so the pending restart by policy
+	// is canceled and the policy state is reset.
+	c.cancelRestart()
+	c.restartBackoff = 0
+	if c.IsRestarting() {
+		c.SetStatusExited(c.State.ExitCode, c.State.Error)
+	}
+
+	return mgr.startContainer(ctx, c, options)
+}
+
+// startContainer starts the container, the caller should hold the lock of
+// container.
+func (mgr *ContainerManager) startContainer(ctx context.Context, c *Container, options *types.ContainerStartOptions) error {
 	var err error
 	c.DetachKeys = options.DetachKeys
 
@@ -683,14 +977,54 @@ func (mgr *ContainerManager) start(ctx context.Context, c *Container, options *t
 		attachedVolumes[mp.Name] = struct{}{}
 	}
 
+	if err = mgr.prepareUtsNamespace(ctx, c); err != nil {
+		return err
+	}
+
 	if err = mgr.prepareContainerNetwork(ctx, c); err != nil {
 		return err
 	}
 
-	if err = mgr.createContainerdContainer(ctx, c, options.CheckpointDir, options.CheckpointID); err != nil {
+	if err = mgr.setupShm(ctx, c); err != nil {
+		return err
+	}
+
+	if err = mgr.createContainerdContainer(ctx, c, options); err != nil {
 		return errors.Wrapf(err, "failed to create container(%s) on containerd", c.ID)
 	}
 
+	// the network interfaces are in the network namespace of container
+	// after it's created, so the bandwidth limits are applied here, and the
+	// container must not run without the limits.
+	if err = mgr.applyNetworkBandwidth(c, false); err != nil {
+		err = errors.Wrapf(err, "failed to apply network bandwidth limits of container %s", c.ID)
+		msg, derr := mgr.Client.DestroyContainer(ctx, c.ID, c.StopTimeout())
+		if derr != nil {
+			log.With(ctx).Errorf("failed to destroy container %s when start container rollback: %v", c.ID, derr)
+		}
+		if rerr := mgr.markStoppedAndRelease(ctx, c, msg); rerr != nil {
+			log.With(ctx).Errorf("failed to mark container %s stopped when start container rollback: %v", c.ID, rerr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// prepareUtsNamespace copies the hostname and domainname of the container
+// whose uts namespace is joined, like the container type network mode, so
+// that they are written into the hostname and hosts files of container.
+func (mgr *ContainerManager) prepareUtsNamespace(ctx context.Context, c *Container) error {
+	if !isContainer(c.HostConfig.UTSMode) {
+		return nil
+	}
+
+	owner, err := getUtsContainer(ctx, mgr, connectedContainer(c.HostConfig.UTSMode))
+	if err != nil {
+		return err
+	}
+	c.Config.Hostname = owner.Config.Hostname
+	c.Config.Domainname = owner.Config.Domainname
 	return nil
 }
 
@@ -732,6 +1066,11 @@ func (mgr *ContainerManager) prepareContainerNetwork(ctx context.Context, c *Con
 		return nil
 	}
 
+	// network is set up by the CNI plugins.
+	if IsCNI(networkMode) {
+		return mgr.setupCNINetwork(ctx, c)
+	}
+
 	// initialise network endpoint
 	if c.NetworkSettings == nil {
 		return nil
@@ -773,7 +1112,7 @@ func (mgr *ContainerManager) buildNetworkRelatedPath(c *Container) error {
 	return ioutil.WriteFile(c.HostnamePath, []byte(c.Config.Hostname+"\n"), 0644)
 }
 
-func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *Container, checkpointDir, checkpointID string) error {
+func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *Container, options *types.ContainerStartOptions) error {
 	// CgroupParent from HostConfig will be first priority to use,
 	// then will be value from mgr.Config.CgroupParent
 	if c.HostConfig.CgroupParent == "" {
@@ -802,6 +1141,11 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		}
 	}
 
+	initPath, err := mgr.initPath(c)
+	if err != nil {
+		return err
+	}
+
 	sw := &SpecWrapper{
 		ctrMgr:     mgr,
 		volMgr:     mgr.VolumeMgr,
@@ -809,12 +1153,20 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		prioArr:    prioArr,
 		argsArr:    argsArr,
 		useSystemd: mgr.Config.UseSystemd(),
+		idMapping:  mgr.idMapping,
+		hooks:      mgr.hooks,
+		initPath:   initPath,
+		baseSpec:   mgr.Config.Runtimes[c.HostConfig.Runtime].BaseSpec,
 	}
 
 	if err = createSpec(ctx, c, sw); err != nil {
 		return err
 	}
 
+	if err = mgr.mutateSpec(ctx, c, sw.s); err != nil {
+		return err
+	}
+
 	// init log driver
 	if err := mgr.initLogDriverBeforeStart(c); err != nil {
 		return errors.Wrap(err, "failed to initialize log driver")
@@ -827,7 +1179,7 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		c.HostConfig.RuntimeType = ctrd.RuntimeTypeV1
 	}
 
-	runtimeOptions, err := mgr.generateRuntimeOptions(c.HostConfig.Runtime)
+	runtimeOptions, err := mgr.generateRuntimeOptions(c)
 	if err != nil {
 		return err
 	}
@@ -843,19 +1195,30 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		RootFSProvided: c.RootFSProvided,
 		BaseFS:         c.BaseFS,
 		UseSystemd:     mgr.Config.UseSystemd(),
+		Resources:      &c.HostConfig.Resources,
 	}
 	// make sure the SnapshotID got a proper value
 	ctrdContainer.SnapshotID = c.SnapshotKey()
 
-	if checkpointID != "" {
-		checkpointDir, err = mgr.getCheckpointDir(c.ID, checkpointDir, checkpointID, false)
-		if err != nil {
+	restore, err := mgr.getRestoreOptions(c.ID, options)
+	if err != nil {
+		return err
+	}
+	// the lazy-pages daemon serves the restored task, it's only stopped
+	// if the task fails to restore.
+	var stopLazyPages func()
+	if restore != nil && options.LazyPages {
+		if stopLazyPages, err = mgr.prepareLazyRestore(ctx, c, restore, options.PageServer, sw.s); err != nil {
 			return err
 		}
 	}
-	if err := mgr.Client.CreateContainer(ctx, ctrdContainer, checkpointDir); err != nil {
+	if err := mgr.Client.CreateContainer(ctx, ctrdContainer, restore); err != nil {
 		log.With(ctx).Errorf("failed to create new containerd container: %v", err)
 
+		if stopLazyPages != nil {
+			stopLazyPages()
+		}
+
 		// TODO(ziren): markStoppedAndRelease may failed
 		// we should clean resources of container when start failed
 		_ = mgr.markStoppedAndRelease(ctx, c, nil)
@@ -870,6 +1233,7 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 	}
 
 	c.SetStatusRunning(int64(pid))
+	mgr.startHealthMonitor(c, true)
 
 	// set Snapshot MergedDir
 	c.Snapshotter.Data["MergedDir"] = c.BaseFS
@@ -892,6 +1256,18 @@ func (mgr *ContainerManager) ensureRootFSMounted(rootfs string, snapData map[str
 		return nil
 	}
 
+	// the rootfs of snapshotter other than overlayfs is mounted as it is.
+	if mountType, ok := snapData["MountType"]; ok {
+		m := mount.Mount{
+			Type:   mountType,
+			Source: snapData["MountSource"],
+		}
+		if opts := snapData["MountOptions"]; opts != "" {
+			m.Options = strings.Split(opts, ",")
+		}
+		return m.Mount(rootfs)
+	}
+
 	var workDir, upperDir, lowerDir string
 	for _, dir := range []string{"WorkDir", "UpperDir", "LowerDir"} {
 		if v, ok := snapData[dir]; ok {
@@ -943,6 +1319,11 @@ func (mgr *ContainerManager) Stop(ctx context.Context, name string, timeout int6
 	}
 	mgr.LogContainerEvent(ctx, c, "stop")
 
+	if c.HostConfig.AutoRemove {
+		c.Lock()
+		defer c.Unlock()
+		return mgr.autoRemove(ctx, c)
+	}
 	return nil
 }
 
@@ -950,6 +1331,13 @@ func (mgr *ContainerManager) stop(ctx context.Context, c *Container, timeout int
 	c.Lock()
 	defer c.Unlock()
 
+	// cancel the pending restart by policy.
+	if c.IsRestarting() {
+		c.cancelRestart()
+		c.SetStatusStopped(c.State.ExitCode, c.State.Error)
+		return c.Write(mgr.Store)
+	}
+
 	if !c.IsRunningOrPaused() {
 		// stopping a non-running container is valid.
 		return nil
@@ -992,6 +1380,10 @@ func (mgr *ContainerManager) Restart(ctx context.Context, name string, timeout i
 
 	log.With(ctx).Debugf("start container %s when restarting", c.ID)
 
+	if err := mgr.startDependencies(ctx, c); err != nil {
+		return err
+	}
+
 	// start container
 	err = mgr.start(ctx, c, &types.ContainerStartOptions{})
 	if err != nil {
@@ -1094,6 +1486,19 @@ func (mgr *ContainerManager) AttachContainerIO(ctx context.Context, name string,
 	cntrio := mgr.IOs.Get(c.ID)
 	cfg.Terminal = c.Config.Tty
 
+	// NOTE: the detach keys of attaching take precedence over the ones set
+	// by starting the container, and they only apply to the container with
+	// tty.
+	if c.Config.Tty && cfg.DetachKeys == nil {
+		keys := c.DetachKeys
+		if keys == "" {
+			keys = streams.DefaultDetachKeys
+		}
+		if cfg.DetachKeys, err = streams.ParseDetachKeys(keys); err != nil {
+			cfg.DetachKeys, _ = streams.ParseDetachKeys(streams.DefaultDetachKeys)
+		}
+	}
+
 	// NOTE: the AttachContainerIO might use the hijack's connection as
 	// stdin in the AttachConfig. If we close it directly, the stdout/stderr
 	// will return the `using closed connection` error. As a result, the
@@ -1157,6 +1562,16 @@ func (mgr *ContainerManager) Rename(ctx context.Context, oldName, newName string
 		return fmt.Errorf("Invalid container name (%s), only %s are allowed", newName, daemon_config.ValidNameChars)
 	}
 
+	// the endpoints are renamed so that the container is resolved by the new
+	// name in user-defined networks.
+	if c.NetworkSettings != nil && c.NetworkSettings.SandboxID != "" {
+		if sb, err := mgr.NetworkMgr.Controller().SandboxByID(c.NetworkSettings.SandboxID); err == nil {
+			if err := sb.Rename(newName); err != nil {
+				return errors.Wrapf(err, "failed to rename endpoints of container %s", c.ID)
+			}
+		}
+	}
+
 	name := c.Name
 	c.Name = newName
 
@@ -1202,23 +1617,38 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 		}
 	}()
 
-	if c.State.Running && config.Resources.KernelMemory != 0 {
-		return fmt.Errorf("failed to update container %s: can not update kernel memory to a running container, please stop it first", c.ID)
+	// kernel memory accounting of cgroup v1 can't be enabled after tasks
+	// joined the cgroup, so it's only updatable if the container is started
+	// with kernel memory limit.
+	if c.State.Running && config.Resources.KernelMemory != 0 && c.HostConfig.KernelMemory == 0 {
+		return fmt.Errorf("failed to update container %s: can not update kernel memory to a running container which is started without kernel memory limit, please stop it first", c.ID)
 	}
 
 	if c.State.Dead {
 		return fmt.Errorf("cannot update a dead container %s", c.ID)
 	}
 
+	if c.State.Running {
+		if err := validateRuntimeUpdate(c, &config.Resources); err != nil {
+			return err
+		}
+	}
+
 	// update container disk quota
 	if err := mgr.updateContainerDiskQuota(ctx, c, config.DiskQuota); err != nil {
 		return errors.Wrapf(err, "failed to update diskquota of container %s", c.ID)
 	}
 
-	// init Container Labels
-	if c.Config.Labels == nil {
-		c.Config.Labels = map[string]string{}
+	// copy Container Labels, so that they can be restored.
+	labels := make(map[string]string, len(c.Config.Labels))
+	for k, v := range c.Config.Labels {
+		labels[k] = v
 	}
+	c.Config.Labels = labels
+
+	// labelChanges records the updated labels, and the removed labels
+	// with empty value.
+	labelChanges := map[string]string{}
 
 	// compatibility with alidocker, UpdateConfig.Label is []string
 	// but ContainerConfig.Labels is map[string]string
@@ -1232,8 +1662,13 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 			} else {
 				c.Config.Labels[k] = v
 			}
+			labelChanges[k] = v
 		}
 	}
+	for _, k := range config.RemoveLabel {
+		delete(c.Config.Labels, k)
+		labelChanges[k] = ""
+	}
 
 	// update Resources of a container.
 	if err := mgr.updateContainerResources(c, config.Resources); err != nil {
@@ -1263,8 +1698,18 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 		}
 	}
 
-	if len(config.SpecAnnotation) > 0 {
-		c.Config.SpecAnnotation = mergeAnnotation(config.SpecAnnotation, c.Config.SpecAnnotation)
+	// the annotations of runtime spec take effect when the container is
+	// started again.
+	if len(config.SpecAnnotation) > 0 || len(config.RemoveSpecAnnotation) > 0 {
+		annotations := make(map[string]string, len(c.Config.SpecAnnotation))
+		for k, v := range c.Config.SpecAnnotation {
+			annotations[k] = v
+		}
+		annotations = mergeAnnotation(config.SpecAnnotation, annotations)
+		for _, k := range config.RemoveSpecAnnotation {
+			delete(annotations, k)
+		}
+		c.Config.SpecAnnotation = annotations
 	}
 
 	if mgr.containerPlugin != nil && len(config.Env) > 0 {
@@ -1283,12 +1728,28 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 		}
 	}
 
+	// the containerd container only exists when the container is running
+	// or paused, otherwise it's created with the labels at next start.
+	if c.IsRunningOrPaused() && len(labelChanges) != 0 {
+		if err := mgr.Client.UpdateContainerLabels(ctx, c.ID, labelChanges); err != nil {
+			restore = true
+			return errors.Wrapf(err, "failed to update labels of container %s", c.ID)
+		}
+	}
+
 	// store disk.
 	err = c.Write(mgr.Store)
 	if err != nil {
 		restore = true
 	}
 
+	// the labels of container may be selected by network policy.
+	if err == nil && len(labelChanges) != 0 && c.IsRunningOrPaused() && mgr.NetworkMgr != nil {
+		if err := mgr.NetworkMgr.ReconcileFirewall(); err != nil {
+			log.With(ctx).Warnf("failed to reconcile firewall after updating labels: %v", err)
+		}
+	}
+
 	mgr.LogContainerEvent(ctx, c, "update")
 	return err
 }
@@ -1309,6 +1770,11 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 	c.Lock()
 	defer c.Unlock()
 
+	return mgr.remove(ctx, c, options)
+}
+
+// remove deletes the container, the caller should hold the lock of container.
+func (mgr *ContainerManager) remove(ctx context.Context, c *Container, options *types.ContainerRemoveOptions) error {
 	if c.IsRunningOrPaused() && !options.Force {
 		return fmt.Errorf("container %s is not stopped, cannot remove it without flag force", c.ID)
 	}
@@ -1318,6 +1784,13 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		return nil
 	}
 
+	if err := mgr.checkNetworkJoiners(c); err != nil {
+		return err
+	}
+
+	// cancel the pending restart by policy.
+	c.cancelRestart()
+
 	// if the container is running, force to stop it.
 	if c.IsRunningOrPaused() && options.Force {
 		_, err := mgr.Client.DestroyContainer(ctx, c.ID, c.StopTimeout())
@@ -1331,13 +1804,23 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		}
 	}
 
+	// the CNI network is left if it failed to be released when the
+	// container exited.
+	if IsCNI(c.HostConfig.NetworkMode) && mgr.NetworkMgr != nil {
+		if err := mgr.releaseCNINetwork(ctx, c); err != nil {
+			log.With(ctx).Errorf("failed to release CNI network of container %s when removing: %v", c.ID, err)
+		}
+	}
+
 	if err := mgr.detachVolumes(ctx, c, options.Volumes); err != nil {
 		log.With(ctx).Errorf("failed to detach volume: %v", err)
 	}
 
-	// if creating the container by specify rootfs,
-	// we should umount the rootfs when delete the container.
-	if c.RootFSProvided {
+	// the rootfs on host is kept as it is, and if creating the container
+	// by specify rootfs, we should umount the rootfs when delete the container.
+	if c.hostRootfs() {
+		log.With(ctx).Debugf("keep the rootfs %s on host of container %s", c.BaseFS, c.ID)
+	} else if c.RootFSProvided {
 		if err := mount.Unmount(c.BaseFS, 0); err != nil {
 			log.With(ctx).Errorf("failed to umount rootfs when remove the container %s: %v", c.ID, err)
 		}
@@ -1360,6 +1843,10 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 	// 2. meta.json for container in local disk.
 	// 3. remove the container IO from cache
 
+	if err := label.ReleaseLabel(c.ProcessLabel); err != nil {
+		log.With(ctx).Errorf("failed to release SELinux label of container %s: %v", c.ID, err)
+	}
+
 	// remove name
 	mgr.NameToID.Remove(c.Name)
 	// remove container cache
@@ -1467,19 +1954,26 @@ func (mgr *ContainerManager) updateContainerResources(c *Container, resources ty
 	if resources.MemoryReservation != 0 {
 		cResources.MemoryReservation = resources.MemoryReservation
 	}
+	if resources.MemorySwappiness != nil {
+		cResources.MemorySwappiness = resources.MemorySwappiness
+	}
 	if resources.KernelMemory != 0 {
 		cResources.KernelMemory = resources.KernelMemory
 	}
+	if resources.PidsLimit != 0 {
+		cResources.PidsLimit = resources.PidsLimit
+	}
+	if len(resources.HugepageLimits) != 0 {
+		cResources.HugepageLimits = mergeHugepageLimits(cResources.HugepageLimits, resources.HugepageLimits)
+	}
 
 	return nil
 }
 
-// Top lists the processes running inside of the given container
+// Top lists the processes running inside of the given container, psArgs
+// are the options of ps command, which are not supported by the runtimes
+// running processes in the sandbox, like kata and gVisor.
 func (mgr *ContainerManager) Top(ctx context.Context, name string, psArgs string) (*types.ContainerProcessList, error) {
-	if psArgs == "" {
-		psArgs = "-ef"
-	}
-
 	c, err := mgr.container(name)
 	if err != nil {
 		return nil, err
@@ -1487,26 +1981,35 @@ func (mgr *ContainerManager) Top(ctx context.Context, name string, psArgs string
 
 	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
 
+	// the container lock isn't held when ps is running, which may take a
+	// while on the host with lots of processes.
 	c.Lock()
-	defer c.Unlock()
+	running := c.IsRunningOrPaused()
+	sandboxed := isKataRuntime(c) || isRunscRuntime(c)
+	c.Unlock()
 
-	if !c.IsRunningOrPaused() {
-		return nil, fmt.Errorf("container %s is not running or paused, cannot execute top command", c.ID)
+	if !running {
+		return nil, errors.Wrapf(errtypes.ErrConflict, "container %s is not running or paused, cannot execute top command", c.ID)
 	}
 
-	pids, err := mgr.Client.ContainerPIDs(ctx, c.ID)
+	processes, err := mgr.Client.ContainerProcesses(ctx, c.ID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get pids of container %s", c.ID)
+		return nil, errors.Wrapf(err, "failed to get processes of container %s", c.ID)
 	}
 
-	output, err := exec.Command("ps", strings.Split(psArgs, " ")...).Output()
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to run ps command")
-	}
-
-	procList, err := parsePSOutput(output, pids)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed parsePSOutput")
+	var procList *types.ContainerProcessList
+	if sandboxed {
+		// the pids in sandbox are meaningless on host, so only the info
+		// of processes reported by runtime is listed.
+		if psArgs != "" {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "ps options are not supported by runtime %s of container %s", c.HostConfig.Runtime, c.ID)
+		}
+		procList = sandboxProcessList(processes)
+	} else {
+		procList, err = hostProcessList(psArgs, processes)
+		if err != nil {
+			return nil, err
+		}
 	}
 	mgr.LogContainerEvent(ctx, c, "top")
 
@@ -1533,28 +2036,6 @@ func (mgr *ContainerManager) Resize(ctx context.Context, name string, opts types
 	return mgr.Client.ResizeContainer(ctx, c.ID, opts)
 }
 
-// Wait stops processing until the given container is stopped.
-func (mgr *ContainerManager) Wait(ctx context.Context, name string) (types.ContainerWaitOKBody, error) {
-	c, err := mgr.container(name)
-	if err != nil {
-		return types.ContainerWaitOKBody{}, err
-	}
-
-	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
-
-	// We should notice that container's meta data shouldn't be locked in wait process, otherwise waiting for
-	// a running container to stop would make other client commands which manage this container are blocked.
-	// If a container status is exited or stopped, return exit code immediately.
-	if !c.IsRunningOrPaused() {
-		return types.ContainerWaitOKBody{
-			Error:      c.State.Error,
-			StatusCode: c.ExitCode(),
-		}, nil
-	}
-
-	return mgr.Client.WaitContainer(ctx, c.ID)
-}
-
 // Connect is used to connect a container to a network.
 func (mgr *ContainerManager) Connect(ctx context.Context, name string, networkIDOrName string, epConfig *types.EndpointSettings) error {
 	c, err := mgr.container(name)
@@ -1579,21 +2060,48 @@ func (mgr *ContainerManager) Connect(ctx context.Context, name string, networkID
 	c.Lock()
 	defer c.Unlock()
 
-	if !c.State.Running {
-		if c.State.Dead {
-			return fmt.Errorf("container %s is marked for removal and cannot be connected or disconnected to the network %s", c.ID, n.Name)
-		}
+	if c.State.Dead {
+		return fmt.Errorf("container %s is marked for removal and cannot be connected or disconnected to the network %s", c.ID, n.Name)
+	}
 
-		if err := mgr.updateNetworkConfig(c, n.Name, epConfig); err != nil {
-			return err
-		}
-	} else if err := mgr.connectToNetwork(ctx, c, networkIDOrName, epConfig); err != nil {
+	if err := mgr.checkAddressConflicts(c, n.Name, epConfig); err != nil {
 		return err
 	}
 
+	// the endpoint of running container is created in its network namespace,
+	// the others are created when the container starts.
+	running := c.IsRunningOrPaused()
+	if running {
+		err = mgr.connectToNetwork(ctx, c, n, epConfig)
+	} else {
+		err = mgr.updateNetworkConfig(c, n, epConfig)
+	}
+	if err != nil {
+		return err
+	}
+
+	networkDisabled := c.Config.NetworkDisabled
+	c.Config.NetworkDisabled = false
+
+	if err := c.Write(mgr.Store); err != nil {
+		// roll back the connection, so that the meta is consistent with
+		// the network namespace of container.
+		if running {
+			endpoint := mgr.buildContainerEndpoint(ctx, c, n.Name)
+			endpoint.EndpointConfig = epConfig
+			endpoint.KeepSandbox = true
+			if err := mgr.NetworkMgr.EndpointRemove(ctx, endpoint); err != nil {
+				log.With(ctx).Errorf("failed to remove endpoint after failing to connect network %s: %v", n.Name, err)
+			}
+		}
+		delete(c.NetworkSettings.Networks, n.Name)
+		c.Config.NetworkDisabled = networkDisabled
+		return errors.Wrapf(err, "failed to update meta of container %s", c.ID)
+	}
+
 	mgr.LogNetworkEventWithAttributes(ctx, n.Network, "connect", map[string]string{"container": c.ID})
 
-	return c.Write(mgr.Store)
+	return nil
 }
 
 // Disconnect disconnects the given container from
@@ -1634,6 +2142,9 @@ func (mgr *ContainerManager) Disconnect(ctx context.Context, containerName, netw
 
 	endpoint := mgr.buildContainerEndpoint(ctx, c, network.Name)
 	endpoint.EndpointConfig = epConfig
+	// the network namespace is still used by the running container even if
+	// it's disconnected from all the networks.
+	endpoint.KeepSandbox = c.IsRunningOrPaused()
 	if err := mgr.NetworkMgr.EndpointRemove(ctx, endpoint); err != nil {
 		// TODO(ziren): it is a trick, we should wrapper sandbox
 		// not found as an error type
@@ -1643,6 +2154,14 @@ func (mgr *ContainerManager) Disconnect(ctx context.Context, containerName, netw
 		}
 	}
 
+	// the hosts entries of container itself are added by libnetwork when
+	// the endpoint joins, but left when it leaves.
+	if endpoint.KeepSandbox && c.HostsPath != "" {
+		if err := removeHostsEntries(c.HostsPath, c.Config.Hostname.String(), epConfig.IPAddress, epConfig.GlobalIPV6Address); err != nil {
+			log.With(ctx).Warnf("failed to remove hosts entries of network %s: %v", network.Name, err)
+		}
+	}
+
 	// disconnect an endpoint success, delete endpoint info from container json
 	delete(c.NetworkSettings.Networks, network.Name)
 
@@ -1666,58 +2185,66 @@ func (mgr *ContainerManager) Disconnect(ctx context.Context, containerName, netw
 	return nil
 }
 
-func (mgr *ContainerManager) updateNetworkConfig(container *Container, networkIDOrName string, endpointConfig *types.EndpointSettings) error {
+// validateNetworkConnect checks whether the container could be connected to
+// the network with the endpoint config.
+func validateNetworkConnect(container *Container, network *networktypes.Network, endpointConfig *types.EndpointSettings) error {
 	if IsContainer(container.HostConfig.NetworkMode) {
 		return fmt.Errorf("container sharing network namespace with another container or host cannot be connected to any other network")
 	}
+	if IsCNI(container.HostConfig.NetworkMode) {
+		return fmt.Errorf("container in cni network mode cannot be connected to any other network")
+	}
 
 	// TODO check bridge-mode conflict
 
-	if IsUserDefined(container.HostConfig.NetworkMode) {
-		if hasUserDefinedIPAddress(endpointConfig) {
-			return fmt.Errorf("user specified IP address is supported on user defined networks only")
-		}
-		if endpointConfig != nil && len(endpointConfig.Aliases) > 0 {
-			return fmt.Errorf("network-scoped alias is supported only for containers in user defined networks")
-		}
-	} else {
-		addShortID := true
-		shortID := utils.TruncateID(container.ID)
-		for _, alias := range endpointConfig.Aliases {
-			if alias == shortID {
-				addShortID = false
-				break
-			}
-		}
-		if addShortID {
-			endpointConfig.Aliases = append(endpointConfig.Aliases, shortID)
+	if container.NetworkSettings != nil {
+		if _, ok := container.NetworkSettings.Networks[network.Name]; ok {
+			return errors.Wrapf(errtypes.ErrConflict, "container %s is already connected to network %s", container.ID, network.Name)
 		}
 	}
 
-	network, err := mgr.NetworkMgr.Get(context.Background(), networkIDOrName)
-	if err != nil {
+	if !IsUserDefined(network.Name) && hasUserDefinedIPAddress(endpointConfig) {
+		return fmt.Errorf("user specified IP address is supported on user defined networks only")
+	}
+	if err := validateEndpointAliases(network.Name, endpointConfig); err != nil {
 		return err
 	}
 
-	if err := validateNetworkingConfig(network.Network, endpointConfig); err != nil {
+	return validateNetworkingConfig(network.Network, endpointConfig)
+}
+
+// updateNetworkConfig records the endpoint config of network in container,
+// the endpoint is created when the container starts.
+func (mgr *ContainerManager) updateNetworkConfig(container *Container, network *networktypes.Network, endpointConfig *types.EndpointSettings) error {
+	if err := validateNetworkConnect(container, network, endpointConfig); err != nil {
 		return err
 	}
 
+	if container.NetworkSettings == nil {
+		container.NetworkSettings = &types.NetworkSettings{}
+	}
+	if container.NetworkSettings.Networks == nil {
+		container.NetworkSettings.Networks = make(map[string]*types.EndpointSettings)
+	}
 	container.NetworkSettings.Networks[network.Name] = endpointConfig
 
 	return nil
 }
 
-func (mgr *ContainerManager) connectToNetwork(ctx context.Context, container *Container, networkIDOrName string, epConfig *types.EndpointSettings) (err error) {
-	if IsContainer(container.HostConfig.NetworkMode) {
-		return fmt.Errorf("container sharing network namespace with another container or host cannot be connected to any other network")
+// connectToNetwork creates the endpoint of network in the network namespace
+// of running container, and records the endpoint config in container.
+func (mgr *ContainerManager) connectToNetwork(ctx context.Context, container *Container, network *networktypes.Network, epConfig *types.EndpointSettings) error {
+	if err := validateNetworkConnect(container, network, epConfig); err != nil {
+		return err
 	}
 
-	// TODO check bridge mode conflict
-
-	network, err := mgr.NetworkMgr.Get(context.Background(), networkIDOrName)
-	if err != nil {
-		return errors.Wrap(err, "failed to get network")
+	// the sandbox is set up with the network namespace of container when it
+	// starts, a new sandbox can't be joined by the running container.
+	if container.NetworkSettings == nil || container.NetworkSettings.SandboxID == "" {
+		return fmt.Errorf("container %s is running without network namespace managed by pouchd, restart it to connect network %s", container.ID, network.Name)
+	}
+	if _, err := mgr.NetworkMgr.Controller().SandboxByID(container.NetworkSettings.SandboxID); err != nil {
+		return errors.Wrapf(err, "failed to get sandbox of container %s", container.ID)
 	}
 
 	endpoint := mgr.buildContainerEndpoint(ctx, container, network.Name)
@@ -1727,7 +2254,16 @@ func (mgr *ContainerManager) connectToNetwork(ctx context.Context, container *Co
 		return err
 	}
 
-	return mgr.updateNetworkConfig(container, networkIDOrName, endpoint.EndpointConfig)
+	// the new interface is limited as the others, and it's removed if the
+	// limits fail to apply.
+	if err := mgr.applyNetworkBandwidth(container, false); err != nil {
+		if rerr := mgr.NetworkMgr.EndpointRemove(ctx, endpoint); rerr != nil {
+			log.With(ctx).Errorf("failed to remove endpoint of network %s: %v", network.Name, rerr)
+		}
+		return errors.Wrapf(err, "failed to apply network bandwidth limits of container %s", container.ID)
+	}
+
+	return mgr.updateNetworkConfig(container, network, endpoint.EndpointConfig)
 }
 
 func (mgr *ContainerManager) initContainerIO(c *Container) (*containerio.IO, error) {
@@ -1763,6 +2299,9 @@ func (mgr *ContainerManager) initLogDriverBeforeStart(c *Container) error {
 		return err
 	}
 
+	// NOTE: the logs are buffered in non-blocking mode, so that the slow
+	// log driver cannot block the output of container. The default size of
+	// buffer is used if max-buffer-size is not set.
 	if logger.LogMode(logInfo.LogConfig["mode"]) == logger.LogModeNonBlock {
 		if maxBufferSize, ok := logInfo.LogConfig["max-buffer-size"]; ok {
 			maxBytes, err := units.RAMInBytes(maxBufferSize)
@@ -1770,8 +2309,8 @@ func (mgr *ContainerManager) initLogDriverBeforeStart(c *Container) error {
 				return errors.Wrapf(err, "failed to parse option max-buffer-size: %s", maxBufferSize)
 			}
 			cntrio.SetMaxBufferSize(maxBytes)
-			cntrio.SetNonBlock(true)
 		}
+		cntrio.SetNonBlock(true)
 	}
 	cntrio.SetLogDriver(logDriver)
 	return nil
@@ -1908,24 +2447,39 @@ func (mgr *ContainerManager) exitedAndRelease(id string, m *ctrd.Message, cleanu
 
 	// send exit event to monitor
 	mgr.monitor.PostEvent(ContainerExitEvent(c).WithHandle(func(c *Container) error {
+		c.Lock()
+		defer c.Unlock()
+
 		// check status and restart policy
 		if !c.State.Exited {
 			return nil
 		}
-
-		policy := (*ContainerRestartPolicy)(c.HostConfig.RestartPolicy)
-		keys := c.DetachKeys
-
-		if policy == nil || policy.IsNone() {
-			return nil
+		if err := mgr.handleExitByPolicy(ctx, c); err != nil {
+			return err
 		}
-
-		return mgr.Start(context.TODO(), c.ID, &types.ContainerStartOptions{DetachKeys: keys})
+		return mgr.autoRemove(ctx, c)
 	}))
 
 	return nil
 }
 
+// autoRemove removes the exited container and its anonymous volumes if
+// AutoRemove is set. The container is kept if it has been started again
+// or it is going to be restarted, the caller should hold the lock of
+// container.
+func (mgr *ContainerManager) autoRemove(ctx context.Context, c *Container) error {
+	if !c.HostConfig.AutoRemove || c.IsRunningOrPaused() || c.IsRestarting() || c.State.Dead {
+		return nil
+	}
+
+	// the container has been removed.
+	if container, err := mgr.container(c.ID); err != nil || container != c {
+		return nil
+	}
+
+	return mgr.remove(ctx, c, &types.ContainerRemoveOptions{Force: true, Volumes: true})
+}
+
 // execExitedAndRelease be register into ctrd as a callback function, when the exec process in a container
 // exited, "ctrd" will call it to release resource and so on.
 func (mgr *ContainerManager) execExitedAndRelease(id string, m *ctrd.Message) error {
@@ -1943,6 +2497,7 @@ func (mgr *ContainerManager) execExitedAndRelease(id string, m *ctrd.Message) er
 	execConfig.Running = false
 	execConfig.Error = m.RawError()
 	execConfig.Exited = true
+	execConfig.ExitTime = m.ExitTime()
 
 	execConfig.Unlock()
 
@@ -1959,6 +2514,9 @@ func (mgr *ContainerManager) execExitedAndRelease(id string, m *ctrd.Message) er
 
 func (mgr *ContainerManager) releaseContainerResources(ctx context.Context, c *Container) error {
 	mgr.resetContainerIOs(c.ID)
+	if err := mgr.releaseShm(c); err != nil {
+		log.With(ctx).Warnf("failed to release shm of container %s: %v", c.ID, err)
+	}
 	return mgr.releaseContainerNetwork(ctx, c)
 }
 
@@ -1972,6 +2530,10 @@ func (mgr *ContainerManager) releaseContainerNetwork(ctx context.Context, c *Con
 		return nil
 	}
 
+	if IsCNI(c.HostConfig.NetworkMode) {
+		return mgr.releaseCNINetwork(ctx, c)
+	}
+
 	for name, epConfig := range c.NetworkSettings.Networks {
 		endpoint := mgr.buildContainerEndpoint(ctx, c, name)
 		endpoint.EndpointConfig = epConfig
@@ -1985,6 +2547,18 @@ func (mgr *ContainerManager) releaseContainerNetwork(ctx context.Context, c *Con
 		}
 	}
 
+	// the sandbox is kept if the running container is disconnected from all
+	// the networks, release it since the network namespace is gone.
+	if c.NetworkSettings.SandboxID != "" {
+		sb, err := mgr.NetworkMgr.Controller().SandboxByID(c.NetworkSettings.SandboxID)
+		if err == nil && len(sb.Endpoints()) == 0 {
+			if err := sb.Delete(); err != nil {
+				log.With(ctx).Errorf("failed to delete sandbox %s: %v", sb.ID(), err)
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -2008,6 +2582,7 @@ func (mgr *ContainerManager) buildContainerEndpoint(ctx context.Context, c *Cont
 	if !IsUserDefined(name) {
 		ep.DisableResolver = true
 	}
+	ep.ReservedAddresses = mgr.reservedAddresses(c.ID, name)
 
 	if mgr.containerPlugin != nil {
 		// just ignore return err
@@ -2042,32 +2617,109 @@ func (mgr *ContainerManager) setBaseFS(ctx context.Context, c *Container) {
 // execProcessGC cleans unused exec processes config every 5 minutes.
 func (mgr *ContainerManager) execProcessGC() {
 	for range time.Tick(time.Duration(GCExecProcessTick) * time.Minute) {
-		execProcesses := mgr.ExecProcesses.Values(nil)
-		cleaned := 0
+		cleaned := mgr.cleanExecProcesses("", false)
+		if len(cleaned) > 0 {
+			log.With(nil).Debugf("clean %d unused exec process", len(cleaned))
+		}
+	}
+}
 
-		for id, v := range execProcesses {
-			execConfig, ok := v.(*ContainerExecConfig)
-			if !ok {
-				log.With(nil).Warnf("get incorrect exec config: %v", v)
-				continue
-			}
-			// if unused exec processes are found, we will tag them, and clean
-			// them in next loop, so that we can ensure exec process can get
-			// correct exit code.
-			execConfig.Lock()
-			if execConfig.WaitForClean {
-				cleaned++
-				mgr.ExecProcesses.Remove(id)
-			} else if execConfig.Exited && execConfig.Used {
+// imageGC removes the images not used by containers periodically by the
+// image gc policy of daemon config.
+func (mgr *ContainerManager) imageGC() {
+	filter := filters.NewArgs()
+	if mgr.Config.ImageGCUnused {
+		filter.Add("unused", "true")
+	}
+	if mgr.Config.ImageGCMinAge > 0 {
+		filter.Add("until", (time.Duration(mgr.Config.ImageGCMinAge) * time.Second).String())
+	}
+
+	for range time.Tick(time.Duration(mgr.Config.ImageGCInterval) * time.Second) {
+		ctx := context.Background()
+		resp, err := mgr.ImageMgr.PruneImages(ctx, filter, mgr.IsImageUsed)
+		if err != nil {
+			log.With(ctx).Warnf("failed to gc images: %v", err)
+			continue
+		}
+		if len(resp.ImagesDeleted) > 0 {
+			log.With(ctx).Infof("image gc removes %d images, reclaims %d bytes", len(resp.ImagesDeleted), resp.SpaceReclaimed)
+		}
+	}
+}
+
+// IsImageUsed returns whether the image is used by any container, including
+// the ones being created which have held the image.
+func (mgr *ContainerManager) IsImageUsed(ctx context.Context, imageID string) (bool, error) {
+	containers, err := mgr.List(ctx, &ContainerListOption{
+		All: true,
+		FilterFunc: func(c *Container) bool {
+			return c.Image == imageID
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(containers) > 0, nil
+}
+
+// cleanExecProcesses removes the config of finished exec processes which
+// are not needed any more, and returns the ids of removed ones. Only exec
+// processes of the container are checked if containerID is not empty, and
+// all the finished ones are removed if force is true.
+func (mgr *ContainerManager) cleanExecProcesses(containerID string, force bool) []string {
+	var (
+		cleaned   []string
+		retention = time.Duration(mgr.Config.ExecRetentionTime) * time.Second
+		retained  = map[string][]*ContainerExecConfig{}
+	)
+
+	for id, v := range mgr.ExecProcesses.Values(nil) {
+		execConfig, ok := v.(*ContainerExecConfig)
+		if !ok {
+			log.With(nil).Warnf("get incorrect exec config: %v", v)
+			continue
+		}
+		if containerID != "" && execConfig.ContainerID != containerID {
+			continue
+		}
+
+		// if unused exec processes are found, we will tag them, and clean
+		// them in next loop, so that we can ensure exec process can get
+		// correct exit code.
+		execConfig.Lock()
+		switch {
+		case !execConfig.Exited:
+		case force, execConfig.WaitForClean,
+			retention > 0 && time.Since(execConfig.ExitTime) > retention:
+			cleaned = append(cleaned, id)
+			mgr.ExecProcesses.Remove(id)
+		default:
+			if execConfig.Used {
 				execConfig.WaitForClean = true
 			}
-			execConfig.Unlock()
+			retained[execConfig.ContainerID] = append(retained[execConfig.ContainerID], execConfig)
 		}
+		execConfig.Unlock()
+	}
 
-		if cleaned > 0 {
-			log.With(nil).Debugf("clean %d unused exec process", cleaned)
+	// only keep the latest finished exec processes of each container.
+	if max := mgr.Config.MaxExecsPerContainer; max > 0 {
+		for _, execConfigs := range retained {
+			if len(execConfigs) <= max {
+				continue
+			}
+			sort.Slice(execConfigs, func(i, j int) bool {
+				return execConfigs[i].ExitTime.After(execConfigs[j].ExitTime)
+			})
+			for _, execConfig := range execConfigs[max:] {
+				cleaned = append(cleaned, execConfig.ExecID)
+				mgr.ExecProcesses.Remove(execConfig.ExecID)
+			}
 		}
 	}
+
+	return cleaned
 }
 
 // NewSnapshotsSyncer creates a snapshot syncer.
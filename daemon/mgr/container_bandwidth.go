@@ -0,0 +1,195 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// bandwidthBurstDivisor makes the default burst a tenth of the rate.
+	bandwidthBurstDivisor = 10
+
+	// minBandwidthBurst is the minimal default burst, which holds several
+	// packets of the max MTU at least.
+	minBandwidthBurst = 64 * 1024
+
+	// tbfLatency is the max time a packet waits for tokens in the token
+	// bucket filter, the packets waiting longer are dropped.
+	tbfLatency = "50ms"
+)
+
+// validateNetworkBandwidth validates the bandwidth limits of container
+// network, the limits only work on the network namespace set up by pouchd.
+func validateNetworkBandwidth(b *types.NetworkBandwidth, networkMode string) error {
+	if b == nil {
+		return nil
+	}
+
+	if b.EgressRate < 0 || b.EgressBurst < 0 || b.IngressRate < 0 || b.IngressBurst < 0 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "network bandwidth limits cannot be negative")
+	}
+	if b.EgressRate == 0 && b.EgressBurst > 0 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "egress burst should be used with egress rate")
+	}
+	if b.IngressRate == 0 && b.IngressBurst > 0 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "ingress burst should be used with ingress rate")
+	}
+
+	if (b.EgressRate > 0 || b.IngressRate > 0) &&
+		(IsHost(networkMode) || IsContainer(networkMode) || IsNetNS(networkMode) || IsCNI(networkMode)) {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "network bandwidth limits are not supported in network mode %s", networkMode)
+	}
+	return nil
+}
+
+// bandwidthBurst returns the burst of the rate, the default burst is used if
+// it's not specified.
+func bandwidthBurst(rate, burst int64) int64 {
+	if burst > 0 {
+		return burst
+	}
+	if burst = rate / bandwidthBurstDivisor; burst < minBandwidthBurst {
+		burst = minBandwidthBurst
+	}
+	return burst
+}
+
+// tcBandwidthCommands returns the tc commands to apply the bandwidth limits
+// on the interface. The failures of reset commands are ignored, since they
+// remove the limits which may not exist.
+func tcBandwidthCommands(iface string, b *types.NetworkBandwidth) (resets [][]string, sets [][]string) {
+	if b == nil {
+		b = &types.NetworkBandwidth{}
+	}
+
+	// the root qdisc is replaced if the egress is still limited.
+	if b.EgressRate == 0 {
+		resets = append(resets, []string{"qdisc", "del", "dev", iface, "root"})
+	}
+	// the policing filter is removed with the ingress qdisc.
+	resets = append(resets, []string{"qdisc", "del", "dev", iface, "ingress"})
+
+	if b.EgressRate > 0 {
+		sets = append(sets, []string{
+			"qdisc", "replace", "dev", iface, "root", "tbf",
+			"rate", strconv.FormatInt(b.EgressRate, 10) + "bps",
+			"burst", strconv.FormatInt(bandwidthBurst(b.EgressRate, b.EgressBurst), 10),
+			"latency", tbfLatency,
+		})
+	}
+	if b.IngressRate > 0 {
+		sets = append(sets,
+			[]string{"qdisc", "add", "dev", iface, "handle", "ffff:", "ingress"},
+			[]string{
+				"filter", "add", "dev", iface, "parent", "ffff:", "protocol", "all", "prio", "1",
+				"u32", "match", "u32", "0", "0",
+				"police", "rate", strconv.FormatInt(b.IngressRate, 10) + "bps",
+				"burst", strconv.FormatInt(bandwidthBurst(b.IngressRate, b.IngressBurst), 10),
+				"drop", "flowid", ":1",
+			},
+		)
+	}
+	return resets, sets
+}
+
+// runTC runs tc in the network namespace.
+func runTC(netns string, args ...string) error {
+	output, err := exec.Command("nsenter", append([]string{"--net=" + netns, "tc"}, args...)...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "failed to run tc %s: %s", strings.Join(args, " "), output)
+	}
+	return nil
+}
+
+// applyNetworkBandwidth applies the bandwidth limits on all the network
+// interfaces of running container, the existing limits are removed first if
+// reset is true. The caller should hold the lock of container.
+func (mgr *ContainerManager) applyNetworkBandwidth(c *Container, reset bool) error {
+	b := c.HostConfig.NetworkBandwidth
+	if !reset && (b == nil || (b.EgressRate == 0 && b.IngressRate == 0)) {
+		return nil
+	}
+	if mgr.NetworkMgr == nil || c.NetworkSettings == nil || c.NetworkSettings.SandboxID == "" {
+		return nil
+	}
+
+	sb, err := mgr.NetworkMgr.Controller().SandboxByID(c.NetworkSettings.SandboxID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sandbox of container %s", c.ID)
+	}
+	stats, err := sb.Statistics()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get network interfaces of container %s", c.ID)
+	}
+
+	ifaces := make([]string, 0, len(stats))
+	for iface := range stats {
+		ifaces = append(ifaces, iface)
+	}
+	sort.Strings(ifaces)
+
+	for _, iface := range ifaces {
+		resets, sets := tcBandwidthCommands(iface, b)
+		for _, args := range resets {
+			runTC(sb.Key(), args...)
+		}
+		for _, args := range sets {
+			if err := runTC(sb.Key(), args...); err != nil {
+				return errors.Wrapf(err, "failed to limit bandwidth of interface %s", iface)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateNetwork updates the bandwidth limits of container network, the limits
+// are applied on the running container at once.
+func (mgr *ContainerManager) UpdateNetwork(ctx context.Context, name string, bandwidth *types.NetworkBandwidth) error {
+	c, err := mgr.container(name)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.State.Dead {
+		return fmt.Errorf("cannot update network of a dead container %s", c.ID)
+	}
+	if err := validateNetworkBandwidth(bandwidth, c.HostConfig.NetworkMode); err != nil {
+		return err
+	}
+
+	old := c.HostConfig.NetworkBandwidth
+	c.HostConfig.NetworkBandwidth = bandwidth
+	if c.IsRunningOrPaused() {
+		if err := mgr.applyNetworkBandwidth(c, true); err != nil {
+			// restore the previous limits.
+			c.HostConfig.NetworkBandwidth = old
+			if err := mgr.applyNetworkBandwidth(c, true); err != nil {
+				log.With(ctx).Errorf("failed to restore network bandwidth limits: %v", err)
+			}
+			return err
+		}
+	}
+
+	if err := c.Write(mgr.Store); err != nil {
+		log.With(ctx).Errorf("failed to update meta of container %s: %v", c.ID, err)
+		return err
+	}
+
+	mgr.LogContainerEvent(ctx, c, "update")
+	return nil
+}
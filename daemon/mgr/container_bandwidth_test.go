@@ -0,0 +1,60 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNetworkBandwidth(t *testing.T) {
+	for _, tc := range []struct {
+		bandwidth *types.NetworkBandwidth
+		mode      string
+		wantErr   bool
+	}{
+		{nil, "host", false},
+		{&types.NetworkBandwidth{EgressRate: 1024, IngressRate: 2048}, "bridge", false},
+		{&types.NetworkBandwidth{EgressRate: 1024, EgressBurst: 512}, "mynet", false},
+		{&types.NetworkBandwidth{}, "host", false},
+		{&types.NetworkBandwidth{EgressRate: -1}, "bridge", true},
+		{&types.NetworkBandwidth{IngressBurst: 512}, "bridge", true},
+		{&types.NetworkBandwidth{EgressRate: 1024}, "host", true},
+		{&types.NetworkBandwidth{IngressRate: 1024}, "container:abc", true},
+		{&types.NetworkBandwidth{IngressRate: 1024}, "cni", true},
+	} {
+		err := validateNetworkBandwidth(tc.bandwidth, tc.mode)
+		assert.Equal(t, tc.wantErr, err != nil, "%+v %s: %v", tc.bandwidth, tc.mode, err)
+		if err != nil {
+			assert.True(t, errtypes.IsInvalidParam(err))
+		}
+	}
+}
+
+func TestBandwidthBurst(t *testing.T) {
+	assert.Equal(t, int64(4096), bandwidthBurst(1024, 4096))
+	assert.Equal(t, int64(minBandwidthBurst), bandwidthBurst(1024, 0))
+	assert.Equal(t, int64(1048576), bandwidthBurst(10485760, 0))
+}
+
+func TestTCBandwidthCommands(t *testing.T) {
+	resets, sets := tcBandwidthCommands("eth0", nil)
+	assert.Equal(t, [][]string{
+		{"qdisc", "del", "dev", "eth0", "root"},
+		{"qdisc", "del", "dev", "eth0", "ingress"},
+	}, resets)
+	assert.Empty(t, sets)
+
+	resets, sets = tcBandwidthCommands("eth1", &types.NetworkBandwidth{EgressRate: 1048576, IngressRate: 2097152, IngressBurst: 131072})
+	assert.Equal(t, [][]string{
+		{"qdisc", "del", "dev", "eth1", "ingress"},
+	}, resets)
+	assert.Equal(t, [][]string{
+		{"qdisc", "replace", "dev", "eth1", "root", "tbf", "rate", "1048576bps", "burst", "104857", "latency", "50ms"},
+		{"qdisc", "add", "dev", "eth1", "handle", "ffff:", "ingress"},
+		{"filter", "add", "dev", "eth1", "parent", "ffff:", "protocol", "all", "prio", "1", "u32", "match", "u32", "0", "0",
+			"police", "rate", "2097152bps", "burst", "131072", "drop", "flowid", ":1"},
+	}, sets)
+}
@@ -0,0 +1,85 @@
+package mgr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/oci"
+
+	"github.com/docker/docker/daemon/caps"
+)
+
+// capabilityAll represents all the capabilities in cap-add and cap-drop.
+const capabilityAll = "ALL"
+
+// normalizeCapabilities validates the capabilities against the ones supported
+// by runtime, and returns them in upper case without prefix CAP_, which is the
+// format of cap-add and cap-drop.
+func normalizeCapabilities(capList []string) ([]string, error) {
+	var normalized []string
+	for _, c := range capList {
+		c = strings.TrimPrefix(strings.ToUpper(c), "CAP_")
+		if c != capabilityAll && caps.GetCapability("CAP_"+c) == nil {
+			return nil, fmt.Errorf("unknown capability %q", c)
+		}
+		normalized = append(normalized, c)
+	}
+	return normalized, nil
+}
+
+// defaultCapabilities returns the default capabilities of containers with
+// prefix CAP_, which can be overridden in the config of daemon.
+func (mgr *ContainerManager) defaultCapabilities() ([]string, error) {
+	if mgr.Config == nil || len(mgr.Config.DefaultCapabilities) == 0 {
+		return oci.DefaultCapabilities(), nil
+	}
+
+	normalized, err := normalizeCapabilities(mgr.Config.DefaultCapabilities)
+	if err != nil {
+		return nil, err
+	}
+	var capList []string
+	for _, c := range normalized {
+		if c == capabilityAll {
+			return caps.GetAllCapabilities(), nil
+		}
+		capList = append(capList, "CAP_"+c)
+	}
+	return capList, nil
+}
+
+// resolveCapabilities validates the capabilities to add and drop of container,
+// and resolves the capability set of container when it is created, so that the
+// set is kept after the default capabilities of daemon change.
+func (mgr *ContainerManager) resolveCapabilities(c *Container) error {
+	hostConfig := c.HostConfig
+	adds, err := normalizeCapabilities(hostConfig.CapAdd)
+	if err != nil {
+		return fmt.Errorf("invalid cap-add: %v", err)
+	}
+	drops, err := normalizeCapabilities(hostConfig.CapDrop)
+	if err != nil {
+		return fmt.Errorf("invalid cap-drop: %v", err)
+	}
+	hostConfig.CapAdd, hostConfig.CapDrop = adds, drops
+
+	if hostConfig.Privileged {
+		c.Capabilities = caps.GetAllCapabilities()
+		return nil
+	}
+
+	basics, err := mgr.defaultCapabilities()
+	if err != nil {
+		return err
+	}
+	capList, err := caps.TweakCapabilities(basics, adds, drops)
+	if err != nil {
+		return err
+	}
+	// an empty set means all the capabilities are dropped.
+	if capList == nil {
+		capList = []string{}
+	}
+	c.Capabilities = capList
+	return nil
+}
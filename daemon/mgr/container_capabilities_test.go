@@ -0,0 +1,55 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/oci"
+
+	"github.com/docker/docker/daemon/caps"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCapabilities(t *testing.T) {
+	capList, err := normalizeCapabilities([]string{"net_admin", "CAP_SYS_ADMIN", "all"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"NET_ADMIN", "SYS_ADMIN", "ALL"}, capList)
+
+	_, err = normalizeCapabilities([]string{"NET_ADMIN", "FOO"})
+	assert.Error(t, err)
+}
+
+func TestResolveCapabilities(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{}}
+
+	c := &Container{HostConfig: &types.HostConfig{}}
+	assert.NoError(t, mgr.resolveCapabilities(c))
+	assert.Equal(t, oci.DefaultCapabilities(), c.Capabilities)
+
+	c = &Container{HostConfig: &types.HostConfig{CapAdd: []string{"cap_net_admin"}, CapDrop: []string{"ALL"}}}
+	assert.NoError(t, mgr.resolveCapabilities(c))
+	assert.Equal(t, []string{"NET_ADMIN"}, c.HostConfig.CapAdd)
+	assert.Equal(t, []string{"CAP_NET_ADMIN"}, c.Capabilities)
+
+	c = &Container{HostConfig: &types.HostConfig{CapDrop: []string{"all"}}}
+	assert.NoError(t, mgr.resolveCapabilities(c))
+	assert.Equal(t, []string{}, c.Capabilities)
+
+	c = &Container{HostConfig: &types.HostConfig{Privileged: true, CapDrop: []string{"KILL"}}}
+	assert.NoError(t, mgr.resolveCapabilities(c))
+	assert.Equal(t, caps.GetAllCapabilities(), c.Capabilities)
+
+	c = &Container{HostConfig: &types.HostConfig{CapAdd: []string{"FOO"}}}
+	assert.Error(t, mgr.resolveCapabilities(c))
+
+	// the default capabilities of daemon are overridden.
+	mgr.Config.DefaultCapabilities = []string{"chown", "CAP_KILL"}
+	c = &Container{HostConfig: &types.HostConfig{CapDrop: []string{"KILL"}}}
+	assert.NoError(t, mgr.resolveCapabilities(c))
+	assert.Equal(t, []string{"CAP_CHOWN"}, c.Capabilities)
+
+	mgr.Config.DefaultCapabilities = []string{"FOO"}
+	_, err := mgr.defaultCapabilities()
+	assert.Error(t, err)
+}
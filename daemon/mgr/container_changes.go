@@ -0,0 +1,36 @@
+package mgr
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+// Changes returns the changes of the container filesystem against its image.
+func (mgr *ContainerManager) Changes(ctx context.Context, name string) ([]*types.ContainerChangeResponseItem, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	c.Lock()
+	var (
+		snapshotter    = c.Config.Snapshotter
+		snapshotKey    = c.SnapshotKey()
+		rootfsProvided = c.RootFSProvided
+	)
+	c.Unlock()
+
+	if rootfsProvided {
+		return nil, errors.Wrapf(errtypes.ErrNotImplemented, "changes of container %s whose rootfs is provided", c.ID)
+	}
+
+	return mgr.Client.SnapshotChanges(ctrd.WithSnapshotter(ctx, snapshotter), snapshotKey)
+}
@@ -4,17 +4,35 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/archive"
+	"github.com/pkg/errors"
 )
 
 var (
 	checkpointConfigPath             = "config.json"
 	checkpointConfigPerm os.FileMode = 0700
+
+	// checkpointRootfsDiffPath is the changes of container's rootfs stored
+	// in the checkpoint, see CheckpointCreateOptions.RootfsDiff.
+	checkpointRootfsDiffPath = "rootfs-diff.tar"
+
+	// checkpointParentPath records the ID of parent checkpoint, so that the
+	// link to parent created by CRIU can be recreated after the checkpoint
+	// is imported, the link itself isn't exported since it points to the
+	// absolute path of parent on this host.
+	checkpointParentPath = "parent-checkpoint"
+	criuParentLink       = "parent"
 )
 
 // getCheckpointDir gets container checkpoint directory.
@@ -70,6 +88,30 @@ func (mgr *ContainerManager) CreateCheckpoint(ctx context.Context, name string,
 		return fmt.Errorf("checkpoint not support on containers with tty")
 	}
 
+	if options.PreDump && (options.LazyPages || options.RootfsDiff) {
+		return errors.Wrap(errtypes.ErrInvalidParam, "pre-dump can't be used with lazy pages or rootfs diff")
+	}
+	// the rootfs is only consistent with the checkpoint if the container
+	// doesn't run any more.
+	if options.RootfsDiff && !options.Exit {
+		return errors.Wrap(errtypes.ErrInvalidParam, "rootfs diff can only be stored if the container exits after checkpoint")
+	}
+
+	// the parent is in the same directory. CRIU links the checkpoint to
+	// its parent by the given path, which should be absolute, since the
+	// checkpoint is unpacked into a temporary directory by containerd shim
+	// when restoring, where the relative link dangles.
+	var parentPath string
+	if options.ParentCheckpoint != "" {
+		parentDir, err := mgr.getCheckpointDir(c.ID, options.CheckpointDir, options.ParentCheckpoint, false)
+		if err != nil {
+			return err
+		}
+		if parentPath, err = filepath.Abs(parentDir); err != nil {
+			return err
+		}
+	}
+
 	dir, err := mgr.getCheckpointDir(c.ID, options.CheckpointDir, options.CheckpointID, true)
 	if err != nil {
 		return err
@@ -80,13 +122,105 @@ func (mgr *ContainerManager) CreateCheckpoint(ctx context.Context, name string,
 		}
 	}()
 
-	if err := mgr.Client.CreateCheckpoint(ctx, c.ID, dir, options.Exit); err != nil {
+	switch {
+	case options.LazyPages:
+		err = mgr.createLazyCheckpoint(ctx, c, dir, parentPath, options)
+	case options.PreDump || parentPath != "":
+		err = mgr.createRuncCheckpoint(ctx, c, dir, parentPath, options)
+	default:
+		err = mgr.Client.CreateCheckpoint(ctx, c.ID, dir, &ctrd.CheckpointOptions{
+			Exit:           options.Exit,
+			TCPEstablished: options.TCPEstablished,
+		})
+	}
+	if err != nil {
 		return err
 	}
 
+	if options.RootfsDiff {
+		if err := mgr.exportRootfsDiff(ctx, c, filepath.Join(dir, checkpointRootfsDiffPath)); err != nil {
+			return err
+		}
+	}
+
+	if parentPath != "" {
+		if err := ioutil.WriteFile(filepath.Join(dir, checkpointParentPath), []byte(filepath.Base(parentPath)), checkpointConfigPerm); err != nil {
+			return err
+		}
+	}
+
 	return writeCheckpointConfig(filepath.Join(dir, checkpointConfigPath), c.ID, options.CheckpointID)
 }
 
+// exportRootfsDiff writes the changes of container's rootfs into path.
+func (mgr *ContainerManager) exportRootfsDiff(ctx context.Context, c *Container, path string) (err0 error) {
+	if c.RootFSProvided {
+		return errors.Wrapf(errtypes.ErrNotImplemented, "rootfs diff of container %s whose rootfs is provided", c.ID)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, checkpointConfigPerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && err0 == nil {
+			err0 = err
+		}
+	}()
+
+	if err := mgr.Client.ExportSnapshotDiff(ctrd.WithSnapshotter(ctx, c.Config.Snapshotter), c.SnapshotKey(), f); err != nil {
+		return errors.Wrapf(err, "failed to export rootfs diff of container %s", c.ID)
+	}
+	return nil
+}
+
+// importRootfsDiff applies the changes of rootfs in path to the container,
+// and removes it since it is not needed by restore.
+func (mgr *ContainerManager) importRootfsDiff(ctx context.Context, c *Container, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	if c.IsRunningOrPaused() {
+		return errors.Wrapf(errtypes.ErrConflict, "can not apply rootfs diff to a %s container", c.State.Status)
+	}
+	if c.RootFSProvided {
+		return errors.Wrapf(errtypes.ErrNotImplemented, "rootfs diff of container %s whose rootfs is provided", c.ID)
+	}
+
+	if err := mgr.Client.ApplySnapshotDiff(ctrd.WithSnapshotter(ctx, c.Config.Snapshotter), c.SnapshotKey(), f); err != nil {
+		return errors.Wrapf(err, "failed to apply rootfs diff to container %s", c.ID)
+	}
+	return os.Remove(path)
+}
+
+// getRestoreOptions returns the options used to restore container's task
+// from a checkpoint, nil means the container will be started from scratch.
+func (mgr *ContainerManager) getRestoreOptions(container string, options *types.ContainerStartOptions) (*ctrd.RestoreOptions, error) {
+	checkpointDir := options.CheckpointDir
+	if options.CheckpointID != "" {
+		dir, err := mgr.getCheckpointDir(container, options.CheckpointDir, options.CheckpointID, false)
+		if err != nil {
+			return nil, err
+		}
+		checkpointDir = dir
+	}
+
+	if checkpointDir == "" {
+		return nil, nil
+	}
+
+	return &ctrd.RestoreOptions{
+		CheckpointDir:  checkpointDir,
+		TCPEstablished: options.TCPEstablished,
+	}, nil
+}
+
 // ListCheckpoint lists checkpoints from a container
 func (mgr *ContainerManager) ListCheckpoint(ctx context.Context, name string, options *types.CheckpointListOptions) ([]string, error) {
 	c, err := mgr.container(name)
@@ -133,6 +267,80 @@ func (mgr *ContainerManager) DeleteCheckpoint(ctx context.Context, name string,
 	return os.RemoveAll(dir)
 }
 
+// ExportCheckpoint exports a checkpoint of container as a tar stream, it
+// is used to transfer the checkpoint to another host.
+func (mgr *ContainerManager) ExportCheckpoint(ctx context.Context, name, checkpointID, checkpointDir string) (io.ReadCloser, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := mgr.getCheckpointDir(c.ID, checkpointDir, checkpointID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return archive.TarWithOptions(dir, &archive.TarOptions{
+		ExcludePatterns: []string{criuParentLink},
+	})
+}
+
+// ImportCheckpoint imports a checkpoint into container from a tar stream
+// generated by ExportCheckpoint.
+func (mgr *ContainerManager) ImportCheckpoint(ctx context.Context, name, checkpointID, checkpointDir string, content io.Reader) (err0 error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return err
+	}
+
+	dir, err := mgr.getCheckpointDir(c.ID, checkpointDir, checkpointID, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err0 != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	if err := archive.Untar(content, dir, &archive.TarOptions{NoLchown: true}); err != nil {
+		return errors.Wrapf(err, "failed to extract checkpoint %s", checkpointID)
+	}
+
+	if err := linkParentCheckpoint(dir); err != nil {
+		return err
+	}
+
+	if err := mgr.importRootfsDiff(ctx, c, filepath.Join(dir, checkpointRootfsDiffPath)); err != nil {
+		return err
+	}
+
+	// the checkpoint belongs to the container which it is imported into.
+	return writeCheckpointConfig(filepath.Join(dir, checkpointConfigPath), c.ID, checkpointID)
+}
+
+// linkParentCheckpoint links the imported checkpoint to its parent, which
+// should be imported into the same directory before.
+func linkParentCheckpoint(dir string) error {
+	raw, err := ioutil.ReadFile(filepath.Join(dir, checkpointParentPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	parent := filepath.Base(strings.TrimSpace(string(raw)))
+	parentDir, err := filepath.Abs(filepath.Join(filepath.Dir(dir), parent))
+	if err != nil {
+		return err
+	}
+	if stat, err := os.Stat(parentDir); err != nil || !stat.IsDir() {
+		return errors.Wrapf(errtypes.ErrPreCheckFailed, "parent checkpoint %s should be imported first", parent)
+	}
+	return os.Symlink(parentDir, filepath.Join(dir, criuParentLink))
+}
+
 func writeCheckpointConfig(path, container, checkpoint string) error {
 	config := &types.Checkpoint{
 		ContainerID:    container,
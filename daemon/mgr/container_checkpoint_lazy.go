@@ -0,0 +1,323 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/runtime/linux/runctypes"
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// criuConfigAnnotation is the annotation used by runc to find the
+	// CRIU configuration file of container.
+	criuConfigAnnotation = "org.criu.config"
+
+	// lazyPagesSocket is the socket created by CRIU lazy-pages daemon
+	// in the work directory.
+	lazyPagesSocket = "lazy-pages.socket"
+
+	// lazyPagesReadyTimeout is the time to wait for page server or
+	// lazy-pages daemon to be ready.
+	lazyPagesReadyTimeout = 30 * time.Second
+)
+
+// runcCheckpointOptions are the options of runc checkpoint, which is invoked
+// directly for the CRIU features not exposed by containerd shim.
+type runcCheckpointOptions struct {
+	// root, criu and systemdCgroup are the global options of runc, they
+	// are the same as the ones passed by containerd shim.
+	root          string
+	criu          string
+	systemdCgroup bool
+
+	imagePath      string
+	workPath       string
+	parentPath     string
+	preDump        bool
+	leaveRunning   bool
+	tcpEstablished bool
+	lazyPages      bool
+	pageServer     string
+
+	// statusFd is the fd which CRIU notifies once the page server is
+	// ready, 0 means it is not used.
+	statusFd int
+}
+
+// args returns the arguments of runc to checkpoint the container id.
+func (o *runcCheckpointOptions) args(id string) []string {
+	args := []string{"--root", o.root}
+	if o.criu != "" {
+		args = append(args, "--criu", o.criu)
+	}
+	if o.systemdCgroup {
+		args = append(args, "--systemd-cgroup")
+	}
+
+	args = append(args, "checkpoint", "--image-path", o.imagePath, "--work-path", o.workPath)
+	if o.parentPath != "" {
+		args = append(args, "--parent-path", o.parentPath)
+	}
+	// the container always keeps running after pre-dump.
+	if o.preDump {
+		args = append(args, "--pre-dump")
+	} else if o.leaveRunning {
+		args = append(args, "--leave-running")
+	}
+	if o.tcpEstablished {
+		args = append(args, "--tcp-established")
+	}
+	if o.lazyPages {
+		args = append(args, "--lazy-pages", "--page-server", o.pageServer)
+	}
+	if o.statusFd > 0 {
+		args = append(args, "--status-fd", strconv.Itoa(o.statusFd))
+	}
+	return append(args, id)
+}
+
+// createLazyCheckpoint dumps the container with CRIU lazy-pages. containerd
+// packs the checkpoint images only after dump finished, but a lazy dump will
+// not finish until all memory pages have been pulled by the restore side, and
+// the shim has no option for lazy-pages either, so runc is invoked directly
+// here with the same global options as the shim. It returns once the page
+// server is ready, the dump process keeps serving the memory pages in the
+// background. The task exits after all the pages are transferred, unless
+// the checkpoint is created with leave-running.
+func (mgr *ContainerManager) createLazyCheckpoint(ctx context.Context, c *Container, dir, parentPath string, options *types.CheckpointCreateOptions) error {
+	if _, _, err := net.SplitHostPort(options.PageServer); err != nil {
+		return errors.Wrapf(err, "invalid page server address %s", options.PageServer)
+	}
+
+	runtimePath, runtimeRoot, criuPath, err := mgr.runtimeBinaries(c)
+	if err != nil {
+		return err
+	}
+
+	statusR, statusW, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer statusR.Close()
+
+	opts := &runcCheckpointOptions{
+		root:           runtimeRoot,
+		criu:           criuPath,
+		systemdCgroup:  mgr.Config.UseSystemd(),
+		imagePath:      dir,
+		workPath:       dir,
+		parentPath:     parentPath,
+		leaveRunning:   !options.Exit,
+		tcpEstablished: options.TCPEstablished,
+		lazyPages:      true,
+		pageServer:     options.PageServer,
+		// the first one of ExtraFiles is fd 3 in the child process.
+		statusFd: 3,
+	}
+
+	cmd := exec.Command(runtimePath, opts.args(c.ID)...)
+	cmd.ExtraFiles = []*os.File{statusW}
+	if err := cmd.Start(); err != nil {
+		statusW.Close()
+		return errors.Wrapf(err, "failed to start lazy checkpoint of container %s", c.ID)
+	}
+	statusW.Close()
+
+	exitCh := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		if err != nil {
+			log.With(ctx).Errorf("lazy checkpoint of container %s exited: %v", c.ID, err)
+		} else {
+			log.With(ctx).Infof("lazy checkpoint of container %s finished", c.ID)
+		}
+		exitCh <- err
+	}()
+
+	// CRIU writes '\0' into status fd once the page server is ready.
+	readyCh := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 1)
+		_, err := statusR.Read(buf)
+		readyCh <- err
+	}()
+
+	select {
+	case err := <-readyCh:
+		if err == nil {
+			return nil
+		}
+		if exitErr := <-exitCh; exitErr != nil {
+			err = exitErr
+		}
+		return errors.Wrapf(err, "failed to checkpoint container %s lazily", c.ID)
+	case err := <-exitCh:
+		return errors.Wrapf(err, "failed to checkpoint container %s lazily", c.ID)
+	case <-time.After(lazyPagesReadyTimeout):
+		cmd.Process.Kill()
+		return fmt.Errorf("timeout to wait for page server of container %s", c.ID)
+	}
+}
+
+// createRuncCheckpoint dumps the container by invoking runc directly, for the
+// pre-dump and the dump on top of a parent checkpoint, which containerd shim
+// doesn't expose either. parentPath is the absolute path of parent checkpoint.
+func (mgr *ContainerManager) createRuncCheckpoint(ctx context.Context, c *Container, dir, parentPath string, options *types.CheckpointCreateOptions) error {
+	runtimePath, runtimeRoot, criuPath, err := mgr.runtimeBinaries(c)
+	if err != nil {
+		return err
+	}
+
+	opts := &runcCheckpointOptions{
+		root:           runtimeRoot,
+		criu:           criuPath,
+		systemdCgroup:  mgr.Config.UseSystemd(),
+		imagePath:      dir,
+		workPath:       dir,
+		parentPath:     parentPath,
+		preDump:        options.PreDump,
+		leaveRunning:   !options.Exit,
+		tcpEstablished: options.TCPEstablished,
+	}
+
+	output, err := exec.Command(runtimePath, opts.args(c.ID)...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "failed to checkpoint container %s: %s", c.ID, strings.TrimSpace(string(output)))
+	}
+	log.With(ctx).Infof("checkpoint %s of container %s is created by runc", filepath.Base(dir), c.ID)
+	return nil
+}
+
+// prepareLazyRestore starts a CRIU lazy-pages daemon which pulls the memory
+// pages from the page server, and configures CRIU of the new task to restore
+// the pages lazily through the container's CRIU configuration file. The
+// daemon exits by itself after all the pages are pulled, the returned stop
+// should be called to kill it and clean up if the task fails to restore.
+func (mgr *ContainerManager) prepareLazyRestore(ctx context.Context, c *Container, restore *ctrd.RestoreOptions, pageServer string, s *specs.Spec) (stop func(), err0 error) {
+	host, port, err := net.SplitHostPort(pageServer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid page server address %s", pageServer)
+	}
+
+	_, _, criuPath, err := mgr.runtimeBinaries(c)
+	if err != nil {
+		return nil, err
+	}
+
+	workDir := filepath.Join(mgr.Store.Path(c.ID), "criu-lazy")
+	if err := os.RemoveAll(workDir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(workDir, 0700); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err0 != nil {
+			os.RemoveAll(workDir)
+		}
+	}()
+
+	// NOTE: options in CRIU configuration file take precedence over the
+	// options passed by runc, so the restore will connect to the lazy-pages
+	// daemon through the socket in the same work directory.
+	configPath := filepath.Join(workDir, "criu.conf")
+	config := strings.Join([]string{
+		"lazy-pages",
+		"work-dir " + workDir,
+	}, "\n") + "\n"
+	if err := ioutil.WriteFile(configPath, []byte(config), 0600); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(criuPath, "lazy-pages",
+		"--page-server",
+		"--address", host,
+		"--port", port,
+		"--images-dir", restore.CheckpointDir,
+		"--work-dir", workDir,
+	)
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "failed to start lazy-pages daemon for container %s", c.ID)
+	}
+
+	exitCh := make(chan struct{})
+	go func() {
+		defer close(exitCh)
+		if err := cmd.Wait(); err != nil {
+			log.With(ctx).Errorf("lazy-pages daemon of container %s exited: %v", c.ID, err)
+		}
+	}()
+
+	stop = func() {
+		cmd.Process.Kill()
+		<-exitCh
+		if err := os.RemoveAll(workDir); err != nil {
+			log.With(ctx).Warnf("failed to remove lazy-pages work directory of container %s: %v", c.ID, err)
+		}
+		delete(s.Annotations, criuConfigAnnotation)
+	}
+
+	// wait for lazy-pages daemon to create the socket.
+	socket := filepath.Join(workDir, lazyPagesSocket)
+	for start := time.Now(); time.Since(start) < lazyPagesReadyTimeout; time.Sleep(100 * time.Millisecond) {
+		select {
+		case <-exitCh:
+			return nil, fmt.Errorf("lazy-pages daemon of container %s exited before ready", c.ID)
+		default:
+		}
+
+		if _, err := os.Stat(socket); err == nil {
+			if s.Annotations == nil {
+				s.Annotations = make(map[string]string)
+			}
+			s.Annotations[criuConfigAnnotation] = configPath
+			return stop, nil
+		}
+	}
+
+	stop()
+	return nil, fmt.Errorf("timeout to wait for lazy-pages daemon of container %s", c.ID)
+}
+
+// runtimeBinaries returns the runtime binary, runtime state root and CRIU
+// binary used by the container.
+func (mgr *ContainerManager) runtimeBinaries(c *Container) (runtimePath, runtimeRoot, criuPath string, err error) {
+	r, exist := mgr.Config.Runtimes[c.HostConfig.Runtime]
+	if !exist {
+		return "", "", "", fmt.Errorf("failed to find runtime %s in daemon config", c.HostConfig.Runtime)
+	}
+
+	runtimePath = r.Path
+	if runtimePath == "" {
+		runtimePath = c.HostConfig.Runtime
+	}
+
+	switch o := r.Options.(type) {
+	case *runctypes.RuncOptions:
+		criuPath = o.CriuPath
+	case *runcoptions.Options:
+		criuPath = o.CriuPath
+	}
+	if criuPath == "" {
+		criuPath = "criu"
+	}
+
+	// shim runs the runtime with the state root under namespace.
+	runtimeRoot = filepath.Join(ctrd.RuntimeRoot, mgr.Config.DefaultNamespace)
+	return runtimePath, runtimeRoot, criuPath, nil
+}
@@ -0,0 +1,137 @@
+package mgr
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/meta"
+
+	"github.com/containerd/containerd/runtime/linux/runctypes"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuncCheckpointOptionsArgs(t *testing.T) {
+	for _, tc := range []struct {
+		opts *runcCheckpointOptions
+		args string
+	}{
+		{
+			opts: &runcCheckpointOptions{root: "/run/runc/default", imagePath: "/cp", workPath: "/cp"},
+			args: "--root /run/runc/default checkpoint --image-path /cp --work-path /cp c1",
+		},
+		{
+			opts: &runcCheckpointOptions{
+				root:           "/run/runc/default",
+				criu:           "/usr/local/sbin/criu",
+				systemdCgroup:  true,
+				imagePath:      "/cp",
+				workPath:       "/cp",
+				leaveRunning:   true,
+				tcpEstablished: true,
+				lazyPages:      true,
+				pageServer:     "192.168.0.1:27000",
+				statusFd:       3,
+			},
+			args: "--root /run/runc/default --criu /usr/local/sbin/criu --systemd-cgroup checkpoint --image-path /cp --work-path /cp " +
+				"--leave-running --tcp-established --lazy-pages --page-server 192.168.0.1:27000 --status-fd 3 c1",
+		},
+		{
+			// pre-dump keeps the container running by itself.
+			opts: &runcCheckpointOptions{
+				root:         "/run/runc/default",
+				imagePath:    "/cp/pre1",
+				workPath:     "/cp/pre1",
+				parentPath:   "/cp/pre0",
+				preDump:      true,
+				leaveRunning: true,
+			},
+			args: "--root /run/runc/default checkpoint --image-path /cp/pre1 --work-path /cp/pre1 --parent-path /cp/pre0 --pre-dump c1",
+		},
+	} {
+		assert.Equal(t, tc.args, strings.Join(tc.opts.args("c1"), " "))
+	}
+}
+
+// fakeCriu acts as the lazy-pages daemon, it records its pid and creates the
+// socket in the work directory.
+const fakeCriu = `#!/bin/sh
+while [ $# -gt 0 ]; do
+	if [ "$1" = "--work-dir" ]; then
+		workdir=$2
+	fi
+	shift
+done
+echo $$ > "$(dirname "$workdir")/criu.pid"
+touch "$workdir/lazy-pages.socket"
+exec sleep 60
+`
+
+func TestPrepareLazyRestoreStop(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "lazy-restore")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	criuPath := filepath.Join(tmpDir, "criu")
+	assert.NoError(t, ioutil.WriteFile(criuPath, []byte(fakeCriu), 0700))
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: tmpDir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Container{}),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	mgr := &ContainerManager{
+		Store: store,
+		Config: &config.Config{
+			Runtimes: map[string]types.Runtime{
+				"runc": {Options: &runctypes.RuncOptions{CriuPath: criuPath}},
+			},
+		},
+	}
+
+	c := &Container{ID: "lazy", HostConfig: &types.HostConfig{Runtime: "runc"}}
+	assert.NoError(t, os.MkdirAll(store.Path(c.ID), 0755))
+
+	s := &specs.Spec{}
+	stop, err := mgr.prepareLazyRestore(context.Background(), c, &ctrd.RestoreOptions{CheckpointDir: "/cp"}, "192.168.0.1:27000", s)
+	assert.NoError(t, err)
+
+	workDir := filepath.Join(store.Path(c.ID), "criu-lazy")
+	assert.Equal(t, filepath.Join(workDir, "criu.conf"), s.Annotations[criuConfigAnnotation])
+
+	raw, err := ioutil.ReadFile(filepath.Join(store.Path(c.ID), "criu.pid"))
+	assert.NoError(t, err)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+	assert.NoError(t, err)
+	assert.NoError(t, syscall.Kill(pid, 0))
+
+	// the daemon is killed and reaped, and the work directory is removed.
+	stop()
+	assert.Equal(t, syscall.ESRCH, syscall.Kill(pid, 0))
+	_, err = os.Stat(workDir)
+	assert.True(t, os.IsNotExist(err))
+	assert.Empty(t, s.Annotations[criuConfigAnnotation])
+
+	// the work directory is removed if the daemon fails to start.
+	mgr.Config.Runtimes["runc"] = types.Runtime{Options: &runctypes.RuncOptions{CriuPath: filepath.Join(tmpDir, "missing")}}
+	_, err = mgr.prepareLazyRestore(context.Background(), c, &ctrd.RestoreOptions{CheckpointDir: "/cp"}, "192.168.0.1:27000", s)
+	assert.Error(t, err)
+	_, err = os.Stat(workDir)
+	assert.True(t, os.IsNotExist(err))
+}
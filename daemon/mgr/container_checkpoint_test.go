@@ -1,6 +1,7 @@
 package mgr
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/alibaba/pouch/apis/types"
 
+	"github.com/containerd/containerd/archive"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -47,3 +49,72 @@ func TestRWCheckpointConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkParentCheckpoint(t *testing.T) {
+	assert := assert.New(t)
+	tmpDir, err := ioutil.TempDir("", "checkpoint-test")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	// checkpoint without parent is not linked.
+	cp0 := filepath.Join(tmpDir, "cp0")
+	assert.NoError(os.MkdirAll(cp0, 0755))
+	assert.NoError(linkParentCheckpoint(cp0))
+	_, err = os.Lstat(filepath.Join(cp0, criuParentLink))
+	assert.True(os.IsNotExist(err))
+
+	// the parent should be imported before.
+	cp1 := filepath.Join(tmpDir, "cp1")
+	assert.NoError(os.MkdirAll(cp1, 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(cp1, checkpointParentPath), []byte("pre0\n"), 0644))
+	assert.Error(linkParentCheckpoint(cp1))
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "pre0"), 0755))
+	assert.NoError(linkParentCheckpoint(cp1))
+	link, err := os.Readlink(filepath.Join(cp1, criuParentLink))
+	assert.NoError(err)
+	assert.Equal(filepath.Join(tmpDir, "pre0"), link)
+}
+
+// TestRestorePreDumpChain checks that the pages of pre-dump chain are found
+// from the checkpoint unpacked by containerd shim to restore.
+func TestRestorePreDumpChain(t *testing.T) {
+	assert := assert.New(t)
+	tmpDir, err := ioutil.TempDir("", "checkpoint-test")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	// pre0 <- pre1 <- cp
+	parent := ""
+	for _, id := range []string{"pre0", "pre1", "cp"} {
+		dir := filepath.Join(tmpDir, id)
+		assert.NoError(os.MkdirAll(dir, 0755))
+		assert.NoError(ioutil.WriteFile(filepath.Join(dir, "pages-1.img"), []byte(id), 0644))
+		if parent != "" {
+			assert.NoError(ioutil.WriteFile(filepath.Join(dir, checkpointParentPath), []byte(parent), 0644))
+			assert.NoError(linkParentCheckpoint(dir))
+		}
+		parent = id
+	}
+
+	// the same as createCheckpointDescriptor and the unpack of shim.
+	ctx := context.Background()
+	tar := archive.Diff(ctx, "", filepath.Join(tmpDir, "cp"))
+	defer tar.Close()
+
+	workDir, err := ioutil.TempDir("", "checkpoint-restore")
+	assert.NoError(err)
+	defer os.RemoveAll(workDir)
+	_, err = archive.Apply(ctx, workDir, tar)
+	assert.NoError(err)
+
+	for path, expected := range map[string]string{
+		"pages-1.img": "cp",
+		filepath.Join(criuParentLink, "pages-1.img"):                 "pre1",
+		filepath.Join(criuParentLink, criuParentLink, "pages-1.img"): "pre0",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(workDir, path))
+		assert.NoError(err, path)
+		assert.Equal(expected, string(data))
+	}
+}
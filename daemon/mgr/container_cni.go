@@ -0,0 +1,254 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	cnicurrent "github.com/containernetworking/cni/pkg/types/current"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/docker/go-connections/nat"
+	"github.com/docker/libnetwork/etchosts"
+	"github.com/docker/libnetwork/resolvconf"
+	networktypes "github.com/docker/libnetwork/types"
+	"github.com/pkg/errors"
+)
+
+// cniPodNetwork builds the CNI networks of container in the network
+// namespace netns, the port bindings of container are passed to the CNI
+// plugins which support the portMappings capability.
+func cniPodNetwork(c *Container, netns string, networks []string) *ocicni.PodNetwork {
+	portMappings := toCNIPortMappings(c.HostConfig.PortBindings)
+
+	runtimeConfig := make(map[string]ocicni.RuntimeConfig, len(networks))
+	for _, name := range networks {
+		runtimeConfig[name] = ocicni.RuntimeConfig{PortMappings: portMappings}
+	}
+
+	return &ocicni.PodNetwork{
+		Name:          c.Name,
+		ID:            c.ID,
+		NetNS:         netns,
+		Networks:      networks,
+		RuntimeConfig: runtimeConfig,
+	}
+}
+
+// toCNIPortMappings converts the port bindings into the port mappings of CNI.
+func toCNIPortMappings(bindings types.PortMap) []ocicni.PortMapping {
+	var portMappings []ocicni.PortMapping
+	for port, hostBindings := range bindings {
+		containerPort := nat.Port(port)
+		for _, b := range hostBindings {
+			hostPort, err := nat.ParsePort(b.HostPort)
+			if err != nil || hostPort == 0 {
+				continue
+			}
+			portMappings = append(portMappings, ocicni.PortMapping{
+				HostPort:      int32(hostPort),
+				ContainerPort: int32(containerPort.Int()),
+				Protocol:      containerPort.Proto(),
+				HostIP:        b.HostIP,
+			})
+		}
+	}
+	return portMappings
+}
+
+// cniEndpointSettings converts the result of CNI network into the endpoint
+// settings recorded in container.
+func cniEndpointSettings(name string, result cnitypes.Result) (*types.EndpointSettings, error) {
+	ep := &types.EndpointSettings{NetworkID: name}
+
+	r, err := cnicurrent.NewResultFromResult(result)
+	if err != nil {
+		return ep, err
+	}
+
+	for _, iface := range r.Interfaces {
+		// the interfaces not in sandbox are the ones on host, such as bridge.
+		if iface.Sandbox != "" {
+			ep.MacAddress = iface.Mac
+			break
+		}
+	}
+
+	for _, ipc := range r.IPs {
+		var gateway string
+		if ipc.Gateway != nil {
+			gateway = ipc.Gateway.String()
+		}
+		prefixLen, _ := ipc.Address.Mask.Size()
+
+		if ipc.Address.IP.To4() != nil {
+			if ep.IPAddress == "" {
+				ep.IPAddress = ipc.Address.IP.String()
+				ep.IPPrefixLen = int64(prefixLen)
+				ep.Gateway = gateway
+			}
+		} else if ep.GlobalIPV6Address == "" {
+			ep.GlobalIPV6Address = ipc.Address.IP.String()
+			ep.GlobalIPV6PrefixLen = int64(prefixLen)
+			ep.IPV6Gateway = gateway
+		}
+	}
+	return ep, nil
+}
+
+// setupCNINetwork creates the network namespace of container and adds it to
+// the CNI networks, the results of IPAM are recorded into the network
+// settings of container. The caller should hold the lock of container.
+func (mgr *ContainerManager) setupCNINetwork(ctx context.Context, c *Container) (err0 error) {
+	cniMgr, err := mgr.NetworkMgr.CNI()
+	if err != nil {
+		return err
+	}
+
+	networks := cniNetworks(c.HostConfig.NetworkMode)
+	if len(networks) == 0 {
+		name := cniMgr.GetDefaultNetworkName()
+		if name == "" {
+			return fmt.Errorf("no CNI network found in %s", mgr.Config.CriConfig.NetworkPluginConfDir)
+		}
+		networks = []string{name}
+	}
+
+	netns, err := cniMgr.NewNetNS()
+	if err != nil {
+		return errors.Wrap(err, "failed to create network namespace")
+	}
+	defer func() {
+		if err0 != nil {
+			if err := cniMgr.RemoveNetNS(netns); err != nil {
+				log.With(ctx).Errorf("failed to remove network namespace %s: %v", netns, err)
+			}
+		}
+	}()
+
+	// the container is removed from the networks if it fails.
+	results, err := cniMgr.SetUpPodNetwork(cniPodNetwork(c, netns, networks))
+	if err != nil {
+		return err
+	}
+
+	c.NetworkSettings.SandboxKey = netns
+	c.NetworkSettings.Networks = make(map[string]*types.EndpointSettings, len(networks))
+	for i, name := range networks {
+		ep := &types.EndpointSettings{NetworkID: name}
+		if i < len(results) {
+			if ep, err = cniEndpointSettings(name, results[i]); err != nil {
+				log.With(ctx).Warnf("failed to parse result of CNI network %s: %v", name, err)
+			}
+		}
+		c.NetworkSettings.Networks[name] = ep
+	}
+	c.NetworkSettings.Ports = c.HostConfig.PortBindings
+
+	var ip string
+	for _, name := range networks {
+		if ip = c.NetworkSettings.Networks[name].IPAddress; ip != "" {
+			break
+		}
+	}
+	return mgr.buildCNINetworkFiles(c, ip)
+}
+
+// releaseCNINetwork removes the container from the CNI networks and removes
+// its network namespace, the addresses of container are cleared. The caller
+// should hold the lock of container.
+func (mgr *ContainerManager) releaseCNINetwork(ctx context.Context, c *Container) error {
+	if c.NetworkSettings == nil || c.NetworkSettings.SandboxKey == "" {
+		return nil
+	}
+
+	cniMgr, err := mgr.NetworkMgr.CNI()
+	if err != nil {
+		return err
+	}
+
+	// the order of networks decides the names of interfaces, so that the
+	// default network recorded in setup is used if it's not specified.
+	networks := cniNetworks(c.HostConfig.NetworkMode)
+	if len(networks) == 0 {
+		for name := range c.NetworkSettings.Networks {
+			networks = append(networks, name)
+		}
+	}
+
+	netns := c.NetworkSettings.SandboxKey
+	if err := cniMgr.TearDownPodNetwork(cniPodNetwork(c, netns, networks)); err != nil {
+		return err
+	}
+	if err := cniMgr.RemoveNetNS(netns); err != nil {
+		return errors.Wrapf(err, "failed to remove network namespace %s", netns)
+	}
+
+	c.NetworkSettings.SandboxKey = ""
+	for name := range c.NetworkSettings.Networks {
+		c.NetworkSettings.Networks[name] = &types.EndpointSettings{NetworkID: name}
+	}
+	return nil
+}
+
+// buildCNINetworkFiles writes the hosts and resolv.conf of container, which
+// are written by libnetwork in the other network modes.
+func (mgr *ContainerManager) buildCNINetworkFiles(c *Container, ip string) error {
+	var hostGatewayIP string
+	if hasHostGateway(c.HostConfig.ExtraHosts) {
+		gateway, err := mgr.NetworkMgr.HostGatewayIP()
+		if err != nil {
+			return err
+		}
+		hostGatewayIP = gateway
+	}
+	extraHosts, err := parseExtraHosts(c.HostConfig.ExtraHosts, hostGatewayIP)
+	if err != nil {
+		return err
+	}
+
+	if err := etchosts.Build(c.HostsPath, ip, string(c.Config.Hostname), c.Config.Domainname, extraHosts); err != nil {
+		return errors.Wrapf(err, "failed to build hosts of container %s", c.ID)
+	}
+
+	f, err := resolvconf.Get()
+	if err != nil {
+		return errors.Wrap(err, "failed to read resolv.conf of host")
+	}
+
+	// the localhost nameservers of host are unreachable in container.
+	f, err = resolvconf.FilterResolvDNS(f.Content, true)
+	if err != nil {
+		return err
+	}
+
+	dns, dnsSearch, dnsOptions := c.HostConfig.DNS, c.HostConfig.DNSSearch, c.HostConfig.DNSOptions
+	if len(dns) == 0 {
+		dns = mgr.Config.NetworkConfig.DNS
+	}
+	if len(dnsSearch) == 0 {
+		dnsSearch = mgr.Config.NetworkConfig.DNSSearch
+	}
+	if len(dnsOptions) == 0 {
+		dnsOptions = mgr.Config.NetworkConfig.DNSOptions
+	}
+
+	if len(dns) == 0 && len(dnsSearch) == 0 && len(dnsOptions) == 0 {
+		return ioutil.WriteFile(c.ResolvConfPath, f.Content, 0644)
+	}
+
+	if len(dns) == 0 {
+		dns = resolvconf.GetNameservers(f.Content, networktypes.IP)
+	}
+	if len(dnsSearch) == 0 {
+		dnsSearch = resolvconf.GetSearchDomains(f.Content)
+	}
+	if len(dnsOptions) == 0 {
+		dnsOptions = resolvconf.GetOptions(f.Content)
+	}
+	_, err = resolvconf.Build(c.ResolvConfPath, dns, dnsSearch, dnsOptions)
+	return err
+}
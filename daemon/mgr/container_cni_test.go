@@ -0,0 +1,72 @@
+package mgr
+
+import (
+	"net"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	cnicurrent "github.com/containernetworking/cni/pkg/types/current"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCNINetworkMode(t *testing.T) {
+	assert.True(t, IsCNI("cni"))
+	assert.True(t, IsCNI("cni:net1,net2"))
+	assert.False(t, IsCNI("cnibridge"))
+	assert.False(t, IsUserDefined("cni:net1"))
+
+	assert.Nil(t, cniNetworks("cni"))
+	assert.Nil(t, cniNetworks("cni:"))
+	assert.Equal(t, []string{"net1", "net2"}, cniNetworks("cni:net1,net2"))
+}
+
+func TestToCNIPortMappings(t *testing.T) {
+	bindings := types.PortMap{
+		"80/tcp": {
+			{HostIP: "127.0.0.1", HostPort: "8080"},
+			// the random host port is not supported by CNI.
+			{HostPort: ""},
+		},
+	}
+
+	assert.Equal(t, []ocicni.PortMapping{{
+		HostPort:      8080,
+		ContainerPort: 80,
+		Protocol:      "tcp",
+		HostIP:        "127.0.0.1",
+	}}, toCNIPortMappings(bindings))
+}
+
+func TestCNIEndpointSettings(t *testing.T) {
+	_, v4, _ := net.ParseCIDR("10.22.0.5/16")
+	v4.IP = net.ParseIP("10.22.0.5")
+	_, v6, _ := net.ParseCIDR("fd00::5/64")
+	v6.IP = net.ParseIP("fd00::5")
+
+	result := &cnicurrent.Result{
+		CNIVersion: "0.3.1",
+		Interfaces: []*cnicurrent.Interface{
+			{Name: "cni0", Mac: "aa:bb:cc:dd:ee:00"},
+			{Name: "eth0", Mac: "aa:bb:cc:dd:ee:ff", Sandbox: "/var/run/netns/cni-1"},
+		},
+		IPs: []*cnicurrent.IPConfig{
+			{Version: "4", Address: *v4, Gateway: net.ParseIP("10.22.0.1")},
+			{Version: "6", Address: *v6, Gateway: net.ParseIP("fd00::1")},
+		},
+	}
+
+	ep, err := cniEndpointSettings("mynet", result)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.EndpointSettings{
+		NetworkID:           "mynet",
+		MacAddress:          "aa:bb:cc:dd:ee:ff",
+		IPAddress:           "10.22.0.5",
+		IPPrefixLen:         16,
+		Gateway:             "10.22.0.1",
+		GlobalIPV6Address:   "fd00::5",
+		GlobalIPV6PrefixLen: 64,
+		IPV6Gateway:         "fd00::1",
+	}, ep)
+}
@@ -2,12 +2,17 @@ package mgr
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/frontend/dockerfile/shell"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
@@ -34,6 +39,10 @@ func (mgr *ContainerManager) Commit(ctx context.Context, name string, options *t
 		return nil, errors.Wrapf(errtypes.ErrConflict, "failed to commit container(%s) which is Dead", c.ID)
 	}
 
+	if c.RootFSProvided {
+		return nil, errors.Wrapf(errtypes.ErrNotImplemented, "commit of container %s whose rootfs is provided", c.ID)
+	}
+
 	if c.IsRunning() {
 		if err := mgr.doPause(ctx, c); err != nil {
 			return nil, errors.Wrapf(err, "failed to pause container(%s)", c.ID)
@@ -67,13 +76,19 @@ func (mgr *ContainerManager) Commit(ctx context.Context, name string, options *t
 		return nil, errors.Wrapf(err, "failed to merge config from image")
 	}
 
+	// the changes are only applied to the config of new image.
+	config, err := applyCommitChanges(c.Config, options.Changes)
+	if err != nil {
+		return nil, err
+	}
+
 	commitConfig := &ctrd.CommitConfig{
 		Author:          options.Author,
 		Comment:         options.Comment,
 		ContainerID:     c.ID,
 		Reference:       options.Repository + ":" + options.Tag,
 		ParentReference: pRef.String(),
-		ContainerConfig: c.Config,
+		ContainerConfig: config,
 		CImage:          img,
 		Image:           ociImage,
 	}
@@ -105,3 +120,125 @@ func (mgr *ContainerManager) Commit(ctx context.Context, name string, options *t
 	imageID := imageDigest.Hex()
 	return &types.ContainerCommitResp{ID: string(imageID[:12])}, nil
 }
+
+// applyCommitChanges returns the copy of config applied with the Dockerfile
+// instructions in changes, CMD, ENV and EXPOSE are supported.
+func applyCommitChanges(config *types.ContainerConfig, changes []string) (*types.ContainerConfig, error) {
+	if len(changes) == 0 {
+		return config, nil
+	}
+
+	newConfig := *config
+	for _, change := range changes {
+		change = strings.TrimSpace(change)
+		if change == "" {
+			continue
+		}
+
+		fields := strings.SplitN(change, " ", 2)
+		instruction, args := strings.ToUpper(fields[0]), ""
+		if len(fields) == 2 {
+			args = strings.TrimSpace(fields[1])
+		}
+		if args == "" {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "%s requires at least one argument", instruction)
+		}
+
+		switch instruction {
+		case "CMD":
+			newConfig.Cmd = parseCommitCmd(args)
+		case "ENV":
+			envs, err := parseCommitEnv(args, newConfig.Env)
+			if err != nil {
+				return nil, err
+			}
+			newConfig.Env = overrideEnv(newConfig.Env, envs)
+		case "EXPOSE":
+			ports := make(map[string]interface{}, len(newConfig.ExposedPorts))
+			for k, v := range newConfig.ExposedPorts {
+				ports[k] = v
+			}
+			for _, port := range strings.Fields(args) {
+				if !strings.Contains(port, "/") {
+					port += "/tcp"
+				}
+				ports[port] = struct{}{}
+			}
+			newConfig.ExposedPorts = ports
+		default:
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "%s is not supported for commit, only CMD, ENV and EXPOSE are supported", instruction)
+		}
+	}
+	return &newConfig, nil
+}
+
+// parseCommitCmd parses the command of JSON array form, the command of
+// shell form is run by /bin/sh -c.
+func parseCommitCmd(args string) []string {
+	var cmd []string
+	if strings.HasPrefix(args, "[") && json.Unmarshal([]byte(args), &cmd) == nil {
+		return cmd
+	}
+	return []string{"/bin/sh", "-c", args}
+}
+
+// parseCommitEnv parses the env of "KEY=VALUE [KEY=VALUE...]" or "KEY VALUE"
+// form into the slice of "KEY=VALUE" with the Dockerfile parser, so that the
+// quotes and escapes are handled like the ENV of Dockerfile, and the variables
+// are expanded by env.
+func parseCommitEnv(args string, env []string) ([]string, error) {
+	result, err := parser.Parse(strings.NewReader("ENV " + args))
+	if err != nil {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid ENV %s: %v", args, err)
+	}
+	if len(result.AST.Children) != 1 {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid ENV %s, only one instruction is allowed", args)
+	}
+
+	instruction, err := instructions.ParseInstruction(result.AST.Children[0])
+	if err != nil {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid ENV %s: %v", args, err)
+	}
+	envCmd, ok := instruction.(*instructions.EnvCommand)
+	if !ok {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid ENV %s", args)
+	}
+
+	lex := shell.NewLex(result.EscapeToken)
+	if err := envCmd.Expand(func(word string) (string, error) {
+		return lex.ProcessWord(word, env)
+	}); err != nil {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid ENV %s: %v", args, err)
+	}
+
+	envs := make([]string, 0, len(envCmd.Env))
+	for _, kv := range envCmd.Env {
+		if kv.Key == "" {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid env %s, the form should be KEY=VALUE", args)
+		}
+		envs = append(envs, kv.Key+"="+kv.Value)
+	}
+	return envs, nil
+}
+
+// overrideEnv returns the env with the value of the same key overridden by
+// envs, the order of env is kept.
+func overrideEnv(env, envs []string) []string {
+	newEnv := make([]string, 0, len(env)+len(envs))
+	newEnv = append(newEnv, env...)
+	for _, e := range envs {
+		key := strings.SplitN(e, "=", 2)[0]
+
+		found := false
+		for i, old := range newEnv {
+			if strings.SplitN(old, "=", 2)[0] == key {
+				newEnv[i], found = e, true
+				break
+			}
+		}
+		if !found {
+			newEnv = append(newEnv, e)
+		}
+	}
+	return newEnv
+}
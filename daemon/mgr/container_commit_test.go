@@ -0,0 +1,54 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCommitChanges(t *testing.T) {
+	config := &types.ContainerConfig{
+		Cmd:          []string{"top"},
+		Env:          []string{"PATH=/bin", "A=1"},
+		ExposedPorts: map[string]interface{}{"80/tcp": struct{}{}},
+	}
+
+	newConfig, err := applyCommitChanges(config, []string{
+		`CMD ["nginx", "-g", "daemon off;"]`,
+		"ENV A=2 B=3",
+		"env C 4 5",
+		"EXPOSE 443 53/udp",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"nginx", "-g", "daemon off;"}, newConfig.Cmd)
+	assert.Equal(t, []string{"PATH=/bin", "A=2", "B=3", "C=4 5"}, newConfig.Env)
+	assert.Equal(t, map[string]interface{}{
+		"80/tcp":  struct{}{},
+		"443/tcp": struct{}{},
+		"53/udp":  struct{}{},
+	}, newConfig.ExposedPorts)
+
+	// the config of container is not changed.
+	assert.Equal(t, []string{"top"}, config.Cmd)
+	assert.Equal(t, []string{"PATH=/bin", "A=1"}, config.Env)
+	assert.Len(t, config.ExposedPorts, 1)
+
+	newConfig, err = applyCommitChanges(config, []string{"CMD nginx -g 'daemon off;'"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/bin/sh", "-c", "nginx -g 'daemon off;'"}, newConfig.Cmd)
+
+	// the quoted values and variables are handled like the ENV of Dockerfile.
+	newConfig, err = applyCommitChanges(config, []string{
+		`ENV A="b c" B='$A' C=${A}x D=e\ f`,
+		`ENV E "g h"`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PATH=/bin", "A=b c", "B=$A", "C=1x", "D=e f", "E=g h"}, newConfig.Env)
+
+	for _, change := range []string{"CMD", "ENV A", "ENV =1", "ENV A=1\nRUN ls", "RUN ls"} {
+		_, err := applyCommitChanges(config, []string{change})
+		assert.Error(t, err, change)
+	}
+}
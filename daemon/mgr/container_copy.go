@@ -12,6 +12,7 @@ import (
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/ioutils"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/docker/docker/pkg/archive"
 	"github.com/docker/docker/pkg/chrootarchive"
@@ -58,9 +59,12 @@ func (mgr *ContainerManager) StatPath(ctx context.Context, name, path string) (s
 	}
 	defer c.unmountVolumes(ctx, running)
 
-	resolvedPath, absPath := c.getResolvedPath(path, running)
-	lstat, err := os.Lstat(resolvedPath)
+	resolvedPath, absPath, err := c.getResolvedPath(path, running)
+	if err != nil {
+		return nil, err
+	}
 
+	lstat, err := os.Lstat(resolvedPath)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +130,11 @@ func (mgr *ContainerManager) ArchivePath(ctx context.Context, name, path string)
 		}
 	}()
 
-	resolvedPath, absPath := c.getResolvedPath(path, running)
+	resolvedPath, absPath, err := c.getResolvedPath(path, running)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	lstat, err := os.Lstat(resolvedPath)
 	if err != nil {
 		return nil, nil, err
@@ -201,7 +209,17 @@ func (mgr *ContainerManager) ExtractToDir(ctx context.Context, name, path string
 	}
 	defer c.unmountVolumes(ctx, running)
 
-	resolvedPath, _ := c.getResolvedPath(path, running)
+	resolvedPath, _, err := c.getResolvedPath(path, running)
+	if err != nil {
+		return err
+	}
+
+	// the directory to extract is resolved too, since the archive is
+	// extracted into it.
+	resolvedPath, err = utils.FollowSymlinkInScope(resolvedPath, c.rootfs(running))
+	if err != nil {
+		return err
+	}
 
 	lstat, err := os.Lstat(resolvedPath)
 	if err != nil {
@@ -239,23 +257,41 @@ func (mgr *ContainerManager) ExtractToDir(ctx context.Context, name, path string
 	return chrootarchive.Untar(content, resolvedPath, opts)
 }
 
-func (c *Container) getResolvedPath(path string, running bool) (resolvedPath, absPath string) {
+// rootfs returns the rootfs of container on the host.
+func (c *Container) rootfs(running bool) string {
+	if running {
+		return c.BaseFS
+	}
+	return c.MountFS
+}
+
+// getResolvedPath returns the real path on the host of the given path in the
+// container. The symlinks in the parent directories are resolved in the rootfs
+// of container so that the path never escapes from it, and the last component
+// is kept so that the symlink itself is archived.
+func (c *Container) getResolvedPath(path string, running bool) (resolvedPath, absPath string, err error) {
 	// consider the given path as an absolute path in the container.
 	absPath = path
 	if !filepath.IsAbs(absPath) {
 		absPath = archive.PreserveTrailingDotOrSeparator(filepath.Join(string(os.PathSeparator), path), path, os.PathSeparator)
 	}
 
-	rootfs := c.MountFS
-	if running {
-		rootfs = c.BaseFS
+	rootfs := c.rootfs(running)
+	dir, base := filepath.Split(filepath.Join(string(os.PathSeparator), absPath))
+	resolvedDir, err := utils.FollowSymlinkInScope(filepath.Join(rootfs, dir), rootfs)
+	if err != nil {
+		return "", "", err
 	}
 
-	// get the real path on the host
-	resolvedPath = filepath.Join(rootfs, absPath)
-	resolvedPath = filepath.Clean(resolvedPath)
+	return filepath.Join(resolvedDir, base), absPath, nil
+}
 
-	return resolvedPath, absPath
+// getMountPath returns the real path on the host to mount the volume, all the
+// symlinks in the destination are resolved in the rootfs of container, since
+// the mount follows the symlink of target.
+func (c *Container) getMountPath(dest string, running bool) (string, error) {
+	rootfs := c.rootfs(running)
+	return utils.FollowSymlinkInScope(filepath.Join(rootfs, filepath.Join(string(os.PathSeparator), dest)), rootfs)
 }
 
 func (c *Container) mountVolumes(ctx context.Context, running bool) (err0 error) {
@@ -274,7 +310,10 @@ func (c *Container) mountVolumes(ctx context.Context, running bool) (err0 error)
 	}()
 
 	for _, m := range c.Mounts {
-		dest, _ := c.getResolvedPath(m.Destination, running)
+		dest, err := c.getMountPath(m.Destination, running)
+		if err != nil {
+			return err
+		}
 
 		log.With(ctx).Debugf("try to mount volume(source %s -> dest %s", m.Source, dest)
 
@@ -319,7 +358,10 @@ func (c *Container) mountVolumes(ctx context.Context, running bool) (err0 error)
 
 func (c *Container) unmountVolumes(ctx context.Context, running bool) error {
 	for _, m := range c.Mounts {
-		dest, _ := c.getResolvedPath(m.Destination, running)
+		dest, err := c.getMountPath(m.Destination, running)
+		if err != nil {
+			return err
+		}
 
 		if err := mount.Unmount(dest); err != nil {
 			return err
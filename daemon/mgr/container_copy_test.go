@@ -0,0 +1,37 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetResolvedPath(t *testing.T) {
+	rootfs, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(t, err)
+	defer os.RemoveAll(rootfs)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(rootfs, "etc"), 0755))
+	assert.NoError(t, os.Symlink("/etc", filepath.Join(rootfs, "link")))
+	assert.NoError(t, os.Symlink("../../../../etc", filepath.Join(rootfs, "escape")))
+
+	c := &Container{MountFS: rootfs}
+	for path, expected := range map[string]string{
+		"/":              "/",
+		"etc/passwd":     "/etc/passwd",
+		"/link":          "/link",
+		"/link/passwd":   "/etc/passwd",
+		"/escape/passwd": "/etc/passwd",
+	} {
+		resolved, _, err := c.getResolvedPath(path, false)
+		assert.NoError(t, err, path)
+		assert.Equal(t, filepath.Join(rootfs, expected), resolved, path)
+	}
+
+	dest, err := c.getMountPath("/escape", false)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(rootfs, "etc"), dest)
+}
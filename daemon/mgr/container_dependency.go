@@ -0,0 +1,190 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// dependencyStarted means the dependency should be running.
+	dependencyStarted = "started"
+
+	// dependencyHealthy means the dependency should be running and healthy.
+	dependencyHealthy = "healthy"
+
+	// dependencyPollInterval is the interval to check the health status
+	// of dependency.
+	dependencyPollInterval = 100 * time.Millisecond
+)
+
+// validateDependencies validates the dependencies of container, the name of
+// dependency is replaced with its ID, so that the dependency is kept after
+// it is renamed.
+func (mgr *ContainerManager) validateDependencies(c *Container) error {
+	for _, dep := range c.HostConfig.DependsOn {
+		if dep == nil {
+			return fmt.Errorf("dependency of container cannot be empty")
+		}
+
+		switch dep.Condition {
+		case "", dependencyStarted, dependencyHealthy:
+		default:
+			return fmt.Errorf("invalid condition %s of dependency %s, should be %s or %s", dep.Condition, dep.Name, dependencyStarted, dependencyHealthy)
+		}
+
+		d, err := mgr.container(dep.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find dependency %s", dep.Name)
+		}
+
+		// the dependency should be created before the container, so there
+		// is no circular dependency except itself.
+		if d.ID == c.ID {
+			return fmt.Errorf("container cannot depend on itself")
+		}
+
+		if dep.Condition == dependencyHealthy && probeCommand(d.Config.Healthcheck) == nil {
+			return fmt.Errorf("dependency %s has no healthcheck, cannot wait for it to be %s", dep.Name, dependencyHealthy)
+		}
+
+		dep.Name = d.ID
+	}
+	return nil
+}
+
+// startDependencies starts the dependencies of container in topological
+// order, and waits for them to satisfy the conditions.
+func (mgr *ContainerManager) startDependencies(ctx context.Context, c *Container) error {
+	return mgr.startDependenciesInChain(ctx, c, map[string]bool{})
+}
+
+// startDependenciesInChain starts the dependencies recursively, the chain
+// records the containers being started to detect circular dependency.
+func (mgr *ContainerManager) startDependenciesInChain(ctx context.Context, c *Container, chain map[string]bool) error {
+	if c.HostConfig == nil || len(c.HostConfig.DependsOn) == 0 {
+		return nil
+	}
+
+	chain[c.ID] = true
+	defer delete(chain, c.ID)
+
+	for _, dep := range c.HostConfig.DependsOn {
+		d, err := mgr.container(dep.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find dependency %s of container %s", dep.Name, c.ID)
+		}
+
+		if chain[d.ID] {
+			return fmt.Errorf("circular dependency between container %s and %s", c.ID, d.ID)
+		}
+
+		if err := mgr.startDependenciesInChain(ctx, d, chain); err != nil {
+			return err
+		}
+
+		d.Lock()
+		running := d.IsRunningOrPaused()
+		d.Unlock()
+
+		if !running {
+			// the dependency may be started by other container at the
+			// same time, which has published the start event.
+			err := mgr.start(ctx, d, &types.ContainerStartOptions{})
+			switch {
+			case err == nil:
+				mgr.LogContainerEvent(ctx, d, "start")
+			case !errtypes.IsNotModified(err):
+				return errors.Wrapf(err, "failed to start dependency %s of container %s", d.ID, c.ID)
+			}
+		}
+
+		if dep.Condition == dependencyHealthy {
+			if err := waitContainerHealthy(ctx, d); err != nil {
+				return errors.Wrapf(err, "dependency %s of container %s is not healthy", d.ID, c.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// sortByDependencies sorts the containers in topological order, so that the
+// dependencies are before the containers depending on them. The containers
+// without dependency between them are kept in the original order. Unlike
+// startDependencies, the circular dependency is not an error here, it is
+// broken where it's found so that all the containers are still returned.
+func sortByDependencies(containers []*Container) []*Container {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		index  = make(map[string]*Container, len(containers))
+		state  = make(map[string]int, len(containers))
+		sorted = make([]*Container, 0, len(containers))
+		visit  func(c *Container)
+	)
+	for _, c := range containers {
+		index[c.ID] = c
+	}
+
+	visit = func(c *Container) {
+		if state[c.ID] != unvisited {
+			return
+		}
+
+		state[c.ID] = visiting
+		if c.HostConfig != nil {
+			for _, dep := range c.HostConfig.DependsOn {
+				// the dependency may have been removed.
+				if d, ok := index[dep.Name]; ok {
+					visit(d)
+				}
+			}
+		}
+		state[c.ID] = visited
+		sorted = append(sorted, c)
+	}
+
+	for _, c := range containers {
+		visit(c)
+	}
+	return sorted
+}
+
+// waitContainerHealthy waits for the container to be healthy.
+func waitContainerHealthy(ctx context.Context, c *Container) error {
+	for {
+		c.Lock()
+		running := c.IsRunningOrPaused()
+		health := c.State.Health
+		status := ""
+		if health != nil {
+			status = health.Status
+		}
+		c.Unlock()
+
+		switch {
+		case !running:
+			return fmt.Errorf("container is not running")
+		case health == nil:
+			return fmt.Errorf("container has no healthcheck")
+		case status == healthHealthy:
+			return nil
+		case status == healthUnhealthy:
+			return fmt.Errorf("container is %s", healthUnhealthy)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dependencyPollInterval):
+		}
+	}
+}
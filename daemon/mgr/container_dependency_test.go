@@ -0,0 +1,113 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/collect"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDependencyTestManager(containers ...*Container) *ContainerManager {
+	mgr := &ContainerManager{
+		cache:    collect.NewSafeMap(),
+		NameToID: collect.NewSafeMap(),
+	}
+	for _, c := range containers {
+		mgr.cache.Put(c.ID, c)
+		mgr.NameToID.Put(c.Name, c.ID)
+	}
+	return mgr
+}
+
+func newDependencyTestContainer(id string, deps ...*types.ContainerDependency) *Container {
+	return &Container{
+		ID:         id,
+		Name:       "name-" + id,
+		Config:     &types.ContainerConfig{},
+		HostConfig: &types.HostConfig{DependsOn: deps},
+		State:      &types.ContainerState{Running: true, Status: types.StatusRunning},
+	}
+}
+
+func TestValidateDependencies(t *testing.T) {
+	db := newDependencyTestContainer("db")
+	web := newDependencyTestContainer("web")
+	mgr := newDependencyTestManager(db, web)
+
+	c := newDependencyTestContainer("app", &types.ContainerDependency{Name: "name-db"})
+	assert.NoError(t, mgr.validateDependencies(c))
+	assert.Equal(t, "db", c.HostConfig.DependsOn[0].Name)
+
+	for _, dep := range []*types.ContainerDependency{
+		{Name: "name-db", Condition: "running"},
+		{Name: "name-db", Condition: dependencyHealthy},
+		{Name: "name-web"},
+	} {
+		c := newDependencyTestContainer("web", dep)
+		assert.Error(t, mgr.validateDependencies(c), dep.Name)
+	}
+
+	db.Config.Healthcheck = &types.HealthConfig{Test: []string{"CMD", "true"}}
+	c = newDependencyTestContainer("app", &types.ContainerDependency{Name: "db", Condition: dependencyHealthy})
+	assert.NoError(t, mgr.validateDependencies(c))
+}
+
+func TestStartDependencies(t *testing.T) {
+	db := newDependencyTestContainer("db")
+	cache := newDependencyTestContainer("cache", &types.ContainerDependency{Name: "db"})
+	app := newDependencyTestContainer("app",
+		&types.ContainerDependency{Name: "db"},
+		&types.ContainerDependency{Name: "cache"},
+	)
+	mgr := newDependencyTestManager(db, cache, app)
+
+	// all the dependencies are running.
+	assert.NoError(t, mgr.startDependencies(context.Background(), app))
+
+	// circular dependency.
+	db.HostConfig.DependsOn = []*types.ContainerDependency{{Name: "app"}}
+	assert.Error(t, mgr.startDependencies(context.Background(), app))
+}
+
+func TestSortByDependencies(t *testing.T) {
+	db := newDependencyTestContainer("db")
+	cache := newDependencyTestContainer("cache", &types.ContainerDependency{Name: "db"})
+	app := newDependencyTestContainer("app",
+		&types.ContainerDependency{Name: "cache"},
+		&types.ContainerDependency{Name: "removed"},
+	)
+	web := newDependencyTestContainer("web")
+
+	ids := func(containers []*Container) []string {
+		var ids []string
+		for _, c := range containers {
+			ids = append(ids, c.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"db", "cache", "app", "web"}, ids(sortByDependencies([]*Container{app, web, cache, db})))
+
+	// circular dependency doesn't drop any container.
+	db.HostConfig.DependsOn = []*types.ContainerDependency{{Name: "app"}}
+	assert.Equal(t, []string{"db", "cache", "app", "web"}, ids(sortByDependencies([]*Container{app, web, cache, db})))
+}
+
+func TestWaitContainerHealthy(t *testing.T) {
+	c := newDependencyTestContainer("db")
+	assert.Error(t, waitContainerHealthy(context.Background(), c))
+
+	c.State.Health = &types.Health{Status: healthHealthy}
+	assert.NoError(t, waitContainerHealthy(context.Background(), c))
+
+	c.State.Health.Status = healthUnhealthy
+	assert.Error(t, waitContainerHealthy(context.Background(), c))
+
+	c.State.Health.Status = healthStarting
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, waitContainerHealthy(ctx, c))
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
@@ -11,6 +15,7 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
 	"github.com/alibaba/pouch/pkg/streams"
+	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/user"
 
 	"github.com/docker/docker/daemon/caps"
@@ -35,6 +40,27 @@ func (mgr *ContainerManager) CreateExec(ctx context.Context, name string, config
 		return "", err
 	}
 
+	if config.WorkingDir != "" && !filepath.IsAbs(config.WorkingDir) {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "working directory %s of exec is not an absolute path", config.WorkingDir)
+	}
+
+	if r := config.Resources; r != nil && (r.Memory < 0 || r.CPUShares < 0 || r.CPUPeriod < 0 || r.CPUQuota < 0) {
+		return "", errors.Wrap(errtypes.ErrInvalidParam, "resources of exec should not be negative")
+	}
+	if config.Resources != nil && system.GetCgroupVersion() == system.CgroupV2 {
+		return "", errors.Wrap(errtypes.ErrInvalidParam, "resources of exec are not supported in cgroup v2")
+	}
+	if execResources(config.Resources) != nil {
+		if err := mgr.checkExecResourcesRuntime(c.HostConfig.Runtime); err != nil {
+			return "", err
+		}
+	}
+
+	// keep the finished exec processes of container under the cap.
+	if mgr.Config.MaxExecsPerContainer > 0 {
+		mgr.cleanExecProcesses(c.ID, false)
+	}
+
 	execid := randomid.Generate()
 	execConfig := &ContainerExecConfig{
 		ExecID:           execid,
@@ -75,6 +101,7 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 			exitCode := 126
 			execConfig.ExitCode = int64(exitCode)
 			execConfig.Exited = true
+			execConfig.ExitTime = time.Now()
 			execConfig.Used = true
 			execConfig.Unlock()
 		}
@@ -94,14 +121,27 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 		execConfig.User = c.Config.User
 	}
 
+	// additional groups of exec process are appended to container's
+	groupAdd := append(append([]string{}, c.HostConfig.GroupAdd...), execConfig.GroupAdd...)
+
 	uid, gid, additionalGids, err := user.Get(c.GetSpecificBasePath(user.PasswdFile),
-		c.GetSpecificBasePath(user.GroupFile), execConfig.User, c.HostConfig.GroupAdd)
+		c.GetSpecificBasePath(user.GroupFile), execConfig.User, groupAdd)
 	if err != nil {
 		execConfig.Unlock()
 		return err
 	}
 
-	cwd := c.Config.WorkingDir
+	// the exec process joins the user namespace of container.
+	if err := mgr.validateExecUser(c, uid, gid, additionalGids); err != nil {
+		execConfig.Unlock()
+		return err
+	}
+
+	// set exec process working directory, exec config takes precedence
+	cwd := execConfig.WorkingDir
+	if cwd == "" {
+		cwd = c.Config.WorkingDir
+	}
 	if cwd == "" {
 		cwd = "/"
 	}
@@ -116,6 +156,7 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 			GID:            gid,
 			AdditionalGids: additionalGids,
 		},
+		SelinuxLabel: c.ProcessLabel,
 	}
 
 	if execConfig.Privileged {
@@ -182,6 +223,7 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 		IO:          eio,
 		P:           process,
 		Detach:      cfg.Detach,
+		Resources:   execResources(execConfig.Resources),
 	}, timeout); err != nil {
 		return err
 	}
@@ -212,6 +254,10 @@ func (mgr *ContainerManager) InspectExec(ctx context.Context, execid string) (*t
 		ExitCode:      execConfig.ExitCode,
 		ContainerID:   execConfig.ContainerID,
 		ProcessConfig: processConfig,
+		OpenStdin:     execConfig.AttachStdin,
+		OpenStdout:    execConfig.AttachStdout,
+		OpenStderr:    execConfig.AttachStderr,
+		DetachKeys:    execConfig.DetachKeys,
 	}, nil
 }
 
@@ -239,6 +285,21 @@ func (mgr *ContainerManager) CheckExecExist(ctx context.Context, name string) er
 	return err
 }
 
+// PruneExecs removes the metadata of all finished exec processes, and only
+// the ones of container `name` if it is not empty.
+func (mgr *ContainerManager) PruneExecs(ctx context.Context, name string) ([]string, error) {
+	var containerID string
+	if name != "" {
+		c, err := mgr.container(name)
+		if err != nil {
+			return nil, err
+		}
+		containerID = c.ID
+	}
+
+	return mgr.cleanExecProcesses(containerID, true), nil
+}
+
 func (mgr *ContainerManager) getEntrypointAndArgs(cmd []string) (string, []string) {
 	if len(cmd) == 0 {
 		return "", []string{}
@@ -246,3 +307,75 @@ func (mgr *ContainerManager) getEntrypointAndArgs(cmd []string) (string, []strin
 
 	return cmd[0], cmd[1:]
 }
+
+// execResources converts the resources of exec into the resources of the sub
+// cgroup that exec process runs in.
+func execResources(r *types.ExecResources) *specs.LinuxResources {
+	if r == nil || (r.Memory == 0 && r.CPUShares == 0 && r.CPUPeriod == 0 && r.CPUQuota == 0) {
+		return nil
+	}
+
+	resources := &specs.LinuxResources{
+		CPU:    &specs.LinuxCPU{},
+		Memory: &specs.LinuxMemory{},
+	}
+	if r.Memory > 0 {
+		resources.Memory.Limit = &r.Memory
+	}
+	if r.CPUShares > 0 {
+		shares := uint64(r.CPUShares)
+		resources.CPU.Shares = &shares
+	}
+	if r.CPUPeriod > 0 {
+		period := uint64(r.CPUPeriod)
+		resources.CPU.Period = &period
+	}
+	if r.CPUQuota > 0 {
+		resources.CPU.Quota = &r.CPUQuota
+	}
+	return resources
+}
+
+// execCgroupRuncVersion is the first runc version which refuses to exec in the
+// frozen container, the exec process with resources is started while the
+// container is frozen, so that it runs nothing before joining its sub cgroup.
+var execCgroupRuncVersion = [2]int{1, 1}
+
+// probeRuntimeVersion is replaced in the tests.
+var probeRuntimeVersion = func(binary string) (string, error) {
+	_, version, err := probeBinary(binary, "--version")
+	return version, err
+}
+
+// checkExecResourcesRuntime checks whether the runtime of container is able to
+// start the exec process with resources.
+func (mgr *ContainerManager) checkExecResourcesRuntime(runtime string) error {
+	runtimeType, err := mgr.getRuntimeType(runtime)
+	if err != nil {
+		return err
+	}
+	if runtimeType != ctrd.RuntimeTypeV1 && runtimeType != ctrd.RuntimeTypeV2runcV1 {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "resources of exec are not supported by runtime type %s", runtimeType)
+	}
+
+	binary := runtimeBinary(runtime, runtimeType, mgr.Config.Runtimes[runtime])
+	version, err := probeRuntimeVersion(binary)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get version of runtime %s", runtime)
+	}
+
+	matches := versionRegexp.FindStringSubmatch(version)
+	if matches == nil {
+		return errors.Wrapf(errtypes.ErrNotImplemented, "resources of exec are not supported by unknown version %q of runtime %s", version, runtime)
+	}
+	major, _ := strconv.Atoi(matches[1])
+	minor, _ := strconv.Atoi(matches[2])
+	if major > execCgroupRuncVersion[0] || (major == execCgroupRuncVersion[0] && minor >= execCgroupRuncVersion[1]) {
+		return errors.Wrapf(errtypes.ErrNotImplemented, "resources of exec are not supported by %s of runtime %s, which refuses to exec in the frozen container", version, runtime)
+	}
+	return nil
+}
+
+// versionRegexp matches the major and minor version in the version of runc,
+// such as "runc version 1.0.0-rc93".
+var versionRegexp = regexp.MustCompile(`(\d+)\.(\d+)`)
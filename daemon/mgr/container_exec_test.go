@@ -0,0 +1,132 @@
+package mgr
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/collect"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func Test_execResources(t *testing.T) {
+	var (
+		memory int64  = 1024 * 1024 * 64
+		shares uint64 = 512
+		period uint64 = 100000
+		quota  int64  = 50000
+	)
+
+	tests := []struct {
+		name string
+		r    *types.ExecResources
+		want *specs.LinuxResources
+	}{
+		{name: "nil", r: nil, want: nil},
+		{name: "empty", r: &types.ExecResources{}, want: nil},
+		{
+			name: "memory",
+			r:    &types.ExecResources{Memory: memory},
+			want: &specs.LinuxResources{
+				CPU:    &specs.LinuxCPU{},
+				Memory: &specs.LinuxMemory{Limit: &memory},
+			},
+		},
+		{
+			name: "cpu",
+			r:    &types.ExecResources{CPUShares: 512, CPUPeriod: 100000, CPUQuota: 50000},
+			want: &specs.LinuxResources{
+				CPU:    &specs.LinuxCPU{Shares: &shares, Period: &period, Quota: &quota},
+				Memory: &specs.LinuxMemory{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := execResources(tt.r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("execResources() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cleanExecProcesses(t *testing.T) {
+	now := time.Now()
+	execs := map[string]*ContainerExecConfig{
+		"running": {ContainerID: "c1", Running: true},
+		"expired": {ContainerID: "c1", Exited: true, ExitTime: now.Add(-2 * time.Hour)},
+		"old":     {ContainerID: "c1", Exited: true, ExitTime: now.Add(-3 * time.Minute)},
+		"new":     {ContainerID: "c1", Exited: true, ExitTime: now.Add(-1 * time.Minute)},
+		"other":   {ContainerID: "c2", Exited: true, ExitTime: now.Add(-2 * time.Minute)},
+	}
+
+	mgr := &ContainerManager{
+		Config: &config.Config{
+			ExecRetentionTime:    3600,
+			MaxExecsPerContainer: 1,
+		},
+		ExecProcesses: collect.NewSafeMap(),
+	}
+	for id, execConfig := range execs {
+		execConfig.ExecID = id
+		mgr.ExecProcesses.Put(id, execConfig)
+	}
+
+	cleaned := mgr.cleanExecProcesses("", false)
+	sort.Strings(cleaned)
+	if want := []string{"expired", "old"}; !reflect.DeepEqual(cleaned, want) {
+		t.Errorf("cleanExecProcesses() = %v, want %v", cleaned, want)
+	}
+
+	cleaned = mgr.cleanExecProcesses("c2", true)
+	if want := []string{"other"}; !reflect.DeepEqual(cleaned, want) {
+		t.Errorf("cleanExecProcesses() = %v, want %v", cleaned, want)
+	}
+
+	for _, id := range []string{"running", "new"} {
+		if _, ok := mgr.ExecProcesses.Get(id).Result(); !ok {
+			t.Errorf("exec process %s should not be cleaned", id)
+		}
+	}
+}
+
+func TestCheckExecResourcesRuntime(t *testing.T) {
+	defer func(f func(string) (string, error)) {
+		probeRuntimeVersion = f
+	}(probeRuntimeVersion)
+
+	mgr := &ContainerManager{
+		Config: &config.Config{
+			Runtimes: map[string]types.Runtime{
+				"runc":  {},
+				"runsc": {Type: "io.containerd.runsc.v1"},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		runtime string
+		version string
+		wantErr bool
+	}{
+		{runtime: "runc", version: "runc version 1.0.0-rc93", wantErr: false},
+		{runtime: "runc", version: "runc version 1.0.3", wantErr: false},
+		{runtime: "runc", version: "runc version 1.1.0", wantErr: true},
+		{runtime: "runc", version: "runc version 2.0.0", wantErr: true},
+		{runtime: "runc", version: "unknown", wantErr: true},
+		{runtime: "runsc", version: "runsc version release-20230605.0", wantErr: true},
+		{runtime: "missing", wantErr: true},
+	} {
+		version := tt.version
+		probeRuntimeVersion = func(string) (string, error) {
+			return version, nil
+		}
+		if err := mgr.checkExecResourcesRuntime(tt.runtime); (err != nil) != tt.wantErr {
+			t.Errorf("checkExecResourcesRuntime(%s) with %q error = %v, wantErr %v", tt.runtime, tt.version, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package mgr
+
+import (
+	"context"
+	"io"
+
+	"github.com/alibaba/pouch/pkg/ioutils"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/archive"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// Export returns the tar stream of the container rootfs, the volumes of
+// container are not included.
+func (mgr *ContainerManager) Export(ctx context.Context, name string) (_ io.ReadCloser, err0 error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	c.Lock()
+	defer func() {
+		if err0 != nil {
+			c.Unlock()
+		}
+	}()
+
+	if c.State.Dead {
+		return nil, pkgerrors.Errorf("container(%s) has been deleted", c.ID)
+	}
+
+	// the merged rootfs has no whiteout, and the owner of files is kept
+	// in the tar stream.
+	rootfs := c.BaseFS
+	running := c.IsRunningOrPaused()
+	if !running {
+		if err := mgr.Mount(ctx, c); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to mount cid(%s)", c.ID)
+		}
+		defer func() {
+			if err0 != nil {
+				mgr.Unmount(ctx, c)
+			}
+		}()
+		rootfs = c.MountFS
+	}
+
+	data, err := archive.Tar(rootfs, archive.Uncompressed)
+	if err != nil {
+		return nil, err
+	}
+
+	// wait for io finish, then unmount the rootfs
+	content := ioutils.NewReadCloserWrapper(data, func() error {
+		err := data.Close()
+		if !running {
+			mgr.Unmount(ctx, c)
+		}
+		c.Unlock()
+		return err
+	})
+	mgr.LogContainerEvent(ctx, c, "export")
+
+	return content, nil
+}
@@ -0,0 +1,222 @@
+package mgr
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// gpuCapability is the capability of device request asking for GPUs.
+	gpuCapability = "gpu"
+
+	// nvidiaDriver is the only supported driver of GPU device request.
+	nvidiaDriver = "nvidia"
+
+	// defaultGPUDriverCapabilities is the default driver capabilities of GPU
+	// device request.
+	defaultGPUDriverCapabilities = "compute,utility"
+
+	// nvidiaSmiName is the tool to query the utilization of GPUs.
+	nvidiaSmiName = "nvidia-smi"
+
+	// nvidiaSmiTimeout is the timeout of querying the utilization of GPUs.
+	nvidiaSmiTimeout = 5 * time.Second
+)
+
+// nvidiaDeviceGlob matches the GPU device nodes on host.
+var nvidiaDeviceGlob = "/dev/nvidia[0-9]*"
+
+// resolveGPURequests resolves the GPU device request into the nvidia config,
+// so that the devices, driver libraries and environment are injected into the
+// spec by the nvidia prestart hook.
+func resolveGPURequests(r *types.Resources) error {
+	var gpuRequest *types.DeviceRequest
+	for _, req := range r.DeviceRequests {
+		if req == nil {
+			continue
+		}
+		if req.Driver != "" && req.Driver != nvidiaDriver {
+			return fmt.Errorf("unsupported driver %s of device request, only %s is supported", req.Driver, nvidiaDriver)
+		}
+		if req.Driver == "" && !hasGPUCapability(req) {
+			return fmt.Errorf("device request should specify driver %s or capability %s", nvidiaDriver, gpuCapability)
+		}
+		if gpuRequest != nil {
+			return fmt.Errorf("only one GPU device request is supported")
+		}
+		gpuRequest = req
+	}
+
+	if gpuRequest == nil {
+		return nil
+	}
+	if r.NvidiaConfig != nil {
+		return fmt.Errorf("conflicting options: GPU device request and nvidia config")
+	}
+
+	devices, err := gpuVisibleDevices(gpuRequest)
+	if err != nil {
+		return err
+	}
+
+	var capabilities []string
+	for _, caps := range gpuRequest.Capabilities {
+		for _, c := range caps {
+			if c != gpuCapability && !utils.StringInSlice(capabilities, c) {
+				capabilities = append(capabilities, c)
+			}
+		}
+	}
+	driverCapabilities := defaultGPUDriverCapabilities
+	if len(capabilities) != 0 {
+		driverCapabilities = strings.Join(capabilities, ",")
+	}
+
+	r.NvidiaConfig = &types.NvidiaConfig{
+		NvidiaDriverCapabilities: driverCapabilities,
+		NvidiaVisibleDevices:     devices,
+	}
+	return nil
+}
+
+// hasGPUCapability returns whether the device request asks for GPUs.
+func hasGPUCapability(req *types.DeviceRequest) bool {
+	for _, caps := range req.Capabilities {
+		if utils.StringInSlice(caps, gpuCapability) {
+			return true
+		}
+	}
+	return false
+}
+
+// gpuVisibleDevices returns the visible devices of nvidia for the request.
+func gpuVisibleDevices(req *types.DeviceRequest) (string, error) {
+	switch {
+	case len(req.DeviceIDs) != 0:
+		if req.Count != 0 {
+			return "", fmt.Errorf("conflicting options: count and device ids of GPU device request")
+		}
+		return strings.Join(req.DeviceIDs, ","), nil
+	case req.Count < 0:
+		return "all", nil
+	case req.Count == 0:
+		return "", fmt.Errorf("GPU device request should specify count or device ids")
+	}
+
+	matches, err := filepath.Glob(nvidiaDeviceGlob)
+	if err != nil {
+		return "", err
+	}
+	var indexes []int
+	for _, m := range matches {
+		if idx, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(m), "nvidia")); err == nil {
+			indexes = append(indexes, idx)
+		}
+	}
+	if int64(len(indexes)) < req.Count {
+		return "", fmt.Errorf("GPU device request asks for %d GPUs, only %d GPUs are available", req.Count, len(indexes))
+	}
+
+	sort.Ints(indexes)
+	ids := make([]string, 0, req.Count)
+	for _, idx := range indexes[:req.Count] {
+		ids = append(ids, strconv.Itoa(idx))
+	}
+	return strings.Join(ids, ","), nil
+}
+
+// gpuStats returns the utilization of GPUs visible to the container, it
+// returns nil if the container uses no GPU.
+func gpuStats(ctx context.Context, c *Container) ([]*types.GPUStats, error) {
+	devices := ""
+	if n := c.HostConfig.NvidiaConfig; n != nil {
+		devices = n.NvidiaVisibleDevices
+	} else {
+		devices = utils.ConvertKVStrToMapWithNoErr(c.Config.Env)["NVIDIA_VISIBLE_DEVICES"]
+	}
+	if devices == "" || devices == "none" || devices == "void" {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, nvidiaSmiTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, nvidiaSmiName,
+		"--query-gpu=index,uuid,name,utilization.gpu,utilization.memory,memory.used,memory.total",
+		"--format=csv,noheader,nounits").Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query GPUs by %s", nvidiaSmiName)
+	}
+
+	stats, err := parseGPUStats(string(output))
+	if err != nil {
+		return nil, err
+	}
+	if devices == "all" {
+		return stats, nil
+	}
+
+	visible := strings.Split(devices, ",")
+	res := make([]*types.GPUStats, 0, len(visible))
+	for _, s := range stats {
+		if utils.StringInSlice(visible, strconv.FormatUint(s.Index, 10)) || utils.StringInSlice(visible, s.UUID) {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+// parseGPUStats parses the csv output of nvidia-smi query, the memory is
+// reported in MiB and converted into bytes.
+func parseGPUStats(output string) ([]*types.GPUStats, error) {
+	var stats []*types.GPUStats
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("invalid GPU stats %q", line)
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		values := make([]uint64, 0, 5)
+		for _, f := range append([]string{fields[0]}, fields[3:]...) {
+			// the unsupported value is reported as "[N/A]" or "[Not Supported]".
+			v, err := strconv.ParseUint(f, 10, 64)
+			if err != nil && !strings.HasPrefix(f, "[") {
+				return nil, fmt.Errorf("invalid GPU stats %q: %v", line, err)
+			}
+			values = append(values, v)
+		}
+
+		stats = append(stats, &types.GPUStats{
+			Index:             values[0],
+			UUID:              fields[1],
+			Name:              fields[2],
+			UtilizationGPU:    values[1],
+			UtilizationMemory: values[2],
+			MemoryUsed:        values[3] << 20,
+			MemoryTotal:       values[4] << 20,
+		})
+	}
+	return stats, scanner.Err()
+}
@@ -0,0 +1,91 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveGPURequests(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-gpu-devices")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	for _, dev := range []string{"nvidia0", "nvidia1", "nvidiactl"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, dev), nil, 0644))
+	}
+	defer func(glob string) { nvidiaDeviceGlob = glob }(nvidiaDeviceGlob)
+	nvidiaDeviceGlob = filepath.Join(tmpDir, "nvidia[0-9]*")
+
+	for _, tc := range []struct {
+		req      *types.DeviceRequest
+		expected *types.NvidiaConfig
+	}{
+		{
+			&types.DeviceRequest{Count: -1, Capabilities: [][]string{{"gpu"}}},
+			&types.NvidiaConfig{NvidiaVisibleDevices: "all", NvidiaDriverCapabilities: "compute,utility"},
+		},
+		{
+			&types.DeviceRequest{Count: 2, Driver: "nvidia"},
+			&types.NvidiaConfig{NvidiaVisibleDevices: "0,1", NvidiaDriverCapabilities: "compute,utility"},
+		},
+		{
+			&types.DeviceRequest{DeviceIDs: []string{"1", "GPU-fef8089b"}, Capabilities: [][]string{{"gpu", "video"}}},
+			&types.NvidiaConfig{NvidiaVisibleDevices: "1,GPU-fef8089b", NvidiaDriverCapabilities: "video"},
+		},
+	} {
+		r := &types.Resources{DeviceRequests: []*types.DeviceRequest{tc.req}}
+		assert.NoError(t, resolveGPURequests(r), "%+v", tc.req)
+		assert.Equal(t, tc.expected, r.NvidiaConfig, "%+v", tc.req)
+	}
+
+	for _, r := range []*types.Resources{
+		{DeviceRequests: []*types.DeviceRequest{{Driver: "amd", Count: 1}}},
+		{DeviceRequests: []*types.DeviceRequest{{Count: 1}}},
+		{DeviceRequests: []*types.DeviceRequest{{Driver: "nvidia"}}},
+		{DeviceRequests: []*types.DeviceRequest{{Driver: "nvidia", Count: 3}}},
+		{DeviceRequests: []*types.DeviceRequest{{Driver: "nvidia", Count: 1, DeviceIDs: []string{"0"}}}},
+		{DeviceRequests: []*types.DeviceRequest{{Driver: "nvidia", Count: 1}, {Driver: "nvidia", Count: 1}}},
+		{
+			DeviceRequests: []*types.DeviceRequest{{Driver: "nvidia", Count: 1}},
+			NvidiaConfig:   &types.NvidiaConfig{NvidiaVisibleDevices: "0"},
+		},
+	} {
+		assert.Error(t, resolveGPURequests(r), "%+v", r.DeviceRequests)
+	}
+}
+
+func TestParseGPUStats(t *testing.T) {
+	output := "0, GPU-fef8089b, Tesla V100-SXM2-16GB, 45, 12, 1024, 16160\n" +
+		"1, GPU-1a2b3c4d, Tesla V100-SXM2-16GB, [N/A], [N/A], 0, 16160\n"
+
+	stats, err := parseGPUStats(output)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.GPUStats{
+		{
+			Index:             0,
+			UUID:              "GPU-fef8089b",
+			Name:              "Tesla V100-SXM2-16GB",
+			UtilizationGPU:    45,
+			UtilizationMemory: 12,
+			MemoryUsed:        1024 << 20,
+			MemoryTotal:       16160 << 20,
+		},
+		{
+			Index:       1,
+			UUID:        "GPU-1a2b3c4d",
+			Name:        "Tesla V100-SXM2-16GB",
+			MemoryTotal: 16160 << 20,
+		},
+	}, stats)
+
+	for _, output := range []string{"0, GPU-fef8089b, Tesla", "a, GPU-fef8089b, Tesla, 1, 1, 1, 1"} {
+		_, err := parseGPUStats(output)
+		assert.Error(t, err, output)
+	}
+}
@@ -0,0 +1,334 @@
+package mgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/streams"
+)
+
+const (
+	// health status of container.
+	healthStarting  = "starting"
+	healthHealthy   = "healthy"
+	healthUnhealthy = "unhealthy"
+
+	// the test types of healthcheck.
+	healthTestNone     = "NONE"
+	healthTestCmd      = "CMD"
+	healthTestCmdShell = "CMD-SHELL"
+
+	defaultProbeInterval    = 30 * time.Second
+	defaultProbeTimeout     = 30 * time.Second
+	defaultProbeStartPeriod = 0
+	defaultProbeRetries     = 3
+
+	// minProbeDuration is the minimal interval, timeout and start period.
+	minProbeDuration = time.Millisecond
+
+	// maxHealthLogEntries is the number of probe results kept in state.
+	maxHealthLogEntries = 5
+
+	// maxHealthOutputLen is the max length of output kept in probe result.
+	maxHealthOutputLen = 4096
+)
+
+// validateHealthcheck validates the healthcheck config of container.
+func validateHealthcheck(hc *types.HealthConfig) error {
+	if hc == nil {
+		return nil
+	}
+
+	if len(hc.Test) > 0 {
+		switch hc.Test[0] {
+		case healthTestNone:
+		case healthTestCmd, healthTestCmdShell:
+			if len(hc.Test) == 1 {
+				return fmt.Errorf("healthcheck %s requires a command", hc.Test[0])
+			}
+		default:
+			return fmt.Errorf("unknown healthcheck type %s, should be one of %s, %s and %s", hc.Test[0], healthTestNone, healthTestCmd, healthTestCmdShell)
+		}
+	}
+
+	for name, d := range map[string]int64{
+		"interval":     hc.Interval,
+		"timeout":      hc.Timeout,
+		"start period": hc.StartPeriod,
+	} {
+		if d != 0 && time.Duration(d) < minProbeDuration {
+			return fmt.Errorf("healthcheck %s should be 0 or at least %v", name, minProbeDuration)
+		}
+	}
+
+	if hc.Retries < 0 {
+		return fmt.Errorf("healthcheck retries %d cannot be negative", hc.Retries)
+	}
+	return nil
+}
+
+// mergeHealthcheck fills the unset fields of container's healthcheck with
+// the image's.
+func mergeHealthcheck(hc, imageHC *types.HealthConfig) *types.HealthConfig {
+	if imageHC == nil {
+		return hc
+	}
+	if hc == nil {
+		return imageHC
+	}
+
+	merged := *hc
+	if len(merged.Test) == 0 {
+		merged.Test = imageHC.Test
+	}
+	if merged.Interval == 0 {
+		merged.Interval = imageHC.Interval
+	}
+	if merged.Timeout == 0 {
+		merged.Timeout = imageHC.Timeout
+	}
+	if merged.StartPeriod == 0 {
+		merged.StartPeriod = imageHC.StartPeriod
+	}
+	if merged.Retries == 0 {
+		merged.Retries = imageHC.Retries
+	}
+	return &merged
+}
+
+// probeCommand returns the command of healthcheck, nil means healthcheck is
+// disabled.
+func probeCommand(hc *types.HealthConfig) []string {
+	if hc == nil || len(hc.Test) < 2 {
+		return nil
+	}
+
+	switch hc.Test[0] {
+	case healthTestCmd:
+		return hc.Test[1:]
+	case healthTestCmdShell:
+		return []string{"/bin/sh", "-c", strings.Join(hc.Test[1:], " ")}
+	default:
+		return nil
+	}
+}
+
+func durationOrDefault(d int64, defaultDuration time.Duration) time.Duration {
+	if d == 0 {
+		return defaultDuration
+	}
+	return time.Duration(d)
+}
+
+// startHealthMonitor starts to probe the container periodically if it has
+// healthcheck. The health state is reset to starting if reset is true,
+// otherwise the previous state is kept, for example, when recovering the
+// container after daemon restarts. The caller should hold the lock of
+// container.
+func (mgr *ContainerManager) startHealthMonitor(c *Container, reset bool) {
+	c.stopHealthMonitor()
+
+	cmd := probeCommand(c.Config.Healthcheck)
+	if cmd == nil {
+		c.State.Health = nil
+		return
+	}
+
+	if reset || c.State.Health == nil {
+		c.State.Health = &types.Health{Status: healthStarting}
+	}
+
+	stop := make(chan struct{})
+	c.healthStop = stop
+	go mgr.monitorHealth(c, cmd, stop)
+}
+
+// stopHealthMonitor stops probing the container, the caller should hold the
+// lock of container.
+func (c *Container) stopHealthMonitor() {
+	if c.healthStop != nil {
+		close(c.healthStop)
+		c.healthStop = nil
+	}
+}
+
+// monitorHealth runs the probe every interval until the monitor is stopped.
+func (mgr *ContainerManager) monitorHealth(c *Container, cmd []string, stop chan struct{}) {
+	hc := c.Config.Healthcheck
+	var (
+		interval    = durationOrDefault(hc.Interval, defaultProbeInterval)
+		timeout     = durationOrDefault(hc.Timeout, defaultProbeTimeout)
+		startPeriod = durationOrDefault(hc.StartPeriod, defaultProbeStartPeriod)
+		retries     = hc.Retries
+		started     = time.Now()
+	)
+	if retries == 0 {
+		retries = defaultProbeRetries
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+
+		// the exec process can not run in a frozen container.
+		c.Lock()
+		paused := c.State.Paused
+		c.Unlock()
+		if paused {
+			continue
+		}
+
+		result := mgr.probe(c.ID, cmd, timeout)
+		mgr.updateHealth(c, result, retries, time.Since(started) < startPeriod, stop)
+	}
+}
+
+// probe runs the command in container and records the result.
+func (mgr *ContainerManager) probe(id string, cmd []string, timeout time.Duration) *types.HealthcheckResult {
+	ctx := context.Background()
+	start := time.Now()
+	result := &types.HealthcheckResult{
+		Start: start.UTC().Format(time.RFC3339Nano),
+	}
+	defer func() {
+		result.End = time.Now().UTC().Format(time.RFC3339Nano)
+	}()
+
+	execid, err := mgr.CreateExec(ctx, id, &types.ExecCreateConfig{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		result.ExitCode, result.Output = -1, err.Error()
+		return result
+	}
+	defer mgr.ExecProcesses.Remove(execid)
+
+	output := &limitedBuffer{}
+	attach := &streams.AttachConfig{
+		UseStdout: true,
+		Stdout:    output,
+		UseStderr: true,
+		Stderr:    output,
+	}
+
+	// NOTE: the timeout of exec is in seconds.
+	if err := mgr.StartExec(ctx, execid, attach, int(math.Ceil(timeout.Seconds()))); err != nil {
+		result.ExitCode, result.Output = -1, err.Error()
+		return result
+	}
+
+	execConfig, err := mgr.GetExecConfig(ctx, execid)
+	if err != nil {
+		result.ExitCode, result.Output = -1, err.Error()
+		return result
+	}
+
+	execConfig.Lock()
+	exitCode, execErr := execConfig.ExitCode, execConfig.Error
+	execConfig.Unlock()
+
+	if execErr != nil {
+		result.ExitCode = -1
+		result.Output = fmt.Sprintf("health check failed: %v", execErr)
+		return result
+	}
+
+	// the exec process is killed when it exceeds the timeout.
+	if exitCode != 0 && time.Since(start) >= timeout {
+		result.ExitCode = -1
+		result.Output = fmt.Sprintf("health check exceeded timeout (%v)", timeout)
+		return result
+	}
+
+	result.ExitCode, result.Output = exitCode, output.String()
+	return result
+}
+
+// updateHealth updates the health state of container with the probe result.
+func (mgr *ContainerManager) updateHealth(c *Container, result *types.HealthcheckResult, retries int64, inStartPeriod bool, stop chan struct{}) {
+	c.Lock()
+	defer c.Unlock()
+
+	// the monitor has been stopped while probing.
+	if c.healthStop != stop || c.State.Health == nil {
+		return
+	}
+
+	ctx := log.NewContext(context.Background(), map[string]interface{}{
+		"ContainerID": c.ID,
+	})
+	if applyProbeResult(c.State.Health, result, retries, inStartPeriod) {
+		mgr.LogContainerEvent(ctx, c, "health_status: "+c.State.Health.Status)
+	}
+
+	if err := c.Write(mgr.Store); err != nil {
+		log.With(ctx).Errorf("failed to update health state: %v", err)
+	}
+}
+
+// applyProbeResult records the probe result in health state and returns
+// whether the health status is changed. The failures in start period don't
+// count towards the retries.
+func applyProbeResult(h *types.Health, result *types.HealthcheckResult, retries int64, inStartPeriod bool) bool {
+	oldStatus := h.Status
+
+	h.Log = append(h.Log, result)
+	if len(h.Log) > maxHealthLogEntries {
+		h.Log = h.Log[len(h.Log)-maxHealthLogEntries:]
+	}
+
+	switch {
+	case result.ExitCode == 0:
+		h.FailingStreak = 0
+		h.Status = healthHealthy
+	case inStartPeriod && h.Status == healthStarting:
+	default:
+		h.FailingStreak++
+		if h.FailingStreak >= retries {
+			h.Status = healthUnhealthy
+		}
+	}
+
+	return h.Status != oldStatus
+}
+
+// limitedBuffer keeps the beginning of probe output, it can be written by
+// stdout and stderr concurrently.
+type limitedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write implements io.Writer.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if n := maxHealthOutputLen - b.buf.Len(); n > 0 {
+		if len(p) > n {
+			b.buf.Write(p[:n])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// String returns the output.
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,89 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHealthcheck(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		input    *types.HealthConfig
+		hasError bool
+	}{
+		{name: "nil", input: nil},
+		{name: "inherit", input: &types.HealthConfig{Interval: int64(time.Second)}},
+		{name: "none", input: &types.HealthConfig{Test: []string{"NONE"}}},
+		{name: "cmd", input: &types.HealthConfig{Test: []string{"CMD", "true"}, Retries: 1}},
+		{name: "cmd without command", input: &types.HealthConfig{Test: []string{"CMD-SHELL"}}, hasError: true},
+		{name: "unknown type", input: &types.HealthConfig{Test: []string{"SHELL", "true"}}, hasError: true},
+		{name: "short interval", input: &types.HealthConfig{Interval: int64(time.Microsecond)}, hasError: true},
+		{name: "negative timeout", input: &types.HealthConfig{Timeout: -1}, hasError: true},
+		{name: "negative retries", input: &types.HealthConfig{Retries: -1}, hasError: true},
+	} {
+		err := validateHealthcheck(tc.input)
+		if tc.hasError {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMergeHealthcheck(t *testing.T) {
+	imageHC := &types.HealthConfig{
+		Test:     []string{"CMD", "check"},
+		Interval: int64(10 * time.Second),
+		Retries:  5,
+	}
+
+	assert.Nil(t, mergeHealthcheck(nil, nil))
+	assert.Equal(t, imageHC, mergeHealthcheck(nil, imageHC))
+
+	merged := mergeHealthcheck(&types.HealthConfig{Interval: int64(time.Second)}, imageHC)
+	assert.Equal(t, &types.HealthConfig{
+		Test:     []string{"CMD", "check"},
+		Interval: int64(time.Second),
+		Retries:  5,
+	}, merged)
+
+	disabled := &types.HealthConfig{Test: []string{"NONE"}}
+	assert.Equal(t, []string{"NONE"}, mergeHealthcheck(disabled, imageHC).Test)
+}
+
+func TestProbeCommand(t *testing.T) {
+	assert.Nil(t, probeCommand(nil))
+	assert.Nil(t, probeCommand(&types.HealthConfig{Test: []string{"NONE"}}))
+	assert.Equal(t, []string{"check", "-v"}, probeCommand(&types.HealthConfig{Test: []string{"CMD", "check", "-v"}}))
+	assert.Equal(t, []string{"/bin/sh", "-c", "check -v"}, probeCommand(&types.HealthConfig{Test: []string{"CMD-SHELL", "check -v"}}))
+}
+
+func TestApplyProbeResult(t *testing.T) {
+	h := &types.Health{Status: healthStarting}
+	apply := func(exitCode int64, inStartPeriod bool) bool {
+		return applyProbeResult(h, &types.HealthcheckResult{ExitCode: exitCode}, 2, inStartPeriod)
+	}
+
+	assert.False(t, apply(1, true))
+	assert.Equal(t, healthStarting, h.Status)
+	assert.Equal(t, int64(0), h.FailingStreak)
+
+	assert.True(t, apply(0, true))
+	assert.Equal(t, healthHealthy, h.Status)
+
+	assert.False(t, apply(1, false))
+	assert.True(t, apply(1, false))
+	assert.Equal(t, healthUnhealthy, h.Status)
+	assert.Equal(t, int64(2), h.FailingStreak)
+
+	for i := 0; i < maxHealthLogEntries; i++ {
+		apply(0, false)
+	}
+	assert.Equal(t, maxHealthLogEntries, len(h.Log))
+	assert.Equal(t, healthHealthy, h.Status)
+	assert.Equal(t, int64(0), h.FailingStreak)
+}
@@ -7,8 +7,11 @@ import (
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/daemon/logger"
-	"github.com/alibaba/pouch/daemon/logger/jsonfile"
-	"github.com/alibaba/pouch/daemon/logger/syslog"
+	// register the log drivers
+	_ "github.com/alibaba/pouch/daemon/logger/fluentd"
+	_ "github.com/alibaba/pouch/daemon/logger/journald"
+	_ "github.com/alibaba/pouch/daemon/logger/jsonfile"
+	_ "github.com/alibaba/pouch/daemon/logger/syslog"
 	"github.com/alibaba/pouch/pkg/log"
 )
 
@@ -22,15 +25,12 @@ func logOptionsForContainerio(c *Container, info logger.Info) (logger.LogDriver,
 		return nil, nil
 	}
 
-	switch cfg.LogDriver {
-	case types.LogConfigLogDriverJSONFile:
-		return jsonfile.Init(info)
-	case types.LogConfigLogDriverSyslog:
-		return syslog.Init(info)
-	default:
-		log.With(nil).Warnf("not support (%v) log driver yet", cfg.LogDriver)
+	create, err := logger.GetLogDriver(cfg.LogDriver)
+	if err != nil {
+		log.With(nil).Warnf("%v", err)
 		return nil, nil
 	}
+	return create(info)
 }
 
 // convContainerToLoggerInfo uses logger.Info to wrap container information.
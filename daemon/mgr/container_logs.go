@@ -58,7 +58,8 @@ func (mgr *ContainerManager) Logs(ctx context.Context, name string, logOpt *type
 
 	fileName := filepath.Join(rootDir, "json.log")
 
-	jf, err := jsonfile.NewJSONLogFile(fileName, 0640, nil, nil)
+	// NOTE: the log options tell the reader how the logs are rotated.
+	jf, err := jsonfile.NewJSONLogFile(fileName, 0640, c.HostConfig.LogConfig.LogOpts, nil)
 
 	if err != nil {
 		return nil, false, err
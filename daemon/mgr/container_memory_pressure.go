@@ -0,0 +1,113 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/signal"
+)
+
+// memoryPressureCooldown is the min interval between two actions of memory
+// pressure policy, so that the container has time to release the memory.
+const memoryPressureCooldown = time.Minute
+
+// validateMemoryPressurePolicy validates the memory pressure policy.
+func validateMemoryPressurePolicy(policy *types.MemoryPressurePolicy) error {
+	if policy == nil || policy.Signal == "" {
+		return nil
+	}
+
+	if policy.Action != types.MemoryPressurePolicyActionSignal {
+		return fmt.Errorf("signal of memory pressure policy can only be used with action %s", types.MemoryPressurePolicyActionSignal)
+	}
+	if _, err := signal.ParseSignal(policy.Signal); err != nil {
+		return err
+	}
+	return nil
+}
+
+// shouldHandleMemoryPressure returns whether the action of memory pressure
+// policy should be taken for the pressure level. The caller should hold the
+// lock of container.
+func shouldHandleMemoryPressure(c *Container, level string, now time.Time) bool {
+	if c.HostConfig == nil || c.HostConfig.MemoryPressurePolicy == nil || !c.IsRunning() {
+		return false
+	}
+
+	policy := c.HostConfig.MemoryPressurePolicy
+	if policy.Action == "" || policy.Action == types.MemoryPressurePolicyActionNone {
+		return false
+	}
+
+	// the medium level is also reached when the level is critical.
+	if policy.Level != types.MemoryPressurePolicyLevelMedium && level != ctrd.MemoryPressureCritical {
+		return false
+	}
+
+	return now.Sub(c.memoryPressureHandledAt) >= memoryPressureCooldown
+}
+
+// handleMemoryPressure takes the action of memory pressure policy when the
+// memory of container is under pressure.
+func (mgr *ContainerManager) handleMemoryPressure(ctx context.Context, id, action string, attributes map[string]string) error {
+	if action != ctrd.MemoryPressureAction {
+		return nil
+	}
+
+	c, err := mgr.container(id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.Lock()
+	handle := shouldHandleMemoryPressure(c, attributes["level"], now)
+	var policy types.MemoryPressurePolicy
+	if handle {
+		c.memoryPressureHandledAt = now
+		policy = *c.HostConfig.MemoryPressurePolicy
+	}
+	c.Unlock()
+
+	if handle {
+		// restarting the container stops the watcher which sends the event,
+		// so the action is taken in background.
+		go mgr.takeMemoryPressureAction(ctx, c, policy)
+	}
+	return nil
+}
+
+// takeMemoryPressureAction signals or restarts the container by the policy.
+func (mgr *ContainerManager) takeMemoryPressureAction(ctx context.Context, c *Container, policy types.MemoryPressurePolicy) {
+	log.With(ctx).Infof("take action %s of memory pressure policy on container %s", policy.Action, c.ID)
+
+	var err error
+	switch policy.Action {
+	case types.MemoryPressurePolicyActionSignal:
+		sig := syscall.SIGTERM
+		if policy.Signal != "" {
+			sig, err = signal.ParseSignal(policy.Signal)
+			if err != nil {
+				break
+			}
+		}
+		if err = mgr.Client.KillContainer(ctx, c.ID, sig); err == nil {
+			c.Lock()
+			mgr.LogContainerEventWithAttributes(ctx, c, "kill", map[string]string{"signal": strconv.Itoa(int(sig))})
+			c.Unlock()
+		}
+	case types.MemoryPressurePolicyActionRestart:
+		err = mgr.Restart(ctx, c.ID, 0)
+	}
+
+	if err != nil {
+		log.With(ctx).Errorf("failed to take action %s of memory pressure policy on container %s: %v", policy.Action, c.ID, err)
+	}
+}
@@ -0,0 +1,67 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMemoryPressurePolicy(t *testing.T) {
+	for _, policy := range []*types.MemoryPressurePolicy{
+		nil,
+		{Action: "restart"},
+		{Action: "signal"},
+		{Action: "signal", Signal: "SIGUSR1"},
+		{Action: "signal", Signal: "9"},
+	} {
+		assert.NoError(t, validateMemoryPressurePolicy(policy), "%v", policy)
+	}
+
+	for _, policy := range []*types.MemoryPressurePolicy{
+		{Action: "restart", Signal: "SIGUSR1"},
+		{Action: "signal", Signal: "SIGFOO"},
+	} {
+		assert.Error(t, validateMemoryPressurePolicy(policy), "%v", policy)
+	}
+}
+
+func TestShouldHandleMemoryPressure(t *testing.T) {
+	now := time.Now()
+	newContainer := func(policy *types.MemoryPressurePolicy) *Container {
+		return &Container{
+			State:      &types.ContainerState{Status: types.StatusRunning, Running: true},
+			HostConfig: &types.HostConfig{MemoryPressurePolicy: policy},
+		}
+	}
+
+	for _, tc := range []struct {
+		policy   *types.MemoryPressurePolicy
+		level    string
+		expected bool
+	}{
+		{nil, ctrd.MemoryPressureCritical, false},
+		{&types.MemoryPressurePolicy{Action: "none"}, ctrd.MemoryPressureCritical, false},
+		{&types.MemoryPressurePolicy{Action: "restart"}, ctrd.MemoryPressureMedium, false},
+		{&types.MemoryPressurePolicy{Action: "restart"}, ctrd.MemoryPressureCritical, true},
+		{&types.MemoryPressurePolicy{Action: "signal", Level: "medium"}, ctrd.MemoryPressureMedium, true},
+		{&types.MemoryPressurePolicy{Action: "signal", Level: "medium"}, ctrd.MemoryPressureCritical, true},
+	} {
+		c := newContainer(tc.policy)
+		assert.Equal(t, tc.expected, shouldHandleMemoryPressure(c, tc.level, now), "%v %s", tc.policy, tc.level)
+	}
+
+	// the action is taken once in cooldown.
+	c := newContainer(&types.MemoryPressurePolicy{Action: "restart"})
+	c.memoryPressureHandledAt = now.Add(-time.Second)
+	assert.False(t, shouldHandleMemoryPressure(c, ctrd.MemoryPressureCritical, now))
+	c.memoryPressureHandledAt = now.Add(-memoryPressureCooldown)
+	assert.True(t, shouldHandleMemoryPressure(c, ctrd.MemoryPressureCritical, now))
+
+	// the container not running is not handled.
+	c.State.Status, c.State.Running = types.StatusStopped, false
+	assert.False(t, shouldHandleMemoryPressure(c, ctrd.MemoryPressureCritical, now))
+}
@@ -0,0 +1,82 @@
+package mgr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/pkg/errors"
+)
+
+// networkContainerID returns the ID or name of container whose network
+// namespace is joined in the network mode, it's empty for the other modes.
+func networkContainerID(mode string) string {
+	if !IsContainer(mode) {
+		return ""
+	}
+	return strings.SplitN(mode, ":", 2)[1]
+}
+
+// validateNetworkContainer validates the container which joins the network
+// namespace of another container, the name of the owner is replaced with its
+// ID, so that the network namespace is still joined after it is renamed.
+func (mgr *ContainerManager) validateNetworkContainer(c *Container) error {
+	hostConfig := c.HostConfig
+	name := networkContainerID(hostConfig.NetworkMode)
+	if name == "" {
+		return nil
+	}
+
+	owner, err := mgr.container(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find container %s to join network namespace", name)
+	}
+	if owner.ID == c.ID {
+		return fmt.Errorf("can not join own network")
+	}
+
+	// the network is set up by the owner, so the network options of the
+	// container are meaningless.
+	switch {
+	case len(hostConfig.PortBindings) > 0 || hostConfig.PublishAllPorts:
+		return fmt.Errorf("conflicting options: port publishing and the container type network mode")
+	case len(hostConfig.DNS) > 0 || len(hostConfig.DNSOptions) > 0 || len(hostConfig.DNSSearch) > 0:
+		return fmt.Errorf("conflicting options: dns and the container type network mode")
+	case len(hostConfig.ExtraHosts) > 0:
+		return fmt.Errorf("conflicting options: custom host-to-IP mapping and the container type network mode")
+	case c.Config.MacAddress != "":
+		return fmt.Errorf("conflicting options: mac-address and the container type network mode")
+	case c.NetworkSettings != nil && len(c.NetworkSettings.Networks) > 0:
+		return fmt.Errorf("conflicting options: networking config and the container type network mode")
+	}
+
+	hostConfig.NetworkMode = "container:" + owner.ID
+	return nil
+}
+
+// networkJoiners returns the running containers which join the network
+// namespace of container.
+func (mgr *ContainerManager) networkJoiners(id string) []string {
+	var joiners []string
+	for _, obj := range mgr.cache.Values(nil) {
+		c, ok := obj.(*Container)
+		if !ok || c.ID == id || c.HostConfig == nil {
+			continue
+		}
+
+		if networkContainerID(c.HostConfig.NetworkMode) == id && c.IsRunningOrPaused() {
+			joiners = append(joiners, c.ID)
+		}
+	}
+	return joiners
+}
+
+// checkNetworkJoiners returns error if the network namespace of container is
+// joined by the running containers, since they lose the network if it's gone.
+func (mgr *ContainerManager) checkNetworkJoiners(c *Container) error {
+	if joiners := mgr.networkJoiners(c.ID); len(joiners) > 0 {
+		return errors.Wrapf(errtypes.ErrInUse, "network namespace of container %s is joined by running containers %s", c.ID, strings.Join(joiners, ", "))
+	}
+	return nil
+}
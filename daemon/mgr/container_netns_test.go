@@ -0,0 +1,60 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newNetworkJoiner(id, mode string) *Container {
+	c := newDependencyTestContainer(id)
+	c.HostConfig.NetworkMode = mode
+	c.NetworkSettings = &types.NetworkSettings{}
+	return c
+}
+
+func TestValidateNetworkContainer(t *testing.T) {
+	owner := newDependencyTestContainer("owner")
+	mgr := newDependencyTestManager(owner)
+
+	c := newNetworkJoiner("joiner", "container:name-owner")
+	assert.NoError(t, mgr.validateNetworkContainer(c))
+	assert.Equal(t, "container:owner", c.HostConfig.NetworkMode)
+
+	c = newNetworkJoiner("owner", "container:owner")
+	assert.Error(t, mgr.validateNetworkContainer(c))
+
+	c = newNetworkJoiner("joiner", "container:owner")
+	c.HostConfig.PortBindings = types.PortMap{"80/tcp": []types.PortBinding{{HostPort: "8080"}}}
+	assert.Error(t, mgr.validateNetworkContainer(c))
+
+	c = newNetworkJoiner("joiner", "container:owner")
+	c.HostConfig.DNS = []string{"8.8.8.8"}
+	assert.Error(t, mgr.validateNetworkContainer(c))
+
+	c = newNetworkJoiner("joiner", "container:owner")
+	c.NetworkSettings.Networks = map[string]*types.EndpointSettings{"mynet": {}}
+	assert.Error(t, mgr.validateNetworkContainer(c))
+
+	c = newNetworkJoiner("joiner", "bridge")
+	c.HostConfig.DNS = []string{"8.8.8.8"}
+	assert.NoError(t, mgr.validateNetworkContainer(c))
+}
+
+func TestCheckNetworkJoiners(t *testing.T) {
+	owner := newDependencyTestContainer("owner")
+	joiner := newNetworkJoiner("joiner", "container:owner")
+	other := newNetworkJoiner("other", "bridge")
+	mgr := newDependencyTestManager(owner, joiner, other)
+
+	assert.Equal(t, []string{"joiner"}, mgr.networkJoiners("owner"))
+	assert.True(t, errtypes.IsInUse(mgr.checkNetworkJoiners(owner)))
+	assert.NoError(t, mgr.checkNetworkJoiners(joiner))
+
+	// the stopped joiner doesn't use the network namespace.
+	joiner.State = &types.ContainerState{Status: types.StatusStopped}
+	assert.NoError(t, mgr.checkNetworkJoiners(owner))
+}
@@ -0,0 +1,272 @@
+package mgr
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/system"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// numaPolicySpread spreads the CPUs across the NUMA nodes.
+	numaPolicySpread = "spread"
+
+	// numaPolicyPack places the CPUs into as few NUMA nodes as possible.
+	numaPolicyPack = "pack"
+
+	// defaultCPUPeriod is the default cfs period in microseconds.
+	defaultCPUPeriod = 100000
+)
+
+// resolveNumaPolicy validates the cpuset of container against the NUMA
+// topology of host, and resolves the NUMA placement policy into cpuset. It is
+// called when creating the container, so that the conflicts are reported
+// before the container runs.
+func (mgr *ContainerManager) resolveNumaPolicy(c *Container) error {
+	r := &c.HostConfig.Resources
+	if c.HostConfig.NumaPolicy == "" && r.CpusetCpus == "" && r.CpusetMems == "" {
+		return nil
+	}
+
+	nodes, err := system.GetNumaTopology()
+	if err != nil {
+		return errors.Wrap(err, "failed to get NUMA topology of host")
+	}
+	return placeNuma(nodes, c.HostConfig.NumaPolicy, r, mgr.cpusetUsage(c.ID))
+}
+
+// cpusetUsage returns the number of other containers pinned on each CPU.
+func (mgr *ContainerManager) cpusetUsage(id string) map[int]int {
+	usage := map[int]int{}
+	for _, obj := range mgr.cache.Values(nil) {
+		c, ok := obj.(*Container)
+		if !ok || c.ID == id || c.HostConfig == nil {
+			continue
+		}
+
+		cpus, err := system.ParseCPUList(c.HostConfig.CpusetCpus)
+		if err != nil {
+			continue
+		}
+		for _, cpu := range cpus {
+			usage[cpu]++
+		}
+	}
+	return usage
+}
+
+// numaCPUCount returns the number of CPUs to place by the cpu quota, it
+// returns 0 if there is no quota.
+func numaCPUCount(r *types.Resources) int {
+	switch {
+	case r.NanoCpus > 0:
+		return int((r.NanoCpus + nanoSecondsPerSecond - 1) / nanoSecondsPerSecond)
+	case r.CPUQuota > 0:
+		period := r.CPUPeriod
+		if period <= 0 {
+			period = defaultCPUPeriod
+		}
+		return int((r.CPUQuota + period - 1) / period)
+	default:
+		return 0
+	}
+}
+
+// placeNuma validates the cpuset of resources against the NUMA nodes, and
+// sets the cpuset by the policy. The usage is the number of containers pinned
+// on each CPU, the least used CPUs are preferred.
+func placeNuma(nodes []system.NumaNode, policy string, r *types.Resources, usage map[int]int) error {
+	cpus, err := system.ParseCPUList(r.CpusetCpus)
+	if err != nil {
+		return errors.Wrap(err, "invalid cpuset-cpus")
+	}
+	mems, err := system.ParseCPUList(r.CpusetMems)
+	if err != nil {
+		return errors.Wrap(err, "invalid cpuset-mems")
+	}
+
+	nodeOfCPU := map[int]int{}
+	nodeByID := map[int]system.NumaNode{}
+	for _, n := range nodes {
+		nodeByID[n.ID] = n
+		for _, cpu := range n.CPUs {
+			nodeOfCPU[cpu] = n.ID
+		}
+	}
+	for _, cpu := range cpus {
+		if _, ok := nodeOfCPU[cpu]; !ok {
+			return fmt.Errorf("cpu %d of cpuset-cpus %s does not exist on host", cpu, r.CpusetCpus)
+		}
+	}
+	for _, mem := range mems {
+		if _, ok := nodeByID[mem]; !ok {
+			return fmt.Errorf("NUMA node %d of cpuset-mems %s does not exist on host", mem, r.CpusetMems)
+		}
+	}
+
+	switch policy {
+	case "":
+		return nil
+	case numaPolicySpread, numaPolicyPack:
+	default:
+		return fmt.Errorf("invalid NUMA policy %s, should be %s or %s", policy, numaPolicySpread, numaPolicyPack)
+	}
+
+	// only the memory nodes are resolved for the given CPUs.
+	if len(cpus) != 0 {
+		if len(mems) != 0 {
+			return fmt.Errorf("conflicting options: NUMA policy %s, cpuset-cpus and cpuset-mems", policy)
+		}
+
+		used := map[int]bool{}
+		for _, cpu := range cpus {
+			if !used[nodeOfCPU[cpu]] {
+				used[nodeOfCPU[cpu]] = true
+				mems = append(mems, nodeOfCPU[cpu])
+			}
+		}
+		r.CpusetMems = system.FormatCPUList(mems)
+		return nil
+	}
+
+	var candidates []system.NumaNode
+	total := 0
+	for _, n := range nodes {
+		if len(n.CPUs) == 0 || (len(mems) != 0 && !containsInt(mems, n.ID)) {
+			continue
+		}
+		candidates = append(candidates, n)
+		total += len(n.CPUs)
+	}
+
+	count := numaCPUCount(r)
+	if total == 0 || count > total {
+		return fmt.Errorf("NUMA policy %s cannot place %d CPUs, only %d CPUs are available", policy, count, total)
+	}
+
+	var picked map[int][]int
+	if policy == numaPolicyPack {
+		picked = packNuma(candidates, count, usage)
+	} else {
+		picked = spreadNuma(candidates, count, usage)
+	}
+
+	cpus, mems = nil, nil
+	for id, ids := range picked {
+		mems = append(mems, id)
+		cpus = append(cpus, ids...)
+	}
+	r.CpusetCpus = system.FormatCPUList(cpus)
+	r.CpusetMems = system.FormatCPUList(mems)
+	return nil
+}
+
+// packNuma places the CPUs into the nodes with most free CPUs, and only one
+// node is used if it has enough CPUs. The least used node is picked as a whole
+// if count is 0.
+func packNuma(nodes []system.NumaNode, count int, usage map[int]int) map[int][]int {
+	if count == 0 {
+		best := nodes[0]
+		for _, n := range nodes[1:] {
+			// compare the average usage of CPUs.
+			if nodeUsage(n, usage)*len(best.CPUs) < nodeUsage(best, usage)*len(n.CPUs) {
+				best = n
+			}
+		}
+		return map[int][]int{best.ID: best.CPUs}
+	}
+
+	ordered := append([]system.NumaNode(nil), nodes...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return freeCPUs(ordered[i], usage) > freeCPUs(ordered[j], usage)
+	})
+
+	for _, n := range ordered {
+		if len(n.CPUs) >= count {
+			return map[int][]int{n.ID: leastUsedCPUs(n, usage)[:count]}
+		}
+	}
+
+	picked := map[int][]int{}
+	for _, n := range ordered {
+		cpus := leastUsedCPUs(n, usage)
+		if len(cpus) > count {
+			cpus = cpus[:count]
+		}
+		picked[n.ID] = cpus
+		if count -= len(cpus); count == 0 {
+			break
+		}
+	}
+	return picked
+}
+
+// spreadNuma picks the least used CPU from the nodes in turn. All the CPUs of
+// nodes are used if count is 0.
+func spreadNuma(nodes []system.NumaNode, count int, usage map[int]int) map[int][]int {
+	picked := map[int][]int{}
+	if count == 0 {
+		for _, n := range nodes {
+			picked[n.ID] = n.CPUs
+		}
+		return picked
+	}
+
+	queues := make([][]int, len(nodes))
+	for i, n := range nodes {
+		queues[i] = leastUsedCPUs(n, usage)
+	}
+	for count > 0 {
+		for i, n := range nodes {
+			if count == 0 || len(queues[i]) == 0 {
+				continue
+			}
+			picked[n.ID] = append(picked[n.ID], queues[i][0])
+			queues[i] = queues[i][1:]
+			count--
+		}
+	}
+	return picked
+}
+
+// leastUsedCPUs returns the CPUs of node sorted by usage.
+func leastUsedCPUs(n system.NumaNode, usage map[int]int) []int {
+	cpus := append([]int(nil), n.CPUs...)
+	sort.SliceStable(cpus, func(i, j int) bool {
+		return usage[cpus[i]] < usage[cpus[j]]
+	})
+	return cpus
+}
+
+// nodeUsage returns the total usage of CPUs in node.
+func nodeUsage(n system.NumaNode, usage map[int]int) int {
+	total := 0
+	for _, cpu := range n.CPUs {
+		total += usage[cpu]
+	}
+	return total
+}
+
+// freeCPUs returns the number of CPUs in node not used by any container.
+func freeCPUs(n system.NumaNode, usage map[int]int) int {
+	free := 0
+	for _, cpu := range n.CPUs {
+		if usage[cpu] == 0 {
+			free++
+		}
+	}
+	return free
+}
+
+// containsInt returns whether the slice contains the value.
+func containsInt(s []int, v int) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/system"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNumaNodes = []system.NumaNode{
+	{ID: 0, CPUs: []int{0, 1, 2, 3}},
+	{ID: 1, CPUs: []int{4, 5, 6, 7}},
+	{ID: 2, CPUs: []int{}},
+}
+
+func TestNumaCPUCount(t *testing.T) {
+	assert.Equal(t, 0, numaCPUCount(&types.Resources{}))
+	assert.Equal(t, 2, numaCPUCount(&types.Resources{NanoCpus: 1500000000}))
+	assert.Equal(t, 1, numaCPUCount(&types.Resources{CPUQuota: 100000}))
+	assert.Equal(t, 3, numaCPUCount(&types.Resources{CPUQuota: 250000, CPUPeriod: 100000}))
+}
+
+func TestPlaceNuma(t *testing.T) {
+	for _, tc := range []struct {
+		policy    string
+		resources types.Resources
+		usage     map[int]int
+		cpus      string
+		mems      string
+	}{
+		// only validated without policy.
+		{"", types.Resources{CpusetCpus: "0-5", CpusetMems: "2"}, nil, "0-5", "2"},
+		// the memory nodes of given CPUs.
+		{numaPolicyPack, types.Resources{CpusetCpus: "3-4"}, nil, "3-4", "0-1"},
+		// the least used node as a whole.
+		{numaPolicyPack, types.Resources{}, map[int]int{0: 1}, "4-7", "1"},
+		{numaPolicyPack, types.Resources{CPUQuota: 200000}, map[int]int{4: 1}, "0-1", "0"},
+		{numaPolicyPack, types.Resources{CPUQuota: 300000}, map[int]int{0: 1, 1: 1}, "4-6", "1"},
+		{numaPolicyPack, types.Resources{CPUQuota: 600000}, nil, "0-5", "0-1"},
+		{numaPolicyPack, types.Resources{CPUQuota: 200000, CpusetMems: "1"}, nil, "4-5", "1"},
+		{numaPolicySpread, types.Resources{}, nil, "0-7", "0-1"},
+		{numaPolicySpread, types.Resources{CPUQuota: 200000}, nil, "0,4", "0-1"},
+		{numaPolicySpread, types.Resources{CPUQuota: 300000}, map[int]int{0: 1}, "1-2,4", "0-1"},
+	} {
+		r := tc.resources
+		assert.NoError(t, placeNuma(testNumaNodes, tc.policy, &r, tc.usage), "%s %+v", tc.policy, tc.resources)
+		assert.Equal(t, tc.cpus, r.CpusetCpus, "%s %+v", tc.policy, tc.resources)
+		assert.Equal(t, tc.mems, r.CpusetMems, "%s %+v", tc.policy, tc.resources)
+	}
+
+	for _, tc := range []struct {
+		policy    string
+		resources types.Resources
+	}{
+		{"", types.Resources{CpusetCpus: "8"}},
+		{"", types.Resources{CpusetMems: "3"}},
+		{"", types.Resources{CpusetCpus: "a"}},
+		{numaPolicyPack, types.Resources{CpusetCpus: "0", CpusetMems: "0"}},
+		{numaPolicyPack, types.Resources{CPUQuota: 900000}},
+		{numaPolicySpread, types.Resources{CPUQuota: 500000, CpusetMems: "0"}},
+		{numaPolicySpread, types.Resources{CpusetMems: "2"}},
+	} {
+		r := tc.resources
+		assert.Error(t, placeNuma(testNumaNodes, tc.policy, &r, nil), "%s %+v", tc.policy, tc.resources)
+	}
+}
@@ -0,0 +1,151 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+// PauseContainers pauses a group of running containers at the same time,
+// none of them is paused if any fails. It returns IDs of the paused
+// containers.
+func (mgr *ContainerManager) PauseContainers(ctx context.Context, names []string, filter map[string][]string) ([]string, error) {
+	cs, err := mgr.selectContainers(ctx, names, filter)
+	if err != nil {
+		return nil, err
+	}
+	return mgr.freezeContainers(ctx, cs, true)
+}
+
+// UnpauseContainers unpauses a group of paused containers at the same time,
+// none of them is unpaused if any fails. It returns IDs of the unpaused
+// containers.
+func (mgr *ContainerManager) UnpauseContainers(ctx context.Context, names []string, filter map[string][]string) ([]string, error) {
+	cs, err := mgr.selectContainers(ctx, names, filter)
+	if err != nil {
+		return nil, err
+	}
+	return mgr.freezeContainers(ctx, cs, false)
+}
+
+// selectContainers returns the containers specified by names and the ones
+// matching the filter, they are sorted by ID.
+func (mgr *ContainerManager) selectContainers(ctx context.Context, names []string, filter map[string][]string) ([]*Container, error) {
+	if len(names) == 0 && len(filter) == 0 {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "containers should be specified by names or filters")
+	}
+
+	selected := make(map[string]*Container)
+	for _, name := range names {
+		c, err := mgr.container(name)
+		if err != nil {
+			return nil, err
+		}
+		selected[c.ID] = c
+	}
+
+	if len(filter) > 0 {
+		cs, err := mgr.List(ctx, &ContainerListOption{All: true, Filter: filter})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cs {
+			selected[c.ID] = c
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, errors.Wrap(errtypes.ErrNotfound, "no container matches the filters")
+	}
+
+	cs := make([]*Container, 0, len(selected))
+	for _, c := range selected {
+		cs = append(cs, c)
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].ID < cs[j].ID
+	})
+	return cs, nil
+}
+
+// freezeContainers pauses or unpauses the containers through cgroup freezer
+// concurrently, the containers are rolled back if any fails. The containers
+// should be sorted by ID, so that they are locked in the same order.
+func (mgr *ContainerManager) freezeContainers(ctx context.Context, cs []*Container, pause bool) ([]string, error) {
+	action := "pause"
+	if !pause {
+		action = "unpause"
+	}
+
+	freeze := func(id string, pause bool) error {
+		if pause {
+			return mgr.Client.PauseContainer(ctx, id)
+		}
+		return mgr.Client.UnpauseContainer(ctx, id)
+	}
+
+	for _, c := range cs {
+		c.Lock()
+		defer c.Unlock()
+	}
+
+	for _, c := range cs {
+		if pause && !c.IsRunning() {
+			return nil, fmt.Errorf("status(%s) of container %s is not running", c.State.Status, c.ID)
+		}
+		if !pause && !c.State.Paused {
+			return nil, fmt.Errorf("status(%s) of container %s is not paused", c.State.Status, c.ID)
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(cs))
+	)
+	for i, c := range cs {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			errs[i] = freeze(id, pause)
+		}(i, c.ID)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		for j, c := range cs {
+			if errs[j] != nil {
+				continue
+			}
+			if rerr := freeze(c.ID, !pause); rerr != nil {
+				log.With(ctx).Errorf("failed to rollback %s of container %s: %v", action, c.ID, rerr)
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to %s container %s", action, cs[i].ID)
+	}
+
+	ids := make([]string, 0, len(cs))
+	for _, c := range cs {
+		if pause {
+			c.SetStatusPaused()
+		} else {
+			c.SetStatusUnpaused()
+		}
+
+		if err := c.Write(mgr.Store); err != nil {
+			log.With(ctx).Errorf("failed to update meta of container %s: %v", c.ID, err)
+		}
+		mgr.LogContainerEvent(ctx, c, action)
+		ids = append(ids, c.ID)
+	}
+	return ids, nil
+}
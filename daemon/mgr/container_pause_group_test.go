@@ -0,0 +1,52 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectContainers(t *testing.T) {
+	a := newDependencyTestContainer("a")
+	b := newDependencyTestContainer("b")
+	c := newDependencyTestContainer("c")
+	a.Config.Labels = map[string]string{"app": "web"}
+	c.Config.Labels = map[string]string{"app": "web"}
+	mgr := newDependencyTestManager(c, b, a)
+	ctx := context.Background()
+
+	_, err := mgr.selectContainers(ctx, nil, nil)
+	assert.True(t, errtypes.IsInvalidParam(errors.Cause(err)))
+
+	_, err = mgr.selectContainers(ctx, nil, map[string][]string{"label": {"app=db"}})
+	assert.True(t, errtypes.IsNotfound(errors.Cause(err)))
+
+	cs, err := mgr.selectContainers(ctx, []string{"name-b", "a"}, map[string][]string{"label": {"app=web"}})
+	assert.NoError(t, err)
+	var ids []string
+	for _, c := range cs {
+		ids = append(ids, c.ID)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
+
+func TestFreezeContainersInvalidStatus(t *testing.T) {
+	a := newDependencyTestContainer("a")
+	b := newDependencyTestContainer("b")
+	b.State = &types.ContainerState{Status: types.StatusExited}
+	mgr := newDependencyTestManager(a, b)
+	ctx := context.Background()
+
+	// nothing is paused if any container is not running.
+	_, err := mgr.freezeContainers(ctx, []*Container{a, b}, true)
+	assert.Error(t, err)
+	assert.False(t, a.State.Paused)
+
+	_, err = mgr.freezeContainers(ctx, []*Container{a}, false)
+	assert.Error(t, err)
+}
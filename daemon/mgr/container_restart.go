@@ -0,0 +1,161 @@
+package mgr
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+)
+
+const (
+	// restartBackoffMin is the delay of the first restart by restart policy.
+	restartBackoffMin = 100 * time.Millisecond
+
+	// restartBackoffMax is the max delay between two restarts, the delay is
+	// doubled for every restart until it reaches the max.
+	restartBackoffMax = time.Minute
+
+	// restartResetPeriod is the time for a container to run successfully,
+	// the delay is reset to restartBackoffMin if the container runs longer.
+	restartResetPeriod = 10 * time.Second
+)
+
+// shouldRestart returns whether the container should be restarted by its
+// restart policy. The container stopped by user is only restarted by policy
+// always when daemon starts.
+func shouldRestart(c *Container, onBoot bool) bool {
+	if c.HostConfig == nil || c.HostConfig.RestartPolicy == nil || c.State.Dead {
+		return false
+	}
+
+	policy := (*ContainerRestartPolicy)(c.HostConfig.RestartPolicy)
+	if policy.IsNone() {
+		return false
+	}
+
+	exited := c.State.Status == types.StatusExited || c.IsRestarting()
+	switch {
+	case policy.IsAlways():
+		return exited || (onBoot && c.State.Status == types.StatusStopped)
+	case policy.IsUnlessStopped():
+		return exited
+	case policy.IsOnFailure():
+		if !exited || c.State.ExitCode == 0 {
+			return false
+		}
+		return policy.MaximumRetryCount == 0 || c.RestartCount < policy.MaximumRetryCount
+	default:
+		return false
+	}
+}
+
+// nextRestartBackoff returns the delay before restarting the container.
+func nextRestartBackoff(c *Container) time.Duration {
+	backoff := c.restartBackoff * 2
+	if backoff < restartBackoffMin {
+		backoff = restartBackoffMin
+	}
+	if backoff > restartBackoffMax {
+		backoff = restartBackoffMax
+	}
+	return backoff
+}
+
+// handleExitByPolicy is called when the container exits, the backoff is reset
+// if the container has run successfully before restarting it. The caller
+// should hold the lock of container.
+func (mgr *ContainerManager) handleExitByPolicy(ctx context.Context, c *Container) error {
+	started, serr := time.Parse(utils.TimeLayout, c.State.StartedAt)
+	finished, ferr := time.Parse(utils.TimeLayout, c.State.FinishedAt)
+	if serr == nil && ferr == nil && finished.Sub(started) >= restartResetPeriod {
+		c.restartBackoff = 0
+	}
+	return mgr.scheduleRestart(ctx, c, false)
+}
+
+// scheduleRestart restarts the exited container after backoff if its restart
+// policy asks. The caller should hold the lock of container.
+func (mgr *ContainerManager) scheduleRestart(ctx context.Context, c *Container, onBoot bool) error {
+	c.cancelRestart()
+
+	if !shouldRestart(c, onBoot) {
+		c.restartBackoff = 0
+		return nil
+	}
+
+	// the container has been removed.
+	if container, err := mgr.container(c.ID); err != nil || container != c {
+		return nil
+	}
+
+	backoff := nextRestartBackoff(c)
+	c.restartBackoff = backoff
+	c.SetStatusRestarting()
+
+	// persist the state, so the restart is continued after daemon restarts.
+	if err := c.Write(mgr.Store); err != nil {
+		return err
+	}
+
+	log.With(ctx).Infof("restart container %s by policy %s after %v", c.ID, c.HostConfig.RestartPolicy.Name, backoff)
+
+	cancel := make(chan struct{})
+	c.restartCancel = cancel
+	go func() {
+		select {
+		case <-cancel:
+			return
+		case <-time.After(backoff):
+		}
+		mgr.restartByPolicy(ctx, c, cancel)
+	}()
+	return nil
+}
+
+// restartByPolicy starts the container if the pending restart isn't canceled.
+func (mgr *ContainerManager) restartByPolicy(ctx context.Context, c *Container, cancel chan struct{}) {
+	// the dependencies are started without the lock of container, since
+	// it may take a long time to wait for them to be healthy.
+	depErr := mgr.startDependencies(ctx, c)
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.restartCancel != cancel {
+		return
+	}
+	c.restartCancel = nil
+
+	c.RestartCount++
+	err := depErr
+	if err == nil {
+		err = mgr.startContainer(ctx, c, &types.ContainerStartOptions{DetachKeys: c.DetachKeys})
+	}
+	if err == nil {
+		mgr.LogContainerEvent(ctx, c, "start")
+		return
+	}
+
+	log.With(ctx).Errorf("failed to restart container %s by policy: %v", c.ID, err)
+
+	// failure to start is treated as an exit, so that it is retried with
+	// a longer backoff.
+	c.SetStatusExited(c.State.ExitCode, err.Error())
+	if err := mgr.scheduleRestart(ctx, c, false); err != nil {
+		log.With(ctx).Errorf("failed to schedule restart of container %s: %v", c.ID, err)
+	}
+	if err := c.Write(mgr.Store); err != nil {
+		log.With(ctx).Errorf("failed to update meta of container %s: %v", c.ID, err)
+	}
+}
+
+// cancelRestart cancels the pending restart of container, the caller should
+// hold the lock of container.
+func (c *Container) cancelRestart() {
+	if c.restartCancel != nil {
+		close(c.restartCancel)
+		c.restartCancel = nil
+	}
+}
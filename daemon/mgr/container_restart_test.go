@@ -0,0 +1,61 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldRestart(t *testing.T) {
+	newContainer := func(policy string, maxRetry int64, status types.Status, exitCode, restartCount int64) *Container {
+		return &Container{
+			HostConfig: &types.HostConfig{
+				RestartPolicy: &types.RestartPolicy{Name: policy, MaximumRetryCount: maxRetry},
+			},
+			State: &types.ContainerState{
+				Status:   status,
+				ExitCode: exitCode,
+			},
+			RestartCount: restartCount,
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		c        *Container
+		onBoot   bool
+		expected bool
+	}{
+		{name: "no policy", c: newContainer("no", 0, types.StatusExited, 1, 0), expected: false},
+		{name: "always exited", c: newContainer("always", 0, types.StatusExited, 0, 0), expected: true},
+		{name: "always stopped", c: newContainer("always", 0, types.StatusStopped, 0, 0), expected: false},
+		{name: "always stopped on boot", c: newContainer("always", 0, types.StatusStopped, 0, 0), onBoot: true, expected: true},
+		{name: "always created on boot", c: newContainer("always", 0, types.StatusCreated, 0, 0), onBoot: true, expected: false},
+		{name: "unless-stopped restarting", c: newContainer("unless-stopped", 0, types.StatusRestarting, 0, 0), expected: true},
+		{name: "unless-stopped stopped on boot", c: newContainer("unless-stopped", 0, types.StatusStopped, 0, 0), onBoot: true, expected: false},
+		{name: "on-failure exit 0", c: newContainer("on-failure", 0, types.StatusExited, 0, 0), expected: false},
+		{name: "on-failure unlimited", c: newContainer("on-failure", 0, types.StatusExited, 1, 100), expected: true},
+		{name: "on-failure under max", c: newContainer("on-failure", 3, types.StatusExited, 1, 2), expected: true},
+		{name: "on-failure reach max", c: newContainer("on-failure", 3, types.StatusExited, 1, 3), expected: false},
+	} {
+		assert.Equal(t, tc.expected, shouldRestart(tc.c, tc.onBoot), tc.name)
+	}
+}
+
+func TestNextRestartBackoff(t *testing.T) {
+	c := &Container{}
+	for _, expected := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+	} {
+		c.restartBackoff = nextRestartBackoff(c)
+		assert.Equal(t, expected, c.restartBackoff)
+	}
+
+	c.restartBackoff = 50 * time.Second
+	assert.Equal(t, restartBackoffMax, nextRestartBackoff(c))
+}
@@ -0,0 +1,203 @@
+package mgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// bundleConfigFile is the runtime config file in OCI bundle.
+const bundleConfigFile = "config.json"
+
+// hostRootfs returns whether the container is created from the existing
+// rootfs on host, which is neither mounted nor removed by pouchd.
+func (c *Container) hostRootfs() bool {
+	return c.HostConfig != nil && c.HostConfig.Rootfs != nil
+}
+
+// prepareRootfs validates the rootfs on host specified by user, and returns
+// the rootfs directory. If the path is an OCI bundle, the process of bundle
+// is returned as the image config to merge into the container config.
+func (mgr *ContainerManager) prepareRootfs(config *types.ContainerCreateConfig) (string, ocispec.ImageConfig, error) {
+	rc := config.HostConfig.Rootfs
+
+	if config.Image != "" {
+		return "", ocispec.ImageConfig{}, errors.Wrapf(errtypes.ErrInvalidParam, "image %s conflicts with rootfs %s", config.Image, rc.Path)
+	}
+
+	if _, ok := config.HostConfig.StorageOpt[storageOptSize]; ok {
+		return "", ocispec.ImageConfig{}, errors.Wrapf(errtypes.ErrInvalidParam, "storage option size is not supported by rootfs %s", rc.Path)
+	}
+	for _, exp := range []string{"/", ".*"} {
+		if _, exist := config.DiskQuota[exp]; exist {
+			return "", ocispec.ImageConfig{}, errors.Wrapf(errtypes.ErrInvalidParam, "disk quota %s is not supported by rootfs %s", exp, rc.Path)
+		}
+	}
+
+	if rc.Owner != "" {
+		if _, _, err := parseRootfsOwner(rc.Owner); err != nil {
+			return "", ocispec.ImageConfig{}, err
+		}
+	}
+
+	if !filepath.IsAbs(rc.Path) {
+		return "", ocispec.ImageConfig{}, errors.Wrapf(errtypes.ErrInvalidParam, "rootfs %s must be an absolute path", rc.Path)
+	}
+
+	dir, err := mgr.checkRootfsDir(rc.Path)
+	if err != nil {
+		return "", ocispec.ImageConfig{}, err
+	}
+
+	configPath := filepath.Join(dir, bundleConfigFile)
+	if _, err := os.Stat(configPath); err != nil {
+		if !os.IsNotExist(err) {
+			return "", ocispec.ImageConfig{}, errors.Wrapf(err, "failed to stat %s", configPath)
+		}
+		rc.Bundle = false
+		return dir, ocispec.ImageConfig{}, nil
+	}
+
+	spec, err := readBundleSpec(configPath)
+	if err != nil {
+		return "", ocispec.ImageConfig{}, errors.Wrapf(errtypes.ErrInvalidParam, "invalid bundle %s: %v", rc.Path, err)
+	}
+
+	root := "rootfs"
+	if spec.Root != nil && spec.Root.Path != "" {
+		root = spec.Root.Path
+	}
+	if !filepath.IsAbs(root) {
+		root = filepath.Join(dir, root)
+	}
+
+	if dir, err = mgr.checkRootfsDir(root); err != nil {
+		return "", ocispec.ImageConfig{}, err
+	}
+	rc.Bundle = true
+	return dir, bundleImageConfig(spec.Process), nil
+}
+
+// checkRootfsDir resolves the path of rootfs, and checks it's a directory in
+// the rootfs dirs allowed by the daemon, which is neither the root of host nor
+// in the home directory of pouchd.
+func (mgr *ContainerManager) checkRootfsDir(path string) (string, error) {
+	dir, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "failed to resolve rootfs %s: %v", path, err)
+	}
+
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "failed to stat rootfs %s: %v", path, err)
+	}
+	if !fi.IsDir() {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "rootfs %s is not a directory", path)
+	}
+
+	if dir == "/" {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "rootfs %s cannot be the root directory of host", path)
+	}
+
+	if home := filepath.Clean(mgr.Config.HomeDir); isSubPath(dir, home) {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "rootfs %s cannot be in the home directory %s of pouchd", path, home)
+	}
+
+	// the rootfs is changed by pouchd such as its owner, so only the rootfs
+	// in the directories allowed by the daemon is accepted.
+	for _, allowed := range mgr.Config.RootfsDirs {
+		if allowedDir, err := filepath.EvalSymlinks(allowed); err == nil && isSubPath(dir, allowedDir) {
+			return dir, nil
+		}
+	}
+	return "", errors.Wrapf(errtypes.ErrInvalidParam, "rootfs %s is not in the rootfs dirs %v allowed by pouchd", path, mgr.Config.RootfsDirs)
+}
+
+// isSubPath returns whether path is dir or in dir.
+func isSubPath(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// readBundleSpec reads the runtime config of OCI bundle.
+func readBundleSpec(path string) (*specs.Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &specs.Spec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// bundleImageConfig converts the process of bundle to the image config, so
+// that it's merged into the container config like the image.
+func bundleImageConfig(process *specs.Process) ocispec.ImageConfig {
+	if process == nil {
+		return ocispec.ImageConfig{}
+	}
+
+	config := ocispec.ImageConfig{
+		Cmd:        process.Args,
+		Env:        process.Env,
+		WorkingDir: process.Cwd,
+	}
+	if process.User.UID != 0 || process.User.GID != 0 {
+		config.User = fmt.Sprintf("%d:%d", process.User.UID, process.User.GID)
+	}
+	return config
+}
+
+// parseRootfsOwner parses the owner of rootfs in the form `uid:gid`.
+func parseRootfsOwner(owner string) (int, int, error) {
+	parts := strings.Split(owner, ":")
+	if len(parts) != 2 {
+		return 0, 0, errors.Wrapf(errtypes.ErrInvalidParam, "invalid rootfs owner %s, it should be uid:gid", owner)
+	}
+
+	ids := make([]int, 2)
+	for i, part := range parts {
+		id, err := strconv.Atoi(part)
+		if err != nil || id < 0 {
+			return 0, 0, errors.Wrapf(errtypes.ErrInvalidParam, "invalid rootfs owner %s, it should be uid:gid", owner)
+		}
+		ids[i] = id
+	}
+	return ids[0], ids[1], nil
+}
+
+// chownRootfs changes the owner of all the files in rootfs, the symbolic
+// links themselves are changed rather than their targets. The mode is set
+// again after chown, since chown clears the setuid and setgid bits.
+func chownRootfs(rootfs, owner string) error {
+	uid, gid, err := parseRootfsOwner(owner)
+	if err != nil {
+		return err
+	}
+
+	return filepath.Walk(rootfs, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := os.Lchown(path, uid, gid); err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return nil
+		}
+		return os.Chmod(path, info.Mode())
+	})
+}
@@ -0,0 +1,121 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareRootfs(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-prepare-rootfs")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	home := filepath.Join(tmpDir, "home")
+	rootfs := filepath.Join(tmpDir, "rootfs")
+	bundle := filepath.Join(tmpDir, "bundle")
+	other := filepath.Join(tmpDir, "other")
+	for _, dir := range []string{home, rootfs, filepath.Join(bundle, "rootfs"), other} {
+		assert.NoError(t, os.MkdirAll(dir, 0755))
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(bundle, bundleConfigFile), []byte(`{
+	"process": {"user": {"uid": 1000, "gid": 100}, "args": ["sh"], "env": ["A=a"], "cwd": "/root"},
+	"root": {"path": "rootfs"}
+}`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "file"), nil, 0644))
+
+	mgr := &ContainerManager{Config: &config.Config{HomeDir: home, RootfsDirs: []string{rootfs, bundle}}}
+	newConfig := func(rc *types.RootfsConfig) *types.ContainerCreateConfig {
+		return &types.ContainerCreateConfig{HostConfig: &types.HostConfig{Rootfs: rc}}
+	}
+
+	rc := &types.RootfsConfig{Path: rootfs, Bundle: true}
+	dir, imageConfig, err := mgr.prepareRootfs(newConfig(rc))
+	assert.NoError(t, err)
+	assert.Equal(t, rootfs, dir)
+	assert.False(t, rc.Bundle)
+	assert.Empty(t, imageConfig.Cmd)
+
+	rc = &types.RootfsConfig{Path: bundle}
+	dir, imageConfig, err = mgr.prepareRootfs(newConfig(rc))
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(bundle, "rootfs"), dir)
+	assert.True(t, rc.Bundle)
+	assert.Equal(t, []string{"sh"}, imageConfig.Cmd)
+	assert.Equal(t, []string{"A=a"}, imageConfig.Env)
+	assert.Equal(t, "/root", imageConfig.WorkingDir)
+	assert.Equal(t, "1000:100", imageConfig.User)
+
+	for _, rc := range []*types.RootfsConfig{
+		{Path: "rootfs"},
+		{Path: "/"},
+		{Path: home},
+		{Path: other},
+		{Path: filepath.Join(tmpDir, "file")},
+		{Path: filepath.Join(tmpDir, "none")},
+		{Path: rootfs, Owner: "root"},
+	} {
+		_, _, err := mgr.prepareRootfs(newConfig(rc))
+		assert.True(t, errtypes.IsInvalidParam(err), rc.Path)
+	}
+
+	config := newConfig(&types.RootfsConfig{Path: rootfs})
+	config.Image = "busybox"
+	_, _, err = mgr.prepareRootfs(config)
+	assert.True(t, errtypes.IsInvalidParam(err))
+
+	config = newConfig(&types.RootfsConfig{Path: rootfs})
+	config.HostConfig.StorageOpt = map[string]string{storageOptSize: "10G"}
+	_, _, err = mgr.prepareRootfs(config)
+	assert.True(t, errtypes.IsInvalidParam(err))
+}
+
+func TestParseRootfsOwner(t *testing.T) {
+	uid, gid, err := parseRootfsOwner("1000:100")
+	assert.NoError(t, err)
+	assert.Equal(t, 1000, uid)
+	assert.Equal(t, 100, gid)
+
+	for _, owner := range []string{"", "1000", "root:root", "1000:", "-1:0", "1:2:3"} {
+		_, _, err := parseRootfsOwner(owner)
+		assert.Error(t, err, owner)
+	}
+}
+
+func TestChownRootfs(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("chown requires root")
+	}
+
+	tmpDir, err := ioutil.TempDir("", "test-chown-rootfs")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	bin := filepath.Join(tmpDir, "bin")
+	assert.NoError(t, ioutil.WriteFile(bin, nil, 0755))
+	assert.NoError(t, os.Chmod(bin, 0755|os.ModeSetuid|os.ModeSetgid))
+	assert.NoError(t, os.Symlink("bin", filepath.Join(tmpDir, "link")))
+
+	assert.NoError(t, chownRootfs(tmpDir, "1000:100"))
+
+	for _, path := range []string{tmpDir, bin, filepath.Join(tmpDir, "link")} {
+		info, err := os.Lstat(path)
+		assert.NoError(t, err)
+		st := info.Sys().(*syscall.Stat_t)
+		assert.Equal(t, uint32(1000), st.Uid, path)
+		assert.Equal(t, uint32(100), st.Gid, path)
+	}
+
+	// the setuid and setgid bits are kept.
+	info, err := os.Stat(bin)
+	assert.NoError(t, err)
+	assert.Equal(t, 0755|os.ModeSetuid|os.ModeSetgid, info.Mode())
+}
@@ -0,0 +1,120 @@
+package mgr
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/alibaba/pouch/ctrd"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+)
+
+const (
+	// runscPlatformLabel overrides the platform of runsc for container.
+	runscPlatformLabel = "pouch.runsc.platform"
+	// runscNetworkLabel overrides the network stack of runsc for container.
+	runscNetworkLabel = "pouch.runsc.network"
+	// runscDebugLogLabel overrides the debug log path of runsc for container.
+	runscDebugLogLabel = "pouch.runsc.debug_log"
+
+	// runscConfigFile is the config file of the gVisor shim generated for
+	// container.
+	runscConfigFile = "runsc.toml"
+)
+
+// isRunscRuntime returns true if the container runs in gVisor.
+func isRunscRuntime(c *Container) bool {
+	return c.HostConfig != nil && c.HostConfig.RuntimeType == ctrd.RuntimeTypeV2runscV1
+}
+
+// runscOptions returns the runsc options of container, the labels of
+// container override the options of daemon. It returns nil if the options
+// are not set at all.
+func runscOptions(c *Container, options interface{}) *ctrd.RunscOptions {
+	o := &ctrd.RunscOptions{}
+	if daemonOpts, ok := options.(*ctrd.RunscOptions); ok {
+		*o = *daemonOpts
+	}
+
+	if c.Config != nil {
+		if v, ok := c.Config.Labels[runscPlatformLabel]; ok {
+			o.Platform = v
+		}
+		if v, ok := c.Config.Labels[runscNetworkLabel]; ok {
+			o.Network = v
+		}
+		if v, ok := c.Config.Labels[runscDebugLogLabel]; ok {
+			o.DebugLog = v
+		}
+	}
+
+	if *o == (ctrd.RunscOptions{}) {
+		return nil
+	}
+	return o
+}
+
+// runscLogDir returns the directory which the debug logs of runsc are
+// written into.
+func (mgr *ContainerManager) runscLogDir() string {
+	return filepath.Join(mgr.Config.HomeDir, ctrd.RunscDebugLogDir)
+}
+
+// validateRunscOptions checks the runsc options set by the labels of
+// container.
+func (mgr *ContainerManager) validateRunscOptions(c *Container) error {
+	if !isRunscRuntime(c) {
+		return nil
+	}
+	if o := runscOptions(c, nil); o != nil {
+		return o.Validate(mgr.runscLogDir())
+	}
+	return nil
+}
+
+// generateRunscOptions writes the runsc flags into the config file of the
+// gVisor shim for container, the config file of daemon is copied if set.
+func (mgr *ContainerManager) generateRunscOptions(c *Container, o *ctrd.RunscOptions) (*ctrd.RuntimeOptions, error) {
+	if err := o.Validate(mgr.runscLogDir()); err != nil {
+		return nil, err
+	}
+	if o.DebugLog != "" {
+		if err := os.MkdirAll(mgr.runscLogDir(), 0700); err != nil {
+			return nil, errors.Wrapf(err, "failed to create runsc debug log dir %s", mgr.runscLogDir())
+		}
+	}
+
+	flags := o.Flags()
+	if len(flags) == 0 {
+		return &ctrd.RuntimeOptions{TypeURL: ctrd.RunscOptionsTypeURL, ConfigPath: o.ConfigPath}, nil
+	}
+
+	config := make(map[string]interface{})
+	if o.ConfigPath != "" {
+		if _, err := toml.DecodeFile(o.ConfigPath, &config); err != nil {
+			return nil, errors.Wrapf(err, "failed to load runsc config %s", o.ConfigPath)
+		}
+	}
+
+	runscConfig := make(map[string]interface{})
+	if m, ok := config["runsc_config"].(map[string]interface{}); ok {
+		runscConfig = m
+	}
+	for k, v := range flags {
+		runscConfig[k] = v
+	}
+	config["runsc_config"] = runscConfig
+
+	configPath := filepath.Join(mgr.Store.Path(c.ID), runscConfigFile)
+	f, err := os.OpenFile(configPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create runsc config %s", configPath)
+	}
+	defer f.Close()
+
+	if err := toml.NewEncoder(f).Encode(config); err != nil {
+		return nil, errors.Wrapf(err, "failed to write runsc config %s", configPath)
+	}
+	return &ctrd.RuntimeOptions{TypeURL: ctrd.RunscOptionsTypeURL, ConfigPath: configPath}, nil
+}
@@ -0,0 +1,97 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/meta"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunscOptions(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{HomeDir: "/var/lib/pouch"}}
+	c := &Container{
+		Config:     &types.ContainerConfig{Labels: map[string]string{runscNetworkLabel: "host"}},
+		HostConfig: &types.HostConfig{RuntimeType: ctrd.RuntimeTypeV2runscV1},
+	}
+	assert.Equal(t, &ctrd.RunscOptions{Platform: "kvm", Network: "host"},
+		runscOptions(c, &ctrd.RunscOptions{Platform: "kvm", Network: "sandbox"}))
+	assert.Equal(t, &ctrd.RunscOptions{Network: "host"}, runscOptions(c, nil))
+	assert.NoError(t, mgr.validateRunscOptions(c))
+
+	c.Config.Labels = nil
+	assert.Nil(t, runscOptions(c, nil))
+
+	c.Config.Labels = map[string]string{runscPlatformLabel: "xen"}
+	assert.Error(t, mgr.validateRunscOptions(c))
+
+	// the debug log is only allowed within the debug log dir of pouchd
+	c.Config.Labels = map[string]string{runscDebugLogLabel: "/var/lib/pouch/runsc-logs/"}
+	assert.NoError(t, mgr.validateRunscOptions(c))
+	for _, path := range []string{"/etc/", "/var/lib/pouch/runsc-logs/../containers/", "/var/lib/pouch/runsc-logs-foo"} {
+		c.Config.Labels = map[string]string{runscDebugLogLabel: path}
+		assert.Error(t, mgr.validateRunscOptions(c), path)
+	}
+}
+
+func TestGenerateRunscOptions(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "runsc-options")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: tmpDir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Container{}),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	mgr := &ContainerManager{Store: store, Config: &config.Config{HomeDir: tmpDir}}
+
+	c := &Container{ID: "runsc"}
+	assert.NoError(t, os.MkdirAll(store.Path(c.ID), 0755))
+
+	// the config file of daemon is passed as is without flags
+	options, err := mgr.generateRunscOptions(c, &ctrd.RunscOptions{ConfigPath: "/etc/runsc.toml"})
+	assert.NoError(t, err)
+	assert.Equal(t, &ctrd.RuntimeOptions{TypeURL: ctrd.RunscOptionsTypeURL, ConfigPath: "/etc/runsc.toml"}, options)
+
+	daemonConfig := filepath.Join(tmpDir, "runsc.toml")
+	assert.NoError(t, ioutil.WriteFile(daemonConfig, []byte("binary_name = \"/usr/bin/runsc\"\n[runsc_config]\n  platform = \"ptrace\"\n  overlay = \"true\"\n"), 0644))
+
+	debugLog := filepath.Join(tmpDir, ctrd.RunscDebugLogDir) + "/"
+	options, err = mgr.generateRunscOptions(c, &ctrd.RunscOptions{ConfigPath: daemonConfig, Platform: "kvm", DebugLog: debugLog})
+	assert.NoError(t, err)
+	assert.Equal(t, &ctrd.RuntimeOptions{TypeURL: ctrd.RunscOptionsTypeURL, ConfigPath: filepath.Join(store.Path(c.ID), runscConfigFile)}, options)
+
+	var config struct {
+		BinaryName  string            `toml:"binary_name"`
+		RunscConfig map[string]string `toml:"runsc_config"`
+	}
+	_, err = toml.DecodeFile(options.ConfigPath, &config)
+	assert.NoError(t, err)
+	assert.Equal(t, "/usr/bin/runsc", config.BinaryName)
+	assert.Equal(t, map[string]string{
+		"platform":  "kvm",
+		"overlay":   "true",
+		"debug":     "true",
+		"debug-log": debugLog,
+	}, config.RunscConfig)
+	_, err = os.Stat(debugLog)
+	assert.NoError(t, err)
+
+	_, err = mgr.generateRunscOptions(c, &ctrd.RunscOptions{Network: "bridge"})
+	assert.Error(t, err)
+}
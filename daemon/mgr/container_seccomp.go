@@ -0,0 +1,121 @@
+package mgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+var (
+	// seccompActions are the actions supported in seccomp profile.
+	seccompActions = map[specs.LinuxSeccompAction]bool{
+		specs.ActKill:  true,
+		specs.ActTrap:  true,
+		specs.ActErrno: true,
+		specs.ActTrace: true,
+		specs.ActAllow: true,
+	}
+
+	// seccompOperators are the operators supported in syscall arguments.
+	seccompOperators = map[specs.LinuxSeccompOperator]bool{
+		specs.OpNotEqual:     true,
+		specs.OpLessThan:     true,
+		specs.OpLessEqual:    true,
+		specs.OpEqualTo:      true,
+		specs.OpGreaterEqual: true,
+		specs.OpGreaterThan:  true,
+		specs.OpMaskedEqual:  true,
+	}
+)
+
+// loadSeccompProfile loads and validates the seccomp profile in json format.
+func loadSeccompProfile(path string) (*specs.LinuxSeccomp, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load seccomp profile %q: %v", path, err)
+	}
+
+	profile := &specs.LinuxSeccomp{}
+	if err := json.Unmarshal(data, profile); err != nil {
+		return nil, fmt.Errorf("failed to decode seccomp profile %q: %v", path, err)
+	}
+	if err := validateSeccompProfile(profile); err != nil {
+		return nil, errors.Wrapf(err, "invalid seccomp profile %q", path)
+	}
+	return profile, nil
+}
+
+// validateSeccompProfile checks the actions, architectures and syscalls of
+// seccomp profile.
+func validateSeccompProfile(profile *specs.LinuxSeccomp) error {
+	if !seccompActions[profile.DefaultAction] {
+		return fmt.Errorf("invalid default action %q", profile.DefaultAction)
+	}
+
+	for _, arch := range profile.Architectures {
+		switch arch {
+		case specs.ArchX86, specs.ArchX86_64, specs.ArchX32, specs.ArchARM, specs.ArchAARCH64,
+			specs.ArchMIPS, specs.ArchMIPS64, specs.ArchMIPS64N32, specs.ArchMIPSEL,
+			specs.ArchMIPSEL64, specs.ArchMIPSEL64N32, specs.ArchPPC, specs.ArchPPC64,
+			specs.ArchPPC64LE, specs.ArchS390, specs.ArchS390X, specs.ArchPARISC, specs.ArchPARISC64:
+		default:
+			return fmt.Errorf("invalid architecture %q", arch)
+		}
+	}
+
+	for _, syscall := range profile.Syscalls {
+		if len(syscall.Names) == 0 {
+			return fmt.Errorf("syscall rule should have names")
+		}
+		if !seccompActions[syscall.Action] {
+			return fmt.Errorf("invalid action %q of syscalls %v", syscall.Action, syscall.Names)
+		}
+		for _, arg := range syscall.Args {
+			if !seccompOperators[arg.Op] {
+				return fmt.Errorf("invalid operator %q of syscalls %v", arg.Op, syscall.Names)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveSeccompProfile resolves the seccomp profile of container when
+// creating it, the default profile of daemon is used if not set, and the
+// custom profile is validated. The resolved profile is reported in inspect.
+func (mgr *ContainerManager) resolveSeccompProfile(c *Container) error {
+	// the privileged container runs without seccomp.
+	if c.HostConfig.Privileged {
+		c.SeccompProfile = ProfileNameUnconfined
+		return nil
+	}
+
+	switch c.SeccompProfile {
+	case ProfileNameUnconfined:
+		return nil
+	case "", ProfilePouchDefault, ProfileDockerDefault, ProfileRuntimeDefault:
+		if !IsSeccompEnable() {
+			if c.SeccompProfile != "" {
+				return fmt.Errorf("seccomp is not supported by pouchd, cannot set seccomp profile %s", c.SeccompProfile)
+			}
+			c.SeccompProfile = ProfileNameUnconfined
+			return nil
+		}
+
+		c.SeccompProfile = ProfilePouchDefault
+		if mgr.Config.SeccompProfile != "" {
+			c.SeccompProfile = mgr.Config.SeccompProfile
+			_, err := loadSeccompProfile(c.SeccompProfile)
+			return err
+		}
+		return nil
+	default:
+		if !IsSeccompEnable() {
+			return fmt.Errorf("seccomp is not supported by pouchd, cannot set seccomp profile %s", c.SeccompProfile)
+		}
+		_, err := loadSeccompProfile(c.SeccompProfile)
+		return err
+	}
+}
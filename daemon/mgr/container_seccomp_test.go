@@ -0,0 +1,74 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSeccompProfile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-seccomp-profile")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "profile.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`{
+	"defaultAction": "SCMP_ACT_ERRNO",
+	"architectures": ["SCMP_ARCH_X86_64"],
+	"syscalls": [
+		{"names": ["read", "write"], "action": "SCMP_ACT_ALLOW"},
+		{"names": ["personality"], "action": "SCMP_ACT_ALLOW", "args": [{"index": 0, "value": 0, "op": "SCMP_CMP_EQ"}]}
+	]
+}`), 0644))
+
+	profile, err := loadSeccompProfile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, specs.ActErrno, profile.DefaultAction)
+	assert.Equal(t, 2, len(profile.Syscalls))
+
+	_, err = loadSeccompProfile(filepath.Join(tmpDir, "none.json"))
+	assert.Error(t, err)
+
+	for _, profile := range []string{
+		`{`,
+		`{"defaultAction": "SCMP_ACT_DENY"}`,
+		`{"defaultAction": "SCMP_ACT_ERRNO", "architectures": ["SCMP_ARCH_Z80"]}`,
+		`{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"action": "SCMP_ACT_ALLOW"}]}`,
+		`{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"names": ["read"], "action": "ALLOW"}]}`,
+		`{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"names": ["read"], "action": "SCMP_ACT_ALLOW", "args": [{"op": "EQ"}]}]}`,
+	} {
+		assert.NoError(t, ioutil.WriteFile(path, []byte(profile), 0644))
+		_, err := loadSeccompProfile(path)
+		assert.Error(t, err, profile)
+	}
+}
+
+func TestResolveSeccompProfile(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{}}
+
+	c := &Container{HostConfig: &types.HostConfig{Privileged: true}, SeccompProfile: "/not/exist.json"}
+	assert.NoError(t, mgr.resolveSeccompProfile(c))
+	assert.Equal(t, ProfileNameUnconfined, c.SeccompProfile)
+
+	c = &Container{HostConfig: &types.HostConfig{}, SeccompProfile: ProfileNameUnconfined}
+	assert.NoError(t, mgr.resolveSeccompProfile(c))
+	assert.Equal(t, ProfileNameUnconfined, c.SeccompProfile)
+
+	c = &Container{HostConfig: &types.HostConfig{}}
+	assert.NoError(t, mgr.resolveSeccompProfile(c))
+	if IsSeccompEnable() {
+		assert.Equal(t, ProfilePouchDefault, c.SeccompProfile)
+	} else {
+		assert.Equal(t, ProfileNameUnconfined, c.SeccompProfile)
+	}
+
+	c = &Container{HostConfig: &types.HostConfig{}, SeccompProfile: "/not/exist.json"}
+	assert.Error(t, mgr.resolveSeccompProfile(c))
+}
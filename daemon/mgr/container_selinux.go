@@ -0,0 +1,61 @@
+package mgr
+
+import (
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/opencontainers/selinux/go-selinux/label"
+	"github.com/pkg/errors"
+)
+
+// selinuxSecurityOpts returns the security options of container with the
+// SELinux label options implied by its namespace modes. The labeling is
+// disabled for the container sharing namespaces with host, and the container
+// sharing pid or ipc namespace with another one reuses its process label. The
+// implied options come first, so that they can be overridden by the user.
+func (mgr *ContainerManager) selinuxSecurityOpts(hostConfig *types.HostConfig) ([]string, error) {
+	var labelOpts []string
+	switch {
+	case hostConfig.Privileged || isHost(hostConfig.PidMode) || isHost(hostConfig.IpcMode):
+		labelOpts = label.DisableSecOpt()
+	case isContainer(hostConfig.PidMode):
+		opts, err := mgr.dupSecOpt(connectedContainer(hostConfig.PidMode))
+		if err != nil {
+			return nil, err
+		}
+		labelOpts = opts
+	case isContainer(hostConfig.IpcMode):
+		opts, err := mgr.dupSecOpt(connectedContainer(hostConfig.IpcMode))
+		if err != nil {
+			return nil, err
+		}
+		labelOpts = opts
+	}
+
+	securityOpts := make([]string, 0, len(labelOpts)+len(hostConfig.SecurityOpt))
+	for _, opt := range labelOpts {
+		securityOpts = append(securityOpts, "label="+opt)
+	}
+	return append(securityOpts, hostConfig.SecurityOpt...), nil
+}
+
+// dupSecOpt returns the label options to reuse the process label of container.
+func (mgr *ContainerManager) dupSecOpt(name string) ([]string, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get container %s to share namespace", name)
+	}
+	return label.DupSecOpt(c.ProcessLabel), nil
+}
+
+// relabelMount relabels the source of mount point with the mount label of
+// container if it is requested by mode z or Z, the content is shared among
+// containers with z and private to the container with Z.
+func relabelMount(c *Container, mp *types.MountPoint) error {
+	if mp.Source == "" || !label.RelabelNeeded(mp.Mode) {
+		return nil
+	}
+	if err := label.Relabel(mp.Source, c.MountLabel, label.IsShared(mp.Mode)); err != nil {
+		return errors.Wrapf(err, "failed to relabel %s", mp.Source)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/opencontainers/selinux/go-selinux/label"
+	"github.com/stretchr/testify/assert"
+)
+
+func withLabelPrefix(opts []string) []string {
+	var res []string
+	for _, opt := range opts {
+		res = append(res, "label="+opt)
+	}
+	return res
+}
+
+func TestSelinuxSecurityOpts(t *testing.T) {
+	processLabel := "system_u:system_r:container_t:s0:c1,c2"
+	mgr := newDependencyTestManager(&Container{
+		ID:           "a",
+		Name:         "name-a",
+		ProcessLabel: processLabel,
+	})
+
+	for _, tc := range []struct {
+		hostConfig *types.HostConfig
+		expected   []string
+	}{
+		{&types.HostConfig{}, nil},
+		{&types.HostConfig{SecurityOpt: []string{"label=level:s0:c3"}}, []string{"label=level:s0:c3"}},
+		{&types.HostConfig{Privileged: true}, withLabelPrefix(label.DisableSecOpt())},
+		{&types.HostConfig{PidMode: "host"}, withLabelPrefix(label.DisableSecOpt())},
+		{&types.HostConfig{IpcMode: "container:name-a"}, withLabelPrefix(label.DupSecOpt(processLabel))},
+		{
+			&types.HostConfig{PidMode: "container:a", SecurityOpt: []string{"seccomp=unconfined"}},
+			append(withLabelPrefix(label.DupSecOpt(processLabel)), "seccomp=unconfined"),
+		},
+	} {
+		opts, err := mgr.selinuxSecurityOpts(tc.hostConfig)
+		assert.NoError(t, err, "%+v", tc.hostConfig)
+		assert.Equal(t, len(tc.expected), len(opts), "%+v", tc.hostConfig)
+		for i := range tc.expected {
+			assert.Equal(t, tc.expected[i], opts[i], "%+v", tc.hostConfig)
+		}
+	}
+}
+
+func TestRelabelMount(t *testing.T) {
+	c := &Container{MountLabel: "system_u:object_r:container_file_t:s0:c1,c2"}
+
+	// nothing to relabel without source or mode z/Z.
+	assert.NoError(t, relabelMount(c, &types.MountPoint{Mode: "Z"}))
+	assert.NoError(t, relabelMount(c, &types.MountPoint{Source: "/nonexistent", Mode: "ro"}))
+}
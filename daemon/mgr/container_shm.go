@@ -0,0 +1,86 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/alibaba/pouch/pkg/mount"
+
+	"github.com/pkg/errors"
+)
+
+// defaultShmSize is the size of /dev/shm of container if it's not set.
+const defaultShmSize int64 = 64 * 1024 * 1024
+
+// shmPath returns the path of the shm owned by container.
+func (mgr *ContainerManager) shmPath(c *Container) string {
+	return filepath.Join(mgr.Store.Path(c.ID), "shm")
+}
+
+// setupShm prepares the shm mounted as /dev/shm of container before it
+// starts. The container owns a tmpfs of its shm size, unless it joins the
+// ipc namespace of host or another container, in which case their shm is
+// shared, such as the containers in a pod sharing the one of sandbox. No
+// shm is mounted for ipc mode none.
+func (mgr *ContainerManager) setupShm(ctx context.Context, c *Container) error {
+	ipcMode := c.HostConfig.IpcMode
+	switch {
+	case ipcMode == ipcModeNone:
+		c.ShmPath = ""
+		return nil
+	case isHost(ipcMode):
+		c.ShmPath = "/dev/shm"
+		return nil
+	case isContainer(ipcMode):
+		orig, err := getIpcContainer(ctx, mgr, connectedContainer(ipcMode))
+		if err != nil {
+			return err
+		}
+		c.ShmPath = orig.ShmPath
+		return nil
+	}
+
+	shmPath := mgr.shmPath(c)
+	if err := os.MkdirAll(shmPath, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create shm of container %s", c.ID)
+	}
+
+	if notMnt, err := mount.IsLikelyNotMountPoint(shmPath); err != nil || notMnt {
+		size := defaultShmSize
+		if c.HostConfig.ShmSize != nil && *c.HostConfig.ShmSize > 0 {
+			size = *c.HostConfig.ShmSize
+		}
+
+		data := fmt.Sprintf("mode=1777,size=%d", size)
+		if c.MountLabel != "" {
+			data = fmt.Sprintf("%s,context=%q", data, c.MountLabel)
+		}
+		if err := syscall.Mount("shm", shmPath, "tmpfs", uintptr(syscall.MS_NOEXEC|syscall.MS_NOSUID|syscall.MS_NODEV), data); err != nil {
+			return errors.Wrapf(err, "failed to mount shm of container %s", c.ID)
+		}
+	}
+
+	c.ShmPath = shmPath
+	return nil
+}
+
+// releaseShm unmounts the shm owned by container after it exits, the
+// containers which have joined its ipc namespace keep the shm until they
+// exit too.
+func (mgr *ContainerManager) releaseShm(c *Container) error {
+	shmPath := mgr.shmPath(c)
+	if c.ShmPath != shmPath {
+		return nil
+	}
+
+	if notMnt, err := mount.IsLikelyNotMountPoint(shmPath); err != nil || notMnt {
+		return nil
+	}
+	if err := syscall.Unmount(shmPath, syscall.MNT_DETACH); err != nil {
+		return errors.Wrapf(err, "failed to unmount shm of container %s", c.ID)
+	}
+	return nil
+}
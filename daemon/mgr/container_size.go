@@ -0,0 +1,155 @@
+package mgr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+// containerSizeCacheTTL is the time to cache the size of the running
+// container, since its writable layer may be written at any time.
+var containerSizeCacheTTL = 10 * time.Second
+
+// containerSize is the cached size of the container.
+type containerSize struct {
+	rw       int64
+	rootfs   int64
+	running  bool
+	cachedAt time.Time
+}
+
+// containerSizeCache caches the size of containers and images, the size of
+// a container is invalidated when any event of it is logged, such as start,
+// exec and extract-to-dir, which may write its writable layer.
+type containerSizeCache struct {
+	sync.Mutex
+	containers map[string]containerSize
+	images     map[string]int64
+}
+
+// getContainer returns the cached size of the container if it's still valid.
+func (c *containerSizeCache) getContainer(id string, now time.Time) (containerSize, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	size, ok := c.containers[id]
+	if !ok || (size.running && now.Sub(size.cachedAt) > containerSizeCacheTTL) {
+		return containerSize{}, false
+	}
+	return size, true
+}
+
+func (c *containerSizeCache) setContainer(id string, size containerSize) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.containers == nil {
+		c.containers = map[string]containerSize{}
+	}
+	c.containers[id] = size
+}
+
+// invalidate removes the cached size of the container.
+func (c *containerSizeCache) invalidate(id string) {
+	c.Lock()
+	defer c.Unlock()
+
+	delete(c.containers, id)
+}
+
+func (c *containerSizeCache) getImage(id string) (int64, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	size, ok := c.images[id]
+	return size, ok
+}
+
+func (c *containerSizeCache) setImage(id string, size int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.images == nil {
+		c.images = map[string]int64{}
+	}
+	c.images[id] = size
+}
+
+// imageSize returns the size of the unpacked layers of image, the layers
+// are immutable so that the size is cached until daemon restarts.
+func (mgr *ContainerManager) imageSize(ctx context.Context, imageID string) (int64, error) {
+	if size, ok := mgr.sizeCache.getImage(imageID); ok {
+		return size, nil
+	}
+
+	img, err := mgr.ImageMgr.GetImage(ctx, imageID)
+	if err != nil {
+		return 0, err
+	}
+
+	refs, err := imageSnapshots(img, mgr.ImageMgr.LazySnapshotter(ctx, imageID))
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, ref := range refs {
+		size, err := snapshotUsage(ctx, mgr.Client, ref)
+		if err != nil && !errdefs.IsNotFound(err) {
+			return 0, err
+		}
+		total += size
+	}
+
+	mgr.sizeCache.setImage(imageID, total)
+	return total, nil
+}
+
+// Size returns the size of files created or changed by the container, and
+// the size of all the files in its rootfs including the image layers.
+func (mgr *ContainerManager) Size(ctx context.Context, name string) (int64, int64, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := time.Now()
+	if size, ok := mgr.sizeCache.getContainer(c.ID, now); ok {
+		return size.rw, size.rootfs, nil
+	}
+
+	c.Lock()
+	var (
+		snapshotter = c.Config.Snapshotter
+		running     = c.IsRunningOrPaused()
+	)
+	c.Unlock()
+
+	// the usage of rootfs on host isn't counted, since it has no snapshot.
+	var rw int64
+	if !c.hostRootfs() {
+		rw, err = snapshotUsage(ctx, mgr.Client, snapshotRef{snapshotter: snapshotter, key: c.ID})
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	var rootfs int64
+	if c.Image != "" {
+		rootfs, err = mgr.imageSize(ctx, c.Image)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	rootfs += rw
+
+	mgr.sizeCache.setContainer(c.ID, containerSize{
+		rw:       rw,
+		rootfs:   rootfs,
+		running:  running,
+		cachedAt: now,
+	})
+	return rw, rootfs, nil
+}
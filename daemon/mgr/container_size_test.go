@@ -0,0 +1,46 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerSizeCache(t *testing.T) {
+	var (
+		cache = containerSizeCache{}
+		now   = time.Now()
+	)
+
+	_, ok := cache.getContainer("running", now)
+	assert.False(t, ok)
+
+	cache.setContainer("running", containerSize{rw: 1, rootfs: 2, running: true, cachedAt: now})
+	cache.setContainer("stopped", containerSize{rw: 3, rootfs: 4, cachedAt: now})
+
+	size, ok := cache.getContainer("running", now.Add(containerSizeCacheTTL))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), size.rw)
+	assert.Equal(t, int64(2), size.rootfs)
+
+	// the size of running container expires after ttl.
+	_, ok = cache.getContainer("running", now.Add(containerSizeCacheTTL+time.Second))
+	assert.False(t, ok)
+
+	// the size of stopped container is valid until invalidated.
+	size, ok = cache.getContainer("stopped", now.Add(time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), size.rw)
+
+	cache.invalidate("stopped")
+	_, ok = cache.getContainer("stopped", now)
+	assert.False(t, ok)
+
+	_, ok = cache.getImage("image")
+	assert.False(t, ok)
+	cache.setImage("image", 5)
+	imageSize, ok := cache.getImage("image")
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), imageSize)
+}
@@ -22,6 +22,12 @@ func (c *Container) ExitCode() int64 {
 	return c.State.ExitCode
 }
 
+// IsRestarting returns container is waiting to be restarted by its restart
+// policy or not.
+func (c *Container) IsRestarting() bool {
+	return c.State.Status == types.StatusRestarting
+}
+
 // IsCreated returns container is created or not.
 func (c *Container) IsCreated() bool {
 	return c.State.Status == types.StatusCreated
@@ -55,6 +61,7 @@ func (c *Container) SetStatusRunning(pid int64) {
 // ExitCode -> input param
 // Error -> input param
 func (c *Container) SetStatusStopped(exitCode int64, errMsg string) {
+	c.stopHealthMonitor()
 	c.State.Status = types.StatusStopped
 	c.State.FinishedAt = time.Now().UTC().Format(utils.TimeLayout)
 	c.State.Pid = 0
@@ -65,6 +72,7 @@ func (c *Container) SetStatusStopped(exitCode int64, errMsg string) {
 
 // SetStatusExited sets a container to be status exited.
 func (c *Container) SetStatusExited(exitCode int64, errMsg string) {
+	c.stopHealthMonitor()
 	c.State.Status = types.StatusExited
 	c.State.FinishedAt = time.Now().UTC().Format(utils.TimeLayout)
 	c.State.Pid = 0
@@ -86,8 +94,16 @@ func (c *Container) SetStatusUnpaused() {
 	c.setStatusFlags(types.StatusRunning)
 }
 
+// SetStatusRestarting sets a container to be status restarting, the exit
+// code and finish time of last run are kept.
+func (c *Container) SetStatusRestarting() {
+	c.State.Status = types.StatusRestarting
+	c.setStatusFlags(types.StatusRestarting)
+}
+
 // SetStatusDead sets a container to be status dead.
 func (c *Container) SetStatusDead() {
+	c.stopHealthMonitor()
 	c.State.Status = types.StatusDead
 	c.setStatusFlags(types.StatusDead)
 }
@@ -59,6 +59,12 @@ func (mgr *ContainerManager) StreamStats(ctx context.Context, name string, confi
 			log.With(nil).Debugf("failed to get network stats from container %s: %v", name, err)
 		}
 		stats.Networks = networkStat
+
+		gpuStat, err := gpuStats(ctx, c)
+		if err != nil {
+			log.With(nil).Debugf("failed to get GPU stats from container %s: %v", name, err)
+		}
+		stats.GPUStats = gpuStat
 		return stats, nil
 	}
 
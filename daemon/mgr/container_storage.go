@@ -15,6 +15,7 @@ import (
 
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
 	"github.com/alibaba/pouch/pkg/archive"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
@@ -27,14 +28,32 @@ import (
 	"github.com/pkg/errors"
 )
 
+// storageOptSize is the storage option to limit the size of the writable
+// layer of container.
+const storageOptSize = "size"
+
+// storageSize returns the size of the writable layer set by storage options.
+func storageSize(c *Container) (string, bool) {
+	if c.HostConfig == nil {
+		return "", false
+	}
+	size, ok := c.HostConfig.StorageOpt[storageOptSize]
+	return size, ok
+}
+
 func (mgr *ContainerManager) attachVolume(ctx context.Context, name string, c *Container) (string, string, error) {
 	driver := volumetypes.DefaultBackend
 	v, err := mgr.VolumeMgr.Get(ctx, name)
 	if err != nil || v == nil {
-		opts := map[string]string{
-			"backend": driver,
+		// the options are passed to the volume plugin as they are, so that
+		// only the local driver gets the backend option.
+		opts := map[string]string{}
+		if c.HostConfig.VolumeDriver != "" {
+			driver = c.HostConfig.VolumeDriver
+		} else {
+			opts["backend"] = driver
 		}
-		if _, err := mgr.VolumeMgr.Create(ctx, name, c.HostConfig.VolumeDriver, opts, nil); err != nil {
+		if _, err := mgr.VolumeMgr.Create(ctx, name, driver, opts, nil); err != nil {
 			log.With(ctx).Errorf("failed to create volume(%s), err(%v)", name, err)
 			return "", "", errors.Wrap(err, "failed to create volume")
 		}
@@ -250,6 +269,11 @@ func (mgr *ContainerManager) getMountPointFromVolumes(ctx context.Context, c *Co
 func (mgr *ContainerManager) getMountPointFromImage(ctx context.Context, c *Container, volumeSet map[string]struct{}) error {
 	var err error
 
+	// the rootfs on host has no image.
+	if c.Image == "" {
+		return nil
+	}
+
 	// parse volumes from image
 	image, err := mgr.ImageMgr.GetImage(ctx, c.Image)
 	if err != nil {
@@ -361,6 +385,21 @@ func (mgr *ContainerManager) getMountPointFromContainers(ctx context.Context, co
 	return nil
 }
 
+// volumeNoCopy returns whether the volume is created with nocopy option,
+// the image data is never copied into it.
+func (mgr *ContainerManager) volumeNoCopy(ctx context.Context, name string) bool {
+	if name == "" {
+		return false
+	}
+
+	v, err := mgr.VolumeMgr.Get(ctx, name)
+	if err != nil || v == nil {
+		return false
+	}
+	noCopy, _ := strconv.ParseBool(v.Option(volumetypes.OptionNoCopy))
+	return noCopy
+}
+
 func (mgr *ContainerManager) populateVolumes(ctx context.Context, c *Container, qms []*quota.QMap) error {
 	// sort mounts by destination directory string shortest length.
 	// the reason is: there are two mounts: /home/admin and /home/admin/log,
@@ -373,7 +412,7 @@ func (mgr *ContainerManager) populateVolumes(ctx context.Context, c *Container,
 			continue
 		}
 
-		if !mp.CopyData {
+		if !mp.CopyData || mgr.volumeNoCopy(ctx, mp.Name) {
 			continue
 		}
 
@@ -519,6 +558,15 @@ func (mgr *ContainerManager) prepareQuotaMap(ctx context.Context, c *Container,
 		globalQuotaID uint32
 	)
 
+	// the size of writable layer is enforced by the quota of rootfs.
+	if size, ok := storageSize(c); ok {
+		quotas = make(map[string]string, len(c.Config.DiskQuota)+1)
+		for exp, s := range c.Config.DiskQuota {
+			quotas[exp] = s
+		}
+		quotas["/"] = size
+	}
+
 	if quota.IsSetQuotaID(c.Config.QuotaID) {
 		id, err := strconv.Atoi(c.Config.QuotaID)
 		if err != nil {
@@ -629,6 +677,9 @@ func (mgr *ContainerManager) setDiskQuota(ctx context.Context, c *Container, upd
 		if qm.Destination == "/" {
 			// set rootfs quota
 			_, err = quota.SetRootfsDiskQuota(qm.Source, qm.Size, qm.QuotaID, update)
+			if _, ok := storageSize(c); ok && err != nil {
+				return errors.Wrapf(err, "failed to limit the size of writable layer to %s", qm.Size)
+			}
 			if err != nil {
 				log.With(ctx).Warnf("failed to set rootfs quota, mountfs(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
@@ -704,7 +755,13 @@ func (mgr *ContainerManager) setMountFS(ctx context.Context, c *Container) {
 
 // Mount sets the container rootfs
 func (mgr *ContainerManager) Mount(ctx context.Context, c *Container) error {
+	// the rootfs on host is operated in place.
+	if c.hostRootfs() {
+		c.MountFS = c.BaseFS
+		return nil
+	}
 
+	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
 	mounts, err := mgr.Client.GetMounts(ctx, c.ID)
 	if err != nil {
 		return err
@@ -727,6 +784,10 @@ func (mgr *ContainerManager) Mount(ctx context.Context, c *Container) error {
 // Unmount unsets the container rootfs
 // cleanup decides whether to clean up the dir or not
 func (mgr *ContainerManager) Unmount(ctx context.Context, c *Container) error {
+	if c.hostRootfs() {
+		return nil
+	}
+
 	// TODO: if umount is failed, and how to deal it.
 	err := mount.Unmount(c.MountFS, 0)
 	if err != nil {
@@ -773,10 +834,10 @@ func (mgr *ContainerManager) initContainerStorage(ctx context.Context, c *Contai
 		return errors.Wrapf(err, "failed to setup container %s working directory", c.ID)
 	}
 
-	// set mount point disk quota
+	// set mount point disk quota, only the failure to limit the size of
+	// writable layer is returned.
 	if err = mgr.setDiskQuota(ctx, c, false, qms); err != nil {
-		// just ignore failed to set disk quota
-		log.With(ctx).Warnf("failed to set disk quota, err(%v)", err)
+		return errors.Wrap(err, "failed to set disk quota")
 	}
 
 	// set volumes into /etc/mtab in container
@@ -1,7 +1,6 @@
 package mgr
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,7 +12,6 @@ import (
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/cri/stream/remotecommand"
-	"github.com/alibaba/pouch/ctrd"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -50,6 +48,9 @@ var (
 	// MemorySwappinessWarn is warning for flag --memory-swappiness
 	MemorySwappinessWarn = "Current Kernel does not support memory swappiness , discard --memory-swappiness"
 
+	// KernelMemoryWarn is warning for flag --kernel-memory
+	KernelMemoryWarn = "Current Kernel does not support kernel memory limit, discard --kernel-memory"
+
 	//OOMKillWarn is warning for flag --oom-kill-disable
 	OOMKillWarn = "Current Kernel does not support disable oom kill, discard --oom-kill-disable"
 
@@ -135,6 +136,9 @@ type ContainerExecConfig struct {
 
 	// Exited means exec process exit or not
 	Exited bool
+
+	// ExitTime is the time when exec process exited
+	ExitTime time.Time
 }
 
 // AttachConfig wraps some infos of attaching.
@@ -190,6 +194,10 @@ type Container struct {
 	// no new privileges
 	NoNewPrivileges bool `json:"NoNewPrivileges,omitempty"`
 
+	// the capabilities resolved when the container is created, it is nil
+	// for the container created by the older version of pouchd.
+	Capabilities []string `json:"Capabilities"`
+
 	// The arguments to the command being run
 	Args []string `json:"Args"`
 
@@ -257,6 +265,9 @@ type Container struct {
 	// restart count
 	RestartCount int64 `json:"RestartCount,omitempty"`
 
+	// shm path, which is mounted as /dev/shm of container
+	ShmPath string `json:"ShmPath,omitempty"`
+
 	// The total size of all the files in this container.
 	SizeRootFs int64 `json:"SizeRootFs,omitempty"`
 
@@ -280,6 +291,19 @@ type Container struct {
 
 	// SnapshotID specify id of the snapshot that container using.
 	SnapshotID string
+
+	// healthStop is closed to stop the health monitor of container.
+	healthStop chan struct{}
+
+	// restartCancel is closed to cancel the pending restart of container.
+	restartCancel chan struct{}
+
+	// restartBackoff is the delay of last restart by restart policy.
+	restartBackoff time.Duration
+
+	// memoryPressureHandledAt is the time of last action taken by memory
+	// pressure policy.
+	memoryPressureHandledAt time.Time
 }
 
 // Key returns container's id.
@@ -404,9 +428,15 @@ func (c *Container) FormatStatus() (string, error) {
 		status = "Up " + startAt
 		if c.State.Status == types.StatusPaused {
 			status += "(paused)"
+		} else if h := c.State.Health; h != nil {
+			if h.Status == healthStarting {
+				status += " (health: starting)"
+			} else {
+				status += " (" + h.Status + ")"
+			}
 		}
 
-	case types.StatusStopped, types.StatusExited:
+	case types.StatusStopped, types.StatusExited, types.StatusRestarting:
 		finish, err := time.Parse(utils.TimeLayout, c.State.FinishedAt)
 		if err != nil {
 			return "", err
@@ -425,6 +455,9 @@ func (c *Container) FormatStatus() (string, error) {
 		if c.State.Status == types.StatusExited {
 			status = fmt.Sprintf("Exited (%d) %s", exitCode, finishAt)
 		}
+		if c.State.Status == types.StatusRestarting {
+			status = fmt.Sprintf("Restarting (%d) %s", exitCode, finishAt)
+		}
 	}
 
 	if status == "" {
@@ -444,24 +477,31 @@ func (c *Container) UnsetMergedDir() {
 	c.Snapshotter.Data["MergedDir"] = ""
 }
 
-// SetSnapshotterMeta sets snapshotter for container
-func (c *Container) SetSnapshotterMeta(mounts []mount.Mount) {
-	// TODO(ziren): now we only support overlayfs
+// SetSnapshotterMeta sets snapshotter for container. The overlay directories
+// are kept for the overlay mount, and the mount itself is kept for the other
+// snapshotters, such as the block device of devmapper or the subvolume of btrfs.
+func (c *Container) SetSnapshotterMeta(snapshotter string, mounts []mount.Mount) {
 	data := make(map[string]string)
-	for _, opt := range mounts[0].Options {
-		if strings.HasPrefix(opt, "upperdir=") {
-			data["UpperDir"] = strings.TrimPrefix(opt, "upperdir=")
-		}
-		if strings.HasPrefix(opt, "lowerdir=") {
-			data["LowerDir"] = strings.TrimPrefix(opt, "lowerdir=")
-		}
-		if strings.HasPrefix(opt, "workdir=") {
-			data["WorkDir"] = strings.TrimPrefix(opt, "workdir=")
+	if mounts[0].Type == "overlay" {
+		for _, opt := range mounts[0].Options {
+			if strings.HasPrefix(opt, "upperdir=") {
+				data["UpperDir"] = strings.TrimPrefix(opt, "upperdir=")
+			}
+			if strings.HasPrefix(opt, "lowerdir=") {
+				data["LowerDir"] = strings.TrimPrefix(opt, "lowerdir=")
+			}
+			if strings.HasPrefix(opt, "workdir=") {
+				data["WorkDir"] = strings.TrimPrefix(opt, "workdir=")
+			}
 		}
+	} else {
+		data["MountType"] = mounts[0].Type
+		data["MountSource"] = mounts[0].Source
+		data["MountOptions"] = strings.Join(mounts[0].Options, ",")
 	}
 
 	c.Snapshotter = &types.SnapshotterData{
-		Name: ctrd.CurrentSnapshotterName(context.TODO()),
+		Name: snapshotter,
 		Data: data,
 	}
 }
@@ -565,3 +605,15 @@ func (p ContainerRestartPolicy) IsNone() bool {
 func (p ContainerRestartPolicy) IsAlways() bool {
 	return p.Name == "always"
 }
+
+// IsUnlessStopped returns the container need to be restarted unless it is
+// stopped by user.
+func (p ContainerRestartPolicy) IsUnlessStopped() bool {
+	return p.Name == "unless-stopped"
+}
+
+// IsOnFailure returns the container need to be restarted only when it exits
+// with non-zero code.
+func (p ContainerRestartPolicy) IsOnFailure() bool {
+	return p.Name == "on-failure"
+}
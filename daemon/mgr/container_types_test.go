@@ -9,6 +9,7 @@ import (
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/containerd/containerd/mount"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/stretchr/testify/assert"
@@ -256,3 +257,30 @@ func TestMerge(t *testing.T) {
 		assert.Equal(true, ret, fmt.Sprintf("test %d fails\n %+v should equal with %+v\n", idx, tc.c.Config, tc.expected))
 	}
 }
+
+func TestSetSnapshotterMeta(t *testing.T) {
+	c := &Container{}
+	c.SetSnapshotterMeta("overlayfs", []mount.Mount{{
+		Type:    "overlay",
+		Source:  "overlay",
+		Options: []string{"workdir=/work", "upperdir=/upper", "lowerdir=/lower1:/lower2"},
+	}})
+	assert.Equal(t, "overlayfs", c.Snapshotter.Name)
+	assert.Equal(t, map[string]string{
+		"WorkDir":  "/work",
+		"UpperDir": "/upper",
+		"LowerDir": "/lower1:/lower2",
+	}, c.Snapshotter.Data)
+
+	c.SetSnapshotterMeta("devmapper", []mount.Mount{{
+		Type:    "ext4",
+		Source:  "/dev/mapper/containerd-pool-snap-1",
+		Options: []string{"rw"},
+	}})
+	assert.Equal(t, "devmapper", c.Snapshotter.Name)
+	assert.Equal(t, map[string]string{
+		"MountType":    "ext4",
+		"MountSource":  "/dev/mapper/containerd-pool-snap-1",
+		"MountOptions": "rw",
+	}, c.Snapshotter.Data)
+}
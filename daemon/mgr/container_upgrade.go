@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
 
@@ -24,6 +26,13 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 
 	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
 
+	if c.RootFSProvided {
+		return errors.Wrapf(errtypes.ErrNotImplemented, "upgrade of container %s whose rootfs is provided", c.ID)
+	}
+
+	// the new snapshot is prepared in the snapshotter of container.
+	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+
 	var (
 		needRollback  = false
 		oldConfig     = *c.Config
@@ -77,7 +86,7 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 	}
 
 	// prepare new snapshot for the new container
-	newSnapID, err := mgr.prepareSnapshotForUpgrade(ctx, c.Key(), c.SnapshotKey(), config.Image)
+	newSnapID, err := mgr.prepareSnapshotForUpgrade(ctx, c.Key(), c.SnapshotKey(), config.Image, c.HostConfig)
 	if err != nil {
 		return err
 	}
@@ -161,7 +170,7 @@ func (mgr *ContainerManager) prepareContainerEntrypointForUpgrade(ctx context.Co
 	return nil
 }
 
-func (mgr *ContainerManager) prepareSnapshotForUpgrade(ctx context.Context, cID, oldSnapID, image string) (string, error) {
+func (mgr *ContainerManager) prepareSnapshotForUpgrade(ctx context.Context, cID, oldSnapID, image string, hostConfig *types.HostConfig) (string, error) {
 	newSnapID := ""
 	// get a ID for the new snapshot
 	for {
@@ -172,7 +181,7 @@ func (mgr *ContainerManager) prepareSnapshotForUpgrade(ctx context.Context, cID,
 	}
 
 	// create a snapshot with image for new container.
-	if err := mgr.Client.CreateSnapshot(ctx, newSnapID, image); err != nil {
+	if err := mgr.createSnapshot(ctx, newSnapID, image, hostConfig); err != nil {
 		return "", errors.Wrap(err, "failed to create snapshot")
 	}
 
@@ -0,0 +1,140 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/docker/docker/pkg/idtools"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultRemappedUser is the user created to hold the subordinate id
+	// ranges when userns-remap is "default".
+	defaultRemappedUser = "pouchremap"
+
+	// usernsModeHost opts the container out of the user namespace remapping.
+	usernsModeHost = "host"
+)
+
+// newIdentityMapping returns the id mapping of the subordinate ranges of the
+// user and group in the format of user[:group], "default" creates the user
+// pouchremap if it does not exist. It returns nil if remap is empty.
+func newIdentityMapping(remap string) (*idtools.IdentityMapping, error) {
+	if remap == "" {
+		return nil, nil
+	}
+
+	username, groupname := remap, ""
+	if parts := strings.SplitN(remap, ":", 2); len(parts) == 2 {
+		username, groupname = parts[0], parts[1]
+	}
+
+	if username == "default" {
+		if groupname != "" {
+			return nil, fmt.Errorf("userns-remap default cannot be used with group %s", groupname)
+		}
+		username, groupname = defaultRemappedUser, defaultRemappedUser
+		if _, err := idtools.LookupUser(defaultRemappedUser); err != nil {
+			if _, _, err := idtools.AddNamespaceRangesUser(defaultRemappedUser); err != nil {
+				return nil, errors.Wrapf(err, "failed to create user %s for userns-remap", defaultRemappedUser)
+			}
+		}
+	}
+
+	// the subordinate ranges are looked up by name.
+	if uid, err := strconv.Atoi(username); err == nil {
+		u, err := idtools.LookupUID(uid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to look up uid %d of userns-remap", uid)
+		}
+		username = u.Name
+	} else if _, err := idtools.LookupUser(username); err != nil {
+		return nil, errors.Wrapf(err, "failed to look up user %s of userns-remap", username)
+	}
+	if username == "root" {
+		return nil, fmt.Errorf("userns-remap cannot remap root of container into root")
+	}
+
+	if groupname == "" {
+		groupname = username
+	} else if gid, err := strconv.Atoi(groupname); err == nil {
+		g, err := idtools.LookupGID(gid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to look up gid %d of userns-remap", gid)
+		}
+		groupname = g.Name
+	}
+
+	return idtools.NewIdentityMapping(username, groupname)
+}
+
+// usernsRemapped returns whether the root of container is remapped by the
+// daemon, the container with --userns=host shares the user namespace of host.
+func (mgr *ContainerManager) usernsRemapped(hostConfig *types.HostConfig) bool {
+	return mgr.idMapping != nil && !mgr.idMapping.Empty() && hostConfig.UsernsMode != usernsModeHost
+}
+
+// createSnapshot creates the snapshot of container, the files are owned by
+// the remapped root if the container is remapped.
+func (mgr *ContainerManager) createSnapshot(ctx context.Context, id, image string, hostConfig *types.HostConfig) error {
+	if !mgr.usernsRemapped(hostConfig) {
+		return mgr.Client.CreateSnapshot(ctx, id, image)
+	}
+
+	root := mgr.idMapping.RootPair()
+	return mgr.Client.CreateRemappedSnapshot(ctx, id, image, uint32(root.UID), uint32(root.GID))
+}
+
+// validateUsernsMode checks the user namespace mode of container and the
+// options incompatible with the remapping.
+func (mgr *ContainerManager) validateUsernsMode(hostConfig *types.HostConfig) error {
+	switch hostConfig.UsernsMode {
+	case "", usernsModeHost:
+	default:
+		return fmt.Errorf("invalid userns mode %s, only %s is supported", hostConfig.UsernsMode, usernsModeHost)
+	}
+
+	if !mgr.usernsRemapped(hostConfig) {
+		return nil
+	}
+
+	if hostConfig.Privileged {
+		return fmt.Errorf("privileged mode is incompatible with userns-remap, use --userns=host instead")
+	}
+	if IsHost(hostConfig.NetworkMode) {
+		return fmt.Errorf("network mode host is incompatible with userns-remap, use --userns=host instead")
+	}
+	for mode, value := range map[string]string{
+		"pid": hostConfig.PidMode,
+		"ipc": hostConfig.IpcMode,
+		"uts": hostConfig.UTSMode,
+	} {
+		if value == "host" {
+			return fmt.Errorf("%s mode host is incompatible with userns-remap, use --userns=host instead", mode)
+		}
+	}
+	return nil
+}
+
+// validateExecUser checks the user of exec process is mapped in the user
+// namespace of container.
+func (mgr *ContainerManager) validateExecUser(c *Container, uid, gid uint32, additionalGids []uint32) error {
+	if !mgr.usernsRemapped(c.HostConfig) {
+		return nil
+	}
+
+	if _, err := mgr.idMapping.ToHost(idtools.Identity{UID: int(uid), GID: int(gid)}); err != nil {
+		return errors.Wrapf(err, "user %d:%d is not mapped in the user namespace of container", uid, gid)
+	}
+	for _, g := range additionalGids {
+		if _, err := mgr.idMapping.ToHost(idtools.Identity{UID: int(uid), GID: int(g)}); err != nil {
+			return errors.Wrapf(err, "group %d is not mapped in the user namespace of container", g)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/docker/docker/pkg/idtools"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var testIDMapping = idtools.NewIDMappingsFromMaps(
+	[]idtools.IDMap{{ContainerID: 0, HostID: 100000, Size: 65536}},
+	[]idtools.IDMap{{ContainerID: 0, HostID: 200000, Size: 65536}},
+)
+
+func TestNewIdentityMapping(t *testing.T) {
+	m, err := newIdentityMapping("")
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+
+	for _, remap := range []string{"root", "0", "default:pouch"} {
+		_, err := newIdentityMapping(remap)
+		assert.Error(t, err, remap)
+	}
+}
+
+func TestValidateUsernsMode(t *testing.T) {
+	mgr := &ContainerManager{idMapping: testIDMapping}
+
+	for _, hostConfig := range []*types.HostConfig{
+		{},
+		{UsernsMode: "host", Privileged: true},
+		{UsernsMode: "host", NetworkMode: "host", PidMode: "host"},
+	} {
+		assert.NoError(t, mgr.validateUsernsMode(hostConfig), "%+v", hostConfig)
+	}
+
+	for _, hostConfig := range []*types.HostConfig{
+		{UsernsMode: "private"},
+		{Privileged: true},
+		{NetworkMode: "host"},
+		{PidMode: "host"},
+		{IpcMode: "host"},
+		{UTSMode: "host"},
+	} {
+		assert.Error(t, mgr.validateUsernsMode(hostConfig), "%+v", hostConfig)
+	}
+
+	// the options are not restricted without remapping.
+	mgr.idMapping = nil
+	assert.NoError(t, mgr.validateUsernsMode(&types.HostConfig{Privileged: true}))
+}
+
+func TestValidateExecUser(t *testing.T) {
+	mgr := &ContainerManager{idMapping: testIDMapping}
+	c := &Container{HostConfig: &types.HostConfig{}}
+
+	assert.NoError(t, mgr.validateExecUser(c, 0, 0, []uint32{10}))
+	assert.Error(t, mgr.validateExecUser(c, 70000, 0, nil))
+	assert.Error(t, mgr.validateExecUser(c, 0, 0, []uint32{70000}))
+
+	c.HostConfig.UsernsMode = "host"
+	assert.NoError(t, mgr.validateExecUser(c, 70000, 0, nil))
+}
+
+func TestSetupUserNamespace(t *testing.T) {
+	c := &Container{HostConfig: &types.HostConfig{}}
+	sw := &SpecWrapper{s: &specs.Spec{Linux: &specs.Linux{}}, idMapping: testIDMapping}
+
+	assert.NoError(t, setupUserNamespace(context.Background(), c, sw))
+	assert.Equal(t, []specs.LinuxNamespace{{Type: specs.UserNamespace}}, sw.s.Linux.Namespaces)
+	assert.Equal(t, []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}, sw.s.Linux.UIDMappings)
+	assert.Equal(t, []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}}, sw.s.Linux.GIDMappings)
+
+	c.HostConfig.UsernsMode = "host"
+	sw = &SpecWrapper{s: &specs.Spec{Linux: &specs.Linux{}}, idMapping: testIDMapping}
+	assert.NoError(t, setupUserNamespace(context.Background(), c, sw))
+	assert.Empty(t, sw.s.Linux.Namespaces)
+	assert.Empty(t, sw.s.Linux.UIDMappings)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -135,11 +136,57 @@ func (mgr *ContainerManager) getRuntimeType(runtime string) (string, error) {
 	return r.Type, nil
 }
 
+// mergeRuntimeDefaults merges the default annotations and mounts of runtime
+// into the config of container, the ones of container take precedence.
+func (mgr *ContainerManager) mergeRuntimeDefaults(config *types.ContainerCreateConfig) {
+	r, exist := mgr.Config.Runtimes[config.HostConfig.Runtime]
+	if !exist {
+		return
+	}
+
+	if len(r.Annotations) != 0 {
+		annotations := make(map[string]string, len(r.Annotations)+len(config.SpecAnnotation))
+		for k, v := range r.Annotations {
+			annotations[k] = v
+		}
+		for k, v := range config.SpecAnnotation {
+			annotations[k] = v
+		}
+		config.SpecAnnotation = annotations
+	}
+
+	destinations := make(map[string]bool)
+	for _, bind := range config.HostConfig.Binds {
+		if parts := strings.Split(bind, ":"); len(parts) > 1 {
+			destinations[filepath.Clean(parts[1])] = true
+		}
+	}
+	for dest := range config.HostConfig.Tmpfs {
+		destinations[filepath.Clean(dest)] = true
+	}
+	for dest := range config.Volumes {
+		destinations[filepath.Clean(dest)] = true
+	}
+	for _, m := range r.Mounts {
+		if dest := filepath.Clean(strings.Split(m, ":")[1]); !destinations[dest] {
+			config.HostConfig.Binds = append(config.HostConfig.Binds, m)
+			destinations[dest] = true
+		}
+	}
+}
+
 // generateRuntimeOptions generate options from daemon runtime configurations.
-func (mgr *ContainerManager) generateRuntimeOptions(runtime string) (interface{}, error) {
-	r, exist := mgr.Config.Runtimes[runtime]
+func (mgr *ContainerManager) generateRuntimeOptions(c *Container) (interface{}, error) {
+	r, exist := mgr.Config.Runtimes[c.HostConfig.Runtime]
 	if !exist {
-		return nil, fmt.Errorf("failed to find runtime %s in daemon config", runtime)
+		return nil, fmt.Errorf("failed to find runtime %s in daemon config", c.HostConfig.Runtime)
+	}
+
+	// io.containerd.runsc.v1 configured by the flags of runsc
+	if isRunscRuntime(c) {
+		if o := runscOptions(c, r.Options); o != nil {
+			return mgr.generateRunscOptions(c, o)
+		}
 	}
 
 	var options interface{}
@@ -165,6 +212,12 @@ func (mgr *ContainerManager) generateRuntimeOptions(runtime string) (interface{}
 			CriuPath:      o.CriuPath,
 			SystemdCgroup: mgr.Config.UseSystemd(),
 		}
+	// io.containerd.kata.v2 and io.containerd.runsc.v1
+	case *ctrd.RuntimeOptions:
+		options = &ctrd.RuntimeOptions{
+			TypeURL:    o.TypeURL,
+			ConfigPath: o.ConfigPath,
+		}
 	// TODO: support other v2 shim options.
 	default:
 		return nil, nil
@@ -192,6 +245,7 @@ func (mgr *ContainerManager) getContainerSpec(c *Container) (*specs.Spec, error)
 func BuildContainerEndpoint(c *Container) *networktypes.Endpoint {
 	return &networktypes.Endpoint{
 		Owner:           c.ID,
+		ContainerName:   c.Name,
 		Hostname:        c.Config.Hostname,
 		Domainname:      c.Config.Domainname,
 		HostsPath:       c.HostsPath,
@@ -234,14 +288,18 @@ func parseSecurityOpts(c *Container, securityOpts []string) error {
 			c.SeccompProfile = value
 		case "label":
 			labelOpts = append(labelOpts, value)
+		case "no-new-privileges":
+			noNewPrivileges, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid --security-opt %s: no-new-privileges must be a boolean", securityOpt)
+			}
+			c.NoNewPrivileges = noNewPrivileges
 		default:
 			return fmt.Errorf("invalid type %s in --security-opt %s: unknown type from apparmor, seccomp, no-new-privileges and SELinux label", key, securityOpt)
 		}
 	}
 
-	if len(labelOpts) == 0 {
-		return nil
-	}
+	// the labels are generated even without options if SELinux is enabled.
 	c.ProcessLabel, c.MountLabel, err = label.InitLabels(labelOpts)
 	if err != nil {
 		return fmt.Errorf("failed to init labels: %v", err)
@@ -262,6 +320,46 @@ func fieldsASCII(s string) []string {
 	return strings.FieldsFunc(s, fn)
 }
 
+// hostProcessList runs ps on host with psArgs, "-ef" by default, and keeps
+// the processes of container in its output.
+func hostProcessList(psArgs string, processes []ctrd.ProcessInfo) (*types.ContainerProcessList, error) {
+	args := fieldsASCII(psArgs)
+	if len(args) == 0 {
+		args = []string{"-ef"}
+	}
+
+	output, err := exec.Command("ps", args...).Output()
+	if err != nil {
+		if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "failed to run ps %s: %s", strings.Join(args, " "), strings.TrimSpace(string(ee.Stderr)))
+		}
+		return nil, errors.Wrapf(err, "failed to run ps command")
+	}
+
+	pids := make([]int, 0, len(processes))
+	for _, p := range processes {
+		pids = append(pids, p.Pid)
+	}
+	return parsePSOutput(output, pids)
+}
+
+// sandboxProcessList lists the processes reported by runtime, since the
+// processes in sandbox are invisible on host.
+func sandboxProcessList(processes []ctrd.ProcessInfo) *types.ContainerProcessList {
+	procList := &types.ContainerProcessList{
+		Titles: []string{"PID", "EXEC ID"},
+	}
+	for _, p := range processes {
+		execID := p.ExecID
+		if execID == "" {
+			execID = "-"
+		}
+		procList.Processes = append(procList.Processes, []string{strconv.Itoa(p.Pid), execID})
+	}
+	return procList
+}
+
+// parsePSOutput parses the output of ps, and keeps the processes in pids.
 func parsePSOutput(output []byte, pids []int) (*types.ContainerProcessList, error) {
 	procList := &types.ContainerProcessList{}
 
@@ -275,7 +373,12 @@ func parsePSOutput(output []byte, pids []int) (*types.ContainerProcessList, erro
 		}
 	}
 	if pidIndex == -1 {
-		return nil, fmt.Errorf("Couldn't find PID field in ps output")
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "couldn't find PID field in ps output")
+	}
+
+	contains := make(map[int]bool, len(pids))
+	for _, pid := range pids {
+		contains[pid] = true
 	}
 
 	// loop through the output and extract the PID from each line
@@ -284,20 +387,27 @@ func parsePSOutput(output []byte, pids []int) (*types.ContainerProcessList, erro
 			continue
 		}
 		fields := fieldsASCII(line)
+		if len(fields) <= pidIndex {
+			return nil, fmt.Errorf("unexpected line of ps output: %s", line)
+		}
+
 		p, err := strconv.Atoi(fields[pidIndex])
 		if err != nil {
-			return nil, fmt.Errorf("Unexpected pid '%s': %s", fields[pidIndex], err)
+			return nil, fmt.Errorf("unexpected pid '%s': %s", fields[pidIndex], err)
 		}
 
-		for _, pid := range pids {
-			if pid == p {
-				// Make sure number of fields equals number of header titles
-				// merging "overhanging" fields
-				process := fields[:len(procList.Titles)-1]
-				process = append(process, strings.Join(fields[len(procList.Titles)-1:], " "))
-				procList.Processes = append(procList.Processes, process)
-			}
+		if !contains[p] {
+			continue
 		}
+
+		// Make sure number of fields equals number of header titles
+		// merging "overhanging" fields
+		if len(fields) >= len(procList.Titles) {
+			process := fields[:len(procList.Titles)-1]
+			process = append(process, strings.Join(fields[len(procList.Titles)-1:], " "))
+			fields = process
+		}
+		procList.Processes = append(procList.Processes, fields)
 	}
 	return procList, nil
 }
@@ -371,3 +481,19 @@ func mergeAnnotation(newAnnotation, oldAnnotation map[string]string) map[string]
 
 	return oldAnnotation
 }
+
+// mergeHugepageLimits updates the limits of the same page size, and keeps the
+// limits of other page sizes.
+func mergeHugepageLimits(limits, updates []*types.HugepageLimit) []*types.HugepageLimit {
+	merged := make([]*types.HugepageLimit, 0, len(limits)+len(updates))
+	updated := map[string]bool{}
+	for _, u := range updates {
+		updated[u.PageSize] = true
+	}
+	for _, l := range limits {
+		if !updated[l.PageSize] {
+			merged = append(merged, l)
+		}
+	}
+	return append(merged, updates...)
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/pkg/collect"
 	"github.com/alibaba/pouch/pkg/meta"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -121,6 +123,22 @@ func Test_parseSecurityOpt(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid security option",
+			args: args{
+				meta:        &Container{},
+				securityOpt: "no-new-privileges=true",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid security option",
+			args: args{
+				meta:        &Container{},
+				securityOpt: "no-new-privileges=yes",
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid security option",
 			args: args{
@@ -165,6 +183,21 @@ func Test_parsePSOutput(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "testParsePSOutputFilterPIDs",
+			args: args{
+				output: []byte("  PID STAT COMMAND\n    1 Ss   sh\n    7 R    ps\n   12 S    top -b\n"),
+				pids:   []int{1, 12},
+			},
+			want: &types.ContainerProcessList{
+				Processes: [][]string{
+					{"1", "Ss", "sh"},
+					{"12", "S", "top -b"},
+				},
+				Titles: []string{"PID", "STAT", "COMMAND"},
+			},
+			wantErr: false,
+		},
 		{
 			name: "testParsePSOutputWithNoPID",
 			args: args{
@@ -189,6 +222,20 @@ func Test_parsePSOutput(t *testing.T) {
 	}
 }
 
+func Test_sandboxProcessList(t *testing.T) {
+	got := sandboxProcessList([]ctrd.ProcessInfo{{Pid: 1}, {Pid: 23, ExecID: "exec-1"}})
+	want := &types.ContainerProcessList{
+		Titles: []string{"PID", "EXEC ID"},
+		Processes: [][]string{
+			{"1", "-"},
+			{"23", "exec-1"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sandboxProcessList() = %v, want %v", got, want)
+	}
+}
+
 func Test_mergeEnvSlice(t *testing.T) {
 	type args struct {
 		newEnv []string
@@ -286,3 +333,46 @@ func Test_mergeEnvSlice(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeHugepageLimits(t *testing.T) {
+	limits := []*types.HugepageLimit{
+		{PageSize: "2MB", Limit: 1 << 30},
+		{PageSize: "1GB", Limit: 2 << 30},
+	}
+
+	merged := mergeHugepageLimits(limits, []*types.HugepageLimit{
+		{PageSize: "2MB", Limit: 2 << 30},
+		{PageSize: "64KB", Limit: 1 << 20},
+	})
+	assert.Equal(t, []*types.HugepageLimit{
+		{PageSize: "1GB", Limit: 2 << 30},
+		{PageSize: "2MB", Limit: 2 << 30},
+		{PageSize: "64KB", Limit: 1 << 20},
+	}, merged)
+
+	assert.Equal(t, limits, mergeHugepageLimits(nil, limits))
+}
+
+func TestMergeRuntimeDefaults(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{Runtimes: map[string]types.Runtime{
+		"kata": {
+			Type:        ctrd.RuntimeTypeV2kataV2,
+			Annotations: map[string]string{kataDefaultVCPUs: "2", kataDefaultMemory: "2048"},
+			Mounts:      []string{"/etc/localtime:/etc/localtime:ro", "/opt/kata:/opt"},
+		},
+	}}}
+
+	cfg := &types.ContainerCreateConfig{
+		ContainerConfig: types.ContainerConfig{SpecAnnotation: map[string]string{kataDefaultMemory: "4096"}},
+		HostConfig:      &types.HostConfig{Runtime: "kata", Binds: []string{"/data:/opt/"}},
+	}
+	mgr.mergeRuntimeDefaults(cfg)
+	assert.Equal(t, map[string]string{kataDefaultVCPUs: "2", kataDefaultMemory: "4096"}, cfg.SpecAnnotation)
+	assert.Equal(t, []string{"/data:/opt/", "/etc/localtime:/etc/localtime:ro"}, cfg.HostConfig.Binds)
+
+	// nothing is merged for the other runtimes.
+	cfg = &types.ContainerCreateConfig{HostConfig: &types.HostConfig{Runtime: "runc"}}
+	mgr.mergeRuntimeDefaults(cfg)
+	assert.Nil(t, cfg.SpecAnnotation)
+	assert.Nil(t, cfg.HostConfig.Binds)
+}
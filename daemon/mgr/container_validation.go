@@ -1,16 +1,20 @@
 package mgr
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	daemon_config "github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/logger"
 	"github.com/alibaba/pouch/daemon/logger/jsonfile"
-	"github.com/alibaba/pouch/daemon/logger/syslog"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -30,6 +34,18 @@ var (
 	// all: all GPUs will be accessible
 	supportedDrivers = map[string]*struct{}{"compute": nil, "compat32": nil, "graphics": nil, "utility": nil, "video": nil, "display": nil}
 
+	// ipcSysctls are the sysctls in ipc namespace besides fs.mqueue.*.
+	ipcSysctls = map[string]bool{
+		"kernel.msgmax":          true,
+		"kernel.msgmnb":          true,
+		"kernel.msgmni":          true,
+		"kernel.sem":             true,
+		"kernel.shmall":          true,
+		"kernel.shmmax":          true,
+		"kernel.shmmni":          true,
+		"kernel.shm_rmid_forced": true,
+	}
+
 	errInvalidDevice    = errors.New("invalid nvidia device")
 	errInvalidDriver    = errors.New("invalid nvidia driver capability")
 	errInvalidDiskQuota = errors.New("invalid disk quota")
@@ -56,10 +72,34 @@ func (mgr *ContainerManager) validateConfig(c *Container, update bool) ([]string
 	if err != nil {
 		return nil, err
 	}
+	// resolves GPU device request into nvidia config
+	if err := resolveGPURequests(&hostConfig.Resources); err != nil {
+		return warnings, err
+	}
 	// validates nvidia config
 	if err := validateNvidiaConfig(&hostConfig.Resources); err != nil {
 		return warnings, err
 	}
+	// validates user namespace mode
+	if err := mgr.validateUsernsMode(hostConfig); err != nil {
+		return warnings, err
+	}
+	// validates and resolves capabilities
+	if err := mgr.resolveCapabilities(c); err != nil {
+		return warnings, err
+	}
+	// validates CDI devices
+	if err := validateCDIDevices(c); err != nil {
+		return warnings, err
+	}
+	// validates kata annotations
+	if err := validateKataAnnotations(c); err != nil {
+		return warnings, err
+	}
+	// validates runsc options
+	if err := mgr.validateRunscOptions(c); err != nil {
+		return warnings, err
+	}
 	warnings = append(warnings, warns...)
 
 	if hostConfig.OomScoreAdj < -1000 || hostConfig.OomScoreAdj > 1000 {
@@ -70,26 +110,104 @@ func (mgr *ContainerManager) validateConfig(c *Container, update bool) ([]string
 		return warnings, fmt.Errorf("shm-size %d should greater than 0", *hostConfig.ShmSize)
 	}
 
+	// validate tmpfs mounts
+	if err := validateTmpfs(c); err != nil {
+		return warnings, err
+	}
+
 	// validate log config
 	if err := mgr.validateLogConfig(c); err != nil {
 		return warnings, err
 	}
 
+	// validate memory pressure policy
+	if err := validateMemoryPressurePolicy(hostConfig.MemoryPressurePolicy); err != nil {
+		return warnings, err
+	}
+
+	// validate OCI hooks
+	for _, hook := range hostConfig.Hooks {
+		if err := daemon_config.ValidateHook(hook); err != nil {
+			return warnings, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+	}
+
+	// validate namespace modes
+	for _, err := range []error{
+		validateNamespaceMode("ipc", hostConfig.IpcMode, ipcModeShareable, ipcModeNone),
+		validateNamespaceMode("pid", hostConfig.PidMode),
+		validateNamespaceMode("uts", hostConfig.UTSMode),
+	} {
+		if err != nil {
+			return warnings, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+	}
+
+	// validate sysctls
+	if err := validateSysctls(hostConfig); err != nil {
+		return warnings, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	// validate ulimits
+	if err := validateUlimits(hostConfig.Ulimits); err != nil {
+		return warnings, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	// validate extra hosts
+	if err := opts.ValidateExtraHosts(hostConfig.ExtraHosts); err != nil {
+		return warnings, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	// rich mode runs its own init as the first process.
+	if hostConfig.Init != nil && *hostConfig.Init && c.Config.Rich {
+		return warnings, errors.Wrap(errtypes.ErrInvalidParam, "conflicting options: init and rich mode")
+	}
+
+	// the auto removed container can't be restarted.
+	if hostConfig.AutoRemove && hostConfig.RestartPolicy != nil && !(*ContainerRestartPolicy)(hostConfig.RestartPolicy).IsNone() {
+		return warnings, fmt.Errorf("conflicting options: AutoRemove and restart policy %s", hostConfig.RestartPolicy.Name)
+	}
+
+	// validate dependencies
+	if err := mgr.validateDependencies(c); err != nil {
+		return warnings, err
+	}
+
+	// validate network bandwidth limits
+	if err := validateNetworkBandwidth(hostConfig.NetworkBandwidth, hostConfig.NetworkMode); err != nil {
+		return warnings, err
+	}
+
+	// validate the container whose network namespace is joined
+	if !update {
+		if err := mgr.validateNetworkContainer(c); err != nil {
+			return warnings, err
+		}
+	}
+
+	// validate healthcheck
+	if err := validateHealthcheck(c.Config.Healthcheck); err != nil {
+		return warnings, err
+	}
+
 	// validate seccomp, apparmor security parameters
 	sysInfo := system.NewInfo()
 	if !sysInfo.Seccomp {
-		if c.SeccompProfile != "" || c.SeccompProfile != ProfileUnconfined {
+		if c.SeccompProfile != "" && c.SeccompProfile != ProfileUnconfined {
 			warnings = append(warnings, fmt.Sprintf("Current Kernel does not support seccomp, discard --security-opt seccomp=%s", c.SeccompProfile))
 		}
 		// always set SeccompProfile to unconfined if kernel not support seccomp
 		c.SeccompProfile = ProfileUnconfined
-
+	} else if err := mgr.resolveSeccompProfile(c); err != nil {
+		return warnings, err
 	}
 	if !sysInfo.AppArmor {
 		if c.AppArmorProfile != "" {
 			warnings = append(warnings, fmt.Sprintf("Current Kernel does not support apparmor, discard --security-opt apparmor=%s", c.AppArmorProfile))
 		}
 		c.AppArmorProfile = ""
+	} else {
+		resolveAppArmorProfile(c)
 	}
 
 	return warnings, nil
@@ -135,6 +253,161 @@ func (mgr *ContainerManager) validateDiskQuota(config *types.ContainerCreateConf
 	return nil
 }
 
+// validateStorageOpt validates the storage options of container. The size of
+// writable layer is limited by the project quota on the upper directory of
+// overlayfs snapshot, so that the backing filesystem must support quota.
+func (mgr *ContainerManager) validateStorageOpt(ctx context.Context, config *types.ContainerCreateConfig) error {
+	for key := range config.HostConfig.StorageOpt {
+		if key != storageOptSize {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "unknown storage option %s", key)
+		}
+	}
+
+	size, ok := config.HostConfig.StorageOpt[storageOptSize]
+	if !ok {
+		return nil
+	}
+
+	if n, err := units.RAMInBytes(size); err != nil || n <= 0 {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "invalid storage option size %s", size)
+	}
+
+	for _, exp := range []string{"/", ".*"} {
+		if _, exist := config.DiskQuota[exp]; exist {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "storage option size conflicts with disk quota %s of rootfs", exp)
+		}
+	}
+
+	// the devmapper snapshotter creates the thin device of writable layer
+	// with its configured base size, which can't be changed per container.
+	snapshotter := ctrd.CurrentSnapshotterName(ctx)
+	if snapshotter != "overlayfs" {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "storage option size is not supported by %s snapshotter", snapshotter)
+	}
+
+	root := filepath.Join(mgr.Config.HomeDir, "containerd/root", "io.containerd.snapshotter.v1.overlayfs")
+	if err := quota.CheckQuotaSupport(root); err != nil {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "storage option size is not supported: %v", err)
+	}
+	return nil
+}
+
+// validateSnapshotter validates the snapshotter chosen by user, it should be
+// the default or remote snapshotter unless multi snapshotter is allowed.
+func (mgr *ContainerManager) validateSnapshotter(snapshotter string) error {
+	if snapshotter == "" || mgr.Config.AllowMultiSnapshotter {
+		return nil
+	}
+
+	if snapshotter != ctrd.CurrentSnapshotterName(context.TODO()) && snapshotter != mgr.Config.RemoteSnapshotter {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "snapshotter %s is not allowed, enable allow-multi-snapshotter to use it", snapshotter)
+	}
+	return nil
+}
+
+// labelSnapshotter is the image label to choose the snapshotter of container.
+const labelSnapshotter = "io.alibaba.pouch.snapshotter"
+
+// imageSnapshotter returns the snapshotter labeled on the image, so that the
+// image built for the specific snapshotter, such as devmapper or btrfs, runs
+// in it by default.
+func (mgr *ContainerManager) imageSnapshotter(ctx context.Context, image string) (string, error) {
+	img, err := mgr.ImageMgr.GetImage(ctx, image)
+	if err != nil || img.Config == nil {
+		// the image not found is reported when checking its reference.
+		return "", nil
+	}
+
+	snapshotter := img.Config.Labels[labelSnapshotter]
+	if err := mgr.validateSnapshotter(snapshotter); err != nil {
+		return "", errors.Wrapf(err, "invalid snapshotter labeled on image %s", image)
+	}
+	return snapshotter, nil
+}
+
+// validateTmpfs verifies the tmpfs mounts do not conflict with the mount
+// points of container.
+func validateTmpfs(c *Container) error {
+	for dest, options := range c.HostConfig.Tmpfs {
+		if !filepath.IsAbs(dest) || filepath.Clean(dest) == "/" {
+			return fmt.Errorf("invalid tmpfs destination %s: must be an absolute path other than /", dest)
+		}
+		if hasContainerMount(c, filepath.Clean(dest)) {
+			return fmt.Errorf("conflicting options: tmpfs and mount point both on %s", dest)
+		}
+		if _, err := parseTmpfsOptions(options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateNamespaceMode verifies the mode of ipc, pid or uts namespace,
+// extra are the modes supported besides host, private and container.
+func validateNamespaceMode(ns, mode string, extra ...string) error {
+	switch {
+	case mode == "", mode == namespaceModePrivate, isHost(mode):
+		return nil
+	case isContainer(mode):
+		if connectedContainer(mode) == "" {
+			return fmt.Errorf("invalid %s mode %s, container should be set", ns, mode)
+		}
+		return nil
+	}
+
+	for _, m := range extra {
+		if mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s mode %s", ns, mode)
+}
+
+// validateSysctls verifies the sysctls are in the namespaces of container,
+// which are not shared with the host, since the other ones would change
+// the host.
+func validateSysctls(hostConfig *types.HostConfig) error {
+	for key := range hostConfig.Sysctls {
+		switch {
+		case ipcSysctls[key] || strings.HasPrefix(key, "fs.mqueue."):
+			if isHost(hostConfig.IpcMode) {
+				return fmt.Errorf("sysctl %s is not allowed in the host ipc namespace", key)
+			}
+		case strings.HasPrefix(key, "net."):
+			if IsHost(hostConfig.NetworkMode) {
+				return fmt.Errorf("sysctl %s is not allowed in the host network namespace", key)
+			}
+		case key == "kernel.domainname":
+			if isHost(hostConfig.UTSMode) {
+				return fmt.Errorf("sysctl %s is not allowed in the host uts namespace", key)
+			}
+		default:
+			return fmt.Errorf("sysctl %s is not namespaced, only net.*, fs.mqueue.*, kernel.msg*, kernel.sem, kernel.shm* and kernel.domainname are allowed", key)
+		}
+	}
+	return nil
+}
+
+// validateUlimits verifies the names of ulimits are valid and unique, and
+// the soft limits don't exceed the hard ones.
+func validateUlimits(ulimits []*types.Ulimit) error {
+	names := make(map[string]bool, len(ulimits))
+	for _, ul := range ulimits {
+		if ul == nil {
+			return fmt.Errorf("ulimit cannot be empty")
+		}
+		if names[ul.Name] {
+			return fmt.Errorf("duplicate ulimit %s", ul.Name)
+		}
+		names[ul.Name] = true
+
+		if err := daemon_config.ValidateUlimit(&units.Ulimit{Name: ul.Name, Soft: ul.Soft, Hard: ul.Hard}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateRichMode verifies rich mode parameters
 func validateRichMode(c *Container) error {
 	richModes := []string{
@@ -210,17 +483,33 @@ func validateResource(r *types.Resources, update bool) ([]string, error) {
 		if r.Memory > 0 && r.MemorySwap > 0 && r.MemorySwap < 2*r.Memory {
 			warnings = append(warnings, "You should typically size your swap space to approximately 2x main memory for systems with less than 2GB of RAM")
 		}
+		// the client sets the default values of swappiness and oom kill
+		// disable, which have no equivalent in cgroup v2, so only warn when
+		// they are set explicitly on cgroup v2.
+		unified := cgroupInfo.Version == system.CgroupV2
 		if r.MemorySwappiness != nil && !cgroupInfo.Memory.MemorySwappiness {
-			log.With(nil).Warn(MemorySwappinessWarn)
-			warnings = append(warnings, MemorySwappinessWarn)
+			if !unified || *r.MemorySwappiness > 0 {
+				log.With(nil).Warn(MemorySwappinessWarn)
+				warnings = append(warnings, MemorySwappinessWarn)
+			}
 			r.MemorySwappiness = nil
 		}
 		if r.MemorySwappiness != nil && *r.MemorySwappiness != -1 && (*r.MemorySwappiness < 0 || *r.MemorySwappiness > 100) {
 			return warnings, fmt.Errorf("MemorySwappiness should in range [0, 100] or -1 as a legacy alias of 0")
 		}
+		if r.KernelMemory > 0 && !cgroupInfo.Memory.KernelMemory {
+			log.With(nil).Warn(KernelMemoryWarn)
+			warnings = append(warnings, KernelMemoryWarn)
+			r.KernelMemory = 0
+		}
+		if r.KernelMemory != 0 && r.KernelMemory < MinMemory {
+			return warnings, fmt.Errorf("Minimal kernel memory should greater than 4M")
+		}
 		if r.OomKillDisable != nil && !cgroupInfo.Memory.OOMKillDisable {
-			log.With(nil).Warn(OOMKillWarn)
-			warnings = append(warnings, OOMKillWarn)
+			if !unified || *r.OomKillDisable {
+				log.With(nil).Warn(OOMKillWarn)
+				warnings = append(warnings, OOMKillWarn)
+			}
 			r.OomKillDisable = nil
 		}
 	}
@@ -329,10 +618,13 @@ func (mgr *ContainerManager) validateLogConfig(c *Container) error {
 		}
 
 		// try to parse the max-buffer-size option
-		_, err := units.RAMInBytes(maxBufferSize)
+		size, err := units.RAMInBytes(maxBufferSize)
 		if err != nil {
 			return errors.Wrapf(err, "failed to parse option max-buffer-size: %s", maxBufferSize)
 		}
+		if size <= 0 {
+			return fmt.Errorf("max-buffer-size should be positive, but got %s", maxBufferSize)
+		}
 	}
 
 	// filter the option which have been validated in common.
@@ -343,18 +635,16 @@ func (mgr *ContainerManager) validateLogConfig(c *Container) error {
 		}
 	}
 
-	switch logCfg.LogDriver {
-	case types.LogConfigLogDriverNone, types.LogConfigLogDriverJSONFile:
+	if logCfg.LogDriver == types.LogConfigLogDriverNone {
 		return jsonfile.ValidateLogOpt(restOpts)
-	case types.LogConfigLogDriverSyslog:
-		info, err := mgr.convContainerToLoggerInfo(c)
-		if err != nil {
-			return err
-		}
-		return syslog.ValidateSyslogOption(info)
-	default:
-		return fmt.Errorf("not support (%v) log driver yet", logCfg.LogDriver)
 	}
+
+	info, err := mgr.convContainerToLoggerInfo(c)
+	if err != nil {
+		return err
+	}
+	info.LogConfig = restOpts
+	return logger.ValidateLogOpts(logCfg.LogDriver, info)
 }
 
 // validateNvidiaConfig
@@ -423,3 +713,47 @@ func validateNvidiaDevice(r *types.Resources) error {
 	}
 	return nil
 }
+
+// updatedResources returns the kinds of resources set in the update config.
+func updatedResources(r *types.Resources) []string {
+	var kinds []string
+	if r.CPUPeriod != 0 || r.CPUQuota != 0 || r.CPUShares != 0 || r.CpusetCpus != "" || r.CpusetMems != "" {
+		kinds = append(kinds, ctrd.UpdateResourceCPU)
+	}
+	if r.Memory != 0 || r.MemorySwap != 0 || r.MemoryReservation != 0 || r.KernelMemory != 0 || r.MemorySwappiness != nil {
+		kinds = append(kinds, ctrd.UpdateResourceMemory)
+	}
+	if r.BlkioWeight != 0 || len(r.BlkioDeviceReadBps) != 0 || len(r.BlkioDeviceReadIOps) != 0 ||
+		len(r.BlkioDeviceWriteBps) != 0 || len(r.BlkioDeviceWriteIOps) != 0 {
+		kinds = append(kinds, ctrd.UpdateResourceBlkio)
+	}
+	if r.PidsLimit != 0 {
+		kinds = append(kinds, ctrd.UpdateResourcePids)
+	}
+	if len(r.HugepageLimits) != 0 {
+		kinds = append(kinds, ctrd.UpdateResourceHugetlb)
+	}
+	return kinds
+}
+
+// validateRuntimeUpdate verifies the runtime of running container supports
+// updating the resources.
+func validateRuntimeUpdate(c *Container, resources *types.Resources) error {
+	runtimeType := c.HostConfig.RuntimeType
+	if runtimeType == "" {
+		runtimeType = ctrd.RuntimeTypeV1
+	}
+
+	supported := ctrd.UpdatableResources(runtimeType)
+	for _, kind := range updatedResources(resources) {
+		if !utils.StringInSlice(supported, kind) {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "runtime %s of container %s can't update %s resources when it's running, only %s could be updated",
+				c.HostConfig.Runtime, c.ID, kind, strings.Join(supported, ", "))
+		}
+	}
+
+	if isKataRuntime(c) {
+		return validateKataUpdate(c, resources)
+	}
+	return nil
+}
@@ -1,11 +1,16 @@
 package mgr
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/collect"
 
+	units "github.com/docker/go-units"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -181,3 +186,162 @@ func TestValidateResource(t *testing.T) {
 		assert.Equal(t, tc.errExpected, err)
 	}
 }
+
+func TestValidateTmpfs(t *testing.T) {
+	c := &Container{
+		HostConfig: &types.HostConfig{Tmpfs: map[string]string{"/run": "size=64m"}},
+		Mounts:     []*types.MountPoint{{Destination: "/data/"}},
+	}
+	assert.NoError(t, validateTmpfs(c))
+
+	for _, tmpfs := range []map[string]string{
+		{"run": ""},
+		{"/": ""},
+		{"/data": ""},
+		{"/run": "size=foo"},
+		{"/run": "mode=rw"},
+	} {
+		c.HostConfig.Tmpfs = tmpfs
+		assert.Error(t, validateTmpfs(c), "%v", tmpfs)
+	}
+}
+
+func TestValidateNamespaceMode(t *testing.T) {
+	for _, mode := range []string{"", "private", "host", "container:foo"} {
+		assert.NoError(t, validateNamespaceMode("pid", mode), mode)
+	}
+	for _, mode := range []string{"shareable", "none"} {
+		assert.NoError(t, validateNamespaceMode("ipc", mode, ipcModeShareable, ipcModeNone), mode)
+		assert.Error(t, validateNamespaceMode("uts", mode), mode)
+	}
+	for _, mode := range []string{"foo", "container:", "container"} {
+		assert.Error(t, validateNamespaceMode("ipc", mode, ipcModeShareable, ipcModeNone), mode)
+	}
+}
+
+func TestPrepareUtsNamespace(t *testing.T) {
+	owner := newDependencyTestContainer("owner")
+	owner.Config.Hostname = "owner-host"
+	owner.Config.Domainname = "example.com"
+	mgr := newDependencyTestManager(owner)
+	mgr.ExecProcesses = collect.NewSafeMap()
+
+	c := newDependencyTestContainer("joiner")
+	c.Config.Hostname = "joiner"
+	c.HostConfig.UTSMode = "container:name-owner"
+	assert.NoError(t, mgr.prepareUtsNamespace(context.Background(), c))
+	assert.Equal(t, "owner-host", c.Config.Hostname.String())
+	assert.Equal(t, "example.com", c.Config.Domainname)
+
+	// the stopped container's uts namespace can't be joined.
+	owner.State = &types.ContainerState{Status: types.StatusStopped}
+	assert.Error(t, mgr.prepareUtsNamespace(context.Background(), c))
+
+	c = newDependencyTestContainer("private")
+	c.Config.Hostname = "private"
+	assert.NoError(t, mgr.prepareUtsNamespace(context.Background(), c))
+	assert.Equal(t, "private", c.Config.Hostname.String())
+}
+
+func TestValidateSysctls(t *testing.T) {
+	assert.NoError(t, validateSysctls(&types.HostConfig{
+		Sysctls: map[string]string{
+			"net.core.somaxconn":     "1024",
+			"kernel.shmmax":          "68719476736",
+			"kernel.msgmnb":          "65536",
+			"fs.mqueue.msg_max":      "100",
+			"kernel.domainname":      "example.com",
+			"net.ipv4.ip_forward":    "1",
+			"kernel.shm_rmid_forced": "1",
+		},
+	}))
+
+	for _, hostConfig := range []*types.HostConfig{
+		{Sysctls: map[string]string{"vm.swappiness": "0"}},
+		{Sysctls: map[string]string{"kernel.panic": "10"}},
+		{Sysctls: map[string]string{"net.core.somaxconn": "1024"}, NetworkMode: "host"},
+		{Sysctls: map[string]string{"kernel.shmmax": "1024"}, IpcMode: "host"},
+		{Sysctls: map[string]string{"fs.mqueue.msg_max": "100"}, IpcMode: "host"},
+		{Sysctls: map[string]string{"kernel.domainname": "example.com"}, UTSMode: "host"},
+	} {
+		assert.Error(t, validateSysctls(hostConfig), "%v", hostConfig.Sysctls)
+	}
+}
+
+func TestValidateUlimits(t *testing.T) {
+	assert.NoError(t, validateUlimits([]*types.Ulimit{
+		{Name: "nofile", Soft: 1024, Hard: 65536},
+		{Name: "memlock", Soft: -1, Hard: -1},
+		{Name: "core", Soft: 0, Hard: -1},
+	}))
+
+	for _, ulimits := range [][]*types.Ulimit{
+		{nil},
+		{{Name: "foo", Soft: 1, Hard: 1}},
+		{{Name: "nofile", Soft: 65536, Hard: 1024}},
+		{{Name: "nofile", Soft: -1, Hard: 1024}},
+		{{Name: "nofile", Soft: -2, Hard: 1024}},
+		{{Name: "nofile", Soft: 1024, Hard: 1024}, {Name: "nofile", Soft: 2048, Hard: 2048}},
+	} {
+		assert.Error(t, validateUlimits(ulimits), "%v", ulimits)
+	}
+}
+
+func TestMergeDefaultUlimits(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{
+		DefaultUlimits: map[string]*units.Ulimit{
+			"nproc":  {Name: "nproc", Soft: 4096, Hard: 4096},
+			"nofile": {Name: "nofile", Soft: 1024, Hard: 1024},
+			"core":   {Name: "core", Soft: 0, Hard: 0},
+		},
+	}}
+
+	assert.Equal(t, []*types.Ulimit{
+		{Name: "nofile", Soft: 65536, Hard: 65536},
+		{Name: "core", Soft: 0, Hard: 0},
+		{Name: "nproc", Soft: 4096, Hard: 4096},
+	}, mgr.mergeDefaultUlimits([]*types.Ulimit{{Name: "nofile", Soft: 65536, Hard: 65536}}))
+
+	mgr.Config.DefaultUlimits = nil
+	assert.Nil(t, mgr.mergeDefaultUlimits(nil))
+}
+
+func TestValidateSnapshotter(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{RemoteSnapshotter: "stargz"}}
+
+	for _, sn := range []string{"", ctrd.CurrentSnapshotterName(context.TODO()), "stargz"} {
+		assert.NoError(t, mgr.validateSnapshotter(sn), sn)
+	}
+	assert.Error(t, mgr.validateSnapshotter("btrfs"))
+
+	mgr.Config.AllowMultiSnapshotter = true
+	assert.NoError(t, mgr.validateSnapshotter("btrfs"))
+}
+
+func TestValidateStorageOpt(t *testing.T) {
+	mgr := &ContainerManager{Config: &config.Config{}}
+	ctx := context.TODO()
+
+	newConfig := func(storageOpt, diskQuota map[string]string) *types.ContainerCreateConfig {
+		return &types.ContainerCreateConfig{
+			ContainerConfig: types.ContainerConfig{DiskQuota: diskQuota},
+			HostConfig:      &types.HostConfig{StorageOpt: storageOpt},
+		}
+	}
+
+	assert.NoError(t, mgr.validateStorageOpt(ctx, newConfig(nil, nil)))
+
+	for _, tc := range []*types.ContainerCreateConfig{
+		newConfig(map[string]string{"foo": "bar"}, nil),
+		newConfig(map[string]string{"size": "foo"}, nil),
+		newConfig(map[string]string{"size": "10G"}, map[string]string{"/": "20G"}),
+		newConfig(map[string]string{"size": "10G"}, map[string]string{".*": "20G"}),
+	} {
+		assert.Error(t, mgr.validateStorageOpt(ctx, tc), "%v", tc.HostConfig.StorageOpt)
+	}
+
+	// only the overlayfs snapshotter supports to limit the writable layer.
+	err := mgr.validateStorageOpt(ctrd.WithSnapshotter(ctx, "devmapper"), newConfig(map[string]string{"size": "10G"}, nil))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "devmapper")
+}
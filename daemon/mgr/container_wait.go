@@ -0,0 +1,104 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/events"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// WaitConditionNotRunning waits until the container is not running, it
+	// returns immediately if the container is not running. It is the
+	// default condition.
+	WaitConditionNotRunning = "not-running"
+
+	// WaitConditionNextExit waits until the next exit of container, even if
+	// the container is not running now.
+	WaitConditionNextExit = "next-exit"
+
+	// WaitConditionRemoved waits until the container is removed.
+	WaitConditionRemoved = "removed"
+)
+
+// Wait stops processing until the given container satisfies the condition,
+// and returns the last exit code of container.
+func (mgr *ContainerManager) Wait(ctx context.Context, name, condition string) (types.ContainerWaitOKBody, error) {
+	switch condition {
+	case "":
+		condition = WaitConditionNotRunning
+	case WaitConditionNotRunning, WaitConditionNextExit, WaitConditionRemoved:
+	default:
+		return types.ContainerWaitOKBody{}, errors.Wrapf(errtypes.ErrInvalidParam, "invalid wait condition %s, should be %s, %s or %s",
+			condition, WaitConditionNotRunning, WaitConditionNextExit, WaitConditionRemoved)
+	}
+
+	c, err := mgr.container(name)
+	if err != nil {
+		return types.ContainerWaitOKBody{}, err
+	}
+
+	// subscribe to the events before checking the state, so that the exit
+	// between them is not missed.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	_, evCh, errCh := mgr.eventsService.Subscribe(ctx, time.Time{}, time.Time{}, events.NewFilter(filters.NewArgs(
+		filters.Arg("type", string(types.EventTypeContainer)),
+		filters.Arg("container", c.ID),
+		filters.Arg("event", "die"),
+		filters.Arg("event", "destroy"),
+	)))
+
+	// We should notice that container's meta data shouldn't be locked in wait process, otherwise waiting for
+	// a running container to stop would make other client commands which manage this container are blocked.
+	status := types.ContainerWaitOKBody{
+		Error:      c.State.Error,
+		StatusCode: c.ExitCode(),
+	}
+	if condition == WaitConditionNotRunning && !c.IsRunningOrPaused() {
+		return status, nil
+	}
+
+	for {
+		select {
+		case ev := <-evCh:
+			if ev.Action == "destroy" {
+				// the container will never exit again once it's removed.
+				return status, nil
+			}
+
+			status = types.ContainerWaitOKBody{StatusCode: eventExitCode(ev)}
+			if condition != WaitConditionRemoved {
+				return status, nil
+			}
+		case err := <-errCh:
+			if err == nil {
+				err = ctx.Err()
+			}
+			if err == nil {
+				err = fmt.Errorf("events service is closed")
+			}
+			return types.ContainerWaitOKBody{}, errors.Wrapf(err, "failed to wait for container %s", c.ID)
+		}
+	}
+}
+
+// eventExitCode returns the exit code in the attributes of die event.
+func eventExitCode(ev *types.EventsMessage) int64 {
+	if ev.Actor == nil {
+		return 0
+	}
+	code, err := strconv.ParseInt(ev.Actor.Attributes["exitCode"], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return code
+}
@@ -0,0 +1,81 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/events"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// waitResult is the result of ContainerManager.Wait.
+type waitResult struct {
+	status types.ContainerWaitOKBody
+	err    error
+}
+
+// waitInBackground calls Wait in background, and publishes the events of
+// container repeatedly until Wait returns, since the events published
+// before the subscription of Wait are not received.
+func waitInBackground(mgr *ContainerManager, c *Container, condition string, actions ...string) waitResult {
+	done := make(chan waitResult, 1)
+	go func() {
+		status, err := mgr.Wait(context.Background(), c.ID, condition)
+		done <- waitResult{status: status, err: err}
+	}()
+
+	for {
+		for _, action := range actions {
+			mgr.eventsService.Publish(context.Background(), action, types.EventTypeContainer, &types.EventsActor{
+				ID:         c.ID,
+				Attributes: map[string]string{"exitCode": "3"},
+			})
+		}
+
+		select {
+		case res := <-done:
+			return res
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestWait(t *testing.T) {
+	c := newDependencyTestContainer("db")
+	mgr := newDependencyTestManager(c)
+	mgr.eventsService = events.NewEvents()
+
+	_, err := mgr.Wait(context.Background(), c.ID, "unknown")
+	assert.True(t, errtypes.IsInvalidParam(err))
+
+	// the running container exits.
+	res := waitInBackground(mgr, c, "", "die")
+	assert.NoError(t, res.err)
+	assert.Equal(t, int64(3), res.status.StatusCode)
+
+	// the stopped container returns immediately.
+	c.State = &types.ContainerState{Status: types.StatusStopped, ExitCode: 1}
+	status, err := mgr.Wait(context.Background(), c.ID, WaitConditionNotRunning)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), status.StatusCode)
+
+	// the stopped container waits for the next exit.
+	res = waitInBackground(mgr, c, WaitConditionNextExit, "die")
+	assert.NoError(t, res.err)
+	assert.Equal(t, int64(3), res.status.StatusCode)
+
+	// the other events are ignored until the container is removed.
+	res = waitInBackground(mgr, c, WaitConditionRemoved, "start", "destroy")
+	assert.NoError(t, res.err)
+	assert.Equal(t, int64(1), res.status.StatusCode)
+
+	// the wait is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = mgr.Wait(ctx, c.ID, WaitConditionRemoved)
+	assert.Error(t, err)
+}
@@ -17,6 +17,9 @@ func (mgr *ContainerManager) LogContainerEvent(ctx context.Context, container *C
 
 // LogContainerEventWithAttributes generates an event related to a container with specific given attributes.
 func (mgr *ContainerManager) LogContainerEventWithAttributes(ctx context.Context, container *Container, action string, attributes map[string]string) {
+	// the writable layer may be changed by the action of container.
+	mgr.sizeCache.invalidate(container.ID)
+
 	copyAttributes(attributes, container.Config.Labels)
 	if container.Config.Image != "" {
 		attributes["image"] = container.Config.Image
@@ -80,7 +83,7 @@ func (mgr *ImageManager) LogImageEventWithAttributes(ctx context.Context, imageI
 	}
 
 	if refName != "" {
-		attributes["Name"] = refName
+		attributes["name"] = refName
 	}
 	actor := &types.EventsActor{
 		ID:         imageID,
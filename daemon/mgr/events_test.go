@@ -0,0 +1,40 @@
+package mgr
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/daemon/events"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogImageEventMatchesImageFilter(t *testing.T) {
+	store, err := newImageStore()
+	assert.NoError(t, err)
+
+	eventsService := events.NewEvents()
+	mgr := &ImageManager{localStore: store, eventsService: eventsService}
+
+	since := time.Now()
+	id := "sha256:2b8fd9751c4c0f5dd266fcae00707e67a2545ef34f9a29354585f93dac906749"
+	mgr.LogImageEvent(context.TODO(), id, "registry.hub.docker.com/library/busybox:latest", "pull")
+	mgr.LogImageEvent(context.TODO(), id, "registry.hub.docker.com/library/busybox:1.28", "tag")
+	mgr.LogImageEvent(context.TODO(), id, "registry.hub.docker.com/library/busybox:latest", "delete")
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	buffered, _, _ := eventsService.Subscribe(ctx, since, time.Now(), events.NewFilter(filters.NewArgs(
+		filters.Arg("image", "registry.hub.docker.com/library/busybox:latest"),
+	)))
+
+	var actions []string
+	for _, ev := range buffered {
+		actions = append(actions, ev.Action)
+	}
+	sort.Strings(actions)
+	assert.Equal(t, []string{"delete", "pull"}, actions)
+}
@@ -10,7 +10,9 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alibaba/pouch/apis/filters"
@@ -23,6 +25,7 @@ import (
 	"github.com/alibaba/pouch/pkg/jsonstream"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/pkg/trust"
 	"github.com/alibaba/pouch/pkg/utils"
 	searchtypes "github.com/alibaba/pouch/registry/types"
 
@@ -58,12 +61,18 @@ type ImageMgr interface {
 	// LookupImageReferences find possible image reference list.
 	LookupImageReferences(ref string) []string
 
+	// SetRegistryMirrors replaces the registry mirrors of default registry.
+	SetRegistryMirrors(mirrors []string)
+
 	// PullImage pulls images from specified registry.
 	PullImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error
 
 	// PushImage pushes image to specified registry.
 	PushImage(ctx context.Context, name, tag string, authConfig *types.AuthConfig, out io.Writer) error
 
+	// CreateManifestList creates a manifest list from local images for push.
+	CreateManifestList(ctx context.Context, name string, images []string) error
+
 	// GetImage returns imageInfo by reference or id.
 	GetImage(ctx context.Context, idOrRef string) (*types.ImageInfo, error)
 
@@ -76,6 +85,12 @@ type ImageMgr interface {
 	// RemoveImage deletes an image by reference.
 	RemoveImage(ctx context.Context, idOrRef string, force bool) error
 
+	// PruneImages removes the images not used by containers.
+	PruneImages(ctx context.Context, filter filters.Args, inUse func(ctx context.Context, id string) (bool, error)) (*types.ImagePruneResp, error)
+
+	// HoldImage prevents the image from being pruned until release is called.
+	HoldImage(id digest.Digest) (release func())
+
 	// AddTag creates target ref for source image.
 	AddTag(ctx context.Context, sourceImage string, targetRef string) error
 
@@ -88,8 +103,11 @@ type ImageMgr interface {
 	// LoadImage creates a set of images by tarstream.
 	LoadImage(ctx context.Context, imageName string, tarstream io.ReadCloser) error
 
-	// SaveImage saves image to tarstream.
-	SaveImage(ctx context.Context, idOrRef string) (io.ReadCloser, error)
+	// ImportImage creates a single layer image from the tarball of rootfs.
+	ImportImage(ctx context.Context, ref, message string, tarball io.Reader) error
+
+	// SaveImage saves image to tarstream of OCI image layout or docker-archive format.
+	SaveImage(ctx context.Context, idOrRef, format string) (io.ReadCloser, error)
 
 	// ImageHistory returns image history by reference.
 	ImageHistory(ctx context.Context, idOrRef string) ([]types.HistoryResultItem, error)
@@ -99,6 +117,18 @@ type ImageMgr interface {
 
 	// GetOCIImageConfig returns the image config of OCI
 	GetOCIImageConfig(ctx context.Context, image string) (ocispec.ImageConfig, error)
+
+	// GetImageHealthcheck returns the healthcheck of image.
+	GetImageHealthcheck(ctx context.Context, image string) (*types.HealthConfig, error)
+
+	// CheckImageTrust checks whether the image is allowed to run by the trust policy.
+	CheckImageTrust(ctx context.Context, idOrRef string) error
+
+	// LazySnapshotter returns the remote snapshotter which the image is lazily pulled into.
+	LazySnapshotter(ctx context.Context, idOrRef string) string
+
+	// FetchLazyImage fetches the whole lazily pulled image for the snapshotter.
+	FetchLazyImage(ctx context.Context, idOrRef, snapshotter string) error
 }
 
 // ImageManager is an implementation of interface ImageMgr.
@@ -113,6 +143,7 @@ type ImageManager struct {
 
 	// RegistryMirrors is a list of registry URLs that act as a mirror for the default registry.
 	RegistryMirrors []string
+	mirrorsLock     sync.RWMutex
 
 	// client is a interface to the containerd client.
 	// It is used to interact with containerd.
@@ -126,6 +157,25 @@ type ImageManager struct {
 
 	// imagePlugin is a plugin called before image operations
 	imagePlugin hookplugins.ImagePlugin
+
+	// trust verifies the signatures of images by the trust policies.
+	trust *trust.Verifier
+
+	// registries are the mirrors and credentials of registries.
+	registries map[string]config.RegistryConfig
+
+	// remoteSnapshotter is the snapshotter to lazily pull images.
+	remoteSnapshotter string
+
+	// lazyAuths are the auth configs used to lazily pull the images, they
+	// are used again to fetch the whole images.
+	lazyAuths     map[string]*types.AuthConfig
+	lazyAuthsLock sync.Mutex
+
+	// holds counts the operations going to use the images, like the
+	// containers being created, so that the images are not pruned.
+	holds     map[digest.Digest]int
+	holdsLock sync.Mutex
 }
 
 // NewImageManager initializes a brand new image manager.
@@ -135,6 +185,11 @@ func NewImageManager(cfg *config.Config, client ctrd.APIClient, eventsService *e
 		return nil, err
 	}
 
+	verifier, err := trust.NewVerifier(cfg.TrustPolicies, filepath.Join(cfg.HomeDir, "trust", "results.json"))
+	if err != nil {
+		return nil, err
+	}
+
 	mgr := &ImageManager{
 		DefaultRegistry:  cfg.DefaultRegistry,
 		DefaultNamespace: cfg.DefaultRegistryNS,
@@ -144,6 +199,10 @@ func NewImageManager(cfg *config.Config, client ctrd.APIClient, eventsService *e
 		localStore:    store,
 		eventsService: eventsService,
 		imagePlugin:   imagePlugin,
+		trust:         verifier,
+		registries:    cfg.Registries,
+
+		remoteSnapshotter: cfg.RemoteSnapshotter,
 	}
 
 	if err := mgr.updateLocalStore(); err != nil {
@@ -167,15 +226,17 @@ func (mgr *ImageManager) LookupImageReferences(ref string) []string {
 		remainder = ref
 	}
 
-	// create a list of reference name in order of RegistryMirrors, DefaultRegistry
-	// for partial reference like 'ns/ubuntu', 'ubuntu'
+	// create a list of reference name in order of RegistryMirrors, mirrors
+	// of registry and registry for partial reference like 'ns/ubuntu', 'ubuntu'
 	var fullRefs []string
 
 	// if the domain field is empty, concat the ref with registry mirror urls.
 	if registry == "" {
+		mgr.mirrorsLock.RLock()
 		for _, reg := range mgr.RegistryMirrors {
 			fullRefs = append(fullRefs, path.Join(reg, ref))
 		}
+		mgr.mirrorsLock.RUnlock()
 		registry = mgr.DefaultRegistry
 	}
 
@@ -184,11 +245,21 @@ func (mgr *ImageManager) LookupImageReferences(ref string) []string {
 		remainder = mgr.DefaultNamespace + "/" + remainder
 	}
 
+	for _, mirror := range mgr.registryMirrors(registry) {
+		fullRefs = append(fullRefs, path.Join(mirror, remainder))
+	}
 	fullRefs = append(fullRefs, registry+"/"+remainder)
 
 	return fullRefs
 }
 
+// SetRegistryMirrors replaces the registry mirrors of default registry.
+func (mgr *ImageManager) SetRegistryMirrors(mirrors []string) {
+	mgr.mirrorsLock.Lock()
+	mgr.RegistryMirrors = mirrors
+	mgr.mirrorsLock.Unlock()
+}
+
 // PullImage pulls images from specified registry.
 func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error {
 	namedRef, err := reference.Parse(ref)
@@ -222,29 +293,51 @@ func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *
 	fullRefs := mgr.LookupImageReferences(ref)
 	namedRef = reference.TrimTagForDigest(reference.WithDefaultTagIfMissing(namedRef))
 
-	resolver, availableRef, err := mgr.client.ResolveImage(ctx, namedRef.String(), fullRefs, authConfig, docker.ResolverOptions{})
+	resolver, availableRef, err := mgr.client.ResolveImage(ctx, namedRef.String(), fullRefs, authConfig, docker.ResolverOptions{
+		Credentials: mgr.registryCredentials(authConfig),
+	})
 	if err != nil {
+		closeStream()
 		return err
 	}
 	log.With(nil).Infof("pulling image name %v reference %v", namedRef.String(), availableRef)
 
-	img, err := mgr.client.FetchImage(pctx, resolver, availableRef, authConfig, stream)
+	// the image is fetched by the resolver pinned to the verified manifest.
+	resolver, trustResult, err := mgr.verifyImageTrust(ctx, resolver, availableRef)
 	if err != nil {
-		writeStream(err)
+		closeStream()
 		return err
 	}
 
-	// before image unpack, call WithImageUnpack
-	ctx = ctrd.WithImageUnpack(ctx)
+	// try to pull image lazily, and fall back to pull the whole image.
+	img := mgr.fetchImageLazily(pctx, resolver, availableRef, stream)
+	if img != nil {
+		mgr.setLazyAuth(img.Name(), authConfig)
+	} else {
+		img, err = mgr.client.FetchImage(pctx, resolver, availableRef, authConfig, stream)
+		if err != nil {
+			writeStream(err)
+			return err
+		}
+
+		// before image unpack, call WithImageUnpack
+		ctx = ctrd.WithImageUnpack(ctx)
 
-	// unpack image
-	if err = img.Unpack(ctx, ctrd.CurrentSnapshotterName(ctx)); err != nil {
+		// unpack image and send the extract progress of layers
+		if err = mgr.client.UnpackImage(ctx, img, ctrd.CurrentSnapshotterName(ctx), stream); err != nil {
+			writeStream(err)
+			return err
+		}
+	}
+
+	if err := checkImageTrustDigest(img, trustResult); err != nil {
+		if rerr := mgr.client.RemoveImage(ctx, img.Name()); rerr != nil {
+			log.With(ctx).Errorf("failed to remove unverified image %s: %v", img.Name(), rerr)
+		}
 		writeStream(err)
 		return err
 	}
 
-	closeStream()
-
 	// NOTE: pull image with different snapshotter, refer #2574
 	// clean snapshotter key if has been set, not allow
 	// user set except through image plugin
@@ -253,13 +346,26 @@ func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *
 	if mgr.imagePlugin != nil {
 		if err = mgr.imagePlugin.PostPull(ctx, ctrd.CurrentSnapshotterName(ctx), img); err != nil {
 			log.With(nil).Errorf("failed to execute post pull plugin: %s", err)
+			writeStream(err)
 			return err
 		}
 	}
 
 	mgr.LogImageEvent(ctx, img.Name(), namedRef.String(), "pull")
 
-	return mgr.StoreImageReference(ctx, img)
+	if err := mgr.StoreImageReference(ctx, img); err != nil {
+		writeStream(err)
+		return err
+	}
+	if err := mgr.storeImageTrust(ctx, img, trustResult); err != nil {
+		writeStream(err)
+		return err
+	}
+
+	// the stream is kept until the image is stored, so that the client
+	// gets the error of the whole pull.
+	closeStream()
+	return nil
 }
 
 // PushImage pushes image to specified registry.
@@ -275,7 +381,9 @@ func (mgr *ImageManager) PushImage(ctx context.Context, name, tag string, authCo
 		ref = reference.WithTag(ref, tag)
 	}
 	mgr.LogImageEvent(ctx, ref.String(), ref.String(), "push")
-	return mgr.client.PushImage(ctx, ref.String(), authConfig, out)
+	return mgr.client.PushImage(ctx, ref.String(), authConfig, docker.ResolverOptions{
+		Credentials: mgr.registryCredentials(authConfig),
+	}, out)
 }
 
 // GetImage returns imageInfo by reference.
@@ -433,9 +541,15 @@ func (mgr *ImageManager) SearchImages(ctx context.Context, name, registry string
 // RemoveImage deletes a reference.
 //
 // NOTE: if the reference is short ID or ID, should remove all the references.
-func (mgr *ImageManager) RemoveImage(ctx context.Context, idOrRef string, force bool) error {
+func (mgr *ImageManager) RemoveImage(ctx context.Context, idOrRef string, force bool) (err0 error) {
 	id, namedRef, primaryRef, err := mgr.CheckReference(ctx, idOrRef)
 	if err != nil {
+		// the manifest list is not in the local store.
+		if errtypes.IsNotfound(err) {
+			if rerr := mgr.removeManifestList(ctx, idOrRef); rerr == nil || !errtypes.IsNotfound(rerr) {
+				return rerr
+			}
+		}
 		return err
 	}
 
@@ -446,6 +560,15 @@ func (mgr *ImageManager) RemoveImage(ctx context.Context, idOrRef string, force
 	defer func() {
 		if len(mgr.localStore.GetPrimaryReferences(id)) == 0 {
 			mgr.localStore.ClearCtrdImageInfo(id)
+			if mgr.trust != nil {
+				if err := mgr.trust.Remove(id.String()); err != nil {
+					log.With(ctx).Warnf("failed to remove trust result of image %s: %v", id, err)
+				}
+			}
+
+			if err0 == nil {
+				mgr.LogImageEvent(ctx, id.String(), namedRef.String(), "delete")
+			}
 		}
 	}()
 
@@ -745,6 +868,15 @@ func (mgr *ImageManager) GetOCIImageConfig(ctx context.Context, image string) (o
 	return ociImage.Config, nil
 }
 
+// GetImageHealthcheck returns the healthcheck of image.
+func (mgr *ImageManager) GetImageHealthcheck(ctx context.Context, image string) (*types.HealthConfig, error) {
+	img, err := mgr.client.GetImage(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+	return containerdImageHealthcheck(ctx, img)
+}
+
 // updateLocalStore updates the local store.
 func (mgr *ImageManager) updateLocalStore() error {
 	ctx, cancel := context.WithTimeout(context.Background(), deadlineLoadImagesAtBootup)
@@ -880,6 +1012,11 @@ func (mgr *ImageManager) containerdImageToImageInfo(ctx context.Context, id dige
 		}
 	}
 
+	var trustResult *types.ImageTrust
+	if mgr.trust != nil {
+		trustResult = toImageTrust(mgr.trust.Result(ctrdImageInfo.ID.String()))
+	}
+
 	return types.ImageInfo{
 		Architecture: ociImage.Architecture,
 		Config:       getImageInfoConfigFromOciImage(ociImage),
@@ -892,7 +1029,8 @@ func (mgr *ImageManager) containerdImageToImageInfo(ctx context.Context, id dige
 			Type:   ociImage.RootFS.Type,
 			Layers: digestSliceToStringSlice(ociImage.RootFS.DiffIDs),
 		},
-		Size: ctrdImageInfo.Size,
+		Size:  ctrdImageInfo.Size,
+		Trust: trustResult,
 	}, nil
 }
 
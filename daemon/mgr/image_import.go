@@ -0,0 +1,52 @@
+package mgr
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// ImportImage creates the image named by ref from the tarball of rootfs,
+// the image has only one layer.
+func (mgr *ImageManager) ImportImage(ctx context.Context, ref, message string, tarball io.Reader) error {
+	namedRef, err := parseTagReference(ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := namedRef.(reference.Digested); ok {
+		return pkgerrors.Wrapf(errtypes.ErrInvalidParam, "image reference (%s) cannot contains any digest information", ref)
+	}
+	if err := mgr.validateTagReference(namedRef); err != nil {
+		return err
+	}
+
+	created := time.Now()
+	img := ocispec.Image{
+		Created:      &created,
+		Architecture: runtime.GOARCH,
+		OS:           runtime.GOOS,
+		History: []ocispec.History{{
+			Created:   &created,
+			CreatedBy: "pouch import",
+			Comment:   message,
+		}},
+	}
+
+	ctrdImg, err := mgr.client.CreateImageFromLayer(ctx, namedRef.String(), tarball, img)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create image from tarball")
+	}
+
+	if err := mgr.StoreImageReference(ctx, ctrdImg); err != nil {
+		return err
+	}
+	mgr.LogImageEvent(ctx, namedRef.String(), namedRef.String(), "import")
+	return nil
+}
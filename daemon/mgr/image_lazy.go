@@ -0,0 +1,123 @@
+package mgr
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/jsonstream"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+)
+
+// fetchImageLazily pulls the image lazily into the remote snapshotter, it
+// returns nil if the image should be pulled as usual.
+func (mgr *ImageManager) fetchImageLazily(ctx context.Context, resolver remotes.Resolver, ref string, stream *jsonstream.JSONStream) containerd.Image {
+	if mgr.remoteSnapshotter == "" {
+		return nil
+	}
+
+	img, err := mgr.client.FetchImageLazily(ctx, resolver, ref, mgr.remoteSnapshotter, stream)
+	if err != nil {
+		if err != ctrd.ErrLazyPullUnsupported {
+			log.With(ctx).Warnf("failed to lazily pull image %s, fall back to pull the whole image: %v", ref, err)
+		}
+		return nil
+	}
+	return img
+}
+
+// setLazyAuth records the auth config used to lazily pull the image.
+func (mgr *ImageManager) setLazyAuth(name string, authConfig *types.AuthConfig) {
+	mgr.lazyAuthsLock.Lock()
+	defer mgr.lazyAuthsLock.Unlock()
+
+	if mgr.lazyAuths == nil {
+		mgr.lazyAuths = make(map[string]*types.AuthConfig)
+	}
+	mgr.lazyAuths[name] = authConfig
+}
+
+// lazyAuth returns the auth config used to lazily pull the image, it is nil
+// if the image was pulled before pouchd restarts.
+func (mgr *ImageManager) lazyAuth(name string) *types.AuthConfig {
+	mgr.lazyAuthsLock.Lock()
+	defer mgr.lazyAuthsLock.Unlock()
+
+	return mgr.lazyAuths[name]
+}
+
+// lazySnapshotter returns the image and the snapshotter which the image is
+// lazily pulled into.
+func (mgr *ImageManager) lazySnapshotter(ctx context.Context, idOrRef string) (containerd.Image, string, error) {
+	_, _, primaryRef, err := mgr.CheckReference(ctx, idOrRef)
+	if err != nil {
+		return nil, "", err
+	}
+
+	img, err := mgr.client.GetImage(ctx, primaryRef.String())
+	if err != nil {
+		return nil, "", err
+	}
+	return img, img.Labels()[ctrd.LabelLazySnapshotter], nil
+}
+
+// LazySnapshotter returns the remote snapshotter which the image is lazily
+// pulled into, it returns empty if the image is pulled as usual or the
+// remote snapshotter is not enabled any more.
+func (mgr *ImageManager) LazySnapshotter(ctx context.Context, idOrRef string) string {
+	if mgr.remoteSnapshotter == "" {
+		return ""
+	}
+
+	_, snapshotter, err := mgr.lazySnapshotter(ctx, idOrRef)
+	if err != nil || snapshotter != mgr.remoteSnapshotter {
+		return ""
+	}
+	return snapshotter
+}
+
+// FetchLazyImage fetches the whole lazily pulled image, so that it can be
+// unpacked into the snapshotter other than the remote snapshotter.
+func (mgr *ImageManager) FetchLazyImage(ctx context.Context, idOrRef, snapshotter string) error {
+	img, lazySnapshotter, err := mgr.lazySnapshotter(ctx, idOrRef)
+	if err != nil {
+		return err
+	}
+	if lazySnapshotter == "" || lazySnapshotter == snapshotter {
+		return nil
+	}
+
+	log.With(ctx).Infof("fetch the lazily pulled image %s for snapshotter %s", img.Name(), snapshotter)
+
+	// fetch with the credentials of the pull, which are required by the
+	// private registry.
+	authConfig := mgr.lazyAuth(img.Name())
+	resolver, availableRef, err := mgr.client.ResolveImage(ctx, img.Name(), []string{img.Name()}, authConfig, docker.ResolverOptions{
+		Credentials: mgr.registryCredentials(authConfig),
+	})
+	if err != nil {
+		return err
+	}
+
+	stream := jsonstream.New(ioutil.Discard, nil)
+	defer func() {
+		stream.Close()
+		stream.Wait()
+	}()
+
+	// the image is stored without the label of lazy snapshotter after
+	// fetched, so that it is used as the one pulled as usual.
+	if _, err := mgr.client.FetchImage(ctx, resolver, availableRef, authConfig, stream); err != nil {
+		return err
+	}
+
+	mgr.lazyAuthsLock.Lock()
+	delete(mgr.lazyAuths, img.Name())
+	mgr.lazyAuthsLock.Unlock()
+	return nil
+}
@@ -0,0 +1,77 @@
+package mgr
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// CreateManifestList creates the manifest list named by name from the local
+// images, so that the images of different platforms can be pushed as one.
+//
+// NOTE: the manifest list is only kept in containerd for push, since it may
+// contain no image of the current platform.
+func (mgr *ImageManager) CreateManifestList(ctx context.Context, name string, images []string) error {
+	if len(images) == 0 {
+		return pkgerrors.Wrap(errtypes.ErrInvalidParam, "no image is specified for manifest list")
+	}
+
+	namedRef, err := parseTagReference(name)
+	if err != nil {
+		return err
+	}
+	if _, ok := namedRef.(reference.Digested); ok {
+		return pkgerrors.Wrapf(errtypes.ErrInvalidParam, "manifest list reference (%s) cannot contains any digest information", name)
+	}
+
+	refs := make([]string, 0, len(images))
+	for _, image := range images {
+		_, _, primaryRef, err := mgr.CheckReference(ctx, image)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, primaryRef.String())
+	}
+
+	if _, err := mgr.client.CreateManifestList(ctx, namedRef.String(), refs); err != nil {
+		return err
+	}
+	mgr.LogImageEvent(ctx, namedRef.String(), namedRef.String(), "create")
+	return nil
+}
+
+// removeManifestList removes the manifest list created by CreateManifestList,
+// since it is not in the local store. It returns ErrNotfound if name is not
+// a manifest list.
+func (mgr *ImageManager) removeManifestList(ctx context.Context, name string) error {
+	// the manifest list is always named by tag.
+	namedRef, err := parseTagReference(name)
+	if err != nil {
+		return pkgerrors.Wrapf(errtypes.ErrNotfound, "manifest list %s", name)
+	}
+	if _, ok := namedRef.(reference.Digested); ok {
+		return pkgerrors.Wrapf(errtypes.ErrNotfound, "manifest list %s", name)
+	}
+
+	img, err := mgr.client.GetImage(ctx, namedRef.String())
+	if err != nil {
+		return err
+	}
+
+	switch img.Target().MediaType {
+	case ctrdmetaimages.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+	default:
+		return pkgerrors.Wrapf(errtypes.ErrNotfound, "manifest list %s", name)
+	}
+
+	if err := mgr.client.RemoveImage(ctx, img.Name()); err != nil {
+		return err
+	}
+	mgr.LogImageEvent(ctx, img.Target().Digest.String(), img.Name(), "delete")
+	return nil
+}
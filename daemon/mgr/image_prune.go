@@ -0,0 +1,223 @@
+package mgr
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/opencontainers/go-digest"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// the filter tags set allowed when pouch image prune -f
+var acceptedImagePruneFilterTags = map[string]bool{
+	"dangling": true,
+	"unused":   true,
+	"until":    true,
+}
+
+// imagePruneOption is the parsed filters of image prune.
+type imagePruneOption struct {
+	// danglingOnly means only the images without any tag are pruned,
+	// otherwise all the images not used by containers are pruned.
+	danglingOnly bool
+
+	// until means only the images pulled before it are pruned.
+	until time.Time
+}
+
+// parseImagePruneFilter parses the filters of image prune.
+func parseImagePruneFilter(filter filters.Args) (imagePruneOption, error) {
+	opt := imagePruneOption{danglingOnly: true}
+
+	if err := filter.Validate(acceptedImagePruneFilterTags); err != nil {
+		return opt, err
+	}
+
+	for _, tag := range []string{"dangling", "unused", "until"} {
+		if len(filter.Get(tag)) > 1 {
+			return opt, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "can't use %s filter more than one", tag)
+		}
+	}
+
+	if values := filter.Get("dangling"); len(values) > 0 {
+		dangling, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return opt, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "invalid dangling filter %q", values[0])
+		}
+		opt.danglingOnly = dangling
+	}
+
+	if values := filter.Get("unused"); len(values) > 0 {
+		unused, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return opt, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "invalid unused filter %q", values[0])
+		}
+		if unused {
+			opt.danglingOnly = false
+		}
+	}
+
+	if values := filter.Get("until"); len(values) > 0 {
+		ts, err := utils.GetUnixTimestamp(values[0], time.Now())
+		if err != nil {
+			return opt, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "invalid until filter %q: %v", values[0], err)
+		}
+		sec, nsec, err := utils.ParseTimestamp(ts, 0)
+		if err != nil {
+			return opt, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "invalid until filter %q: %v", values[0], err)
+		}
+		opt.until = time.Unix(sec, nsec)
+	}
+	return opt, nil
+}
+
+// pruneCandidates returns the images which can be pruned, pulled is when
+// the images are last pulled by ID. The images whose pulled time is unknown
+// are kept if until is specified.
+func pruneCandidates(images []types.ImageInfo, pulled map[string]time.Time, opt imagePruneOption) []types.ImageInfo {
+	var candidates []types.ImageInfo
+	for _, img := range images {
+		if opt.danglingOnly && len(img.RepoTags) > 0 {
+			continue
+		}
+		if !opt.until.IsZero() {
+			if at, ok := pulled[img.ID]; !ok || !at.Before(opt.until) {
+				continue
+			}
+		}
+		candidates = append(candidates, img)
+	}
+	return candidates
+}
+
+// imagesPulledTime returns when the images are last pulled by ID, that is,
+// the latest pulled time of their primary references.
+func (mgr *ImageManager) imagesPulledTime(ctx context.Context, images []types.ImageInfo) (map[string]time.Time, error) {
+	refs, err := mgr.client.ImagePulledTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pulled := make(map[string]time.Time, len(images))
+	for _, img := range images {
+		for _, ref := range mgr.localStore.GetPrimaryReferences(digest.Digest(img.ID)) {
+			if at, ok := refs[ref.String()]; ok && at.After(pulled[img.ID]) {
+				pulled[img.ID] = at
+			}
+		}
+	}
+	return pulled, nil
+}
+
+// HoldImage prevents the image from being pruned until release is called.
+// The image might have been pruned before it is held, so the caller should
+// hold it before it uses the image, and fail if the image is not found.
+func (mgr *ImageManager) HoldImage(id digest.Digest) func() {
+	mgr.holdsLock.Lock()
+	defer mgr.holdsLock.Unlock()
+
+	if mgr.holds == nil {
+		mgr.holds = make(map[digest.Digest]int)
+	}
+	mgr.holds[id]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			mgr.holdsLock.Lock()
+			defer mgr.holdsLock.Unlock()
+
+			if mgr.holds[id]--; mgr.holds[id] <= 0 {
+				delete(mgr.holds, id)
+			}
+		})
+	}
+}
+
+// pruneImage removes the image if it is neither held nor used, and returns
+// whether it is removed. The image is checked and removed with the holds
+// locked, so that no container can start using it meanwhile.
+func (mgr *ImageManager) pruneImage(ctx context.Context, id string, inUse func(ctx context.Context, id string) (bool, error)) (bool, error) {
+	mgr.holdsLock.Lock()
+	defer mgr.holdsLock.Unlock()
+
+	if mgr.holds[digest.Digest(id)] > 0 {
+		return false, nil
+	}
+
+	used, err := inUse(ctx, id)
+	if err != nil || used {
+		return false, err
+	}
+
+	// the image with references of different repositories is kept, it's
+	// only removed by user explicitly.
+	if err := mgr.RemoveImage(ctx, id, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PruneImages removes the images which match the filter and are not in use,
+// and returns the removed images and the reclaimed bytes. Every image is
+// checked by inUse right before it is removed, see pruneImage.
+//
+// The images being pulled are not in the local store yet and their content
+// is protected by the containerd leases, so that they are never pruned.
+func (mgr *ImageManager) PruneImages(ctx context.Context, filter filters.Args, inUse func(ctx context.Context, id string) (bool, error)) (*types.ImagePruneResp, error) {
+	opt, err := parseImagePruneFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := mgr.ListImages(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	pulled, err := mgr.imagesPulledTime(ctx, images)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := mgr.client.ImageContentUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.ImagePruneResp{}
+	for _, img := range pruneCandidates(images, pulled, opt) {
+		removed, err := mgr.pruneImage(ctx, img.ID, inUse)
+		if err != nil {
+			log.With(ctx).Warnf("failed to prune image %s: %v", img.ID, err)
+			continue
+		}
+		if removed {
+			resp.ImagesDeleted = append(resp.ImagesDeleted, img.ID)
+		}
+	}
+
+	if len(resp.ImagesDeleted) == 0 {
+		return resp, nil
+	}
+
+	// the blobs which are not referenced by any image are reclaimed.
+	after, err := mgr.client.ImageContentUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for dgst, size := range before {
+		if _, ok := after[dgst]; !ok {
+			resp.SpaceReclaimed += size
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImagePruneFilter(t *testing.T) {
+	opt, err := parseImagePruneFilter(filters.NewArgs())
+	assert.NoError(t, err)
+	assert.True(t, opt.danglingOnly)
+	assert.True(t, opt.until.IsZero())
+
+	opt, err = parseImagePruneFilter(filters.NewArgs(filters.Arg("dangling", "false")))
+	assert.NoError(t, err)
+	assert.False(t, opt.danglingOnly)
+
+	opt, err = parseImagePruneFilter(filters.NewArgs(filters.Arg("unused", "true")))
+	assert.NoError(t, err)
+	assert.False(t, opt.danglingOnly)
+
+	opt, err = parseImagePruneFilter(filters.NewArgs(filters.Arg("until", "1h")))
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), opt.until, time.Minute)
+
+	for _, filter := range []filters.Args{
+		filters.NewArgs(filters.Arg("label", "foo")),
+		filters.NewArgs(filters.Arg("dangling", "foo")),
+		filters.NewArgs(filters.Arg("unused", "foo")),
+		filters.NewArgs(filters.Arg("until", "foo")),
+		filters.NewArgs(filters.Arg("until", "1h"), filters.Arg("until", "2h")),
+	} {
+		_, err := parseImagePruneFilter(filter)
+		assert.Error(t, err)
+	}
+}
+
+func TestPruneCandidates(t *testing.T) {
+	now := time.Now()
+	images := []types.ImageInfo{
+		// the created time of image config is not the pulled time.
+		{ID: "dangling", CreatedAt: now.Add(-24 * time.Hour).Format(utils.TimeLayout)},
+		{ID: "tagged", RepoTags: []string{"busybox:latest"}, CreatedAt: now.Add(-24 * time.Hour).Format(utils.TimeLayout)},
+		{ID: "new", CreatedAt: now.Add(-24 * time.Hour).Format(utils.TimeLayout)},
+		{ID: "unknown", CreatedAt: now.Add(-24 * time.Hour).Format(utils.TimeLayout)},
+	}
+	pulled := map[string]time.Time{
+		"dangling": now.Add(-2 * time.Hour),
+		"tagged":   now.Add(-2 * time.Hour),
+		"new":      now,
+	}
+
+	ids := func(images []types.ImageInfo) []string {
+		var ids []string
+		for _, img := range images {
+			ids = append(ids, img.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"dangling", "new", "unknown"}, ids(pruneCandidates(images, pulled, imagePruneOption{danglingOnly: true})))
+	assert.Equal(t, []string{"dangling", "tagged", "new", "unknown"}, ids(pruneCandidates(images, pulled, imagePruneOption{})))
+	assert.Equal(t, []string{"dangling", "tagged"}, ids(pruneCandidates(images, pulled, imagePruneOption{until: now.Add(-time.Hour)})))
+}
+
+func TestPruneImageHeldOrUsed(t *testing.T) {
+	mgr := &ImageManager{}
+	id := "sha256:2b8fd9751c4c0f5dd266fcae00707e67a2545ef34f9a29354585f93dac906749"
+
+	var checked []string
+	inUse := func(ctx context.Context, imageID string) (bool, error) {
+		checked = append(checked, imageID)
+		return true, nil
+	}
+
+	// the held image is kept without checking whether it's used.
+	release := mgr.HoldImage(digest.Digest(id))
+	anotherRelease := mgr.HoldImage(digest.Digest(id))
+	removed, err := mgr.pruneImage(context.TODO(), id, inUse)
+	assert.NoError(t, err)
+	assert.False(t, removed)
+	assert.Empty(t, checked)
+
+	// release is idempotent, the image is held until all are released.
+	release()
+	release()
+	removed, err = mgr.pruneImage(context.TODO(), id, inUse)
+	assert.NoError(t, err)
+	assert.False(t, removed)
+	assert.Empty(t, checked)
+
+	// the used image is checked right before removal and kept.
+	anotherRelease()
+	assert.Empty(t, mgr.holds)
+	removed, err = mgr.pruneImage(context.TODO(), id, inUse)
+	assert.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, []string{id}, checked)
+
+	_, err = mgr.pruneImage(context.TODO(), id, func(ctx context.Context, imageID string) (bool, error) {
+		return false, fmt.Errorf("failed to list containers")
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package mgr
+
+import (
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/registry"
+)
+
+// dockerHubHosts are the hosts of docker hub, the config of any of them
+// applies to docker hub.
+var dockerHubHosts = []string{"docker.io", "index.docker.io", "registry-1.docker.io", "registry.hub.docker.com"}
+
+// isDockerHub returns whether the registry host is docker hub.
+func isDockerHub(host string) bool {
+	for _, h := range dockerHubHosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupRegistryConfig returns the config of registry host in registries,
+// the config of any docker hub host applies to docker hub.
+func lookupRegistryConfig(registries map[string]config.RegistryConfig, host string) (config.RegistryConfig, bool) {
+	if cfg, ok := registries[host]; ok {
+		return cfg, true
+	}
+
+	if isDockerHub(host) {
+		for _, hub := range dockerHubHosts {
+			if cfg, ok := registries[hub]; ok {
+				return cfg, true
+			}
+		}
+	}
+	return config.RegistryConfig{}, false
+}
+
+// registryMirrors returns the mirrors of registry host.
+func (mgr *ImageManager) registryMirrors(host string) []string {
+	cfg, _ := lookupRegistryConfig(mgr.registries, host)
+	return cfg.Mirrors
+}
+
+// registryCredentials returns the function providing the credentials of
+// registry hosts. The credentials of request are used for all the hosts if
+// provided, otherwise the ones of host in config are used.
+func (mgr *ImageManager) registryCredentials(authConfig *types.AuthConfig) func(string) (string, string, error) {
+	return func(host string) (string, string, error) {
+		if authConfig != nil {
+			if authConfig.Username != "" || authConfig.Password != "" {
+				return authConfig.Username, authConfig.Password, nil
+			}
+			if authConfig.IdentityToken != "" {
+				return "", authConfig.IdentityToken, nil
+			}
+		}
+
+		cfg, ok := lookupRegistryConfig(mgr.registries, host)
+		if !ok {
+			return "", "", nil
+		}
+		if cfg.CredentialHelper != "" {
+			return registry.GetHelperCredentials(cfg.CredentialHelper, host)
+		}
+		return cfg.Username, cfg.Password, nil
+	}
+}
+
+// registryIndexConfigs returns the effective configs of the default registry
+// and the registries in config, the credentials are not included.
+func registryIndexConfigs(cfg *config.Config) map[string]types.IndexInfo {
+	insecure := make(map[string]bool, len(cfg.InsecureRegistries))
+	for _, host := range cfg.InsecureRegistries {
+		insecure[host] = true
+	}
+
+	indexInfo := func(host string) types.IndexInfo {
+		registryCfg, _ := lookupRegistryConfig(cfg.Registries, host)
+		mirrors := []string{}
+		if host == cfg.DefaultRegistry {
+			mirrors = append(mirrors, cfg.RegistryMirrors...)
+		}
+		return types.IndexInfo{
+			CredentialHelper: registryCfg.CredentialHelper,
+			Mirrors:          append(mirrors, registryCfg.Mirrors...),
+			Name:             host,
+			Official:         isDockerHub(host),
+			Secure:           !insecure[host],
+		}
+	}
+
+	configs := map[string]types.IndexInfo{}
+	if cfg.DefaultRegistry != "" {
+		configs[cfg.DefaultRegistry] = indexInfo(cfg.DefaultRegistry)
+	}
+	for host := range cfg.Registries {
+		configs[host] = indexInfo(host)
+	}
+	for host := range insecure {
+		configs[host] = indexInfo(host)
+	}
+	return configs
+}
@@ -0,0 +1,127 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupImageReferencesWithMirrors(t *testing.T) {
+	mgr := &ImageManager{
+		DefaultRegistry:  "registry.hub.docker.com",
+		DefaultNamespace: "library",
+		RegistryMirrors:  []string{"hub-mirror.example.com"},
+		registries: map[string]config.RegistryConfig{
+			"docker.io":            {Mirrors: []string{"docker-mirror.example.com"}},
+			"registry.example.com": {Mirrors: []string{"127.0.0.1:5000/registry.example.com"}},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"hub-mirror.example.com/busybox:latest",
+		"docker-mirror.example.com/library/busybox:latest",
+		"registry.hub.docker.com/library/busybox:latest",
+	}, mgr.LookupImageReferences("busybox:latest"))
+
+	assert.Equal(t, []string{
+		"127.0.0.1:5000/registry.example.com/ns/app:1.0",
+		"registry.example.com/ns/app:1.0",
+	}, mgr.LookupImageReferences("registry.example.com/ns/app:1.0"))
+
+	assert.Equal(t, []string{
+		"other.example.com/ns/app:1.0",
+	}, mgr.LookupImageReferences("other.example.com/ns/app:1.0"))
+}
+
+func TestRegistryCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credential-helper")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	helper := `#!/bin/sh
+read host
+case "$host" in
+registry.example.com) echo '{"ServerURL":"registry.example.com","Username":"helper","Secret":"helper-secret"}' ;;
+token.example.com) echo '{"ServerURL":"token.example.com","Username":"<token>","Secret":"identity-token"}' ;;
+*) echo "credentials not found in native keychain"; exit 1 ;;
+esac
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "docker-credential-test"), []byte(helper), 0755))
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+":"+os.Getenv("PATH"))
+
+	mgr := &ImageManager{
+		registries: map[string]config.RegistryConfig{
+			"docker.io":            {Username: "user", Password: "secret"},
+			"registry.example.com": {CredentialHelper: "test"},
+			"token.example.com":    {CredentialHelper: "test"},
+			"missing.example.com":  {CredentialHelper: "test"},
+		},
+	}
+
+	for _, tc := range []struct {
+		host     string
+		username string
+		secret   string
+	}{
+		{host: "registry-1.docker.io", username: "user", secret: "secret"},
+		{host: "registry.example.com", username: "helper", secret: "helper-secret"},
+		{host: "token.example.com", secret: "identity-token"},
+		{host: "missing.example.com"},
+		{host: "other.example.com"},
+	} {
+		username, secret, err := mgr.registryCredentials(nil)(tc.host)
+		assert.NoError(t, err, tc.host)
+		assert.Equal(t, tc.username, username, tc.host)
+		assert.Equal(t, tc.secret, secret, tc.host)
+	}
+
+	// the credentials of request are preferred.
+	username, secret, err := mgr.registryCredentials(&types.AuthConfig{Username: "req", Password: "req-secret"})("registry.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "req", username)
+	assert.Equal(t, "req-secret", secret)
+}
+
+func TestRegistryIndexConfigs(t *testing.T) {
+	configs := registryIndexConfigs(&config.Config{
+		DefaultRegistry:    "registry.hub.docker.com",
+		RegistryMirrors:    []string{"hub-mirror.example.com"},
+		InsecureRegistries: []string{"127.0.0.1:5000"},
+		Registries: map[string]config.RegistryConfig{
+			"docker.io":            {Mirrors: []string{"docker-mirror.example.com"}},
+			"registry.example.com": {CredentialHelper: "pass", Mirrors: []string{"127.0.0.1:5000"}},
+		},
+	})
+
+	assert.Equal(t, map[string]types.IndexInfo{
+		"registry.hub.docker.com": {
+			Name:     "registry.hub.docker.com",
+			Mirrors:  []string{"hub-mirror.example.com", "docker-mirror.example.com"},
+			Official: true,
+			Secure:   true,
+		},
+		"docker.io": {
+			Name:     "docker.io",
+			Mirrors:  []string{"docker-mirror.example.com"},
+			Official: true,
+			Secure:   true,
+		},
+		"registry.example.com": {
+			Name:             "registry.example.com",
+			Mirrors:          []string{"127.0.0.1:5000"},
+			CredentialHelper: "pass",
+			Secure:           true,
+		},
+		"127.0.0.1:5000": {
+			Name:    "127.0.0.1:5000",
+			Mirrors: []string{},
+		},
+	}, configs)
+}
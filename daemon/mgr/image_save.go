@@ -4,17 +4,51 @@ import (
 	"context"
 	"io"
 
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	ctrdmetaimages "github.com/containerd/containerd/images"
 	ociimage "github.com/containerd/containerd/images/oci"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// ImageFormatOCI is the format of OCI image layout tarball.
+	ImageFormatOCI = "oci"
+
+	// ImageFormatDockerArchive is the format of tarball created by docker save.
+	ImageFormatDockerArchive = "docker-archive"
 )
 
-// SaveImage saves image to the oci.v1 format tarstream.
-func (mgr *ImageManager) SaveImage(ctx context.Context, idOrRef string) (io.ReadCloser, error) {
+// SaveImage saves image to the tarstream of format, oci.v1 format is used
+// if format is empty.
+func (mgr *ImageManager) SaveImage(ctx context.Context, idOrRef, format string) (io.ReadCloser, error) {
 	_, _, ref, err := mgr.CheckReference(ctx, idOrRef)
 	if err != nil {
 		return nil, err
 	}
 
-	exportedStream, err := mgr.client.SaveImage(ctx, &ociimage.V1Exporter{}, ref.String())
+	var exporter ctrdmetaimages.Exporter
+	switch format {
+	case "", ImageFormatOCI:
+		exporter = &ociimage.V1Exporter{}
+	case ImageFormatDockerArchive:
+		var repoTags []string
+		if reference.IsNameTagged(ref) {
+			repoTags = append(repoTags, ref.String())
+		}
+		exporter = &ctrd.DockerArchiveExporter{RepoTags: repoTags}
+	default:
+		return nil, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "unsupported image format %s", format)
+	}
+
+	// the layers of lazily pulled image are required in the tarstream.
+	if err := mgr.FetchLazyImage(ctx, ref.String(), ""); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to fetch lazily pulled image %s", ref.String())
+	}
+
+	exportedStream, err := mgr.client.SaveImage(ctx, exporter, ref.String())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,126 @@
+package mgr
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/pkg/trust"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// pinnedResolver resolves the reference to the manifest whose signatures
+// are verified, so that the image fetched later is exactly the verified one
+// even if the tag is moved in the registry after verification.
+type pinnedResolver struct {
+	remotes.Resolver
+	ref  string
+	desc ocispec.Descriptor
+}
+
+// Resolve returns the verified manifest for the pinned reference.
+func (r *pinnedResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	if ref != r.ref {
+		return r.Resolver.Resolve(ctx, ref)
+	}
+	return ref, r.desc, nil
+}
+
+// verifyImageTrust verifies the signatures of the image to pull by the trust
+// policy of its registry, the unverified image is rejected in enforce mode.
+// The returned resolver always resolves ref to the verified manifest, which
+// should be used to fetch the image.
+func (mgr *ImageManager) verifyImageTrust(ctx context.Context, resolver remotes.Resolver, ref string) (remotes.Resolver, *trust.Result, error) {
+	if mgr.trust == nil {
+		return resolver, nil, nil
+	}
+
+	namedRef, err := reference.Parse(ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mgr.trust.Mode(namedRef.Name()) == trust.ModeOff {
+		return resolver, nil, nil
+	}
+
+	reg := trust.NewRemoteRegistry(resolver)
+	desc, err := reg.Resolve(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := mgr.trust.Verify(ctx, reg, namedRef.Name(), desc.Digest)
+	if err != nil {
+		return nil, nil, pkgerrors.Wrap(errtypes.ErrPreCheckFailed, err.Error())
+	}
+	if !result.Verified {
+		log.With(ctx).Warnf("image %s is not verified by trust policy: %s", ref, result.Message)
+	}
+	return &pinnedResolver{Resolver: resolver, ref: ref, desc: desc}, result, nil
+}
+
+// checkImageTrustDigest makes sure the fetched image is the verified one.
+func checkImageTrustDigest(img containerd.Image, result *trust.Result) error {
+	if result == nil {
+		return nil
+	}
+
+	if dgst := img.Target().Digest; dgst != result.Digest {
+		return pkgerrors.Wrapf(errtypes.ErrPreCheckFailed, "manifest %s of image %s is not the verified one %s", dgst, img.Name(), result.Digest)
+	}
+	return nil
+}
+
+// storeImageTrust caches the verification result of the pulled image.
+func (mgr *ImageManager) storeImageTrust(ctx context.Context, img containerd.Image, result *trust.Result) error {
+	if result == nil {
+		return nil
+	}
+
+	imgCfg, err := img.Config(ctx)
+	if err != nil {
+		return err
+	}
+	return mgr.trust.Store(imgCfg.Digest.String(), result)
+}
+
+// CheckImageTrust checks whether the image is allowed to run by the trust
+// policy, the image must be verified when pulled in enforce mode.
+func (mgr *ImageManager) CheckImageTrust(ctx context.Context, idOrRef string) error {
+	if mgr.trust == nil {
+		return nil
+	}
+
+	id, _, primaryRef, err := mgr.CheckReference(ctx, idOrRef)
+	if err != nil {
+		return err
+	}
+	if err := mgr.trust.Check(primaryRef.Name(), id.String()); err != nil {
+		return pkgerrors.Wrap(errtypes.ErrPreCheckFailed, err.Error())
+	}
+	return nil
+}
+
+// toImageTrust converts the verification result into the one of image inspect.
+func toImageTrust(result *trust.Result) *types.ImageTrust {
+	if result == nil {
+		return nil
+	}
+
+	return &types.ImageTrust{
+		Digest:     result.Digest.String(),
+		Message:    result.Message,
+		Mode:       result.Mode,
+		Signer:     result.Signer,
+		Type:       result.Type,
+		Verified:   result.Verified,
+		VerifiedAt: result.VerifiedAt.Format(utils.TimeLayout),
+	}
+}
@@ -0,0 +1,121 @@
+package mgr
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/trust"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// movingTagResolver resolves the tag to the next manifest every time, which
+// acts like a registry whose tag is moved between two resolves.
+type movingTagResolver struct {
+	tag       string
+	manifests []ocispec.Descriptor
+	resolved  int
+}
+
+func (r *movingTagResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	if ref != r.tag {
+		return "", ocispec.Descriptor{}, fmt.Errorf("%s not found", ref)
+	}
+	desc := r.manifests[r.resolved%len(r.manifests)]
+	r.resolved++
+	return ref, desc, nil
+}
+
+func (r *movingTagResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	return remotes.FetcherFunc(func(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("%s not found", desc.Digest)
+	}), nil
+}
+
+func (r *movingTagResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return nil, fmt.Errorf("push is not supported")
+}
+
+// fakeImage is the containerd image with the given manifest.
+type fakeImage struct {
+	containerd.Image
+	name   string
+	target ocispec.Descriptor
+}
+
+func (img *fakeImage) Name() string {
+	return img.name
+}
+
+func (img *fakeImage) Target() ocispec.Descriptor {
+	return img.target
+}
+
+func newWarnVerifier(t *testing.T, dir string) *trust.Verifier {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	keyFile := filepath.Join(dir, "cosign.pub")
+	assert.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	verifier, err := trust.NewVerifier(map[string]trust.Policy{
+		"*": {Mode: trust.ModeWarn, CosignKeys: []string{keyFile}},
+	}, "")
+	assert.NoError(t, err)
+	return verifier
+}
+
+func TestVerifyImageTrustPinsManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "image-trust")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ref := "reg.example.com/library/busybox:latest"
+	verified := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: digest.FromString("verified"), Size: 8}
+	moved := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: digest.FromString("moved"), Size: 5}
+	resolver := &movingTagResolver{tag: ref, manifests: []ocispec.Descriptor{verified, moved}}
+
+	mgr := &ImageManager{trust: newWarnVerifier(t, dir)}
+	pinned, result, err := mgr.verifyImageTrust(context.TODO(), resolver, ref)
+	assert.NoError(t, err)
+	assert.Equal(t, verified.Digest, result.Digest)
+	assert.False(t, result.Verified)
+
+	// the tag is moved in registry, but the verified manifest is fetched.
+	name, desc, err := pinned.Resolve(context.TODO(), ref)
+	assert.NoError(t, err)
+	assert.Equal(t, ref, name)
+	assert.Equal(t, verified, desc)
+	_, desc, err = resolver.Resolve(context.TODO(), ref)
+	assert.NoError(t, err)
+	assert.Equal(t, moved, desc)
+
+	// the fetched image must be the verified one.
+	assert.NoError(t, checkImageTrustDigest(&fakeImage{name: ref, target: verified}, result))
+	err = checkImageTrustDigest(&fakeImage{name: ref, target: moved}, result)
+	assert.True(t, errtypes.IsPreCheckFailed(err))
+	assert.NoError(t, checkImageTrustDigest(&fakeImage{name: ref, target: moved}, nil))
+
+	// the resolver is not pinned if verification is off.
+	mgr = &ImageManager{}
+	unpinned, result, err := mgr.verifyImageTrust(context.TODO(), resolver, ref)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, remotes.Resolver(resolver), unpinned)
+}
@@ -22,11 +22,44 @@ var legacyDockerConfigMediaType = "application/octet-stream"
 func containerdImageToOciImage(ctx context.Context, img containerd.Image) (ocispec.Image, error) {
 	var ociImage ocispec.Image
 
-	cfg, err := img.Config(ctx)
+	data, err := readImageConfigBlob(ctx, img)
 	if err != nil {
 		return ocispec.Image{}, err
 	}
 
+	if err := json.Unmarshal(data, &ociImage); err != nil {
+		return ocispec.Image{}, err
+	}
+	return ociImage, nil
+}
+
+// containerdImageHealthcheck returns the healthcheck of image. The OCI image
+// spec has no healthcheck, so it is parsed from the docker image config.
+func containerdImageHealthcheck(ctx context.Context, img containerd.Image) (*types.HealthConfig, error) {
+	var dockerImage struct {
+		Config struct {
+			Healthcheck *types.HealthConfig `json:"Healthcheck,omitempty"`
+		} `json:"config,omitempty"`
+	}
+
+	data, err := readImageConfigBlob(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &dockerImage); err != nil {
+		return nil, err
+	}
+	return dockerImage.Config.Healthcheck, nil
+}
+
+// readImageConfigBlob returns the content of image config.
+func readImageConfigBlob(ctx context.Context, img containerd.Image) ([]byte, error) {
+	cfg, err := img.Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// NOTE(fuweid): There is config content with legacy media type in
 	// content storage. In order to compatible with existing image,
 	// we should support it.
@@ -36,19 +69,10 @@ func containerdImageToOciImage(ctx context.Context, img containerd.Image) (ocisp
 	case ocispec.MediaTypeImageConfig, images.MediaTypeDockerSchema2Config,
 		legacyDockerConfigMediaType:
 
-		data, err := content.ReadBlob(ctx, img.ContentStore(), cfg)
-		if err != nil {
-			return ocispec.Image{}, err
-		}
-
-		if err := json.Unmarshal(data, &ociImage); err != nil {
-			return ocispec.Image{}, err
-		}
+		return content.ReadBlob(ctx, img.ContentStore(), cfg)
 	default:
-		return ocispec.Image{}, fmt.Errorf("unknown image config media type %s", cfg.MediaType)
+		return nil, fmt.Errorf("unknown image config media type %s", cfg.MediaType)
 	}
-
-	return ociImage, nil
 }
 
 // getImageInfoConfigFromOciImage returns config of ImageConfig from oci image.
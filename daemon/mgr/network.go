@@ -7,11 +7,16 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 
 	apitypes "github.com/alibaba/pouch/apis/types"
+	criconfig "github.com/alibaba/pouch/cri/config"
+	cni "github.com/alibaba/pouch/cri/ocicni"
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/events"
 	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/network/firewall"
+	"github.com/alibaba/pouch/network/hostdev"
 	"github.com/alibaba/pouch/network/types"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
@@ -59,6 +64,15 @@ type NetworkMgr interface {
 
 	// GetNetworkStats returns the network stats of specific sandbox
 	GetNetworkStats(sandboxID string) (map[string]apitypes.NetworkStats, error)
+
+	// CNI returns the CNI manager used by the containers in cni network mode.
+	CNI() (cni.CniMgr, error)
+
+	// HostGatewayIP returns the address of host-gateway in extra hosts.
+	HostGatewayIP() (string, error)
+
+	// ReconcileFirewall reprograms the firewall rules of bridge networks.
+	ReconcileFirewall() error
 }
 
 // NetworkManager is the default implement of interface NetworkMgr.
@@ -67,6 +81,22 @@ type NetworkManager struct {
 	controller    libnetwork.NetworkController
 	config        network.Config
 	eventsService *events.Events
+
+	// criConfig provides the CNI plugin directories shared with CRI.
+	criConfig criconfig.Config
+
+	// cniMgr is initialized when the first container in cni network mode
+	// starts, so that the daemon never depends on CNI if it's not used.
+	cniLock sync.Mutex
+	cniMgr  cni.CniMgr
+
+	// firewall programs the rules of bridge networks owned by pouchd, it's
+	// nil if iptables is disabled or unavailable.
+	firewallLock sync.Mutex
+	firewall     firewall.Firewall
+
+	// ctrMgr provides the labels of containers matched by network policy.
+	ctrMgr ContainerMgr
 }
 
 // NewNetworkManager creates a brand new network manager.
@@ -80,12 +110,25 @@ func NewNetworkManager(cfg *config.Config, store *meta.Store, ctrMgr ContainerMg
 		cfg.NetworkConfig.ExecRoot = network.DefaultExecRoot
 	}
 
+	if bridgeCfg := &cfg.NetworkConfig.BridgeConfig; bridgeCfg.UserlandProxy && bridgeCfg.UserlandProxyPath == "" {
+		proxyPath, err := setupUserlandProxyLink(cfg.NetworkConfig.ExecRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to setup userland proxy")
+		}
+		bridgeCfg.UserlandProxyPath = proxyPath
+	}
+
+	fw, err := setupFirewall(&cfg.NetworkConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	// get active sandboxes
 	ctrs, err := ctrMgr.List(context.Background(),
 		&ContainerListOption{
 			All: true,
 			FilterFunc: func(c *Container) bool {
-				return c.IsRunningOrPaused() && !isContainer(c.HostConfig.NetworkMode)
+				return c.IsRunningOrPaused() && !isContainer(c.HostConfig.NetworkMode) && !IsCNI(c.HostConfig.NetworkMode)
 			}})
 	if err != nil {
 		log.With(nil).Errorf("failed to new network manager: cannot get container list")
@@ -94,7 +137,7 @@ func NewNetworkManager(cfg *config.Config, store *meta.Store, ctrMgr ContainerMg
 	cfg.NetworkConfig.ActiveSandboxes = make(map[string]interface{})
 	for _, c := range ctrs {
 		endpoint := BuildContainerEndpoint(c)
-		sbOptions, err := buildSandboxOptions(cfg.NetworkConfig, endpoint)
+		sbOptions, err := buildSandboxOptions(cfg.NetworkConfig, endpoint, configHostGatewayIP(cfg.NetworkConfig))
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to build sandbox options")
 		}
@@ -106,17 +149,57 @@ func NewNetworkManager(cfg *config.Config, store *meta.Store, ctrMgr ContainerMg
 		return nil, errors.Wrap(err, "failed to build network options")
 	}
 
+	pg, err := setupHostdevDriver(cfg.NetworkConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to setup %s driver", hostdev.DriverName)
+	}
+	ctlOptions = append(ctlOptions, nwconfig.OptionPluginGetter(pg))
+
 	controller, err := libnetwork.New(ctlOptions...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create network controller")
 	}
 
-	return &NetworkManager{
+	nm := &NetworkManager{
 		store:         store,
 		controller:    controller,
 		config:        cfg.NetworkConfig,
 		eventsService: eventsService,
-	}, nil
+		criConfig:     cfg.CriConfig,
+		firewall:      fw,
+		ctrMgr:        ctrMgr,
+	}
+
+	// the ip6tables rules are lost if host reboots.
+	for _, n := range controller.Networks() {
+		if err := nm.setupIP6tables(n); err != nil {
+			log.With(nil).Warnf("failed to setup ip6tables of network %s: %v", n.Name(), err)
+		}
+	}
+	// the rules are restored if host reboots, and the stale rules are
+	// removed if the networks are changed when pouchd stops.
+	if err := nm.ReconcileFirewall(); err != nil {
+		return nil, err
+	}
+	return nm, nil
+}
+
+// CNI returns the CNI manager used by the containers in cni network mode,
+// the CNI plugins are loaded from the same directories as CRI.
+func (nm *NetworkManager) CNI() (cni.CniMgr, error) {
+	nm.cniLock.Lock()
+	defer nm.cniLock.Unlock()
+
+	if nm.cniMgr != nil {
+		return nm.cniMgr, nil
+	}
+
+	cniMgr, err := cni.NewCniManager(&nm.criConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init CNI")
+	}
+	nm.cniMgr = cniMgr
+	return cniMgr, nil
 }
 
 // Create is used to create network.
@@ -125,6 +208,29 @@ func (nm *NetworkManager) Create(ctx context.Context, create apitypes.NetworkCre
 	driver := create.NetworkCreate.Driver
 	id := randomid.Generate()
 
+	if err := validateVlanNetwork(create); err != nil {
+		return nil, err
+	}
+
+	if err := validateIPv6Network(create); err != nil {
+		return nil, err
+	}
+
+	if err := validateOverlayNetwork(create, nm.config); err != nil {
+		return nil, err
+	}
+
+	if err := validateHostdevNetwork(create); err != nil {
+		return nil, err
+	}
+
+	if err := validateNetworkPolicy(&create.NetworkCreate); err != nil {
+		return nil, err
+	}
+	if create.NetworkCreate.Policy != nil && nm.firewall == nil {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "network policy requires iptables enabled in pouchd")
+	}
+
 	nwOptions, err := networkOptions(create)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to build network's options")
@@ -139,6 +245,20 @@ func (nm *NetworkManager) Create(ctx context.Context, create apitypes.NetworkCre
 		return nil, errors.Wrap(err, "failed to create network")
 	}
 
+	if err := nm.setupIP6tables(net); err != nil {
+		if err := net.Delete(); err != nil {
+			log.With(ctx).Errorf("failed to delete network %s after failing to setup ip6tables: %v", name, err)
+		}
+		return nil, err
+	}
+
+	if err := nm.ReconcileFirewall(); err != nil {
+		if err := net.Delete(); err != nil {
+			log.With(ctx).Errorf("failed to delete network %s after failing to reconcile firewall: %v", name, err)
+		}
+		return nil, err
+	}
+
 	network := types.Network{
 		Name:    name,
 		ID:      id,
@@ -203,6 +323,10 @@ func (nm *NetworkManager) Remove(ctx context.Context, name string) error {
 	if err := nw.Delete(); err != nil {
 		return err
 	}
+	nm.removeIP6tables(nw)
+	if err := nm.ReconcileFirewall(); err != nil {
+		log.With(ctx).Warnf("failed to reconcile firewall after removing network %s: %v", name, err)
+	}
 
 	nm.LogNetworkEvent(ctx, nw, "destroy")
 	return nil
@@ -300,14 +424,22 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 		return "", err
 	}
 
-	endpointName := containerID[:8]
+	// the name of endpoint is resolved by the embedded DNS server.
+	endpointName := endpoint.ContainerName
+	if endpointName == "" {
+		endpointName = containerID[:8]
+	}
 
 	// ensure the endpoint has been deleted before creating
 	if ep, _ := n.EndpointByName(endpointName); ep != nil {
 		ep.Delete(true)
 	}
 
-	ep, err := n.CreateEndpoint(endpointName, epOptions...)
+	var reserved []string
+	if !hasUserDefinedIPAddress(endpointConfig) {
+		reserved = endpoint.ReservedAddresses
+	}
+	ep, err := createEndpoint(n, endpointName, reserved, epOptions)
 	if err != nil {
 		return "", err
 	}
@@ -323,7 +455,15 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 	// create sandbox
 	sb := nm.getNetworkSandbox(containerID)
 	if sb == nil {
-		sandboxOptions, err := buildSandboxOptions(nm.config, endpoint)
+		var hostGatewayIP string
+		if hasHostGateway(endpoint.ExtraHosts) {
+			if hostGatewayIP, err = nm.HostGatewayIP(); err != nil {
+				return "", err
+			}
+		}
+
+		var sandboxOptions []libnetwork.SandboxOption
+		sandboxOptions, err = buildSandboxOptions(nm.config, endpoint, hostGatewayIP)
 		if err != nil {
 			return "", fmt.Errorf("failed to build sandbox options(%v)", err)
 		}
@@ -341,10 +481,18 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 	if err != nil {
 		return "", err
 	}
-	if err := ep.Join(sb, joinOptions...); err != nil {
+	if err = ep.Join(sb, joinOptions...); err != nil {
 		return "", fmt.Errorf("failed to join sandbox(%v)", err)
 	}
 
+	// the port mappings of endpoint are forwarded by firewall.
+	if err = nm.ReconcileFirewall(); err != nil {
+		if err := ep.Leave(sb); err != nil {
+			log.With(ctx).Errorf("failed to leave sandbox after failing to reconcile firewall: %v", err)
+		}
+		return "", err
+	}
+
 	// update endpoint settings
 	epInfo := ep.Info()
 	if epInfo.Gateway() != nil {
@@ -365,6 +513,12 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 			endpointConfig.IPAddress = iface.Address().IP.String()
 		}
 
+		if iface.AddressIPv6() != nil && iface.AddressIPv6().IP != nil {
+			mask, _ := iface.AddressIPv6().Mask.Size()
+			endpointConfig.GlobalIPV6PrefixLen = int64(mask)
+			endpointConfig.GlobalIPV6Address = iface.AddressIPv6().IP.String()
+		}
+
 		if iface.MacAddress() != nil {
 			endpointConfig.MacAddress = iface.MacAddress().String()
 		}
@@ -433,12 +587,16 @@ func (nm *NetworkManager) EndpointRemove(ctx context.Context, endpoint *types.En
 		return errors.Wrapf(err, "failed to delete endpoint(%s)", endpoint.ID)
 	}
 
+	if err := nm.ReconcileFirewall(); err != nil {
+		log.With(ctx).Warnf("failed to reconcile firewall after removing endpoint(%s): %v", endpoint.ID, err)
+	}
+
 	// clean endpoint configure data
 	nm.cleanEndpointConfig(epConfig)
 
 	// check sandbox has endpoint or not.
 	eplist = sb.Endpoints()
-	if len(eplist) == 0 {
+	if len(eplist) == 0 && !endpoint.KeepSandbox {
 		if err := sb.Delete(); err != nil {
 			log.With(nil).Errorf("failed to delete sandbox id(%s): %v", sid, err)
 			return errors.Wrapf(err, "failed to delete sandbox id(%s)", sid)
@@ -501,19 +659,28 @@ func controllerOptions(cfg network.Config) ([]nwconfig.Option, error) {
 	options = append(options, nwconfig.OptionDefaultDriver("bridge"))
 	options = append(options, nwconfig.OptionDefaultNetwork("bridge"))
 	options = append(options, nwconfig.OptionNetworkControlPlaneMTU(cfg.BridgeConfig.Mtu))
-	options = append(options, nwconfig.OptionExperimental(false))
+	// the ipvlan driver is only registered as experimental driver of libnetwork.
+	options = append(options, nwconfig.OptionExperimental(true))
 
 	// set bridge options
 	options = append(options, bridgeDriverOptions(cfg.BridgeConfig))
 
+	// set cluster options of overlay networks
+	clusterOpts, err := clusterOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, clusterOpts...)
+
 	return options, nil
 }
 
 func bridgeDriverOptions(cfg network.BridgeConfig) nwconfig.Option {
 	bridgeConfig := options.Generic{
 		"EnableIPForwarding":  cfg.IPForward,
-		"EnableIPTables":      cfg.IPTables,
-		"EnableUserlandProxy": cfg.UserlandProxy}
+		"EnableIPTables":      cfg.IPTables && cfg.FirewallBackend != firewall.BackendNFTables,
+		"EnableUserlandProxy": cfg.UserlandProxy,
+		"UserlandProxyPath":   cfg.UserlandProxyPath}
 	bridgeOption := options.Generic{netlabel.GenericData: bridgeConfig}
 
 	return nwconfig.OptionDriverConfig("bridge", bridgeOption)
@@ -613,11 +780,26 @@ func endpointOptions(n libnetwork.Network, endpoint *types.Endpoint) ([]libnetwo
 		}
 	}
 
+	// the container is also resolved by its short ID if the embedded DNS
+	// server is enabled.
+	if !endpoint.DisableResolver && endpoint.Owner != "" {
+		shortID := utils.TruncateID(endpoint.Owner)
+		if epConfig == nil || !utils.StringInSlice(epConfig.Aliases, shortID) {
+			createOptions = append(createOptions, libnetwork.CreateOptionMyAlias(shortID))
+		}
+	}
+
 	// generate genric endpoint options
 	genericOption := options.Generic{}
 	if len(endpoint.GenericParams) > 0 {
 		genericOption, _ = utils.MergeMap(genericOption, endpoint.GenericParams)
 	}
+	// the driver options of endpoint are passed to the network driver.
+	if epConfig != nil {
+		for k, v := range epConfig.DriverOpts {
+			genericOption[k] = v
+		}
+	}
 
 	if n.Name() == endpoint.NetworkMode && endpoint.MacAddress != "" {
 		mac, err := net.ParseMAC(endpoint.MacAddress)
@@ -636,7 +818,7 @@ func endpointOptions(n libnetwork.Network, endpoint *types.Endpoint) ([]libnetwo
 	return createOptions, nil
 }
 
-func buildSandboxOptions(config network.Config, endpoint *types.Endpoint) ([]libnetwork.SandboxOption, error) {
+func buildSandboxOptions(config network.Config, endpoint *types.Endpoint, hostGatewayIP string) ([]libnetwork.SandboxOption, error) {
 	var (
 		sandboxOptions []libnetwork.SandboxOption
 		dns            []string
@@ -693,8 +875,14 @@ func buildSandboxOptions(config network.Config, endpoint *types.Endpoint) ([]lib
 		sandboxOptions = append(sandboxOptions, libnetwork.OptionDNSOptions(ds))
 	}
 
+	// parse extra hosts
+	hostOptions, err := extraHostOptions(endpoint.ExtraHosts, hostGatewayIP)
+	if err != nil {
+		return nil, err
+	}
+	sandboxOptions = append(sandboxOptions, hostOptions...)
+
 	// TODO: secondary ip address
-	// TODO: parse extra hosts
 	var bindings = make(nat.PortMap)
 	if endpoint.PortBindings != nil {
 		for p, b := range endpoint.PortBindings {
@@ -708,12 +896,16 @@ func buildSandboxOptions(config network.Config, endpoint *types.Endpoint) ([]lib
 		}
 	}
 
-	portSpecs := endpoint.ExposedPorts
-	var ports = make([]nat.Port, len(portSpecs))
-	var i int
+	// the published ports are exposed even if they are not in exposed ports,
+	// which may be omitted by the clients of API.
+	var ports = make([]nat.Port, 0, len(endpoint.ExposedPorts)+len(bindings))
 	for p := range endpoint.ExposedPorts {
-		ports[i] = nat.Port(p)
-		i++
+		ports = append(ports, nat.Port(p))
+	}
+	for p := range bindings {
+		if _, ok := endpoint.ExposedPorts[string(p)]; !ok {
+			ports = append(ports, p)
+		}
 	}
 	nat.SortPortMap(ports, bindings)
 
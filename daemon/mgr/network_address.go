@@ -0,0 +1,188 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/libnetwork"
+	"github.com/pkg/errors"
+)
+
+// staticAddresses returns the static IP addresses of endpoint config.
+func staticAddresses(epConfig *types.EndpointSettings) []string {
+	if !hasUserDefinedIPAddress(epConfig) {
+		return nil
+	}
+
+	var addrs []string
+	for _, addr := range []string{epConfig.IPAMConfig.IPV4Address, epConfig.IPAMConfig.IPV6Address} {
+		if addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// validateStaticAddresses verifies the static IP and mac addresses of the
+// container in networks, the IP addresses should be in the configured
+// subnets of networks and not conflict with the other containers.
+func (mgr *ContainerManager) validateStaticAddresses(ctx context.Context, c *Container) error {
+	if c.Config.MacAddress != "" {
+		if _, err := net.ParseMAC(c.Config.MacAddress); err != nil {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid mac address %s", c.Config.MacAddress)
+		}
+	}
+
+	for name, epConfig := range c.NetworkSettings.Networks {
+		if !hasUserDefinedIPAddress(epConfig) {
+			continue
+		}
+		n, err := mgr.NetworkMgr.Get(ctx, name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get network %s", name)
+		}
+		if err := validateNetworkingConfig(n.Network, epConfig); err != nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+	}
+
+	for name, epConfig := range c.NetworkSettings.Networks {
+		if err := mgr.checkAddressConflicts(c, name, epConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAddressConflicts returns error if the static IP or mac address of
+// container in the network is reserved by another container, or used by a
+// running one.
+func (mgr *ContainerManager) checkAddressConflicts(c *Container, network string, epConfig *types.EndpointSettings) error {
+	addrs := staticAddresses(epConfig)
+	mac := ""
+	if c.HostConfig.NetworkMode == network {
+		mac = c.Config.MacAddress
+	}
+	if len(addrs) == 0 && mac == "" {
+		return nil
+	}
+
+	for _, obj := range mgr.cache.Values(nil) {
+		other, ok := obj.(*Container)
+		if !ok || other.ID == c.ID || other.NetworkSettings == nil {
+			continue
+		}
+		otherConfig, ok := other.NetworkSettings.Networks[network]
+		if !ok || otherConfig == nil {
+			continue
+		}
+
+		used := staticAddresses(otherConfig)
+		if other.IsRunningOrPaused() {
+			used = append(used, otherConfig.IPAddress, otherConfig.GlobalIPV6Address)
+		}
+		for _, addr := range addrs {
+			for _, u := range used {
+				if u != "" && net.ParseIP(addr).Equal(net.ParseIP(u)) {
+					return errors.Wrapf(errtypes.ErrConflict, "IP address %s in network %s is already used by container %s", addr, network, other.ID)
+				}
+			}
+		}
+
+		if mac != "" && other.HostConfig != nil && other.HostConfig.NetworkMode == network && other.Config.MacAddress == mac {
+			return errors.Wrapf(errtypes.ErrConflict, "mac address %s in network %s is already used by container %s", mac, network, other.ID)
+		}
+	}
+	return nil
+}
+
+// reservedAddresses returns the static IP addresses in the network of the
+// containers other than the given one, they are reserved across the
+// restarts of containers, so they are not allocated to the others.
+func (mgr *ContainerManager) reservedAddresses(id, network string) []string {
+	var addrs []string
+	for _, obj := range mgr.cache.Values(nil) {
+		c, ok := obj.(*Container)
+		if !ok || c.ID == id || c.NetworkSettings == nil {
+			continue
+		}
+		addrs = append(addrs, staticAddresses(c.NetworkSettings.Networks[network])...)
+	}
+	return addrs
+}
+
+// createEndpoint creates the endpoint in network, the IP addresses reserved
+// by the containers with static addresses are skipped, by holding them with
+// placeholder endpoints until another one is allocated, which is requested
+// by the endpoint then.
+func createEndpoint(n libnetwork.Network, name string, reserved []string, options []libnetwork.EndpointOption) (libnetwork.Endpoint, error) {
+	ep, err := n.CreateEndpoint(name, options...)
+	if err != nil || !isReservedEndpoint(ep, reserved) {
+		return ep, err
+	}
+
+	held := []libnetwork.Endpoint{ep}
+	defer func() {
+		for _, ep := range held {
+			if err := ep.Delete(true); err != nil {
+				log.With(nil).Errorf("failed to delete placeholder endpoint %s: %v", ep.Name(), err)
+			}
+		}
+	}()
+
+	for i := 1; i <= len(reserved); i++ {
+		placeholder, err := n.CreateEndpoint(fmt.Sprintf("%s-reserved-%d", name, i), options...)
+		if err != nil {
+			return nil, err
+		}
+		held = append(held, placeholder)
+		if isReservedEndpoint(placeholder, reserved) {
+			continue
+		}
+
+		var ipv4, ipv6 net.IP
+		if iface := placeholder.Info().Iface(); iface != nil {
+			if iface.Address() != nil {
+				ipv4 = iface.Address().IP
+			}
+			if iface.AddressIPv6() != nil {
+				ipv6 = iface.AddressIPv6().IP
+			}
+		}
+
+		// release the addresses for the endpoint.
+		for _, ep := range held {
+			if err := ep.Delete(true); err != nil {
+				log.With(nil).Errorf("failed to delete placeholder endpoint %s: %v", ep.Name(), err)
+			}
+		}
+		held = nil
+
+		options = append(options, libnetwork.CreateOptionIpam(ipv4, ipv6, nil, nil))
+		return n.CreateEndpoint(name, options...)
+	}
+	return nil, fmt.Errorf("no available IP address in network %s besides the reserved ones", n.Name())
+}
+
+// isReservedEndpoint returns whether the endpoint is allocated with the
+// reserved IP address.
+func isReservedEndpoint(ep libnetwork.Endpoint, reserved []string) bool {
+	iface := ep.Info().Iface()
+	if iface == nil {
+		return false
+	}
+
+	for _, addr := range reserved {
+		ip := net.ParseIP(addr)
+		if (iface.Address() != nil && iface.Address().IP.Equal(ip)) ||
+			(iface.AddressIPv6() != nil && iface.AddressIPv6().IP.Equal(ip)) {
+			return true
+		}
+	}
+	return false
+}
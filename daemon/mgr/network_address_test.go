@@ -0,0 +1,72 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAddressTestContainer(id, network, ip, mac string, running bool) *Container {
+	epConfig := &types.EndpointSettings{}
+	if ip != "" {
+		epConfig.IPAMConfig = &types.EndpointIPAMConfig{IPV4Address: ip}
+	}
+	return &Container{
+		ID:              id,
+		Config:          &types.ContainerConfig{MacAddress: mac},
+		HostConfig:      &types.HostConfig{NetworkMode: network},
+		State:           &types.ContainerState{Running: running},
+		NetworkSettings: &types.NetworkSettings{Networks: map[string]*types.EndpointSettings{network: epConfig}},
+	}
+}
+
+func TestCheckAddressConflicts(t *testing.T) {
+	static := newAddressTestContainer("static", "foo", "172.30.0.10", "02:42:ac:1e:00:0a", false)
+	dynamic := newAddressTestContainer("dynamic", "foo", "", "", true)
+	dynamic.NetworkSettings.Networks["foo"].IPAddress = "172.30.0.2"
+	mgr := &ContainerManager{cache: collect.NewSafeMap()}
+	mgr.cache.Put(static.ID, static)
+	mgr.cache.Put(dynamic.ID, dynamic)
+
+	for _, c := range []*Container{
+		newAddressTestContainer("c", "foo", "172.30.0.11", "02:42:ac:1e:00:0b", false),
+		newAddressTestContainer("c", "bar", "172.30.0.10", "02:42:ac:1e:00:0a", false),
+		newAddressTestContainer("c", "foo", "", "", false),
+		static,
+	} {
+		assert.NoError(t, mgr.checkAddressConflicts(c, c.HostConfig.NetworkMode, c.NetworkSettings.Networks[c.HostConfig.NetworkMode]))
+	}
+
+	for _, c := range []*Container{
+		newAddressTestContainer("c", "foo", "172.30.0.10", "", false),
+		newAddressTestContainer("c", "foo", "172.30.0.2", "", false),
+		newAddressTestContainer("c", "foo", "", "02:42:ac:1e:00:0a", false),
+	} {
+		err := mgr.checkAddressConflicts(c, "foo", c.NetworkSettings.Networks["foo"])
+		assert.True(t, errtypes.IsConflict(err), "%v", err)
+	}
+
+	// the address of stopped container without static address is free.
+	dynamic.State.Running = false
+	c := newAddressTestContainer("c", "foo", "172.30.0.2", "", false)
+	assert.NoError(t, mgr.checkAddressConflicts(c, "foo", c.NetworkSettings.Networks["foo"]))
+}
+
+func TestReservedAddresses(t *testing.T) {
+	mgr := &ContainerManager{cache: collect.NewSafeMap()}
+	for _, c := range []*Container{
+		newAddressTestContainer("a", "foo", "172.30.0.10", "", false),
+		newAddressTestContainer("b", "foo", "", "", false),
+		newAddressTestContainer("c", "bar", "172.31.0.10", "", false),
+	} {
+		mgr.cache.Put(c.ID, c)
+	}
+
+	assert.Equal(t, []string{"172.30.0.10"}, mgr.reservedAddresses("b", "foo"))
+	assert.Empty(t, mgr.reservedAddresses("a", "foo"))
+	assert.Equal(t, []string{"172.31.0.10"}, mgr.reservedAddresses("a", "bar"))
+}
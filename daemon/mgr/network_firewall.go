@@ -0,0 +1,135 @@
+package mgr
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/network/firewall"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/libnetwork"
+	"github.com/docker/libnetwork/drivers/bridge"
+	"github.com/docker/libnetwork/netlabel"
+	networktypes "github.com/docker/libnetwork/types"
+	"github.com/pkg/errors"
+)
+
+// setupFirewall resolves the firewall backend of bridge networks, the rules
+// are programmed by pouchd instead of the bridge driver if nftables is used,
+// otherwise pouchd only programs the policy rules by iptables.
+func setupFirewall(cfg *network.Config) (firewall.Firewall, error) {
+	bridgeCfg := &cfg.BridgeConfig
+	if !bridgeCfg.IPTables {
+		return nil, nil
+	}
+
+	backend, err := firewall.SelectBackend(bridgeCfg.FirewallBackend)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to select firewall backend")
+	}
+	bridgeCfg.FirewallBackend = backend
+	log.With(nil).Infof("firewall backend %s is used by bridge networks", backend)
+
+	if backend == firewall.BackendNFTables {
+		return firewall.NewNFTables()
+	}
+
+	// the bridge driver still works without the network policy.
+	fw, err := firewall.NewIPTables()
+	if err != nil {
+		log.With(nil).Warnf("network policy is disabled: %v", err)
+		return nil, nil
+	}
+	return fw, nil
+}
+
+// bridgeName returns the name of bridge interface of the bridge network, the
+// bridge is named by the ID of network if it's not specified.
+func bridgeName(n libnetwork.Network, options map[string]string) string {
+	if name := options[bridge.BridgeName]; name != "" {
+		return name
+	}
+	return "br-" + n.ID()[:12]
+}
+
+// bridgeOptionEnabled returns the boolean driver option of bridge network,
+// which is enabled by default.
+func bridgeOptionEnabled(options map[string]string, option string) bool {
+	enabled, err := strconv.ParseBool(options[option])
+	return err != nil || enabled
+}
+
+// firewallRuleset returns the desired firewall rules of all the bridge
+// networks and the port mappings of their endpoints.
+func (nm *NetworkManager) firewallRuleset() firewall.Ruleset {
+	var rs firewall.Ruleset
+	labels := nm.containerLabels()
+
+	networks := nm.controller.Networks()
+	sort.Slice(networks, func(i, j int) bool { return networks[i].Name() < networks[j].Name() })
+
+	for _, n := range networks {
+		if n.Type() != "bridge" {
+			continue
+		}
+
+		// the options are copied under the lock of network, so that ICC
+		// and policy are read from the same state.
+		options := n.Info().DriverOptions()
+		fn := firewall.Network{
+			Bridge:     bridgeName(n, options),
+			Internal:   n.Info().Internal(),
+			ICC:        bridgeOptionEnabled(options, bridge.EnableICC),
+			Masquerade: bridgeOptionEnabled(options, bridge.EnableIPMasquerade),
+			Rules:      policyRules(NetworkPolicy(options), policyEndpoints(n, labels)),
+		}
+		v4Infos, v6Infos := n.Info().IpamInfo()
+		for _, info := range v4Infos {
+			if info.Pool != nil {
+				fn.Subnets = append(fn.Subnets, info.Pool)
+			}
+		}
+		if nm.config.BridgeConfig.IP6Tables && n.Info().IPv6Enabled() {
+			for _, info := range v6Infos {
+				if info.Pool != nil {
+					fn.Subnets = append(fn.Subnets, info.Pool)
+				}
+			}
+		}
+		rs.Networks = append(rs.Networks, fn)
+
+		for _, ep := range n.Endpoints() {
+			driverInfo, err := ep.DriverInfo()
+			if err != nil || driverInfo == nil {
+				continue
+			}
+			bindings, _ := driverInfo[netlabel.PortMap].([]networktypes.PortBinding)
+			for _, b := range bindings {
+				rs.PortMappings = append(rs.PortMappings, firewall.PortMapping{
+					Bridge:        fn.Bridge,
+					Proto:         b.Proto.String(),
+					HostIP:        b.HostIP,
+					HostPort:      int(b.HostPort),
+					ContainerIP:   b.IP,
+					ContainerPort: int(b.Port),
+				})
+			}
+		}
+	}
+	return rs
+}
+
+// ReconcileFirewall replaces the firewall rules with the current state of
+// bridge networks, it's called whenever the networks, endpoints or labels of
+// containers change.
+func (nm *NetworkManager) ReconcileFirewall() error {
+	if nm.firewall == nil {
+		return nil
+	}
+
+	nm.firewallLock.Lock()
+	defer nm.firewallLock.Unlock()
+
+	return nm.firewall.Reconcile(nm.firewallRuleset())
+}
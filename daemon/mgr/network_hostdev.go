@@ -0,0 +1,146 @@
+package mgr
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/network/hostdev"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/plugingetter"
+	"github.com/docker/docker/pkg/plugins"
+	"github.com/docker/libnetwork/driverapi"
+	"github.com/pkg/errors"
+)
+
+// builtinPlugin is the network driver served by pouchd in the protocol of
+// remote driver, since libnetwork only registers the drivers it's built with.
+type builtinPlugin struct {
+	name   string
+	client *plugins.Client
+}
+
+// Name implements plugingetter.CompatPlugin.
+func (p *builtinPlugin) Name() string {
+	return p.name
+}
+
+// ScopedPath implements plugingetter.CompatPlugin.
+func (p *builtinPlugin) ScopedPath(s string) string {
+	return s
+}
+
+// IsV1 implements plugingetter.CompatPlugin.
+func (p *builtinPlugin) IsV1() bool {
+	return true
+}
+
+// Client implements plugingetter.CompatPlugin.
+func (p *builtinPlugin) Client() *plugins.Client {
+	return p.client
+}
+
+// builtinPlugins is the plugin getter of libnetwork, which provides the
+// builtin network drivers as the managed plugins, and the other plugins are
+// still discovered from the plugin directories of host.
+type builtinPlugins struct {
+	drivers []plugingetter.CompatPlugin
+}
+
+// Get implements plugingetter.PluginGetter.
+func (pg *builtinPlugins) Get(name, capability string, mode int) (plugingetter.CompatPlugin, error) {
+	if capability == driverapi.NetworkPluginEndpointType {
+		for _, p := range pg.drivers {
+			if p.Name() == name {
+				return p, nil
+			}
+		}
+	}
+	p, err := plugins.Get(name, capability)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetAllByCap implements plugingetter.PluginGetter.
+func (pg *builtinPlugins) GetAllByCap(capability string) ([]plugingetter.CompatPlugin, error) {
+	res := pg.GetAllManagedPluginsByCap(capability)
+
+	legacy, err := plugins.GetAll(capability)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range legacy {
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// GetAllManagedPluginsByCap implements plugingetter.PluginGetter.
+func (pg *builtinPlugins) GetAllManagedPluginsByCap(capability string) []plugingetter.CompatPlugin {
+	if capability != driverapi.NetworkPluginEndpointType {
+		return nil
+	}
+	return append([]plugingetter.CompatPlugin(nil), pg.drivers...)
+}
+
+// Handle implements plugingetter.PluginGetter.
+func (pg *builtinPlugins) Handle(capability string, callback func(string, *plugins.Client)) {
+	plugins.Handle(capability, callback)
+}
+
+// setupHostdevDriver serves the hostdev network driver on the socket under
+// exec root, and returns the plugin getter registering it in libnetwork.
+func setupHostdevDriver(cfg network.Config) (plugingetter.PluginGetter, error) {
+	stateDir := filepath.Join(cfg.MetaPath, "network")
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create state directory of %s driver", hostdev.DriverName)
+	}
+	d, err := hostdev.New(filepath.Join(stateDir, hostdev.DriverName+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	socket := filepath.Join(cfg.ExecRoot, hostdev.DriverName+".sock")
+	if err := os.MkdirAll(cfg.ExecRoot, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create exec root %s", cfg.ExecRoot)
+	}
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to remove stale socket %s", socket)
+	}
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen on socket of %s driver", hostdev.DriverName)
+	}
+	go func() {
+		if err := d.Serve(l); err != nil {
+			log.With(nil).Errorf("%s driver stops serving: %v", hostdev.DriverName, err)
+		}
+	}()
+
+	client, err := plugins.NewClient("unix://"+socket, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create client of %s driver", hostdev.DriverName)
+	}
+	return &builtinPlugins{
+		drivers: []plugingetter.CompatPlugin{&builtinPlugin{name: hostdev.DriverName, client: client}},
+	}, nil
+}
+
+// validateHostdevNetwork checks the parent of hostdev network before creating
+// it, since the errors of remote driver are reported as the internal errors.
+func validateHostdevNetwork(create apitypes.NetworkCreateConfig) error {
+	if create.NetworkCreate.Driver != hostdev.DriverName {
+		return nil
+	}
+
+	if err := hostdev.ValidateNetworkOptions(create.NetworkCreate.Options); err != nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+	return nil
+}
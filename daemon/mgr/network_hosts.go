@@ -0,0 +1,130 @@
+package mgr
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/opts"
+	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/docker/libnetwork"
+	"github.com/docker/libnetwork/etchosts"
+	"github.com/pkg/errors"
+)
+
+// HostGatewayIP returns the address of host-gateway in extra hosts, which is
+// the configured one or the IPv4 gateway of default bridge network.
+func (nm *NetworkManager) HostGatewayIP() (string, error) {
+	if nm.config.BridgeConfig.HostGatewayIP != "" {
+		return nm.config.BridgeConfig.HostGatewayIP, nil
+	}
+
+	n, err := nm.controller.NetworkByName("bridge")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve %s", opts.HostGateway)
+	}
+	v4Infos, _ := n.Info().IpamInfo()
+	for _, info := range v4Infos {
+		if info.Gateway != nil {
+			return info.Gateway.IP.String(), nil
+		}
+	}
+	return "", fmt.Errorf("failed to resolve %s: default bridge network has no IPv4 gateway", opts.HostGateway)
+}
+
+// configHostGatewayIP returns the address of host-gateway which could be
+// known from the config, before the network controller is created.
+func configHostGatewayIP(config network.Config) string {
+	switch {
+	case config.BridgeConfig.HostGatewayIP != "":
+		return config.BridgeConfig.HostGatewayIP
+	case config.BridgeConfig.GatewayIPv4 != "":
+		return config.BridgeConfig.GatewayIPv4
+	}
+	if ip, _, err := net.ParseCIDR(config.BridgeConfig.IPv4); err == nil {
+		return ip.String()
+	}
+	return ""
+}
+
+// hasHostGateway returns true if any of extra hosts is mapped to host-gateway.
+func hasHostGateway(extraHosts []string) bool {
+	for _, extraHost := range extraHosts {
+		if _, ip, err := opts.ParseExtraHost(extraHost); err == nil && ip == opts.HostGateway {
+			return true
+		}
+	}
+	return false
+}
+
+// parseExtraHosts converts the extra hosts into records of hosts file, with
+// host-gateway resolved to hostGatewayIP. The ones mapped to host-gateway
+// are skipped if hostGatewayIP is empty.
+func parseExtraHosts(extraHosts []string, hostGatewayIP string) ([]etchosts.Record, error) {
+	var records []etchosts.Record
+	for _, extraHost := range extraHosts {
+		host, ip, err := opts.ParseExtraHost(extraHost)
+		if err != nil {
+			return nil, err
+		}
+		if ip == opts.HostGateway {
+			if hostGatewayIP == "" {
+				log.With(nil).Warnf("skip extra host %s, since %s is unknown", extraHost, opts.HostGateway)
+				continue
+			}
+			ip = hostGatewayIP
+		}
+		records = append(records, etchosts.Record{Hosts: host, IP: ip})
+	}
+	return records, nil
+}
+
+// extraHostOptions returns the sandbox options to add extra hosts into the
+// hosts file of container.
+func extraHostOptions(extraHosts []string, hostGatewayIP string) ([]libnetwork.SandboxOption, error) {
+	records, err := parseExtraHosts(extraHosts, hostGatewayIP)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []libnetwork.SandboxOption
+	for _, r := range records {
+		options = append(options, libnetwork.OptionExtraHost(r.Hosts, r.IP))
+	}
+	return options, nil
+}
+
+// removeHostsEntries removes the entries of container itself with the
+// addresses from the hosts file, which are left by libnetwork after the
+// container is disconnected from the network of addresses.
+func removeHostsEntries(path, hostname string, addresses ...string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	removed := make(map[string]bool)
+	for _, address := range addresses {
+		if address != "" {
+			removed[address] = true
+		}
+	}
+
+	var lines []string
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && removed[fields[0]] && utils.StringInSlice(fields[1:], hostname) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return ioutil.WriteFile(path, []byte(strings.Join(lines, "")), 0644)
+}
@@ -0,0 +1,72 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/network"
+
+	"github.com/docker/libnetwork/etchosts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigHostGatewayIP(t *testing.T) {
+	for _, tc := range []struct {
+		bridgeConfig network.BridgeConfig
+		expected     string
+	}{
+		{network.BridgeConfig{}, ""},
+		{network.BridgeConfig{IPv4: "192.168.5.1/24"}, "192.168.5.1"},
+		{network.BridgeConfig{IPv4: "192.168.5.1/24", GatewayIPv4: "192.168.5.254"}, "192.168.5.254"},
+		{network.BridgeConfig{GatewayIPv4: "192.168.5.254", HostGatewayIP: "10.0.0.1"}, "10.0.0.1"},
+	} {
+		assert.Equal(t, tc.expected, configHostGatewayIP(network.Config{BridgeConfig: tc.bridgeConfig}))
+	}
+}
+
+func TestParseExtraHosts(t *testing.T) {
+	extraHosts := []string{"foo:10.0.0.2", "host.docker.internal:host-gateway"}
+	assert.True(t, hasHostGateway(extraHosts))
+	assert.False(t, hasHostGateway(extraHosts[:1]))
+
+	records, err := parseExtraHosts(extraHosts, "192.168.5.1")
+	assert.NoError(t, err)
+	assert.Equal(t, []etchosts.Record{{Hosts: "foo", IP: "10.0.0.2"}, {Hosts: "host.docker.internal", IP: "192.168.5.1"}}, records)
+
+	// host-gateway is skipped if it's unknown.
+	records, err = parseExtraHosts(extraHosts, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []etchosts.Record{{Hosts: "foo", IP: "10.0.0.2"}}, records)
+
+	_, err = parseExtraHosts([]string{"foo"}, "")
+	assert.Error(t, err)
+
+	options, err := extraHostOptions(extraHosts, "192.168.5.1")
+	assert.NoError(t, err)
+	assert.Len(t, options, 2)
+}
+
+func TestRemoveHostsEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hosts")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "hosts")
+	content := "127.0.0.1\tlocalhost\n" +
+		"10.0.0.2\tfoo\n" +
+		"172.17.0.2\tweb\n" +
+		"172.30.0.2\tweb.example.com web\n" +
+		"172.30.0.2\tdb\n" +
+		"fd00::2\tweb\n"
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+
+	assert.NoError(t, removeHostsEntries(path, "web", "172.30.0.2", "fd00::2"))
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1\tlocalhost\n10.0.0.2\tfoo\n172.17.0.2\tweb\n172.30.0.2\tdb\n", string(data))
+
+	// nothing to do if the hosts file doesn't exist.
+	assert.NoError(t, removeHostsEntries(filepath.Join(dir, "none"), "web", "172.30.0.2"))
+}
@@ -0,0 +1,133 @@
+package mgr
+
+import (
+	"net"
+	"os/exec"
+	"strings"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network/firewall"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/libnetwork"
+	"github.com/pkg/errors"
+)
+
+// ip6tablesRule is a rule of ip6tables in the chain of table.
+type ip6tablesRule struct {
+	table string
+	chain string
+	args  []string
+}
+
+// validateIPv6Network checks the IPv6 configurations of network, the IPv6
+// subnet is required by the default IPAM driver if IPv6 is enabled.
+func validateIPv6Network(create apitypes.NetworkCreateConfig) error {
+	var hasV6Subnet bool
+	ipam := create.NetworkCreate.IPAM
+	if ipam != nil {
+		for _, cfg := range ipam.Config {
+			if ip, _, err := net.ParseCIDR(cfg.Subnet); err == nil && ip.To4() == nil {
+				hasV6Subnet = true
+				break
+			}
+		}
+	}
+
+	if !create.NetworkCreate.EnableIPV6 {
+		if hasV6Subnet {
+			return errors.Wrap(errtypes.ErrInvalidParam, "IPv6 subnet is only allowed in the network with IPv6 enabled")
+		}
+		return nil
+	}
+
+	if !hasV6Subnet && (ipam == nil || ipam.Driver == "" || ipam.Driver == "default") {
+		return errors.Wrap(errtypes.ErrInvalidParam, "IPv6 subnet is required by the network with IPv6 enabled")
+	}
+	return nil
+}
+
+// ip6tablesRules returns the rules which forward the IPv6 traffic of bridge,
+// the outgoing traffic from subnet is masqueraded if the network is not
+// internal, since the vendored bridge driver only programs iptables.
+func ip6tablesRules(bridgeName string, subnets []string, internal bool) []ip6tablesRule {
+	rules := []ip6tablesRule{
+		{table: "filter", chain: "FORWARD", args: []string{"-i", bridgeName, "-o", bridgeName, "-j", "ACCEPT"}},
+	}
+	if internal {
+		return rules
+	}
+
+	rules = append(rules,
+		ip6tablesRule{table: "filter", chain: "FORWARD", args: []string{"-i", bridgeName, "!", "-o", bridgeName, "-j", "ACCEPT"}},
+		ip6tablesRule{table: "filter", chain: "FORWARD", args: []string{"-o", bridgeName, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}},
+	)
+	for _, subnet := range subnets {
+		rules = append(rules, ip6tablesRule{
+			table: "nat", chain: "POSTROUTING", args: []string{"-s", subnet, "!", "-o", bridgeName, "-j", "MASQUERADE"},
+		})
+	}
+	return rules
+}
+
+// bridgeIP6tablesRules returns the ip6tables rules of the bridge network with
+// IPv6 enabled, nil for the other networks.
+func bridgeIP6tablesRules(n libnetwork.Network) []ip6tablesRule {
+	if n.Type() != "bridge" || !n.Info().IPv6Enabled() {
+		return nil
+	}
+
+	var subnets []string
+	_, _, _, v6Confs := n.Info().IpamConfig()
+	for _, conf := range v6Confs {
+		if conf.PreferredPool != "" {
+			subnets = append(subnets, conf.PreferredPool)
+		}
+	}
+	return ip6tablesRules(bridgeName(n, n.Info().DriverOptions()), subnets, n.Info().Internal())
+}
+
+func runIP6tables(args ...string) error {
+	output, err := exec.Command("ip6tables", args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "failed to run ip6tables %s: %s", strings.Join(args, " "), output)
+	}
+	return nil
+}
+
+// setupIP6tables programs the ip6tables rules of network if ip6tables is
+// enabled in pouchd, the existing rules are skipped. The IPv6 rules are in
+// the nftables table if nftables firewall is used.
+func (nm *NetworkManager) setupIP6tables(n libnetwork.Network) error {
+	if !nm.config.BridgeConfig.IP6Tables || nm.config.BridgeConfig.FirewallBackend == firewall.BackendNFTables {
+		return nil
+	}
+
+	for _, rule := range bridgeIP6tablesRules(n) {
+		check := append([]string{"-t", rule.table, "-C", rule.chain}, rule.args...)
+		if runIP6tables(check...) == nil {
+			continue
+		}
+
+		insert := append([]string{"-t", rule.table, "-I", rule.chain}, rule.args...)
+		if err := runIP6tables(insert...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeIP6tables removes the ip6tables rules of network.
+func (nm *NetworkManager) removeIP6tables(n libnetwork.Network) {
+	if !nm.config.BridgeConfig.IP6Tables || nm.config.BridgeConfig.FirewallBackend == firewall.BackendNFTables {
+		return
+	}
+
+	for _, rule := range bridgeIP6tablesRules(n) {
+		del := append([]string{"-t", rule.table, "-D", rule.chain}, rule.args...)
+		if err := runIP6tables(del...); err != nil {
+			log.With(nil).Warnf("failed to remove ip6tables rule of network %s: %v", n.Name(), err)
+		}
+	}
+}
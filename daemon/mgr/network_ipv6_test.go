@@ -0,0 +1,58 @@
+package mgr
+
+import (
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIPv6Network(t *testing.T) {
+	newCreate := func(enableIPv6 bool, ipamDriver string, subnets ...string) apitypes.NetworkCreateConfig {
+		ipam := &apitypes.IPAM{Driver: ipamDriver}
+		for _, subnet := range subnets {
+			ipam.Config = append(ipam.Config, apitypes.IPAMConfig{Subnet: subnet})
+		}
+		return apitypes.NetworkCreateConfig{
+			Name: "net6",
+			NetworkCreate: apitypes.NetworkCreate{
+				Driver:     "bridge",
+				EnableIPV6: enableIPv6,
+				IPAM:       ipam,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		create  apitypes.NetworkCreateConfig
+		wantErr bool
+	}{
+		{create: newCreate(false, "default", "192.168.2.0/24"), wantErr: false},
+		{create: newCreate(true, "default", "192.168.2.0/24", "fd00:2::/64"), wantErr: false},
+		{create: newCreate(true, "other"), wantErr: false},
+		{create: newCreate(true, "default", "192.168.2.0/24"), wantErr: true},
+		{create: newCreate(false, "default", "fd00:2::/64"), wantErr: true},
+	} {
+		err := validateIPv6Network(tc.create)
+		if tc.wantErr {
+			assert.True(t, errtypes.IsInvalidParam(err), "%v: %v", tc.create.NetworkCreate, err)
+		} else {
+			assert.NoError(t, err, "%v", tc.create.NetworkCreate)
+		}
+	}
+}
+
+func TestIP6tablesRules(t *testing.T) {
+	rules := ip6tablesRules("br-0123456789ab", []string{"fd00:2::/64"}, false)
+	assert.Equal(t, []ip6tablesRule{
+		{table: "filter", chain: "FORWARD", args: []string{"-i", "br-0123456789ab", "-o", "br-0123456789ab", "-j", "ACCEPT"}},
+		{table: "filter", chain: "FORWARD", args: []string{"-i", "br-0123456789ab", "!", "-o", "br-0123456789ab", "-j", "ACCEPT"}},
+		{table: "filter", chain: "FORWARD", args: []string{"-o", "br-0123456789ab", "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}},
+		{table: "nat", chain: "POSTROUTING", args: []string{"-s", "fd00:2::/64", "!", "-o", "br-0123456789ab", "-j", "MASQUERADE"}},
+	}, rules)
+
+	// the internal network is isolated from the outside.
+	assert.Len(t, ip6tablesRules("br-0123456789ab", []string{"fd00:2::/64"}, true), 1)
+}
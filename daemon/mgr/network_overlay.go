@@ -0,0 +1,53 @@
+package mgr
+
+import (
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/network/cluster"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	nwconfig "github.com/docker/libnetwork/config"
+	"github.com/pkg/errors"
+)
+
+// overlayDriver connects the containers on different hosts by VXLAN, the
+// peers are discovered from the cluster store.
+const overlayDriver = "overlay"
+
+// clusterOptions returns the controller options of cluster store, the host
+// is advertised in the store so that the overlay networks span the hosts.
+func clusterOptions(cfg network.Config) ([]nwconfig.Option, error) {
+	if cfg.ClusterStore == "" {
+		return nil, nil
+	}
+
+	provider, address, err := cluster.ParseStore(cfg.ClusterStore)
+	if err != nil {
+		return nil, err
+	}
+	d, err := cluster.New(cfg.ClusterStore, cfg.ClusterAdvertise, cfg.ClusterStoreOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return []nwconfig.Option{
+		nwconfig.OptionKVProvider(provider),
+		nwconfig.OptionKVProviderURL(address),
+		nwconfig.OptionKVOpts(cfg.ClusterStoreOpts),
+		nwconfig.OptionDiscoveryWatcher(d.Watcher()),
+		nwconfig.OptionDiscoveryAddress(d.Address()),
+	}, nil
+}
+
+// validateOverlayNetwork checks the overlay network is created with the
+// cluster store, which holds the network shared by the hosts.
+func validateOverlayNetwork(create apitypes.NetworkCreateConfig, cfg network.Config) error {
+	if create.NetworkCreate.Driver != overlayDriver {
+		return nil
+	}
+
+	if cfg.ClusterStore == "" {
+		return errors.Wrap(errtypes.ErrInvalidParam, "overlay network requires the cluster store of pouchd")
+	}
+	return nil
+}
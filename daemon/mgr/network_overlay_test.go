@@ -0,0 +1,22 @@
+package mgr
+
+import (
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOverlayNetwork(t *testing.T) {
+	create := apitypes.NetworkCreateConfig{
+		Name:          "overlaynet",
+		NetworkCreate: apitypes.NetworkCreate{Driver: "overlay"},
+	}
+	assert.Error(t, validateOverlayNetwork(create, network.Config{}))
+	assert.NoError(t, validateOverlayNetwork(create, network.Config{ClusterStore: "etcd://10.0.0.1:2379"}))
+
+	create.NetworkCreate.Driver = "bridge"
+	assert.NoError(t, validateOverlayNetwork(create, network.Config{}))
+}
@@ -0,0 +1,235 @@
+package mgr
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network/firewall"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/libnetwork"
+	"github.com/docker/libnetwork/drivers/bridge"
+	"github.com/pkg/errors"
+)
+
+// networkPolicyOption is the driver option holding the policy rules of
+// network in JSON, the option is ignored by the bridge driver.
+const networkPolicyOption = "pouch.network.policy"
+
+// policySelector selects the containers on network by CIDR or label.
+type policySelector struct {
+	subnet *net.IPNet
+
+	key      string
+	value    string
+	hasValue bool
+}
+
+// parsePolicySelector parses the selector in the form of CIDR, IP, `key` or
+// `key=value`, nil is returned for the empty selector which matches any.
+func parsePolicySelector(s string) (*policySelector, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if strings.Contains(s, "/") {
+		_, subnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid CIDR %s in network policy", s)
+		}
+		return &policySelector{subnet: subnet}, nil
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		return &policySelector{subnet: hostSubnet(ip)}, nil
+	}
+
+	parts := strings.SplitN(s, "=", 2)
+	if parts[0] == "" {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid label %s in network policy", s)
+	}
+	sel := &policySelector{key: parts[0]}
+	if len(parts) == 2 {
+		sel.value, sel.hasValue = parts[1], true
+	}
+	return sel, nil
+}
+
+// matchLabels returns whether the labels are selected by the label selector.
+func (s *policySelector) matchLabels(labels map[string]string) bool {
+	v, ok := labels[s.key]
+	return ok && (!s.hasValue || v == s.value)
+}
+
+// hostSubnet returns the subnet only containing the IP.
+func hostSubnet(ip net.IP) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+// validateNetworkPolicy validates the policy of network, which only works on
+// the bridge network, and stores the policy in the driver options.
+func validateNetworkPolicy(create *apitypes.NetworkCreate) error {
+	policy := create.Policy
+	if policy == nil {
+		return nil
+	}
+
+	if create.Driver != "" && create.Driver != "bridge" {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "network policy is not supported by driver %s", create.Driver)
+	}
+
+	for _, r := range policy.Rules {
+		if r.Action != apitypes.NetworkPolicyRuleActionAllow && r.Action != apitypes.NetworkPolicyRuleActionDeny {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid action %q of network policy rule, should be allow or deny", r.Action)
+		}
+		if _, err := parsePolicySelector(r.From); err != nil {
+			return err
+		}
+		if _, err := parsePolicySelector(r.To); err != nil {
+			return err
+		}
+	}
+
+	if create.Options == nil {
+		create.Options = make(map[string]string)
+	}
+	if policy.DisableICC {
+		if v, ok := create.Options[bridge.EnableICC]; ok && v != "false" {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "option %s=%s conflicts with DisableICC of network policy", bridge.EnableICC, v)
+		}
+		create.Options[bridge.EnableICC] = "false"
+	}
+	if len(policy.Rules) > 0 {
+		data, err := json.Marshal(policy.Rules)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal network policy rules")
+		}
+		create.Options[networkPolicyOption] = string(data)
+	}
+	return nil
+}
+
+// NetworkPolicy returns the policy of network from its driver options, nil
+// is returned if the network has no policy.
+func NetworkPolicy(options map[string]string) *apitypes.NetworkPolicy {
+	policy := &apitypes.NetworkPolicy{}
+	if v, ok := options[bridge.EnableICC]; ok {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			policy.DisableICC = !enabled
+		}
+	}
+	if data, ok := options[networkPolicyOption]; ok {
+		// the option is validated when creating network.
+		json.Unmarshal([]byte(data), &policy.Rules)
+	}
+
+	if !policy.DisableICC && len(policy.Rules) == 0 {
+		return nil
+	}
+	return policy
+}
+
+// policyEndpoint is the endpoint on network matched by the policy rules.
+type policyEndpoint struct {
+	labels    map[string]string
+	addresses []*net.IPNet
+}
+
+// containerLabels returns the labels of all the containers by their IDs.
+func (nm *NetworkManager) containerLabels() map[string]map[string]string {
+	labels := make(map[string]map[string]string)
+	if nm.ctrMgr == nil {
+		return labels
+	}
+
+	ctrs, err := nm.ctrMgr.List(context.Background(), &ContainerListOption{All: true})
+	if err != nil {
+		log.With(nil).Warnf("failed to list containers for network policy: %v", err)
+		return labels
+	}
+	for _, c := range ctrs {
+		if c.Config != nil {
+			labels[c.ID] = c.Config.Labels
+		}
+	}
+	return labels
+}
+
+// policyEndpoints returns the addresses of endpoints on network with the
+// labels of their containers.
+func policyEndpoints(n libnetwork.Network, labels map[string]map[string]string) []policyEndpoint {
+	var endpoints []policyEndpoint
+	for _, ep := range n.Endpoints() {
+		info := ep.Info()
+		if info == nil || info.Iface() == nil {
+			continue
+		}
+
+		var pe policyEndpoint
+		if addr := info.Iface().Address(); addr != nil {
+			pe.addresses = append(pe.addresses, hostSubnet(addr.IP))
+		}
+		if addr := info.Iface().AddressIPv6(); addr != nil && addr.IP != nil {
+			pe.addresses = append(pe.addresses, hostSubnet(addr.IP))
+		}
+		if sb := info.Sandbox(); sb != nil {
+			pe.labels = labels[sb.ContainerID()]
+		}
+		endpoints = append(endpoints, pe)
+	}
+	return endpoints
+}
+
+// policyRules resolves the selectors of policy rules into the addresses of
+// endpoints, the rule selecting no endpoint is skipped.
+func policyRules(policy *apitypes.NetworkPolicy, endpoints []policyEndpoint) []firewall.PolicyRule {
+	if policy == nil {
+		return nil
+	}
+
+	resolve := func(s string) ([]*net.IPNet, bool) {
+		sel, err := parsePolicySelector(s)
+		if err != nil {
+			return nil, false
+		}
+		if sel == nil {
+			return nil, true
+		}
+		if sel.subnet != nil {
+			return []*net.IPNet{sel.subnet}, true
+		}
+
+		var addrs []*net.IPNet
+		for _, ep := range endpoints {
+			if sel.matchLabels(ep.labels) {
+				addrs = append(addrs, ep.addresses...)
+			}
+		}
+		return addrs, len(addrs) > 0
+	}
+
+	var rules []firewall.PolicyRule
+	for _, r := range policy.Rules {
+		from, ok := resolve(r.From)
+		if !ok {
+			continue
+		}
+		to, ok := resolve(r.To)
+		if !ok {
+			continue
+		}
+		rules = append(rules, firewall.PolicyRule{
+			Accept: r.Action == apitypes.NetworkPolicyRuleActionAllow,
+			From:   from,
+			To:     to,
+		})
+	}
+	return rules
+}
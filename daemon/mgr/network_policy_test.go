@@ -0,0 +1,90 @@
+package mgr
+
+import (
+	"net"
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network/firewall"
+
+	"github.com/docker/libnetwork/drivers/bridge"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePolicySelector(t *testing.T) {
+	sel, err := parsePolicySelector("")
+	assert.NoError(t, err)
+	assert.Nil(t, sel)
+
+	sel, err = parsePolicySelector("10.0.0.0/8")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", sel.subnet.String())
+
+	sel, err = parsePolicySelector("fd00::2")
+	assert.NoError(t, err)
+	assert.Equal(t, "fd00::2/128", sel.subnet.String())
+
+	sel, err = parsePolicySelector("tenant=a")
+	assert.NoError(t, err)
+	assert.True(t, sel.matchLabels(map[string]string{"tenant": "a"}))
+	assert.False(t, sel.matchLabels(map[string]string{"tenant": "b"}))
+
+	sel, err = parsePolicySelector("tenant")
+	assert.NoError(t, err)
+	assert.True(t, sel.matchLabels(map[string]string{"tenant": "b"}))
+	assert.False(t, sel.matchLabels(nil))
+
+	for _, s := range []string{"10.0.0.0/33", "=a"} {
+		_, err := parsePolicySelector(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestValidateNetworkPolicy(t *testing.T) {
+	create := &apitypes.NetworkCreate{
+		Driver: "bridge",
+		Policy: &apitypes.NetworkPolicy{
+			DisableICC: true,
+			Rules:      []apitypes.NetworkPolicyRule{{Action: "allow", From: "tenant=a", To: "tenant=a"}},
+		},
+	}
+	assert.NoError(t, validateNetworkPolicy(create))
+	assert.Equal(t, "false", create.Options[bridge.EnableICC])
+	assert.Equal(t, create.Policy, NetworkPolicy(create.Options))
+
+	for _, create := range []*apitypes.NetworkCreate{
+		{Driver: "macvlan", Policy: &apitypes.NetworkPolicy{DisableICC: true}},
+		{Policy: &apitypes.NetworkPolicy{Rules: []apitypes.NetworkPolicyRule{{Action: "accept"}}}},
+		{Policy: &apitypes.NetworkPolicy{Rules: []apitypes.NetworkPolicyRule{{Action: "deny", To: "10.0.0.0/33"}}}},
+		{Options: map[string]string{bridge.EnableICC: "true"}, Policy: &apitypes.NetworkPolicy{DisableICC: true}},
+	} {
+		assert.Error(t, validateNetworkPolicy(create))
+	}
+
+	assert.Nil(t, NetworkPolicy(map[string]string{bridge.EnableICC: "true"}))
+}
+
+func TestPolicyRules(t *testing.T) {
+	host := func(s string) *net.IPNet {
+		return hostSubnet(net.ParseIP(s))
+	}
+	endpoints := []policyEndpoint{
+		{labels: map[string]string{"tenant": "a"}, addresses: []*net.IPNet{host("172.18.0.2"), host("fd00::2")}},
+		{labels: map[string]string{"tenant": "b"}, addresses: []*net.IPNet{host("172.18.0.3")}},
+		{addresses: []*net.IPNet{host("172.18.0.4")}},
+	}
+	policy := &apitypes.NetworkPolicy{
+		Rules: []apitypes.NetworkPolicyRule{
+			{Action: "allow", From: "tenant=a", To: "172.18.0.0/16"},
+			// no container is selected.
+			{Action: "deny", From: "tenant=c"},
+			{Action: "deny", To: "tenant"},
+		},
+	}
+
+	_, subnet, _ := net.ParseCIDR("172.18.0.0/16")
+	assert.Equal(t, []firewall.PolicyRule{
+		{Accept: true, From: []*net.IPNet{host("172.18.0.2"), host("fd00::2")}, To: []*net.IPNet{subnet}},
+		{Accept: false, To: []*net.IPNet{host("172.18.0.2"), host("fd00::2"), host("172.18.0.3")}},
+	}, policyRules(policy, endpoints))
+}
@@ -0,0 +1,38 @@
+package mgr
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/alibaba/pouch/network/proxy"
+
+	"github.com/pkg/errors"
+)
+
+// setupUserlandProxyLink links pouchd into the exec root with the name of
+// userland proxy, so that pouchd runs as the proxy when the bridge driver
+// starts it for the published ports. The path of link is returned.
+func setupUserlandProxyLink(execRoot string) (string, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the path of pouchd")
+	}
+
+	if err := os.MkdirAll(execRoot, 0755); err != nil {
+		return "", err
+	}
+
+	link := filepath.Join(execRoot, proxy.CommandName)
+	if target, err := os.Readlink(link); err == nil && target == self {
+		return link, nil
+	}
+
+	// the link is left by the pouchd binary in another path.
+	if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	if err := os.Symlink(self, link); err != nil {
+		return "", errors.Wrapf(err, "failed to link userland proxy %s", link)
+	}
+	return link, nil
+}
@@ -0,0 +1,39 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/network/proxy"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupUserlandProxyLink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "userland-proxy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the stale link is replaced.
+	link := filepath.Join(dir, proxy.CommandName)
+	assert.NoError(t, os.Symlink("/nonexistent/pouchd", link))
+
+	for i := 0; i < 2; i++ {
+		path, err := setupUserlandProxyLink(dir)
+		assert.NoError(t, err)
+		assert.Equal(t, link, path)
+
+		target, err := os.Readlink(path)
+		assert.NoError(t, err)
+		assert.Equal(t, self, target)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
 	"github.com/docker/libnetwork"
 )
 
@@ -101,3 +104,65 @@ func Test_getIpamConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEndpointAliases(t *testing.T) {
+	aliased := &apitypes.EndpointSettings{Aliases: []string{"db"}}
+
+	for network, wantErr := range map[string]bool{
+		"mynet":  false,
+		"bridge": true,
+		"host":   true,
+		"none":   true,
+	} {
+		if err := validateEndpointAliases(network, aliased); (err != nil) != wantErr {
+			t.Errorf("validateEndpointAliases(%s) error = %v, wantErr %v", network, err, wantErr)
+		}
+	}
+
+	if err := validateEndpointAliases("bridge", &apitypes.EndpointSettings{}); err != nil {
+		t.Errorf("validateEndpointAliases() without aliases error = %v", err)
+	}
+}
+
+func TestValidateNetworkConnect(t *testing.T) {
+	newContainer := func(mode string, networks ...string) *Container {
+		c := &Container{
+			HostConfig:      &apitypes.HostConfig{NetworkMode: mode},
+			NetworkSettings: &apitypes.NetworkSettings{Networks: map[string]*apitypes.EndpointSettings{}},
+		}
+		for _, n := range networks {
+			c.NetworkSettings.Networks[n] = &apitypes.EndpointSettings{}
+		}
+		return c
+	}
+	mynet := &types.Network{Name: "mynet"}
+	bridge := &types.Network{Name: "bridge"}
+	withIP := &apitypes.EndpointSettings{IPAMConfig: &apitypes.EndpointIPAMConfig{IPV4Address: "172.18.0.10"}}
+
+	tests := []struct {
+		name      string
+		container *Container
+		network   *types.Network
+		epConfig  *apitypes.EndpointSettings
+		wantErr   bool
+	}{
+		{name: "connect", container: newContainer("bridge", "bridge"), network: mynet, epConfig: &apitypes.EndpointSettings{}, wantErr: false},
+		{name: "connectWithIP", container: newContainer("bridge", "bridge"), network: mynet, epConfig: withIP, wantErr: false},
+		{name: "alreadyConnected", container: newContainer("mynet", "mynet"), network: mynet, epConfig: &apitypes.EndpointSettings{}, wantErr: true},
+		{name: "containerMode", container: newContainer("container:abc"), network: mynet, epConfig: &apitypes.EndpointSettings{}, wantErr: true},
+		{name: "cniMode", container: newContainer("cni"), network: mynet, epConfig: &apitypes.EndpointSettings{}, wantErr: true},
+		{name: "ipOnDefaultBridge", container: newContainer("mynet", "mynet"), network: bridge, epConfig: withIP, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateNetworkConnect(tt.container, tt.network, tt.epConfig); (err != nil) != tt.wantErr {
+				t.Errorf("validateNetworkConnect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	err := validateNetworkConnect(newContainer("mynet", "mynet"), mynet, &apitypes.EndpointSettings{})
+	if !errtypes.IsConflict(err) {
+		t.Errorf("validateNetworkConnect() to the connected network error = %v, want conflict", err)
+	}
+}
@@ -2,6 +2,7 @@ package mgr
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -36,9 +37,26 @@ func IsNetNS(mode string) bool {
 	return len(parts) > 1 && parts[0] == "netns"
 }
 
+// IsCNI is used to check if network mode is cni mode, in which the network
+// is set up by the CNI plugins, "cni" for the default CNI network and
+// "cni:net1,net2" for the specific CNI networks.
+func IsCNI(mode string) bool {
+	return mode == "cni" || strings.HasPrefix(mode, "cni:")
+}
+
+// cniNetworks returns the CNI networks of cni network mode, empty means the
+// default CNI network.
+func cniNetworks(mode string) []string {
+	parts := strings.SplitN(mode, ":", 2)
+	if len(parts) < 2 || parts[1] == "" {
+		return nil
+	}
+	return strings.Split(parts[1], ",")
+}
+
 // IsUserDefined is used to check if network mode is user-created.
 func IsUserDefined(mode string) bool {
-	return !IsBridge(mode) && !IsContainer(mode) && !IsHost(mode) && !IsNone(mode) && !IsNetNS(mode)
+	return !IsBridge(mode) && !IsContainer(mode) && !IsHost(mode) && !IsNone(mode) && !IsNetNS(mode) && !IsCNI(mode)
 }
 
 // IsDefault indicates whether container uses the default network stack.
@@ -56,6 +74,15 @@ func hasUserDefinedIPAddress(epConfig *types.EndpointSettings) bool {
 	return epConfig != nil && epConfig.IPAMConfig != nil && (len(epConfig.IPAMConfig.IPV4Address) > 0 || len(epConfig.IPAMConfig.IPV6Address) > 0)
 }
 
+// validateEndpointAliases checks that the network-scoped aliases are only set
+// in user-defined networks, where the embedded DNS server resolves them.
+func validateEndpointAliases(network string, epConfig *types.EndpointSettings) error {
+	if epConfig != nil && len(epConfig.Aliases) > 0 && !IsUserDefined(network) {
+		return fmt.Errorf("network-scoped alias is supported only for containers in user defined networks")
+	}
+	return nil
+}
+
 // User specified ip address is acceptable only for networks with user specified subnets.
 func validateNetworkingConfig(network libnetwork.Network, epConfig *types.EndpointSettings) error {
 	if network == nil || epConfig == nil {
@@ -67,29 +94,48 @@ func validateNetworkingConfig(network libnetwork.Network, epConfig *types.Endpoi
 
 	_, _, nwIPv4Configs, nwIPv6Configs := network.Info().IpamConfig()
 	for _, s := range []struct {
-		ipConfigured  bool
+		address       string
+		ipv4          bool
 		subnetConfigs []*libnetwork.IpamConf
 	}{
 		{
-			ipConfigured:  len(epConfig.IPAMConfig.IPV4Address) > 0,
+			address:       epConfig.IPAMConfig.IPV4Address,
+			ipv4:          true,
 			subnetConfigs: nwIPv4Configs,
 		},
 		{
-			ipConfigured:  len(epConfig.IPAMConfig.IPV6Address) > 0,
+			address:       epConfig.IPAMConfig.IPV6Address,
 			subnetConfigs: nwIPv6Configs,
 		},
 	} {
-		if s.ipConfigured {
-			foundSubnet := false
-			for _, cfg := range s.subnetConfigs {
-				if len(cfg.PreferredPool) > 0 {
-					foundSubnet = true
-					break
-				}
+		if s.address == "" {
+			continue
+		}
+
+		ip := net.ParseIP(s.address)
+		if ip == nil || (ip.To4() != nil) != s.ipv4 {
+			return fmt.Errorf("invalid IP address %s", s.address)
+		}
+
+		foundSubnet, inSubnet := false, false
+		for _, cfg := range s.subnetConfigs {
+			if len(cfg.PreferredPool) == 0 {
+				continue
+			}
+			foundSubnet = true
+			if _, subnet, err := net.ParseCIDR(cfg.PreferredPool); err != nil || !subnet.Contains(ip) {
+				continue
 			}
-			if !foundSubnet {
-				return fmt.Errorf("user specified IP address is supported only when connecting to networks with user configured subnets")
+			if cfg.Gateway != "" && net.ParseIP(cfg.Gateway).Equal(ip) {
+				return fmt.Errorf("user specified IP address %s is the gateway of network %s", s.address, network.Name())
 			}
+			inSubnet = true
+		}
+		if !foundSubnet {
+			return fmt.Errorf("user specified IP address is supported only when connecting to networks with user configured subnets")
+		}
+		if !inSubnet {
+			return fmt.Errorf("user specified IP address %s is not in the subnets of network %s", s.address, network.Name())
 		}
 	}
 
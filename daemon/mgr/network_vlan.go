@@ -0,0 +1,92 @@
+package mgr
+
+import (
+	"net"
+	"strings"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// macvlanDriver is the driver of network whose containers get the macvlan
+	// sub-interfaces of the parent interface.
+	macvlanDriver = "macvlan"
+
+	// ipvlanDriver is the driver of network whose containers get the ipvlan
+	// sub-interfaces of the parent interface.
+	ipvlanDriver = "ipvlan"
+
+	// vlanParentOpt is the option of parent interface, such as "eth0" or
+	// "eth0.10" for the 802.1q sub-interface which is created by the driver.
+	vlanParentOpt = "parent"
+)
+
+// vlanModes are the modes supported by the macvlan and ipvlan drivers.
+var vlanModes = map[string][]string{
+	macvlanDriver: {"bridge", "private", "vepa", "passthru"},
+	ipvlanDriver:  {"l2", "l3"},
+}
+
+// isVlanDriver returns whether the network driver binds network to the
+// parent interface of host.
+func isVlanDriver(driver string) bool {
+	_, ok := vlanModes[driver]
+	return ok
+}
+
+// validateVlanNetwork checks the options of macvlan and ipvlan networks
+// before creating them, since the drivers only report the invalid options
+// in the logs of daemon. The parent interface is optional, the driver
+// creates a dummy interface for the network only reachable by its containers.
+func validateVlanNetwork(create apitypes.NetworkCreateConfig) error {
+	driver := create.NetworkCreate.Driver
+	if !isVlanDriver(driver) {
+		return nil
+	}
+
+	opts := create.NetworkCreate.Options
+	if mode, ok := opts[driver+"_mode"]; ok && mode != "" {
+		valid := false
+		for _, m := range vlanModes[driver] {
+			if mode == m {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid %s mode %s, supported modes: %s",
+				driver, mode, strings.Join(vlanModes[driver], ", "))
+		}
+	}
+
+	if parent := opts[vlanParentOpt]; parent != "" {
+		// the vlan sub-interface "eth0.10" is created on "eth0" if it doesn't exist.
+		link := strings.SplitN(parent, ".", 2)[0]
+		if _, err := net.InterfaceByName(link); err != nil {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid parent interface %s of %s network: %v", parent, driver, err)
+		}
+	}
+
+	// the containers are in the same layer 2 network with the parent
+	// interface, so that the subnet can't be allocated by the default pools.
+	ipam := create.NetworkCreate.IPAM
+	if ipam != nil && ipam.Driver != "" && ipam.Driver != "default" {
+		return nil
+	}
+	hasV4Subnet := false
+	if ipam != nil {
+		for _, cfg := range ipam.Config {
+			if ip, _, err := net.ParseCIDR(cfg.Subnet); err == nil && ip.To4() != nil {
+				hasV4Subnet = true
+				break
+			}
+		}
+	}
+	if !hasV4Subnet {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "%s network requires an IPv4 subnet", driver)
+	}
+	return nil
+}
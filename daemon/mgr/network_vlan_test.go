@@ -0,0 +1,50 @@
+package mgr
+
+import (
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVlanNetwork(t *testing.T) {
+	newCreate := func(driver string, opts map[string]string, subnet string) apitypes.NetworkCreateConfig {
+		ipam := &apitypes.IPAM{Driver: "default"}
+		if subnet != "" {
+			ipam.Config = []apitypes.IPAMConfig{{Subnet: subnet}}
+		}
+		return apitypes.NetworkCreateConfig{
+			Name: "vlan",
+			NetworkCreate: apitypes.NetworkCreate{
+				Driver:  driver,
+				Options: opts,
+				IPAM:    ipam,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		create  apitypes.NetworkCreateConfig
+		wantErr bool
+	}{
+		{create: newCreate("bridge", nil, ""), wantErr: false},
+		{create: newCreate("macvlan", nil, "192.168.1.0/24"), wantErr: false},
+		{create: newCreate("macvlan", map[string]string{"parent": "lo", "macvlan_mode": "private"}, "192.168.1.0/24"), wantErr: false},
+		{create: newCreate("macvlan", map[string]string{"parent": "lo.10"}, "192.168.1.0/24"), wantErr: false},
+		{create: newCreate("ipvlan", map[string]string{"parent": "lo", "ipvlan_mode": "l3"}, "192.168.1.0/24"), wantErr: false},
+		{create: newCreate("macvlan", map[string]string{"macvlan_mode": "l3"}, "192.168.1.0/24"), wantErr: true},
+		{create: newCreate("ipvlan", map[string]string{"ipvlan_mode": "bridge"}, "192.168.1.0/24"), wantErr: true},
+		{create: newCreate("macvlan", map[string]string{"parent": "nonexistent0"}, "192.168.1.0/24"), wantErr: true},
+		{create: newCreate("macvlan", nil, ""), wantErr: true},
+		{create: newCreate("ipvlan", nil, "fd00::/64"), wantErr: true},
+	} {
+		err := validateVlanNetwork(tc.create)
+		if tc.wantErr {
+			assert.True(t, errtypes.IsInvalidParam(err), "%v: %v", tc.create.NetworkCreate, err)
+		} else {
+			assert.NoError(t, err, "%v", tc.create.NetworkCreate)
+		}
+	}
+}
@@ -2,10 +2,15 @@ package mgr
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 
+	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/oci"
 
+	"github.com/docker/docker/pkg/idtools"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
 // SpecWrapper wraps the container's specs and add manager operations.
@@ -18,6 +23,18 @@ type SpecWrapper struct {
 	prioArr    []int
 	argsArr    [][]string
 	useSystemd bool
+	idMapping  *idtools.IdentityMapping
+
+	// hooks are the OCI hooks of daemon.
+	hooks []*types.OCIHook
+
+	// initPath is the path of init binary run as the first process of
+	// container, empty if the container doesn't run the init.
+	initPath string
+
+	// baseSpec is the path of spec template of runtime, which is merged
+	// into the default spec.
+	baseSpec string
 }
 
 // All the functions related to the spec is lock-free for container instance,
@@ -26,8 +43,11 @@ type SpecWrapper struct {
 
 // createSpec create a runtime-spec.
 func createSpec(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
-	// new a default spec from containerd.
-	s := oci.NewDefaultSpec()
+	// new a default spec from containerd, merged with the template of runtime.
+	s, err := newBaseSpec(specWrapper.baseSpec)
+	if err != nil {
+		return err
+	}
 	specWrapper.s = s
 
 	s.Hostname = c.Config.Hostname.String()
@@ -35,6 +55,9 @@ func createSpec(ctx context.Context, c *Container, specWrapper *SpecWrapper) err
 		Path:     c.BaseFS,
 		Readonly: c.HostConfig.ReadonlyRootfs,
 	}
+	if c.hostRootfs() && c.HostConfig.Rootfs.Propagation != "" {
+		s.Linux.RootfsPropagation = c.HostConfig.Rootfs.Propagation
+	}
 
 	// create Spec.Process spec
 	if err := setupProcess(ctx, c, s); err != nil {
@@ -46,11 +69,21 @@ func createSpec(ctx context.Context, c *Container, specWrapper *SpecWrapper) err
 		return err
 	}
 
+	// inject the init as the first process
+	if err := setupInit(ctx, c, specWrapper); err != nil {
+		return err
+	}
+
 	// create Spec.Annotations
 	if err := setupAnnotations(ctx, c, s); err != nil {
 		return err
 	}
 
+	// pass kata annotations through to the shim
+	if err := setupKataAnnotations(ctx, c, s); err != nil {
+		return err
+	}
+
 	// create Spec.Hooks spec
 	if err := setupHook(ctx, c, specWrapper); err != nil {
 		return err
@@ -58,5 +91,28 @@ func createSpec(ctx context.Context, c *Container, specWrapper *SpecWrapper) err
 
 	// platform-specified spec setting
 	// TODO: support window and Solaris platform
-	return populatePlatform(ctx, c, specWrapper)
+	if err := populatePlatform(ctx, c, specWrapper); err != nil {
+		return err
+	}
+
+	// merge the edits of CDI devices
+	return setupCDIDevices(ctx, c, specWrapper)
+}
+
+// newBaseSpec returns the default spec merged with the spec template, the
+// fields in template override the default ones.
+func newBaseSpec(template string) (*specs.Spec, error) {
+	s := oci.NewDefaultSpec()
+	if template == "" {
+		return s, nil
+	}
+
+	data, err := ioutil.ReadFile(template)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read base spec %s", template)
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse base spec %s", template)
+	}
+	return s, nil
 }
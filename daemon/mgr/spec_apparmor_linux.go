@@ -4,21 +4,59 @@ package mgr
 
 import (
 	"context"
+	"fmt"
+
+	pouchapparmor "github.com/alibaba/pouch/pkg/apparmor"
 
 	"github.com/opencontainers/runc/libcontainer/apparmor"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// installAppArmorProfile loads the default AppArmor profile when the daemon
+// starts. The failure fails the daemon start, since no container without
+// its own profile can start if the default profile isn't loaded.
+func installAppArmorProfile() error {
+	if !apparmor.IsEnabled() {
+		return nil
+	}
+
+	return pouchapparmor.InstallDefaultProfile(pouchapparmor.DefaultProfileName)
+}
+
+// resolveAppArmorProfile resolves the AppArmor profile of container when
+// creating it, so that the profile is reported in inspect.
+func resolveAppArmorProfile(c *Container) {
+	if !apparmor.IsEnabled() || c.AppArmorProfile != "" {
+		return
+	}
+
+	if c.HostConfig.Privileged {
+		c.AppArmorProfile = ProfileNameUnconfined
+	} else {
+		c.AppArmorProfile = pouchapparmor.DefaultProfileName
+	}
+}
+
 func setupAppArmor(ctx context.Context, c *Container, s *specs.Spec) error {
 	if apparmor.IsEnabled() {
 		appArmorProfile := ""
 		if c.AppArmorProfile != "" {
 			appArmorProfile = c.AppArmorProfile
 		} else if c.HostConfig.Privileged {
-			appArmorProfile = "unconfined"
+			appArmorProfile = ProfileNameUnconfined
 		} else {
-			// TODO: generate pouch-default apparmor profile
-			// appArmorProfile = "pouch-default"
+			appArmorProfile = pouchapparmor.DefaultProfileName
+		}
+
+		// the profile should be loaded before task starts.
+		if appArmorProfile != ProfileNameUnconfined {
+			loaded, err := pouchapparmor.IsProfileLoaded(appArmorProfile)
+			if err != nil {
+				return fmt.Errorf("failed to check AppArmor profile %s: %v", appArmorProfile, err)
+			}
+			if !loaded {
+				return fmt.Errorf("AppArmor profile %s is not loaded", appArmorProfile)
+			}
 		}
 
 		s.Process.ApparmorProfile = appArmorProfile
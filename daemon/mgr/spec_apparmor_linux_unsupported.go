@@ -8,6 +8,14 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// do nothing in this case
+func installAppArmorProfile() error {
+	return nil
+}
+
+// do nothing in this case
+func resolveAppArmorProfile(c *Container) {}
+
 // do nothing in this case
 func setupAppArmor(ctx context.Context, c *Container, s *specs.Spec) error {
 	return nil
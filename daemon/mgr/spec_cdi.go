@@ -0,0 +1,149 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/cdi"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// cdiSpecDirs are the directories to load the CDI spec files from.
+var cdiSpecDirs = cdi.DefaultSpecDirs
+
+// cdiDevices returns the CDI devices requested by qualified name in the
+// devices of container.
+func cdiDevices(c *Container) []string {
+	var names []string
+	for _, d := range c.HostConfig.Devices {
+		if d != nil && cdi.IsQualifiedName(d.PathOnHost) {
+			names = append(names, d.PathOnHost)
+		}
+	}
+	return names
+}
+
+// validateCDIDevices checks the CDI devices of container can be resolved, so
+// that the missing devices are reported when creating the container.
+func validateCDIDevices(c *Container) error {
+	names := cdiDevices(c)
+	if len(names) == 0 {
+		return nil
+	}
+
+	registry, err := cdi.NewRegistry(cdiSpecDirs...)
+	if err != nil {
+		return errors.Wrap(err, "failed to load CDI specs")
+	}
+	_, err = registry.Resolve(names)
+	return err
+}
+
+// setupCDIDevices merges the edits of CDI devices into the spec.
+func setupCDIDevices(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	names := cdiDevices(c)
+	if len(names) == 0 {
+		return nil
+	}
+
+	registry, err := cdi.NewRegistry(cdiSpecDirs...)
+	if err != nil {
+		return errors.Wrap(err, "failed to load CDI specs")
+	}
+	edits, err := registry.Resolve(names)
+	if err != nil {
+		return err
+	}
+	return applyCDIEdits(edits, specWrapper.s)
+}
+
+// applyCDIEdits applies the env, device nodes, mounts and hooks of CDI into
+// the spec.
+func applyCDIEdits(edits *cdi.ContainerEdits, s *specs.Spec) error {
+	for _, env := range edits.Env {
+		key := strings.SplitN(env, "=", 2)[0] + "="
+		replaced := false
+		for i, e := range s.Process.Env {
+			if strings.HasPrefix(e, key) {
+				s.Process.Env[i] = env
+				replaced = true
+			}
+		}
+		if !replaced {
+			s.Process.Env = append(s.Process.Env, env)
+		}
+	}
+
+	for _, d := range edits.DeviceNodes {
+		hostPath, permissions := d.HostPath, d.Permissions
+		if hostPath == "" {
+			hostPath = d.Path
+		}
+		if permissions == "" {
+			permissions = "rwm"
+		}
+
+		devs, devPermissions, err := devicesFromPath(hostPath, d.Path, permissions)
+		if err != nil {
+			return err
+		}
+		for _, dev := range devs {
+			if !hasLinuxDevice(s.Linux.Devices, dev.Path) {
+				s.Linux.Devices = append(s.Linux.Devices, dev)
+			}
+		}
+		s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, devPermissions...)
+	}
+
+	for _, m := range edits.Mounts {
+		typ, options := m.Type, m.Options
+		if typ == "" {
+			typ = "bind"
+		}
+		if typ == "bind" && !utils.StringInSlice(options, "bind") && !utils.StringInSlice(options, "rbind") {
+			options = append([]string{"rbind"}, options...)
+		}
+		s.Mounts = append(s.Mounts, specs.Mount{
+			Destination: m.ContainerPath,
+			Source:      m.HostPath,
+			Type:        typ,
+			Options:     options,
+		})
+	}
+
+	for _, h := range edits.Hooks {
+		hook := specs.Hook{
+			Path:    h.Path,
+			Args:    h.Args,
+			Env:     h.Env,
+			Timeout: h.Timeout,
+		}
+		// the hooks run in runtime namespace before pivot_root are prestart
+		// hooks of the runtime spec.
+		switch h.HookName {
+		case "prestart", "createRuntime", "createContainer":
+			s.Hooks.Prestart = append(s.Hooks.Prestart, hook)
+		case "poststart":
+			s.Hooks.Poststart = append(s.Hooks.Poststart, hook)
+		case "poststop":
+			s.Hooks.Poststop = append(s.Hooks.Poststop, hook)
+		default:
+			return fmt.Errorf("unsupported CDI hook %s of %s", h.HookName, h.Path)
+		}
+	}
+	return nil
+}
+
+// hasLinuxDevice returns whether the device of path exists.
+func hasLinuxDevice(devs []specs.LinuxDevice, path string) bool {
+	for _, d := range devs {
+		if d.Path == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/cdi"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCDIEdits(t *testing.T) {
+	s := &specs.Spec{
+		Process: &specs.Process{Env: []string{"PATH=/bin", "VENDOR=old"}},
+		Linux:   &specs.Linux{Resources: &specs.LinuxResources{}},
+		Hooks:   &specs.Hooks{},
+	}
+
+	edits := &cdi.ContainerEdits{
+		Env:         []string{"VENDOR=new", "VENDOR_DRIVER=1"},
+		DeviceNodes: []*cdi.DeviceNode{{Path: "/dev/vendor0", HostPath: "/dev/null"}},
+		Mounts: []*cdi.Mount{
+			{HostPath: "/usr/lib/libvendor.so", ContainerPath: "/usr/lib/libvendor.so", Options: []string{"ro"}},
+			{HostPath: "tmpfs", ContainerPath: "/vendor", Type: "tmpfs"},
+		},
+		Hooks: []*cdi.Hook{
+			{HookName: "createContainer", Path: "/usr/bin/vendor-hook"},
+			{HookName: "poststop", Path: "/usr/bin/vendor-cleanup"},
+		},
+	}
+	assert.NoError(t, applyCDIEdits(edits, s))
+
+	assert.Equal(t, []string{"PATH=/bin", "VENDOR=new", "VENDOR_DRIVER=1"}, s.Process.Env)
+	assert.Equal(t, 1, len(s.Linux.Devices))
+	assert.Equal(t, "/dev/vendor0", s.Linux.Devices[0].Path)
+	assert.Equal(t, 1, len(s.Linux.Resources.Devices))
+	assert.Equal(t, []specs.Mount{
+		{Destination: "/usr/lib/libvendor.so", Source: "/usr/lib/libvendor.so", Type: "bind", Options: []string{"rbind", "ro"}},
+		{Destination: "/vendor", Source: "tmpfs", Type: "tmpfs"},
+	}, s.Mounts)
+	assert.Equal(t, []specs.Hook{{Path: "/usr/bin/vendor-hook"}}, s.Hooks.Prestart)
+	assert.Equal(t, []specs.Hook{{Path: "/usr/bin/vendor-cleanup"}}, s.Hooks.Poststop)
+
+	edits = &cdi.ContainerEdits{Hooks: []*cdi.Hook{{HookName: "startContainer", Path: "/usr/bin/vendor-hook"}}}
+	assert.Error(t, applyCDIEdits(edits, s))
+}
+
+func TestValidateCDIDevices(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-cdi-devices")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	spec := "kind: vendor.com/gpu\ndevices:\n- name: gpu0\n  containerEdits:\n    env: [GPU=0]\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "vendor.yaml"), []byte(spec), 0644))
+	defer func(dirs []string) { cdiSpecDirs = dirs }(cdiSpecDirs)
+	cdiSpecDirs = []string{tmpDir}
+
+	c := &Container{HostConfig: &types.HostConfig{}}
+	c.HostConfig.Devices = []*types.DeviceMapping{
+		{PathOnHost: "/dev/null", PathInContainer: "/dev/null", CgroupPermissions: "rwm"},
+		{PathOnHost: "vendor.com/gpu=gpu0", PathInContainer: "vendor.com/gpu=gpu0", CgroupPermissions: "rwm"},
+	}
+	assert.Equal(t, []string{"vendor.com/gpu=gpu0"}, cdiDevices(c))
+	assert.NoError(t, validateCDIDevices(c))
+
+	c.HostConfig.Devices[1].PathOnHost = "vendor.com/gpu=gpu1"
+	assert.Error(t, validateCDIDevices(c))
+}
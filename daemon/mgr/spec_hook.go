@@ -2,9 +2,15 @@ package mgr
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 )
@@ -52,9 +58,73 @@ func setupHook(ctx context.Context, c *Container, specWrapper *SpecWrapper) erro
 		return errors.Wrap(err, "failed to set nvidia prestart hook")
 	}
 
+	// the OCI hooks of daemon are executed before the ones of container.
+	appendOCIHooks(s.Hooks, specWrapper.hooks)
+	appendOCIHooks(s.Hooks, c.HostConfig.Hooks)
+
 	return nil
 }
 
+// appendOCIHooks appends the OCI hooks to the spec hooks of their stages.
+func appendOCIHooks(hooks *specs.Hooks, ociHooks []*types.OCIHook) {
+	for _, h := range ociHooks {
+		hook := specs.Hook{
+			Path: h.Path,
+			Args: h.Args,
+			Env:  h.Env,
+		}
+		// the executable is the first argument like argv.
+		if len(hook.Args) == 0 {
+			hook.Args = []string{h.Path}
+		}
+		if h.Timeout > 0 {
+			timeout := int(h.Timeout)
+			hook.Timeout = &timeout
+		}
+
+		switch h.Stage {
+		case types.OCIHookStagePrestart:
+			hooks.Prestart = append(hooks.Prestart, hook)
+		case types.OCIHookStagePoststart:
+			hooks.Poststart = append(hooks.Poststart, hook)
+		case types.OCIHookStagePoststop:
+			hooks.Poststop = append(hooks.Poststop, hook)
+		}
+	}
+}
+
+// loadOCIHooks loads the OCI hooks of daemon from config and the json files
+// in hooks dir, the hooks in dir are sorted by their file names.
+func loadOCIHooks(cfg *config.Config) ([]*types.OCIHook, error) {
+	hooks := append([]*types.OCIHook{}, cfg.Hooks...)
+	if cfg.HooksDir == "" {
+		return hooks, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(cfg.HooksDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read hook %s", file)
+		}
+
+		hook := &types.OCIHook{}
+		if err := json.Unmarshal(data, hook); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse hook %s", file)
+		}
+		if err := config.ValidateHook(hook); err != nil {
+			return nil, errors.Wrapf(err, "invalid hook %s", file)
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
 type hookArray []*wrapperEmbedPrestart
 
 // Len is defined in order to support sort
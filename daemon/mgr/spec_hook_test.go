@@ -0,0 +1,64 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendOCIHooks(t *testing.T) {
+	hooks := &specs.Hooks{}
+	appendOCIHooks(hooks, []*types.OCIHook{
+		{Stage: "prestart", Path: "/bin/a", Env: []string{"A=a"}, Timeout: 5},
+		{Stage: "poststart", Path: "/bin/b", Args: []string{"b", "-v"}},
+		{Stage: "poststop", Path: "/bin/c"},
+		{Stage: "prestart", Path: "/bin/d"},
+	})
+
+	timeout := 5
+	assert.Equal(t, []specs.Hook{
+		{Path: "/bin/a", Args: []string{"/bin/a"}, Env: []string{"A=a"}, Timeout: &timeout},
+		{Path: "/bin/d", Args: []string{"/bin/d"}},
+	}, hooks.Prestart)
+	assert.Equal(t, []specs.Hook{{Path: "/bin/b", Args: []string{"b", "-v"}}}, hooks.Poststart)
+	assert.Equal(t, []specs.Hook{{Path: "/bin/c", Args: []string{"/bin/c"}}}, hooks.Poststop)
+}
+
+func TestLoadOCIHooks(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-load-oci-hooks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "20-b.json"), []byte(`{"Stage": "poststop", "Path": "/bin/b"}`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "10-a.json"), []byte(`{"Stage": "prestart", "Path": "/bin/a"}`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "README"), []byte(`not a hook`), 0644))
+
+	cfg := &config.Config{
+		Hooks:    []*types.OCIHook{{Stage: "prestart", Path: "/bin/daemon"}},
+		HooksDir: tmpDir,
+	}
+	hooks, err := loadOCIHooks(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.OCIHook{
+		{Stage: "prestart", Path: "/bin/daemon"},
+		{Stage: "prestart", Path: "/bin/a"},
+		{Stage: "poststop", Path: "/bin/b"},
+	}, hooks)
+
+	for _, content := range []string{
+		`{`,
+		`{"Stage": "createRuntime", "Path": "/bin/c"}`,
+		`{"Stage": "prestart", "Path": "bin/c"}`,
+	} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "30-c.json"), []byte(content), 0644))
+		_, err := loadOCIHooks(cfg)
+		assert.Error(t, err, content)
+	}
+}
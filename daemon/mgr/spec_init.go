@@ -0,0 +1,59 @@
+package mgr
+
+import (
+	"context"
+	"os/exec"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultInitBinary is the init binary looked up in PATH if the init
+	// path of daemon isn't set.
+	defaultInitBinary = "docker-init"
+
+	// containerInitPath is where the init binary is mounted in container.
+	containerInitPath = "/sbin/docker-init"
+)
+
+// initPath returns the path of init binary injected into the container,
+// it is empty if the container doesn't run the init. The rich containers
+// run their own init, so the init of daemon doesn't apply to them.
+func (mgr *ContainerManager) initPath(c *Container) (string, error) {
+	if c.HostConfig.Init != nil {
+		if !*c.HostConfig.Init {
+			return "", nil
+		}
+	} else if !mgr.Config.Init || c.Config.Rich {
+		return "", nil
+	}
+
+	if mgr.Config.InitPath != "" {
+		return mgr.Config.InitPath, nil
+	}
+
+	path, err := exec.LookPath(defaultInitBinary)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find init binary %s", defaultInitBinary)
+	}
+	return path, nil
+}
+
+// setupInit mounts the init binary into container and runs it as the first
+// process, which forwards signals to the entrypoint and reaps the zombies.
+func setupInit(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	if specWrapper.initPath == "" {
+		return nil
+	}
+
+	s := specWrapper.s
+	s.Process.Args = append([]string{containerInitPath, "--"}, s.Process.Args...)
+	s.Mounts = append(s.Mounts, specs.Mount{
+		Destination: containerInitPath,
+		Type:        "bind",
+		Source:      specWrapper.initPath,
+		Options:     []string{"bind", "ro"},
+	})
+	return nil
+}
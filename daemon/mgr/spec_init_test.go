@@ -0,0 +1,57 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitPath(t *testing.T) {
+	enabled, disabled := true, false
+	newContainer := func(init *bool, rich bool) *Container {
+		return &Container{
+			Config:     &types.ContainerConfig{Rich: rich},
+			HostConfig: &types.HostConfig{Init: init},
+		}
+	}
+
+	mgr := &ContainerManager{Config: &config.Config{InitPath: "/usr/bin/tini"}}
+	for _, tc := range []struct {
+		daemonInit bool
+		c          *Container
+		expected   string
+	}{
+		{false, newContainer(nil, false), ""},
+		{false, newContainer(&enabled, false), "/usr/bin/tini"},
+		{true, newContainer(nil, false), "/usr/bin/tini"},
+		{true, newContainer(&disabled, false), ""},
+		{true, newContainer(nil, true), ""},
+	} {
+		mgr.Config.Init = tc.daemonInit
+		path, err := mgr.initPath(tc.c)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, path)
+	}
+}
+
+func TestSetupInit(t *testing.T) {
+	sw := &SpecWrapper{s: &specs.Spec{Process: &specs.Process{Args: []string{"sh", "-c", "sleep 1"}}}}
+	assert.NoError(t, setupInit(context.Background(), &Container{}, sw))
+	assert.Equal(t, []string{"sh", "-c", "sleep 1"}, sw.s.Process.Args)
+	assert.Empty(t, sw.s.Mounts)
+
+	sw.initPath = "/usr/bin/tini"
+	assert.NoError(t, setupInit(context.Background(), &Container{}, sw))
+	assert.Equal(t, []string{containerInitPath, "--", "sh", "-c", "sleep 1"}, sw.s.Process.Args)
+	assert.Equal(t, []specs.Mount{{
+		Destination: containerInitPath,
+		Type:        "bind",
+		Source:      "/usr/bin/tini",
+		Options:     []string{"bind", "ro"},
+	}}, sw.s.Mounts)
+}
@@ -0,0 +1,120 @@
+package mgr
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// kataAnnotationPrefix is the prefix of the annotations recognized by
+	// the kata shim, which overrides the options in its configuration file.
+	// The options must be enabled by enable_annotations in the configuration
+	// file of kata.
+	kataAnnotationPrefix = "io.katacontainers."
+
+	// kataDefaultVCPUs is the number of vCPUs of the guest VM.
+	kataDefaultVCPUs = "io.katacontainers.config.hypervisor.default_vcpus"
+	// kataDefaultMaxVCPUs is the max number of vCPUs of the guest VM.
+	kataDefaultMaxVCPUs = "io.katacontainers.config.hypervisor.default_max_vcpus"
+	// kataDefaultMemory is the memory of the guest VM in MiB.
+	kataDefaultMemory = "io.katacontainers.config.hypervisor.default_memory"
+	// kataMemorySlots is the number of memory slots of the guest VM.
+	kataMemorySlots = "io.katacontainers.config.hypervisor.memory_slots"
+	// kataHotplugVFIOOnRootBus indicates whether to hotplug VFIO devices on
+	// the root bus of the guest VM.
+	kataHotplugVFIOOnRootBus = "io.katacontainers.config.hypervisor.hotplug_vfio_on_root_bus"
+	// kataPCIeRootPort is the number of PCIe root ports of the guest VM.
+	kataPCIeRootPort = "io.katacontainers.config.hypervisor.pcie_root_port"
+)
+
+// isKataRuntime returns true if the container runs in kata containers.
+func isKataRuntime(c *Container) bool {
+	return c.HostConfig != nil && c.HostConfig.RuntimeType == ctrd.RuntimeTypeV2kataV2
+}
+
+// kataAnnotations returns the kata annotations of container, which are taken
+// from labels and spec annotations, the spec annotations take precedence.
+func kataAnnotations(c *Container) map[string]string {
+	annotations := make(map[string]string)
+	if !isKataRuntime(c) || c.Config == nil {
+		return annotations
+	}
+
+	for _, m := range []map[string]string{c.Config.Labels, c.Config.SpecAnnotation} {
+		for k, v := range m {
+			if strings.HasPrefix(k, kataAnnotationPrefix) {
+				annotations[k] = v
+			}
+		}
+	}
+	return annotations
+}
+
+// validateKataAnnotations checks the values of the kata annotations which
+// size the guest VM, so that the invalid values are reported when creating
+// the container rather than when starting the VM.
+func validateKataAnnotations(c *Container) error {
+	for k, v := range kataAnnotations(c) {
+		switch k {
+		case kataDefaultVCPUs, kataDefaultMaxVCPUs, kataDefaultMemory, kataMemorySlots, kataPCIeRootPort:
+			if _, err := strconv.ParseUint(v, 10, 32); err != nil {
+				return errors.Wrapf(errtypes.ErrInvalidParam, "invalid kata annotation %s=%s: should be a non-negative integer", k, v)
+			}
+		case kataHotplugVFIOOnRootBus:
+			if _, err := strconv.ParseBool(v); err != nil {
+				return errors.Wrapf(errtypes.ErrInvalidParam, "invalid kata annotation %s=%s: should be a boolean", k, v)
+			}
+		}
+	}
+	return nil
+}
+
+// setupKataAnnotations passes the kata annotations through to the spec, so
+// that the guest VM can be sized per container.
+func setupKataAnnotations(ctx context.Context, c *Container, s *specs.Spec) error {
+	annotations := kataAnnotations(c)
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	if s.Annotations == nil {
+		s.Annotations = make(map[string]string)
+	}
+	for k, v := range annotations {
+		s.Annotations[k] = v
+	}
+	return nil
+}
+
+// validateKataUpdate checks the guest VM of running container could be
+// resized to the updated resources by hotplugging cpus and memory.
+func validateKataUpdate(c *Container, resources *types.Resources) error {
+	annotations := kataAnnotations(c)
+
+	// the vcpus are hotplugged to fit the cpu quota.
+	r := c.HostConfig.Resources
+	if resources.CPUQuota != 0 {
+		r.CPUQuota = resources.CPUQuota
+	}
+	if resources.CPUPeriod != 0 {
+		r.CPUPeriod = resources.CPUPeriod
+	}
+	if max, err := strconv.Atoi(annotations[kataDefaultMaxVCPUs]); err == nil && max > 0 {
+		if vcpus := numaCPUCount(&r); vcpus > max {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "cpu quota of container %s needs %d vcpus, exceeding the max vcpus %d of kata guest", c.ID, vcpus, max)
+		}
+	}
+
+	if resources.Memory != 0 && annotations[kataMemorySlots] == "0" {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "memory of kata guest of container %s can't be hotplugged, since %s is 0", c.ID, kataMemorySlots)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupKataAnnotations(t *testing.T) {
+	c := &Container{
+		Config: &types.ContainerConfig{
+			Labels: map[string]string{
+				kataDefaultVCPUs:  "2",
+				kataDefaultMemory: "1024",
+				"app":             "web",
+			},
+			SpecAnnotation: map[string]string{
+				kataDefaultMemory: "4096",
+			},
+		},
+		HostConfig: &types.HostConfig{RuntimeType: ctrd.RuntimeTypeV2kataV2},
+	}
+
+	s := &specs.Spec{}
+	assert.NoError(t, setupKataAnnotations(context.TODO(), c, s))
+	assert.Equal(t, map[string]string{
+		kataDefaultVCPUs:  "2",
+		kataDefaultMemory: "4096",
+	}, s.Annotations)
+
+	// not passed through for other runtimes
+	c.HostConfig.RuntimeType = ctrd.RuntimeTypeV1
+	s = &specs.Spec{}
+	assert.NoError(t, setupKataAnnotations(context.TODO(), c, s))
+	assert.Nil(t, s.Annotations)
+}
+
+func TestValidateKataAnnotations(t *testing.T) {
+	for _, tc := range []struct {
+		labels map[string]string
+		valid  bool
+	}{
+		{labels: map[string]string{kataDefaultVCPUs: "4", kataHotplugVFIOOnRootBus: "true"}, valid: true},
+		{labels: map[string]string{"io.katacontainers.config.hypervisor.kernel_params": "quiet"}, valid: true},
+		{labels: map[string]string{kataDefaultMemory: "2G"}, valid: false},
+		{labels: map[string]string{kataDefaultMaxVCPUs: "-1"}, valid: false},
+		{labels: map[string]string{kataHotplugVFIOOnRootBus: "yes"}, valid: false},
+	} {
+		c := &Container{
+			Config:     &types.ContainerConfig{Labels: tc.labels},
+			HostConfig: &types.HostConfig{RuntimeType: ctrd.RuntimeTypeV2kataV2},
+		}
+		err := validateKataAnnotations(c)
+		if tc.valid {
+			assert.NoError(t, err)
+		} else {
+			assert.True(t, errtypes.IsInvalidParam(errors.Cause(err)), "%v", tc.labels)
+		}
+	}
+}
+
+func TestValidateRuntimeUpdate(t *testing.T) {
+	newContainer := func(runtimeType string, labels map[string]string) *Container {
+		return &Container{
+			ID:         "foo",
+			Config:     &types.ContainerConfig{Labels: labels},
+			HostConfig: &types.HostConfig{Runtime: "foo", RuntimeType: runtimeType},
+		}
+	}
+
+	// all the resources could be updated by runc.
+	c := newContainer(ctrd.RuntimeTypeV2runcV1, nil)
+	assert.NoError(t, validateRuntimeUpdate(c, &types.Resources{BlkioWeight: 100, PidsLimit: 100}))
+
+	// only cpu and memory could be hotplugged by kata.
+	c = newContainer(ctrd.RuntimeTypeV2kataV2, nil)
+	assert.NoError(t, validateRuntimeUpdate(c, &types.Resources{CPUQuota: 400000, Memory: 1 << 30}))
+	err := validateRuntimeUpdate(c, &types.Resources{Memory: 1 << 30, PidsLimit: 100})
+	assert.True(t, errtypes.IsInvalidParam(errors.Cause(err)), "%v", err)
+
+	c = newContainer(ctrd.RuntimeTypeV2kataV2, map[string]string{kataDefaultMaxVCPUs: "4", kataMemorySlots: "0"})
+	assert.NoError(t, validateRuntimeUpdate(c, &types.Resources{CPUQuota: 400000}))
+	err = validateRuntimeUpdate(c, &types.Resources{CPUQuota: 500000})
+	assert.True(t, errtypes.IsInvalidParam(errors.Cause(err)), "%v", err)
+	err = validateRuntimeUpdate(c, &types.Resources{CPUPeriod: 50000, CPUQuota: 400000})
+	assert.True(t, errtypes.IsInvalidParam(errors.Cause(err)), "%v", err)
+	err = validateRuntimeUpdate(c, &types.Resources{Memory: 1 << 30})
+	assert.True(t, errtypes.IsInvalidParam(errors.Cause(err)), "%v", err)
+}
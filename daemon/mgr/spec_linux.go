@@ -11,7 +11,9 @@ import (
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/cdi"
 
+	"github.com/docker/docker/pkg/idtools"
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/devices"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -34,6 +36,19 @@ const (
 	defaultCgroupParent = "pouch"
 )
 
+const (
+	// namespaceModePrivate is the mode of own private namespace.
+	namespaceModePrivate = "private"
+
+	// ipcModeShareable is the mode of own private ipc namespace which can
+	// be joined by other containers, it's the default ipc mode.
+	ipcModeShareable = "shareable"
+
+	// ipcModeNone is the mode of own private ipc namespace without
+	// /dev/shm mounted.
+	ipcModeNone = "none"
+)
+
 // Setup linux-platform-sepecific specification.
 func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
 	s := specWrapper.s
@@ -71,10 +86,11 @@ func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrappe
 		s.Linux.MountLabel = c.MountLabel
 
 		// if MaskedPaths or ReadonlyPaths are set, we will use them, otherwise using the default values.
-		if len(c.HostConfig.MaskedPaths) > 0 {
+		// an empty list overrides the default values, so that nothing is masked or read only.
+		if c.HostConfig.MaskedPaths != nil {
 			s.Linux.MaskedPaths = c.HostConfig.MaskedPaths
 		}
-		if len(c.HostConfig.ReadonlyPaths) > 0 {
+		if c.HostConfig.ReadonlyPaths != nil {
 			s.Linux.ReadonlyPaths = c.HostConfig.ReadonlyPaths
 		}
 	} else {
@@ -121,6 +137,9 @@ func setupResource(ctx context.Context, c *Container, s *specs.Spec) error {
 		Limit: c.HostConfig.PidsLimit,
 	}
 
+	// start to setup hugetlb cgroup
+	s.Linux.Resources.HugepageLimits = ctrd.GetHugepageLimits(c.HostConfig.HugepageLimits)
+
 	return nil
 }
 
@@ -240,6 +259,10 @@ func setupDevices(ctx context.Context, c *Container, s *specs.Spec) error {
 		}
 	} else {
 		for _, deviceMapping := range c.HostConfig.Devices {
+			// CDI devices are injected by the CDI specs.
+			if cdi.IsQualifiedName(deviceMapping.PathOnHost) {
+				continue
+			}
 			if !opts.ValidateDeviceMode(deviceMapping.CgroupPermissions) {
 				return fmt.Errorf("%s invalid device mode: %s", deviceMapping.PathOnHost, deviceMapping.CgroupPermissions)
 			}
@@ -390,9 +413,15 @@ func getIpcContainer(ctx context.Context, mgr ContainerMgr, id string) (*Contain
 		return nil, fmt.Errorf("can't join IPC namespace of container %q: %v", id, err)
 	}
 
-	// TODO: check whether the container is running and not restarting.
+	if !c.IsRunningOrPaused() {
+		return nil, fmt.Errorf("can't join IPC namespace of container %q which is not running", id)
+	}
 
-	// TODO: check whether the container's ipc namespace is shareable.
+	// the ipc namespace of container is shareable unless it's private.
+	switch c.HostConfig.IpcMode {
+	case namespaceModePrivate, ipcModeNone:
+		return nil, fmt.Errorf("can't join IPC namespace of container %q which is not shareable", id)
+	}
 
 	return c, nil
 }
@@ -404,16 +433,56 @@ func getPidContainer(ctx context.Context, mgr ContainerMgr, id string) (*Contain
 		return nil, fmt.Errorf("can't join PID namespace of %q: %v", id, err)
 	}
 
-	// TODO: check whether the container is running and not restarting.
+	if !c.IsRunningOrPaused() {
+		return nil, fmt.Errorf("can't join PID namespace of container %q which is not running", id)
+	}
+
+	return c, nil
+}
+
+func getUtsContainer(ctx context.Context, mgr ContainerMgr, id string) (*Container, error) {
+	// Check the container exists.
+	c, err := mgr.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("can't join UTS namespace of %q: %v", id, err)
+	}
+
+	if !c.IsRunningOrPaused() {
+		return nil, fmt.Errorf("can't join UTS namespace of container %q which is not running", id)
+	}
 
 	return c, nil
 }
 
 // TODO
+// setupUserNamespace creates the user namespace with the id mappings if the
+// container is remapped.
 func setupUserNamespace(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	m := specWrapper.idMapping
+	if m == nil || m.Empty() || c.HostConfig.UsernsMode == usernsModeHost {
+		return nil
+	}
+
+	s := specWrapper.s
+	setNamespace(s, specs.LinuxNamespace{Type: specs.UserNamespace})
+	s.Linux.UIDMappings = toLinuxIDMappings(m.UIDs())
+	s.Linux.GIDMappings = toLinuxIDMappings(m.GIDs())
 	return nil
 }
 
+// toLinuxIDMappings converts the id mappings into the runtime spec.
+func toLinuxIDMappings(idMaps []idtools.IDMap) []specs.LinuxIDMapping {
+	mappings := make([]specs.LinuxIDMapping, 0, len(idMaps))
+	for _, m := range idMaps {
+		mappings = append(mappings, specs.LinuxIDMapping{
+			ContainerID: uint32(m.ContainerID),
+			HostID:      uint32(m.HostID),
+			Size:        uint32(m.Size),
+		})
+	}
+	return mappings
+}
+
 func setupNetworkNamespace(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
 	if c.Config.NetworkDisabled {
 		return nil
@@ -439,6 +508,9 @@ func setupNetworkNamespace(ctx context.Context, c *Container, specWrapper *SpecW
 		ns.Path = strings.SplitN(networkMode, ":", 2)[1]
 	} else if IsHost(networkMode) {
 		ns.Path = c.NetworkSettings.SandboxKey
+	} else if IsCNI(networkMode) {
+		// the network namespace is created and set up by the CNI plugins.
+		ns.Path = c.NetworkSettings.SandboxKey
 	}
 
 	setNamespace(s, ns)
@@ -506,6 +578,17 @@ func setupUtsNamespace(ctx context.Context, c *Container, specWrapper *SpecWrapp
 	s := specWrapper.s
 	utsMode := c.HostConfig.UTSMode
 	switch {
+	case isContainer(utsMode):
+		ns := specs.LinuxNamespace{Type: specs.UTSNamespace}
+		owner, err := getUtsContainer(ctx, specWrapper.ctrMgr, connectedContainer(utsMode))
+		if err != nil {
+			return fmt.Errorf("setup container uts namespace mode failed: %v", err)
+		}
+		ns.Path = fmt.Sprintf("/proc/%d/ns/uts", owner.State.Pid)
+		setNamespace(s, ns)
+		// the hostname is set by the joined container, whose hostname and
+		// domainname are copied by prepareUtsNamespace.
+		s.Hostname = ""
 	case isHost(utsMode):
 		removeNamespace(s, specs.UTSNamespace)
 		// remove hostname
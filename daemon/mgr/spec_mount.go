@@ -13,6 +13,7 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/pkg/errors"
 
+	units "github.com/docker/go-units"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -31,6 +32,19 @@ const (
 	SlavePropagationMode = "slave"
 )
 
+var (
+	// defaultTmpfsOptions are the options of tmpfs mounts, which can be
+	// overridden by the options given by user.
+	defaultTmpfsOptions = []string{"noexec", "nosuid", "nodev"}
+
+	// readonlyRootfsTmpfs are the options of writable directories mounted as
+	// tmpfs for the container with read-only rootfs.
+	readonlyRootfsTmpfs = map[string][]string{
+		"/run": {"noexec", "nosuid", "nodev", "mode=755"},
+		"/tmp": {"nosuid", "nodev", "mode=1777"},
+	}
+)
+
 func clearReadonly(m *specs.Mount) {
 	var opts []string
 	for _, o := range m.Options {
@@ -41,7 +55,7 @@ func clearReadonly(m *specs.Mount) {
 	m.Options = opts
 }
 
-func overrideDefaultMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]specs.Mount, error) {
+func overrideDefaultMount(mounts []specs.Mount, c *Container, s *specs.Spec, tmpfsMounts []specs.Mount) ([]specs.Mount, error) {
 	for _, sm := range s.Mounts {
 		dup := false
 		for _, cm := range c.Mounts {
@@ -50,6 +64,12 @@ func overrideDefaultMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]
 				break
 			}
 		}
+		for _, tm := range tmpfsMounts {
+			if sm.Destination == tm.Destination {
+				dup = true
+				break
+			}
+		}
 		if dup {
 			continue
 		}
@@ -60,10 +80,45 @@ func overrideDefaultMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]
 	return mounts, nil
 }
 
+// bindMountOptions returns the options of the bind mount of the mount point,
+// the rootfs propagation of spec is changed to make the propagation of the
+// mount point work.
+func bindMountOptions(mp *types.MountPoint, s *specs.Spec) []string {
+	pg := mp.Propagation
+	rootfspg := s.Linux.RootfsPropagation
+	// Set rootfs propagation, default setting is private.
+	switch pg {
+	case SharedPropagationMode, RSharedPropagationMode:
+		if rootfspg != SharedPropagationMode && rootfspg != RSharedPropagationMode {
+			s.Linux.RootfsPropagation = SharedPropagationMode
+		}
+	case SlavePropagationMode, RSlavePropagationMode:
+		if rootfspg != SharedPropagationMode && rootfspg != RSharedPropagationMode &&
+			rootfspg != SlavePropagationMode && rootfspg != RSlavePropagationMode {
+			s.Linux.RootfsPropagation = RSlavePropagationMode
+		}
+	}
+
+	opts := []string{"rbind"}
+	if !mp.RW {
+		opts = append(opts, "ro")
+	}
+
+	// set rprivate propagation to bind mount if pg is ""
+	if pg == "" {
+		pg = RPrivatePropagationMode
+	}
+	return append(opts, pg)
+}
+
 func mergeContainerMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]specs.Mount, error) {
+	// the network files mounted by user, which are mounted with the mode of them.
+	networkMounts := make(map[string]*types.MountPoint)
+
 	for _, mp := range c.Mounts {
 		if trySetupNetworkMount(mp, c) {
 			// ignore the network mount, we will handle it later.
+			networkMounts[mp.Destination] = mp
 			continue
 		}
 
@@ -74,45 +129,21 @@ func mergeContainerMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]s
 			}
 		}
 
-		pg := mp.Propagation
-		rootfspg := s.Linux.RootfsPropagation
-		// Set rootfs propagation, default setting is private.
-		switch pg {
-		case SharedPropagationMode, RSharedPropagationMode:
-			if rootfspg != SharedPropagationMode && rootfspg != RSharedPropagationMode {
-				s.Linux.RootfsPropagation = SharedPropagationMode
-			}
-		case SlavePropagationMode, RSlavePropagationMode:
-			if rootfspg != SharedPropagationMode && rootfspg != RSharedPropagationMode &&
-				rootfspg != SlavePropagationMode && rootfspg != RSlavePropagationMode {
-				s.Linux.RootfsPropagation = RSlavePropagationMode
-			}
+		if err := relabelMount(c, mp); err != nil {
+			return nil, err
 		}
 
-		opts := []string{"rbind"}
-		if !mp.RW {
-			opts = append(opts, "ro")
-		}
-
-		// set rprivate propagation to bind mount if pg is ""
-		if pg == "" {
-			pg = RPrivatePropagationMode
-		}
-		opts = append(opts, pg)
-
-		// TODO: support copy data.
-
 		mounts = append(mounts, specs.Mount{
 			Source:      mp.Source,
 			Destination: mp.Destination,
 			Type:        "bind",
-			Options:     opts,
+			Options:     bindMountOptions(mp, s),
 		})
 	}
 
 	// if disable hostfiles, we will not mount the hosts files into container.
 	if !c.Config.DisableNetworkFiles {
-		mounts = append(mounts, generateNetworkMounts(c)...)
+		mounts = append(mounts, generateNetworkMounts(c, s, networkMounts)...)
 	}
 
 	return mounts, nil
@@ -125,8 +156,17 @@ func setupMounts(ctx context.Context, c *Container, s *specs.Spec) error {
 		err    error
 	)
 
+	tmpfsMounts, err := generateTmpfsMounts(c)
+	if err != nil {
+		return err
+	}
+
+	// mount the shm prepared at start as /dev/shm, or nothing for ipc
+	// mode none.
+	s.Mounts = setupShmMount(c, s.Mounts)
+
 	// Override the default mounts which are duplicate with user defined ones.
-	mounts, err = overrideDefaultMount(mounts, c, s)
+	mounts, err = overrideDefaultMount(mounts, c, s, tmpfsMounts)
 	if err != nil {
 		return errors.Wrap(err, "failed to override default spec mounts")
 	}
@@ -136,6 +176,7 @@ func setupMounts(ctx context.Context, c *Container, s *specs.Spec) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to merge container mounts")
 	}
+	mounts = append(mounts, tmpfsMounts...)
 
 	// modify share memory size, and change rw mode for privileged mode.
 	for i := range mounts {
@@ -166,8 +207,118 @@ func setupMounts(ctx context.Context, c *Container, s *specs.Spec) error {
 	return nil
 }
 
-// generateNetworkMounts will generate network mounts.
-func generateNetworkMounts(c *Container) []specs.Mount {
+// setupShmMount replaces the default /dev/shm mount with the shm of
+// container.
+func setupShmMount(c *Container, mounts []specs.Mount) []specs.Mount {
+	var result []specs.Mount
+	for _, m := range mounts {
+		if m.Destination == "/dev/shm" {
+			if c.HostConfig.IpcMode == ipcModeNone {
+				continue
+			}
+			if c.ShmPath != "" {
+				m = specs.Mount{
+					Source:      c.ShmPath,
+					Destination: "/dev/shm",
+					Type:        "bind",
+					Options:     []string{"rbind", "nosuid", "noexec", "nodev"},
+				}
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// generateTmpfsMounts generates the tmpfs mounts of container sorted by
+// destination. The writable directories are mounted as tmpfs for the container
+// with read-only rootfs, unless they are mounted by the container.
+func generateTmpfsMounts(c *Container) ([]specs.Mount, error) {
+	tmpfs := make(map[string][]string)
+	for dest, options := range c.HostConfig.Tmpfs {
+		parsed, err := parseTmpfsOptions(options)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid tmpfs %s", dest)
+		}
+		tmpfs[filepath.Clean(dest)] = append(append([]string{}, defaultTmpfsOptions...), parsed...)
+	}
+
+	if c.HostConfig.ReadonlyRootfs {
+		for dest, opts := range readonlyRootfsTmpfs {
+			if _, exist := tmpfs[dest]; exist || hasContainerMount(c, dest) {
+				continue
+			}
+			tmpfs[dest] = opts
+		}
+	}
+
+	dests := make([]string, 0, len(tmpfs))
+	for dest := range tmpfs {
+		dests = append(dests, dest)
+	}
+	sort.Strings(dests)
+
+	mounts := make([]specs.Mount, 0, len(dests))
+	for _, dest := range dests {
+		mounts = append(mounts, specs.Mount{
+			Source:      "tmpfs",
+			Destination: dest,
+			Type:        "tmpfs",
+			Options:     tmpfs[dest],
+		})
+	}
+	return mounts, nil
+}
+
+// parseTmpfsOptions parses the options of tmpfs mount given by user, the
+// size in human readable format such as 64m is converted to bytes, and the
+// mode should be octal.
+func parseTmpfsOptions(options string) ([]string, error) {
+	if options == "" {
+		return nil, nil
+	}
+
+	var opts []string
+	for _, opt := range strings.Split(options, ",") {
+		kv := strings.SplitN(opt, "=", 2)
+		switch {
+		case kv[0] == "size" && len(kv) == 2 && strings.HasSuffix(kv[1], "%"):
+			// the percentage of physical memory
+			if _, err := strconv.ParseUint(strings.TrimSuffix(kv[1], "%"), 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid tmpfs option %s", opt)
+			}
+		case kv[0] == "size" && len(kv) == 2:
+			size, err := units.RAMInBytes(kv[1])
+			if err != nil || size < 0 {
+				return nil, fmt.Errorf("invalid tmpfs option %s", opt)
+			}
+			opt = "size=" + strconv.FormatInt(size, 10)
+		case kv[0] == "mode" && len(kv) == 2:
+			if _, err := strconv.ParseUint(kv[1], 8, 32); err != nil {
+				return nil, fmt.Errorf("invalid tmpfs option %s, mode should be octal", opt)
+			}
+		case kv[0] == "size" || kv[0] == "mode":
+			return nil, fmt.Errorf("invalid tmpfs option %s", opt)
+		}
+		opts = append(opts, opt)
+	}
+	return opts, nil
+}
+
+// hasContainerMount returns whether the destination is mounted by the mount
+// points of container.
+func hasContainerMount(c *Container, dest string) bool {
+	for _, mp := range c.Mounts {
+		if filepath.Clean(mp.Destination) == dest {
+			return true
+		}
+	}
+	return false
+}
+
+// generateNetworkMounts will generate network mounts, the network files
+// mounted by user in userMounts are mounted with their mode.
+func generateNetworkMounts(c *Container, s *specs.Spec, userMounts map[string]*types.MountPoint) []specs.Mount {
 	mounts := make([]specs.Mount, 0)
 
 	fileBinds := []struct {
@@ -186,11 +337,15 @@ func generateNetworkMounts(c *Container) []specs.Mount {
 			if err != nil {
 				log.With(nil).Warnf("%s set to %s, but stat error: %v, skip it", bind.Name, bind.Source, err)
 			} else {
+				opts := []string{"rbind", "rprivate"}
+				if mp, ok := userMounts[bind.Dest]; ok {
+					opts = bindMountOptions(mp, s)
+				}
 				mounts = append(mounts, specs.Mount{
 					Source:      bind.Source,
 					Destination: bind.Dest,
 					Type:        "bind",
-					Options:     []string{"rbind", "rprivate"},
+					Options:     opts,
 				})
 			}
 		}
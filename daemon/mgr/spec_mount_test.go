@@ -1,10 +1,14 @@
 package mgr
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/alibaba/pouch/apis/types"
+
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_sortMounts(t *testing.T) {
@@ -39,3 +43,124 @@ func Test_sortMounts(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateTmpfsMounts(t *testing.T) {
+	c := &Container{
+		HostConfig: &types.HostConfig{Tmpfs: map[string]string{"/run/": "exec,size=64m"}},
+	}
+	mounts, err := generateTmpfsMounts(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []specs.Mount{
+		{Source: "tmpfs", Destination: "/run", Type: "tmpfs", Options: []string{"noexec", "nosuid", "nodev", "exec", "size=67108864"}},
+	}, mounts)
+
+	// the writable directories are mounted unless they are mounted by user.
+	c.HostConfig.ReadonlyRootfs = true
+	c.Mounts = []*types.MountPoint{{Destination: "/tmp"}}
+	mounts, err = generateTmpfsMounts(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []specs.Mount{
+		{Source: "tmpfs", Destination: "/run", Type: "tmpfs", Options: []string{"noexec", "nosuid", "nodev", "exec", "size=67108864"}},
+	}, mounts)
+
+	c.HostConfig.Tmpfs = nil
+	c.Mounts = nil
+	mounts, err = generateTmpfsMounts(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []specs.Mount{
+		{Source: "tmpfs", Destination: "/run", Type: "tmpfs", Options: readonlyRootfsTmpfs["/run"]},
+		{Source: "tmpfs", Destination: "/tmp", Type: "tmpfs", Options: readonlyRootfsTmpfs["/tmp"]},
+	}, mounts)
+}
+
+func TestParseTmpfsOptions(t *testing.T) {
+	opts, err := parseTmpfsOptions("rw,size=1g,mode=1777,uid=1000")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rw", "size=1073741824", "mode=1777", "uid=1000"}, opts)
+
+	opts, err = parseTmpfsOptions("size=50%")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"size=50%"}, opts)
+
+	opts, err = parseTmpfsOptions("")
+	assert.NoError(t, err)
+	assert.Nil(t, opts)
+
+	for _, options := range []string{"size=foo", "size=-1", "size=a%", "size", "mode=999", "mode=rw", "mode"} {
+		_, err := parseTmpfsOptions(options)
+		assert.Error(t, err, options)
+	}
+}
+
+func TestSetupMountsShm(t *testing.T) {
+	c := &Container{
+		Config:     &types.ContainerConfig{DisableNetworkFiles: true},
+		HostConfig: &types.HostConfig{},
+		ShmPath:    "/var/lib/pouch/containers/foo/shm",
+	}
+	s := &specs.Spec{
+		Root:  &specs.Root{},
+		Linux: &specs.Linux{},
+		Mounts: []specs.Mount{
+			{Source: "shm", Destination: "/dev/shm", Type: "tmpfs", Options: []string{"size=65536k"}},
+		},
+	}
+	assert.NoError(t, setupMounts(context.Background(), c, s))
+	assert.Equal(t, []specs.Mount{
+		{Source: c.ShmPath, Destination: "/dev/shm", Type: "bind", Options: []string{"rbind", "nosuid", "noexec", "nodev"}},
+	}, s.Mounts)
+}
+
+func TestSetupMountsTmpfs(t *testing.T) {
+	c := &Container{
+		Config: &types.ContainerConfig{DisableNetworkFiles: true},
+		HostConfig: &types.HostConfig{
+			ReadonlyRootfs: true,
+			Tmpfs:          map[string]string{"/dev/shm": "size=1g"},
+		},
+	}
+	s := &specs.Spec{
+		Root:  &specs.Root{Readonly: true},
+		Linux: &specs.Linux{},
+		Mounts: []specs.Mount{
+			{Source: "proc", Destination: "/proc", Type: "proc"},
+			{Source: "shm", Destination: "/dev/shm", Type: "tmpfs", Options: []string{"size=65536k"}},
+		},
+	}
+	assert.NoError(t, setupMounts(context.Background(), c, s))
+
+	dests := map[string]string{}
+	for _, m := range s.Mounts {
+		dests[m.Destination] = m.Source
+	}
+	assert.Equal(t, map[string]string{"/proc": "proc", "/dev/shm": "tmpfs", "/run": "tmpfs", "/tmp": "tmpfs"}, dests)
+}
+
+func TestBindMountOptions(t *testing.T) {
+	s := &specs.Spec{Linux: &specs.Linux{}}
+	assert.Equal(t, []string{"rbind", "rprivate"}, bindMountOptions(&types.MountPoint{RW: true}, s))
+	assert.Equal(t, "", s.Linux.RootfsPropagation)
+
+	assert.Equal(t, []string{"rbind", "ro", "rslave"}, bindMountOptions(&types.MountPoint{Propagation: "rslave"}, s))
+	assert.Equal(t, RSlavePropagationMode, s.Linux.RootfsPropagation)
+
+	assert.Equal(t, []string{"rbind", "shared"}, bindMountOptions(&types.MountPoint{RW: true, Propagation: "shared"}, s))
+	assert.Equal(t, SharedPropagationMode, s.Linux.RootfsPropagation)
+
+	// the shared rootfs propagation is kept for the slave mount.
+	bindMountOptions(&types.MountPoint{Propagation: "slave"}, s)
+	assert.Equal(t, SharedPropagationMode, s.Linux.RootfsPropagation)
+}
+
+func TestSetupShmMount(t *testing.T) {
+	mounts := []specs.Mount{
+		{Source: "proc", Destination: "/proc", Type: "proc"},
+		{Source: "shm", Destination: "/dev/shm", Type: "tmpfs", Options: []string{"size=65536k"}},
+	}
+
+	c := &Container{HostConfig: &types.HostConfig{}}
+	assert.Equal(t, mounts, setupShmMount(c, mounts))
+
+	c.HostConfig.IpcMode = "none"
+	assert.Equal(t, mounts[:1], setupShmMount(c, mounts))
+}
@@ -0,0 +1,107 @@
+package mgr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/hookplugins"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// specPluginRequest is written to the stdin of the spec plugin executable.
+type specPluginRequest struct {
+	ID         string
+	Config     *types.ContainerConfig
+	HostConfig *types.HostConfig
+	Spec       *specs.Spec
+}
+
+// execSpecPlugin is the spec plugin of an external executable. It receives
+// the spec plugin request in json on stdin, and writes the mutated spec in
+// json to stdout, or nothing to keep the spec. Exiting with non-zero status
+// rejects the container with the message in stderr.
+type execSpecPlugin struct {
+	path    string
+	timeout time.Duration
+}
+
+// PreCreateSpec runs the executable to mutate or reject the spec.
+func (p *execSpecPlugin) PreCreateSpec(ctx context.Context, id string, config *types.ContainerConfig, hostConfig *types.HostConfig, spec *specs.Spec) error {
+	input, err := json.Marshal(&specPluginRequest{
+		ID:         id,
+		Config:     config,
+		HostConfig: hostConfig,
+		Spec:       spec,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal spec plugin request")
+	}
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return errors.Wrapf(errtypes.ErrTimeout, "spec plugin %s timed out after %s", p.path, p.timeout)
+		}
+		if _, ok := err.(*exec.ExitError); ok {
+			return errors.Wrapf(errtypes.ErrPreCheckFailed, "rejected by spec plugin %s: %s", p.path, strings.TrimSpace(stderr.String()))
+		}
+		return errors.Wrapf(err, "failed to run spec plugin %s", p.path)
+	}
+
+	if len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return nil
+	}
+
+	mutated := specs.Spec{}
+	if err := json.Unmarshal(stdout.Bytes(), &mutated); err != nil {
+		return errors.Wrapf(err, "failed to parse the spec returned by spec plugin %s", p.path)
+	}
+	*spec = mutated
+	return nil
+}
+
+// newSpecPlugins returns the spec plugins invoked in order, the registered
+// plugin is the first and then the executables in config.
+func newSpecPlugins(specPlugin hookplugins.SpecPlugin, paths []string, timeout int) []hookplugins.SpecPlugin {
+	var plugins []hookplugins.SpecPlugin
+	if specPlugin != nil {
+		plugins = append(plugins, specPlugin)
+	}
+	for _, path := range paths {
+		plugins = append(plugins, &execSpecPlugin{
+			path:    path,
+			timeout: time.Duration(timeout) * time.Second,
+		})
+	}
+	return plugins
+}
+
+// mutateSpec invokes the spec plugins just before the container is created
+// in containerd, any of them could reject the container.
+func (mgr *ContainerManager) mutateSpec(ctx context.Context, c *Container, spec *specs.Spec) error {
+	for _, plugin := range mgr.specPlugins {
+		if err := plugin.PreCreateSpec(ctx, c.ID, c.Config, c.HostConfig, spec); err != nil {
+			return errors.Wrapf(err, "failed to create spec of container %s", c.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package mgr
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecSpecPlugin(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-exec-spec-plugin")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	writePlugin := func(name, script string) string {
+		path := filepath.Join(tmpDir, name)
+		assert.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755))
+		return path
+	}
+
+	newSpec := func() *specs.Spec {
+		return &specs.Spec{Version: "1.0.0", Hostname: "foo"}
+	}
+	config := &types.ContainerConfig{Image: "busybox"}
+	hostConfig := &types.HostConfig{}
+
+	// empty output keeps the spec.
+	spec := newSpec()
+	p := &execSpecPlugin{path: writePlugin("keep", "cat >/dev/null")}
+	assert.NoError(t, p.PreCreateSpec(context.Background(), "id", config, hostConfig, spec))
+	assert.Equal(t, newSpec(), spec)
+
+	// the request is passed on stdin, and the output replaces the spec.
+	spec = newSpec()
+	p = &execSpecPlugin{path: writePlugin("mutate", `grep -q '"ID":"id"' && echo '{"ociVersion": "1.0.0", "hostname": "bar"}'`)}
+	assert.NoError(t, p.PreCreateSpec(context.Background(), "id", config, hostConfig, spec))
+	assert.Equal(t, "bar", spec.Hostname)
+
+	// non-zero exit rejects the spec.
+	p = &execSpecPlugin{path: writePlugin("reject", "echo 'privileged is not allowed' >&2; exit 1")}
+	err = p.PreCreateSpec(context.Background(), "id", config, hostConfig, newSpec())
+	assert.True(t, errtypes.IsPreCheckFailed(err))
+	assert.Contains(t, err.Error(), "privileged is not allowed")
+
+	p = &execSpecPlugin{path: writePlugin("invalid", "echo '{'")}
+	assert.Error(t, p.PreCreateSpec(context.Background(), "id", config, hostConfig, newSpec()))
+
+	p = &execSpecPlugin{path: writePlugin("sleep", "sleep 5"), timeout: 100 * time.Millisecond}
+	err = p.PreCreateSpec(context.Background(), "id", config, hostConfig, newSpec())
+	assert.True(t, errtypes.IsTimeout(err))
+}
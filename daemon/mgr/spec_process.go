@@ -47,7 +47,7 @@ func setupProcess(ctx context.Context, c *Container, s *specs.Spec) error {
 		s.Process.OOMScoreAdj = &v
 	}
 
-	if err := setupCapabilities(ctx, c.HostConfig, s); err != nil {
+	if err := setupCapabilities(ctx, c, s); err != nil {
 		return err
 	}
 
@@ -84,7 +84,7 @@ func setupUser(ctx context.Context, c *Container, s *specs.Spec) (err error) {
 	return nil
 }
 
-func setupCapabilities(ctx context.Context, hostConfig *types.HostConfig, s *specs.Spec) error {
+func setupCapabilities(ctx context.Context, c *Container, s *specs.Spec) error {
 	var caplist []string
 	var err error
 
@@ -92,9 +92,13 @@ func setupCapabilities(ctx context.Context, hostConfig *types.HostConfig, s *spe
 		s.Process.Capabilities = &specs.LinuxCapabilities{}
 	}
 	capabilities := s.Process.Capabilities
+	hostConfig := c.HostConfig
 
 	if hostConfig.Privileged {
 		caplist = caps.GetAllCapabilities()
+	} else if c.Capabilities != nil {
+		// use the capabilities resolved when the container is created.
+		caplist = c.Capabilities
 	} else if caplist, err = caps.TweakCapabilities(capabilities.Effective, hostConfig.CapAdd, hostConfig.CapDrop); err != nil {
 		return err
 	}
@@ -4,9 +4,6 @@ package mgr
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
 
 	"github.com/containerd/containerd/contrib/seccomp"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -23,25 +20,18 @@ func setupSeccomp(ctx context.Context, c *Container, s *specs.Spec) error {
 		return nil
 	}
 
-	if s.Linux.Seccomp == nil {
-		s.Linux.Seccomp = &specs.LinuxSeccomp{}
-	}
-
 	seccompProfile := c.SeccompProfile
 	switch seccompProfile {
 	case ProfileNameUnconfined:
-		return nil
+		s.Linux.Seccomp = nil
 	case ProfilePouchDefault, "":
 		s.Linux.Seccomp = seccomp.DefaultProfile(s)
 	default:
-		data, err := ioutil.ReadFile(seccompProfile)
-		if err != nil {
-			return fmt.Errorf("failed to load seccomp profile %q: %v", seccompProfile, err)
-		}
-		err = json.Unmarshal(data, s.Linux.Seccomp)
+		profile, err := loadSeccompProfile(seccompProfile)
 		if err != nil {
-			return fmt.Errorf("failed to decode seccomp profile %q: %v", seccompProfile, err)
+			return err
 		}
+		s.Linux.Seccomp = profile
 	}
 
 	return nil
@@ -0,0 +1,39 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/oci"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBaseSpec(t *testing.T) {
+	s, err := newBaseSpec("")
+	assert.NoError(t, err)
+	assert.Equal(t, oci.NewDefaultSpec(), s)
+
+	dir, err := ioutil.TempDir("", "base-spec")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	template := filepath.Join(dir, "spec.json")
+	assert.NoError(t, ioutil.WriteFile(template, []byte(`{"linux": {"sysctl": {"net.core.somaxconn": "1024"}}}`), 0644))
+
+	s, err = newBaseSpec(template)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"net.core.somaxconn": "1024"}, s.Linux.Sysctl)
+	// the fields not in template are kept.
+	assert.Equal(t, oci.NewDefaultSpec().Mounts, s.Mounts)
+	assert.Equal(t, oci.NewDefaultSpec().Linux.MaskedPaths, s.Linux.MaskedPaths)
+
+	assert.NoError(t, ioutil.WriteFile(template, []byte(`{"linux": `), 0644))
+	_, err = newBaseSpec(template)
+	assert.Error(t, err)
+
+	_, err = newBaseSpec(filepath.Join(dir, "none.json"))
+	assert.Error(t, err)
+}
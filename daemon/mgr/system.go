@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -39,7 +40,9 @@ type SystemMgr interface {
 	Version() (types.SystemVersion, error)
 	Auth(*types.AuthConfig) (string, error)
 	UpdateDaemon(*types.DaemonUpdateConfig) error
+	ReloadDaemon(ctx context.Context) error
 	SubscribeToEvents(ctx context.Context, since, until time.Time, ef filters.Args) ([]types.EventsMessage, <-chan *types.EventsMessage, <-chan error)
+	DiskUsage(ctx context.Context) (*types.DiskUsage, error)
 }
 
 // SystemManager is an instance of system management.
@@ -47,22 +50,30 @@ type SystemManager struct {
 	name     string
 	registry *registry.Client
 	config   *config.Config
-	imageMgr ImageMgr
+	client   ctrd.APIClient
+	imageMgr  ImageMgr
+	volumeMgr VolumeMgr
 
 	store *meta.Store
 
 	eventsService *events.Events
+
+	// runtimeStatus is the status of runtimes probed when the daemon starts.
+	runtimeStatus map[string]types.RuntimeStatus
 }
 
 // NewSystemManager creates a brand new system manager.
-func NewSystemManager(cfg *config.Config, store *meta.Store, imageManager ImageMgr, eventsService *events.Events) (*SystemManager, error) {
+func NewSystemManager(cfg *config.Config, store *meta.Store, client ctrd.APIClient, imageManager ImageMgr, volumeManager VolumeMgr, eventsService *events.Events) (*SystemManager, error) {
 	return &SystemManager{
 		name:          "system_manager",
 		registry:      &registry.Client{},
 		config:        cfg,
+		client:        client,
 		imageMgr:      imageManager,
+		volumeMgr:     volumeManager,
 		store:         store,
 		eventsService: eventsService,
+		runtimeStatus: probeRuntimes(cfg.Runtimes),
 	}, nil
 }
 
@@ -134,6 +145,12 @@ func (mgr *SystemManager) Info() (types.SystemInfo, error) {
 		securityOpts = append(securityOpts, "selinux")
 	}
 
+	// the config is changed by reload and update with the lock held, so it
+	// is read with the lock, and the slices are copied.
+	cfg := mgr.config
+	cfg.Lock()
+	defer cfg.Unlock()
+
 	info := types.SystemInfo{
 		Architecture: runtime.GOARCH,
 		// CgroupDriver: ,
@@ -142,20 +159,21 @@ func (mgr *SystemManager) Info() (types.SystemInfo, error) {
 		ContainersPaused:  cPaused,
 		ContainersRunning: cRunning,
 		ContainersStopped: cStopped,
-		Debug:             mgr.config.Debug,
-		DefaultRuntime:    mgr.config.DefaultRuntime,
+		Debug:             cfg.Debug,
+		DefaultRuntime:    cfg.DefaultRuntime,
 		Driver:            ctrd.CurrentSnapshotterName(context.TODO()),
 		// DriverStatus: ,
 		ExperimentalBuild: false,
-		HTTPProxy:         mgr.config.ImageProxy,
+		HTTPProxy:         cfg.ImageProxy,
 		// HTTPSProxy: ,
 		// ID: ,
 		CgroupDriver:       mgr.config.GetCgroupDriver(),
+		CgroupVersion:      system.GetCgroupVersion(),
 		Images:             int64(len(images)),
 		IndexServerAddress: "https://index.docker.io/v1/",
 		DefaultRegistry:    mgr.config.DefaultRegistry,
 		KernelVersion:      kernelVersion,
-		Labels:             mgr.config.Labels,
+		Labels:             append([]string{}, cfg.Labels...),
 		LiveRestoreEnabled: true,
 		LoggingDriver:      mgr.config.DefaultLogConfig.LogDriver,
 		VolumeDrivers:      volumeDrivers,
@@ -168,11 +186,13 @@ func (mgr *SystemManager) Info() (types.SystemInfo, error) {
 		OSType:             runtime.GOOS,
 		PouchRootDir:       mgr.config.HomeDir,
 		RegistryConfig: &types.RegistryServiceConfig{
-			InsecureRegistryCIDRs: mgr.config.InsecureRegistries,
-			Mirrors:               mgr.config.RegistryMirrors,
+			IndexConfigs:          registryIndexConfigs(cfg),
+			InsecureRegistryCIDRs: append([]string{}, cfg.InsecureRegistries...),
+			Mirrors:               append([]string{}, cfg.RegistryMirrors...),
 		},
 		// RuncCommit: ,
 		Runtimes:        mgr.config.Runtimes,
+		RuntimeStatus:   mgr.runtimeStatus,
 		SecurityOptions: securityOpts,
 		ServerVersion:   version.Version,
 		ListenAddresses: mgr.config.Listen,
@@ -248,3 +268,92 @@ func (mgr *SystemManager) UpdateDaemon(cfg *types.DaemonUpdateConfig) error {
 
 	return nil
 }
+
+// systemLabelKeys are the keys of labels added by daemon, they are kept when
+// labels are reloaded.
+var systemLabelKeys = []string{"node_ip", "SN"}
+
+// ReloadDaemon reloads daemon config from config file, only log level,
+// registry mirrors, default runtime, insecure registries and labels are
+// reloaded, others take effect after daemon restarted.
+func (mgr *SystemManager) ReloadDaemon(ctx context.Context) error {
+	fileCfg, reloaded, err := mgr.config.LoadReloadableConfigurations()
+	if err != nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	daemonCfg := mgr.config
+
+	daemonCfg.Lock()
+	defer daemonCfg.Unlock()
+
+	// validates all the reloaded config before applying any of them.
+	if reloaded["default-runtime"] {
+		if _, exist := daemonCfg.Runtimes[fileCfg.DefaultRuntime]; !exist {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "default runtime %s is not registered in daemon", fileCfg.DefaultRuntime)
+		}
+	}
+	if reloaded["label"] {
+		if err := config.ValidateLabels(fileCfg.Labels); err != nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+	}
+	if reloaded["insecure-registries"] {
+		if err := mgr.client.SetInsecureRegistries(fileCfg.InsecureRegistries); err != nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+		daemonCfg.InsecureRegistries = fileCfg.InsecureRegistries
+	}
+
+	if reloaded["debug"] {
+		daemonCfg.Debug = fileCfg.Debug
+		log.SetDebug(fileCfg.Debug)
+	}
+	if reloaded["registry-mirrors"] {
+		daemonCfg.RegistryMirrors = fileCfg.RegistryMirrors
+		mgr.imageMgr.SetRegistryMirrors(fileCfg.RegistryMirrors)
+	}
+	if reloaded["default-runtime"] {
+		daemonCfg.DefaultRuntime = fileCfg.DefaultRuntime
+	}
+	if reloaded["label"] {
+		daemonCfg.Labels = mergeSystemLabels(fileCfg.Labels, daemonCfg.Labels)
+	}
+
+	attributes := make(map[string]string, len(reloaded))
+	for _, flag := range config.ReloadableFlags {
+		attributes[flag] = strconv.FormatBool(reloaded[flag])
+	}
+	log.With(ctx).Infof("daemon config reloaded: %v", attributes)
+	mgr.eventsService.Publish(ctx, "reload", types.EventTypeDaemon, &types.EventsActor{
+		Attributes: attributes,
+	})
+
+	return nil
+}
+
+// mergeSystemLabels keeps the labels added by daemon in the new labels if
+// they are not overridden.
+func mergeSystemLabels(labels, oldLabels []string) []string {
+	merged := append([]string{}, labels...)
+	for _, key := range systemLabelKeys {
+		if _, exist := labelValue(labels, key); exist {
+			continue
+		}
+		if value, exist := labelValue(oldLabels, key); exist {
+			merged = append(merged, key+"="+value)
+		}
+	}
+	return merged
+}
+
+// labelValue returns the value of label key in labels.
+func labelValue(labels []string, key string) (string, bool) {
+	for _, label := range labels {
+		kv := strings.SplitN(label, "=", 2)
+		if len(kv) == 2 && kv[0] == key {
+			return kv[1], true
+		}
+	}
+	return "", false
+}
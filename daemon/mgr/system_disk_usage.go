@@ -0,0 +1,197 @@
+package mgr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/meta"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/containerd/containerd/errdefs"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	"github.com/pkg/errors"
+)
+
+// snapshotRef is the snapshot in the specific snapshotter.
+type snapshotRef struct {
+	snapshotter string
+	key         string
+}
+
+// snapshotUsage returns the size of the snapshot in bytes, excluding the
+// size of its parents.
+func snapshotUsage(ctx context.Context, client ctrd.APIClient, ref snapshotRef) (int64, error) {
+	usage, err := client.GetSnapshotUsage(ctrd.WithSnapshotter(ctx, ref.snapshotter), ref.key)
+	if err != nil {
+		return 0, err
+	}
+	return usage.Size, nil
+}
+
+// imageSnapshots returns the snapshots of all the layers of image.
+func imageSnapshots(img *types.ImageInfo, snapshotter string) ([]snapshotRef, error) {
+	if img.RootFS == nil {
+		return nil, nil
+	}
+
+	diffIDs := make([]digest.Digest, 0, len(img.RootFS.Layers))
+	for _, layer := range img.RootFS.Layers {
+		dgst, err := digest.Parse(layer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid layer %s of image %s", layer, img.ID)
+		}
+		diffIDs = append(diffIDs, dgst)
+	}
+
+	refs := make([]snapshotRef, 0, len(diffIDs))
+	for _, chainID := range identity.ChainIDs(diffIDs) {
+		refs = append(refs, snapshotRef{snapshotter: snapshotter, key: chainID.String()})
+	}
+	return refs, nil
+}
+
+// imageDiskUsages returns the size and shared size of images by the
+// snapshots of them, the snapshot referenced by more than one image is
+// shared.
+func imageDiskUsages(images []types.ImageInfo, snapshots map[string][]snapshotRef, usage map[snapshotRef]int64, containers map[string]int64) []*types.ImageDiskUsage {
+	refCount := map[snapshotRef]int{}
+	for _, refs := range snapshots {
+		for _, ref := range refs {
+			refCount[ref]++
+		}
+	}
+
+	result := make([]*types.ImageDiskUsage, 0, len(images))
+	for _, img := range images {
+		du := &types.ImageDiskUsage{
+			ID:         img.ID,
+			RepoTags:   img.RepoTags,
+			Containers: containers[img.ID],
+		}
+		for _, ref := range snapshots[img.ID] {
+			du.Size += usage[ref]
+			if refCount[ref] > 1 {
+				du.SharedSize += usage[ref]
+			}
+		}
+		result = append(result, du)
+	}
+	return result
+}
+
+// DiskUsage returns the disk usage of images, containers and volumes.
+func (mgr *SystemManager) DiskUsage(ctx context.Context) (*types.DiskUsage, error) {
+	images, err := mgr.imageMgr.ListImages(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := mgr.client.ImageContentUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	du := &types.DiskUsage{}
+	for _, size := range blobs {
+		du.LayersSize += size
+	}
+
+	var (
+		snapshots = make(map[string][]snapshotRef, len(images))
+		imageSize = make(map[string]int64, len(images))
+		usage     = map[snapshotRef]int64{}
+	)
+	for i := range images {
+		img := &images[i]
+		refs, err := imageSnapshots(img, mgr.imageMgr.LazySnapshotter(ctx, img.ID))
+		if err != nil {
+			log.With(ctx).Warnf("failed to get snapshots of image %s: %v", img.ID, err)
+			continue
+		}
+		snapshots[img.ID] = refs
+
+		for _, ref := range refs {
+			if _, ok := usage[ref]; !ok {
+				size, err := snapshotUsage(ctx, mgr.client, ref)
+				if err != nil && !errdefs.IsNotFound(err) {
+					log.With(ctx).Warnf("failed to get usage of snapshot %s: %v", ref.key, err)
+				}
+				usage[ref] = size
+			}
+			imageSize[img.ID] += usage[ref]
+		}
+	}
+
+	containers := map[string]int64{}
+	err = mgr.store.ForEach(func(obj meta.Object) error {
+		c, ok := obj.(*Container)
+		if !ok {
+			return nil
+		}
+		containers[c.Image]++
+
+		cdu := &types.ContainerDiskUsage{
+			ID:    c.ID,
+			Name:  c.Name,
+			Image: c.Image,
+		}
+		if c.State != nil {
+			cdu.State = string(c.State.Status)
+		}
+
+		var snapshotter string
+		if c.Config != nil {
+			snapshotter = c.Config.Snapshotter
+		}
+		size, err := snapshotUsage(ctx, mgr.client, snapshotRef{snapshotter: snapshotter, key: c.ID})
+		if err != nil {
+			log.With(ctx).Warnf("failed to get usage of container %s: %v", c.ID, err)
+		}
+		cdu.SizeRw = size
+		cdu.SizeRootFs = size + imageSize[c.Image]
+
+		du.Containers = append(du.Containers, cdu)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	du.Images = imageDiskUsages(images, snapshots, usage, containers)
+	for _, size := range usage {
+		du.ImagesSize += size
+	}
+
+	volumes, err := mgr.volumeMgr.List(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range volumes {
+		size, err := mgr.volumeMgr.Usage(ctx, v.Name)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get usage of volume %s: %v", v.Name, err)
+			size = -1
+		} else if size >= 0 {
+			du.VolumesSize += size
+		}
+
+		var refCount int64
+		if ref := v.Option(volumetypes.OptionRef); ref != "" {
+			refCount = int64(len(strings.Split(ref, ",")))
+		}
+
+		du.Volumes = append(du.Volumes, &types.VolumeDiskUsage{
+			Name:       v.Name,
+			Driver:     v.Driver(),
+			Mountpoint: v.Path(),
+			Size:       size,
+			RefCount:   refCount,
+		})
+	}
+	return du, nil
+}
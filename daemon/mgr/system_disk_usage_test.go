@@ -0,0 +1,60 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageSnapshots(t *testing.T) {
+	diffIDs := []digest.Digest{digest.FromString("layer1"), digest.FromString("layer2")}
+	img := &types.ImageInfo{
+		ID: "sha256:1",
+		RootFS: &types.ImageInfoRootFS{
+			Type:   "layers",
+			Layers: []string{diffIDs[0].String(), diffIDs[1].String()},
+		},
+	}
+
+	refs, err := imageSnapshots(img, "overlayfs")
+	assert.NoError(t, err)
+	assert.Equal(t, []snapshotRef{
+		{snapshotter: "overlayfs", key: diffIDs[0].String()},
+		{snapshotter: "overlayfs", key: identity.ChainID(diffIDs).String()},
+	}, refs)
+
+	img.RootFS.Layers = []string{"invalid"}
+	_, err = imageSnapshots(img, "overlayfs")
+	assert.Error(t, err)
+
+	refs, err = imageSnapshots(&types.ImageInfo{ID: "sha256:2"}, "overlayfs")
+	assert.NoError(t, err)
+	assert.Empty(t, refs)
+}
+
+func TestImageDiskUsages(t *testing.T) {
+	var (
+		base  = snapshotRef{key: "base"}
+		top1  = snapshotRef{key: "top1"}
+		top2  = snapshotRef{key: "top2"}
+		usage = map[snapshotRef]int64{base: 100, top1: 10, top2: 20}
+	)
+	images := []types.ImageInfo{
+		{ID: "sha256:1", RepoTags: []string{"busybox:1"}},
+		{ID: "sha256:2", RepoTags: []string{"busybox:2"}},
+	}
+	snapshots := map[string][]snapshotRef{
+		"sha256:1": {base, top1},
+		"sha256:2": {base, top2},
+	}
+
+	result := imageDiskUsages(images, snapshots, usage, map[string]int64{"sha256:1": 2})
+	assert.Equal(t, []*types.ImageDiskUsage{
+		{ID: "sha256:1", RepoTags: []string{"busybox:1"}, Size: 110, SharedSize: 100, Containers: 2},
+		{ID: "sha256:2", RepoTags: []string{"busybox:2"}, Size: 120, SharedSize: 100},
+	}, result)
+}
@@ -0,0 +1,159 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+
+	"github.com/containerd/containerd/runtime/linux/runctypes"
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
+)
+
+// runtimeProbeTimeout is the timeout of getting the version of a runtime
+// binary, so that a hanging binary doesn't block the daemon from starting.
+var runtimeProbeTimeout = 5 * time.Second
+
+// probeRuntimes probes the binaries and capabilities of the runtimes.
+func probeRuntimes(runtimes map[string]types.Runtime) map[string]types.RuntimeStatus {
+	status := make(map[string]types.RuntimeStatus, len(runtimes))
+	for name, r := range runtimes {
+		status[name] = probeRuntime(name, r)
+	}
+	return status
+}
+
+// probeRuntime looks up the shim and OCI runtime binaries of runtime and gets
+// their versions. The runtime is healthy if all the binaries are found and
+// report their versions.
+func probeRuntime(name string, r types.Runtime) types.RuntimeStatus {
+	runtimeType := r.Type
+	if runtimeType == "" {
+		runtimeType = ctrd.RuntimeTypeV1
+	}
+
+	status := types.RuntimeStatus{Type: runtimeType}
+	var errs []string
+
+	// the shim of v1 has no flag to print its version.
+	if runtimeType == ctrd.RuntimeTypeV1 {
+		path, err := exec.LookPath("containerd-shim")
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		status.ShimPath = path
+	} else {
+		path, version, err := probeBinary(shimBinary(runtimeType), "-v")
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		status.ShimPath, status.ShimVersion = path, version
+	}
+
+	if binary := runtimeBinary(name, runtimeType, r); binary != "" {
+		path, version, err := probeBinary(binary, "--version")
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		status.RuntimePath, status.RuntimeVersion = path, version
+	}
+
+	switch runtimeType {
+	case ctrd.RuntimeTypeV1, ctrd.RuntimeTypeV2runcV1:
+		status.Pause = true
+		_, err := exec.LookPath(criuBinary(r))
+		status.Checkpoint = err == nil
+	case ctrd.RuntimeTypeV2kataV2, ctrd.RuntimeTypeV2runscV1:
+		status.Pause = true
+	}
+	status.Update = ctrd.UpdatableResources(runtimeType)
+
+	status.Healthy = len(errs) == 0
+	status.Error = strings.Join(errs, "; ")
+	return status
+}
+
+// shimBinary returns the name of shim binary of runtime type, which is
+// resolved by containerd in the same way.
+func shimBinary(runtimeType string) string {
+	parts := strings.Split(runtimeType, ".")
+	if len(parts) < 2 {
+		return "containerd-shim-" + runtimeType
+	}
+	return fmt.Sprintf("containerd-shim-%s-%s", parts[len(parts)-2], parts[len(parts)-1])
+}
+
+// runtimeBinary returns the OCI runtime binary invoked by the shim, it
+// returns empty if the shim runs the containers by itself.
+func runtimeBinary(name, runtimeType string, r types.Runtime) string {
+	path := r.Path
+	if path == "" {
+		path = name
+	}
+
+	switch runtimeType {
+	case ctrd.RuntimeTypeV1, ctrd.RuntimeTypeV2runcV1:
+		return path
+	case ctrd.RuntimeTypeV2runscV1:
+		// the gVisor shim runs runsc in PATH unless the path is specified.
+		if filepath.IsAbs(path) {
+			return path
+		}
+		return "runsc"
+	default:
+		return ""
+	}
+}
+
+// criuBinary returns the CRIU binary used by the runc runtimes.
+func criuBinary(r types.Runtime) string {
+	switch o := r.Options.(type) {
+	case *runctypes.RuncOptions:
+		if o.CriuPath != "" {
+			return o.CriuPath
+		}
+	case *runcoptions.Options:
+		if o.CriuPath != "" {
+			return o.CriuPath
+		}
+	}
+	return "criu"
+}
+
+// probeBinary returns the absolute path of binary and its version.
+func probeBinary(binary string, versionArgs ...string) (string, string, error) {
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return "", "", err
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), runtimeProbeTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, path, versionArgs...).Output()
+	if err != nil {
+		return path, "", fmt.Errorf("failed to get version of %s: %v", path, err)
+	}
+	return path, parseVersion(string(out)), nil
+}
+
+// parseVersion returns the first line mentioning the version in the output
+// of binary, such as "Version: v1.2.0" of shims or "runc version 1.0.0" of
+// runc, and falls back to the first line.
+func parseVersion(out string) string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	for _, line := range lines {
+		if strings.Contains(strings.ToLower(line), "version") {
+			return strings.Join(strings.Fields(line), " ")
+		}
+	}
+	return strings.Join(strings.Fields(lines[0]), " ")
+}
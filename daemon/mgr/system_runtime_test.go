@@ -0,0 +1,77 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShimBinary(t *testing.T) {
+	assert.Equal(t, "containerd-shim-runc-v1", shimBinary(ctrd.RuntimeTypeV2runcV1))
+	assert.Equal(t, "containerd-shim-kata-v2", shimBinary(ctrd.RuntimeTypeV2kataV2))
+	assert.Equal(t, "containerd-shim-wasmedge-v1", shimBinary(ctrd.RuntimeTypeV2wasmedgeV1))
+}
+
+func TestParseVersion(t *testing.T) {
+	assert.Equal(t, "Version: v1.2.0", parseVersion("containerd-shim-runc-v1:\n  Version:  v1.2.0\n  Revision: 6806845b\n"))
+	assert.Equal(t, "runc version 1.0.0-rc8", parseVersion("runc version 1.0.0-rc8\nspec: 1.0.1-dev\n"))
+	assert.Equal(t, "v0.13.1", parseVersion("v0.13.1\n"))
+}
+
+func TestProbeRuntime(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "runtime-probe")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	for name, data := range map[string]string{
+		"containerd-shim-runc-v1": "#!/bin/sh\necho 'containerd-shim-runc-v1:'\necho '  Version: v1.2.0'\n",
+		"runc":                    "#!/bin/sh\necho 'runc version 1.0.0-rc8'\necho 'spec: 1.0.1-dev'\n",
+		"criu":                    "#!/bin/sh\n",
+		"containerd-shim-kata-v2": "#!/bin/sh\nexit 1\n",
+	} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, name), []byte(data), 0755))
+	}
+
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", tmpDir)
+
+	status := probeRuntime("runc", types.Runtime{Type: ctrd.RuntimeTypeV2runcV1, Options: &runcoptions.Options{}})
+	assert.Equal(t, types.RuntimeStatus{
+		Type:           ctrd.RuntimeTypeV2runcV1,
+		Healthy:        true,
+		ShimPath:       filepath.Join(tmpDir, "containerd-shim-runc-v1"),
+		ShimVersion:    "Version: v1.2.0",
+		RuntimePath:    filepath.Join(tmpDir, "runc"),
+		RuntimeVersion: "runc version 1.0.0-rc8",
+		Checkpoint:     true,
+		Pause:          true,
+		Update:         ctrd.UpdatableResources(ctrd.RuntimeTypeV2runcV1),
+	}, status)
+
+	// the criu binary in options is not found.
+	status = probeRuntime("runc", types.Runtime{Type: ctrd.RuntimeTypeV2runcV1, Options: &runcoptions.Options{CriuPath: "/not/exist/criu"}})
+	assert.True(t, status.Healthy)
+	assert.False(t, status.Checkpoint)
+
+	// the shim fails to report its version.
+	status = probeRuntime("kata", types.Runtime{Type: ctrd.RuntimeTypeV2kataV2})
+	assert.False(t, status.Healthy)
+	assert.Contains(t, status.Error, "failed to get version")
+	assert.Equal(t, filepath.Join(tmpDir, "containerd-shim-kata-v2"), status.ShimPath)
+	assert.Empty(t, status.RuntimePath)
+	assert.Equal(t, []string{ctrd.UpdateResourceCPU, ctrd.UpdateResourceMemory}, status.Update)
+
+	// the shim is not found.
+	status = probeRuntime("wasmedge", types.Runtime{Type: ctrd.RuntimeTypeV2wasmedgeV1})
+	assert.False(t, status.Healthy)
+	assert.NotEmpty(t, status.Error)
+	assert.False(t, status.Pause)
+}
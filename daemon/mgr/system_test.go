@@ -0,0 +1,41 @@
+package mgr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_mergeSystemLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		labels    []string
+		oldLabels []string
+		want      []string
+	}{
+		{
+			name:      "keep system labels",
+			labels:    []string{"zone=hangzhou"},
+			oldLabels: []string{"storage=ssd", "node_ip=192.168.0.1", "SN=xxxxx"},
+			want:      []string{"zone=hangzhou", "node_ip=192.168.0.1", "SN=xxxxx"},
+		},
+		{
+			name:      "override system labels",
+			labels:    []string{"node_ip=10.0.0.1"},
+			oldLabels: []string{"node_ip=192.168.0.1"},
+			want:      []string{"node_ip=10.0.0.1"},
+		},
+		{
+			name:      "no labels",
+			labels:    nil,
+			oldLabels: nil,
+			want:      []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeSystemLabels(tt.labels, tt.oldLabels); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeSystemLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
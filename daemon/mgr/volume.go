@@ -38,11 +38,30 @@ type VolumeMgr interface {
 	// Path returns the mount path of volume.
 	Path(ctx context.Context, name string) (string, error)
 
+	// Usage returns the size of volume in bytes, -1 if the driver doesn't support it.
+	Usage(ctx context.Context, name string) (int64, error)
+
+	// Stats returns the usage and capacity of volume, -1 for the values
+	// the driver doesn't support.
+	Stats(ctx context.Context, name string) (*types.VolumeStats, error)
+
 	// Attach is used to bind a volume to container.
 	Attach(ctx context.Context, name string, options map[string]string) (*types.Volume, error)
 
 	// Detach is used to unbind a volume from container.
 	Detach(ctx context.Context, name string, options map[string]string) (*types.Volume, error)
+
+	// CreateSnapshot takes a snapshot of volume.
+	CreateSnapshot(ctx context.Context, name, snapshot string, labels map[string]string) (*types.VolumeSnapshot, error)
+
+	// ListSnapshots returns the snapshots of volume.
+	ListSnapshots(ctx context.Context, name string) ([]*types.VolumeSnapshot, error)
+
+	// RemoveSnapshot removes a snapshot of volume.
+	RemoveSnapshot(ctx context.Context, name, snapshot string) error
+
+	// Clone creates a volume from the snapshot of volume.
+	Clone(ctx context.Context, name, snapshot, newName string, options, labels map[string]string) (*types.Volume, error)
 }
 
 // VolumeManager is the default implement of interface VolumeMgr.
@@ -160,6 +179,23 @@ func (vm *VolumeManager) Path(ctx context.Context, name string) (string, error)
 	return vm.core.VolumePath(ctx, id)
 }
 
+// Usage returns the size of volume in bytes, -1 if the driver doesn't support it.
+func (vm *VolumeManager) Usage(ctx context.Context, name string) (int64, error) {
+	id := types.VolumeContext{
+		Name: name,
+	}
+	return vm.core.VolumeUsage(ctx, id)
+}
+
+// Stats returns the usage and capacity of volume, -1 for the values the
+// driver doesn't support.
+func (vm *VolumeManager) Stats(ctx context.Context, name string) (*types.VolumeStats, error) {
+	id := types.VolumeContext{
+		Name: name,
+	}
+	return vm.core.VolumeStats(ctx, id)
+}
+
 // Attach is used to bind a volume to container.
 func (vm *VolumeManager) Attach(ctx context.Context, name string, options map[string]string) (*types.Volume, error) {
 	id := types.VolumeContext{
@@ -182,6 +218,8 @@ func (vm *VolumeManager) Attach(ctx context.Context, name string, options map[st
 			options[types.OptionRef] = cid
 		} else if !strings.Contains(ref, cid) {
 			options[types.OptionRef] = strings.Join([]string{ref, cid}, ",")
+		} else {
+			options[types.OptionRef] = ref
 		}
 	}
 
@@ -224,3 +262,63 @@ func (vm *VolumeManager) Detach(ctx context.Context, name string, options map[st
 	vm.LogVolumeEvent(ctx, name, "detach", map[string]string{"driver": v.Driver()})
 	return vm.core.DetachVolume(ctx, id, options)
 }
+
+// CreateSnapshot takes a snapshot of volume.
+func (vm *VolumeManager) CreateSnapshot(ctx context.Context, name, snapshot string, labels map[string]string) (*types.VolumeSnapshot, error) {
+	id := types.VolumeContext{
+		Name: name,
+	}
+
+	s, err := vm.core.CreateSnapshot(ctx, id, snapshot, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	vm.LogVolumeEvent(ctx, name, "snapshot", map[string]string{"driver": s.Driver, "snapshot": s.Name})
+	return s, nil
+}
+
+// ListSnapshots returns the snapshots of volume.
+func (vm *VolumeManager) ListSnapshots(ctx context.Context, name string) ([]*types.VolumeSnapshot, error) {
+	id := types.VolumeContext{
+		Name: name,
+	}
+	return vm.core.ListSnapshots(ctx, id)
+}
+
+// RemoveSnapshot removes a snapshot of volume.
+func (vm *VolumeManager) RemoveSnapshot(ctx context.Context, name, snapshot string) error {
+	id := types.VolumeContext{
+		Name: name,
+	}
+	if err := vm.core.RemoveSnapshot(ctx, id, snapshot); err != nil {
+		return err
+	}
+
+	vm.LogVolumeEvent(ctx, name, "snapshot-remove", map[string]string{"snapshot": snapshot})
+	return nil
+}
+
+// Clone creates a volume named newName from the snapshot of volume, the new
+// volume is created by the driver of the volume.
+func (vm *VolumeManager) Clone(ctx context.Context, name, snapshot, newName string, options, labels map[string]string) (*types.Volume, error) {
+	id := types.VolumeContext{
+		Name:    newName,
+		Options: map[string]string{},
+		Labels:  map[string]string{},
+	}
+	for k, v := range options {
+		id.Options[k] = v
+	}
+	for k, v := range labels {
+		id.Labels[k] = v
+	}
+
+	v, err := vm.core.CloneVolume(ctx, id, name, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	vm.LogVolumeEvent(ctx, newName, "create", map[string]string{"driver": v.Driver(), "snapshot": snapshot})
+	return v, nil
+}
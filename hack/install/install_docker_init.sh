@@ -0,0 +1,60 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+readonly TINI_VERSION="0.19.0"
+
+# docker_init::check_version checks the command and the version.
+docker_init::check_version() {
+  local has_installed version
+
+  has_installed="$(command -v docker-init || echo false)"
+  if [[ "${has_installed}" = "false" ]]; then
+    echo false
+    exit 0
+  fi
+
+  version="$(docker-init --version 2>&1 | cut -d " " -f 3)"
+  if [[ "${TINI_VERSION}" != "${version}" ]]; then
+    echo false
+    exit 0
+  fi
+
+  echo true
+}
+
+# docker_init::install downloads the static tini binary from release url,
+# which is injected into containers by pouchd with --init.
+docker_init::install() {
+  local url target
+
+  target="/tmp/docker-init"
+
+  url="https://github.com/krallin/tini/releases/download"
+  url="${url}/v${TINI_VERSION}/tini-static-amd64"
+
+  wget --quiet -O "${target}" "${url}"
+  mv "${target}" /usr/bin/
+  chmod +x /usr/bin/docker-init
+}
+
+main() {
+  local has_installed
+
+  has_installed="$(docker_init::check_version)"
+  if [[ "${has_installed}" = "true" ]]; then
+    echo "docker-init(tini-${TINI_VERSION}) has been installed."
+    exit 0
+  fi
+
+  echo ">>>> install docker-init(tini-${TINI_VERSION}) <<<<"
+
+  docker_init::install
+
+  # final check
+  command -v docker-init > /dev/null
+
+  echo
+}
+
+main
@@ -4,12 +4,13 @@ set -o errexit
 set -o nounset
 
 #
-# This script is used to regenerate api.pb.go.
+# This script is used to regenerate api.pb.go and exec.pb.go.
 #
 
 # Get the absolute path of this file
 DIR="$( cd "$( dirname "$0"  )" && pwd  )"/../..
 API_ROOT="${DIR}/cri/apis/v1alpha2"
+EXEC_API_ROOT="${DIR}/apis/grpc/v1alpha1"
 
 if [[ -z "$(which protoc)" || "$(protoc --version)" != "libprotoc 3."* ]]; then
   echo "Generating protobuf requires protoc 3.0.0-beta1 or newer. Please download and"
@@ -59,12 +60,24 @@ protoc::generateproto(){
     gofmt -l -s -w "${API_ROOT}/api.pb.go"
 }
 
+protoc::generateexecproto(){
+    protoc::install_gen_gogo
+    protoc \
+        --proto_path="${EXEC_API_ROOT}" \
+        --proto_path="${DIR}/vendor" \
+        --gogo_out=plugins=grpc:"${EXEC_API_ROOT}" "${EXEC_API_ROOT}/exec.proto"
+
+    gofmt -l -s -w "${EXEC_API_ROOT}/exec.pb.go"
+}
+
 main(){
     local operation
     operation=$1
 
     if [[ "${operation}" == "gen_proto" ]]; then
         protoc::generateproto
+    elif [[ "${operation}" == "gen_exec_proto" ]]; then
+        protoc::generateexecproto
     elif [[ "${operation}" == "gen_doc" ]]; then
         protoc::generatedoc
     else
@@ -0,0 +1,29 @@
+package hookplugins
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// SpecPlugin defines the place where a plugin will be triggered just before the container is created in containerd
+type SpecPlugin interface {
+	// PreCreateSpec accepts the id, config and host config of container and the generated OCI spec, in this plugin
+	// point user could mutate the spec in place, such as injecting mounts or scrubbing env, or reject the container
+	// by returning an error. The config and host config should be treated as read-only.
+	PreCreateSpec(context.Context, string, *types.ContainerConfig, *types.HostConfig, *specs.Spec) error
+}
+
+var specPlugin SpecPlugin
+
+// RegisterSpecPlugin is used to register the spec plugin.
+func RegisterSpecPlugin(sp SpecPlugin) {
+	specPlugin = sp
+}
+
+// GetSpecPlugin returns the spec plugin.
+func GetSpecPlugin() SpecPlugin {
+	return specPlugin
+}
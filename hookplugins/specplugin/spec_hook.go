@@ -0,0 +1,23 @@
+package specplugin
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/hookplugins"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+type specPlugin struct{}
+
+func init() {
+	hookplugins.RegisterSpecPlugin(&specPlugin{})
+}
+
+// PreCreateSpec accepts the id, config and host config of container and the generated OCI spec, in this plugin
+// point user could mutate the spec in place or reject the container by returning an error.
+func (s *specPlugin) PreCreateSpec(ctx context.Context, id string, config *types.ContainerConfig, hostConfig *types.HostConfig, spec *specs.Spec) error {
+	// TODO: Implemented by the developer
+	return nil
+}
@@ -23,17 +23,18 @@ type DaemonProvider interface {
 	MetaStore() *meta.Store
 	ContainerPlugin() hookplugins.ContainerPlugin
 	ImagePlugin() hookplugins.ImagePlugin
+	SpecPlugin() hookplugins.SpecPlugin
 	EventsService() *events.Events
 }
 
 // GenContainerMgr generates a ContainerMgr instance according to config cfg.
 func GenContainerMgr(ctx context.Context, d DaemonProvider) (mgr.ContainerMgr, error) {
-	return mgr.NewContainerManager(ctx, d.MetaStore(), d.Containerd(), d.ImgMgr(), d.VolMgr(), d.Config(), d.ContainerPlugin(), d.EventsService())
+	return mgr.NewContainerManager(ctx, d.MetaStore(), d.Containerd(), d.ImgMgr(), d.VolMgr(), d.Config(), d.ContainerPlugin(), d.SpecPlugin(), d.EventsService())
 }
 
 // GenSystemMgr generates a SystemMgr instance according to config cfg.
 func GenSystemMgr(cfg *config.Config, d DaemonProvider) (mgr.SystemMgr, error) {
-	return mgr.NewSystemManager(cfg, d.MetaStore(), d.ImgMgr(), d.EventsService())
+	return mgr.NewSystemManager(cfg, d.MetaStore(), d.Containerd(), d.ImgMgr(), d.VolMgr(), d.EventsService())
 }
 
 // GenImageMgr generates a ImageMgr instance according to config cfg.
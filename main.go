@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"path/filepath"
 	"strings"
 	"syscall"
 
@@ -15,9 +16,11 @@ import (
 	"github.com/alibaba/pouch/daemon"
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/lxcfs"
+	"github.com/alibaba/pouch/network/proxy"
 	"github.com/alibaba/pouch/pkg/debug"
 	"github.com/alibaba/pouch/pkg/kernel"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/nri"
 	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/quota"
@@ -50,6 +53,12 @@ var rootCmd = &cobra.Command{
 }
 
 func main() {
+	// the bridge driver runs the userland proxy by the link to pouchd.
+	if filepath.Base(os.Args[0]) == proxy.CommandName {
+		proxy.Main()
+		return
+	}
+
 	if reexec.Init() {
 		return
 	}
@@ -77,6 +86,7 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.BoolVar(&cfg.IsCriEnabled, "enable-cri", false, "Specify whether enable the cri part of pouchd which is used to support Kubernetes")
 	flagSet.StringVar(&cfg.CriConfig.CriVersion, "cri-version", "v1alpha2", "Specify the version of cri which is used to support Kubernetes")
 	flagSet.StringVar(&cfg.CriConfig.Listen, "listen-cri", "unix:///var/run/pouchcri.sock", "Specify listening address of CRI")
+	flagSet.StringArrayVar(&cfg.GRPCListen, "listen-grpc", nil, "Specify listening addresses of grpc server for streaming apis like exec, disabled if not specified")
 	flagSet.StringVar(&cfg.CriConfig.NetworkPluginBinDir, "cni-bin-dir", "/opt/cni/bin", "The directory for putting cni plugin binaries.")
 	flagSet.StringVar(&cfg.CriConfig.NetworkPluginConfDir, "cni-conf-dir", "/etc/cni/net.d", "The directory for putting cni plugin configuration files.")
 	flagSet.StringVar(&cfg.CriConfig.SandboxImage, "sandbox-image", "registry.cn-hangzhou.aliyuncs.com/google-containers/pause-amd64:3.0", "The image used by sandbox container.")
@@ -88,11 +98,18 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.BoolVarP(&cfg.Debug, "debug", "D", false, "Switch daemon log level to DEBUG mode")
 	flagSet.StringVarP(&cfg.ContainerdAddr, "containerd", "c", "/var/run/containerd.sock", "Specify listening address of containerd")
 	flagSet.StringVar(&cfg.ContainerdPath, "containerd-path", "", "Specify the path of containerd binary")
+	flagSet.BoolVar(&cfg.ExternalContainerd, "external-containerd", false, "Connect to the containerd listening on the address of --containerd, instead of launching and supervising a private one")
+	flagSet.IntVar(&cfg.ContainerdClients, "containerd-clients", 5, "The number of grpc connections to containerd, the containers are assigned to them in turn")
+	flagSet.IntVar(&cfg.ContainerdHealthCheckInterval, "containerd-health-check-interval", 10, "The time duration (in time.Second) to check the health of containerd connections, the containers on the broken one are rebound to the others, 0 means disabled")
 	flagSet.StringVar(&cfg.TLS.Key, "tlskey", "", "Specify key file of TLS")
 	flagSet.StringVar(&cfg.TLS.Cert, "tlscert", "", "Specify cert file of TLS")
 	flagSet.StringVar(&cfg.TLS.CA, "tlscacert", "", "Specify CA file of TLS")
 	flagSet.BoolVar(&cfg.TLS.VerifyRemote, "tlsverify", false, "Use TLS and verify remote")
+	flagSet.StringVar(&cfg.TLS.MinVersion, "tls-min-version", "", "Specify the min version of TLS, one of 1.0, 1.1, 1.2 and 1.3")
+	flagSet.StringSliceVar(&cfg.TLS.CipherSuites, "tls-cipher-suites", nil, "Specify the cipher suites of TLS, like TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, the default ones of Go are used if not specified")
 	flagSet.StringVar(&cfg.TLS.ManagerWhiteList, "manager-whitelist", "", "Set tls name whitelist, multiple values are separated by commas")
+	flagSet.StringArrayVar(&cfg.AuthorizationPlugins, "authorization-plugin", nil, "Specify the authorization plugins which authorize the api requests in order")
+	flagSet.StringArrayVar(&cfg.AuditLog, "audit-log", nil, "Specify the sinks of audit log for the state-changing api requests, like file:///var/log/pouch/audit.log, syslog:// and syslog+udp://host:514")
 	flagSet.BoolVarP(&printVersion, "version", "v", false, "Print daemon version")
 	flagSet.StringVar(&cfg.DefaultRuntime, "default-runtime", "runc", "Default OCI Runtime")
 	flagSet.BoolVar(&cfg.IsLxcfsEnabled, "enable-lxcfs", false, "Enable Lxcfs to make container to isolate /proc")
@@ -105,6 +122,7 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&cfg.ConfigFile, "config-file", "/etc/pouch/config.json", "Configuration file of pouchd")
 	flagSet.StringVar(&cfg.Snapshotter, "snapshotter", "overlayfs", "Snapshotter driver of pouchd, it will be passed to containerd")
 	flagSet.BoolVar(&cfg.AllowMultiSnapshotter, "allow-multi-snapshotter", false, "If set true, pouchd will allow multi snapshotter")
+	flagSet.StringVar(&cfg.RemoteSnapshotter, "remote-snapshotter", "", "Remote snapshotter to lazily pull images with eStargz or zstd:chunked layers, such as stargz")
 
 	// volume config
 	flagSet.StringVar(&cfg.VolumeConfig.DriverAlias, "volume-driver-alias", "", "Set volume driver alias, <name=alias>[;name1=alias1]")
@@ -114,14 +132,21 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.Name, "bridge-name", "", "Set default bridge name")
 	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.IPv4, "bip", "", "Set bridge IP")
 	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.GatewayIPv4, "default-gateway", "", "Set default IPv4 bridge gateway")
+	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.HostGatewayIP, "host-gateway-ip", "", "Set the IP address of host-gateway in extra hosts of containers, gateway of default bridge is used if not set")
 	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.FixedCIDRv4, "fixed-cidr", "", "Set bridge fixed CIDRv4")
 	flagSet.BoolVar(&cfg.NetworkConfig.BridgeConfig.EnableIPv6, "enable-ipv6", false, "Enable IPv6 networking")
 	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.GatewayIPv6, "default-gateway-v6", "", "Set default IPv6 bridge gateway")
 	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.FixedCIDRv6, "fixed-cidr-v6", "", "Set bridge fixed CIDRv6")
 	flagSet.IntVar(&cfg.NetworkConfig.BridgeConfig.Mtu, "mtu", 1500, "Set bridge MTU")
 	flagSet.BoolVar(&cfg.NetworkConfig.BridgeConfig.IPTables, "iptables", true, "Enable iptables")
+	flagSet.BoolVar(&cfg.NetworkConfig.BridgeConfig.IP6Tables, "ip6tables", false, "Enable ip6tables rules of the bridge networks with IPv6 enabled")
+	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.FirewallBackend, "firewall-backend", "iptables", "Set the firewall backend of bridge networks, iptables, nftables or auto, auto selects nftables only if iptables is missing or backed by nf_tables without any rule")
 	flagSet.BoolVar(&cfg.NetworkConfig.BridgeConfig.IPForward, "ipforward", true, "Enable ipforward")
 	flagSet.BoolVar(&cfg.NetworkConfig.BridgeConfig.UserlandProxy, "userland-proxy", false, "Enable userland proxy")
+	flagSet.StringVar(&cfg.NetworkConfig.BridgeConfig.UserlandProxyPath, "userland-proxy-path", "", "Set the path of userland proxy binary, pouchd itself is used if not set")
+	flagSet.StringVar(&cfg.NetworkConfig.ClusterStore, "cluster-store", "", "Set the URL of KV store shared by the hosts of overlay networks, such as etcd://10.0.0.1:2379")
+	flagSet.StringVar(&cfg.NetworkConfig.ClusterAdvertise, "cluster-advertise", "", "Set the address of this host advertised in cluster store, <ip>:<port> or <interface>:<port>")
+	flagSet.Var(optscfg.NewClusterStoreOpts(&cfg.NetworkConfig.ClusterStoreOpts), "cluster-store-opt", "Set the options of cluster store, <key>=<value>")
 
 	// log config
 	flagSet.StringVar(&cfg.DefaultLogConfig.LogDriver, "log-driver", types.LogConfigLogDriverJSONFile, "Set default log driver")
@@ -144,6 +169,59 @@ func setupFlags(cmd *cobra.Command) {
 	// registry
 	flagSet.StringArrayVar(&cfg.InsecureRegistries, "insecure-registries", []string{}, "enable insecure registry")
 	flagSet.StringArrayVar(&cfg.RegistryMirrors, "registry-mirrors", []string{}, "preferred mirror registry list")
+	flagSet.IntVar(&cfg.MaxConcurrentDownloads, "max-concurrent-downloads", 3, "The max number of concurrent layer downloads of daemon, 0 means no limit")
+	flagSet.StringVar(&cfg.MaxDownloadBandwidth, "max-download-bandwidth", "", "The max download bandwidth of each image pull in bytes per second, such as 10m, no limit if not set")
+
+	// image gc
+	flagSet.IntVar(&cfg.ImageGCInterval, "image-gc-interval", 0, "The time duration (in time.Second) to remove the images not used by containers in background, 0 means disabled")
+	flagSet.BoolVar(&cfg.ImageGCUnused, "image-gc-unused", false, "Remove all the unused images by image gc, not just dangling ones")
+	flagSet.IntVar(&cfg.ImageGCMinAge, "image-gc-min-age", 0, "The min age (in time.Second) since images are pulled for image gc to remove them")
+
+	// exec
+	flagSet.IntVar(&cfg.ExecRetentionTime, "exec-retention-time", 3600, "The time duration (in time.Second) to retain the metadata of finished exec processes, 0 means retaining it until it is inspected")
+	flagSet.IntVar(&cfg.MaxExecsPerContainer, "max-execs-per-container", 0, "The max number of finished exec processes retained for each container, 0 means no limit")
+
+	// container lock
+	flagSet.StringVar(&cfg.ContainerLockMode, "container-lock-mode", "fair", "The mode of container lock held by containerd operations, fair hands the lock over to the waiters in order, unfair allows new callers to take it first")
+	flagSet.Var(optscfg.NewLockTimeoutOpts(&cfg.ContainerLockTimeouts), "container-lock-timeout", "Set the timeout (in time.Second) to acquire the container lock of operation, <operation>=<timeout>, operation \"default\" applies to the others, 0 means no timeout")
+
+	// shutdown
+	flagSet.IntVar(&cfg.ShutdownTimeout, "shutdown-timeout", 60, "The time duration (in time.Second) to wait for pouchd to drain before it exits")
+	flagSet.BoolVar(&cfg.ShutdownStopContainers, "shutdown-stop-containers", false, "Stop running containers with their stop timeout when pouchd shuts down")
+
+	// user namespace
+	flagSet.StringVar(&cfg.UsernsRemap, "userns-remap", "", "User/Group setting for user namespaces, in the format of user[:group], \"default\" uses the user pouchremap")
+
+	// seccomp
+	flagSet.StringVar(&cfg.SeccompProfile, "seccomp-profile", "", "The path of default seccomp profile of containers, the built-in profile is used if not set")
+
+	// capabilities
+	flagSet.StringSliceVar(&cfg.DefaultCapabilities, "default-capabilities", nil, "Override the default capabilities of containers, such as CHOWN,KILL")
+
+	// selinux
+	flagSet.BoolVar(&cfg.EnableSelinux, "selinux-enabled", false, "Enable SELinux labeling of containers")
+
+	// oci hooks
+	flagSet.StringVar(&cfg.HooksDir, "hooks-dir", "", "The directory scanned at start for the OCI hooks of all containers, each json file in it is a hook")
+
+	// spec plugins
+	flagSet.StringSliceVar(&cfg.SpecPlugins, "spec-plugin", nil, "The executable invoked just before containers are created, which receives the OCI spec and config of container on stdin to mutate or reject the spec, can be set multiple times")
+	flagSet.IntVar(&cfg.SpecPluginTimeout, "spec-plugin-timeout", 10, "The time duration (in time.Second) to wait for each spec plugin, 0 means no timeout")
+
+	// nri
+	flagSet.BoolVar(&cfg.EnableNRI, "enable-nri", false, "Enable NRI plugins which are invoked at the create, update, pause, resume and delete of containers")
+	flagSet.StringVar(&cfg.NRIConfigPath, "nri-config-path", nri.DefaultConfPath, "The path of the config of NRI plugins")
+	flagSet.StringVar(&cfg.NRIBinPath, "nri-bin-path", nri.DefaultBinaryPath, "The directory of the NRI plugin binaries")
+
+	// init
+	flagSet.BoolVar(&cfg.Init, "init", false, "Run an init in containers by default to forward signals and reap processes")
+	flagSet.StringVar(&cfg.InitPath, "init-path", "", "The path of init binary injected into containers, docker-init in PATH is used if not set")
+
+	// rootfs
+	flagSet.StringSliceVar(&cfg.RootfsDirs, "rootfs-dir", nil, "The directory on host which containers are allowed to be created from the rootfs or OCI bundle in with --rootfs, can be set multiple times")
+
+	// ulimits
+	flagSet.Var(optscfg.NewDefaultUlimitOpts(&cfg.DefaultUlimits), "default-ulimit", "Set the default ulimits of containers, <type>=<soft>[:<hard>], such as nofile=65536:65536, can be set multiple times")
 
 	// buildkit
 	flagSet.BoolVar(&cfg.EnableBuilder, "enable-builder", false, "Enable buildkit functionality")
@@ -238,6 +316,7 @@ func runDaemon(cmd *cobra.Command) error {
 	var (
 		errCh    = make(chan error, 1)
 		signalCh = make(chan os.Signal, 1)
+		reloadCh = make(chan os.Signal, 1)
 	)
 
 	// new daemon instance, this is core.
@@ -246,9 +325,20 @@ func runDaemon(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to new daemon")
 	}
 
-	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGHUP)
+	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM)
 	sigHandles = append(sigHandles, d.Shutdown, d.ShutdownPlugin)
 
+	// SIGHUP reloads daemon config from config file.
+	signal.Notify(reloadCh, syscall.SIGHUP)
+	go func() {
+		for range reloadCh {
+			log.With(nil).Infof("received signal SIGHUP, reload daemon config")
+			if err := d.Reload(); err != nil {
+				log.With(nil).Errorf("failed to reload daemon config: %v", err)
+			}
+		}
+	}()
+
 	go func() {
 		// FIXME: I think the Run() should always return error.
 		errCh <- d.Run()
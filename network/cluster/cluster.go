@@ -0,0 +1,139 @@
+// Package cluster advertises the host in the KV store shared by the hosts of
+// cluster, the overlay driver discovers its peers from the advertised hosts
+// and stores the overlay networks in the KV store.
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/discovery"
+	// register the KV discovery backends.
+	_ "github.com/docker/docker/pkg/discovery/kv"
+)
+
+const (
+	// defaultHeartbeat is the interval of advertising the host.
+	defaultHeartbeat = 20 * time.Second
+
+	// defaultTTL is the time the host is expired after the last heartbeat.
+	defaultTTL = 60 * time.Second
+
+	// heartbeatOpt and ttlOpt set the heartbeat and ttl in seconds.
+	heartbeatOpt = "discovery.heartbeat"
+	ttlOpt       = "discovery.ttl"
+)
+
+// providers are the supported KV stores.
+var providers = []string{"etcd", "consul", "zk"}
+
+// ParseStore splits the URL of cluster store into the KV provider and the
+// addresses, such as etcd://10.0.0.1:2379,10.0.0.2:2379/prefix.
+func ParseStore(store string) (provider string, address string, err error) {
+	parts := strings.SplitN(store, "://", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid cluster store %s, should be <provider>://<address>[,<address>][/<prefix>]", store)
+	}
+
+	for _, p := range providers {
+		if parts[0] == p {
+			return parts[0], parts[1], nil
+		}
+	}
+	return "", "", fmt.Errorf("invalid provider %s of cluster store, should be one of %s", parts[0], strings.Join(providers, ", "))
+}
+
+// parseHeartbeat returns the heartbeat and ttl of discovery from options.
+func parseHeartbeat(opts map[string]string) (time.Duration, time.Duration, error) {
+	heartbeat, ttl := defaultHeartbeat, defaultTTL
+
+	parse := func(opt string, d *time.Duration) error {
+		v, ok := opts[opt]
+		if !ok {
+			return nil
+		}
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds <= 0 {
+			return fmt.Errorf("invalid %s %s, should be positive seconds", opt, v)
+		}
+		*d = time.Duration(seconds) * time.Second
+		return nil
+	}
+	if err := parse(heartbeatOpt, &heartbeat); err != nil {
+		return 0, 0, err
+	}
+	if err := parse(ttlOpt, &ttl); err != nil {
+		return 0, 0, err
+	}
+
+	if ttl <= heartbeat {
+		return 0, 0, fmt.Errorf("%s %s must be larger than %s %s", ttlOpt, ttl, heartbeatOpt, heartbeat)
+	}
+	return heartbeat, ttl, nil
+}
+
+// Discovery advertises the host in the cluster store periodically.
+type Discovery struct {
+	backend   discovery.Backend
+	advertise string
+	heartbeat time.Duration
+}
+
+// New returns the discovery of cluster store, the advertise address is in
+// the form of <ip>:<port> or <interface>:<port>.
+func New(store, advertise string, opts map[string]string) (*Discovery, error) {
+	if _, _, err := ParseStore(store); err != nil {
+		return nil, err
+	}
+
+	addr, err := discovery.ParseAdvertise(advertise)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeat, ttl, err := parseHeartbeat(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := discovery.New(store, heartbeat, ttl, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize discovery of cluster store %s: %v", store, err)
+	}
+
+	d := &Discovery{
+		backend:   backend,
+		advertise: addr,
+		heartbeat: heartbeat,
+	}
+	go d.advertiseHeartbeat()
+	return d, nil
+}
+
+// Watcher returns the watcher of hosts in cluster.
+func (d *Discovery) Watcher() discovery.Watcher {
+	return d.backend
+}
+
+// Address returns the address advertised to the other hosts.
+func (d *Discovery) Address() string {
+	return d.advertise
+}
+
+// advertiseHeartbeat registers the host in cluster store every heartbeat, so
+// that the host is removed from the cluster after pouchd stops.
+func (d *Discovery) advertiseHeartbeat() {
+	ticker := time.NewTicker(d.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		if err := d.backend.Register(d.advertise); err != nil {
+			log.With(nil).Warnf("failed to advertise %s in cluster store: %v", d.advertise, err)
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,41 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStore(t *testing.T) {
+	provider, address, err := ParseStore("etcd://10.0.0.1:2379,10.0.0.2:2379/pouch")
+	assert.NoError(t, err)
+	assert.Equal(t, "etcd", provider)
+	assert.Equal(t, "10.0.0.1:2379,10.0.0.2:2379/pouch", address)
+
+	for _, store := range []string{"", "10.0.0.1:2379", "etcd://", "boltdb:///var/lib/pouch/kv.db"} {
+		_, _, err := ParseStore(store)
+		assert.Error(t, err, store)
+	}
+}
+
+func TestParseHeartbeat(t *testing.T) {
+	heartbeat, ttl, err := parseHeartbeat(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultHeartbeat, heartbeat)
+	assert.Equal(t, defaultTTL, ttl)
+
+	heartbeat, ttl, err = parseHeartbeat(map[string]string{heartbeatOpt: "5", ttlOpt: "15"})
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, heartbeat)
+	assert.Equal(t, 15*time.Second, ttl)
+
+	for _, opts := range []map[string]string{
+		{heartbeatOpt: "0"},
+		{ttlOpt: "ten"},
+		{heartbeatOpt: "30", ttlOpt: "30"},
+	} {
+		_, _, err := parseHeartbeat(opts)
+		assert.Error(t, err, "%v", opts)
+	}
+}
@@ -20,6 +20,16 @@ type Config struct {
 	// bridge config
 	BridgeConfig BridgeConfig `json:"bridge-config,omitempty"`
 
+	// ClusterStore is the URL of KV store shared by the hosts of cluster,
+	// which holds the overlay networks, such as etcd://10.0.0.1:2379.
+	ClusterStore string `json:"cluster-store,omitempty"`
+	// ClusterAdvertise is the address of host advertised in cluster store,
+	// in the form of <ip>:<port> or <interface>:<port>.
+	ClusterAdvertise string `json:"cluster-advertise,omitempty"`
+	// ClusterStoreOpts are the options of cluster store, such as the TLS
+	// files kv.cacertfile, kv.certfile and kv.keyfile.
+	ClusterStoreOpts map[string]string `json:"cluster-store-opts,omitempty"`
+
 	ActiveSandboxes map[string]interface{} `json:"-"`
 }
 
@@ -35,10 +45,23 @@ type BridgeConfig struct {
 	GatewayIPv6   string `json:"default-gateway-v6,omitempty"`
 	PreferredIP   string `json:"preferred-ip,omitempty"`
 
+	// HostGatewayIP is the address of host-gateway in extra hosts of
+	// containers, the gateway of default bridge is used if it's not set.
+	HostGatewayIP string `json:"host-gateway-ip,omitempty"`
+
 	Mtu           int  `json:"mtu,omitempty"`
 	ICC           bool `json:"icc,omitempty"`
 	IPTables      bool `json:"iptables"`
+	IP6Tables     bool `json:"ip6tables,omitempty"`
 	IPForward     bool `json:"ipforward"`
 	IPMasq        bool `json:"ipmasq,omitempty"`
 	UserlandProxy bool `json:"userland-proxy"`
+
+	// UserlandProxyPath is the path of userland proxy binary, pouchd runs
+	// as the userland proxy if it's not set.
+	UserlandProxyPath string `json:"userland-proxy-path,omitempty"`
+
+	// FirewallBackend is the backend programming the rules of bridge
+	// networks, which is iptables, nftables or auto, iptables by default.
+	FirewallBackend string `json:"firewall-backend,omitempty"`
 }
@@ -0,0 +1,150 @@
+// Package firewall programs the filter and NAT rules of the bridge networks
+// in the tables owned by pouchd, which replaces the iptables rules of the
+// bridge driver on the hosts using nftables. The policy rules between the
+// containers on the same bridge are programmed by both backends.
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// BackendAuto selects nftables if iptables is missing, or it's backed
+	// by nf_tables without any rule, otherwise iptables is selected.
+	BackendAuto = "auto"
+
+	// BackendIPTables programs the rules by the bridge driver with iptables,
+	// except the policy rules in the chain owned by pouchd. It's the default
+	// backend.
+	BackendIPTables = "iptables"
+
+	// BackendNFTables programs the rules in the nftables table owned by pouchd.
+	BackendNFTables = "nftables"
+)
+
+// Network is the bridge network filtered by the firewall.
+type Network struct {
+	// Bridge is the name of bridge interface.
+	Bridge string
+
+	// Subnets are masqueraded when the traffic leaves the bridge.
+	Subnets []*net.IPNet
+
+	// Internal network is isolated from the outside of bridge.
+	Internal bool
+
+	// ICC allows the communication between the containers on the bridge.
+	ICC bool
+
+	// Masquerade enables the masquerade of subnets.
+	Masquerade bool
+
+	// Rules filter the traffic between the containers on the bridge before
+	// ICC, the first matched rule decides whether the traffic is accepted.
+	Rules []PolicyRule
+}
+
+// PolicyRule accepts or drops the traffic between the addresses on bridge.
+type PolicyRule struct {
+	Accept bool
+
+	// From and To are the addresses of source and destination, nil matches
+	// any address.
+	From []*net.IPNet
+	To   []*net.IPNet
+}
+
+// PortMapping forwards the port of host to the container on the bridge.
+type PortMapping struct {
+	Bridge        string
+	Proto         string
+	HostIP        net.IP
+	HostPort      int
+	ContainerIP   net.IP
+	ContainerPort int
+}
+
+// Ruleset is the desired state of the firewall.
+type Ruleset struct {
+	Networks     []Network
+	PortMappings []PortMapping
+}
+
+// Firewall programs the ruleset of bridge networks.
+type Firewall interface {
+	// Reconcile replaces the rules owned by pouchd with the ruleset, it's
+	// idempotent, so the rules are reconciled whenever the state changes.
+	Reconcile(rs Ruleset) error
+}
+
+// lookPath, iptablesVersion and iptablesRules are replaced in the tests.
+var (
+	lookPath = exec.LookPath
+
+	iptablesVersion = func() (string, error) {
+		output, err := exec.Command("iptables", "--version").CombinedOutput()
+		return string(output), err
+	}
+
+	iptablesRules = func() (string, error) {
+		output, err := exec.Command("iptables", "-S").CombinedOutput()
+		return string(output), err
+	}
+)
+
+// SelectBackend resolves the firewall backend, the empty backend is iptables,
+// and the auto backend is resolved by the iptables and nft found on the host.
+func SelectBackend(backend string) (string, error) {
+	switch backend {
+	case "", BackendIPTables:
+		return BackendIPTables, nil
+	case BackendNFTables:
+		if _, err := lookPath("nft"); err != nil {
+			return "", fmt.Errorf("nft is required by firewall backend %s: %v", backend, err)
+		}
+		return backend, nil
+	case BackendAuto:
+	default:
+		return "", fmt.Errorf("invalid firewall backend %s, should be %s, %s or %s", backend, BackendAuto, BackendIPTables, BackendNFTables)
+	}
+
+	if _, err := lookPath("nft"); err != nil {
+		return BackendIPTables, nil
+	}
+	if _, err := lookPath("iptables"); err != nil {
+		return BackendNFTables, nil
+	}
+	// the iptables-nft translates the rules into nftables, it's better to
+	// program nftables natively. But the accept in the chains of pouchd
+	// can't override the drop of the iptables rules, such as the policy of
+	// FORWARD chain set by other tools, so iptables is kept if any rule or
+	// drop policy exists.
+	if version, err := iptablesVersion(); err != nil || !strings.Contains(version, "nf_tables") {
+		return BackendIPTables, nil
+	}
+	if rules, err := iptablesRules(); err != nil || hasIPTablesRules(rules) {
+		return BackendIPTables, nil
+	}
+	return BackendNFTables, nil
+}
+
+// hasIPTablesRules returns whether there is any rule or non-accept policy in
+// the output of iptables -S.
+func hasIPTablesRules(output string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "-P "):
+			if !strings.HasSuffix(line, " ACCEPT") {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package firewall
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PolicyChain is the chain of filter table owned by pouchd, which holds the
+// policy rules when the other rules are programmed by the bridge driver.
+const PolicyChain = "POUCH-POLICY"
+
+type iptables struct {
+	path        string
+	restorePath string
+}
+
+// NewIPTables returns the firewall programming the policy rules by iptables,
+// the IPv6 addresses are ignored since the bridge driver only programs IPv4.
+func NewIPTables() (Firewall, error) {
+	path, err := lookPath("iptables")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find iptables")
+	}
+	restorePath, err := lookPath("iptables-restore")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find iptables-restore")
+	}
+	return &iptables{path: path, restorePath: restorePath}, nil
+}
+
+// Reconcile implements Firewall.
+func (f *iptables) Reconcile(rs Ruleset) error {
+	// the chain is flushed and refilled in one transaction.
+	cmd := exec.Command(f.restorePath, "--noflush")
+	cmd.Stdin = strings.NewReader(PolicyRestoreScript(rs))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to reconcile iptables chain %s: %s", PolicyChain, output)
+	}
+	return f.ensureJump()
+}
+
+// ensureJump keeps the only jump to the policy chain at the top of FORWARD,
+// since the bridge driver inserts its rules at the top when creating network.
+func (f *iptables) ensureJump() error {
+	output, err := exec.Command(f.path, "-t", "filter", "-S", "FORWARD").CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "failed to list iptables chain FORWARD: %s", output)
+	}
+
+	jump := "-A FORWARD -j " + PolicyChain
+	var positions []int
+	position := 0
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "-A ") {
+			continue
+		}
+		position++
+		if line == jump {
+			positions = append(positions, position)
+		}
+	}
+	if len(positions) == 1 && positions[0] == 1 {
+		return nil
+	}
+
+	if output, err := exec.Command(f.path, "-t", "filter", "-I", "FORWARD", "1", "-j", PolicyChain).CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to insert jump to iptables chain %s: %s", PolicyChain, output)
+	}
+	// the stale jumps are shifted by the inserted one, and they are deleted
+	// from the bottom so that the positions are not changed.
+	for i := len(positions) - 1; i >= 0; i-- {
+		pos := strconv.Itoa(positions[i] + 1)
+		if output, err := exec.Command(f.path, "-t", "filter", "-D", "FORWARD", pos).CombinedOutput(); err != nil {
+			return errors.Wrapf(err, "failed to delete jump to iptables chain %s: %s", PolicyChain, output)
+		}
+	}
+	return nil
+}
+
+// PolicyRestoreScript returns the iptables-restore script which replaces the
+// rules of policy chain with the policy rules of networks.
+func PolicyRestoreScript(rs Ruleset) string {
+	var b bytes.Buffer
+	b.WriteString("*filter\n")
+	fmt.Fprintf(&b, ":%s - [0:0]\n", PolicyChain)
+	for _, n := range rs.Networks {
+		if len(n.Rules) == 0 {
+			continue
+		}
+
+		prefix := fmt.Sprintf("-A %s -i %s -o %s", PolicyChain, n.Bridge, n.Bridge)
+		// the replies are accepted even if the reverse traffic is denied.
+		fmt.Fprintf(&b, "%s -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT\n", prefix)
+		for _, r := range n.Rules {
+			target := "DROP"
+			if r.Accept {
+				target = "ACCEPT"
+			}
+
+			sources, ok := ipv4Addresses(r.From)
+			if !ok {
+				continue
+			}
+			destinations, ok := ipv4Addresses(r.To)
+			if !ok {
+				continue
+			}
+			for _, src := range sources {
+				for _, dst := range destinations {
+					rule := prefix
+					if src != "" {
+						rule += " -s " + src
+					}
+					if dst != "" {
+						rule += " -d " + dst
+					}
+					fmt.Fprintf(&b, "%s -j %s\n", rule, target)
+				}
+			}
+		}
+	}
+	b.WriteString("COMMIT\n")
+	return b.String()
+}
+
+// ipv4Addresses returns the IPv4 addresses to match, an empty address means
+// any address. It's false if the addresses contain no IPv4 address.
+func ipv4Addresses(addrs []*net.IPNet) ([]string, bool) {
+	if addrs == nil {
+		return []string{""}, true
+	}
+
+	var res []string
+	for _, addr := range addrs {
+		if addr.IP.To4() != nil {
+			res = append(res, addr.String())
+		}
+	}
+	return res, len(res) > 0
+}
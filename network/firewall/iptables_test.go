@@ -0,0 +1,35 @@
+package firewall
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyRestoreScript(t *testing.T) {
+	rs := Ruleset{
+		Networks: []Network{
+			{Bridge: "p0", ICC: true},
+			{
+				Bridge: "br-tenant",
+				Rules: []PolicyRule{
+					{Accept: true, From: []*net.IPNet{mustParseCIDR(t, "172.18.0.2/32"), mustParseCIDR(t, "172.18.0.4/32")}, To: []*net.IPNet{mustParseCIDR(t, "172.18.0.3/32")}},
+					// the IPv6 addresses are ignored.
+					{Accept: false, From: []*net.IPNet{mustParseCIDR(t, "fd00:2::2/128")}},
+					{Accept: false, To: []*net.IPNet{mustParseCIDR(t, "172.18.1.0/24")}},
+				},
+			},
+		},
+	}
+
+	expected := `*filter
+:POUCH-POLICY - [0:0]
+-A POUCH-POLICY -i br-tenant -o br-tenant -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT
+-A POUCH-POLICY -i br-tenant -o br-tenant -s 172.18.0.2/32 -d 172.18.0.3/32 -j ACCEPT
+-A POUCH-POLICY -i br-tenant -o br-tenant -s 172.18.0.4/32 -d 172.18.0.3/32 -j ACCEPT
+-A POUCH-POLICY -i br-tenant -o br-tenant -d 172.18.1.0/24 -j DROP
+COMMIT
+`
+	assert.Equal(t, expected, PolicyRestoreScript(rs))
+}
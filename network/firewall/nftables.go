@@ -0,0 +1,198 @@
+package firewall
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TableName is the name of the nftables table owned by pouchd, the rules in
+// the table are all replaced in one transaction when reconciling.
+const TableName = "pouch"
+
+type nftables struct {
+	path string
+}
+
+// NewNFTables returns the firewall programming the rules by nft.
+func NewNFTables() (Firewall, error) {
+	path, err := lookPath("nft")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find nft")
+	}
+	return &nftables{path: path}, nil
+}
+
+// Reconcile implements Firewall.
+func (f *nftables) Reconcile(rs Ruleset) error {
+	cmd := exec.Command(f.path, "-f", "-")
+	cmd.Stdin = strings.NewReader(Script(rs))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to reconcile nftables table %s: %s", TableName, output)
+	}
+	return nil
+}
+
+// Script returns the nft script which replaces the table owned by pouchd with
+// the ruleset. The table is created before deleted, so that the script works
+// whether the table exists or not.
+func Script(rs Ruleset) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "table inet %s\n", TableName)
+	fmt.Fprintf(&b, "delete table inet %s\n", TableName)
+	fmt.Fprintf(&b, "table inet %s {\n", TableName)
+
+	// the accepted packets still traverse the chains of other tables, so the
+	// policies are accept and only the isolation drops packets.
+	b.WriteString("\tchain forward {\n")
+	b.WriteString("\t\ttype filter hook forward priority 0; policy accept;\n")
+	for _, n := range rs.Networks {
+		var others []string
+		for _, o := range rs.Networks {
+			if o.Bridge != n.Bridge {
+				others = append(others, strconv.Quote(o.Bridge))
+			}
+		}
+		if len(others) > 0 {
+			fmt.Fprintf(&b, "\t\tiifname %q oifname { %s } drop\n", n.Bridge, strings.Join(others, ", "))
+		}
+		if n.Internal {
+			fmt.Fprintf(&b, "\t\tiifname %q oifname != %q drop\n", n.Bridge, n.Bridge)
+			fmt.Fprintf(&b, "\t\toifname %q iifname != %q drop\n", n.Bridge, n.Bridge)
+		}
+		if len(n.Rules) > 0 {
+			// the replies are accepted even if the reverse traffic is denied.
+			fmt.Fprintf(&b, "\t\tiifname %q oifname %q ct state established,related accept\n", n.Bridge, n.Bridge)
+			for _, r := range n.Rules {
+				verdict := "drop"
+				if r.Accept {
+					verdict = "accept"
+				}
+				for _, match := range policyMatches(r) {
+					fmt.Fprintf(&b, "\t\tiifname %q oifname %q %s%s\n", n.Bridge, n.Bridge, match, verdict)
+				}
+			}
+		}
+		if !n.ICC {
+			fmt.Fprintf(&b, "\t\tiifname %q oifname %q drop\n", n.Bridge, n.Bridge)
+		}
+	}
+	b.WriteString("\t}\n")
+
+	b.WriteString("\tchain prerouting {\n")
+	b.WriteString("\t\ttype nat hook prerouting priority -100; policy accept;\n")
+	b.WriteString("\t\tfib daddr type local jump portmap\n")
+	b.WriteString("\t}\n")
+
+	b.WriteString("\tchain output {\n")
+	b.WriteString("\t\ttype nat hook output priority -100; policy accept;\n")
+	b.WriteString("\t\tip daddr != 127.0.0.0/8 fib daddr type local jump portmap\n")
+	b.WriteString("\t\tip6 daddr != ::1 fib daddr type local jump portmap\n")
+	b.WriteString("\t}\n")
+
+	b.WriteString("\tchain portmap {\n")
+	for _, pm := range rs.PortMappings {
+		if rule := portMappingRule(pm); rule != "" {
+			fmt.Fprintf(&b, "\t\t%s\n", rule)
+		}
+	}
+	b.WriteString("\t}\n")
+
+	b.WriteString("\tchain postrouting {\n")
+	b.WriteString("\t\ttype nat hook postrouting priority 100; policy accept;\n")
+	for _, n := range rs.Networks {
+		if n.Internal || !n.Masquerade {
+			continue
+		}
+		for _, subnet := range n.Subnets {
+			fmt.Fprintf(&b, "\t\t%s saddr %s oifname != %q masquerade\n", family(subnet.IP), subnet, n.Bridge)
+		}
+	}
+	b.WriteString("\t}\n")
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// portMappingRule returns the DNAT rule of port mapping, it's empty if the
+// host IP and container IP are in different families.
+func portMappingRule(pm PortMapping) string {
+	ipFamily := family(pm.ContainerIP)
+
+	var daddr string
+	if pm.HostIP == nil || pm.HostIP.IsUnspecified() {
+		nfproto := "ipv4"
+		if ipFamily == "ip6" {
+			nfproto = "ipv6"
+		}
+		daddr = "meta nfproto " + nfproto
+	} else {
+		if family(pm.HostIP) != ipFamily {
+			return ""
+		}
+		daddr = fmt.Sprintf("%s daddr %s", ipFamily, pm.HostIP)
+	}
+
+	to := net.JoinHostPort(pm.ContainerIP.String(), strconv.Itoa(pm.ContainerPort))
+	return fmt.Sprintf("iifname != %q %s %s dport %d dnat %s to %s", pm.Bridge, daddr, pm.Proto, pm.HostPort, ipFamily, to)
+}
+
+// policyMatches returns the address matches of policy rule for each family,
+// the family is skipped if the rule matches no address of it.
+func policyMatches(r PolicyRule) []string {
+	if r.From == nil && r.To == nil {
+		return []string{""}
+	}
+
+	var matches []string
+	for _, fam := range []string{"ip", "ip6"} {
+		var match string
+		if r.From != nil {
+			set := addressSet(r.From, fam)
+			if set == "" {
+				continue
+			}
+			match += fmt.Sprintf("%s saddr %s ", fam, set)
+		}
+		if r.To != nil {
+			set := addressSet(r.To, fam)
+			if set == "" {
+				continue
+			}
+			match += fmt.Sprintf("%s daddr %s ", fam, set)
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// addressSet returns the anonymous set of the addresses in the family.
+func addressSet(addrs []*net.IPNet, fam string) string {
+	var elems []string
+	for _, addr := range addrs {
+		if family(addr.IP) == fam {
+			elems = append(elems, addr.String())
+		}
+	}
+	switch len(elems) {
+	case 0:
+		return ""
+	case 1:
+		return elems[0]
+	default:
+		return "{ " + strings.Join(elems, ", ") + " }"
+	}
+}
+
+// family returns the nftables family of the IP.
+func family(ip net.IP) string {
+	if ip.To4() != nil {
+		return "ip"
+	}
+	return "ip6"
+}
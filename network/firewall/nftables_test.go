@@ -0,0 +1,158 @@
+package firewall
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseCIDR(t *testing.T, s string) *net.IPNet {
+	_, subnet, err := net.ParseCIDR(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return subnet
+}
+
+func TestScript(t *testing.T) {
+	rs := Ruleset{
+		Networks: []Network{
+			{
+				Bridge:     "p0",
+				Subnets:    []*net.IPNet{mustParseCIDR(t, "172.17.0.0/16"), mustParseCIDR(t, "fd00:1::/64")},
+				ICC:        true,
+				Masquerade: true,
+			},
+			{
+				Bridge:     "br-internal",
+				Subnets:    []*net.IPNet{mustParseCIDR(t, "172.18.0.0/16")},
+				Internal:   true,
+				Masquerade: true,
+			},
+		},
+		PortMappings: []PortMapping{
+			{Bridge: "p0", Proto: "tcp", HostPort: 8080, ContainerIP: net.ParseIP("172.17.0.2"), ContainerPort: 80},
+			{Bridge: "p0", Proto: "udp", HostIP: net.ParseIP("10.0.0.1"), HostPort: 53, ContainerIP: net.ParseIP("172.17.0.3"), ContainerPort: 53},
+			{Bridge: "p0", Proto: "tcp", HostIP: net.ParseIP("::"), HostPort: 8443, ContainerIP: net.ParseIP("fd00:1::2"), ContainerPort: 443},
+			// the families of host IP and container IP mismatch.
+			{Bridge: "p0", Proto: "tcp", HostIP: net.ParseIP("10.0.0.1"), HostPort: 9000, ContainerIP: net.ParseIP("fd00:1::2"), ContainerPort: 9000},
+		},
+	}
+
+	expected := `table inet pouch
+delete table inet pouch
+table inet pouch {
+	chain forward {
+		type filter hook forward priority 0; policy accept;
+		iifname "p0" oifname { "br-internal" } drop
+		iifname "br-internal" oifname { "p0" } drop
+		iifname "br-internal" oifname != "br-internal" drop
+		oifname "br-internal" iifname != "br-internal" drop
+		iifname "br-internal" oifname "br-internal" drop
+	}
+	chain prerouting {
+		type nat hook prerouting priority -100; policy accept;
+		fib daddr type local jump portmap
+	}
+	chain output {
+		type nat hook output priority -100; policy accept;
+		ip daddr != 127.0.0.0/8 fib daddr type local jump portmap
+		ip6 daddr != ::1 fib daddr type local jump portmap
+	}
+	chain portmap {
+		iifname != "p0" meta nfproto ipv4 tcp dport 8080 dnat ip to 172.17.0.2:80
+		iifname != "p0" ip daddr 10.0.0.1 udp dport 53 dnat ip to 172.17.0.3:53
+		iifname != "p0" meta nfproto ipv6 tcp dport 8443 dnat ip6 to [fd00:1::2]:443
+	}
+	chain postrouting {
+		type nat hook postrouting priority 100; policy accept;
+		ip saddr 172.17.0.0/16 oifname != "p0" masquerade
+		ip6 saddr fd00:1::/64 oifname != "p0" masquerade
+	}
+}
+`
+	assert.Equal(t, expected, Script(rs))
+}
+
+func TestScriptPolicy(t *testing.T) {
+	rs := Ruleset{
+		Networks: []Network{
+			{
+				Bridge: "br-tenant",
+				Rules: []PolicyRule{
+					{Accept: true, From: []*net.IPNet{mustParseCIDR(t, "172.18.0.2/32"), mustParseCIDR(t, "fd00:2::2/128")}, To: []*net.IPNet{mustParseCIDR(t, "172.18.0.3/32")}},
+					{Accept: false, To: []*net.IPNet{mustParseCIDR(t, "172.18.0.0/24"), mustParseCIDR(t, "172.18.1.0/24")}},
+					{Accept: true},
+				},
+			},
+		},
+	}
+
+	expected := `	chain forward {
+		type filter hook forward priority 0; policy accept;
+		iifname "br-tenant" oifname "br-tenant" ct state established,related accept
+		iifname "br-tenant" oifname "br-tenant" ip saddr 172.18.0.2/32 ip daddr 172.18.0.3/32 accept
+		iifname "br-tenant" oifname "br-tenant" ip daddr { 172.18.0.0/24, 172.18.1.0/24 } drop
+		iifname "br-tenant" oifname "br-tenant" accept
+		iifname "br-tenant" oifname "br-tenant" drop
+	}
+`
+	assert.Contains(t, Script(rs), expected)
+}
+
+func TestSelectBackend(t *testing.T) {
+	defer func(l func(string) (string, error), v, r func() (string, error)) {
+		lookPath, iptablesVersion, iptablesRules = l, v, r
+	}(lookPath, iptablesVersion, iptablesRules)
+
+	const (
+		emptyRules   = "-P INPUT ACCEPT\n-P FORWARD ACCEPT\n-P OUTPUT ACCEPT\n"
+		forwardDrop  = "-P INPUT ACCEPT\n-P FORWARD DROP\n-P OUTPUT ACCEPT\n"
+		dockerChains = emptyRules + "-N DOCKER\n-A FORWARD -j DOCKER\n"
+	)
+
+	for _, tc := range []struct {
+		backend  string
+		binaries map[string]bool
+		version  string
+		rules    string
+		expected string
+		wantErr  bool
+	}{
+		{backend: "iptables", expected: "iptables"},
+		{backend: "", binaries: map[string]bool{"nft": true, "iptables": true}, version: "iptables v1.8.7 (nf_tables)", rules: emptyRules, expected: "iptables"},
+		{backend: "nftables", binaries: map[string]bool{"nft": true}, expected: "nftables"},
+		{backend: "nftables", wantErr: true},
+		{backend: "ebtables", wantErr: true},
+		{backend: "auto", binaries: map[string]bool{"iptables": true}, expected: "iptables"},
+		{backend: "auto", binaries: map[string]bool{"nft": true}, expected: "nftables"},
+		{backend: "auto", binaries: map[string]bool{"nft": true, "iptables": true}, version: "iptables v1.8.7 (nf_tables)", rules: emptyRules, expected: "nftables"},
+		{backend: "auto", binaries: map[string]bool{"nft": true, "iptables": true}, version: "iptables v1.8.7 (nf_tables)", rules: forwardDrop, expected: "iptables"},
+		{backend: "auto", binaries: map[string]bool{"nft": true, "iptables": true}, version: "iptables v1.8.7 (nf_tables)", rules: dockerChains, expected: "iptables"},
+		{backend: "auto", binaries: map[string]bool{"nft": true, "iptables": true}, version: "iptables v1.8.7 (legacy)", expected: "iptables"},
+	} {
+		binaries, version, rules := tc.binaries, tc.version, tc.rules
+		lookPath = func(file string) (string, error) {
+			if binaries[file] {
+				return "/usr/sbin/" + file, nil
+			}
+			return "", errors.New("not found")
+		}
+		iptablesVersion = func() (string, error) {
+			return version, nil
+		}
+		iptablesRules = func() (string, error) {
+			return rules, nil
+		}
+
+		backend, err := SelectBackend(tc.backend)
+		if tc.wantErr {
+			assert.Error(t, err, tc.backend)
+			continue
+		}
+		assert.NoError(t, err, tc.backend)
+		assert.Equal(t, tc.expected, backend, tc.backend)
+	}
+}
@@ -0,0 +1,390 @@
+package hostdev
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/ioutils"
+	"github.com/docker/libnetwork/driverapi"
+	"github.com/docker/libnetwork/drivers/remote/api"
+	"github.com/docker/libnetwork/netlabel"
+)
+
+// pluginContentType is the content type of the requests and responses of
+// network driver plugin.
+const pluginContentType = "application/vnd.docker.plugins.v1+json"
+
+// network is the network whose endpoints are assigned the host devices.
+type network struct {
+	Parent      string `json:"parent,omitempty"`
+	Gateway     string `json:"gateway,omitempty"`
+	GatewayIPv6 string `json:"gateway-ipv6,omitempty"`
+}
+
+// endpoint is the host device assigned to the endpoint.
+type endpoint struct {
+	NetworkID string `json:"network-id"`
+	Device    string `json:"device"`
+	// VF is the index of VF of parent, -1 if the device is a NIC of host.
+	VF int `json:"vf"`
+	// Vlan is whether the VLAN tagging of VF is set by the endpoint.
+	Vlan bool `json:"vlan,omitempty"`
+	// Trust is whether the VF is trusted by the endpoint.
+	Trust bool `json:"trust,omitempty"`
+}
+
+// state is the state of driver, which is persisted since libnetwork doesn't
+// recreate the networks and endpoints of remote driver after pouchd restarts.
+type state struct {
+	Networks  map[string]*network  `json:"networks"`
+	Endpoints map[string]*endpoint `json:"endpoints"`
+}
+
+// Driver is the hostdev network driver served by pouchd in the protocol of
+// libnetwork remote driver.
+type Driver struct {
+	sync.Mutex
+
+	statePath string
+	state     state
+	mux       *http.ServeMux
+}
+
+// New returns the driver whose state is stored in statePath.
+func New(statePath string) (*Driver, error) {
+	d := &Driver{
+		statePath: statePath,
+		state: state{
+			Networks:  make(map[string]*network),
+			Endpoints: make(map[string]*endpoint),
+		},
+	}
+
+	data, err := ioutil.ReadFile(statePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read state of %s driver: %v", DriverName, err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &d.state); err != nil {
+			return nil, fmt.Errorf("failed to parse state of %s driver: %v", DriverName, err)
+		}
+	}
+
+	d.mux = http.NewServeMux()
+	d.handle("GetCapabilities", nil, func(interface{}) (interface{}, error) {
+		return &api.GetCapabilityResponse{Scope: "local", ConnectivityScope: "local"}, nil
+	})
+	d.handle("CreateNetwork", func() interface{} { return &api.CreateNetworkRequest{} }, d.createNetwork)
+	d.handle("DeleteNetwork", func() interface{} { return &api.DeleteNetworkRequest{} }, d.deleteNetwork)
+	d.handle("CreateEndpoint", func() interface{} { return &api.CreateEndpointRequest{} }, d.createEndpoint)
+	d.handle("DeleteEndpoint", func() interface{} { return &api.DeleteEndpointRequest{} }, d.deleteEndpoint)
+	d.handle("Join", func() interface{} { return &api.JoinRequest{} }, d.join)
+
+	// the device is moved back to host by libnetwork when leaving sandbox.
+	for _, method := range []string{"Leave", "EndpointOperInfo", "DiscoverNew", "DiscoverDelete",
+		"ProgramExternalConnectivity", "RevokeExternalConnectivity", "AllocateNetwork", "FreeNetwork"} {
+		d.handle(method, nil, func(interface{}) (interface{}, error) {
+			return &api.Response{}, nil
+		})
+	}
+	return d, nil
+}
+
+// Serve serves the driver on the listener until it's closed.
+func (d *Driver) Serve(l net.Listener) error {
+	return http.Serve(l, d.mux)
+}
+
+// handle registers the handler of method of network driver, the request is
+// decoded into the one allocated by newReq if it's not nil.
+func (d *Driver) handle(method string, newReq func() interface{}, fn func(req interface{}) (interface{}, error)) {
+	method = driverapi.NetworkPluginEndpointType + "." + method
+	d.mux.HandleFunc("/"+method, func(w http.ResponseWriter, r *http.Request) {
+		var req interface{}
+		if newReq != nil {
+			req = newReq()
+		}
+
+		var resp interface{}
+		if req != nil {
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				resp = &api.Response{Err: fmt.Sprintf("failed to decode request of %s: %v", method, err)}
+			}
+		}
+		if resp == nil {
+			var err error
+			if resp, err = fn(req); err != nil {
+				log.With(nil).Errorf("failed to handle %s of %s driver: %v", method, DriverName, err)
+				resp = &api.Response{Err: err.Error()}
+			}
+		}
+
+		w.Header().Set("Content-Type", pluginContentType)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			log.With(nil).Warnf("failed to encode response of %s: %v", method, err)
+		}
+	})
+}
+
+// save persists the state of driver, it's called with the lock held.
+func (d *Driver) save() error {
+	data, err := json.Marshal(d.state)
+	if err != nil {
+		return err
+	}
+	if err := ioutils.AtomicWriteFile(d.statePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to save state of %s driver: %v", DriverName, err)
+	}
+	return nil
+}
+
+func (d *Driver) createNetwork(r interface{}) (interface{}, error) {
+	req := r.(*api.CreateNetworkRequest)
+
+	opts := make(map[string]string)
+	if generic, ok := req.Options[netlabel.GenericData].(map[string]interface{}); ok {
+		for k, v := range generic {
+			if s, ok := v.(string); ok {
+				opts[k] = s
+			}
+		}
+	}
+	if err := ValidateNetworkOptions(opts); err != nil {
+		return nil, err
+	}
+
+	n := &network{Parent: opts[ParentOpt]}
+	// the containers on internal network have no default route.
+	if internal, _ := req.Options[netlabel.Internal].(bool); !internal {
+		for _, data := range req.IPv4Data {
+			if data.Gateway != nil {
+				n.Gateway = data.Gateway.IP.String()
+				break
+			}
+		}
+		for _, data := range req.IPv6Data {
+			if data.Gateway != nil {
+				n.GatewayIPv6 = data.Gateway.IP.String()
+				break
+			}
+		}
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.state.Networks[req.NetworkID] = n
+	return &api.CreateNetworkResponse{}, d.save()
+}
+
+func (d *Driver) deleteNetwork(r interface{}) (interface{}, error) {
+	req := r.(*api.DeleteNetworkRequest)
+
+	d.Lock()
+	defer d.Unlock()
+
+	delete(d.state.Networks, req.NetworkID)
+	return &api.DeleteNetworkResponse{}, d.save()
+}
+
+// inUse returns whether the device is assigned to any endpoint, it's called
+// with the lock held.
+func (d *Driver) inUse(device string) bool {
+	for _, ep := range d.state.Endpoints {
+		if ep.Device == device {
+			return true
+		}
+	}
+	return false
+}
+
+// allocateDevice returns the device and the index of VF assigned to the
+// endpoint, it's called with the lock held.
+func (d *Driver) allocateDevice(n *network, cfg *endpointConfig) (string, int, error) {
+	if n.Parent == "" {
+		if !deviceExists(cfg.device) {
+			return "", -1, fmt.Errorf("device %s doesn't exist on host", cfg.device)
+		}
+		if d.inUse(cfg.device) {
+			return "", -1, fmt.Errorf("device %s is in use by another endpoint", cfg.device)
+		}
+		return cfg.device, -1, nil
+	}
+
+	if cfg.device != "" {
+		vf, ok := vfIndex(n.Parent, cfg.device)
+		if !ok {
+			return "", -1, fmt.Errorf("device %s is not a VF of %s on host", cfg.device, n.Parent)
+		}
+		if d.inUse(cfg.device) {
+			return "", -1, fmt.Errorf("device %s is in use by another endpoint", cfg.device)
+		}
+		return cfg.device, vf, nil
+	}
+
+	if cfg.vf >= 0 {
+		device := vfDevice(n.Parent, cfg.vf)
+		if device == "" {
+			return "", -1, fmt.Errorf("VF %d of %s has no network device on host", cfg.vf, n.Parent)
+		}
+		if d.inUse(device) {
+			return "", -1, fmt.Errorf("VF %d of %s is in use by another endpoint", cfg.vf, n.Parent)
+		}
+		return device, cfg.vf, nil
+	}
+
+	for _, vf := range vfIndexes(n.Parent) {
+		if device := vfDevice(n.Parent, vf); device != "" && !d.inUse(device) {
+			return device, vf, nil
+		}
+	}
+	return "", -1, fmt.Errorf("no free VF of %s on host", n.Parent)
+}
+
+func (d *Driver) createEndpoint(r interface{}) (interface{}, error) {
+	req := r.(*api.CreateEndpointRequest)
+
+	d.Lock()
+	defer d.Unlock()
+
+	n, ok := d.state.Networks[req.NetworkID]
+	if !ok {
+		return nil, fmt.Errorf("network %s not found", req.NetworkID)
+	}
+	cfg, err := parseEndpointOptions(req.Options, n.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// the MAC address of container is set on the VF so that the frames are
+	// not dropped by the spoof checking of physical function.
+	var reqMac net.HardwareAddr
+	if req.Interface != nil && req.Interface.MacAddress != "" {
+		if reqMac, err = net.ParseMAC(req.Interface.MacAddress); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.mac != nil && reqMac != nil && cfg.mac.String() != reqMac.String() {
+		return nil, fmt.Errorf("option %s %s conflicts with MAC address %s of container", MacOpt, cfg.mac, reqMac)
+	}
+	if cfg.mac == nil && n.Parent != "" {
+		cfg.mac = reqMac
+	}
+
+	device, vf, err := d.allocateDevice(n, cfg)
+	if err != nil {
+		return nil, err
+	}
+	ep := &endpoint{NetworkID: req.NetworkID, Device: device, VF: vf}
+
+	if vf >= 0 {
+		if cfg.mac != nil {
+			if err := setVfHardwareAddr(n.Parent, vf, cfg.mac); err != nil {
+				return nil, fmt.Errorf("failed to set MAC address of VF %d of %s: %v", vf, n.Parent, err)
+			}
+		}
+		if cfg.vlan >= 0 {
+			if err := setVfVlan(n.Parent, vf, cfg.vlan); err != nil {
+				return nil, fmt.Errorf("failed to set VLAN of VF %d of %s: %v", vf, n.Parent, err)
+			}
+			ep.Vlan = cfg.vlan > 0
+		}
+		if cfg.trust != nil {
+			if err := setVfTrust(n.Parent, vf, *cfg.trust); err != nil {
+				return nil, fmt.Errorf("failed to set trust of VF %d of %s: %v", vf, n.Parent, err)
+			}
+			ep.Trust = *cfg.trust
+		}
+	}
+
+	d.state.Endpoints[req.EndpointID] = ep
+	if err := d.save(); err != nil {
+		delete(d.state.Endpoints, req.EndpointID)
+		d.releaseDevice(n, ep)
+		return nil, err
+	}
+
+	resp := &api.CreateEndpointResponse{}
+	// report the MAC address of device if container doesn't specify it.
+	if reqMac == nil {
+		mac := cfg.mac
+		if mac == nil {
+			mac, _ = deviceAddress(device)
+		}
+		if mac != nil {
+			resp.Interface = &api.EndpointInterface{MacAddress: mac.String()}
+		}
+	}
+	return resp, nil
+}
+
+// releaseDevice restores the settings of device changed by the endpoint.
+func (d *Driver) releaseDevice(n *network, ep *endpoint) {
+	if ep.VF >= 0 && n != nil {
+		if ep.Vlan {
+			if err := setVfVlan(n.Parent, ep.VF, 0); err != nil {
+				log.With(nil).Warnf("failed to reset VLAN of VF %d of %s: %v", ep.VF, n.Parent, err)
+			}
+		}
+		if ep.Trust {
+			if err := setVfTrust(n.Parent, ep.VF, false); err != nil {
+				log.With(nil).Warnf("failed to reset trust of VF %d of %s: %v", ep.VF, n.Parent, err)
+			}
+		}
+	}
+
+	if deviceExists(ep.Device) {
+		if err := flushAddrs(ep.Device); err != nil {
+			log.With(nil).Warnf("failed to flush addresses of device %s: %v", ep.Device, err)
+		}
+	}
+}
+
+func (d *Driver) deleteEndpoint(r interface{}) (interface{}, error) {
+	req := r.(*api.DeleteEndpointRequest)
+
+	d.Lock()
+	defer d.Unlock()
+
+	ep, ok := d.state.Endpoints[req.EndpointID]
+	if !ok {
+		return &api.DeleteEndpointResponse{}, nil
+	}
+	d.releaseDevice(d.state.Networks[ep.NetworkID], ep)
+
+	delete(d.state.Endpoints, req.EndpointID)
+	return &api.DeleteEndpointResponse{}, d.save()
+}
+
+func (d *Driver) join(r interface{}) (interface{}, error) {
+	req := r.(*api.JoinRequest)
+
+	d.Lock()
+	defer d.Unlock()
+
+	ep, ok := d.state.Endpoints[req.EndpointID]
+	if !ok {
+		return nil, fmt.Errorf("endpoint %s not found", req.EndpointID)
+	}
+	n, ok := d.state.Networks[ep.NetworkID]
+	if !ok {
+		return nil, fmt.Errorf("network %s not found", ep.NetworkID)
+	}
+	if !deviceExists(ep.Device) {
+		return nil, fmt.Errorf("device %s of endpoint doesn't exist on host", ep.Device)
+	}
+
+	// the device is moved into sandbox and renamed by libnetwork.
+	return &api.JoinResponse{
+		InterfaceName: &api.InterfaceName{SrcName: ep.Device, DstPrefix: "eth"},
+		Gateway:       n.Gateway,
+		GatewayIPv6:   n.GatewayIPv6,
+	}, nil
+}
@@ -0,0 +1,224 @@
+// Package hostdev implements the network driver which moves the host devices
+// into the network namespace of containers for the low-latency workloads,
+// the device is either a NIC of host or a VF of the SR-IOV physical function.
+package hostdev
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	// DriverName is the name of the network driver.
+	DriverName = "hostdev"
+
+	// ParentOpt is the network option of SR-IOV physical function, whose VFs
+	// are assigned to the endpoints of network. The endpoints of network
+	// without parent are assigned the NICs of host.
+	ParentOpt = "parent"
+
+	// DeviceOpt is the endpoint option of the NIC or VF moved into container.
+	DeviceOpt = "hostdev.device"
+	// VFOpt is the endpoint option of the index of VF moved into container.
+	VFOpt = "hostdev.vf"
+	// VlanOpt is the endpoint option of the VLAN ID tagged by the VF.
+	VlanOpt = "hostdev.vlan"
+	// MacOpt is the endpoint option of the MAC address of the VF.
+	MacOpt = "hostdev.mac"
+	// TrustOpt is the endpoint option of whether the VF is trusted, which
+	// is allowed to change its MAC address and enter promiscuous mode.
+	TrustOpt = "hostdev.trust"
+
+	// maxVlan is the largest VLAN ID, 0 disables the VLAN tagging.
+	maxVlan = 4094
+)
+
+var (
+	// sysfsNet is the directory of network devices in sysfs.
+	sysfsNet = "/sys/class/net"
+
+	// the VF settings are programmed on the physical function.
+	setVfVlan = func(pf string, vf, vlan int) error {
+		link, err := netlink.LinkByName(pf)
+		if err != nil {
+			return err
+		}
+		return netlink.LinkSetVfVlan(link, vf, vlan)
+	}
+	setVfHardwareAddr = func(pf string, vf int, mac net.HardwareAddr) error {
+		link, err := netlink.LinkByName(pf)
+		if err != nil {
+			return err
+		}
+		return netlink.LinkSetVfHardwareAddr(link, vf, mac)
+	}
+	setVfTrust = func(pf string, vf int, trust bool) error {
+		link, err := netlink.LinkByName(pf)
+		if err != nil {
+			return err
+		}
+		return netlink.LinkSetVfTrust(link, vf, trust)
+	}
+
+	// flushAddrs removes the addresses left on the device by the container.
+	flushAddrs = func(device string) error {
+		link, err := netlink.LinkByName(device)
+		if err != nil {
+			return err
+		}
+		addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+		if err != nil {
+			return err
+		}
+		for i := range addrs {
+			if err := netlink.AddrDel(link, &addrs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+)
+
+// endpointConfig is the configuration of endpoint parsed from its options.
+type endpointConfig struct {
+	device string
+	// vf is the index of VF, -1 means any free VF.
+	vf int
+	// vlan is the VLAN ID of VF, -1 means it's not specified.
+	vlan  int
+	mac   net.HardwareAddr
+	trust *bool
+}
+
+// ValidateNetworkOptions checks the driver options of network, the parent
+// must be an SR-IOV physical function of host.
+func ValidateNetworkOptions(opts map[string]string) error {
+	parent, ok := opts[ParentOpt]
+	if !ok {
+		return nil
+	}
+	if parent == "" {
+		return fmt.Errorf("option %s of %s network can't be empty", ParentOpt, DriverName)
+	}
+	if !deviceExists(parent) {
+		return fmt.Errorf("parent %s of %s network doesn't exist", parent, DriverName)
+	}
+	if _, err := ioutil.ReadFile(filepath.Join(sysfsNet, parent, "device", "sriov_totalvfs")); err != nil {
+		return fmt.Errorf("parent %s of %s network doesn't support SR-IOV", parent, DriverName)
+	}
+	return nil
+}
+
+// parseEndpointOptions parses the endpoint options of the network, the VF
+// settings are only supported if network has the parent.
+func parseEndpointOptions(opts map[string]interface{}, parent string) (*endpointConfig, error) {
+	str := func(key string) (string, bool) {
+		v, ok := opts[key].(string)
+		return v, ok && v != ""
+	}
+
+	cfg := &endpointConfig{vf: -1, vlan: -1}
+	cfg.device, _ = str(DeviceOpt)
+
+	if v, ok := str(VFOpt); ok {
+		vf, err := strconv.Atoi(v)
+		if err != nil || vf < 0 {
+			return nil, fmt.Errorf("invalid %s %s, should be non-negative integer", VFOpt, v)
+		}
+		cfg.vf = vf
+	}
+	if v, ok := str(VlanOpt); ok {
+		vlan, err := strconv.Atoi(v)
+		if err != nil || vlan < 0 || vlan > maxVlan {
+			return nil, fmt.Errorf("invalid %s %s, should be in range 0-%d", VlanOpt, v, maxVlan)
+		}
+		cfg.vlan = vlan
+	}
+	if v, ok := str(MacOpt); ok {
+		mac, err := net.ParseMAC(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %s: %v", MacOpt, v, err)
+		}
+		cfg.mac = mac
+	}
+	if v, ok := str(TrustOpt); ok {
+		trust, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %s, should be true or false", TrustOpt, v)
+		}
+		cfg.trust = &trust
+	}
+
+	if parent == "" {
+		if cfg.device == "" {
+			return nil, fmt.Errorf("option %s is required by %s network without %s", DeviceOpt, DriverName, ParentOpt)
+		}
+		if cfg.vf >= 0 || cfg.vlan >= 0 || cfg.mac != nil || cfg.trust != nil {
+			return nil, fmt.Errorf("options %s, %s, %s and %s are only supported by %s network with %s",
+				VFOpt, VlanOpt, MacOpt, TrustOpt, DriverName, ParentOpt)
+		}
+		return cfg, nil
+	}
+
+	if cfg.device != "" && cfg.vf >= 0 {
+		return nil, fmt.Errorf("options %s and %s can't be specified together", DeviceOpt, VFOpt)
+	}
+	return cfg, nil
+}
+
+// deviceExists returns whether the network device is in the namespace of host.
+func deviceExists(device string) bool {
+	_, err := os.Stat(filepath.Join(sysfsNet, device))
+	return err == nil
+}
+
+// deviceAddress returns the MAC address of the network device.
+func deviceAddress(device string) (net.HardwareAddr, error) {
+	data, err := ioutil.ReadFile(filepath.Join(sysfsNet, device, "address"))
+	if err != nil {
+		return nil, err
+	}
+	return net.ParseMAC(strings.TrimSpace(string(data)))
+}
+
+// vfDevice returns the network device of VF, which is empty if the VF is
+// bound to no network driver or it's moved into container.
+func vfDevice(pf string, vf int) string {
+	entries, err := ioutil.ReadDir(filepath.Join(sysfsNet, pf, "device", "virtfn"+strconv.Itoa(vf), "net"))
+	if err != nil || len(entries) == 0 {
+		return ""
+	}
+	return entries[0].Name()
+}
+
+// vfIndexes returns the indexes of VFs of the physical function in order.
+func vfIndexes(pf string) []int {
+	matches, _ := filepath.Glob(filepath.Join(sysfsNet, pf, "device", "virtfn*"))
+
+	var indexes []int
+	for _, m := range matches {
+		if vf, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(m), "virtfn")); err == nil {
+			indexes = append(indexes, vf)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// vfIndex returns the index of VF by its network device.
+func vfIndex(pf, device string) (int, bool) {
+	for _, vf := range vfIndexes(pf) {
+		if vfDevice(pf, vf) == device {
+			return vf, true
+		}
+	}
+	return -1, false
+}
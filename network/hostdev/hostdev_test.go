@@ -0,0 +1,170 @@
+package hostdev
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/libnetwork/driverapi"
+	"github.com/docker/libnetwork/drivers/remote/api"
+	"github.com/docker/libnetwork/netlabel"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSysfs creates the physical function pf0 with the VFs vf0 and vf1, and
+// the NIC eth1 of host.
+func fakeSysfs(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "hostdev")
+	assert.NoError(t, err)
+
+	write := func(path, content string) {
+		path = filepath.Join(dir, path)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+	write("pf0/device/sriov_totalvfs", "8\n")
+	write("pf0/device/virtfn0/net/vf0/ifindex", "10\n")
+	write("pf0/device/virtfn1/net/vf1/ifindex", "11\n")
+	write("vf0/address", "02:00:00:00:00:10\n")
+	write("vf1/address", "02:00:00:00:00:11\n")
+	write("eth1/address", "02:00:00:00:00:01\n")
+
+	origin := sysfsNet
+	sysfsNet = dir
+	return func() {
+		sysfsNet = origin
+		os.RemoveAll(dir)
+	}
+}
+
+func TestValidateNetworkOptions(t *testing.T) {
+	defer fakeSysfs(t)()
+
+	assert.NoError(t, ValidateNetworkOptions(nil))
+	assert.NoError(t, ValidateNetworkOptions(map[string]string{ParentOpt: "pf0"}))
+
+	for _, parent := range []string{"", "eth1", "eth2"} {
+		assert.Error(t, ValidateNetworkOptions(map[string]string{ParentOpt: parent}), parent)
+	}
+}
+
+func TestParseEndpointOptions(t *testing.T) {
+	cfg, err := parseEndpointOptions(map[string]interface{}{
+		VFOpt:    "1",
+		VlanOpt:  "100",
+		MacOpt:   "02:42:ac:11:00:02",
+		TrustOpt: "true",
+	}, "pf0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cfg.vf)
+	assert.Equal(t, 100, cfg.vlan)
+	assert.Equal(t, "02:42:ac:11:00:02", cfg.mac.String())
+	assert.True(t, *cfg.trust)
+
+	cfg, err = parseEndpointOptions(nil, "pf0")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, cfg.vf)
+	assert.Equal(t, -1, cfg.vlan)
+
+	for _, opts := range []map[string]interface{}{
+		{VFOpt: "-1"},
+		{VlanOpt: "4095"},
+		{MacOpt: "02:42"},
+		{TrustOpt: "yes"},
+		{DeviceOpt: "vf0", VFOpt: "0"},
+	} {
+		_, err := parseEndpointOptions(opts, "pf0")
+		assert.Error(t, err, "%v", opts)
+	}
+
+	_, err = parseEndpointOptions(map[string]interface{}{DeviceOpt: "eth1"}, "")
+	assert.NoError(t, err)
+	for _, opts := range []map[string]interface{}{
+		nil,
+		{DeviceOpt: "eth1", VlanOpt: "100"},
+	} {
+		_, err := parseEndpointOptions(opts, "")
+		assert.Error(t, err, "%v", opts)
+	}
+}
+
+func TestDriverEndpoints(t *testing.T) {
+	defer fakeSysfs(t)()
+
+	vlans := make(map[int]int)
+	trusts := make(map[int]bool)
+	macs := make(map[int]string)
+	originVlan, originTrust, originMac, originFlush := setVfVlan, setVfTrust, setVfHardwareAddr, flushAddrs
+	setVfVlan = func(pf string, vf, vlan int) error { vlans[vf] = vlan; return nil }
+	setVfTrust = func(pf string, vf int, trust bool) error { trusts[vf] = trust; return nil }
+	setVfHardwareAddr = func(pf string, vf int, mac net.HardwareAddr) error { macs[vf] = mac.String(); return nil }
+	flushAddrs = func(device string) error { return nil }
+	defer func() {
+		setVfVlan, setVfTrust, setVfHardwareAddr, flushAddrs = originVlan, originTrust, originMac, originFlush
+	}()
+
+	statePath := filepath.Join(sysfsNet, "state.json")
+	d, err := New(statePath)
+	assert.NoError(t, err)
+
+	ip, gateway, _ := net.ParseCIDR("192.168.1.1/24")
+	gateway.IP = ip
+	_, err = d.createNetwork(&api.CreateNetworkRequest{
+		NetworkID: "n1",
+		Options:   map[string]interface{}{netlabel.GenericData: map[string]interface{}{ParentOpt: "pf0"}},
+		IPv4Data:  []driverapi.IPAMData{{Gateway: gateway}},
+	})
+	assert.NoError(t, err)
+
+	// the free VFs are assigned in order.
+	resp, err := d.createEndpoint(&api.CreateEndpointRequest{
+		NetworkID:  "n1",
+		EndpointID: "e1",
+		Interface:  &api.EndpointInterface{},
+		Options:    map[string]interface{}{VlanOpt: "100", TrustOpt: "true"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "02:00:00:00:00:10", resp.(*api.CreateEndpointResponse).Interface.MacAddress)
+	assert.Equal(t, 100, vlans[0])
+	assert.True(t, trusts[0])
+
+	_, err = d.createEndpoint(&api.CreateEndpointRequest{
+		NetworkID:  "n1",
+		EndpointID: "e2",
+		Interface:  &api.EndpointInterface{MacAddress: "02:42:ac:11:00:02"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "02:42:ac:11:00:02", macs[1])
+
+	_, err = d.createEndpoint(&api.CreateEndpointRequest{NetworkID: "n1", EndpointID: "e3"})
+	assert.Error(t, err)
+
+	resp, err = d.join(&api.JoinRequest{NetworkID: "n1", EndpointID: "e1"})
+	assert.NoError(t, err)
+	join := resp.(*api.JoinResponse)
+	assert.Equal(t, "vf0", join.InterfaceName.SrcName)
+	assert.Equal(t, "192.168.1.1", join.Gateway)
+
+	// the state is restored after pouchd restarts.
+	d, err = New(statePath)
+	assert.NoError(t, err)
+	_, err = d.deleteEndpoint(&api.DeleteEndpointRequest{NetworkID: "n1", EndpointID: "e1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, vlans[0])
+	assert.False(t, trusts[0])
+
+	_, err = d.createEndpoint(&api.CreateEndpointRequest{
+		NetworkID:  "n1",
+		EndpointID: "e3",
+		Options:    map[string]interface{}{DeviceOpt: "vf1"},
+	})
+	assert.Error(t, err)
+	_, err = d.createEndpoint(&api.CreateEndpointRequest{
+		NetworkID:  "n1",
+		EndpointID: "e3",
+		Options:    map[string]interface{}{VFOpt: "0"},
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,88 @@
+package proxy
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ishidawataru/sctp"
+)
+
+// CommandName is the name with which pouchd runs as the userland proxy, the
+// bridge driver starts a proxy process for each published port.
+const CommandName = "pouch-proxy"
+
+// Main is the entry of userland proxy process, it's compatible with the
+// arguments passed by the port mapper of libnetwork. The result of starting
+// proxy is reported to the parent by the pipe of fd 3.
+func Main() {
+	f := os.NewFile(3, "signal-parent")
+
+	host, container, err := parseHostContainerAddrs(os.Args[1:])
+	if err == nil {
+		var p Proxy
+		if p, err = NewProxy(host, container); err == nil {
+			fmt.Fprint(f, "0\n")
+			f.Close()
+
+			go handleStopSignals(p)
+			// Run blocks until the proxy is closed.
+			p.Run()
+			os.Exit(0)
+		}
+	}
+
+	fmt.Fprintf(f, "1\n%s", err)
+	f.Close()
+	os.Exit(1)
+}
+
+// parseHostContainerAddrs parses the flags passed by the port mapper.
+func parseHostContainerAddrs(args []string) (host net.Addr, container net.Addr, err error) {
+	var (
+		flags         = flag.NewFlagSet(CommandName, flag.ContinueOnError)
+		proto         = flags.String("proto", "tcp", "proxy protocol")
+		hostIP        = flags.String("host-ip", "", "host ip")
+		hostPort      = flags.Int("host-port", -1, "host port")
+		containerIP   = flags.String("container-ip", "", "container ip")
+		containerPort = flags.Int("container-port", -1, "container port")
+	)
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	hIP, cIP := net.ParseIP(*hostIP), net.ParseIP(*containerIP)
+	if hIP == nil || cIP == nil {
+		return nil, nil, fmt.Errorf("invalid host ip %q or container ip %q", *hostIP, *containerIP)
+	}
+
+	switch *proto {
+	case "tcp":
+		host = &net.TCPAddr{IP: hIP, Port: *hostPort}
+		container = &net.TCPAddr{IP: cIP, Port: *containerPort}
+	case "udp":
+		host = &net.UDPAddr{IP: hIP, Port: *hostPort}
+		container = &net.UDPAddr{IP: cIP, Port: *containerPort}
+	case "sctp":
+		host = &sctp.SCTPAddr{IP: []net.IP{hIP}, Port: *hostPort}
+		container = &sctp.SCTPAddr{IP: []net.IP{cIP}, Port: *containerPort}
+	default:
+		return nil, nil, fmt.Errorf("unsupported protocol %s", *proto)
+	}
+	return host, container, nil
+}
+
+// handleStopSignals closes the proxy when the port is unmapped, the port
+// mapper sends SIGINT, and SIGTERM is sent if pouchd exits.
+func handleStopSignals(p Proxy) {
+	s := make(chan os.Signal, 10)
+	signal.Notify(s, os.Interrupt, syscall.SIGTERM)
+
+	for range s {
+		p.Close()
+		os.Exit(0)
+	}
+}
@@ -0,0 +1,39 @@
+// Package proxy implements the userland proxy which forwards the traffic from
+// the published port of host to the port of container. It's used when the
+// iptables DNAT rules don't work, such as the traffic from localhost.
+package proxy
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ishidawataru/sctp"
+)
+
+// Proxy defines the behavior of a proxy which forwards the traffic from the
+// frontend address to the backend address.
+type Proxy interface {
+	// Run starts forwarding traffic and blocks until the proxy is closed.
+	Run()
+	// Close stops forwarding traffic and releases the frontend address.
+	Close()
+	// FrontendAddr returns the address on which the proxy is listening.
+	FrontendAddr() net.Addr
+	// BackendAddr returns the address to which the traffic is forwarded.
+	BackendAddr() net.Addr
+}
+
+// NewProxy creates a proxy for the protocol of the addresses, tcp, udp and
+// sctp are supported.
+func NewProxy(frontendAddr, backendAddr net.Addr) (Proxy, error) {
+	switch frontendAddr.(type) {
+	case *net.TCPAddr:
+		return NewTCPProxy(frontendAddr.(*net.TCPAddr), backendAddr.(*net.TCPAddr))
+	case *net.UDPAddr:
+		return NewUDPProxy(frontendAddr.(*net.UDPAddr), backendAddr.(*net.UDPAddr))
+	case *sctp.SCTPAddr:
+		return NewSCTPProxy(frontendAddr.(*sctp.SCTPAddr), backendAddr.(*sctp.SCTPAddr))
+	default:
+		return nil, fmt.Errorf("unsupported protocol of address %v", frontendAddr)
+	}
+}
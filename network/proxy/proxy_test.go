@@ -0,0 +1,118 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ishidawataru/sctp"
+	"github.com/stretchr/testify/assert"
+)
+
+var testBuf = []byte("Buffalo buffalo Buffalo buffalo buffalo buffalo Buffalo buffalo")
+
+func runTCPEchoServer(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			client, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				io.Copy(c, c)
+				c.Close()
+			}(client)
+		}
+	}()
+	return listener
+}
+
+func runUDPEchoServer(t *testing.T) net.PacketConn {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		buf := make([]byte, udpBufSize)
+		for {
+			read, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			conn.WriteTo(buf[:read], from)
+		}
+	}()
+	return conn
+}
+
+func testProxyEcho(t *testing.T, proto string, p Proxy) {
+	go p.Run()
+	defer p.Close()
+
+	client, err := net.Dial(proto, p.FrontendAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	client.SetDeadline(time.Now().Add(10 * time.Second))
+
+	_, err = client.Write(testBuf)
+	assert.NoError(t, err)
+
+	recvBuf := make([]byte, len(testBuf))
+	_, err = io.ReadFull(client, recvBuf)
+	assert.NoError(t, err)
+	assert.Equal(t, testBuf, recvBuf)
+}
+
+func TestTCPProxy(t *testing.T) {
+	backend := runTCPEchoServer(t)
+	defer backend.Close()
+
+	p, err := NewProxy(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}, backend.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testProxyEcho(t, "tcp", p)
+}
+
+func TestUDPProxy(t *testing.T) {
+	backend := runUDPEchoServer(t)
+	defer backend.Close()
+
+	p, err := NewProxy(&net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)}, backend.LocalAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testProxyEcho(t, "udp", p)
+}
+
+func TestParseHostContainerAddrs(t *testing.T) {
+	host, container, err := parseHostContainerAddrs([]string{
+		"-proto", "udp",
+		"-host-ip", "0.0.0.0", "-host-port", "8053",
+		"-container-ip", "172.17.0.2", "-container-port", "53",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &net.UDPAddr{IP: net.ParseIP("0.0.0.0"), Port: 8053}, host)
+	assert.Equal(t, &net.UDPAddr{IP: net.ParseIP("172.17.0.2"), Port: 53}, container)
+
+	host, _, err = parseHostContainerAddrs([]string{
+		"-proto", "sctp",
+		"-host-ip", "0.0.0.0", "-host-port", "9000",
+		"-container-ip", "172.17.0.2", "-container-port", "9000",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &sctp.SCTPAddr{IP: []net.IP{net.ParseIP("0.0.0.0")}, Port: 9000}, host)
+
+	_, _, err = parseHostContainerAddrs([]string{"-proto", "icmp", "-host-ip", "0.0.0.0", "-container-ip", "172.17.0.2"})
+	assert.Error(t, err)
+
+	_, _, err = parseHostContainerAddrs([]string{"-proto", "tcp", "-host-ip", "localhost", "-container-ip", "172.17.0.2"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"sync"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/ishidawataru/sctp"
+)
+
+// SCTPProxy forwards the sctp associations from the frontend address to the
+// backend address.
+type SCTPProxy struct {
+	listener     *sctp.SCTPListener
+	frontendAddr *sctp.SCTPAddr
+	backendAddr  *sctp.SCTPAddr
+}
+
+// NewSCTPProxy creates a sctp proxy listening on the frontend address.
+func NewSCTPProxy(frontendAddr, backendAddr *sctp.SCTPAddr) (*SCTPProxy, error) {
+	listener, err := sctp.ListenSCTP("sctp", frontendAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the frontend port may be allocated by the kernel.
+	if addr, ok := listener.Addr().(*sctp.SCTPAddr); ok {
+		frontendAddr = addr
+	}
+	return &SCTPProxy{
+		listener:     listener,
+		frontendAddr: frontendAddr,
+		backendAddr:  backendAddr,
+	}, nil
+}
+
+func (proxy *SCTPProxy) clientLoop(client *sctp.SCTPConn, quit chan struct{}) {
+	clientC := sctp.NewSCTPSndRcvInfoWrappedConn(client)
+	backend, err := sctp.DialSCTP("sctp", nil, proxy.backendAddr)
+	if err != nil {
+		log.With(nil).Errorf("failed to connect to %v: %v", proxy.backendAddr, err)
+		client.Close()
+		return
+	}
+	backendC := sctp.NewSCTPSndRcvInfoWrappedConn(backend)
+
+	var wg sync.WaitGroup
+	broker := func(to, from net.Conn) {
+		defer wg.Done()
+		io.Copy(to, from)
+		from.Close()
+		to.Close()
+	}
+
+	wg.Add(2)
+	go broker(clientC, backendC)
+	go broker(backendC, clientC)
+
+	finish := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(finish)
+	}()
+
+	select {
+	case <-quit:
+	case <-finish:
+	}
+	clientC.Close()
+	backendC.Close()
+	<-finish
+}
+
+// Run starts forwarding the sctp associations.
+func (proxy *SCTPProxy) Run() {
+	quit := make(chan struct{})
+	defer close(quit)
+	for {
+		client, err := proxy.listener.Accept()
+		if err != nil {
+			log.With(nil).Debugf("stop proxying on %v: %v", proxy.frontendAddr, err)
+			return
+		}
+		go proxy.clientLoop(client.(*sctp.SCTPConn), quit)
+	}
+}
+
+// Close stops forwarding the sctp associations.
+func (proxy *SCTPProxy) Close() { proxy.listener.Close() }
+
+// FrontendAddr returns the sctp address on which the proxy is listening.
+func (proxy *SCTPProxy) FrontendAddr() net.Addr { return proxy.frontendAddr }
+
+// BackendAddr returns the sctp address to which the associations are forwarded.
+func (proxy *SCTPProxy) BackendAddr() net.Addr { return proxy.backendAddr }
@@ -12,6 +12,10 @@ type Endpoint struct {
 	ID    string
 	Owner string
 
+	// ContainerName is the name of owner container, by which the container
+	// is resolved by the embedded DNS server in user-defined networks.
+	ContainerName string
+
 	Hostname       strfmt.Hostname
 	Domainname     string
 	HostnamePath   string
@@ -35,4 +39,12 @@ type Endpoint struct {
 	GenericParams   map[string]interface{}
 	Priority        int
 	DisableResolver bool
+
+	// ReservedAddresses are the static IP addresses of the other containers
+	// in the network, which are not allocated to the endpoint.
+	ReservedAddresses []string
+
+	// KeepSandbox keeps the sandbox after the last endpoint is removed, since
+	// the network namespace is still used by the running container.
+	KeepSandbox bool
 }
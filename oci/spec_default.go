@@ -6,7 +6,8 @@ import (
 
 func iPtr(i int64) *int64 { return &i }
 
-func defaultCaps() []string {
+// DefaultCapabilities returns the default capabilities of container.
+func DefaultCapabilities() []string {
 	return []string{
 		"CAP_CHOWN",
 		"CAP_DAC_OVERRIDE",
@@ -77,10 +78,10 @@ func NewDefaultSpec() *specs.Spec {
 
 	s.Process = &specs.Process{
 		Capabilities: &specs.LinuxCapabilities{
-			Bounding:    defaultCaps(),
-			Permitted:   defaultCaps(),
-			Inheritable: defaultCaps(),
-			Effective:   defaultCaps(),
+			Bounding:    DefaultCapabilities(),
+			Permitted:   DefaultCapabilities(),
+			Inheritable: DefaultCapabilities(),
+			Effective:   DefaultCapabilities(),
 		},
 	}
 
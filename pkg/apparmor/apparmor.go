@@ -0,0 +1,141 @@
+package apparmor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// DefaultProfileName is the name of AppArmor profile applied to the
+	// containers without AppArmor option.
+	DefaultProfileName = "pouch-default"
+
+	// profileDir is where the AppArmor profiles and abstractions are.
+	profileDir = "/etc/apparmor.d"
+)
+
+// profilesFile lists the AppArmor profiles loaded in kernel.
+var profilesFile = "/sys/kernel/security/apparmor/profiles"
+
+// profileTemplate is the template of default profile, which is similar with
+// the default profile of docker.
+var profileTemplate = template.Must(template.New("apparmor_profile").Parse(`
+{{range $value := .Imports}}
+{{$value}}
+{{end}}
+
+profile {{.Name}} flags=(attach_disconnected,mediate_deleted) {
+{{range $value := .InnerImports}}
+  {{$value}}
+{{end}}
+
+  network,
+  capability,
+  file,
+  umount,
+
+  signal (receive) peer=unconfined,
+  signal (send,receive) peer={{.Name}},
+
+  deny @{PROC}/* w,   # deny write for all files directly in /proc (not in a subdir)
+  # deny write to files not in /proc/<number>/** or /proc/sys/**
+  deny @{PROC}/{[^1-9],[^1-9][^0-9],[^1-9s][^0-9y][^0-9s],[^1-9][^0-9][^0-9][^0-9]*}/** w,
+  deny @{PROC}/sys/[^k]** w,  # deny /proc/sys except /proc/sys/k* (effectively /proc/sys/kernel)
+  deny @{PROC}/sys/kernel/{?,??,[^s][^h][^m]**} w,  # deny everything except shm* in /proc/sys/kernel/
+  deny @{PROC}/sysrq-trigger rwklx,
+  deny @{PROC}/kcore rwklx,
+
+  deny mount,
+
+  deny /sys/[^f]*/** wklx,
+  deny /sys/f[^s]*/** wklx,
+  deny /sys/fs/[^c]*/** wklx,
+  deny /sys/fs/c[^g]*/** wklx,
+  deny /sys/fs/cg[^r]*/** wklx,
+  deny /sys/firmware/** rwklx,
+  deny /sys/kernel/security/** rwklx,
+
+  # suppress ptrace denials when using 'ps' inside a container
+  ptrace (trace,read) peer={{.Name}},
+}
+`))
+
+// profileData is the data to render the profile template.
+type profileData struct {
+	Name         string
+	Imports      []string
+	InnerImports []string
+}
+
+// GenerateDefaultProfile generates the default profile with name, the
+// tunables and abstractions are included only if they exist on host.
+func GenerateDefaultProfile(name string) ([]byte, error) {
+	data := profileData{Name: name}
+	if _, err := os.Stat(filepath.Join(profileDir, "tunables", "global")); err == nil {
+		data.Imports = append(data.Imports, "#include <tunables/global>")
+	} else {
+		data.Imports = append(data.Imports, "@{PROC}=/proc/")
+	}
+	if _, err := os.Stat(filepath.Join(profileDir, "abstractions", "base")); err == nil {
+		data.InnerImports = append(data.InnerImports, "#include <abstractions/base>")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := profileTemplate.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// InstallDefaultProfile generates the default profile with name and loads it
+// into kernel by apparmor_parser, the loaded profile is replaced.
+func InstallDefaultProfile(name string) error {
+	profile, err := GenerateDefaultProfile(name)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile("", "pouch-apparmor-profile")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(profile); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+
+	// -K skips the cache, -r replaces the loaded profile.
+	if output, err := exec.Command("apparmor_parser", "-Kr", f.Name()).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to load AppArmor profile %s: %v: %s", name, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// IsProfileLoaded returns whether the profile with name is loaded in kernel.
+func IsProfileLoaded(name string) (bool, error) {
+	f, err := os.Open(profilesFile)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	// each line is in the format of "<name> (<mode>)".
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.LastIndex(line, " ("); idx > 0 && line[:idx] == name {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
@@ -0,0 +1,42 @@
+package apparmor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateDefaultProfile(t *testing.T) {
+	profile, err := GenerateDefaultProfile(DefaultProfileName)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(profile), "profile pouch-default flags=(attach_disconnected,mediate_deleted) {"))
+	assert.True(t, strings.Contains(string(profile), "ptrace (trace,read) peer=pouch-default,"))
+}
+
+func TestIsProfileLoaded(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-apparmor-profiles")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	defer func(file string) { profilesFile = file }(profilesFile)
+	profilesFile = filepath.Join(tmpDir, "profiles")
+
+	_, err = IsProfileLoaded(DefaultProfileName)
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(profilesFile, []byte("/usr/sbin/ntpd (enforce)\npouch-default (enforce)\nmy profile (complain)\n"), 0644))
+	for name, expected := range map[string]bool{
+		"pouch-default": true,
+		"my profile":    true,
+		"pouch":         false,
+		"ntpd":          false,
+	} {
+		loaded, err := IsProfileLoaded(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, loaded, name)
+	}
+}
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+// Entry is the record of a state-changing api call.
+type Entry struct {
+	// Time is when the request is received.
+	Time time.Time `json:"time"`
+
+	// RequestID correlates the entry with the daemon logs of request.
+	RequestID string `json:"request_id"`
+
+	// User is the user of request, empty if it is anonymous.
+	User string `json:"user,omitempty"`
+
+	// Client is the remote address of request.
+	Client string `json:"client"`
+
+	// Method and Path are what the request calls.
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// Vars are the variables of route like the name of container, and
+	// Query is the query parameters of request.
+	Vars  map[string]string   `json:"vars,omitempty"`
+	Query map[string][]string `json:"query,omitempty"`
+
+	// Result is success or failure, the Error and StatusCode are only set
+	// if the request fails.
+	Result     string `json:"result"`
+	Error      string `json:"error,omitempty"`
+	StatusCode int    `json:"status_code,omitempty"`
+
+	// Duration is the seconds taken to handle the request.
+	Duration float64 `json:"duration"`
+}
+
+const (
+	// ResultSuccess means the request succeeds.
+	ResultSuccess = "success"
+
+	// ResultFailure means the request fails or is rejected.
+	ResultFailure = "failure"
+)
+
+// Sink is where the audit entries are written.
+type Sink interface {
+	// Write writes the entry encoded in JSON.
+	Write(entry []byte) error
+
+	// Close closes the sink.
+	Close() error
+}
+
+// Logger writes the audit entries to all the sinks.
+type Logger struct {
+	sinks []Sink
+}
+
+// New creates a logger with the sinks, like file:///var/log/pouch/audit.log
+// and syslog://, see ParseSink for the supported ones.
+func New(addrs []string) (*Logger, error) {
+	l := &Logger{}
+	for _, addr := range addrs {
+		sink, err := ParseSink(addr)
+		if err != nil {
+			l.Close()
+			return nil, err
+		}
+		l.sinks = append(l.sinks, sink)
+	}
+	return l, nil
+}
+
+// Enabled returns whether there is any sink to write audit entries.
+func (l *Logger) Enabled() bool {
+	return l != nil && len(l.sinks) > 0
+}
+
+// Log writes the entry to all the sinks. The failure of sink is logged but
+// doesn't affect the request, since the sink like remote syslog might be
+// unavailable temporarily.
+func (l *Logger) Log(entry *Entry) {
+	if !l.Enabled() {
+		return
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		log.With(nil).Errorf("failed to marshal audit entry of request %s: %v", entry.RequestID, err)
+		return
+	}
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(b); err != nil {
+			log.With(nil).Errorf("failed to write audit entry of request %s: %v", entry.RequestID, err)
+		}
+	}
+}
+
+// Close closes all the sinks.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	var lastErr error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
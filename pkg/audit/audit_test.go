@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSink(t *testing.T) {
+	for _, addr := range []string{
+		"/var/log/pouch/audit.log",
+		"file://relative/audit.log",
+		"syslog://localhost:514",
+		"syslog+udp://",
+		"kafka://localhost:9092",
+	} {
+		_, err := ParseSink(addr)
+		assert.Error(t, err, "expected error for sink %s", addr)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("failed to create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit", "audit.log")
+	l, err := New([]string{"file://" + path})
+	assert.NoError(t, err)
+	assert.True(t, l.Enabled())
+
+	entries := []*Entry{
+		{RequestID: "r1", Method: "POST", Path: "/containers/create", Result: ResultSuccess},
+		{RequestID: "r2", Method: "DELETE", Path: "/containers/c1", Vars: map[string]string{"name": "c1"}, Result: ResultFailure, Error: "container c1: not found", StatusCode: 404},
+	}
+	for _, e := range entries {
+		l.Log(e)
+	}
+	assert.NoError(t, l.Close())
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	var got []*Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		e := &Entry{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), e))
+		got = append(got, e)
+	}
+	assert.Equal(t, entries, got)
+
+	// the nil logger records nothing.
+	var nilLogger *Logger
+	assert.False(t, nilLogger.Enabled())
+	nilLogger.Log(entries[0])
+	assert.NoError(t, nilLogger.Close())
+}
+
+func TestSyslogSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+
+	l, err := New([]string{"syslog+udp://" + conn.LocalAddr().String()})
+	assert.NoError(t, err)
+	defer l.Close()
+
+	l.Log(&Entry{RequestID: "r1", Method: "POST", Path: "/containers/c1/start", Result: ResultSuccess})
+
+	buf := make([]byte, 4096)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	assert.NoError(t, err)
+
+	msg := string(buf[:n])
+	assert.Contains(t, msg, syslogTag)
+	assert.True(t, strings.HasSuffix(strings.TrimSpace(msg), `"result":"success","duration":0}`), "unexpected message %s", msg)
+	assert.Contains(t, msg, `"request_id":"r1"`)
+}
@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/RackSec/srslog"
+)
+
+// syslogTag is the tag of audit entries in syslog.
+const syslogTag = "pouchd-audit"
+
+// ParseSink creates the sink by address, the supported ones are:
+//
+//	file:///path/to/audit.log    append the entries to the file line by line
+//	syslog://                    write the entries to the local syslog
+//	syslog+udp://host:port       write the entries to the remote syslog
+//	syslog+tcp://host:port       write the entries to the remote syslog
+func ParseSink(addr string) (Sink, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit log sink %q: %v", addr, err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		if u.Host != "" || u.Path == "" || !filepath.IsAbs(u.Path) {
+			return nil, fmt.Errorf("invalid audit log sink %q: the path of file should be absolute", addr)
+		}
+		return newFileSink(u.Path)
+	case "syslog":
+		if u.Host != "" {
+			return nil, fmt.Errorf("invalid audit log sink %q: use syslog+udp or syslog+tcp for the remote syslog", addr)
+		}
+		return newSyslogSink("", "")
+	case "syslog+udp", "syslog+tcp":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid audit log sink %q: the address of remote syslog should be specified", addr)
+		}
+		return newSyslogSink(u.Scheme[len("syslog+"):], u.Host)
+	default:
+		return nil, fmt.Errorf("invalid audit log sink %q: only file, syslog, syslog+udp and syslog+tcp are supported", addr)
+	}
+}
+
+// fileSink appends the entries to the file, one entry per line.
+type fileSink struct {
+	sync.Mutex
+	f *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create directory of audit log: %v", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %v", err)
+	}
+	return &fileSink{f: f}, nil
+}
+
+// Write implements Sink.
+func (s *fileSink) Write(entry []byte) error {
+	s.Lock()
+	defer s.Unlock()
+
+	_, err := s.f.Write(append(entry, '\n'))
+	return err
+}
+
+// Close implements Sink.
+func (s *fileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.f.Close()
+}
+
+// syslogSink writes the entries to syslog, the connection is re-established
+// by srslog if it is broken.
+type syslogSink struct {
+	w *srslog.Writer
+}
+
+func newSyslogSink(network, raddr string) (*syslogSink, error) {
+	w, err := srslog.Dial(network, raddr, srslog.LOG_INFO|srslog.LOG_AUTH, syslogTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog of audit log: %v", err)
+	}
+	if network != "" {
+		w.SetFormatter(srslog.RFC5424Formatter)
+	}
+	if network == "tcp" {
+		w.SetFramer(srslog.RFC5425MessageLengthFramer)
+	}
+	return &syslogSink{w: w}, nil
+}
+
+// Write implements Sink.
+func (s *syslogSink) Write(entry []byte) error {
+	_, err := s.w.Write(entry)
+	return err
+}
+
+// Close implements Sink.
+func (s *syslogSink) Close() error {
+	return s.w.Close()
+}
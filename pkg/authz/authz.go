@@ -0,0 +1,203 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/storage/plugins"
+
+	digest "github.com/opencontainers/go-digest"
+)
+
+const (
+	// PluginType is the type implemented by the authorization plugins.
+	PluginType = "AuthZPlugin"
+
+	// requestPath is the service of plugins which authorizes the request.
+	requestPath = "/AuthZPlugin.AuthZReq"
+
+	// UserHeader is the header carrying the user of request, it is only
+	// used if the client doesn't present a TLS certificate.
+	UserHeader = "X-Pouch-User"
+
+	// maxBodySize is the max size of request body to be digested, the
+	// larger ones are usually the streams like image tarball.
+	maxBodySize = 1 << 20
+)
+
+const (
+	// AuthNMethodTLS means the user is the common name of client certificate.
+	AuthNMethodTLS = "TLS"
+
+	// AuthNMethodHeader means the user is claimed by the UserHeader, which
+	// isn't authenticated by pouchd.
+	AuthNMethodHeader = "Header"
+)
+
+// Request is the metadata of api request sent to the authorization plugins.
+type Request struct {
+	// User is the user of request, empty if it is anonymous.
+	User string `json:"User,omitempty"`
+
+	// UserAuthNMethod is how the user is identified, TLS or Header.
+	UserAuthNMethod string `json:"UserAuthNMethod,omitempty"`
+
+	// RequestMethod is the http method of request.
+	RequestMethod string `json:"RequestMethod"`
+
+	// RequestURI is the path with the query of request.
+	RequestURI string `json:"RequestURI"`
+
+	// RequestPath is the path of request, like /v1.24/containers/create.
+	RequestPath string `json:"RequestPath"`
+
+	// RequestBodyDigest is the sha256 digest of request body, empty if the
+	// body is empty, larger than 1MB or of unknown length.
+	RequestBodyDigest string `json:"RequestBodyDigest,omitempty"`
+}
+
+// Response is the decision of authorization plugin.
+type Response struct {
+	// Allow indicates whether the request is allowed.
+	Allow bool `json:"Allow"`
+
+	// Msg is the message returned to client if the request is denied.
+	Msg string `json:"Msg,omitempty"`
+
+	// Err is the error of plugin while authorizing the request.
+	Err string `json:"Err,omitempty"`
+}
+
+// Plugin authorizes the api requests.
+type Plugin interface {
+	// Name returns the name of plugin.
+	Name() string
+
+	// AuthZRequest authorizes the request.
+	AuthZRequest(req *Request) (*Response, error)
+}
+
+// remotePlugin is the authorization plugin discovered from the plugin
+// directories, which serves the JSON protocol on the unix socket.
+type remotePlugin struct {
+	name string
+}
+
+// NewPlugin returns the authorization plugin by name, the plugin is looked up
+// when the first request is authorized, so that it can be started after
+// pouchd.
+func NewPlugin(name string) Plugin {
+	return &remotePlugin{name: name}
+}
+
+// Name implements Plugin.
+func (p *remotePlugin) Name() string {
+	return p.name
+}
+
+// AuthZRequest implements Plugin.
+func (p *remotePlugin) AuthZRequest(req *Request) (*Response, error) {
+	plugin, err := plugins.Get(PluginType, p.name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &Response{}
+	if err := plugin.Client().CallService(requestPath, req, resp, false); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Authorizer authorizes the api requests by the chain of plugins, the request
+// is allowed only if all the plugins allow it.
+type Authorizer struct {
+	plugins []Plugin
+}
+
+// NewAuthorizer creates an authorizer with the plugins in order.
+func NewAuthorizer(plugins ...Plugin) *Authorizer {
+	return &Authorizer{plugins: plugins}
+}
+
+// Enabled returns whether there is any plugin to authorize the requests.
+func (a *Authorizer) Enabled() bool {
+	return a != nil && len(a.plugins) > 0
+}
+
+// AuthZRequest sends the metadata of request to the plugins in order. The
+// error is returned if any plugin denies the request or fails to authorize
+// it, and the handler of request should not be run.
+func (a *Authorizer) AuthZRequest(req *http.Request) error {
+	if !a.Enabled() {
+		return nil
+	}
+
+	authReq, err := newRequest(req)
+	if err != nil {
+		return err
+	}
+	return a.Authorize(authReq)
+}
+
+// Authorize sends the request to the plugins in order, it is used by the
+// servers other than the http one, which collect the metadata of request by
+// themselves. The error is returned if any plugin denies the request or fails
+// to authorize it.
+func (a *Authorizer) Authorize(authReq *Request) error {
+	if !a.Enabled() {
+		return nil
+	}
+
+	for _, p := range a.plugins {
+		resp, err := p.AuthZRequest(authReq)
+		if err != nil {
+			return fmt.Errorf("failed to authorize request by plugin %s: %v", p.Name(), err)
+		}
+		if resp.Err != "" {
+			return fmt.Errorf("plugin %s failed to authorize request: %s", p.Name(), resp.Err)
+		}
+		if !resp.Allow {
+			return httputils.NewHTTPError(fmt.Errorf("authorization denied by plugin %s: %s", p.Name(), resp.Msg), http.StatusForbidden)
+		}
+	}
+	return nil
+}
+
+// RequestUser returns the user of request and how it is identified. The
+// common name of client certificate takes precedence over the UserHeader.
+func RequestUser(req *http.Request) (user, authNMethod string) {
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		return req.TLS.PeerCertificates[0].Subject.CommonName, AuthNMethodTLS
+	}
+	if user := req.Header.Get(UserHeader); user != "" {
+		return user, AuthNMethodHeader
+	}
+	return "", ""
+}
+
+// newRequest collects the metadata of request. The body is restored after
+// digested so that it can still be read by the handler.
+func newRequest(req *http.Request) (*Request, error) {
+	authReq := &Request{
+		RequestMethod: req.Method,
+		RequestURI:    req.URL.RequestURI(),
+		RequestPath:   req.URL.Path,
+	}
+
+	authReq.User, authReq.UserAuthNMethod = RequestUser(req)
+
+	if req.Body != nil && req.ContentLength > 0 && req.ContentLength <= maxBodySize {
+		body, err := ioutil.ReadAll(io.LimitReader(req.Body, maxBodySize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %v", err)
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		authReq.RequestBodyDigest = digest.FromBytes(body).String()
+	}
+	return authReq, nil
+}
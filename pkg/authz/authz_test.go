@@ -0,0 +1,150 @@
+package authz
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/storage/plugins"
+
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePlugin records the requests and denies the ones of the denied path.
+type fakePlugin struct {
+	name     string
+	denyPath string
+	requests []*Request
+}
+
+func (p *fakePlugin) Name() string {
+	return p.name
+}
+
+func (p *fakePlugin) AuthZRequest(req *Request) (*Response, error) {
+	p.requests = append(p.requests, req)
+	if req.RequestPath == p.denyPath {
+		return &Response{Allow: false, Msg: "forbidden path"}, nil
+	}
+	return &Response{Allow: true}, nil
+}
+
+func TestAuthorizerChain(t *testing.T) {
+	p1 := &fakePlugin{name: "p1", denyPath: "/containers/create"}
+	p2 := &fakePlugin{name: "p2", denyPath: "/images/create"}
+	a := NewAuthorizer(p1, p2)
+	assert.True(t, a.Enabled())
+
+	body := `{"Image":"busybox"}`
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/containers/create?name=c1", strings.NewReader(body))
+	req.Header.Set(UserHeader, "alice")
+
+	err := a.AuthZRequest(req)
+	assert.Error(t, err)
+	httpErr, ok := err.(httputils.HTTPError)
+	if assert.True(t, ok) {
+		assert.Equal(t, http.StatusForbidden, httpErr.Code())
+	}
+	assert.Contains(t, err.Error(), "forbidden path")
+
+	// the chain stops at the first denial.
+	assert.Len(t, p1.requests, 1)
+	assert.Len(t, p2.requests, 0)
+	assert.Equal(t, &Request{
+		User:              "alice",
+		UserAuthNMethod:   AuthNMethodHeader,
+		RequestMethod:     http.MethodPost,
+		RequestURI:        "/containers/create?name=c1",
+		RequestPath:       "/containers/create",
+		RequestBodyDigest: digest.FromString(body).String(),
+	}, p1.requests[0])
+
+	// the body can still be read by the handler.
+	got, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, string(got))
+
+	req, _ = http.NewRequest(http.MethodGet, "http://localhost/containers/json", nil)
+	assert.NoError(t, a.AuthZRequest(req))
+	assert.Len(t, p2.requests, 1)
+
+	// the nil authorizer allows all requests.
+	var nilAuthorizer *Authorizer
+	assert.False(t, nilAuthorizer.Enabled())
+	assert.NoError(t, nilAuthorizer.AuthZRequest(req))
+}
+
+func TestNewRequest(t *testing.T) {
+	// the user of client certificate takes precedence over the header.
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/info", nil)
+	req.Header.Set(UserHeader, "alice")
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "bob"}}},
+	}
+	authReq, err := newRequest(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", authReq.User)
+	assert.Equal(t, AuthNMethodTLS, authReq.UserAuthNMethod)
+
+	// the body larger than 1MB is not digested.
+	large := strings.Repeat("a", maxBodySize+1)
+	req, _ = http.NewRequest(http.MethodPost, "http://localhost/images/load", strings.NewReader(large))
+	authReq, err = newRequest(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "", authReq.User)
+	assert.Equal(t, "", authReq.RequestBodyDigest)
+
+	got, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, len(large), len(got))
+}
+
+func TestRemotePlugin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authz")
+	if err != nil {
+		t.Fatalf("failed to create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	l, err := net.Listen("unix", filepath.Join(dir, "authz-test.sock"))
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(plugins.HandShakePath, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(plugins.HandShakeResp{Implements: []string{PluginType}})
+	})
+	mux.HandleFunc(requestPath, func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			json.NewEncoder(w).Encode(Response{Err: err.Error()})
+			return
+		}
+		json.NewEncoder(w).Encode(Response{Allow: req.User == "admin", Msg: "only admin is allowed"})
+	})
+	go http.Serve(l, mux)
+
+	plugins.SetPluginSockPaths([]string{dir})
+	a := NewAuthorizer(NewPlugin("authz-test"))
+
+	req, _ := http.NewRequest(http.MethodDelete, "http://localhost/containers/c1", nil)
+	req.Header.Set(UserHeader, "admin")
+	assert.NoError(t, a.AuthZRequest(req))
+
+	req.Header.Set(UserHeader, "guest")
+	err = a.AuthZRequest(req)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "authorization denied by plugin authz-test: only admin is allowed")
+}
@@ -0,0 +1,232 @@
+package cdi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultSpecDirs are the directories to load the CDI spec files from, the
+// device in the latter directory overrides the one with the same name in the
+// former directory.
+var DefaultSpecDirs = []string{"/etc/cdi", "/var/run/cdi"}
+
+var (
+	// kindRegexp matches the kind of CDI spec, like vendor.com/device.
+	kindRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*/[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+	// deviceNameRegexp matches the device name in CDI spec.
+	deviceNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._:-]*$`)
+)
+
+// Spec is the CDI spec file provided by the device vendor.
+type Spec struct {
+	Version        string         `yaml:"cdiVersion"`
+	Kind           string         `yaml:"kind"`
+	Devices        []Device       `yaml:"devices"`
+	ContainerEdits ContainerEdits `yaml:"containerEdits"`
+}
+
+// Device is a device of CDI spec, which is requested by the qualified name
+// "<kind>=<name>".
+type Device struct {
+	Name           string         `yaml:"name"`
+	ContainerEdits ContainerEdits `yaml:"containerEdits"`
+}
+
+// ContainerEdits are the edits to the OCI spec of container using the device.
+type ContainerEdits struct {
+	Env         []string      `yaml:"env"`
+	DeviceNodes []*DeviceNode `yaml:"deviceNodes"`
+	Mounts      []*Mount      `yaml:"mounts"`
+	Hooks       []*Hook       `yaml:"hooks"`
+}
+
+// DeviceNode is the device node to create in container.
+type DeviceNode struct {
+	Path        string `yaml:"path"`
+	HostPath    string `yaml:"hostPath"`
+	Permissions string `yaml:"permissions"`
+}
+
+// Mount is the mount to add into container.
+type Mount struct {
+	HostPath      string   `yaml:"hostPath"`
+	ContainerPath string   `yaml:"containerPath"`
+	Type          string   `yaml:"type"`
+	Options       []string `yaml:"options"`
+}
+
+// Hook is the OCI hook to add into container.
+type Hook struct {
+	HookName string   `yaml:"hookName"`
+	Path     string   `yaml:"path"`
+	Args     []string `yaml:"args"`
+	Env      []string `yaml:"env"`
+	Timeout  *int     `yaml:"timeout"`
+}
+
+// Registry holds the devices loaded from the CDI spec files.
+type Registry struct {
+	devices map[string]*registeredDevice
+}
+
+type registeredDevice struct {
+	device *Device
+	spec   *Spec
+}
+
+// IsQualifiedName returns whether the name is a qualified CDI device name,
+// like vendor.com/device=gpu0.
+func IsQualifiedName(name string) bool {
+	_, _, err := ParseQualifiedName(name)
+	return err == nil
+}
+
+// ParseQualifiedName parses the qualified CDI device name into the kind and
+// the device name.
+func ParseQualifiedName(name string) (string, string, error) {
+	parts := strings.SplitN(name, "=", 2)
+	if len(parts) != 2 || !kindRegexp.MatchString(parts[0]) || !deviceNameRegexp.MatchString(parts[1]) {
+		return "", "", fmt.Errorf("invalid CDI device name %q, should be <vendor>/<class>=<name>", name)
+	}
+	return parts[0], parts[1], nil
+}
+
+// NewRegistry loads the CDI spec files with extension .json, .yaml or .yml
+// from the directories, the directory not existing is ignored.
+func NewRegistry(dirs ...string) (*Registry, error) {
+	r := &Registry{devices: map[string]*registeredDevice{}}
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, f := range files {
+			switch filepath.Ext(f.Name()) {
+			case ".json", ".yaml", ".yml":
+			default:
+				continue
+			}
+			if f.IsDir() {
+				continue
+			}
+
+			spec, err := loadSpec(filepath.Join(dir, f.Name()))
+			if err != nil {
+				return nil, err
+			}
+			for i := range spec.Devices {
+				r.devices[spec.Kind+"="+spec.Devices[i].Name] = &registeredDevice{
+					device: &spec.Devices[i],
+					spec:   spec,
+				}
+			}
+		}
+	}
+	return r, nil
+}
+
+// loadSpec loads and validates the CDI spec file, JSON is parsed as YAML.
+func loadSpec(file string) (*Spec, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	if err := yaml.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse CDI spec %s: %v", file, err)
+	}
+	if !kindRegexp.MatchString(spec.Kind) {
+		return nil, fmt.Errorf("invalid kind %q of CDI spec %s", spec.Kind, file)
+	}
+	if err := spec.ContainerEdits.validate(); err != nil {
+		return nil, fmt.Errorf("invalid CDI spec %s: %v", file, err)
+	}
+	for _, d := range spec.Devices {
+		if !deviceNameRegexp.MatchString(d.Name) {
+			return nil, fmt.Errorf("invalid device name %q of CDI spec %s", d.Name, file)
+		}
+		if err := d.ContainerEdits.validate(); err != nil {
+			return nil, fmt.Errorf("invalid device %s of CDI spec %s: %v", d.Name, file, err)
+		}
+	}
+	return spec, nil
+}
+
+// validate checks the required fields of edits.
+func (e *ContainerEdits) validate() error {
+	for _, env := range e.Env {
+		if !strings.Contains(env, "=") {
+			return fmt.Errorf("invalid env %q, should be key=value", env)
+		}
+	}
+	for _, d := range e.DeviceNodes {
+		if d == nil || d.Path == "" {
+			return fmt.Errorf("device node should have path")
+		}
+	}
+	for _, m := range e.Mounts {
+		if m == nil || m.HostPath == "" || m.ContainerPath == "" {
+			return fmt.Errorf("mount should have hostPath and containerPath")
+		}
+	}
+	for _, h := range e.Hooks {
+		if h == nil || h.Path == "" || h.HookName == "" {
+			return fmt.Errorf("hook should have hookName and path")
+		}
+	}
+	return nil
+}
+
+// Devices returns the qualified names of all the devices sorted.
+func (r *Registry) Devices() []string {
+	names := make([]string, 0, len(r.devices))
+	for name := range r.devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Resolve merges the edits of the devices, the edits of spec are applied only
+// once no matter how many devices of the spec are requested.
+func (r *Registry) Resolve(names []string) (*ContainerEdits, error) {
+	edits := &ContainerEdits{}
+	specs := map[*Spec]bool{}
+	for _, name := range names {
+		if _, _, err := ParseQualifiedName(name); err != nil {
+			return nil, err
+		}
+		d, ok := r.devices[name]
+		if !ok {
+			return nil, fmt.Errorf("CDI device %s not found", name)
+		}
+
+		if !specs[d.spec] {
+			specs[d.spec] = true
+			edits.append(&d.spec.ContainerEdits)
+		}
+		edits.append(&d.device.ContainerEdits)
+	}
+	return edits, nil
+}
+
+// append appends the other edits.
+func (e *ContainerEdits) append(o *ContainerEdits) {
+	e.Env = append(e.Env, o.Env...)
+	e.DeviceNodes = append(e.DeviceNodes, o.DeviceNodes...)
+	e.Mounts = append(e.Mounts, o.Mounts...)
+	e.Hooks = append(e.Hooks, o.Hooks...)
+}
@@ -0,0 +1,84 @@
+package cdi
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testYAMLSpec = `cdiVersion: "0.5.0"
+kind: vendor.com/gpu
+containerEdits:
+  env:
+  - VENDOR_DRIVER=1
+  mounts:
+  - hostPath: /usr/lib/libvendor.so
+    containerPath: /usr/lib/libvendor.so
+    options: [ro, bind]
+devices:
+- name: gpu0
+  containerEdits:
+    deviceNodes:
+    - path: /dev/vendor0
+- name: gpu1
+  containerEdits:
+    deviceNodes:
+    - path: /dev/vendor1
+      hostPath: /dev/vendor-1
+    hooks:
+    - hookName: prestart
+      path: /usr/bin/vendor-hook
+      args: [vendor-hook, prestart]
+`
+
+const testJSONSpec = `{
+  "cdiVersion": "0.5.0",
+  "kind": "vendor.com/nic",
+  "devices": [{"name": "nic0", "containerEdits": {"env": ["NIC=0"]}}]
+}`
+
+func TestParseQualifiedName(t *testing.T) {
+	kind, name, err := ParseQualifiedName("vendor.com/gpu=gpu0")
+	assert.NoError(t, err)
+	assert.Equal(t, "vendor.com/gpu", kind)
+	assert.Equal(t, "gpu0", name)
+
+	for _, name := range []string{"/dev/sda", "vendor.com/gpu", "gpu=gpu0", "vendor.com/gpu=", "/dev/a/b=c"} {
+		assert.False(t, IsQualifiedName(name), name)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-cdi")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	etcDir, runDir := filepath.Join(tmpDir, "etc"), filepath.Join(tmpDir, "run")
+	assert.NoError(t, os.MkdirAll(etcDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(etcDir, "vendor.yaml"), []byte(testYAMLSpec), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(etcDir, "nic.json"), []byte(testJSONSpec), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(etcDir, "README"), []byte("ignored"), 0644))
+
+	r, err := NewRegistry(etcDir, runDir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vendor.com/gpu=gpu0", "vendor.com/gpu=gpu1", "vendor.com/nic=nic0"}, r.Devices())
+
+	edits, err := r.Resolve([]string{"vendor.com/gpu=gpu0", "vendor.com/gpu=gpu1", "vendor.com/nic=nic0"})
+	assert.NoError(t, err)
+	// the edits of spec are applied once.
+	assert.Equal(t, []string{"VENDOR_DRIVER=1", "NIC=0"}, edits.Env)
+	assert.Equal(t, 1, len(edits.Mounts))
+	assert.Equal(t, []*DeviceNode{{Path: "/dev/vendor0"}, {Path: "/dev/vendor1", HostPath: "/dev/vendor-1"}}, edits.DeviceNodes)
+	assert.Equal(t, 1, len(edits.Hooks))
+
+	_, err = r.Resolve([]string{"vendor.com/gpu=gpu2"})
+	assert.Error(t, err)
+
+	assert.NoError(t, os.MkdirAll(runDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(runDir, "bad.yaml"), []byte("kind: vendor\n"), 0644))
+	_, err = NewRegistry(etcDir, runDir)
+	assert.Error(t, err)
+}
@@ -96,6 +96,11 @@ func IsInUse(err error) bool {
 	return checkError(err, codeInUse)
 }
 
+// IsConflict checks the error is conflict with the existing resource or not.
+func IsConflict(err error) bool {
+	return checkError(err, codeConflict)
+}
+
 // IsNotModified checks the error is not modified error or not.
 func IsNotModified(err error) bool {
 	return checkError(err, codeNotModified)
@@ -106,6 +111,11 @@ func IsPreCheckFailed(err error) bool {
 	return checkError(err, codePreCheckFailed)
 }
 
+// IsLockfailed checks the error is failed to lock or not.
+func IsLockfailed(err error) bool {
+	return checkError(err, codeLockfailed)
+}
+
 // IsInvalidAuthorization checks the errors is authorization failure or not.
 func IsInvalidAuthorization(err error) bool {
 	return checkError(err, codeInvalidAuthorization)
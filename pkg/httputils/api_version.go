@@ -0,0 +1,55 @@
+package httputils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// APIVersionHeader is the header to specify the api version in request
+	// if the path doesn't have the version prefix, and it is the max api
+	// version of server in response.
+	APIVersionHeader = "X-Pouch-API-Version"
+
+	// MinAPIVersionHeader is the min api version of server in response.
+	MinAPIVersionHeader = "X-Pouch-Min-API-Version"
+)
+
+// ParseAPIVersion parses the api version like 1.24 into the major and minor
+// numbers.
+func ParseAPIVersion(v string) (major, minor int, err error) {
+	parts := strings.Split(v, ".")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid api version %q, should be like 1.24", v)
+	}
+
+	if major, err = strconv.Atoi(parts[0]); err != nil || major < 0 {
+		return 0, 0, fmt.Errorf("invalid api version %q, should be like 1.24", v)
+	}
+	if minor, err = strconv.Atoi(parts[1]); err != nil || minor < 0 {
+		return 0, 0, fmt.Errorf("invalid api version %q, should be like 1.24", v)
+	}
+	return major, minor, nil
+}
+
+// CompareAPIVersion returns -1, 0 or 1 if the api version a is older than,
+// the same as or newer than b. The invalid version is treated as 0.0.
+func CompareAPIVersion(a, b string) int {
+	aMajor, aMinor, _ := ParseAPIVersion(a)
+	bMajor, bMinor, _ := ParseAPIVersion(b)
+
+	switch {
+	case aMajor != bMajor:
+		if aMajor < bMajor {
+			return -1
+		}
+		return 1
+	case aMinor != bMinor:
+		if aMinor < bMinor {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+package httputils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAPIVersion(t *testing.T) {
+	major, minor, err := ParseAPIVersion("1.24")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, major)
+	assert.Equal(t, 24, minor)
+
+	for _, v := range []string{"", "1", "1.2.3", "v1.24", "1.x", "-1.2"} {
+		_, _, err := ParseAPIVersion(v)
+		assert.Error(t, err, "expected error for version %q", v)
+	}
+}
+
+func TestCompareAPIVersion(t *testing.T) {
+	assert.Equal(t, 0, CompareAPIVersion("1.24", "1.24"))
+	assert.Equal(t, -1, CompareAPIVersion("1.9", "1.24"))
+	assert.Equal(t, 1, CompareAPIVersion("2.0", "1.24"))
+	assert.Equal(t, -1, CompareAPIVersion("invalid", "1.24"))
+}
@@ -0,0 +1,267 @@
+package httputils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+// versionTLS13 is the version of TLS 1.3, tls.VersionTLS13 is not defined
+// before go1.12.
+const versionTLS13 = 0x0304
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": versionTLS13,
+}
+
+var tlsCipherSuites = map[string]uint16{
+	"TLS_RSA_WITH_RC4_128_SHA":                tls.TLS_RSA_WITH_RC4_128_SHA,
+	"TLS_RSA_WITH_3DES_EDE_CBC_SHA":           tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
+	"TLS_RSA_WITH_AES_128_CBC_SHA":            tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_RSA_WITH_AES_256_CBC_SHA":            tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_RSA_WITH_AES_128_CBC_SHA256":         tls.TLS_RSA_WITH_AES_128_CBC_SHA256,
+	"TLS_RSA_WITH_AES_128_GCM_SHA256":         tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_RSA_WITH_AES_256_GCM_SHA384":         tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_RC4_128_SHA":        tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_RC4_128_SHA":          tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA,
+	"TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA":     tls.TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":   tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384": tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":    tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":  tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+}
+
+// ParseTLSVersion parses the TLS version, like 1.2.
+func ParseTLSVersion(version string) (uint16, error) {
+	v, ok := tlsVersions[version]
+	if !ok {
+		return 0, fmt.Errorf("unknown TLS version %q, should be one of 1.0, 1.1, 1.2 and 1.3", version)
+	}
+	return v, nil
+}
+
+// ParseCipherSuites parses the names of cipher suites, like
+// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+func ParseCipherSuites(names []string) ([]uint16, error) {
+	suites := make([]uint16, 0, len(names))
+	for _, name := range names {
+		suite, ok := tlsCipherSuites[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite %q, should be one of %v", name, supportedCipherSuites())
+		}
+		suites = append(suites, suite)
+	}
+	return suites, nil
+}
+
+func supportedCipherSuites() []string {
+	names := make([]string, 0, len(tlsCipherSuites))
+	for name := range tlsCipherSuites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ServerTLSOptions is the options of TLS config for server.
+type ServerTLSOptions struct {
+	Key  string
+	Cert string
+	CA   string
+
+	// VerifyRemote requires the client certificates signed by CA, which
+	// means mutual TLS.
+	VerifyRemote bool
+
+	// MinVersion is the min TLS version, like 1.2.
+	MinVersion string
+
+	// CipherSuites are the names of supported cipher suites, the default
+	// ones of Go are used if empty.
+	CipherSuites []string
+}
+
+// NewServerTLSConfig returns the TLS config for server. The key pair and CA
+// are reloaded in handshake if the files have been changed, so that the
+// certificates can be rotated without restarting server.
+func NewServerTLSConfig(opts ServerTLSOptions) (*tls.Config, error) {
+	loader := &certLoader{key: opts.Key, cert: opts.Cert, ca: opts.CA}
+	if _, err := loader.reload(); err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		GetCertificate: loader.getCertificate,
+	}
+
+	if opts.MinVersion != "" {
+		v, err := ParseTLSVersion(opts.MinVersion)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.MinVersion = v
+	}
+
+	if len(opts.CipherSuites) > 0 {
+		suites, err := ParseCipherSuites(opts.CipherSuites)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.CipherSuites = suites
+		tlsConfig.PreferServerCipherSuites = true
+	}
+
+	if opts.VerifyRemote {
+		// NOTE: the client certificates are verified by the reloadable
+		// CA in VerifyPeerCertificate, instead of ClientCAs which can't
+		// be changed once the server is started.
+		tlsConfig.ClientAuth = tls.RequireAnyClientCert
+		tlsConfig.VerifyPeerCertificate = loader.verifyClientCertificates
+	}
+	return tlsConfig, nil
+}
+
+// fileStamp is used to check whether the file has been changed.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// certLoader loads the key pair and CA from files, and reloads them if the
+// files have been changed.
+type certLoader struct {
+	key, cert, ca string
+
+	mu          sync.Mutex
+	stamps      []fileStamp
+	certificate *tls.Certificate
+	pool        *x509.CertPool
+}
+
+func (l *certLoader) files() []string {
+	files := []string{l.cert, l.key}
+	if l.ca != "" {
+		files = append(files, l.ca)
+	}
+	return files
+}
+
+// reload loads the files again if they have been changed since last
+// loading, it returns whether the files are reloaded.
+func (l *certLoader) reload() (bool, error) {
+	var stamps []fileStamp
+	for _, f := range l.files() {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return false, err
+		}
+		stamps = append(stamps, fileStamp{modTime: fi.ModTime(), size: fi.Size()})
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.stamps != nil && equalStamps(l.stamps, stamps) {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(l.cert, l.key)
+	if err != nil {
+		return false, fmt.Errorf("failed to read X509 key pair (cert: %q, key: %q): %v", l.cert, l.key, err)
+	}
+
+	var pool *x509.CertPool
+	if l.ca != "" {
+		pem, err := ioutil.ReadFile(l.ca)
+		if err != nil {
+			return false, fmt.Errorf("failed to read CA certificate %q: %v", l.ca, err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return false, fmt.Errorf("failed to append certificates from PEM file: %q", l.ca)
+		}
+	}
+
+	l.stamps, l.certificate, l.pool = stamps, &certificate, pool
+	return true, nil
+}
+
+// current reloads the files if needed, and returns the current key pair and
+// CA. The ones loaded last time are used if it fails to reload, since the
+// files might be in the middle of replacing.
+func (l *certLoader) current() (*tls.Certificate, *x509.CertPool) {
+	if reloaded, err := l.reload(); err != nil {
+		log.With(nil).Warnf("failed to reload TLS certificates, use the previous ones: %v", err)
+	} else if reloaded {
+		log.With(nil).Infof("TLS certificates reloaded from %v", l.files())
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.certificate, l.pool
+}
+
+func (l *certLoader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	certificate, _ := l.current()
+	return certificate, nil
+}
+
+func (l *certLoader) verifyClientCertificates(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("client certificate is required")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse client certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	_, pool := l.current()
+	opts := x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	if _, err := certs[0].Verify(opts); err != nil {
+		return fmt.Errorf("failed to verify client certificate: %v", err)
+	}
+	return nil
+}
+
+func equalStamps(a, b []fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,216 @@
+package httputils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	tls  tls.Certificate
+}
+
+// newTestCert issues a certificate signed by parent, or a self-signed CA if
+// parent is nil.
+func newTestCert(t *testing.T, name string, usage x509.ExtKeyUsage, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+	}
+
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return &testCert{
+		cert: cert,
+		key:  key,
+		tls:  tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key},
+	}
+}
+
+// writeFiles writes the certificate and key in PEM format, the modification
+// time is set to mtime so that the change can be detected.
+func (c *testCert) writeFiles(t *testing.T, certFile, keyFile string, mtime time.Time) {
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	for file, block := range map[string]*pem.Block{
+		certFile: {Type: "CERTIFICATE", Bytes: c.cert.Raw},
+		keyFile:  {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	} {
+		if file == "" {
+			continue
+		}
+		if err := ioutil.WriteFile(file, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", file, err)
+		}
+		if err := os.Chtimes(file, mtime, mtime); err != nil {
+			t.Fatalf("failed to change times of %s: %v", file, err)
+		}
+	}
+}
+
+// handshake runs the TLS handshake over pipe, and returns the certificate
+// presented by server.
+func handshake(serverConfig, clientConfig *tls.Config) (*x509.Certificate, error) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		server := tls.Server(serverConn, serverConfig)
+		err := server.Handshake()
+		if err != nil {
+			serverConn.Close()
+		}
+		serverErr <- err
+	}()
+
+	client := tls.Client(clientConn, clientConfig)
+	clientErr := client.Handshake()
+	if clientErr != nil {
+		clientConn.Close()
+	}
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+	if clientErr != nil {
+		return nil, clientErr
+	}
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestParseTLSVersion(t *testing.T) {
+	v, err := ParseTLSVersion("1.2")
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), v)
+
+	_, err = ParseTLSVersion("1.4")
+	assert.Error(t, err)
+}
+
+func TestParseCipherSuites(t *testing.T) {
+	suites, err := ParseCipherSuites([]string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}, suites)
+
+	_, err = ParseCipherSuites([]string{"TLS_UNKNOWN"})
+	assert.Error(t, err)
+}
+
+func TestNewServerTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "httputils-tls")
+	if err != nil {
+		t.Fatalf("failed to create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		caFile   = filepath.Join(dir, "ca.pem")
+		certFile = filepath.Join(dir, "cert.pem")
+		keyFile  = filepath.Join(dir, "key.pem")
+		mtime    = time.Now().Add(-time.Minute)
+	)
+
+	ca := newTestCert(t, "ca", x509.ExtKeyUsageAny, nil)
+	ca.writeFiles(t, caFile, "", mtime)
+	newTestCert(t, "server1", x509.ExtKeyUsageServerAuth, ca).writeFiles(t, certFile, keyFile, mtime)
+
+	serverConfig, err := NewServerTLSConfig(ServerTLSOptions{
+		Key:          keyFile,
+		Cert:         certFile,
+		CA:           caFile,
+		VerifyRemote: true,
+		MinVersion:   "1.2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), serverConfig.MinVersion)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientConfig := &tls.Config{
+		ServerName:   "localhost",
+		RootCAs:      roots,
+		MaxVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{newTestCert(t, "client", x509.ExtKeyUsageClientAuth, ca).tls},
+	}
+
+	// client with the certificate signed by CA is accepted.
+	serverCert, err := handshake(serverConfig, clientConfig)
+	assert.NoError(t, err)
+	if assert.NotNil(t, serverCert) {
+		assert.Equal(t, "server1", serverCert.Subject.CommonName)
+	}
+
+	// client without certificate is rejected.
+	_, err = handshake(serverConfig, &tls.Config{ServerName: "localhost", RootCAs: roots, MaxVersion: tls.VersionTLS12})
+	assert.Error(t, err)
+
+	// client with the certificate signed by unknown CA is rejected.
+	other := newTestCert(t, "other", x509.ExtKeyUsageAny, nil)
+	_, err = handshake(serverConfig, &tls.Config{
+		ServerName:   "localhost",
+		RootCAs:      roots,
+		MaxVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{newTestCert(t, "client", x509.ExtKeyUsageClientAuth, other).tls},
+	})
+	assert.Error(t, err)
+
+	// the rotated certificate is used without creating config again.
+	newTestCert(t, "server2", x509.ExtKeyUsageServerAuth, ca).writeFiles(t, certFile, keyFile, mtime.Add(time.Second))
+	serverCert, err = handshake(serverConfig, clientConfig)
+	assert.NoError(t, err)
+	if assert.NotNil(t, serverCert) {
+		assert.Equal(t, "server2", serverCert.Subject.CommonName)
+	}
+
+	// the previous certificate is kept if the files are broken.
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte("broken"), 0600))
+	serverCert, err = handshake(serverConfig, clientConfig)
+	assert.NoError(t, err)
+	if assert.NotNil(t, serverCert) {
+		assert.Equal(t, "server2", serverCert.Subject.CommonName)
+	}
+}
@@ -19,6 +19,12 @@ const (
 	PullStatusExists = "exists"
 	// PullStatusDone represents done status.
 	PullStatusDone = "done"
+	// PullStatusExtracting represents extracting status.
+	PullStatusExtracting = "extracting"
+	// PullStatusExtracted represents extracted status.
+	PullStatusExtracted = "extracted"
+	// PullStatusMounted represents the layer is mounted from remote by lazy pulling.
+	PullStatusMounted = "mounted"
 
 	// PushStatusUploading represents uploading status.
 	PushStatusUploading = "uploading"
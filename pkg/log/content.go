@@ -26,6 +26,15 @@ func Init(debug bool) {
 	logrus.SetFormatter(formatter)
 }
 
+// SetDebug switches log level between DEBUG and INFO.
+func SetDebug(debug bool) {
+	if debug {
+		logrus.SetLevel(logrus.DebugLevel)
+	} else {
+		logrus.SetLevel(logrus.InfoLevel)
+	}
+}
+
 // NewContext returns new log entry, if context has old entry, it will be overwrite
 func NewContext(ctx context.Context, fields map[string]interface{}) context.Context {
 	if ctx == nil {
@@ -0,0 +1,238 @@
+package nri
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	// Version is the version of NRI protocol implemented by pouchd.
+	Version = "0.1"
+
+	// DefaultConfPath is the default path of the config of NRI plugins.
+	DefaultConfPath = "/etc/nri/conf.json"
+
+	// DefaultBinaryPath is the default directory of the NRI plugin binaries.
+	DefaultBinaryPath = "/opt/nri/bin"
+)
+
+// invokeTimeout is the time to wait for each plugin, the plugin is killed
+// after timeout so that the lifecycle of container isn't blocked by it.
+var invokeTimeout = 10 * time.Second
+
+// State is the lifecycle event of container the plugins are invoked at.
+type State string
+
+const (
+	// Create is invoked after the task of container is created and
+	// before it starts, failure of any plugin fails the start.
+	Create State = "create"
+
+	// Delete is invoked before the task of container is deleted.
+	Delete State = "delete"
+
+	// Update is invoked after the resources of container are updated.
+	Update State = "update"
+
+	// Pause is invoked after the container is paused.
+	Pause State = "pause"
+
+	// Resume is invoked after the container is unpaused.
+	Resume State = "resume"
+)
+
+// ConfigList is the config of NRI plugins invoked in order.
+type ConfigList struct {
+	// Version is the version of NRI protocol.
+	Version string `json:"version"`
+
+	// Plugins are invoked in order.
+	Plugins []*Plugin `json:"plugins"`
+}
+
+// Plugin is the config of a NRI plugin.
+type Plugin struct {
+	// Type is the name of plugin binary in the binary directory.
+	Type string `json:"type"`
+
+	// Conf is passed to the plugin in the request.
+	Conf json.RawMessage `json:"conf,omitempty"`
+}
+
+// Spec is the part of OCI spec of container passed to the plugins.
+type Spec struct {
+	// Resources are the linux resources of container.
+	Resources json.RawMessage `json:"resources"`
+
+	// Namespaces are the paths of namespaces keyed by their types.
+	Namespaces map[string]string `json:"namespaces"`
+
+	// CgroupsPath is the cgroups path of container.
+	CgroupsPath string `json:"cgroupsPath"`
+
+	// Annotations are the annotations of container.
+	Annotations map[string]string `json:"annotations"`
+}
+
+// Request is written to the stdin of plugin binary.
+type Request struct {
+	// Version is the version of NRI protocol.
+	Version string `json:"version"`
+
+	// ID is the id of container.
+	ID string `json:"id"`
+
+	// SandboxID is the id of pod sandbox of container, if it's created
+	// by CRI.
+	SandboxID string `json:"sandboxID,omitempty"`
+
+	// Pid is the pid of init process of container.
+	Pid int `json:"pid,omitempty"`
+
+	// State is the lifecycle event of container.
+	State State `json:"state"`
+
+	// Spec is the part of OCI spec of container.
+	Spec *Spec `json:"spec"`
+
+	// Labels are the labels of container.
+	Labels map[string]string `json:"labels"`
+
+	// Conf is the config of plugin.
+	Conf json.RawMessage `json:"conf"`
+
+	// Results are the results of the plugins invoked before.
+	Results []*Result `json:"results,omitempty"`
+}
+
+// Result is written to the stdout of plugin binary.
+type Result struct {
+	// Version is the version of NRI protocol.
+	Version string `json:"version"`
+
+	// Plugin is the type of plugin.
+	Plugin string `json:"plugin"`
+
+	// Error is the error of plugin, empty if it succeeds.
+	Error string `json:"error,omitempty"`
+
+	// Metadata is the metadata returned by plugin.
+	Metadata map[string]string `json:"metadata"`
+}
+
+// NewSpec converts the OCI spec to the spec passed to the plugins.
+func NewSpec(spec *specs.Spec) (*Spec, error) {
+	s := &Spec{
+		Namespaces:  make(map[string]string),
+		Annotations: spec.Annotations,
+	}
+	if spec.Linux == nil {
+		return s, nil
+	}
+
+	data, err := json.Marshal(spec.Linux.Resources)
+	if err != nil {
+		return nil, err
+	}
+	s.Resources = json.RawMessage(data)
+	s.CgroupsPath = spec.Linux.CgroupsPath
+	for _, ns := range spec.Linux.Namespaces {
+		s.Namespaces[string(ns.Type)] = ns.Path
+	}
+	return s, nil
+}
+
+// Client invokes the NRI plugins.
+type Client struct {
+	conf    *ConfigList
+	binPath string
+}
+
+// New loads the config of NRI plugins, nil is returned if the config
+// doesn't exist, which means there is no plugin to invoke.
+func New(confPath, binPath string) (*Client, error) {
+	data, err := ioutil.ReadFile(confPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	conf := &ConfigList{}
+	if err := json.Unmarshal(data, conf); err != nil {
+		return nil, fmt.Errorf("failed to parse NRI config %s: %v", confPath, err)
+	}
+	for _, p := range conf.Plugins {
+		if p == nil || p.Type == "" || strings.Contains(p.Type, "/") {
+			return nil, fmt.Errorf("invalid plugin in NRI config %s, type should be the name of plugin binary", confPath)
+		}
+	}
+
+	return &Client{
+		conf:    conf,
+		binPath: binPath,
+	}, nil
+}
+
+// Invoke invokes the plugins in order with the request, the results of the
+// plugins invoked before are passed to the next one. It stops at the first
+// plugin which fails.
+func (c *Client) Invoke(ctx context.Context, r *Request) ([]*Result, error) {
+	r.Version = c.conf.Version
+	if r.Version == "" {
+		r.Version = Version
+	}
+
+	for _, p := range c.conf.Plugins {
+		r.Conf = p.Conf
+		result, err := c.invoke(ctx, p.Type, r)
+		if err != nil {
+			return r.Results, err
+		}
+		r.Results = append(r.Results, result)
+	}
+	return r.Results, nil
+}
+
+// invoke runs the plugin binary with the request on stdin.
+func (c *Client) invoke(ctx context.Context, name string, r *Request) (*Result, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, invokeTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, filepath.Join(c.binPath, name), "invoke")
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timeout to invoke NRI plugin %s after %v", name, invokeTimeout)
+		}
+		return nil, fmt.Errorf("failed to invoke NRI plugin %s: %v, output: %s, stderr: %s", name, err, strings.TrimSpace(stdout.String()), strings.TrimSpace(stderr.String()))
+	}
+
+	result := &Result{}
+	if err := json.Unmarshal(stdout.Bytes(), result); err != nil {
+		return nil, fmt.Errorf("failed to parse result of NRI plugin %s: %v", name, err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("NRI plugin %s failed: %s", name, result.Error)
+	}
+	return result, nil
+}
@@ -0,0 +1,107 @@
+package nri
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-nri-new")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	client, err := New(filepath.Join(tmpDir, "none.json"), tmpDir)
+	assert.NoError(t, err)
+	assert.Nil(t, client)
+
+	confPath := filepath.Join(tmpDir, "conf.json")
+	for _, conf := range []string{`{`, `{"plugins": [{"type": ""}]}`, `{"plugins": [{"type": "../clearcfs"}]}`} {
+		assert.NoError(t, ioutil.WriteFile(confPath, []byte(conf), 0644))
+		_, err := New(confPath, tmpDir)
+		assert.Error(t, err, conf)
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-nri-invoke")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	writePlugin := func(name, script string) {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, name), []byte("#!/bin/sh\n"+script+"\n"), 0755))
+	}
+	// first checks the request, second checks the result of first.
+	writePlugin("first", `in=$(cat); [ "$1" = invoke ] && echo "$in" | grep -q '"state":"create"' && echo "$in" | grep -q '"conf":{"a":1}' && echo '{"version": "0.1", "plugin": "first", "metadata": {"k": "v"}}'`)
+	writePlugin("second", `grep -q '"plugin":"first"' && echo '{"version": "0.1", "plugin": "second"}'`)
+	writePlugin("failed", `echo '{"version": "0.1", "plugin": "failed", "error": "no resources"}'`)
+	writePlugin("exited", `echo oops >&2; exit 1`)
+
+	confPath := filepath.Join(tmpDir, "conf.json")
+	newClient := func(plugins ...string) *Client {
+		conf := &ConfigList{Version: Version}
+		for _, p := range plugins {
+			conf.Plugins = append(conf.Plugins, &Plugin{Type: p, Conf: json.RawMessage(`{"a":1}`)})
+		}
+		data, err := json.Marshal(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(confPath, data, 0644))
+
+		client, err := New(confPath, tmpDir)
+		assert.NoError(t, err)
+		return client
+	}
+
+	results, err := newClient("first", "second").Invoke(context.Background(), &Request{ID: "c1", State: Create})
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, map[string]string{"k": "v"}, results[0].Metadata)
+		assert.Equal(t, "second", results[1].Plugin)
+	}
+
+	results, err = newClient("first", "failed", "second").Invoke(context.Background(), &Request{ID: "c1", State: Create})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no resources")
+	assert.Len(t, results, 1)
+
+	_, err = newClient("exited").Invoke(context.Background(), &Request{ID: "c1", State: Delete})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "oops")
+
+	// the plugin is killed after timeout.
+	writePlugin("hung", `exec sleep 10`)
+	defer func(timeout time.Duration) { invokeTimeout = timeout }(invokeTimeout)
+	invokeTimeout = 100 * time.Millisecond
+	start := time.Now()
+	_, err = newClient("hung").Invoke(context.Background(), &Request{ID: "c1", State: Delete})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout")
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestNewSpec(t *testing.T) {
+	memory := int64(1 << 20)
+	s, err := NewSpec(&specs.Spec{
+		Annotations: map[string]string{"a": "b"},
+		Linux: &specs.Linux{
+			CgroupsPath: "/default/c1",
+			Resources:   &specs.LinuxResources{Memory: &specs.LinuxMemory{Limit: &memory}},
+			Namespaces: []specs.LinuxNamespace{
+				{Type: specs.NetworkNamespace, Path: "/proc/1/ns/net"},
+				{Type: specs.PIDNamespace},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/default/c1", s.CgroupsPath)
+	assert.Equal(t, map[string]string{"a": "b"}, s.Annotations)
+	assert.Equal(t, map[string]string{"network": "/proc/1/ns/net", "pid": ""}, s.Namespaces)
+	assert.JSONEq(t, `{"memory": {"limit": 1048576}}`, string(s.Resources))
+}
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"sync"
 )
 
 // Scheduler is an interface that implement a function
@@ -25,6 +26,15 @@ type Factory interface {
 	Consume(goods int) error
 }
 
+// HealthyFactory is a Factory which reports its health, the unhealthy ones
+// are skipped by RoundRobinScheduler.
+type HealthyFactory interface {
+	Factory
+
+	// Healthy returns whether the factory is available now.
+	Healthy() bool
+}
+
 // LRUScheduler is a Least Recently Used scheduler.
 type LRUScheduler struct {
 	pool []Factory
@@ -64,3 +74,51 @@ func (lru *LRUScheduler) Schedule(ctx context.Context) (Factory, error) {
 
 	return lru.pool[index], nil
 }
+
+// RoundRobinScheduler chooses the items in turn, the unhealthy items and the
+// items without goods are skipped.
+type RoundRobinScheduler struct {
+	mu   sync.Mutex
+	pool []Factory
+	next int
+}
+
+// NewRoundRobinScheduler new a round robin scheduler.
+func NewRoundRobinScheduler(pool []Factory) (Scheduler, error) {
+	return &RoundRobinScheduler{
+		pool: pool,
+	}, nil
+}
+
+// Schedule is to choose the next candidate.
+func (rr *RoundRobinScheduler) Schedule(ctx context.Context) (Factory, error) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	if len(rr.pool) == 0 {
+		return nil, fmt.Errorf("empty candidate list")
+	}
+
+	healthy := false
+	for i := 0; i < len(rr.pool); i++ {
+		index := (rr.next + i) % len(rr.pool)
+		f := rr.pool[index]
+
+		if hf, ok := f.(HealthyFactory); ok && !hf.Healthy() {
+			continue
+		}
+		healthy = true
+
+		if f.Value() <= 0 {
+			continue
+		}
+
+		rr.next = index + 1
+		return f, nil
+	}
+
+	if !healthy {
+		return nil, fmt.Errorf("no healthy candidate")
+	}
+	return nil, fmt.Errorf("resources exhausted")
+}
@@ -122,3 +122,49 @@ func TestLRUScheduler_Schedule(t *testing.T) {
 		})
 	}
 }
+
+type testHealthyFactory struct {
+	testFactory
+
+	healthy bool
+}
+
+func (tf *testHealthyFactory) Healthy() bool {
+	return tf.healthy
+}
+
+func TestRoundRobinScheduler_Schedule(t *testing.T) {
+	if _, err := (&RoundRobinScheduler{}).Schedule(context.Background()); err == nil {
+		t.Errorf("RoundRobinScheduler.Schedule() expected error of empty pool")
+	}
+
+	var (
+		f0 = &testHealthyFactory{testFactory: testFactory{data: 1}, healthy: true}
+		f1 = &testHealthyFactory{testFactory: testFactory{data: 1}, healthy: false}
+		f2 = &testHealthyFactory{testFactory: testFactory{data: 0}, healthy: true}
+		f3 = &testHealthyFactory{testFactory: testFactory{data: 1}, healthy: true}
+	)
+	rr, _ := NewRoundRobinScheduler([]Factory{f0, f1, f2, f3})
+
+	// the unhealthy and exhausted candidates are skipped.
+	for _, want := range []Factory{f0, f3, f0, f3} {
+		got, err := rr.Schedule(context.Background())
+		if err != nil {
+			t.Fatalf("RoundRobinScheduler.Schedule() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("RoundRobinScheduler.Schedule() = %v, want %v", got, want)
+		}
+	}
+
+	f0.Consume(1)
+	f3.Consume(1)
+	if _, err := rr.Schedule(context.Background()); err == nil || err.Error() != "resources exhausted" {
+		t.Errorf("RoundRobinScheduler.Schedule() error = %v, want resources exhausted", err)
+	}
+
+	f0.healthy, f2.healthy, f3.healthy = false, false, false
+	if _, err := rr.Schedule(context.Background()); err == nil || err.Error() != "no healthy candidate" {
+		t.Errorf("RoundRobinScheduler.Schedule() error = %v, want no healthy candidate", err)
+	}
+}
@@ -0,0 +1,119 @@
+package streams
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefaultDetachKeys is the default key sequence for detaching a container.
+const DefaultDetachKeys = "ctrl-p,ctrl-q"
+
+// ErrDetached is used to indicate the caller has detached from the stream by
+// typing the detach key sequence.
+var ErrDetached = errors.New("detached from stream")
+
+// ParseDetachKeys parses the key sequence like "ctrl-p,ctrl-q" into bytes.
+// The key is a single ASCII character, or ctrl- combined with one of a-z, @,
+// [, \, ], ^ and _.
+func ParseDetachKeys(keys string) ([]byte, error) {
+	var codes []byte
+	for _, key := range strings.Split(keys, ",") {
+		if len(key) == 1 {
+			if key[0] > 0x7f {
+				return nil, fmt.Errorf("invalid detach key %q: should be an ASCII character", key)
+			}
+			codes = append(codes, key[0])
+			continue
+		}
+
+		lower := strings.ToLower(key)
+		if !strings.HasPrefix(lower, "ctrl-") || len(lower) != len("ctrl-")+1 {
+			return nil, fmt.Errorf("invalid detach key %q: should be a character or ctrl-<value>", key)
+		}
+
+		c := lower[len(lower)-1]
+		switch {
+		case c >= 'a' && c <= 'z':
+			codes = append(codes, c-'a'+1)
+		case c == '@':
+			codes = append(codes, 0)
+		case c >= '[' && c <= '_':
+			codes = append(codes, c-'['+0x1b)
+		default:
+			return nil, fmt.Errorf("invalid detach key %q: unknown control character", key)
+		}
+	}
+	return codes, nil
+}
+
+// detachReader watches the detach key sequence in the input, it returns
+// ErrDetached after the data before the sequence has been read. The partial
+// sequence is passed through if the following input doesn't match.
+type detachReader struct {
+	r    io.ReadCloser
+	keys []byte
+
+	matched int
+	pending []byte
+	err     error
+}
+
+// NewDetachReader returns the reader which watches the detach keys in r, it
+// returns r directly if the keys are empty.
+func NewDetachReader(r io.ReadCloser, keys []byte) io.ReadCloser {
+	if len(keys) == 0 {
+		return r
+	}
+	return &detachReader{r: r, keys: keys}
+}
+
+// Read implements the io.Reader interface.
+func (d *detachReader) Read(p []byte) (int, error) {
+	for len(d.pending) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+
+		buf := make([]byte, len(p))
+		n, err := d.r.Read(buf)
+		d.err = err
+
+		out := make([]byte, 0, n)
+		for _, b := range buf[:n] {
+			if b == d.keys[d.matched] {
+				d.matched++
+				if d.matched == len(d.keys) {
+					d.err = ErrDetached
+					break
+				}
+				continue
+			}
+
+			// the partial sequence is the normal input.
+			out = append(out, d.keys[:d.matched]...)
+			d.matched = 0
+			if b == d.keys[0] {
+				d.matched = 1
+				continue
+			}
+			out = append(out, b)
+		}
+
+		if d.err != nil && d.err != ErrDetached {
+			out = append(out, d.keys[:d.matched]...)
+			d.matched = 0
+		}
+		d.pending = out
+	}
+
+	n := copy(p, d.pending)
+	d.pending = d.pending[n:]
+	return n, nil
+}
+
+// Close implements the io.Closer interface.
+func (d *detachReader) Close() error {
+	return d.r.Close()
+}
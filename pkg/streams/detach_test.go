@@ -0,0 +1,139 @@
+package streams
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+func TestParseDetachKeys(t *testing.T) {
+	for _, tc := range []struct {
+		keys     string
+		expected []byte
+		hasErr   bool
+	}{
+		{keys: "ctrl-p,ctrl-q", expected: []byte{0x10, 0x11}},
+		{keys: "ctrl-A,a,ctrl-@", expected: []byte{0x01, 'a', 0x00}},
+		{keys: "ctrl-[,ctrl-\\,ctrl-_", expected: []byte{0x1b, 0x1c, 0x1f}},
+		{keys: "ctrl-1", hasErr: true},
+		{keys: "ctrl-pq", hasErr: true},
+		{keys: "alt-p", hasErr: true},
+		{keys: "", hasErr: true},
+	} {
+		got, err := ParseDetachKeys(tc.keys)
+		if tc.hasErr {
+			if err == nil {
+				t.Fatalf("expected error for detach keys %q, but got %v", tc.keys, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error for detach keys %q: %v", tc.keys, err)
+		}
+		if !bytes.Equal(got, tc.expected) {
+			t.Fatalf("expected %v for detach keys %q, but got %v", tc.expected, tc.keys, got)
+		}
+	}
+}
+
+func TestDetachReader(t *testing.T) {
+	keys := []byte{0x10, 0x11}
+
+	for _, tc := range []struct {
+		input    string
+		expected string
+		detached bool
+	}{
+		{input: "hello", expected: "hello"},
+		{input: "hello\x10\x11world", expected: "hello", detached: true},
+		{input: "\x10hello\x10", expected: "\x10hello\x10"},
+		{input: "\x10\x10\x11", expected: "\x10", detached: true},
+	} {
+		for _, oneByte := range []bool{false, true} {
+			var r io.Reader = bytes.NewBufferString(tc.input)
+			if oneByte {
+				r = iotest.OneByteReader(r)
+			}
+
+			got, err := ioutil.ReadAll(NewDetachReader(ioutil.NopCloser(r), keys))
+			if tc.detached != (err == ErrDetached) {
+				t.Fatalf("expected detached %v for input %q, but got error %v", tc.detached, tc.input, err)
+			}
+			if !tc.detached && err != nil {
+				t.Fatalf("unexpected error for input %q: %v", tc.input, err)
+			}
+			if string(got) != tc.expected {
+				t.Fatalf("expected %q for input %q, but got %q", tc.expected, tc.input, got)
+			}
+		}
+	}
+}
+
+func TestAttachWithDetachKeys(t *testing.T) {
+	stdinR, stdinW := io.Pipe()
+	aStdout := bytes.NewBuffer(nil)
+
+	stream := NewStream()
+	stream.NewStdinInput()
+	defer stream.Close()
+
+	attachErr := stream.Attach(context.Background(), &AttachConfig{
+		UseStdin:   true,
+		Stdin:      stdinR,
+		UseStdout:  true,
+		Stdout:     aStdout,
+		CloseStdin: true,
+		Terminal:   true,
+		DetachKeys: []byte{0x10, 0x11},
+	})
+
+	go stdinW.Write([]byte("hello\x10\x11"))
+
+	got := make([]byte, 5)
+	if _, err := io.ReadFull(stream.Stdin(), got); err != nil || string(got) != "hello" {
+		t.Fatalf("expected to get hello from stdin, but got %q, %v", got, err)
+	}
+
+	select {
+	case err := <-attachErr:
+		if err != ErrDetached {
+			t.Fatalf("expected ErrDetached, but got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected attaching to be done after detaching")
+	}
+
+	// the stdin of process is kept open after detaching.
+	go stream.StdinPipe().Write([]byte("world"))
+	if _, err := io.ReadFull(stream.Stdin(), got); err != nil || string(got) != "world" {
+		t.Fatalf("expected stdin to be open after detaching, but got %q, %v", got, err)
+	}
+}
+
+func TestAttachWithoutTerminalIgnoresDetachKeys(t *testing.T) {
+	stdinR, stdinW := io.Pipe()
+
+	stream := NewStream()
+	stream.NewStdinInput()
+	defer stream.Close()
+
+	stream.Attach(context.Background(), &AttachConfig{
+		UseStdin:   true,
+		Stdin:      stdinR,
+		DetachKeys: []byte{0x10, 0x11},
+	})
+
+	// the binary input is passed through without terminal.
+	input := "hello\x10\x11world"
+	go stdinW.Write([]byte(input))
+
+	got := make([]byte, len(input))
+	if _, err := io.ReadFull(stream.Stdin(), got); err != nil || string(got) != input {
+		t.Fatalf("expected to get %q from stdin, but got %q, %v", input, got, err)
+	}
+}
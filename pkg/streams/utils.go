@@ -27,6 +27,12 @@ type AttachConfig struct {
 	// caller, the stdin of process's stream should be closed.
 	CloseStdin bool
 
+	// DetachKeys is the key sequence for detaching from the stream, the
+	// attaching returns ErrDetached and the stdin of process's stream is
+	// kept open after detaching. It only applies to the Terminal, so that
+	// the binary input is passed through as it is.
+	DetachKeys []byte
+
 	// UseStdin/UseStdout/UseStderr can be used to check the client's stream
 	// is nil or not. It is hard to check io.Write/io.ReadCloser != nil
 	// directly, because they might be specific type, which means
@@ -85,18 +91,43 @@ func (s *Stream) Attach(ctx context.Context, cfg *AttachConfig) <-chan error {
 		stdout, stderr io.ReadCloser
 	)
 
+	// NOTE: the pipes are created before attaching stdin, so that they can
+	// be closed after detaching.
+	if cfg.UseStdout {
+		stdout = s.NewStdoutPipe()
+	}
+	if cfg.UseStderr {
+		stderr = s.NewStderrPipe()
+	}
+
+	// NOTE: the detach keys are typed by user in terminal, the same bytes
+	// may be part of the binary input without terminal.
+	detachKeys := cfg.DetachKeys
+	if !cfg.Terminal {
+		detachKeys = nil
+	}
+
 	if cfg.UseStdin {
 		group.Go(func() error {
 			log.With(nil).Debug("start to attach stdin to stream")
 			defer log.With(nil).Debug("stop attach stdin to stream")
 
-			defer func() {
-				if cfg.CloseStdin {
-					s.StdinPipe().Close()
+			_, err := io.Copy(s.StdinPipe(), NewDetachReader(cfg.Stdin, detachKeys))
+			if err == ErrDetached {
+				// NOTE: the process keeps running after detaching, so
+				// the stdin of process should not be closed.
+				if cfg.UseStdout {
+					stdout.Close()
 				}
-			}()
+				if cfg.UseStderr {
+					stderr.Close()
+				}
+				return err
+			}
 
-			_, err := io.Copy(s.StdinPipe(), cfg.Stdin)
+			if cfg.CloseStdin {
+				s.StdinPipe().Close()
+			}
 			if err == io.ErrClosedPipe {
 				err = nil
 			}
@@ -128,14 +159,12 @@ func (s *Stream) Attach(ctx context.Context, cfg *AttachConfig) <-chan error {
 	}
 
 	if cfg.UseStdout {
-		stdout = s.NewStdoutPipe()
 		group.Go(func() error {
 			return attachFn("stdout", cfg.Stdout, stdout)
 		})
 	}
 
 	if cfg.UseStderr {
-		stderr = s.NewStderrPipe()
 		group.Go(func() error {
 			return attachFn("stderr", cfg.Stderr, stderr)
 		})
@@ -2,10 +2,29 @@ package system
 
 import (
 	"bufio"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
+)
+
+const (
+	// CgroupV1 means the cgroup controllers are mounted in legacy or hybrid
+	// hierarchy.
+	CgroupV1 = "1"
+
+	// CgroupV2 means the cgroup controllers are mounted in unified hierarchy.
+	CgroupV2 = "2"
+
+	// cgroupMountPoint is where the cgroup hierarchy is mounted.
+	cgroupMountPoint = "/sys/fs/cgroup"
+)
+
+var (
+	cgroupVersion     string
+	cgroupVersionOnce sync.Once
 )
 
 // MemoryCgroupInfo defines memory cgroup information on current machine
@@ -15,6 +34,7 @@ type MemoryCgroupInfo struct {
 	MemorySwap        bool
 	MemorySwappiness  bool
 	OOMKillDisable    bool
+	KernelMemory      bool
 }
 
 // CPUCgroupInfo defines cpu cgroup information on current machine
@@ -43,24 +63,81 @@ type PidsCgroupInfo struct {
 
 // CgroupInfo defines cgroup information on current machine
 type CgroupInfo struct {
-	Memory *MemoryCgroupInfo
-	CPU    *CPUCgroupInfo
-	Blkio  *BlkioCgroupInfo
-	Pids   *PidsCgroupInfo
+	Version string
+	Memory  *MemoryCgroupInfo
+	CPU     *CPUCgroupInfo
+	Blkio   *BlkioCgroupInfo
+	Pids    *PidsCgroupInfo
+}
+
+// GetCgroupVersion returns the version of cgroup hierarchy on current
+// machine, it is detected only once since the hierarchy can't be changed
+// without reboot.
+func GetCgroupVersion() string {
+	cgroupVersionOnce.Do(func() {
+		cgroupVersion = getCgroupVersion("/proc/self/mountinfo")
+	})
+	return cgroupVersion
 }
 
 // NewCgroupInfo news a CgroupInfo struct
 func NewCgroupInfo() *CgroupInfo {
+	if GetCgroupVersion() == CgroupV2 {
+		return getUnifiedCgroupInfo(cgroupMountPoint)
+	}
+
 	cgroupRootPath := getCgroupRootMount("/proc/self/mountinfo")
 	if cgroupRootPath == "" {
 		return nil
 	}
 
 	return &CgroupInfo{
-		Memory: getMemoryCgroupInfo(cgroupRootPath),
-		CPU:    getCPUCgroupInfo(cgroupRootPath),
-		Blkio:  getBlkioCgroupInfo(cgroupRootPath),
-		Pids:   getPidsCgroupInfo(cgroupRootPath),
+		Version: CgroupV1,
+		Memory:  getMemoryCgroupInfo(cgroupRootPath),
+		CPU:     getCPUCgroupInfo(cgroupRootPath),
+		Blkio:   getBlkioCgroupInfo(cgroupRootPath),
+		Pids:    getPidsCgroupInfo(cgroupRootPath),
+	}
+}
+
+// getUnifiedCgroupInfo gets the cgroup information from the controllers
+// available in unified hierarchy. The swappiness, oom kill disable, kernel
+// memory and per-device weight have no equivalent in cgroup v2.
+func getUnifiedCgroupInfo(root string) *CgroupInfo {
+	data, err := ioutil.ReadFile(path.Join(root, "cgroup.controllers"))
+	if err != nil {
+		return nil
+	}
+
+	controllers := map[string]bool{}
+	for _, c := range strings.Fields(string(data)) {
+		controllers[c] = true
+	}
+
+	return &CgroupInfo{
+		Version: CgroupV2,
+		Memory: &MemoryCgroupInfo{
+			MemoryLimit:       controllers["memory"],
+			MemoryReservation: controllers["memory"],
+			MemorySwap:        controllers["memory"],
+		},
+		CPU: &CPUCgroupInfo{
+			CpusetCpus: controllers["cpuset"],
+			CpusetMems: controllers["cpuset"],
+			CPUShares:  controllers["cpu"],
+			CPUPeriod:  controllers["cpu"],
+			CPUQuota:   controllers["cpu"],
+		},
+		Blkio: &BlkioCgroupInfo{
+			BlkioWeight:          controllers["io"],
+			BlkioDeviceReadBps:   controllers["io"],
+			BlkioDeviceWriteBps:  controllers["io"],
+			BlkioDeviceReadIOps:  controllers["io"],
+			BlkioDeviceWriteIOps: controllers["io"],
+		},
+		Pids: &PidsCgroupInfo{
+			Pids: controllers["pids"],
+		},
 	}
 }
 
@@ -72,6 +149,7 @@ func getMemoryCgroupInfo(root string) *MemoryCgroupInfo {
 		MemorySwap:        isCgroupEnable(path, "memory.memsw.limit_in_bytes"),
 		MemorySwappiness:  isCgroupEnable(path, "memory.swappiness"),
 		OOMKillDisable:    isCgroupEnable(path, "memory.oom_control"),
+		KernelMemory:      isCgroupEnable(path, "memory.kmem.limit_in_bytes"),
 	}
 }
 
@@ -143,3 +221,34 @@ func getCgroupRootMount(mountFile string) string {
 
 	return cgroupRootPath
 }
+
+// getCgroupVersion returns CgroupV2 if the unified hierarchy is mounted on
+// cgroupMountPoint, otherwise returns CgroupV1. The hybrid mode, which
+// mounts the unified hierarchy on a sub directory, is treated as v1.
+func getCgroupVersion(mountFile string) string {
+	f, err := os.Open(mountFile)
+	if err != nil {
+		return CgroupV1
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		text := scanner.Text()
+		index := strings.Index(text, " - ")
+		if index < 0 {
+			continue
+		}
+		fields := strings.Split(text, " ")
+		postSeparatorFields := strings.Fields(text[index+3:])
+
+		if len(fields) < 5 || len(postSeparatorFields) < 1 || fields[4] != cgroupMountPoint {
+			continue
+		}
+
+		if postSeparatorFields[0] == "cgroup2" {
+			return CgroupV2
+		}
+	}
+	return CgroupV1
+}
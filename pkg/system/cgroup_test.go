@@ -84,3 +84,59 @@ func TestGetCgroupRootMount(t *testing.T) {
 		assert.Equal(tc.cgroupMount, getCgroupRootMount(file))
 	}
 }
+
+func TestGetCgroupVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "test-cgroup-version")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+	file := filepath.Join(tmpDir, "mountinfo")
+
+	for _, tc := range []struct {
+		version string
+		data    string
+	}{
+		{
+			version: CgroupV1,
+			data:    "",
+		},
+		{
+			version: CgroupV1,
+			data:    "25 18 0:22 / /sys/fs/cgroup ro,nosuid,nodev,noexec shared:9 - tmpfs tmpfs ro,mode=755\n" + "26 25 0:23 / /sys/fs/cgroup/unified rw,nosuid,nodev,noexec,relatime shared:10 - cgroup2 cgroup2 rw",
+		},
+		{
+			version: CgroupV2,
+			data:    "25 18 0:22 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime shared:9 - cgroup2 cgroup2 rw,nsdelegate",
+		},
+	} {
+		err := ioutil.WriteFile(file, []byte(tc.data), 0644)
+		assert.NoError(err)
+		assert.Equal(tc.version, getCgroupVersion(file))
+	}
+
+	assert.Equal(CgroupV1, getCgroupVersion(filepath.Join(tmpDir, "foo")))
+}
+
+func TestGetUnifiedCgroupInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "test-cgroup-unified")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	assert.Nil(getUnifiedCgroupInfo(tmpDir))
+
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "cgroup.controllers"), []byte("cpu io memory pids\n"), 0644)
+	assert.NoError(err)
+
+	info := getUnifiedCgroupInfo(tmpDir)
+	assert.Equal(CgroupV2, info.Version)
+	assert.True(info.Memory.MemoryLimit)
+	assert.False(info.Memory.MemorySwappiness)
+	assert.True(info.CPU.CPUQuota)
+	assert.False(info.CPU.CpusetCpus)
+	assert.True(info.Blkio.BlkioWeight)
+	assert.False(info.Blkio.BlkioWeightDevice)
+	assert.True(info.Pids.Pids)
+}
@@ -0,0 +1,135 @@
+package system
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// numaNodePath is where the NUMA nodes are listed.
+	numaNodePath = "/sys/devices/system/node"
+
+	// onlineCPUFile lists the online CPUs of host.
+	onlineCPUFile = "/sys/devices/system/cpu/online"
+)
+
+// NumaNode defines a NUMA node and its CPUs.
+type NumaNode struct {
+	ID   int
+	CPUs []int
+}
+
+// GetNumaTopology returns the NUMA nodes of current machine sorted by ID, the
+// memory-only node has no CPU. The host without NUMA is treated as one node
+// containing all the online CPUs.
+func GetNumaTopology() ([]NumaNode, error) {
+	return getNumaTopology(numaNodePath, onlineCPUFile)
+}
+
+func getNumaTopology(nodePath, onlineFile string) ([]NumaNode, error) {
+	dirs, err := filepath.Glob(filepath.Join(nodePath, "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []NumaNode
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, "cpulist"))
+		if err != nil {
+			return nil, err
+		}
+		// the cpulist of memory-only node is empty.
+		cpus, err := ParseCPUList(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, NumaNode{ID: id, CPUs: cpus})
+	}
+
+	if len(nodes) == 0 {
+		data, err := ioutil.ReadFile(onlineFile)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		cpus, err := ParseCPUList(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, err
+		}
+		if len(cpus) == 0 {
+			return nil, fmt.Errorf("no online CPU found")
+		}
+		nodes = append(nodes, NumaNode{ID: 0, CPUs: cpus})
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+	return nodes, nil
+}
+
+// ParseCPUList parses the list format of cpuset like "0-3,8", the result is
+// sorted without duplicates.
+func ParseCPUList(list string) ([]int, error) {
+	set := map[int]bool{}
+	for _, r := range strings.Split(list, ",") {
+		if r == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(r, "-", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid cpu list %q", list)
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(bounds[1])
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid cpu list %q", list)
+			}
+		}
+
+		for i := start; i <= end; i++ {
+			set[i] = true
+		}
+	}
+
+	ids := make([]int, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// FormatCPUList formats the ids into the list format of cpuset, consecutive
+// ids are merged into a range.
+func FormatCPUList(ids []int) string {
+	sorted := append([]int(nil), ids...)
+	sort.Ints(sorted)
+
+	var ranges []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[i] == sorted[j] {
+			ranges = append(ranges, strconv.Itoa(sorted[i]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ranges, ",")
+}
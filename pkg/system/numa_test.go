@@ -0,0 +1,68 @@
+package system
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCPUList(t *testing.T) {
+	for input, expected := range map[string][]int{
+		"":          {},
+		"0":         {0},
+		"0-3":       {0, 1, 2, 3},
+		"0-1,4,6-7": {0, 1, 4, 6, 7},
+		"3,1-2,2":   {1, 2, 3},
+	} {
+		ids, err := ParseCPUList(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, ids, input)
+	}
+
+	for _, input := range []string{"a", "-1", "3-1", "1-a"} {
+		_, err := ParseCPUList(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestFormatCPUList(t *testing.T) {
+	assert.Equal(t, "", FormatCPUList(nil))
+	assert.Equal(t, "0", FormatCPUList([]int{0}))
+	assert.Equal(t, "0-3", FormatCPUList([]int{3, 2, 1, 0}))
+	assert.Equal(t, "0-1,4,6-7", FormatCPUList([]int{0, 1, 4, 6, 7}))
+}
+
+func TestGetNumaTopology(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test-numa-topology")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	nodePath := filepath.Join(tmpDir, "node")
+	onlineFile := filepath.Join(tmpDir, "online")
+	assert.NoError(t, ioutil.WriteFile(onlineFile, []byte("0-7\n"), 0644))
+
+	// the host without NUMA has one node.
+	nodes, err := getNumaTopology(nodePath, onlineFile)
+	assert.NoError(t, err)
+	assert.Equal(t, []NumaNode{{ID: 0, CPUs: []int{0, 1, 2, 3, 4, 5, 6, 7}}}, nodes)
+
+	for node, cpulist := range map[string]string{
+		"node0":  "0-1,4-5\n",
+		"node1":  "2-3,6-7\n",
+		"node10": "\n",
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(nodePath, node), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(nodePath, node, "cpulist"), []byte(cpulist), 0644))
+	}
+
+	nodes, err = getNumaTopology(nodePath, onlineFile)
+	assert.NoError(t, err)
+	assert.Equal(t, []NumaNode{
+		{ID: 0, CPUs: []int{0, 1, 4, 5}},
+		{ID: 1, CPUs: []int{2, 3, 6, 7}},
+		{ID: 10, CPUs: []int{}},
+	}, nodes)
+}
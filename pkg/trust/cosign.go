@@ -0,0 +1,102 @@
+package trust
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// TypeCosign is the type of cosign signature.
+	TypeCosign = "cosign"
+
+	// cosignSignatureAnnotation is the annotation of signature layer which
+	// holds the base64 encoded signature of the layer.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// cosignPayload is the simple signing payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// cosignSignatureTag returns the tag of cosign signatures of the manifest.
+func cosignSignatureTag(dgst digest.Digest) string {
+	return fmt.Sprintf("%s-%s.sig", dgst.Algorithm(), dgst.Hex())
+}
+
+// verifyCosign verifies the cosign signatures of the manifest in repository,
+// it returns the index of key verifying the signature.
+func verifyCosign(ctx context.Context, reg Registry, name string, dgst digest.Digest, keys []crypto.PublicKey) (int, error) {
+	ref := name + ":" + cosignSignatureTag(dgst)
+	desc, err := reg.Resolve(ctx, ref)
+	if err != nil {
+		return -1, fmt.Errorf("no cosign signature: %v", err)
+	}
+
+	var manifest ocispec.Manifest
+	if err := fetchJSON(ctx, reg, ref, desc, &manifest); err != nil {
+		return -1, fmt.Errorf("failed to fetch cosign signature manifest: %v", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		sig, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if err != nil || len(sig) == 0 {
+			continue
+		}
+		payload, err := fetchBlob(ctx, reg, ref, layer)
+		if err != nil {
+			return -1, fmt.Errorf("failed to fetch cosign signature payload: %v", err)
+		}
+
+		for i, key := range keys {
+			if verifySignature(key, crypto.SHA256, payload, sig) != nil {
+				continue
+			}
+
+			var p cosignPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return -1, fmt.Errorf("invalid cosign signature payload: %v", err)
+			}
+			if p.Critical.Image.DockerManifestDigest != dgst.String() {
+				return -1, fmt.Errorf("cosign signature is for manifest %s", p.Critical.Image.DockerManifestDigest)
+			}
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("no cosign signature verified by the keys")
+}
+
+// verifySignature verifies the signature of data by public key.
+func verifySignature(key crypto.PublicKey, hash crypto.Hash, data, sig []byte) error {
+	h := hash.New()
+	h.Write(data)
+	hashed := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hashed, sig) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, hash, hashed, sig) == nil {
+			return nil
+		}
+		return rsa.VerifyPSS(k, hash, hashed, sig, nil)
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
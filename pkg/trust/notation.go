@@ -0,0 +1,209 @@
+package trust
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// TypeNotation is the type of Notary v2 signature.
+	TypeNotation = "notation"
+
+	// notationArtifactType is the artifact type of Notary v2 signature.
+	notationArtifactType = "application/vnd.cncf.notary.signature"
+
+	// jwsMediaType is the media type of JWS signature envelope.
+	jwsMediaType = "application/jose+json"
+)
+
+// referrer is the descriptor in the index of referrers tag schema, which
+// carries the artifact type.
+type referrer struct {
+	ocispec.Descriptor
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
+// referrersIndex is the index listing the referrers of a manifest.
+type referrersIndex struct {
+	Manifests []referrer `json:"manifests"`
+}
+
+// signatureManifest is the manifest of signature artifact.
+type signatureManifest struct {
+	ArtifactType string               `json:"artifactType,omitempty"`
+	Config       ocispec.Descriptor   `json:"config"`
+	Layers       []ocispec.Descriptor `json:"layers"`
+}
+
+// jwsEnvelope is the JWS JSON serialization of Notary v2 signature.
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		CertChain []string `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+// notationPayload is the payload signed by Notary v2.
+type notationPayload struct {
+	TargetArtifact ocispec.Descriptor `json:"targetArtifact"`
+}
+
+// referrersTag returns the tag of referrers index of the manifest.
+func referrersTag(dgst digest.Digest) string {
+	return fmt.Sprintf("%s-%s", dgst.Algorithm(), dgst.Hex())
+}
+
+// verifyNotation verifies the Notary v2 signatures of the manifest in
+// repository, it returns the subject of certificate signing the manifest.
+func verifyNotation(ctx context.Context, reg Registry, name string, dgst digest.Digest, roots *x509.CertPool) (string, error) {
+	ref := name + ":" + referrersTag(dgst)
+	desc, err := reg.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("no notation signature: %v", err)
+	}
+
+	var index referrersIndex
+	if err := fetchJSON(ctx, reg, ref, desc, &index); err != nil {
+		return "", fmt.Errorf("failed to fetch referrers of manifest: %v", err)
+	}
+
+	for _, r := range index.Manifests {
+		if r.ArtifactType != notationArtifactType {
+			continue
+		}
+
+		var manifest signatureManifest
+		if err := fetchJSON(ctx, reg, ref, r.Descriptor, &manifest); err != nil {
+			return "", fmt.Errorf("failed to fetch notation signature manifest: %v", err)
+		}
+		for _, layer := range manifest.Layers {
+			if layer.MediaType != jwsMediaType {
+				continue
+			}
+			data, err := fetchBlob(ctx, reg, ref, layer)
+			if err != nil {
+				return "", fmt.Errorf("failed to fetch notation signature envelope: %v", err)
+			}
+			if signer, err := verifyJWS(data, dgst, roots); err == nil {
+				return signer, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no notation signature verified by the certificates")
+}
+
+// verifyJWS verifies the JWS envelope signs the manifest by the certificate
+// chain trusted by roots.
+func verifyJWS(data []byte, dgst digest.Digest, roots *x509.CertPool) (string, error) {
+	var env jwsEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return "", err
+	}
+	if len(env.Header.CertChain) == 0 {
+		return "", fmt.Errorf("no certificate chain in envelope")
+	}
+
+	var certs []*x509.Certificate
+	for _, c := range env.Header.CertChain {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return "", err
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return "", err
+		}
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return "", err
+	}
+
+	protected, err := base64.RawURLEncoding.DecodeString(env.Protected)
+	if err != nil {
+		return "", err
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return "", err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(env.Signature)
+	if err != nil {
+		return "", err
+	}
+	if err := verifyJWSSignature(header.Alg, certs[0].PublicKey, []byte(env.Protected+"."+env.Payload), sig); err != nil {
+		return "", err
+	}
+
+	rawPayload, err := base64.RawURLEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return "", err
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		return "", err
+	}
+	if payload.TargetArtifact.Digest != dgst {
+		return "", fmt.Errorf("notation signature is for manifest %s", payload.TargetArtifact.Digest)
+	}
+	return certs[0].Subject.String(), nil
+}
+
+// verifyJWSSignature verifies the JWS signature by algorithm, the ECDSA
+// signature of JWS is the concatenation of r and s.
+func verifyJWSSignature(alg string, key crypto.PublicKey, data, sig []byte) error {
+	hashes := map[string]crypto.Hash{
+		"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+		"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+	}
+	hash, ok := hashes[alg]
+	if !ok {
+		return fmt.Errorf("unsupported JWS algorithm %s", alg)
+	}
+	h := hash.New()
+	h.Write(data)
+	hashed := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if alg[0] != 'P' {
+			return fmt.Errorf("JWS algorithm %s mismatches RSA key", alg)
+		}
+		return rsa.VerifyPSS(k, hash, hashed, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case *ecdsa.PublicKey:
+		if alg[0] != 'E' || len(sig)%2 != 0 {
+			return fmt.Errorf("invalid JWS signature of algorithm %s", alg)
+		}
+		r := new(big.Int).SetBytes(sig[:len(sig)/2])
+		s := new(big.Int).SetBytes(sig[len(sig)/2:])
+		if !ecdsa.Verify(k, hashed, r, s) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
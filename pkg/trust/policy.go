@@ -0,0 +1,115 @@
+package trust
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// ModeEnforce rejects the image without valid signature.
+	ModeEnforce = "enforce"
+
+	// ModeWarn only warns about the image without valid signature.
+	ModeWarn = "warn"
+
+	// ModeOff skips the signature verification.
+	ModeOff = "off"
+
+	// anyRegistry matches all the registries in policies.
+	anyRegistry = "*"
+)
+
+// Policy defines how the images from a registry are verified.
+type Policy struct {
+	// Mode is the verification mode, can be enforce, warn or off.
+	Mode string `json:"mode,omitempty"`
+
+	// CosignKeys are the paths of PEM encoded public keys which verify the
+	// cosign signatures.
+	CosignKeys []string `json:"cosign-keys,omitempty"`
+
+	// NotationCerts are the paths of PEM encoded root certificates which
+	// verify the certificate chains of Notary v2 signatures.
+	NotationCerts []string `json:"notation-certs,omitempty"`
+}
+
+// policy is the loaded Policy with its keys and certificates.
+type policy struct {
+	mode       string
+	cosignKeys []crypto.PublicKey
+	keyFiles   []string
+	roots      *x509.CertPool
+
+	// fingerprint identifies the keys and certificates of policy, the
+	// result is only trusted by the policy with the same fingerprint.
+	fingerprint string
+}
+
+// loadPolicy validates the mode and loads the keys and certificates of policy.
+func loadPolicy(p Policy) (*policy, error) {
+	switch p.Mode {
+	case ModeEnforce, ModeWarn, ModeOff:
+	default:
+		return nil, fmt.Errorf("invalid mode %q, should be %s, %s or %s", p.Mode, ModeEnforce, ModeWarn, ModeOff)
+	}
+
+	loaded := &policy{mode: p.Mode}
+	if p.Mode == ModeOff {
+		return loaded, nil
+	}
+
+	// the content of keys and certificates rather than their paths are
+	// fingerprinted, so that the file replaced in place is not trusted.
+	var material []string
+	if len(p.CosignKeys) == 0 && len(p.NotationCerts) == 0 {
+		return nil, fmt.Errorf("no cosign key or notation certificate for mode %s", p.Mode)
+	}
+
+	for _, file := range p.CosignKeys {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM data in cosign key %s", file)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cosign key %s: %v", file, err)
+		}
+		loaded.cosignKeys = append(loaded.cosignKeys, key)
+		loaded.keyFiles = append(loaded.keyFiles, file)
+		material = append(material, "cosign:"+digest.FromBytes(block.Bytes).String())
+	}
+
+	if len(p.NotationCerts) != 0 {
+		loaded.roots = x509.NewCertPool()
+	}
+	for _, file := range p.NotationCerts {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if !loaded.roots.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate in notation certificate %s", file)
+		}
+		material = append(material, "notation:"+digest.FromBytes(data).String())
+	}
+
+	sort.Strings(material)
+	loaded.fingerprint = digest.FromString(strings.Join(material, "\n")).String()
+	return loaded, nil
+}
+
+// registryDomain returns the domain of the fully qualified repository name.
+func registryDomain(name string) string {
+	return strings.SplitN(name, "/", 2)[0]
+}
@@ -0,0 +1,323 @@
+package trust
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// maxFetchSize limits the size of signature manifests and blobs.
+const maxFetchSize = 4 << 20
+
+// Registry fetches the manifests and blobs from the image registry.
+type Registry interface {
+	// Resolve resolves the reference into the descriptor of manifest.
+	Resolve(ctx context.Context, ref string) (ocispec.Descriptor, error)
+
+	// Fetch fetches the content of descriptor in the repository of ref.
+	Fetch(ctx context.Context, ref string, desc ocispec.Descriptor) (io.ReadCloser, error)
+}
+
+// remoteRegistry is the Registry using the resolver of containerd.
+type remoteRegistry struct {
+	resolver remotes.Resolver
+}
+
+// NewRemoteRegistry returns the Registry using the resolver of containerd.
+func NewRemoteRegistry(resolver remotes.Resolver) Registry {
+	return &remoteRegistry{resolver: resolver}
+}
+
+// Resolve resolves the reference into the descriptor of manifest.
+func (r *remoteRegistry) Resolve(ctx context.Context, ref string) (ocispec.Descriptor, error) {
+	_, desc, err := r.resolver.Resolve(ctx, ref)
+	return desc, err
+}
+
+// Fetch fetches the content of descriptor in the repository of ref.
+func (r *remoteRegistry) Fetch(ctx context.Context, ref string, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	fetcher, err := r.resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return fetcher.Fetch(ctx, desc)
+}
+
+// fetchBlob fetches the content of descriptor and verifies its digest.
+func fetchBlob(ctx context.Context, reg Registry, ref string, desc ocispec.Descriptor) ([]byte, error) {
+	if desc.Size > maxFetchSize {
+		return nil, fmt.Errorf("size %d of %s exceeds the limit", desc.Size, desc.Digest)
+	}
+
+	rc, err := reg.Fetch(ctx, ref, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(rc, maxFetchSize))
+	if err != nil {
+		return nil, err
+	}
+	if desc.Digest != "" && digest.FromBytes(data) != desc.Digest {
+		return nil, fmt.Errorf("digest of %s mismatches", desc.Digest)
+	}
+	return data, nil
+}
+
+// fetchJSON fetches the content of descriptor and decodes it into v.
+func fetchJSON(ctx context.Context, reg Registry, ref string, desc ocispec.Descriptor, v interface{}) error {
+	data, err := fetchBlob(ctx, reg, ref, desc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Result is the result of signature verification of an image.
+type Result struct {
+	// Digest is the digest of verified manifest.
+	Digest digest.Digest `json:"digest"`
+
+	// Mode is the mode of policy verifying the image.
+	Mode string `json:"mode"`
+
+	// Policy is the fingerprint of keys and certificates of the policy
+	// verifying the image.
+	Policy string `json:"policy"`
+
+	// Verified is whether a valid signature is found.
+	Verified bool `json:"verified"`
+
+	// Type is the type of valid signature, cosign or notation.
+	Type string `json:"type,omitempty"`
+
+	// Signer identifies the key or certificate of valid signature.
+	Signer string `json:"signer,omitempty"`
+
+	// Message is the reason why the image is not verified.
+	Message string `json:"message,omitempty"`
+
+	// VerifiedAt is the time of verification.
+	VerifiedAt time.Time `json:"verifiedAt"`
+}
+
+// Verifier verifies the signatures of images by the per-registry policies,
+// and caches the results by image ID in file. A result is only trusted by
+// the policy which produced it.
+type Verifier struct {
+	policies  map[string]*policy
+	cacheFile string
+
+	sync.Mutex
+	results map[string]*Result
+}
+
+// NewVerifier loads the policies keyed by registry domain, "*" is the policy
+// of the registries without their own ones. The cached results are loaded
+// from cacheFile if it exists, the ones produced by the policies which are
+// changed or removed since then are thrown away.
+func NewVerifier(policies map[string]Policy, cacheFile string) (*Verifier, error) {
+	v := &Verifier{
+		policies:  make(map[string]*policy),
+		cacheFile: cacheFile,
+		results:   make(map[string]*Result),
+	}
+	for registry, p := range policies {
+		loaded, err := loadPolicy(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trust policy of registry %s: %v", registry, err)
+		}
+		v.policies[registry] = loaded
+	}
+
+	if cacheFile == "" {
+		return v, nil
+	}
+	data, err := ioutil.ReadFile(cacheFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return v, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &v.results); err != nil {
+		return nil, fmt.Errorf("failed to load trust cache %s: %v", cacheFile, err)
+	}
+
+	if v.pruneResults() {
+		if err := v.save(); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// pruneResults removes the cached results which are not produced by any
+// current policy, it returns whether any result is removed.
+func (v *Verifier) pruneResults() bool {
+	fingerprints := make(map[string]bool, len(v.policies))
+	for _, p := range v.policies {
+		if p.mode != ModeOff {
+			fingerprints[p.fingerprint] = true
+		}
+	}
+
+	pruned := false
+	for id, r := range v.results {
+		if r == nil || !fingerprints[r.Policy] {
+			delete(v.results, id)
+			pruned = true
+		}
+	}
+	return pruned
+}
+
+// policyFor returns the policy of repository, it returns nil if the
+// verification is off.
+func (v *Verifier) policyFor(name string) *policy {
+	p, ok := v.policies[registryDomain(name)]
+	if !ok {
+		p = v.policies[anyRegistry]
+	}
+	if p == nil || p.mode == ModeOff {
+		return nil
+	}
+	return p
+}
+
+// Mode returns the verification mode of repository.
+func (v *Verifier) Mode(name string) string {
+	if p := v.policyFor(name); p != nil {
+		return p.mode
+	}
+	return ModeOff
+}
+
+// Verify verifies the signatures of manifest in the repository, the cosign
+// signature is tried before the notation one. It returns nil if verification
+// is off, and returns error if the manifest is not verified in enforce mode.
+func (v *Verifier) Verify(ctx context.Context, reg Registry, name string, dgst digest.Digest) (*Result, error) {
+	p := v.policyFor(name)
+	if p == nil {
+		return nil, nil
+	}
+
+	// the signatures of manifest are verified only once by the policy.
+	if r := v.verifiedResult(dgst, p.fingerprint); r != nil {
+		return r, nil
+	}
+
+	result := &Result{Digest: dgst, Mode: p.mode, Policy: p.fingerprint, VerifiedAt: time.Now().UTC()}
+	var errs []string
+	if len(p.cosignKeys) != 0 {
+		i, err := verifyCosign(ctx, reg, name, dgst, p.cosignKeys)
+		if err == nil {
+			result.Verified, result.Type, result.Signer = true, TypeCosign, p.keyFiles[i]
+			return result, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	if p.roots != nil {
+		signer, err := verifyNotation(ctx, reg, name, dgst, p.roots)
+		if err == nil {
+			result.Verified, result.Type, result.Signer = true, TypeNotation, signer
+			return result, nil
+		}
+		errs = append(errs, err.Error())
+	}
+
+	result.Message = strings.Join(errs, "; ")
+	if p.mode == ModeEnforce {
+		return result, fmt.Errorf("image %s@%s is not signed by trusted keys: %s", name, dgst, result.Message)
+	}
+	return result, nil
+}
+
+// Check checks whether the image is allowed to run by the policy of its
+// repository, the image must be verified by the current policy of its
+// repository in enforce mode.
+func (v *Verifier) Check(name, id string) error {
+	p := v.policyFor(name)
+	if p == nil || p.mode != ModeEnforce {
+		return nil
+	}
+	if r := v.Result(id); r == nil || !r.Verified || r.Policy != p.fingerprint {
+		return fmt.Errorf("image %s is not verified by trust policy", name)
+	}
+	return nil
+}
+
+// Result returns the cached result of image.
+func (v *Verifier) Result(id string) *Result {
+	v.Lock()
+	defer v.Unlock()
+
+	return v.results[id]
+}
+
+// verifiedResult returns the cached result verifying the manifest by the
+// policy of fingerprint.
+func (v *Verifier) verifiedResult(dgst digest.Digest, fingerprint string) *Result {
+	v.Lock()
+	defer v.Unlock()
+
+	for _, r := range v.results {
+		if r.Digest == dgst && r.Verified && r.Policy == fingerprint {
+			return r
+		}
+	}
+	return nil
+}
+
+// Store caches the result of image.
+func (v *Verifier) Store(id string, result *Result) error {
+	v.Lock()
+	defer v.Unlock()
+
+	v.results[id] = result
+	return v.save()
+}
+
+// Remove removes the cached result of image.
+func (v *Verifier) Remove(id string) error {
+	v.Lock()
+	defer v.Unlock()
+
+	if _, ok := v.results[id]; !ok {
+		return nil
+	}
+	delete(v.results, id)
+	return v.save()
+}
+
+// save writes the cached results into file, the caller should hold the lock.
+func (v *Verifier) save() error {
+	if v.cacheFile == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(v.results)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(v.cacheFile), 0700); err != nil {
+		return err
+	}
+	tmp := v.cacheFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, v.cacheFile)
+}
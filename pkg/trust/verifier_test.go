@@ -0,0 +1,317 @@
+package trust
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+const testName = "reg.example.com/library/busybox"
+
+// fakeRegistry serves the tagged manifests and blobs in memory.
+type fakeRegistry struct {
+	tags  map[string]ocispec.Descriptor
+	blobs map[digest.Digest][]byte
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		tags:  make(map[string]ocispec.Descriptor),
+		blobs: make(map[digest.Digest][]byte),
+	}
+}
+
+func (r *fakeRegistry) add(mediaType string, data []byte) ocispec.Descriptor {
+	dgst := digest.FromBytes(data)
+	r.blobs[dgst] = data
+	return ocispec.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(data))}
+}
+
+func (r *fakeRegistry) tag(ref string, v interface{}) {
+	data, _ := json.Marshal(v)
+	r.tags[ref] = r.add(ocispec.MediaTypeImageManifest, data)
+}
+
+func (r *fakeRegistry) Resolve(ctx context.Context, ref string) (ocispec.Descriptor, error) {
+	desc, ok := r.tags[ref]
+	if !ok {
+		return ocispec.Descriptor{}, fmt.Errorf("%s not found", ref)
+	}
+	return desc, nil
+}
+
+func (r *fakeRegistry) Fetch(ctx context.Context, ref string, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	data, ok := r.blobs[desc.Digest]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", desc.Digest)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func writePEM(t *testing.T, dir, name, typ string, der []byte) string {
+	file := filepath.Join(dir, name)
+	assert.NoError(t, ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600))
+	return file
+}
+
+func newCosignKey(t *testing.T, dir, name string) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	return key, writePEM(t, dir, name, "PUBLIC KEY", der)
+}
+
+func signCosign(t *testing.T, reg *fakeRegistry, key *ecdsa.PrivateKey, dgst digest.Digest) {
+	var p cosignPayload
+	p.Critical.Image.DockerManifestDigest = dgst.String()
+	p.Critical.Type = "cosign container image signature"
+	payload, _ := json.Marshal(p)
+
+	hashed := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hashed[:])
+	assert.NoError(t, err)
+
+	layer := reg.add("application/vnd.dev.cosign.simplesigning.v1+json", payload)
+	layer.Annotations = map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig)}
+	reg.tag(testName+":"+cosignSignatureTag(dgst), ocispec.Manifest{Layers: []ocispec.Descriptor{layer}})
+}
+
+func newCert(t *testing.T, template, parent *x509.Certificate, pub crypto.PublicKey, priv crypto.Signer) *x509.Certificate {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+func signNotation(t *testing.T, reg *fakeRegistry, dir string, dgst digest.Digest) string {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	ca := newCert(t, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+
+	leafKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	leaf := newCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}, ca, &leafKey.PublicKey, caKey)
+
+	protected := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256"}`))
+	rawPayload, _ := json.Marshal(notationPayload{TargetArtifact: ocispec.Descriptor{Digest: dgst}})
+	payload := base64.RawURLEncoding.EncodeToString(rawPayload)
+	hashed := sha256.Sum256([]byte(protected + "." + payload))
+	r, s, err := ecdsa.Sign(rand.Reader, leafKey, hashed[:])
+	assert.NoError(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	var env jwsEnvelope
+	env.Payload, env.Protected = payload, protected
+	env.Signature = base64.RawURLEncoding.EncodeToString(sig)
+	env.Header.CertChain = []string{base64.StdEncoding.EncodeToString(leaf.Raw)}
+	data, _ := json.Marshal(env)
+
+	layer := reg.add(jwsMediaType, data)
+	manifest, _ := json.Marshal(signatureManifest{ArtifactType: notationArtifactType, Layers: []ocispec.Descriptor{layer}})
+	sigManifest := reg.add(ocispec.MediaTypeImageManifest, manifest)
+	reg.tag(testName+":"+referrersTag(dgst), referrersIndex{
+		Manifests: []referrer{{Descriptor: sigManifest, ArtifactType: notationArtifactType}},
+	})
+
+	return writePEM(t, dir, "ca.pem", "CERTIFICATE", ca.Raw)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trust")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	_, keyFile := newCosignKey(t, dir, "cosign.pub")
+
+	for _, tc := range []struct {
+		policy Policy
+		err    bool
+	}{
+		{policy: Policy{Mode: ModeOff}},
+		{policy: Policy{Mode: ModeWarn, CosignKeys: []string{keyFile}}},
+		{policy: Policy{Mode: "strict", CosignKeys: []string{keyFile}}, err: true},
+		{policy: Policy{Mode: ModeEnforce}, err: true},
+		{policy: Policy{Mode: ModeEnforce, CosignKeys: []string{filepath.Join(dir, "missing.pub")}}, err: true},
+		{policy: Policy{Mode: ModeEnforce, NotationCerts: []string{keyFile}}, err: true},
+	} {
+		_, err := loadPolicy(tc.policy)
+		assert.Equal(t, tc.err, err != nil, "policy %+v", tc.policy)
+	}
+}
+
+func TestVerifyCosign(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trust")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key, keyFile := newCosignKey(t, dir, "cosign.pub")
+	otherKey, _ := newCosignKey(t, dir, "other.pub")
+	reg := newFakeRegistry()
+	signed := digest.FromString("signed")
+	signCosign(t, reg, key, signed)
+	forged := digest.FromString("forged")
+	signCosign(t, reg, otherKey, forged)
+
+	v, err := NewVerifier(map[string]Policy{
+		"reg.example.com": {Mode: ModeEnforce, CosignKeys: []string{keyFile}},
+	}, "")
+	assert.NoError(t, err)
+
+	result, err := v.Verify(context.Background(), reg, testName, signed)
+	assert.NoError(t, err)
+	assert.True(t, result.Verified)
+	assert.Equal(t, TypeCosign, result.Type)
+	assert.Equal(t, keyFile, result.Signer)
+
+	result, err = v.Verify(context.Background(), reg, testName, forged)
+	assert.Error(t, err)
+	assert.False(t, result.Verified)
+
+	_, err = v.Verify(context.Background(), reg, testName, digest.FromString("unsigned"))
+	assert.Error(t, err)
+
+	// the registries without policy are not verified.
+	result, err = v.Verify(context.Background(), reg, "docker.io/library/busybox", forged)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestVerifyNotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trust")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	reg := newFakeRegistry()
+	signed := digest.FromString("signed")
+	certFile := signNotation(t, reg, dir, signed)
+
+	v, err := NewVerifier(map[string]Policy{
+		"*": {Mode: ModeWarn, NotationCerts: []string{certFile}},
+	}, "")
+	assert.NoError(t, err)
+
+	result, err := v.Verify(context.Background(), reg, testName, signed)
+	assert.NoError(t, err)
+	assert.True(t, result.Verified)
+	assert.Equal(t, TypeNotation, result.Type)
+	assert.Equal(t, "CN=test signer", result.Signer)
+
+	// the unverified image is allowed in warn mode.
+	result, err = v.Verify(context.Background(), reg, testName, digest.FromString("unsigned"))
+	assert.NoError(t, err)
+	assert.False(t, result.Verified)
+	assert.NotEmpty(t, result.Message)
+}
+
+func TestVerifierCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trust")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, keyFile := newCosignKey(t, dir, "cosign.pub")
+	policies := map[string]Policy{
+		"reg.example.com": {Mode: ModeEnforce, CosignKeys: []string{keyFile}},
+	}
+	cacheFile := filepath.Join(dir, "trust", "results.json")
+
+	v, err := NewVerifier(policies, cacheFile)
+	assert.NoError(t, err)
+	assert.Error(t, v.Check(testName, "sha256:verified"))
+	assert.NoError(t, v.Check("docker.io/library/busybox", "sha256:verified"))
+
+	fingerprint := v.policyFor(testName).fingerprint
+	assert.NoError(t, v.Store("sha256:verified", &Result{Digest: digest.FromString("signed"), Mode: ModeEnforce, Policy: fingerprint, Verified: true}))
+	assert.NoError(t, v.Store("sha256:unverified", &Result{Digest: digest.FromString("unsigned"), Mode: ModeWarn, Policy: fingerprint}))
+
+	// the results are loaded from cache after restart.
+	v, err = NewVerifier(policies, cacheFile)
+	assert.NoError(t, err)
+	assert.NoError(t, v.Check(testName, "sha256:verified"))
+	assert.Error(t, v.Check(testName, "sha256:unverified"))
+
+	// the verified manifest is not fetched again.
+	result, err := v.Verify(context.Background(), newFakeRegistry(), testName, digest.FromString("signed"))
+	assert.NoError(t, err)
+	assert.True(t, result.Verified)
+
+	assert.NoError(t, v.Remove("sha256:verified"))
+	v, err = NewVerifier(policies, cacheFile)
+	assert.NoError(t, err)
+	assert.Nil(t, v.Result("sha256:verified"))
+	assert.NotNil(t, v.Result("sha256:unverified"))
+}
+
+func TestVerifierCachePerPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trust")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key, keyFile := newCosignKey(t, dir, "cosign.pub")
+	_, otherFile := newCosignKey(t, dir, "other.pub")
+	reg := newFakeRegistry()
+	signed := digest.FromString("signed")
+	signCosign(t, reg, key, signed)
+
+	policies := map[string]Policy{
+		"reg.example.com":   {Mode: ModeEnforce, CosignKeys: []string{keyFile}},
+		"other.example.com": {Mode: ModeEnforce, CosignKeys: []string{otherFile}},
+	}
+	cacheFile := filepath.Join(dir, "trust", "results.json")
+
+	v, err := NewVerifier(policies, cacheFile)
+	assert.NoError(t, err)
+	result, err := v.Verify(context.Background(), reg, testName, signed)
+	assert.NoError(t, err)
+	assert.NoError(t, v.Store("sha256:signed", result))
+	assert.NoError(t, v.Check(testName, "sha256:signed"))
+
+	// the result verified by the keys of other registry is not trusted.
+	assert.Error(t, v.Check("other.example.com/library/busybox", "sha256:signed"))
+	_, err = v.Verify(context.Background(), reg, "other.example.com/library/busybox", signed)
+	assert.Error(t, err)
+
+	// the result is thrown away once the keys of policy are changed.
+	policies["reg.example.com"] = Policy{Mode: ModeEnforce, CosignKeys: []string{otherFile}}
+	v, err = NewVerifier(policies, cacheFile)
+	assert.NoError(t, err)
+	assert.Nil(t, v.Result("sha256:signed"))
+	assert.Error(t, v.Check(testName, "sha256:signed"))
+}
@@ -68,6 +68,18 @@ func NewLabelGauge(subsystem, name, help string, labels ...string) *prometheus.G
 		}, labels)
 }
 
+// NewLabelGaugeWithUnit return a new GaugeVec whose name is suffixed with the unit.
+func NewLabelGaugeWithUnit(subsystem, name, help string, unit Unit, labels ...string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   subsystem,
+			Name:        fmt.Sprintf("%s_%s", name, unit),
+			Help:        help,
+			ConstLabels: nil,
+		}, labels)
+}
+
 // NewLabelTimer return a new HistogramVec
 func NewLabelTimer(subsystem, name, help string, labels ...string) *prometheus.HistogramVec {
 	return prometheus.NewHistogramVec(
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxSymlinks is the max number of symlinks followed when resolving a path.
+const maxSymlinks = 255
+
+// FollowSymlinkInScope resolves the symlinks in path as if root is the root
+// directory, so that the resolved path never escapes from root, such as the
+// path in the rootfs of container. The components which don't exist are kept
+// as they are.
+func FollowSymlinkInScope(path, root string) (string, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	if path != root && !strings.HasPrefix(path, strings.TrimSuffix(root, "/")+"/") {
+		return "", fmt.Errorf("path %s is not in %s", path, root)
+	}
+
+	// the unresolved components of path relative to root.
+	unresolved := strings.TrimPrefix(path, root)
+
+	// the resolved path relative to root, it always starts with "/".
+	resolved := "/"
+	for n := 0; unresolved != ""; {
+		var p string
+		if i := strings.IndexByte(unresolved, '/'); i == -1 {
+			p, unresolved = unresolved, ""
+		} else {
+			p, unresolved = unresolved[:i], unresolved[i+1:]
+		}
+		if p == "" || p == "." {
+			continue
+		}
+
+		next := filepath.Join(resolved, p)
+		if next == "/" {
+			resolved = next
+			continue
+		}
+
+		fi, err := os.Lstat(filepath.Join(root, next))
+		if err != nil {
+			if os.IsNotExist(err) {
+				resolved = next
+				continue
+			}
+			return "", err
+		}
+		if fi.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		n++
+		if n > maxSymlinks {
+			return "", fmt.Errorf("too many symlinks in %s", path)
+		}
+
+		dest, err := os.Readlink(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+		// the absolute link is relative to root, and the relative link is
+		// relative to the directory of it.
+		if filepath.IsAbs(dest) {
+			resolved = "/"
+		}
+		unresolved = dest + "/" + unresolved
+	}
+
+	return filepath.Join(root, resolved), nil
+}
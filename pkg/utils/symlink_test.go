@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowSymlinkInScope(t *testing.T) {
+	root, err := ioutil.TempDir("", "symlink")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "etc", "conf"), 0755))
+	for link, dest := range map[string]string{
+		"abs":      "/etc",
+		"rel":      "etc/conf",
+		"escape":   "../../../../etc",
+		"absout":   "/../../etc/passwd",
+		"loop":     "loop",
+		"etc/back": "..",
+	} {
+		assert.NoError(t, os.Symlink(dest, filepath.Join(root, link)))
+	}
+
+	for path, expected := range map[string]string{
+		"/":             "/",
+		"/etc":          "/etc",
+		"/abs/conf":     "/etc/conf",
+		"/rel":          "/etc/conf",
+		"/escape":       "/etc",
+		"/absout":       "/etc/passwd",
+		"/etc/back/abs": "/etc",
+		"/notexist/a":   "/notexist/a",
+	} {
+		resolved, err := FollowSymlinkInScope(filepath.Join(root, path), root)
+		assert.NoError(t, err, path)
+		assert.Equal(t, filepath.Join(root, expected), resolved, path)
+	}
+
+	_, err = FollowSymlinkInScope(filepath.Join(root, "loop"), root)
+	assert.Error(t, err)
+
+	_, err = FollowSymlinkInScope("/etc", root)
+	assert.Error(t, err)
+}
@@ -26,3 +26,12 @@ func TestNewParse(t *testing.T) {
 	want := "this is a string"
 	assert.Equal(t, want, b.String())
 }
+
+func TestPadAndTruncate(t *testing.T) {
+	tm, err := Parse(`[{{pad .Name 1 2}}][{{pad .Empty 1 2}}][{{truncate .ID 6}}][{{truncate .Name 10}}]`)
+	assert.NoError(t, err)
+
+	var b bytes.Buffer
+	assert.NoError(t, tm.Execute(&b, map[string]string{"Name": "web", "Empty": "", "ID": "e42c68a8c2ea"}))
+	assert.Equal(t, "[ web  ][][e42c68][web]", b.String())
+}
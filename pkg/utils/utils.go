@@ -475,3 +475,45 @@ func ExtractIPAndPortFromAddresses(addresses []string) (string, string) {
 	}
 	return "", ""
 }
+
+// DirSize returns the size in bytes of files under the directory dir, the
+// hard links of a file are counted only once.
+func DirSize(dir string) (int64, error) {
+	size, _, err := DirUsage(dir)
+	return size, err
+}
+
+// DirUsage returns the size in bytes of files under the directory dir and
+// the number of inodes used by the directory, the hard links of a file are
+// counted only once.
+func DirUsage(dir string) (int64, int64, error) {
+	var (
+		size   int64
+		inodes int64
+		seen   = map[uint64]bool{}
+	)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the file may be removed during walk.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if st, ok := info.Sys().(*syscall.Stat_t); ok && st.Nlink > 1 && !info.IsDir() {
+			if seen[uint64(st.Ino)] {
+				return nil
+			}
+			seen[uint64(st.Ino)] = true
+		}
+		inodes++
+
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, inodes, err
+}
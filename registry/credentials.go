@@ -0,0 +1,55 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// credentialHelperPrefix is the prefix of credential helper binaries.
+	credentialHelperPrefix = "docker-credential-"
+
+	// tokenUsername is the username returned by credential helper when the
+	// secret is an identity token.
+	tokenUsername = "<token>"
+
+	// errCredentialsNotFound is the message of credential helper when there
+	// is no credentials of the server.
+	errCredentialsNotFound = "credentials not found in native keychain"
+)
+
+// helperCredentials is the output of credential helper.
+type helperCredentials struct {
+	Username string
+	Secret   string
+}
+
+// GetHelperCredentials gets the credentials of registry host from credential
+// helper docker-credential-<helper>. The returned username is empty if the
+// secret is an identity token.
+func GetHelperCredentials(helper, host string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(credentialHelperPrefix+helper, "get")
+	cmd.Stdin = strings.NewReader(host)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stdout.String() + stderr.String())
+		if strings.Contains(msg, errCredentialsNotFound) {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("failed to get credentials of %s from helper %s: %v: %s", host, helper, err, msg)
+	}
+
+	var creds helperCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return "", "", fmt.Errorf("invalid credentials of %s from helper %s: %v", host, helper, err)
+	}
+	if creds.Username == tokenUsername {
+		return "", creds.Secret, nil
+	}
+	return creds.Username, creds.Secret, nil
+}
@@ -89,11 +89,13 @@ func (cli *PluginClient) newPluginRequest(path string, data io.Reader) (*http.Re
 
 // CallService calls the service provided by plugin server.
 func (cli *PluginClient) CallService(service string, in, out interface{}, retry bool) error {
-	input := new(bytes.Buffer)
+	var input []byte
 	if in != nil {
-		if err := json.NewEncoder(input).Encode(in); err != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
 			return err
 		}
+		input = data
 	}
 
 	resp, err := cli.callService(service, input, retry)
@@ -111,6 +113,18 @@ func (cli *PluginClient) CallService(service string, in, out interface{}, retry
 			}
 		}
 
+		// the plugins compatible with docker return the error as
+		// {"Err": "message"}.
+		var pluginErr struct {
+			Err string `json:"Err"`
+		}
+		if err := json.Unmarshal(body, &pluginErr); err == nil && pluginErr.Err != "" {
+			return &ErrPluginStatus{
+				StatusCode: resp.StatusCode,
+				Message:    pluginErr.Err,
+			}
+		}
+
 		return &ErrPluginStatus{
 			StatusCode: resp.StatusCode,
 			Message:    string(body),
@@ -124,17 +138,18 @@ func (cli *PluginClient) CallService(service string, in, out interface{}, retry
 	return json.NewDecoder(resp.Body).Decode(out)
 }
 
-func (cli *PluginClient) callService(service string, data io.Reader, retry bool) (*http.Response, error) {
+func (cli *PluginClient) callService(service string, data []byte, retry bool) (*http.Response, error) {
 	var start = time.Now()
 	var times = 0
 
-	// generate the request.
-	req, err := cli.newPluginRequest(service, data)
-	if err != nil {
-		return nil, err
-	}
-
 	for {
+		// generate the request every time, since the body of request is
+		// consumed by the failed one.
+		req, err := cli.newPluginRequest(service, bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
 		resp, err := cli.client.Do(req)
 		if err != nil {
 			if !retry {
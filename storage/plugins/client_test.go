@@ -122,3 +122,32 @@ func TestCallService(t *testing.T) {
 		t.Fatalf("expect %v, but got %v", input, output)
 	}
 }
+
+func TestCallServiceError(t *testing.T) {
+	setupPluginServer()
+	defer teardownPluginServer()
+
+	mux.HandleFunc("/VolumeDriver.Mount", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, `{"Err": "volume not found"}`)
+	})
+	mux.HandleFunc("/VolumeDriver.Unmount", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	})
+
+	cli, err := NewPluginClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the error of plugins compatible with docker is decoded.
+	err = cli.CallService("/VolumeDriver.Mount", map[string]string{"Name": "foo"}, nil, false)
+	if e, ok := err.(*ErrPluginStatus); !ok || e.Message != "volume not found" {
+		t.Fatalf("expect plugin error with message %q, but got %v", "volume not found", err)
+	}
+
+	err = cli.CallService("/VolumeDriver.Unmount", map[string]string{"Name": "foo"}, nil, false)
+	if e, ok := err.(*ErrPluginStatus); !ok || e.Message != "internal error\n" {
+		t.Fatalf("expect plugin error with message %q, but got %v", "internal error\n", err)
+	}
+}
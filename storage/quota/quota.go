@@ -136,6 +136,24 @@ func GetQuotaID(dir string) (uint32, error) {
 	return id, nil
 }
 
+// CheckQuotaSupport checks whether the filesystem on which the dir lies
+// supports disk quota, only xfs and ext4 are supported.
+func CheckQuotaSupport(dir string) error {
+	devID, err := getDevID(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get device id for directory: (%s)", dir)
+	}
+
+	mountPoint, _, fsType := CheckMountpoint(devID)
+	if mountPoint == "" {
+		return errors.Errorf("mountPoint not found for the device on which dir (%s) lies", dir)
+	}
+	if fsType != "xfs" && fsType != "ext4" {
+		return errors.Errorf("disk quota is not supported on %s filesystem of dir (%s)", fsType, dir)
+	}
+	return nil
+}
+
 // SetRootfsDiskQuota is to set container rootfs dir disk quota.
 func SetRootfsDiskQuota(basefs, size string, quotaID uint32, update bool) (uint32, error) {
 	overlayMountInfo, err := getOverlayMountInfo(basefs)
@@ -21,8 +21,9 @@ import (
 // Core represents volume core struct.
 type Core struct {
 	Config
-	store *metastore.Store
-	lock  *kmutex.KMutex
+	store         *metastore.Store
+	snapshotStore *metastore.Store
+	lock          *kmutex.KMutex
 }
 
 // NewCore returns Core struct instance with volume config.
@@ -56,6 +57,10 @@ func NewCore(cfg Config) (*Core, error) {
 				Name: "volume",
 				Type: reflect.TypeOf(types.Volume{}),
 			},
+			{
+				Name: snapshotBucket,
+				Type: reflect.TypeOf(types.VolumeSnapshot{}),
+			},
 		},
 	})
 	if err != nil {
@@ -63,6 +68,7 @@ func NewCore(cfg Config) (*Core, error) {
 		return nil, err
 	}
 	c.store = volumeStore
+	c.snapshotStore = volumeStore.Bucket(snapshotBucket)
 
 	// set configure into each driver
 	driverConfig := map[string]interface{}{
@@ -98,14 +104,18 @@ func (c *Core) getVolume(ctx context.Context, id types.VolumeContext) (*types.Vo
 			return nil, err
 		}
 
-		// if the driver implements Getter interface.
+		// if the driver implements Getter interface, the volume must still
+		// exist in driver. The options and labels of volume, such as the
+		// references of containers, are only kept in the local meta store.
 		if d, ok := dv.(driver.Getter); ok {
 			curV, err := d.Get(ctx, id.Name)
 			if err != nil {
 				return nil, errtypes.ErrVolumeNotFound
 			}
 
-			return curV, nil
+			if mountPath := curV.Path(); mountPath != "" {
+				v.SetPath(mountPath)
+			}
 		}
 
 		return v, nil
@@ -326,6 +336,15 @@ func (c *Core) RemoveVolume(ctx context.Context, id types.VolumeContext) error {
 		return errors.Wrap(err, "Remove volume: "+id.String())
 	}
 
+	// the snapshots must be removed before the volume.
+	snapshots, err := c.listSnapshots(id.Name)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) > 0 {
+		return errors.Wrapf(errtypes.ErrInUse, "volume %s has %d snapshots", id.Name, len(snapshots))
+	}
+
 	// Call driver's Remove method to remove the volume.
 	if err := dv.Remove(ctx, v); err != nil {
 		return err
@@ -347,6 +366,50 @@ func (c *Core) VolumePath(ctx context.Context, id types.VolumeContext) (string,
 	return c.volumePath(ctx, v, dv)
 }
 
+// VolumeUsage returns the size of volume in bytes, -1 is returned if the
+// volume driver doesn't support it.
+func (c *Core) VolumeUsage(ctx context.Context, id types.VolumeContext) (int64, error) {
+	c.lock.Lock(id.Name)
+	defer c.lock.Unlock(id.Name)
+
+	v, dv, err := c.getVolumeDriver(ctx, id)
+	if err != nil {
+		return -1, errors.Wrap(err, fmt.Sprintf("Get volume: %s usage", id.String()))
+	}
+
+	d, ok := dv.(driver.Usager)
+	if !ok {
+		return -1, nil
+	}
+	return d.Usage(ctx, v)
+}
+
+// VolumeStats returns the usage and capacity of volume, the values not
+// supported by the volume driver are -1.
+func (c *Core) VolumeStats(ctx context.Context, id types.VolumeContext) (*types.VolumeStats, error) {
+	c.lock.Lock(id.Name)
+	defer c.lock.Unlock(id.Name)
+
+	v, dv, err := c.getVolumeDriver(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Get volume: %s stats", id.String()))
+	}
+
+	if d, ok := dv.(driver.Stater); ok {
+		return d.Stats(ctx, v)
+	}
+
+	stats := types.NewUnknownVolumeStats()
+	if d, ok := dv.(driver.Usager); ok {
+		used, err := d.Usage(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		stats.Used = used
+	}
+	return stats, nil
+}
+
 // AttachVolume to enable a volume on local host.
 func (c *Core) AttachVolume(ctx context.Context, id types.VolumeContext, extra map[string]string) (*types.Volume, error) {
 	c.lock.Lock(id.Name)
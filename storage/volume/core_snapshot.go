@@ -0,0 +1,210 @@
+package volume
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	metastore "github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/storage/volume/driver"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/pkg/errors"
+)
+
+// snapshotBucket is the bucket of volume snapshots in the volume meta store.
+const snapshotBucket = "snapshot"
+
+// lockVolumes locks the volumes in the order of names, so that two callers
+// locking the same volumes never deadlock. It returns the func to unlock.
+func (c *Core) lockVolumes(names ...string) func() {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		c.lock.Lock(name)
+	}
+	return func() {
+		for i := len(sorted) - 1; i >= 0; i-- {
+			c.lock.Unlock(sorted[i])
+		}
+	}
+}
+
+// getSnapshot returns the snapshot with specified name.
+func (c *Core) getSnapshot(name string) (*types.VolumeSnapshot, error) {
+	obj, err := c.snapshotStore.Get(name)
+	if err != nil {
+		if err == metastore.ErrObjectNotFound {
+			return nil, errors.Wrapf(errtypes.ErrNotfound, "snapshot %s", name)
+		}
+		return nil, err
+	}
+
+	s, ok := obj.(*types.VolumeSnapshot)
+	if !ok {
+		return nil, errors.Wrapf(errtypes.ErrNotfound, "snapshot %s", name)
+	}
+	return s, nil
+}
+
+// listSnapshots returns the snapshots of volume sorted by create time.
+func (c *Core) listSnapshots(volume string) ([]*types.VolumeSnapshot, error) {
+	var snapshots []*types.VolumeSnapshot
+	err := c.snapshotStore.ForEach(func(obj metastore.Object) error {
+		s, ok := obj.(*types.VolumeSnapshot)
+		if ok && s.Volume == volume {
+			snapshots = append(snapshots, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].CreationTimestamp == nil || snapshots[j].CreationTimestamp == nil {
+			return snapshots[i].Name < snapshots[j].Name
+		}
+		return snapshots[i].CreationTimestamp.Before(*snapshots[j].CreationTimestamp)
+	})
+	return snapshots, nil
+}
+
+// volumeSnapshotter returns the snapshotter of the volume driver.
+func volumeSnapshotter(name string) (driver.Snapshotter, error) {
+	dv, err := driver.Get(name)
+	if err != nil {
+		return nil, errors.Errorf("failed to get backend driver %s: %v", name, err)
+	}
+
+	d, ok := dv.(driver.Snapshotter)
+	if !ok {
+		return nil, errors.Wrapf(errtypes.ErrNotImplemented, "volume driver %s doesn't support snapshot", name)
+	}
+	return d, nil
+}
+
+// CreateSnapshot takes a snapshot of the volume, the snapshot name must be
+// unique on the host.
+func (c *Core) CreateSnapshot(ctx context.Context, id types.VolumeContext, name string, labels map[string]string) (*types.VolumeSnapshot, error) {
+	c.lock.Lock(id.Name)
+	defer c.lock.Unlock(id.Name)
+
+	v, err := c.getVolume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := volumeSnapshotter(v.Driver())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.getSnapshot(name); err == nil {
+		return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "snapshot %s", name)
+	} else if !errtypes.IsNotfound(err) {
+		return nil, err
+	}
+
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	now := time.Now()
+	snapshot := &types.VolumeSnapshot{
+		Name:              name,
+		Volume:            v.Name,
+		Driver:            v.Driver(),
+		Labels:            labels,
+		Extra:             map[string]string{},
+		CreationTimestamp: &now,
+	}
+	if err := d.Snapshot(ctx, v, snapshot); err != nil {
+		return nil, err
+	}
+
+	if err := c.snapshotStore.Put(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// ListSnapshots returns the snapshots of volume.
+func (c *Core) ListSnapshots(ctx context.Context, id types.VolumeContext) ([]*types.VolumeSnapshot, error) {
+	c.lock.Lock(id.Name)
+	defer c.lock.Unlock(id.Name)
+
+	if _, err := c.getVolume(ctx, id); err != nil {
+		return nil, err
+	}
+	return c.listSnapshots(id.Name)
+}
+
+// RemoveSnapshot removes the snapshot of volume.
+func (c *Core) RemoveSnapshot(ctx context.Context, id types.VolumeContext, name string) error {
+	c.lock.Lock(id.Name)
+	defer c.lock.Unlock(id.Name)
+
+	snapshot, err := c.getSnapshot(name)
+	if err != nil {
+		return err
+	}
+	if snapshot.Volume != id.Name {
+		return errors.Wrapf(errtypes.ErrNotfound, "snapshot %s of volume %s", name, id.Name)
+	}
+
+	d, err := volumeSnapshotter(snapshot.Driver)
+	if err != nil {
+		return err
+	}
+	if err := d.RemoveSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+
+	return c.snapshotStore.Remove(name)
+}
+
+// CloneVolume creates a volume from the snapshot of the source volume, the
+// volume is created by the driver of the snapshot. The source volume is
+// locked as well, so that the snapshot isn't removed while cloning.
+func (c *Core) CloneVolume(ctx context.Context, id types.VolumeContext, source, name string) (*types.Volume, error) {
+	if id.Name == source {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "cannot clone volume %s into itself", source)
+	}
+	defer c.lockVolumes(id.Name, source)()
+
+	if _, err := c.getVolume(ctx, id); err == nil {
+		return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "volume %s", id.Name)
+	} else if !errtypes.IsVolumeNotFound(err) {
+		return nil, err
+	}
+
+	snapshot, err := c.getSnapshot(name)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Volume != source {
+		return nil, errors.Wrapf(errtypes.ErrNotfound, "snapshot %s of volume %s", name, source)
+	}
+
+	d, err := volumeSnapshotter(snapshot.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	id.Driver = snapshot.Driver
+	if id.Options == nil {
+		id.Options = map[string]string{}
+	}
+	id.Options[types.OptionSnapshot] = snapshot.Name
+
+	v, err := d.Clone(ctx, id, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.store.Put(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,91 @@
+package volume
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/storage/volume/driver"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestVolumeSnapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driverName := "fake_snapshot_driver"
+	driver.Register(driver.NewFakeDriver(driverName))
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	volID := types.VolumeContext{Name: "snapshot-volume", Driver: driverName}
+	if _, err := core.CreateVolume(ctx, volID); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := core.CreateSnapshot(ctx, volID, "snap1", map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "snapshot-volume", snapshot.Volume)
+	assert.Equal(t, driverName, snapshot.Driver)
+	assert.Equal(t, "/fake/snapshots/snap1", snapshot.Extra["path"])
+
+	// the name of snapshot is unique.
+	_, err = core.CreateSnapshot(ctx, volID, "snap1", nil)
+	assert.True(t, errtypes.IsAlreadyExisted(err))
+
+	snapshots, err := core.ListSnapshots(ctx, volID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(snapshots))
+	assert.Equal(t, "snap1", snapshots[0].Name)
+
+	// the volume with snapshots can't be removed.
+	err = core.RemoveVolume(ctx, volID)
+	assert.True(t, errtypes.IsInUse(err))
+
+	cloneID := types.VolumeContext{Name: "clone-volume"}
+	_, err = core.CloneVolume(ctx, cloneID, "other-volume", "snap1")
+	assert.True(t, errtypes.IsNotfound(err))
+
+	clone, err := core.CloneVolume(ctx, cloneID, "snapshot-volume", "snap1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, driverName, clone.Driver())
+	assert.Equal(t, "snap1", clone.Option(types.OptionSnapshot))
+
+	v, err := core.GetVolume(ctx, cloneID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "clone-volume", v.Name)
+
+	_, err = core.CloneVolume(ctx, cloneID, "snapshot-volume", "snap1")
+	assert.True(t, errtypes.IsAlreadyExisted(err))
+
+	if err := core.RemoveSnapshot(ctx, volID, "snap1"); err != nil {
+		t.Fatal(err)
+	}
+	snapshots, err = core.ListSnapshots(ctx, volID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(snapshots))
+
+	assert.NoError(t, core.RemoveVolume(ctx, volID))
+}
@@ -367,6 +367,44 @@ func TestVolumePath(t *testing.T) {
 	}
 }
 
+func TestVolumeStats(t *testing.T) {
+	driverName := "fake_stats_driver"
+	volid := types.VolumeContext{Name: "stats-volume", Driver: driverName}
+
+	dir, err := ioutil.TempDir("", "TestVolumeStats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driver.Register(driver.NewFakeDriver(driverName))
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	if _, err := core.VolumeStats(ctx, volid); !errtypes.IsNotfound(err) {
+		t.Fatalf("expect volume not found err, but got %v", err)
+	}
+
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := core.VolumeStats(ctx, volid)
+	if err != nil {
+		t.Fatalf("get volume stats error: %v", err)
+	}
+
+	// keep consist with the Stats API in fake_driver.go
+	if stats.Used != 1024 || stats.Capacity != 4096 || stats.Inodes != 4 {
+		t.Fatalf("unexpected volume stats: %+v", stats)
+	}
+}
+
 func TestAttachVolume(t *testing.T) {
 	volumeDriverName := "fake1"
 
@@ -51,6 +51,18 @@ type Formator interface {
 	Format(context.Context, *types.Volume) error
 }
 
+// Usager represents volume usage interface.
+type Usager interface {
+	// Usage returns the size of a volume in bytes.
+	Usage(context.Context, *types.Volume) (int64, error)
+}
+
+// Stater represents volume stats interface.
+type Stater interface {
+	// Stats returns the usage and capacity of a volume.
+	Stats(context.Context, *types.Volume) (*types.VolumeStats, error)
+}
+
 // Getter represents volume get interface.
 type Getter interface {
 	// Get a volume from driver
@@ -62,3 +74,16 @@ type Lister interface {
 	// List a volume from driver
 	List(context.Context) ([]*types.Volume, error)
 }
+
+// Snapshotter represents volume snapshot and clone interface.
+type Snapshotter interface {
+	// Snapshot takes a snapshot of the volume, the driver specific data
+	// of snapshot is saved in its Extra.
+	Snapshot(context.Context, *types.Volume, *types.VolumeSnapshot) error
+
+	// RemoveSnapshot removes a snapshot of volume.
+	RemoveSnapshot(context.Context, *types.VolumeSnapshot) error
+
+	// Clone creates a volume from the snapshot.
+	Clone(context.Context, types.VolumeContext, *types.VolumeSnapshot) (*types.Volume, error)
+}
@@ -46,3 +46,31 @@ func (f *FakeDriver) Remove(ctx context.Context, volume *types.Volume) error {
 func (f *FakeDriver) Path(ctx context.Context, volume *types.Volume) (string, error) {
 	return path.Join("/fake", volume.Name), nil
 }
+
+// Stats returns the usage and capacity of the fake volume.
+func (f *FakeDriver) Stats(ctx context.Context, volume *types.Volume) (*types.VolumeStats, error) {
+	return &types.VolumeStats{
+		Used:       1024,
+		Available:  3072,
+		Capacity:   4096,
+		InodesUsed: 1,
+		InodesFree: 3,
+		Inodes:     4,
+	}, nil
+}
+
+// Snapshot takes a snapshot of the fake volume.
+func (f *FakeDriver) Snapshot(ctx context.Context, volume *types.Volume, snapshot *types.VolumeSnapshot) error {
+	snapshot.Extra["path"] = path.Join("/fake/snapshots", snapshot.Name)
+	return nil
+}
+
+// RemoveSnapshot removes a snapshot of the fake volume.
+func (f *FakeDriver) RemoveSnapshot(ctx context.Context, snapshot *types.VolumeSnapshot) error {
+	return nil
+}
+
+// Clone creates a fake volume from the snapshot.
+func (f *FakeDriver) Clone(ctx context.Context, id types.VolumeContext, snapshot *types.VolumeSnapshot) (*types.Volume, error) {
+	return f.Create(ctx, id)
+}
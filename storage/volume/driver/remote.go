@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"strings"
 
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -117,8 +118,20 @@ func (r *remoteDriverWrapper) Options() map[string]types.Option {
 func (r *remoteDriverWrapper) Attach(ctx context.Context, v *types.Volume) error {
 	log.With(ctx).Debugf("driver wrapper [%s] attach volume: %s", r.Name(ctx), v.Name)
 
-	_, err := r.proxy.Mount(v.Name, v.UID)
-	return err
+	// the volume is mounted once for all the containers using it, since it's
+	// unmounted only when it's not used by any container.
+	if strings.Contains(v.Option(types.OptionRef), ",") {
+		return nil
+	}
+
+	mountPath, err := r.proxy.Mount(v.Name, v.UID)
+	if err != nil {
+		return err
+	}
+	if mountPath != "" {
+		v.SetPath(mountPath)
+	}
+	return nil
 }
 
 // Detach a remote volume.
@@ -0,0 +1,55 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alibaba/pouch/storage/plugins"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteDriverAttach(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var mounts []remoteVolumeMountReq
+	mux.HandleFunc(remoteVolumeMountService, func(w http.ResponseWriter, r *http.Request) {
+		var req remoteVolumeMountReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mounts = append(mounts, req)
+		fmt.Fprintf(w, `{"Mountpoint": "/mnt/%s"}`, req.Name)
+	})
+
+	client, err := plugins.NewPluginClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &remoteDriverWrapper{
+		driverName: "remote",
+		proxy:      &remoteDriverProxy{Name: "remote", client: client},
+	}
+
+	v := types.NewVolumeFromContext("", "", types.VolumeContext{
+		Name:    "foo",
+		Driver:  "remote",
+		Options: map[string]string{types.OptionRef: "c1"},
+	})
+	assert.NoError(t, d.Attach(context.Background(), v))
+	assert.Equal(t, []remoteVolumeMountReq{{Name: "foo", ID: v.UID}}, mounts)
+	assert.Equal(t, "/mnt/foo", v.Path())
+
+	// the volume has been mounted for the other container.
+	v.SetOption(types.OptionRef, "c1,c2")
+	assert.NoError(t, d.Attach(context.Background(), v))
+	assert.Equal(t, 1, len(mounts))
+}
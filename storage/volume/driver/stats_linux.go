@@ -0,0 +1,34 @@
+package driver
+
+import (
+	"syscall"
+
+	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/pkg/errors"
+)
+
+// PathStats returns the stats of the volume whose data is stored under
+// path, the usage is counted by walking the path and the capacity is the
+// one of the filesystem the path is on.
+func PathStats(path string) (*types.VolumeStats, error) {
+	used, inodesUsed, err := utils.DirUsage(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get usage of %s", path)
+	}
+
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return nil, errors.Wrapf(err, "failed to statfs %s", path)
+	}
+
+	return &types.VolumeStats{
+		Used:       used,
+		Available:  int64(st.Bavail) * st.Bsize,
+		Capacity:   int64(st.Blocks) * st.Bsize,
+		InodesUsed: inodesUsed,
+		InodesFree: int64(st.Ffree),
+		Inodes:     int64(st.Files),
+	}, nil
+}
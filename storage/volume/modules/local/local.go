@@ -8,18 +8,47 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/alibaba/pouch/pkg/bytefmt"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/quota"
 	"github.com/alibaba/pouch/storage/volume/driver"
 	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/containerd/containerd/mount"
+	"github.com/docker/docker/pkg/archive"
+	"github.com/pkg/errors"
 )
 
 var (
 	defaultDataPath = "/var/lib/pouch/volume"
 )
 
+const (
+	// optionType is the filesystem type of the device mounted on the
+	// volume path, such as tmpfs, ext4 and nfs.
+	optionType = "type"
+
+	// optionDevice is the device mounted on the volume path.
+	optionDevice = "device"
+
+	// optionMountOpts is the comma separated mount options of the device.
+	optionMountOpts = "o"
+
+	// tmpfsType is the filesystem type of tmpfs.
+	tmpfsType = "tmpfs"
+
+	// snapshotDir is the directory under the data path to store the
+	// snapshots, the volume name can't start with dot so it never conflicts.
+	snapshotDir = ".snapshots"
+
+	// snapshotPathKey is the key of snapshot path in the extra of snapshot.
+	snapshotPathKey = "path"
+)
+
 func init() {
 	if err := driver.Register(&Local{}); err != nil {
 		panic(err)
@@ -77,6 +106,16 @@ func (p *Local) Create(ctx context.Context, id types.VolumeContext) (*types.Volu
 		size = strconv.Itoa(int(sizeInt))
 	}
 
+	if err := validateMountOptions(id.Options, size); err != nil {
+		return nil, err
+	}
+
+	if nocopy, ok := id.Options[types.OptionNoCopy]; ok {
+		if _, err := strconv.ParseBool(nocopy); err != nil {
+			return nil, fmt.Errorf("invalid %s option %q: %v", types.OptionNoCopy, nocopy, err)
+		}
+	}
+
 	// create the volume path
 	if st, exist := os.Stat(mountPath); exist != nil {
 		if e := os.MkdirAll(mountPath, 0755); e != nil {
@@ -94,6 +133,13 @@ func (p *Local) Remove(ctx context.Context, v *types.Volume) error {
 	log.With(ctx).Debugf("Local remove volume: %s", v.Name)
 	mountPath := v.Path()
 
+	// never remove the files of the device still mounted on the volume path.
+	if deviceMount(v) != nil && utils.IsMountpoint(mountPath) {
+		if err := mount.Unmount(mountPath, 0); err != nil {
+			return fmt.Errorf("failed to umount %q, err: %v", mountPath, err)
+		}
+	}
+
 	if err := os.RemoveAll(mountPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("remove %q directory failed, err: %v", mountPath, err)
 	}
@@ -122,10 +168,124 @@ func (p *Local) Path(ctx context.Context, v *types.Volume) (string, error) {
 	return mountPath, nil
 }
 
+// Usage returns local volume's size.
+func (p *Local) Usage(ctx context.Context, v *types.Volume) (int64, error) {
+	return utils.DirSize(v.Path())
+}
+
+// Stats returns local volume's usage and capacity, the capacity of the
+// plain directory with size is its disk quota.
+func (p *Local) Stats(ctx context.Context, v *types.Volume) (*types.VolumeStats, error) {
+	mountPath := v.Path()
+
+	m := deviceMount(v)
+	if m != nil && !utils.IsMountpoint(mountPath) {
+		// the capacity of device is unknown until it's mounted.
+		stats := types.NewUnknownVolumeStats()
+		stats.Used, stats.InodesUsed = 0, 0
+		return stats, nil
+	}
+
+	stats, err := driver.PathStats(mountPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		if size, err := strconv.ParseInt(v.Size(), 10, 64); err == nil && size > 0 {
+			stats.Capacity = size
+			if avail := size - stats.Used; avail < stats.Available {
+				stats.Available = avail
+			}
+			if stats.Available < 0 {
+				stats.Available = 0
+			}
+		}
+	}
+	return stats, nil
+}
+
+// Snapshot copies the data of volume into the snapshot directory. The volume
+// must not be used by any container, so that the copy is consistent.
+func (p *Local) Snapshot(ctx context.Context, v *types.Volume, snapshot *types.VolumeSnapshot) error {
+	log.With(ctx).Debugf("Local snapshot volume: %s", v.Name)
+
+	if deviceMount(v) != nil {
+		return errors.Wrapf(errtypes.ErrNotImplemented, "snapshot of volume %s mounted with device", v.Name)
+	}
+	if ref := v.Option(types.OptionRef); ref != "" {
+		return errors.Wrapf(errtypes.ErrInUse, "volume %s is used by containers %s", v.Name, ref)
+	}
+
+	snapshotPath := p.snapshotPath(snapshot.Name)
+	if err := os.MkdirAll(path.Dir(snapshotPath), 0700); err != nil {
+		return err
+	}
+	if err := archive.NewDefaultArchiver().CopyWithTar(v.Path(), snapshotPath); err != nil {
+		os.RemoveAll(snapshotPath)
+		return fmt.Errorf("failed to copy volume %s into snapshot %s: %v", v.Name, snapshot.Name, err)
+	}
+
+	snapshot.Extra[snapshotPathKey] = snapshotPath
+	return nil
+}
+
+// RemoveSnapshot removes the snapshot directory.
+func (p *Local) RemoveSnapshot(ctx context.Context, snapshot *types.VolumeSnapshot) error {
+	log.With(ctx).Debugf("Local remove snapshot: %s", snapshot.Name)
+
+	snapshotPath := snapshot.Extra[snapshotPathKey]
+	if snapshotPath == "" {
+		return nil
+	}
+	if err := os.RemoveAll(snapshotPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove %q directory failed, err: %v", snapshotPath, err)
+	}
+	return nil
+}
+
+// Clone creates a local volume and copies the data of snapshot into it.
+func (p *Local) Clone(ctx context.Context, id types.VolumeContext, snapshot *types.VolumeSnapshot) (*types.Volume, error) {
+	log.With(ctx).Debugf("Local clone volume %s from snapshot %s", id.Name, snapshot.Name)
+
+	snapshotPath := snapshot.Extra[snapshotPathKey]
+	if snapshotPath == "" {
+		return nil, errors.Wrapf(errtypes.ErrNotfound, "data of snapshot %s", snapshot.Name)
+	}
+
+	v, err := p.Create(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deviceMount(v) != nil {
+		p.Remove(ctx, v)
+		return nil, errors.Wrapf(errtypes.ErrNotImplemented, "clone of volume %s mounted with device", v.Name)
+	}
+
+	if err := archive.NewDefaultArchiver().CopyWithTar(snapshotPath, v.Path()); err != nil {
+		p.Remove(ctx, v)
+		return nil, fmt.Errorf("failed to copy snapshot %s into volume %s: %v", snapshot.Name, v.Name, err)
+	}
+	return v, nil
+}
+
+// snapshotPath returns the directory of snapshot.
+func (p *Local) snapshotPath(name string) string {
+	dataPath := defaultDataPath
+	if p.DataPath != "" {
+		dataPath = p.DataPath
+	}
+	return path.Join(dataPath, snapshotDir, name)
+}
+
 // Options returns local volume's options.
 func (p *Local) Options() map[string]types.Option {
 	return map[string]types.Option{
-		"mount": {Value: "", Desc: "local directory"},
+		"mount":            {Value: "", Desc: "local directory"},
+		optionType:         {Value: "", Desc: "filesystem type of the device mounted on volume, such as tmpfs, ext4 and nfs"},
+		optionDevice:       {Value: "", Desc: "device mounted on volume, defaults to tmpfs for type tmpfs"},
+		optionMountOpts:    {Value: "", Desc: "comma separated mount options of the device"},
+		types.OptionNoCopy: {Value: "false", Desc: "not copy the image data into volume"},
 	}
 }
 
@@ -150,6 +310,16 @@ func (p *Local) Attach(ctx context.Context, v *types.Volume) error {
 		return fmt.Errorf("mount path is not a dir %s", mountPath)
 	}
 
+	if m := deviceMount(v); m != nil {
+		if utils.IsMountpoint(mountPath) {
+			return nil
+		}
+		if err := m.Mount(mountPath); err != nil {
+			return fmt.Errorf("failed to mount %s on %q with type %s, err: %v", m.Source, mountPath, m.Type, err)
+		}
+		return nil
+	}
+
 	if size != "" && size != "0" {
 		if ex := quota.SetDiskQuota(mountPath, size, 0); ex != nil {
 			return ex
@@ -162,6 +332,80 @@ func (p *Local) Attach(ctx context.Context, v *types.Volume) error {
 // Detach a local volume.
 func (p *Local) Detach(ctx context.Context, v *types.Volume) error {
 	log.With(ctx).Debugf("Local detach volume: %s", v.Name)
+	mountPath := v.Path()
+
+	// the device is mounted until the volume is not used by any container.
+	if deviceMount(v) != nil && utils.IsMountpoint(mountPath) {
+		if err := mount.Unmount(mountPath, 0); err != nil {
+			return fmt.Errorf("failed to umount %q, err: %v", mountPath, err)
+		}
+	}
+
+	return nil
+}
+
+// validateMountOptions checks the options to mount a device on the volume,
+// size is only supported by the plain directory and tmpfs since it's set as
+// the disk quota of the directory or the size of tmpfs.
+func validateMountOptions(opts map[string]string, size string) error {
+	mountType := opts[optionType]
+	if mountType == "" {
+		if opts[optionDevice] != "" || opts[optionMountOpts] != "" {
+			return fmt.Errorf("option %s and %s require option %s", optionDevice, optionMountOpts, optionType)
+		}
+		return nil
+	}
+
+	if mountType == tmpfsType {
+		return nil
+	}
 
+	if opts[optionDevice] == "" {
+		return fmt.Errorf("option %s is required by type %s", optionDevice, mountType)
+	}
+	if size != "" {
+		return fmt.Errorf("size is not supported by type %s", mountType)
+	}
 	return nil
 }
+
+// deviceMount returns the mount of the device on the volume, or nil if the
+// volume is a plain directory.
+func deviceMount(v *types.Volume) *mount.Mount {
+	mountType := v.Option(optionType)
+	if mountType == "" {
+		return nil
+	}
+
+	m := &mount.Mount{
+		Type:   mountType,
+		Source: v.Option(optionDevice),
+	}
+	if opts := v.Option(optionMountOpts); opts != "" {
+		m.Options = strings.Split(opts, ",")
+	}
+
+	if mountType == tmpfsType {
+		if m.Source == "" {
+			m.Source = tmpfsType
+		}
+
+		// the size of volume is the size of tmpfs, unless it's given by the
+		// mount options.
+		size := v.Size()
+		if size != "" && size != "0" && !hasMountOption(m.Options, "size") {
+			m.Options = append(m.Options, "size="+size)
+		}
+	}
+	return m
+}
+
+// hasMountOption returns whether the option is in the mount options.
+func hasMountOption(opts []string, name string) bool {
+	for _, o := range opts {
+		if o == name || strings.HasPrefix(o, name+"=") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+// +build linux
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/containerd/containerd/mount"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMountOptions(t *testing.T) {
+	for _, tc := range []struct {
+		opts    map[string]string
+		size    string
+		wantErr bool
+	}{
+		{opts: map[string]string{}, size: "1024"},
+		{opts: map[string]string{"type": "tmpfs", "o": "mode=1777"}, size: "1024"},
+		{opts: map[string]string{"type": "nfs", "device": ":/data", "o": "addr=10.0.0.1"}},
+		{opts: map[string]string{"device": "/dev/sdb"}, wantErr: true},
+		{opts: map[string]string{"o": "ro"}, wantErr: true},
+		{opts: map[string]string{"type": "ext4"}, wantErr: true},
+		{opts: map[string]string{"type": "ext4", "device": "/dev/sdb"}, size: "1024", wantErr: true},
+	} {
+		err := validateMountOptions(tc.opts, tc.size)
+		assert.Equal(t, tc.wantErr, err != nil, "options %v", tc.opts)
+	}
+}
+
+func TestDeviceMount(t *testing.T) {
+	newVolume := func(size string, opts map[string]string) *types.Volume {
+		return types.NewVolumeFromContext("/tmp/v", size, types.VolumeContext{Name: "v", Options: opts})
+	}
+
+	assert.Nil(t, deviceMount(newVolume("", map[string]string{})))
+
+	assert.Equal(t, &mount.Mount{Type: "tmpfs", Source: "tmpfs", Options: []string{"mode=1777", "size=1024"}},
+		deviceMount(newVolume("1024", map[string]string{"type": "tmpfs", "o": "mode=1777"})))
+
+	// the size given by mount options is preferred.
+	assert.Equal(t, &mount.Mount{Type: "tmpfs", Source: "tmpfs", Options: []string{"size=64m"}},
+		deviceMount(newVolume("1024", map[string]string{"type": "tmpfs", "o": "size=64m"})))
+
+	assert.Equal(t, &mount.Mount{Type: "none", Source: "/data", Options: []string{"bind", "ro"}},
+		deviceMount(newVolume("", map[string]string{"type": "none", "device": "/data", "o": "bind,ro"})))
+}
+
+func TestSnapshotAndClone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local-snapshot")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	p := &Local{DataPath: dir}
+	v, err := p.Create(ctx, types.VolumeContext{Name: "src"})
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(v.Path(), "data"), []byte("v1"), 0644))
+
+	// the volume used by containers can't be snapshotted.
+	v.SetOption(types.OptionRef, "c1")
+	err = p.Snapshot(ctx, v, &types.VolumeSnapshot{Name: "snap1", Extra: map[string]string{}})
+	assert.True(t, errtypes.IsInUse(err))
+	v.SetOption(types.OptionRef, "")
+
+	snapshot := &types.VolumeSnapshot{Name: "snap1", Extra: map[string]string{}}
+	assert.NoError(t, p.Snapshot(ctx, v, snapshot))
+	assert.Equal(t, filepath.Join(dir, snapshotDir, "snap1"), snapshot.Extra[snapshotPathKey])
+
+	// the clone has the data when the snapshot is taken.
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(v.Path(), "data"), []byte("v2"), 0644))
+	clone, err := p.Clone(ctx, types.VolumeContext{Name: "clone"}, snapshot)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "clone"), clone.Path())
+	data, err := ioutil.ReadFile(filepath.Join(clone.Path(), "data"))
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", string(data))
+
+	assert.NoError(t, p.RemoveSnapshot(ctx, snapshot))
+	_, err = os.Stat(snapshot.Extra[snapshotPathKey])
+	assert.True(t, os.IsNotExist(err))
+}
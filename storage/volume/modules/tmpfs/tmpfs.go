@@ -84,6 +84,28 @@ func (p *Tmpfs) Path(ctx context.Context, v *types.Volume) (string, error) {
 	return path.Join(dataDir, v.Name), nil
 }
 
+// Usage returns tmpfs volume's size.
+func (p *Tmpfs) Usage(ctx context.Context, v *types.Volume) (int64, error) {
+	return utils.DirSize(v.Path())
+}
+
+// Stats returns tmpfs volume's usage and capacity.
+func (p *Tmpfs) Stats(ctx context.Context, v *types.Volume) (*types.VolumeStats, error) {
+	mountPath := v.Path()
+	if utils.IsMountpoint(mountPath) {
+		return driver.PathStats(mountPath)
+	}
+
+	// the tmpfs is mounted until the volume is attached.
+	stats := types.NewUnknownVolumeStats()
+	stats.Used, stats.InodesUsed = 0, 0
+	if size, err := bytefmt.ToBytes(v.Size()); err == nil {
+		stats.Capacity = int64(size)
+		stats.Available = int64(size)
+	}
+	return stats, nil
+}
+
 // Options returns tmpfs volume's options.
 func (p *Tmpfs) Options() map[string]types.Option {
 	return map[string]types.Option{
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+)
+
+// VolumeSnapshot represents the snapshot of a volume.
+type VolumeSnapshot struct {
+	// Name is the name of snapshot, which is unique on the host.
+	Name string `json:"name"`
+
+	// Volume is the name of the volume which the snapshot is taken from.
+	Volume string `json:"volume"`
+
+	// Driver is the driver of the volume.
+	Driver string `json:"driver"`
+
+	// Labels are the labels of snapshot.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Extra is the driver specific data of snapshot, such as the id of
+	// snapshot in the storage.
+	Extra map[string]string `json:"extra,omitempty"`
+
+	// CreationTimestamp is the time when the snapshot is taken.
+	CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+}
+
+// Key returns the snapshot's name.
+func (s *VolumeSnapshot) Key() string {
+	return s.Name
+}
+
+// CreateTime returns the snapshot's create time.
+func (s *VolumeSnapshot) CreateTime() string {
+	if s.CreationTimestamp == nil {
+		return ""
+	}
+
+	return s.CreationTimestamp.Format("2006-1-2 15:04:05")
+}
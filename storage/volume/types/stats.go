@@ -0,0 +1,35 @@
+package types
+
+// VolumeStats represents the usage and capacity of a volume, the unknown
+// values are -1.
+type VolumeStats struct {
+	// Used is the size of files in the volume in bytes.
+	Used int64 `json:"used"`
+
+	// Available is the bytes available for the volume.
+	Available int64 `json:"available"`
+
+	// Capacity is the total bytes of the filesystem the volume is on.
+	Capacity int64 `json:"capacity"`
+
+	// InodesUsed is the number of inodes used by the volume.
+	InodesUsed int64 `json:"inodesUsed"`
+
+	// InodesFree is the number of free inodes of the filesystem.
+	InodesFree int64 `json:"inodesFree"`
+
+	// Inodes is the total number of inodes of the filesystem.
+	Inodes int64 `json:"inodes"`
+}
+
+// NewUnknownVolumeStats returns the stats whose values are all unknown.
+func NewUnknownVolumeStats() *VolumeStats {
+	return &VolumeStats{
+		Used:       -1,
+		Available:  -1,
+		Capacity:   -1,
+		InodesUsed: -1,
+		InodesFree: -1,
+		Inodes:     -1,
+	}
+}
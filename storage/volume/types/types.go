@@ -10,6 +10,12 @@ var (
 	// OptionRef defines the reference of containers.
 	OptionRef = "ref"
 
+	// OptionNoCopy defines not to copy the image data into the volume.
+	OptionNoCopy = "nocopy"
+
+	// OptionSnapshot defines the snapshot which the volume is cloned from.
+	OptionSnapshot = "snapshot"
+
 	// DefaultBackend defines the default volume backend.
 	DefaultBackend = "local"
 )
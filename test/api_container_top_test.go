@@ -86,3 +86,32 @@ func (suite *APIContainerTopSuite) TestTopContainerWithOptions(c *check.C) {
 		c.Fatalf("unexpected processes length %d expected %d", len(response.Processes), 1)
 	}
 }
+
+// TestTopStoppedContainer is to verify pouch top fails on the stopped container.
+func (suite *APIContainerTopSuite) TestTopStoppedContainer(c *check.C) {
+	cname := "TestTopStoppedContainer"
+
+	CreateBusyboxContainerOk(c, cname)
+	defer DelContainerForceMultyTime(c, cname)
+
+	resp, err := request.Get("/containers/" + cname + "/top")
+	c.Assert(err, check.IsNil)
+	CheckRespStatus(c, resp, 409)
+}
+
+// TestTopContainerWithInvalidOptions is to verify pouch top fails with the invalid ps options.
+func (suite *APIContainerTopSuite) TestTopContainerWithInvalidOptions(c *check.C) {
+	cname := "TestTopWithInvalidOptions"
+
+	CreateBusyboxContainerOk(c, cname)
+	defer DelContainerForceMultyTime(c, cname)
+
+	StartContainerOk(c, cname)
+
+	// the output without PID field cannot be filtered by the processes.
+	q := url.Values{}
+	q.Add("ps_args", "-o comm")
+	resp, err := request.Get("/containers/"+cname+"/top", request.WithQuery(q))
+	c.Assert(err, check.IsNil)
+	CheckRespStatus(c, resp, 400)
+}
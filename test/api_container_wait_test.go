@@ -2,6 +2,7 @@ package main
 
 import (
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/alibaba/pouch/test/environment"
@@ -78,3 +79,57 @@ func (suite *APIContainerWaitSuite) TestWaitNonExistingContainer(c *check.C) {
 	c.Assert(err, check.IsNil)
 	CheckRespStatus(c, resp, 404)
 }
+
+// TestWaitInvalidCondition tests waiting with an invalid condition return 400.
+func (suite *APIContainerWaitSuite) TestWaitInvalidCondition(c *check.C) {
+	cname := "TestWaitInvalidCondition"
+
+	CreateBusyboxContainerOk(c, cname)
+	defer DelContainerForceMultyTime(c, cname)
+
+	q := url.Values{}
+	q.Add("condition", "stopped")
+	resp, err := request.Post("/containers/"+cname+"/wait", request.WithQuery(q))
+	c.Assert(err, check.IsNil)
+	CheckRespStatus(c, resp, 400)
+}
+
+// TestWaitRemoved tests waiting a stopped container to be removed.
+func (suite *APIContainerWaitSuite) TestWaitRemoved(c *check.C) {
+	cname := "TestWaitRemoved"
+
+	CreateBusyboxContainerOk(c, cname)
+	defer DelContainerForceMultyTime(c, cname)
+
+	q := url.Values{}
+	q.Add("condition", "removed")
+
+	var (
+		err  error
+		resp *http.Response
+	)
+	chWait := make(chan struct{})
+	go func() {
+		resp, err = request.Post("/containers/"+cname+"/wait", request.WithQuery(q))
+		close(chWait)
+	}()
+
+	// the stopped container is not returned immediately.
+	select {
+	case <-chWait:
+		c.Fatalf("wait with condition removed returns before the container is removed")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	delResp, delErr := delContainerForce(cname)
+	c.Assert(delErr, check.IsNil)
+	CheckRespStatus(c, delResp, 204)
+
+	select {
+	case <-chWait:
+		c.Assert(err, check.IsNil)
+		CheckRespStatus(c, resp, 200)
+	case <-time.After(2 * time.Second):
+		c.Errorf("timeout waiting for `pouch wait` API to exit")
+	}
+}
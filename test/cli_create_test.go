@@ -187,6 +187,24 @@ func (suite *PouchCreateSuite) TestCreateWithSysctls(c *check.C) {
 	}
 }
 
+// TestCreateWithInvalidSysctls tests creating container with the sysctls
+// which are not namespaced or in the host namespaces.
+func (suite *PouchCreateSuite) TestCreateWithInvalidSysctls(c *check.C) {
+	name := "create-invalid-sysctl"
+
+	for _, args := range [][]string{
+		{"--sysctl", "vm.swappiness=0"},
+		{"--sysctl", "net.ipv4.ip_forward=1", "--net", "host"},
+		{"--sysctl", "kernel.shmmax=68719476736", "--ipc", "host"},
+	} {
+		args = append(append([]string{"create", "--name", name}, args...), busyboxImage)
+		res := command.PouchRun(args...)
+		DelContainerForceMultyTime(c, name)
+		c.Assert(res.Error, check.NotNil, check.Commentf("%v", args))
+		c.Assert(res.Stderr(), check.Matches, "(?s).*is not (namespaced|allowed).*", check.Commentf("%v", args))
+	}
+}
+
 // TestCreateWithAppArmor tries to test create a container with security option AppArmor.
 func (suite *PouchCreateSuite) TestCreateWithAppArmor(c *check.C) {
 	appArmor := "apparmor=unconfined"
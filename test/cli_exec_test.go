@@ -285,6 +285,39 @@ func (suite *PouchExecSuite) TestExecWithWorkingDir(c *check.C) {
 	}
 }
 
+// TestExecWithOverrideWorkingDir tests working directory of exec process can be overridden.
+func (suite *PouchExecSuite) TestExecWithOverrideWorkingDir(c *check.C) {
+	cname := "TestExecWithOverrideWorkingDir"
+
+	res := command.PouchRun("run", "-d", "--name", cname, "-w", "/tmp", busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, cname)
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("exec", "-w", "/etc", cname, "pwd")
+	res.Assert(c, icmd.Success)
+	if out := strings.TrimSpace(res.Stdout()); out != "/etc" {
+		c.Fatalf("failed to run exec with overridden working directory: %s, but got %s", "/etc", out)
+	}
+
+	res = command.PouchRun("exec", "-w", "etc", cname, "pwd")
+	c.Assert(res.Stderr(), check.Matches, ".*not an absolute path.*\n")
+}
+
+// TestExecWithGroupAdd tests additional groups of exec process.
+func (suite *PouchExecSuite) TestExecWithGroupAdd(c *check.C) {
+	cname := "TestExecWithGroupAdd"
+
+	res := command.PouchRun("run", "-d", "--name", cname, "--group-add", "10", busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, cname)
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("exec", "-u", "1000:1000", "--group-add", "20", cname, "id", "-G")
+	res.Assert(c, icmd.Success)
+	if out := strings.TrimSpace(res.Stdout()); out != "1000 10 20" {
+		c.Fatalf("failed to run exec with additional groups: %s, but got %s", "1000 10 20", out)
+	}
+}
+
 // TestExecWithTty tests running container with -tty flag and attach stdin in a non-tty client.
 func (suite *PouchExecSuite) TestExecWithTty(c *check.C) {
 	name := "TestExecWithTty"
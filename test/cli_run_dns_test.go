@@ -135,3 +135,27 @@ func (suite *PouchRunDNSSuite) TestRunWithDNSRepeatFlags(c *check.C) {
 	c.Assert(err, check.IsNil)
 	c.Assert(dnsSearch, check.Equals, "[mydomain mydomain2]")
 }
+
+// TestRunWithAddHost tests adding extra hosts with host-gateway.
+func (suite *PouchRunDNSSuite) TestRunWithAddHost(c *check.C) {
+	cname := "TestRunWithAddHost"
+
+	res := command.PouchRun("run", "--name", cname,
+		"--add-host", "foo:10.0.0.2",
+		"--add-host", "host.pouch.internal:host-gateway",
+		busyboxImage,
+		"cat", "/etc/hosts")
+	defer DelContainerForceMultyTime(c, cname)
+	res.Assert(c, icmd.Success)
+
+	gateway, err := inspectFilter(cname, ".NetworkSettings.Networks.bridge.Gateway")
+	c.Assert(err, check.IsNil)
+
+	out := res.Stdout()
+	c.Assert(strings.Contains(out, "10.0.0.2\tfoo"), check.Equals, true)
+	c.Assert(strings.Contains(out, gateway+"\thost.pouch.internal"), check.Equals, true)
+
+	res = command.PouchRun("run", "--name", cname+"-invalid", "--add-host", "foo:bar", busyboxImage, "true")
+	defer DelContainerForceMultyTime(c, cname+"-invalid")
+	c.Assert(res.ExitCode, check.Not(check.Equals), 0)
+}
@@ -312,3 +312,29 @@ func (suite *PouchRunMemorySuite) TestRunWithDefaultShm(c *check.C) {
 
 	c.Assert(util.PartialEqual(res.Stdout(), "65536"), check.IsNil)
 }
+
+// TestRunWithSharedShm is to verify the container joining the ipc namespace
+// of another container shares its /dev/shm
+func (suite *PouchRunMemorySuite) TestRunWithSharedShm(c *check.C) {
+	cname := "TestRunWithSharedShm"
+	res := command.PouchRun("run", "-d", "--shm-size", "128m",
+		"--name", cname, busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, cname)
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("exec", cname, "touch", "/dev/shm/shared")
+	res.Assert(c, icmd.Success)
+
+	joined := "TestRunWithSharedShmJoined"
+	res = command.PouchRun("run", "-d", "--ipc", "container:"+cname,
+		"--name", joined, busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, joined)
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("exec", joined, "ls", "/dev/shm/shared")
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("exec", joined, "df", "-k", "/dev/shm")
+	res.Assert(c, icmd.Success)
+	c.Assert(util.PartialEqual(res.Stdout(), "131072"), check.IsNil)
+}
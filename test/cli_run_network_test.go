@@ -122,3 +122,24 @@ func (suite *PouchRunNetworkSuite) TestRunWithIP(c *check.C) {
 
 	c.Assert(found, check.Equals, true)
 }
+
+// TestRunWithConflictIP is to verify the static ipv4 address is reserved
+// by the container until it's removed
+func (suite *PouchRunNetworkSuite) TestRunWithConflictIP(c *check.C) {
+	cname := "TestRunWithConflictIP"
+	ipv4 := "192.168.5.101"
+
+	command.PouchRun("create", "--name", cname, "--ip", ipv4, busyboxImage, "sleep", "1000").Assert(c, icmd.Success)
+	defer command.PouchRun("rm", "-vf", cname)
+
+	conflict := "TestRunWithConflictIP2"
+	res := command.PouchRun("run", "-d", "--name", conflict, "--ip", ipv4, busyboxImage, "sleep", "1000")
+	defer command.PouchRun("rm", "-vf", conflict)
+	c.Assert(res.Error, check.NotNil)
+	c.Assert(strings.Contains(res.Stderr(), "already used"), check.Equals, true, check.Commentf(res.Stderr()))
+
+	command.PouchRun("start", cname).Assert(c, icmd.Success)
+	ip, err := inspectFilter(cname, ".NetworkSettings.Networks.bridge.IPAddress")
+	c.Assert(err, check.IsNil)
+	c.Assert(ip, check.Equals, ipv4)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"strings"
 
 	"github.com/alibaba/pouch/test/command"
 	"github.com/alibaba/pouch/test/environment"
@@ -31,7 +32,6 @@ func (suite *PouchRunPidSuite) TearDownTest(c *check.C) {
 }
 
 // TestRunWithPIDMode is to verify --specific PID mode when running a container.
-// TODO: test container pid namespace mode.
 func (suite *PouchRunPidSuite) TestRunWithPIDMode(c *check.C) {
 	name := "test-run-with-pid-mode"
 
@@ -42,6 +42,20 @@ func (suite *PouchRunPidSuite) TestRunWithPIDMode(c *check.C) {
 	res.Assert(c, icmd.Success)
 }
 
+// TestRunWithContainerPIDMode is to verify the sidecar joining the PID
+// namespace of another container sees its processes.
+func (suite *PouchRunPidSuite) TestRunWithContainerPIDMode(c *check.C) {
+	name := "test-run-with-container-pid-mode"
+
+	res := command.PouchRun("run", "-d", "--name", name, busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, name)
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("run", "--rm", "--pid", "container:"+name, busyboxImage, "ps")
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.Contains(res.Stdout(), "top"), check.Equals, true, check.Commentf(res.Stdout()))
+}
+
 // TestRunWithPidsLimit tests running container with --pids-limit flag.
 func (suite *PouchRunPidSuite) TestRunWithPidsLimit(c *check.C) {
 	// pids cgroup may not supported in inner ci
@@ -148,7 +148,6 @@ func (suite *PouchRunSuite) TestRunRestartPolicyNone(c *check.C) {
 }
 
 // TestRunWithIPCMode is to verify --specific IPC mode when running a container.
-// TODO: test container ipc namespace mode.
 func (suite *PouchRunSuite) TestRunWithIPCMode(c *check.C) {
 	name := "test-run-with-ipc-mode"
 
@@ -159,6 +158,39 @@ func (suite *PouchRunSuite) TestRunWithIPCMode(c *check.C) {
 	res.Assert(c, icmd.Success)
 }
 
+// TestRunWithPrivateIPCMode is to verify the private IPC namespace can't be
+// joined by other containers.
+func (suite *PouchRunSuite) TestRunWithPrivateIPCMode(c *check.C) {
+	name := "test-run-with-private-ipc-mode"
+
+	res := command.PouchRun("run", "-d", "--name", name,
+		"--ipc", "private", busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, name)
+	res.Assert(c, icmd.Success)
+
+	joined := "test-run-with-private-ipc-mode-joined"
+	res = command.PouchRun("run", "-d", "--name", joined,
+		"--ipc", "container:"+name, busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, joined)
+	c.Assert(res.Error, check.NotNil)
+	c.Assert(strings.Contains(res.Stderr(), "not shareable"), check.Equals, true, check.Commentf(res.Stderr()))
+}
+
+// TestRunWithContainerUTSMode is to verify the container joining the UTS
+// namespace of another container has its hostname.
+func (suite *PouchRunSuite) TestRunWithContainerUTSMode(c *check.C) {
+	name := "test-run-with-container-uts-mode"
+
+	res := command.PouchRun("run", "-d", "--name", name,
+		"--hostname", "foo", busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, name)
+	res.Assert(c, icmd.Success)
+
+	res = command.PouchRun("run", "--rm", "--uts", "container:"+name, busyboxImage, "hostname")
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, "foo")
+}
+
 // TestRunWithUTSMode is to verify --specific UTS mode when running a container.
 func (suite *PouchRunSuite) TestRunWithUTSMode(c *check.C) {
 	name := "test-run-with-uts-mode"
@@ -329,6 +361,26 @@ func (suite *PouchRunSuite) TestRunWithRM(c *check.C) {
 	c.Assert(util.PartialEqual(output, cname+": not found"), check.IsNil)
 }
 
+// TestRunDetachWithRM is to verify the detached container with rm flag is
+// removed by daemon after it exits.
+func (suite *PouchRunSuite) TestRunDetachWithRM(c *check.C) {
+	cname := "TestRunDetachWithRM"
+	res := command.PouchRun("run", "-d", "--rm", "--name", cname, busyboxImage,
+		"echo", "hello")
+	defer DelContainerForceMultyTime(c, cname)
+	res.Assert(c, icmd.Success)
+
+	command.PouchRun("wait", cname)
+	time.Sleep(time.Second)
+
+	output := command.PouchRun("inspect", cname).Stderr()
+	c.Assert(util.PartialEqual(output, cname+": not found"), check.IsNil)
+
+	// AutoRemove conflicts with restart policy.
+	res = command.PouchRun("run", "-d", "--rm", "--restart", "always", "--name", cname, busyboxImage, "top")
+	c.Assert(res.ExitCode, check.Not(check.Equals), 0)
+}
+
 // TestRunWithDisableNetworkFiles is to verify running container with disable-network-files flag.
 func (suite *PouchRunSuite) TestRunWithDisableNetworkFiles(c *check.C) {
 	// Run a container with disable-network-files flag
@@ -596,3 +596,29 @@ func (suite *PouchUpdateSuite) TestUpdateAnnotation(c *check.C) {
 	checkContainerAnnotation(c, cname, "key1", "value1.new")
 	checkContainerAnnotation(c, cname, "key2", "value2.new")
 }
+
+// TestUpdateRemoveLabelAndAnnotation is to verify the correctness of removing labels and annotations by update interface.
+func (suite *PouchUpdateSuite) TestUpdateRemoveLabelAndAnnotation(c *check.C) {
+	cname := "TestUpdateRemoveLabelAndAnnotation"
+	command.PouchRun("run", "-d", "--name", cname, "--label", "foo=bar", "--label", "env=prod",
+		"--annotation", "key1=value1", "--annotation", "key2=value2", busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, cname)
+
+	command.PouchRun("update", "--label", "tier=frontend", "--label-rm", "env", "--annotation-rm", "key1", cname).Assert(c, icmd.Success)
+
+	output := command.PouchRun("inspect", cname).Stdout()
+	result := []types.ContainerJSON{}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		c.Fatalf("failed to decode inspect output: %v", err)
+	}
+
+	c.Assert(result[0].Config.Labels["foo"], check.Equals, "bar")
+	c.Assert(result[0].Config.Labels["tier"], check.Equals, "frontend")
+	if _, ok := result[0].Config.Labels["env"]; ok {
+		c.Errorf("expect label env being removed, but not")
+	}
+	if _, ok := result[0].Config.SpecAnnotation["key1"]; ok {
+		c.Errorf("expect annotation key1 being removed, but not")
+	}
+	c.Assert(result[0].Config.SpecAnnotation["key2"], check.Equals, "value2")
+}
@@ -11,6 +11,14 @@ var (
 	// APIVersion means the api version daemon serves
 	APIVersion = "1.24"
 
+	// MinAPIVersion is the min api version daemon serves, it is the same as
+	// docker since the api is compatible with docker.
+	MinAPIVersion = "1.12"
+
+	// DeprecatedAPIVersion is the api version before which the versions are
+	// deprecated, the clients of them are warned in the responses.
+	DeprecatedAPIVersion = "1.12"
+
 	// GitCommit is the commit id to build Pouch
 	GitCommit = "unknown"
 )